@@ -37,17 +37,20 @@ var planPeplayerTables = map[string]map[string]struct{}{
 
 var statsTables = map[string]map[string]struct{}{
 	"mysql": {
-		"stats_buckets":      {},
-		"stats_extended":     {},
-		"stats_feedback":     {},
-		"stats_fm_sketch":    {},
-		"stats_histograms":   {},
-		"stats_history":      {},
-		"stats_meta":         {},
-		"stats_meta_history": {},
-		"stats_table_locked": {},
-		"stats_top_n":        {},
-		"column_stats_usage": {},
+		"stats_buckets":         {},
+		"stats_extended":        {},
+		"stats_feedback":        {},
+		"stats_fm_sketch":       {},
+		"stats_histograms":      {},
+		"stats_history":         {},
+		"stats_meta":            {},
+		"stats_meta_history":    {},
+		"stats_table_locked":    {},
+		"stats_column_locked":   {},
+		"stats_lock_history":    {},
+		"stats_index_tombstone": {},
+		"stats_top_n":           {},
+		"column_stats_usage":    {},
 	},
 }
 
@@ -76,6 +79,21 @@ var unRecoverableTable = map[string]map[string]struct{}{
 		"analyze_jobs": {},
 		// Table ID is recorded in the column `table_id` so that the table cannot be recovered simply.
 		"analyze_options": {},
+		// Table ID is recorded in the column `table_id` so that the table cannot be recovered simply.
+		"stats_estimation_errors": {},
+		// Table ID is recorded in the column `table_id` so that the table cannot be recovered simply.
+		"auto_analyze_decisions": {},
+		// Table ID is recorded in the column `table_id` so that the table cannot be recovered simply.
+		"stats_drift": {},
+		// Table ID is recorded in the column `table_id` so that the table cannot be recovered simply.
+		"stats_change_events": {},
+		// Table ID is recorded in the column `table_id` so that the table cannot be recovered simply.
+		"stats_fk_fanout": {},
+		// The revalidation results are derived from the bindings and the stats of the cluster, they're recomputed by the
+		// next revalidation after the bindings are restored.
+		"binding_revalidation": {},
+		// The stats health reports describe the stats of the cluster where they're generated, no need to recovered.
+		"stats_health_reports": {},
 		// Distributed eXecution Framework
 		// Records the tidb node information, no need to recovered.
 		"dist_framework_meta":             {},
@@ -52,6 +52,8 @@ const (
 	SourceManual = "manual"
 	// SourceHistory indicate the binding is created from statement summary by plan digest
 	SourceHistory = "history"
+	// SourcePlanGuard indicates the binding is captured by the plan change guard after analyze.
+	SourcePlanGuard = "plan_guard"
 )
 
 // Binding stores the basic bind hint info.
@@ -181,7 +181,7 @@ func (w *worker) onCreateTable(jobCtx *jobContext, job *model.Job) (ver int64, _
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
-	createTableEvent := notifier.NewCreateTableEvent(tbInfo)
+	createTableEvent := newCreateTableEvent(tbInfo, args)
 	err = asyncNotifyEvent(jobCtx, createTableEvent, job, noSubJob, w.sess)
 	if err != nil {
 		return ver, errors.Trace(err)
@@ -192,6 +192,14 @@ func (w *worker) onCreateTable(jobCtx *jobContext, job *model.Job) (ver int64, _
 	return ver, errors.Trace(err)
 }
 
+// newCreateTableEvent creates the event notified after the table is created.
+func newCreateTableEvent(tbInfo *model.TableInfo, args *model.CreateTableArgs) *notifier.SchemaChangeEvent {
+	if args.StatsReferTableID != 0 {
+		return notifier.NewCreateTableWithStatsEvent(tbInfo, args.StatsReferTableID)
+	}
+	return notifier.NewCreateTableEvent(tbInfo)
+}
+
 func (w *worker) createTableWithForeignKeys(jobCtx *jobContext, job *model.Job, args *model.CreateTableArgs) (ver int64, err error) {
 	tbInfo := args.TableInfo
 	switch tbInfo.State {
@@ -223,7 +231,7 @@ func (w *worker) createTableWithForeignKeys(jobCtx *jobContext, job *model.Job,
 		if err != nil {
 			return ver, errors.Trace(err)
 		}
-		createTableEvent := notifier.NewCreateTableEvent(tbInfo)
+		createTableEvent := newCreateTableEvent(tbInfo, args)
 		err = asyncNotifyEvent(jobCtx, createTableEvent, job, noSubJob, w.sess)
 		if err != nil {
 			return ver, errors.Trace(err)
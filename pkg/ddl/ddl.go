@@ -120,6 +120,9 @@ type CreateTableConfig struct {
 	// by BR now. By reusing IDs BR can save a lot of works such as rewriting table
 	// IDs in backed up KVs.
 	IDAllocated bool
+	// StatsReferTableID is the ID of the table whose statistics are copied to the
+	// new table, 0 means no statistics are copied.
+	StatsReferTableID int64
 }
 
 // CreateTableOption is the option for creating table.
@@ -152,6 +155,13 @@ func WithIDAllocated(idAllocated bool) CreateTableOption {
 	}
 }
 
+// WithStatsReferTable applies the StatsReferTableID option.
+func WithStatsReferTable(referTableID int64) CreateTableOption {
+	return func(cfg *CreateTableConfig) {
+		cfg.StatsReferTableID = referTableID
+	}
+}
+
 const (
 	// OnExistError throws an error on name collision.
 	OnExistError OnExist = iota
@@ -23,6 +23,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -1033,8 +1034,15 @@ func (e *executor) CreateTable(ctx sessionctx.Context, s *ast.CreateTableStmt) (
 	if s.IfNotExists {
 		onExist = OnExistIgnore
 	}
+	opts := []CreateTableOption{WithOnExist(onExist)}
+	if s.ReferTable != nil && s.WithStatistics {
+		if s.TemporaryKeyword != ast.TemporaryNone || referTbl.Meta().TempTableType != model.TempTableNone {
+			return dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("with statistics")
+		}
+		opts = append(opts, WithStatsReferTable(referTbl.Meta().ID))
+	}
 
-	return e.CreateTableWithInfo(ctx, schema.Name, tbInfo, involvingRef, WithOnExist(onExist))
+	return e.CreateTableWithInfo(ctx, schema.Name, tbInfo, involvingRef, opts...)
 }
 
 // createTableWithInfoJob returns the table creation job.
@@ -1118,10 +1126,11 @@ func (e *executor) createTableWithInfoJob(
 		SQLMode:             ctx.GetSessionVars().SQLMode,
 	}
 	args := &model.CreateTableArgs{
-		TableInfo:      tbInfo,
-		OnExistReplace: cfg.OnExist == OnExistReplace,
-		OldViewTblID:   oldViewTblID,
-		FKCheck:        ctx.GetSessionVars().ForeignKeyChecks,
+		TableInfo:         tbInfo,
+		OnExistReplace:    cfg.OnExist == OnExistReplace,
+		OldViewTblID:      oldViewTblID,
+		FKCheck:           ctx.GetSessionVars().ForeignKeyChecks,
+		StatsReferTableID: cfg.StatsReferTableID,
 	}
 	return NewJobWrapperWithArgs(job, args, cfg.IDAllocated), nil
 }
@@ -3830,6 +3839,13 @@ func (e *executor) AlterTableAddStatistics(ctx sessionctx.Context, ident ast.Ide
 	if tblInfo.GetPartitionInfo() != nil {
 		return errors.New("Extended statistics on partitioned tables are not supported now")
 	}
+	if stats.StatsType == ast.StatsTypeExpression {
+		colIDs, exprStr, err := checkAndRestoreStatsExpr(ctx, tblInfo, stats.Expr)
+		if err != nil {
+			return err
+		}
+		return e.statsHandle.InsertExtendedStats(stats.StatsName, colIDs, int(stats.StatsType), exprStr, tblInfo.ID, ifNotExists)
+	}
 	colIDs := make([]int64, 0, 2)
 	colIDSet := make(map[int64]struct{}, 2)
 	// Check whether columns exist.
@@ -3838,6 +3854,9 @@ func (e *executor) AlterTableAddStatistics(ctx sessionctx.Context, ident ast.Ide
 		if col == nil {
 			return infoschema.ErrColumnNotExists.GenWithStackByArgs(colName.Name, ident.Name)
 		}
+		if stats.StatsType == ast.StatsTypeTrigram && (col.FieldType.EvalType() != types.ETString || col.GetType() == mysql.TypeEnum || col.GetType() == mysql.TypeSet) {
+			return errors.Errorf("Trigram statistics type is only supported on string columns, but column '%s' is not", colName.Name.L)
+		}
 		if stats.StatsType == ast.StatsTypeCorrelation && tblInfo.PKIsHandle && mysql.HasPriKeyFlag(col.GetFlag()) {
 			ctx.GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError("No need to create correlation statistics on the integer primary key column"))
 			return nil
@@ -3851,6 +3870,12 @@ func (e *executor) AlterTableAddStatistics(ctx sessionctx.Context, ident ast.Ide
 	if len(colIDs) != 2 && (stats.StatsType == ast.StatsTypeCorrelation || stats.StatsType == ast.StatsTypeDependency) {
 		return errors.New("Only support Correlation and Dependency statistics types on 2 columns")
 	}
+	if len(colIDs) != 1 && stats.StatsType == ast.StatsTypeTrigram {
+		return errors.New("Only support Trigram statistics type on 1 column")
+	}
+	if len(colIDs) != 1 && stats.StatsType == ast.StatsTypeJoinSketch {
+		return errors.New("Only support Join Sketch statistics type on 1 column")
+	}
 	if len(colIDs) < 1 && stats.StatsType == ast.StatsTypeCardinality {
 		return errors.New("Only support Cardinality statistics type on at least 2 columns")
 	}
@@ -3858,7 +3883,37 @@ func (e *executor) AlterTableAddStatistics(ctx sessionctx.Context, ident ast.Ide
 
 	// Call utilities of statistics.Handle to modify system tables instead of doing DML directly,
 	// because locking in Handle can guarantee the correctness of `version` in system tables.
-	return e.statsHandle.InsertExtendedStats(stats.StatsName, colIDs, int(stats.StatsType), tblInfo.ID, ifNotExists)
+	return e.statsHandle.InsertExtendedStats(stats.StatsName, colIDs, int(stats.StatsType), "", tblInfo.ID, ifNotExists)
+}
+
+// checkAndRestoreStatsExpr checks the expression of the expression statistics, and returns the IDs of the columns
+// it references and its restored string.
+func checkAndRestoreStatsExpr(ctx sessionctx.Context, tblInfo *model.TableInfo, expr ast.ExprNode) ([]int64, string, error) {
+	var sb strings.Builder
+	restoreFlags := format.RestoreStringSingleQuotes | format.RestoreKeyWordLowercase | format.RestoreNameBackQuotes |
+		format.RestoreSpacesAroundBinaryOperation | format.RestoreWithoutSchemaName | format.RestoreWithoutTableName
+	if err := expr.Restore(format.NewRestoreCtx(restoreFlags, &sb)); err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	exprStr := sb.String()
+	e, err := expression.ParseSimpleExpr(ctx.GetExprCtx(), exprStr, expression.WithTableInfo("", tblInfo))
+	if err != nil {
+		return nil, "", err
+	}
+	if expression.IsMutableEffectsExpr(e) {
+		return nil, "", errors.New("Expression statistics type is not supported on the non-deterministic expressions")
+	}
+	colIDs := make([]int64, 0, 2)
+	for _, col := range expression.ExtractColumns(e) {
+		if !slices.Contains(colIDs, col.ID) {
+			colIDs = append(colIDs, col.ID)
+		}
+	}
+	// The column IDs are persisted in a varchar(32) column of mysql.stats_extended.
+	if len(colIDs) < 1 || len(colIDs) > 2 {
+		return nil, "", errors.New("Only support Expression statistics type on the expressions of 1 or 2 columns")
+	}
+	return colIDs, exprStr, nil
 }
 
 // AlterTableDropStatistics logically deletes extended statistics for a table.
@@ -4286,8 +4341,9 @@ func (e *executor) TruncateTable(ctx sessionctx.Context, ti ast.Ident) error {
 		SQLMode:        ctx.GetSessionVars().SQLMode,
 	}
 	args := &model.TruncateTableArgs{
-		FKCheck:         fkCheck,
-		OldPartitionIDs: oldPartitionIDs,
+		FKCheck:           fkCheck,
+		OldPartitionIDs:   oldPartitionIDs,
+		StatsRefillFactor: ctx.GetSessionVars().TruncateTableStatsRefillFactor,
 	}
 	err = e.doDDLJob2(ctx, job, args)
 	if err != nil {
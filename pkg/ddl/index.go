@@ -720,7 +720,7 @@ func checkAndBuildIndexInfo(
 func (w *worker) onCreateVectorIndex(jobCtx *jobContext, job *model.Job) (ver int64, err error) {
 	// Handle the rolling back job.
 	if job.IsRollingback() {
-		ver, err = onDropIndex(jobCtx, job)
+		ver, err = w.onDropIndex(jobCtx, job)
 		if err != nil {
 			return ver, errors.Trace(err)
 		}
@@ -948,7 +948,7 @@ func (w *worker) checkVectorIndexProcessOnce(jobCtx *jobContext, tbl table.Table
 func (w *worker) onCreateIndex(jobCtx *jobContext, job *model.Job, isPK bool) (ver int64, err error) {
 	// Handle the rolling back job.
 	if job.IsRollingback() {
-		ver, err = onDropIndex(jobCtx, job)
+		ver, err = w.onDropIndex(jobCtx, job)
 		if err != nil {
 			return ver, errors.Trace(err)
 		}
@@ -1555,7 +1555,7 @@ func runReorgJobAndHandleErr(
 	return true, ver, nil
 }
 
-func onDropIndex(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
+func (w *worker) onDropIndex(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
 	tblInfo, allIndexInfos, ifExists, err := checkDropIndex(jobCtx.infoCache, jobCtx.metaMut, job)
 	if err != nil {
 		if ifExists && dbterror.ErrCantDropFieldOrKey.Equal(err) {
@@ -1662,6 +1662,11 @@ func onDropIndex(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
 			}
 			job.FillFinishedArgs(addIndexArgs)
 		} else {
+			dropIndexEvent := notifier.NewDropIndexEvent(tblInfo, allIndexInfos)
+			err = asyncNotifyEvent(jobCtx, dropIndexEvent, job, noSubJob, w.sess)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
 			// the partition ids were append by convertAddIdxJob2RollbackJob, it is weird, but for the compatibility,
 			// we should keep appending the partitions in the convertAddIdxJob2RollbackJob.
 			job.FinishTableJob(model.JobStateDone, model.StateNone, ver, tblInfo)
@@ -934,7 +934,7 @@ func (w *worker) runOneJobStep(
 	case model.ActionAddVectorIndex:
 		ver, err = w.onCreateVectorIndex(jobCtx, job)
 	case model.ActionDropIndex, model.ActionDropPrimaryKey:
-		ver, err = onDropIndex(jobCtx, job)
+		ver, err = w.onDropIndex(jobCtx, job)
 	case model.ActionRenameIndex:
 		ver, err = onRenameIndex(jobCtx, job)
 	case model.ActionAddForeignKey:
@@ -948,7 +948,7 @@ func (w *worker) runOneJobStep(
 	case model.ActionRebaseAutoRandomBase:
 		ver, err = onRebaseAutoRandomType(jobCtx, job)
 	case model.ActionRenameTable:
-		ver, err = onRenameTable(jobCtx, job)
+		ver, err = w.onRenameTable(jobCtx, job)
 	case model.ActionShardRowID:
 		ver, err = w.onShardRowID(jobCtx, job)
 	case model.ActionModifyTableComment:
@@ -976,7 +976,7 @@ func (w *worker) runOneJobStep(
 	case model.ActionAlterSequence:
 		ver, err = onAlterSequence(jobCtx, job)
 	case model.ActionRenameTables:
-		ver, err = onRenameTables(jobCtx, job)
+		ver, err = w.onRenameTables(jobCtx, job)
 	case model.ActionAlterTableAttributes:
 		ver, err = onAlterTableAttributes(jobCtx, job)
 	case model.ActionAlterTablePartitionAttributes:
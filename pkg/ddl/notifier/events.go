@@ -49,6 +49,9 @@ func (s *SchemaChangeEvent) String() string {
 	if s.inner.OldTableID4Partition != 0 {
 		_, _ = fmt.Fprintf(&sb, ", Old Table ID for Partition: %d", s.inner.OldTableID4Partition)
 	}
+	if s.inner.OldTableName != "" {
+		_, _ = fmt.Fprintf(&sb, ", Old Schema Name: %s, Old Table Name: %s", s.inner.OldSchemaName, s.inner.OldTableName)
+	}
 	if s.inner.AddedPartInfo != nil {
 		for _, partDef := range s.inner.AddedPartInfo.Definitions {
 			if partDef.Name.L != "" {
@@ -68,6 +71,9 @@ func (s *SchemaChangeEvent) String() string {
 	for _, columnInfo := range s.inner.Columns {
 		_, _ = fmt.Fprintf(&sb, ", Column ID: %d, Column Name: %s", columnInfo.ID, columnInfo.Name)
 	}
+	for _, columnInfo := range s.inner.OldColumns {
+		_, _ = fmt.Fprintf(&sb, ", Old Column ID: %d, Old Column Name: %s", columnInfo.ID, columnInfo.Name)
+	}
 	for _, indexInfo := range s.inner.Indexes {
 		_, _ = fmt.Fprintf(&sb, ", Index ID: %d, Index Name: %s", indexInfo.ID, indexInfo.Name)
 	}
@@ -97,6 +103,22 @@ func NewCreateTableEvent(
 	}
 }
 
+// NewCreateTableWithStatsEvent creates a SchemaChangeEvent whose type is
+// ActionCreateTable, the statistics of the refer table are copied to the new
+// table. It's used for CREATE TABLE ... LIKE ... WITH STATISTICS.
+func NewCreateTableWithStatsEvent(
+	newTableInfo *model.TableInfo,
+	statsReferTableID int64,
+) *SchemaChangeEvent {
+	return &SchemaChangeEvent{
+		inner: &jsonSchemaChangeEvent{
+			Tp:                model.ActionCreateTable,
+			TableInfo:         newTableInfo,
+			StatsReferTableID: statsReferTableID,
+		},
+	}
+}
+
 // GetCreateTableInfo returns the table info of the SchemaChangeEvent whose type
 // is ActionCreateTable.
 func (s *SchemaChangeEvent) GetCreateTableInfo() *model.TableInfo {
@@ -104,17 +126,29 @@ func (s *SchemaChangeEvent) GetCreateTableInfo() *model.TableInfo {
 	return s.inner.TableInfo
 }
 
+// GetCreateTableStatsReferTableID returns the ID of the table whose statistics
+// are copied to the new table of the SchemaChangeEvent whose type is
+// ActionCreateTable. 0 means no statistics are copied.
+func (s *SchemaChangeEvent) GetCreateTableStatsReferTableID() int64 {
+	intest.Assert(s.inner.Tp == model.ActionCreateTable)
+	return s.inner.StatsReferTableID
+}
+
 // NewTruncateTableEvent creates a SchemaChangeEvent whose type is
 // ActionTruncateTable.
+// The statsRefillFactor is used to keep the stats of the dropped table for the
+// new table, 0 means the stats are not kept.
 func NewTruncateTableEvent(
 	newTableInfo *model.TableInfo,
 	droppedTableInfo *model.TableInfo,
+	statsRefillFactor float64,
 ) *SchemaChangeEvent {
 	return &SchemaChangeEvent{
 		inner: &jsonSchemaChangeEvent{
-			Tp:           model.ActionTruncateTable,
-			TableInfo:    newTableInfo,
-			OldTableInfo: droppedTableInfo,
+			Tp:                model.ActionTruncateTable,
+			TableInfo:         newTableInfo,
+			OldTableInfo:      droppedTableInfo,
+			StatsRefillFactor: statsRefillFactor,
 		},
 	}
 }
@@ -129,6 +163,14 @@ func (s *SchemaChangeEvent) GetTruncateTableInfo() (
 	return s.inner.TableInfo, s.inner.OldTableInfo
 }
 
+// GetTruncateTableStatsRefillFactor returns the factor to scale the stats of the
+// dropped table for the new table of the SchemaChangeEvent whose type is
+// ActionTruncateTable. 0 means the stats are not kept.
+func (s *SchemaChangeEvent) GetTruncateTableStatsRefillFactor() float64 {
+	intest.Assert(s.inner.Tp == model.ActionTruncateTable)
+	return s.inner.StatsRefillFactor
+}
+
 // NewDropTableEvent creates a SchemaChangeEvent whose type is ActionDropTable.
 func NewDropTableEvent(
 	droppedTableInfo *model.TableInfo,
@@ -148,6 +190,32 @@ func (s *SchemaChangeEvent) GetDropTableInfo() (droppedTableInfo *model.TableInf
 	return s.inner.OldTableInfo
 }
 
+// NewRecoverTableEvent creates a SchemaChangeEvent whose type is
+// ActionRecoverTable. The snapshotTS is the start TS of the job which dropped
+// the table.
+func NewRecoverTableEvent(
+	recoveredTableInfo *model.TableInfo,
+	snapshotTS uint64,
+) *SchemaChangeEvent {
+	return &SchemaChangeEvent{
+		inner: &jsonSchemaChangeEvent{
+			Tp:         model.ActionRecoverTable,
+			TableInfo:  recoveredTableInfo,
+			SnapshotTS: snapshotTS,
+		},
+	}
+}
+
+// GetRecoverTableInfo returns the table info and the snapshot TS of the
+// SchemaChangeEvent whose type is ActionRecoverTable.
+func (s *SchemaChangeEvent) GetRecoverTableInfo() (
+	recoveredTableInfo *model.TableInfo,
+	snapshotTS uint64,
+) {
+	intest.Assert(s.inner.Tp == model.ActionRecoverTable)
+	return s.inner.TableInfo, s.inner.SnapshotTS
+}
+
 // NewAddColumnEvent creates a SchemaChangeEvent whose type is ActionAddColumn.
 func NewAddColumnEvent(
 	tableInfo *model.TableInfo,
@@ -173,28 +241,34 @@ func (s *SchemaChangeEvent) GetAddColumnInfo() (
 }
 
 // NewModifyColumnEvent creates a SchemaChangeEvent whose type is
-// ActionModifyColumn.
+// ActionModifyColumn. The oldColumns are the columns replaced by the
+// modifiedColumns, in the same order.
 func NewModifyColumnEvent(
 	tableInfo *model.TableInfo,
 	modifiedColumns []*model.ColumnInfo,
+	oldColumns []*model.ColumnInfo,
 ) *SchemaChangeEvent {
 	return &SchemaChangeEvent{
 		inner: &jsonSchemaChangeEvent{
-			Tp:        model.ActionModifyColumn,
-			TableInfo: tableInfo,
-			Columns:   modifiedColumns,
+			Tp:         model.ActionModifyColumn,
+			TableInfo:  tableInfo,
+			Columns:    modifiedColumns,
+			OldColumns: oldColumns,
 		},
 	}
 }
 
-// GetModifyColumnInfo returns the table info and modified column info the
-// SchemaChangeEvent whose type is ActionModifyColumn.
+// GetModifyColumnInfo returns the table info, modified column info and the
+// replaced column info of the SchemaChangeEvent whose type is
+// ActionModifyColumn. The oldColumns may be empty for the events created by
+// an older version.
 func (s *SchemaChangeEvent) GetModifyColumnInfo() (
 	newTableInfo *model.TableInfo,
 	modifiedColumns []*model.ColumnInfo,
+	oldColumns []*model.ColumnInfo,
 ) {
 	intest.Assert(s.inner.Tp == model.ActionModifyColumn)
-	return s.inner.TableInfo, s.inner.Columns
+	return s.inner.TableInfo, s.inner.Columns, s.inner.OldColumns
 }
 
 // NewAddPartitionEvent creates a SchemaChangeEvent whose type is
@@ -417,6 +491,63 @@ func (s *SchemaChangeEvent) GetAddIndexInfo() (
 	return s.inner.TableInfo, s.inner.Indexes
 }
 
+// NewDropIndexEvent creates a schema change event whose type is ActionDropIndex.
+// The droppedIndexes are the index infos before they are removed from the table.
+func NewDropIndexEvent(
+	tableInfo *model.TableInfo,
+	droppedIndexes []*model.IndexInfo,
+) *SchemaChangeEvent {
+	return &SchemaChangeEvent{
+		inner: &jsonSchemaChangeEvent{
+			Tp:        model.ActionDropIndex,
+			TableInfo: tableInfo,
+			Indexes:   droppedIndexes,
+		},
+	}
+}
+
+// GetDropIndexInfo returns the table info and dropped index info of the
+// SchemaChangeEvent whose type is ActionDropIndex.
+func (s *SchemaChangeEvent) GetDropIndexInfo() (
+	tableInfo *model.TableInfo,
+	droppedIndexes []*model.IndexInfo,
+) {
+	intest.Assert(s.inner.Tp == model.ActionDropIndex)
+	return s.inner.TableInfo, s.inner.Indexes
+}
+
+// NewRenameTableEvent creates a schema change event whose type is
+// ActionRenameTable. For RENAME TABLES, an event is created for each table.
+// The tableInfo contains the new table name.
+func NewRenameTableEvent(
+	tableInfo *model.TableInfo,
+	oldSchemaName ast.CIStr,
+	newSchemaName ast.CIStr,
+	oldTableName ast.CIStr,
+) *SchemaChangeEvent {
+	return &SchemaChangeEvent{
+		inner: &jsonSchemaChangeEvent{
+			Tp:            model.ActionRenameTable,
+			TableInfo:     tableInfo,
+			OldSchemaName: oldSchemaName.O,
+			SchemaName:    newSchemaName.O,
+			OldTableName:  oldTableName.O,
+		},
+	}
+}
+
+// GetRenameTableInfo returns the table info, the old and new schema names and
+// the old table name of the SchemaChangeEvent whose type is ActionRenameTable.
+func (s *SchemaChangeEvent) GetRenameTableInfo() (
+	tableInfo *model.TableInfo,
+	oldSchemaName string,
+	newSchemaName string,
+	oldTableName string,
+) {
+	intest.Assert(s.inner.Tp == model.ActionRenameTable)
+	return s.inner.TableInfo, s.inner.OldSchemaName, s.inner.SchemaName, s.inner.OldTableName
+}
+
 // NewFlashbackClusterEvent creates a schema change event whose type is
 // ActionFlashbackCluster.
 func NewFlashbackClusterEvent() *SchemaChangeEvent {
@@ -499,10 +630,21 @@ type jsonSchemaChangeEvent struct {
 	AddedPartInfo   *model.PartitionInfo      `json:"added_partition_info,omitempty"`
 	DroppedPartInfo *model.PartitionInfo      `json:"dropped_partition_info,omitempty"`
 	Columns         []*model.ColumnInfo       `json:"columns,omitempty"`
+	OldColumns      []*model.ColumnInfo       `json:"old_columns,omitempty"`
 	Indexes         []*model.IndexInfo        `json:"indexes,omitempty"`
 	// OldTableID4Partition is used to store the table ID when a table transitions from being partitioned to non-partitioned,
 	// or vice versa.
 	OldTableID4Partition int64 `json:"old_table_id_for_partition,omitempty"`
+	// StatsRefillFactor is used to scale the stats of the truncated table, which are kept for the new table.
+	StatsRefillFactor float64 `json:"stats_refill_factor,omitempty"`
+	// StatsReferTableID is the ID of the table whose stats are copied to the created table.
+	StatsReferTableID int64 `json:"stats_refer_table_id,omitempty"`
+	// OldSchemaName, SchemaName and OldTableName are used to store the names of the renamed table.
+	OldSchemaName string `json:"old_schema_name,omitempty"`
+	SchemaName    string `json:"schema_name,omitempty"`
+	OldTableName  string `json:"old_table_name,omitempty"`
+	// SnapshotTS is used to store the start TS of the job which dropped the recovered table.
+	SnapshotTS uint64 `json:"snapshot_ts,omitempty"`
 
 	Tp model.ActionType `json:"type,omitempty"`
 }
@@ -253,6 +253,11 @@ func (w *worker) onRecoverTable(jobCtx *jobContext, job *model.Job) (ver int64,
 		}
 		tblInfo.State = model.StatePublic
 		tblInfo.UpdateTS = metaMut.StartTS
+		recoverTableEvent := notifier.NewRecoverTableEvent(tblInfo, recoverInfo.SnapshotTS)
+		err = asyncNotifyEvent(jobCtx, recoverTableEvent, job, noSubJob, w.sess)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
 		// Finish this job.
 		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
 	default:
@@ -587,7 +592,7 @@ func (w *worker) onTruncateTable(jobCtx *jobContext, job *model.Job) (ver int64,
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
-	truncateTableEvent := notifier.NewTruncateTableEvent(tblInfo, oldTblInfo)
+	truncateTableEvent := notifier.NewTruncateTableEvent(tblInfo, oldTblInfo, args.StatsRefillFactor)
 	err = asyncNotifyEvent(jobCtx, truncateTableEvent, job, noSubJob, w.sess)
 	if err != nil {
 		return ver, errors.Trace(err)
@@ -755,7 +760,7 @@ func verifyNoOverflowShardBits(s *sess.Pool, tbl table.Table, shardRowIDBits uin
 	return nil
 }
 
-func onRenameTable(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
+func (w *worker) onRenameTable(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
 	args, err := model.GetRenameTableArgs(job)
 	if err != nil {
 		// Invalid arguments, cancel this job.
@@ -797,11 +802,20 @@ func onRenameTable(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
+	newDBInfo, err := metaMut.GetDatabase(newSchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	renameTableEvent := notifier.NewRenameTableEvent(tblInfo, oldSchemaName, newDBInfo.Name, oldTableName)
+	err = asyncNotifyEvent(jobCtx, renameTableEvent, job, noSubJob, w.sess)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
 	job.SchemaState = model.StatePublic
 	return ver, nil
 }
 
-func onRenameTables(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
+func (w *worker) onRenameTables(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
 	args, err := model.GetRenameTablesArgs(job)
 	if err != nil {
 		job.State = model.JobStateCancelled
@@ -815,6 +829,7 @@ func onRenameTables(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
 
 	fkh := newForeignKeyHelper()
 	metaMut := jobCtx.metaMut
+	renameTableEvents := make([]*notifier.SchemaChangeEvent, 0, len(args.RenameTableInfos))
 	for _, info := range args.RenameTableInfos {
 		job.TableID = info.TableID
 		job.TableName = info.OldTableName.L
@@ -822,6 +837,7 @@ func onRenameTables(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
 		if err != nil {
 			return ver, errors.Trace(err)
 		}
+		oldTableName := tblInfo.Name
 		ver, err := checkAndRenameTables(metaMut, job, tblInfo, info)
 		if err != nil {
 			return ver, errors.Trace(err)
@@ -832,12 +848,28 @@ func onRenameTables(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
 		if err != nil {
 			return ver, errors.Trace(err)
 		}
+		newSchemaName := info.OldSchemaName
+		if info.NewSchemaID != info.OldSchemaID {
+			dbInfo, err := metaMut.GetDatabase(info.NewSchemaID)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			newSchemaName = dbInfo.Name
+		}
+		renameTableEvents = append(renameTableEvents,
+			notifier.NewRenameTableEvent(tblInfo, info.OldSchemaName, newSchemaName, oldTableName))
 	}
 
 	ver, err = updateSchemaVersion(jobCtx, job, fkh.getLoadedTables()...)
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
+	for i, event := range renameTableEvents {
+		err = asyncNotifyEvent(jobCtx, event, job, int64(i), w.sess)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+	}
 	job.SchemaState = model.StatePublic
 	return ver, nil
 }
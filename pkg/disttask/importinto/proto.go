@@ -63,6 +63,9 @@ type ImportStepMeta struct {
 	SortedDataMeta *external.SortedKVMeta
 	// SortedIndexMetas is a map from index id to its sorted kv meta.
 	SortedIndexMetas map[int64]*external.SortedKVMeta
+	// RowSamples are the rows sampled during encoding to build the stats, see
+	// importer.RowSampler for more info. it's empty if the rows are not sampled.
+	RowSamples []byte
 }
 
 const (
@@ -112,6 +115,8 @@ type SharedVars struct {
 
 	mu       sync.Mutex
 	Checksum *verification.KVGroupChecksum
+	// RowSampler is nil if the rows are not sampled.
+	RowSampler *importer.RowSampler
 
 	SortedDataMeta *external.SortedKVMeta
 	// SortedIndexMetas is a map from index id to its sorted kv meta.
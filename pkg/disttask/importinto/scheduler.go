@@ -601,6 +601,33 @@ func getLoadedRowCountOnGlobalSort(handle storage.TaskHandle, task *proto.Task)
 	return loadedRowCount, nil
 }
 
+// getRowSampler merges the rows sampled by the subtasks of the encode step.
+func getRowSampler(handle storage.TaskHandle, task *proto.Task, taskMeta *TaskMeta, globalSort bool) (*importer.RowSampler, error) {
+	rowSampler := importer.NewRowSampler(taskMeta.Plan.TableInfo)
+	if rowSampler == nil {
+		return nil, nil
+	}
+	metas, err := handle.GetPreviousSubtaskMetas(task.ID, getStepOfEncode(globalSort))
+	if err != nil {
+		return nil, err
+	}
+	for _, bs := range metas {
+		var subtaskMeta ImportStepMeta
+		if err := json.Unmarshal(bs, &subtaskMeta); err != nil {
+			return nil, errors.Trace(err)
+		}
+		// the subtasks finished by the older version don't sample the rows, the stats
+		// built from the partial samples would be misleading.
+		if len(subtaskMeta.RowSamples) == 0 {
+			return nil, nil
+		}
+		if err = rowSampler.MergeMarshaled(subtaskMeta.RowSamples); err != nil {
+			return nil, err
+		}
+	}
+	return rowSampler, nil
+}
+
 func startJob(ctx context.Context, logger *zap.Logger, taskHandle storage.TaskHandle, taskMeta *TaskMeta, jobStep string) error {
 	failpoint.InjectCall("syncBeforeJobStarted", taskMeta.JobID)
 	// retry for 3+6+12+24+(30-4)*30 ~= 825s ~= 14 minutes
@@ -644,13 +671,20 @@ func (sch *importScheduler) finishJob(ctx context.Context, logger *zap.Logger,
 	// we have already switch import-mode when switch to post-process step.
 	sch.unregisterTask(ctx, task)
 	summary := &importer.JobSummary{ImportedRows: taskMeta.Result.LoadedRowCnt}
+	rowSampler, err := getRowSampler(taskHandle, task, taskMeta, sch.GlobalSort)
+	if err != nil {
+		// the stats are not necessary for the job, we fall back to analyze the table later.
+		logger.Warn("get the rows sampled during import failed", zap.Error(err))
+		rowSampler = nil
+	}
 	// retry for 3+6+12+24+(30-4)*30 ~= 825s ~= 14 minutes
 	backoffer := backoff.NewExponential(scheduler.RetrySQLInterval, 2, scheduler.RetrySQLMaxInterval)
 	return handle.RunWithRetry(ctx, scheduler.RetrySQLTimes, backoffer, logger,
 		func(ctx context.Context) (bool, error) {
 			return true, taskHandle.WithNewSession(func(se sessionctx.Context) error {
 				if err := importer.FlushTableStats(ctx, se, taskMeta.Plan.TableInfo.ID, &importer.JobImportResult{
-					Affected: taskMeta.Result.LoadedRowCnt,
+					Affected:   taskMeta.Result.LoadedRowCnt,
+					RowSampler: rowSampler,
 				}); err != nil {
 					logger.Warn("flush table stats failed", zap.Error(err))
 				}
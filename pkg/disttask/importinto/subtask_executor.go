@@ -71,6 +71,7 @@ func (e *importMinimalTaskExecutor) Run(ctx context.Context, dataWriter, indexWr
 			sharedVars.IndexEngine,
 			logger,
 			checksum,
+			sharedVars.RowSampler,
 		); err != nil {
 			return err
 		}
@@ -83,6 +84,7 @@ func (e *importMinimalTaskExecutor) Run(ctx context.Context, dataWriter, indexWr
 			indexWriter,
 			logger,
 			checksum,
+			sharedVars.RowSampler,
 		); err != nil {
 			return err
 		}
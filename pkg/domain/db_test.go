@@ -17,12 +17,15 @@ package domain_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/ddl"
+	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/domain/infosync"
 	"github.com/pingcap/tidb/pkg/keyspace"
 	"github.com/pingcap/tidb/pkg/kv"
@@ -203,3 +206,52 @@ func TestFetchAllSchemasWithTablesWithFailpoint(t *testing.T) {
 	require.Equal(t, err.Error(), "failpoint: failed to fetch schemas with tables")
 	require.Nil(t, dbs)
 }
+
+func TestStatsDeltaCheckpointRestoredAfterRestart(t *testing.T) {
+	store, err := mockstore.NewMockStore()
+	require.NoError(t, err)
+	defer func() {
+		err := store.Close()
+		require.NoError(t, err)
+	}()
+	path := filepath.Join(t.TempDir(), "stats_delta.checkpoint")
+	domain.SetStatsDeltaCheckpointPath4Test(path)
+	defer domain.SetStatsDeltaCheckpointPath4Test("")
+	// The checkpoint is enabled by the stats update loop, which only runs if the stats lease is positive.
+	session.SetStatsLease(time.Hour)
+	defer session.DisableStats4Test()
+	dom, err := session.BootstrapSession(store)
+	require.NoError(t, err)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int)")
+	tk.MustExec("create table t2 (a int)")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	t1ID, t2ID := dom.MustGetTableID(t, "test", "t1"), dom.MustGetTableID(t, "test", "t2")
+	tk.MustExec("insert into t1 values (1), (2), (3)")
+	tk.MustExec("insert into t2 values (1), (2)")
+	// The deltas not dumped are checkpointed after the dump.
+	require.NoError(t, h.DumpStatsDeltaToKVByIDs(t2ID))
+	checkpoint, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// The instance crashes before the deltas of t1 are dumped, they're restored from the checkpoint after the restart.
+	h.ResetSessionStatsList()
+	dom.Close()
+	require.NoError(t, os.WriteFile(path, checkpoint, 0600))
+	dom, err = session.BootstrapSession(store)
+	require.NoError(t, err)
+	defer dom.Close()
+	h = dom.StatsHandle()
+	pending := make(map[int64]int64)
+	for _, delta := range h.PendingStatsDeltas() {
+		pending[delta.TableID] = delta.Count
+	}
+	require.Equal(t, map[int64]int64{t1ID: 3}, pending)
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk = testkit.NewTestKit(t, store)
+	tk.MustQuery("select modify_count, count from mysql.stats_meta where table_id = ?", t1ID).Check(testkit.Rows("3 3"))
+	tk.MustQuery("select modify_count, count from mysql.stats_meta where table_id = ?", t2ID).Check(testkit.Rows("2 2"))
+}
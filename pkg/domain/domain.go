@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"runtime/pprof"
 	"sort"
 	"strconv"
 	"strings"
@@ -76,6 +77,8 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/statistics/handle"
 	"github.com/pingcap/tidb/pkg/statistics/handle/autoanalyze"
+	"github.com/pingcap/tidb/pkg/statistics/handle/drift"
+	"github.com/pingcap/tidb/pkg/statistics/handle/healthreport"
 	"github.com/pingcap/tidb/pkg/statistics/handle/initstats"
 	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
 	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
@@ -87,6 +90,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/cgroup"
 	"github.com/pingcap/tidb/pkg/util/cpu"
 	"github.com/pingcap/tidb/pkg/util/dbterror"
+	"github.com/pingcap/tidb/pkg/util/disk"
 	disttaskutil "github.com/pingcap/tidb/pkg/util/disttask"
 	"github.com/pingcap/tidb/pkg/util/domainutil"
 	"github.com/pingcap/tidb/pkg/util/engine"
@@ -2373,6 +2377,23 @@ func (do *Domain) GetHistoricalStatsWorker() *HistoricalStatsWorker {
 // EnableDumpHistoricalStats used to control whether enable dump stats for unit test
 var enableDumpHistoricalStats atomic.Bool
 
+// statsDeltaCheckpointPath overrides the path to checkpoint the pending stats deltas if it's set, and the checkpoint is
+// disabled if it's set to empty. The checkpoint is kept in the temp storage dir by default.
+var statsDeltaCheckpointPath atomic.Pointer[string]
+
+func init() {
+	if intest.InTest {
+		// The temp storage dir is shared by the tests, so the checkpoint is disabled unless they set their own path.
+		SetStatsDeltaCheckpointPath4Test("")
+	}
+}
+
+// SetStatsDeltaCheckpointPath4Test sets the path to checkpoint the pending stats deltas for test, the checkpoint is
+// disabled if path is empty.
+func SetStatsDeltaCheckpointPath4Test(path string) {
+	statsDeltaCheckpointPath.Store(&path)
+}
+
 // StartHistoricalStatsWorker start historical workers running
 func (do *Domain) StartHistoricalStatsWorker() {
 	if !enableDumpHistoricalStats.Load() {
@@ -2476,7 +2497,7 @@ func (do *Domain) UpdateTableStatsLoop(ctx, initStatsCtx sessionctx.Context) err
 	do.ddl.RegisterStatsHandle(statsHandle)
 	// Negative stats lease indicates that it is in test or in br binary mode, it does not need update.
 	if do.statsLease >= 0 {
-		do.wg.Run(do.loadStatsWorker, "loadStatsWorker")
+		do.wg.Run(statsWorker(handleutil.StatsOperationCacheRefresh, do.loadStatsWorker), "loadStatsWorker")
 	}
 	variable.EnableStatsOwner = do.enableStatsOwner
 	variable.DisableStatsOwner = do.disableStatsOwner
@@ -2492,6 +2513,9 @@ func (do *Domain) UpdateTableStatsLoop(ctx, initStatsCtx sessionctx.Context) err
 	do.wg.Run(func() {
 		do.indexUsageWorker()
 	}, "indexUsageWorker")
+	if do.etcdClient != nil {
+		do.wg.Run(do.evictStatsWatcher, "evictStatsWatcher")
+	}
 	if do.statsLease <= 0 {
 		// For statsLease > 0, `gcStatsWorker` handles the quit of stats owner.
 		do.wg.Run(func() { quitStatsOwner(do, do.statsOwner) }, "quitStatsOwner")
@@ -2506,18 +2530,30 @@ func (do *Domain) UpdateTableStatsLoop(ctx, initStatsCtx sessionctx.Context) err
 		fn()
 	}
 	do.SetStatsUpdating(true)
+	path := disk.StatsDeltaCheckpointPath()
+	if p := statsDeltaCheckpointPath.Load(); p != nil {
+		path = *p
+	}
+	if path != "" {
+		if err := do.StatsHandle().EnableStatsDeltaCheckpoint(path); err != nil {
+			logutil.BgLogger().Warn("enable the checkpoint of the stats deltas failed", zap.Error(err))
+		}
+	}
 	// The asyncLoadHistogram/dumpColStatsUsageWorker/deltaUpdateTickerWorker doesn't require the stats initialization to be completed.
 	// This is because thos workers' primary responsibilities are to update the change delta and handle DDL operations.
 	// These tasks need to be in work mod as soon as possible to avoid the problem.
-	do.wg.Run(do.asyncLoadHistogram, "asyncLoadHistogram")
-	do.wg.Run(do.deltaUpdateTickerWorker, "deltaUpdateTickerWorker")
-	do.wg.Run(do.dumpColStatsUsageWorker, "dumpColStatsUsageWorker")
-	do.wg.Run(func() { waitStartTask(do, do.gcStatsWorker) }, "gcStatsWorker")
+	do.wg.Run(statsWorker(handleutil.StatsOperationLoad, do.asyncLoadHistogram), "asyncLoadHistogram")
+	do.wg.Run(statsWorker(handleutil.StatsOperationDumpDelta, do.deltaUpdateTickerWorker), "deltaUpdateTickerWorker")
+	do.wg.Run(statsWorker(handleutil.StatsOperationDumpDelta, do.dumpColStatsUsageWorker), "dumpColStatsUsageWorker")
+	do.wg.Run(statsWorker(handleutil.StatsOperationGC, func() { waitStartTask(do, do.gcStatsWorker) }), "gcStatsWorker")
 
 	// Wait for the stats worker to finish the initialization.
 	// Otherwise, we may start the auto analyze worker before the stats cache is initialized.
-	do.wg.Run(func() { waitStartTask(do, do.autoAnalyzeWorker) }, "autoAnalyzeWorker")
+	do.wg.Run(statsWorker(handleutil.StatsOperationAnalyze, func() { waitStartTask(do, do.autoAnalyzeWorker) }), "autoAnalyzeWorker")
 	do.wg.Run(func() { waitStartTask(do, do.analyzeJobsCleanupWorker) }, "analyzeJobsCleanupWorker")
+	do.wg.Run(func() { waitStartTask(do, do.statsHealthReportWorker) }, "statsHealthReportWorker")
+	do.wg.Run(func() { waitStartTask(do, do.statsDriftDetectorWorker) }, "statsDriftDetectorWorker")
+	do.wg.Run(statsWorker(handleutil.StatsOperationDumpDelta, func() { waitStartTask(do, do.regionRowDeltaWorker) }), "regionRowDeltaWorker")
 	do.wg.Run(
 		func() {
 			// The initStatsCtx is used to store the internal session for initializing stats,
@@ -2544,6 +2580,15 @@ func (do *Domain) UpdateTableStatsLoop(ctx, initStatsCtx sessionctx.Context) err
 	return nil
 }
 
+// statsWorker labels the goroutine of the stats background worker with the operation of it, so that it can be told in
+// the goroutine dumps before it does any stats work, which is labeled by handleutil.LabelSCtx instead.
+func statsWorker(operation string, worker func()) func() {
+	return func() {
+		pprof.SetGoroutineLabels(handleutil.WithStatsLabels(context.Background(), operation, 0))
+		worker()
+	}
+}
+
 // enableStatsOwner enables this node to execute stats owner jobs.
 // Since ownerManager.CampaignOwner will start a new goroutine to run ownerManager.campaignLoop,
 // we should make sure that before invoking enableStatsOwner(), stats owner is DISABLE.
@@ -2636,6 +2681,7 @@ func (do *Domain) loadStatsWorker() {
 	do.initStats(ctx)
 	statsHandle := do.StatsHandle()
 	var err error
+	interval := lease
 	for {
 		select {
 		case <-loadTicker.C:
@@ -2643,12 +2689,41 @@ func (do *Domain) loadStatsWorker() {
 			if err != nil {
 				logutil.BgLogger().Warn("update stats info failed", zap.Error(err))
 			}
+			if next := nextStatsLoadInterval(interval, lease, statsHandle.LastUpdatedTables()); next != interval {
+				interval = next
+				loadTicker.Reset(interval)
+			}
 		case <-do.exit:
 			return
 		}
 	}
 }
 
+// statsLoadBusyTables is the number of the tables whose stats are refreshed by a load, from which the interval to
+// load the stats is shortened.
+const statsLoadBusyTables = 16
+
+// nextStatsLoadInterval adapts the interval to load the stats to the number of the tables refreshed by the last load.
+// It halves when many tables are changing so the cache is refreshed sooner, and doubles when no table changes,
+// bounded by tidb_stats_lease_min and tidb_stats_lease_max.
+func nextStatsLoadInterval(interval, lease time.Duration, updatedTables int64) time.Duration {
+	lower, upper := vardef.StatsLeaseMin.Load(), vardef.StatsLeaseMax.Load()
+	if lower == 0 {
+		lower = lease
+	}
+	if upper == 0 {
+		upper = lease
+	}
+	upper = max(upper, lower)
+	switch {
+	case updatedTables >= statsLoadBusyTables:
+		interval /= 2
+	case updatedTables == 0:
+		interval *= 2
+	}
+	return min(max(interval, lower), upper)
+}
+
 func (do *Domain) asyncLoadHistogram() {
 	defer util.Recover(metrics.LabelDomain, "asyncLoadStats", nil, false)
 	lease := do.statsLease
@@ -2744,11 +2819,13 @@ func (do *Domain) gcStatsWorker() {
 	gcStatsTicker := time.NewTicker(100 * lease)
 	updateStatsHealthyTicker := time.NewTicker(20 * lease)
 	readMemTicker := time.NewTicker(memory.ReadMemInterval)
+	costCalibrationTicker := time.NewTicker(100 * lease)
 	statsHandle := do.StatsHandle()
 	defer func() {
 		gcStatsTicker.Stop()
 		readMemTicker.Stop()
 		updateStatsHealthyTicker.Stop()
+		costCalibrationTicker.Stop()
 		do.SetStatsUpdating(false)
 		logutil.BgLogger().Info("gcStatsWorker exited.")
 	}()
@@ -2767,12 +2844,26 @@ func (do *Domain) gcStatsWorker() {
 			if err != nil {
 				logutil.BgLogger().Warn("GC stats failed", zap.Error(err))
 			}
+			err = statsHandle.RemoveExpiredLockedTables(do.InfoSchema())
+			if err != nil {
+				logutil.BgLogger().Warn("remove expired locked tables failed", zap.Error(err))
+			}
 			do.CheckAutoAnalyzeWindows()
 		case <-readMemTicker.C:
 			memory.ForceReadMemStats()
 			do.StatsHandle().StatsCache.TriggerEvict()
 		case <-updateStatsHealthyTicker.C:
 			statsHandle.UpdateStatsHealthyMetrics()
+			if err := statsHandle.UpdateStatsAlertMetrics(do.InfoSchema(), do.statsOwner.IsOwner()); err != nil {
+				logutil.BgLogger().Warn("update stats alert metrics failed", zap.Error(err))
+			}
+		case <-costCalibrationTicker.C:
+			if !vardef.EnableCostCalibration.Load() {
+				continue
+			}
+			if err := statsHandle.UpdateCostCalibration(do.statsOwner.IsOwner()); err != nil {
+				logutil.BgLogger().Warn("update cost calibration failed", zap.Error(err))
+			}
 		}
 	}
 }
@@ -2797,17 +2888,24 @@ func (do *Domain) dumpColStatsUsageWorker() {
 			if err != nil {
 				logutil.BgLogger().Warn("dump column stats usage failed", zap.Error(err))
 			}
+			err = statsHandle.DumpEstimationErrorsToKV()
+			if err != nil {
+				logutil.BgLogger().Warn("dump estimation errors failed", zap.Error(err))
+			}
 		}
 	}
 }
 
+// deltaUpdateTickInterval is the interval to check whether the stats deltas of the tables are due to be dumped.
+const deltaUpdateTickInterval = 3 * time.Second
+
 func (do *Domain) deltaUpdateTickerWorker() {
 	defer util.Recover(metrics.LabelDomain, "deltaUpdateTickerWorker", nil, false)
 	logutil.BgLogger().Info("deltaUpdateTickerWorker started.")
-	lease := do.statsLease
-	// We need to have different nodes trigger tasks at different times to avoid the herd effect.
-	randDuration := time.Duration(rand.Int63n(int64(time.Minute)))
-	deltaUpdateTicker := time.NewTicker(20*lease + randDuration)
+	// The flush interval of each table adapts to its write rate, so the ticker only needs to be as frequent as the
+	// shortest interval. The tables reach their intervals at different times, which avoids the herd effect.
+	deltaUpdateTicker := time.NewTicker(deltaUpdateTickInterval)
+	defer deltaUpdateTicker.Stop()
 	statsHandle := do.StatsHandle()
 	for {
 		select {
@@ -2815,7 +2913,7 @@ func (do *Domain) deltaUpdateTickerWorker() {
 			do.deltaUpdateTickerWorkerExitPreprocessing(statsHandle)
 			return
 		case <-deltaUpdateTicker.C:
-			err := statsHandle.DumpStatsDeltaToKV(false)
+			err := statsHandle.DumpDueStatsDeltaToKV()
 			if err != nil {
 				logutil.BgLogger().Warn("dump stats delta failed", zap.Error(err))
 			}
@@ -2863,7 +2961,7 @@ func (do *Domain) autoAnalyzeWorker() {
 //
 //  1. Garbage Collection: It removes outdated analyze jobs from the statistics handle.
 //     This operation is performed every hour and only if the current instance is the owner.
-//     Analyze jobs older than 7 days are considered outdated and are removed.
+//     Analyze jobs older than tidb_analyze_job_history_retention are considered outdated and are removed.
 //
 //  2. Cleanup: It cleans up corrupted analyze jobs.
 //     A corrupted analyze job is one that is in a 'pending' or 'running' state,
@@ -2877,7 +2975,6 @@ func (do *Domain) analyzeJobsCleanupWorker() {
 	defer util.Recover(metrics.LabelDomain, "analyzeJobsCleanupWorker", nil, false)
 	// For GC.
 	const gcInterval = time.Hour
-	const daysToKeep = 7
 	gcTicker := time.NewTicker(gcInterval)
 	// For clean up.
 	// Default stats lease is 3 * time.Second.
@@ -2895,7 +2992,7 @@ func (do *Domain) analyzeJobsCleanupWorker() {
 		case <-gcTicker.C:
 			// Only the owner should perform this operation.
 			if do.statsOwner.IsOwner() {
-				updateTime := time.Now().AddDate(0, 0, -daysToKeep)
+				updateTime := time.Now().Add(-vardef.AnalyzeJobHistoryRetention.Load())
 				err := statsHandle.DeleteAnalyzeJobs(updateTime)
 				if err != nil {
 					logutil.BgLogger().Warn("gc analyze history failed", zap.Error(err))
@@ -2930,6 +3027,129 @@ func (do *Domain) analyzeJobsCleanupWorker() {
 	}
 }
 
+// RunInBackground runs the task in a goroutine which the domain waits for on exit, e.g. the analyze triggered by the
+// HTTP API. The context of the task is cancelled when the domain is closed.
+func (do *Domain) RunInBackground(task func(ctx context.Context), label string) {
+	ctx, cancel := context.WithCancel(do.ctx)
+	do.wg.Run(func() {
+		defer cancel()
+		defer util.Recover(metrics.LabelDomain, label, nil, false)
+		task(ctx)
+	}, label)
+}
+
+// statsHealthReportWorker generates the stats health report into mysql.stats_health_reports, and optionally to the
+// external storage, every tidb_stats_health_report_interval. Only the stats owner generates the reports.
+func (do *Domain) statsHealthReportWorker() {
+	defer util.Recover(metrics.LabelDomain, "statsHealthReportWorker", nil, false)
+	const checkInterval = 10 * time.Minute
+	ticker := time.NewTicker(checkInterval)
+	defer func() {
+		ticker.Stop()
+		logutil.BgLogger().Info("statsHealthReportWorker exited.")
+	}()
+	storageCache := healthreport.NewStatsStorageCache()
+	for {
+		select {
+		case <-ticker.C:
+			interval := vardef.StatsHealthReportInterval.Load()
+			if interval <= 0 || !do.statsOwner.IsOwner() {
+				continue
+			}
+			var report *healthreport.Report
+			err := handleutil.CallWithSCtx(do.StatsHandle().SPool(), func(sctx sessionctx.Context) error {
+				due, err := healthreport.IsDue(sctx, interval)
+				if err != nil || !due {
+					return err
+				}
+				if report, err = healthreport.Generate(sctx, interval, storageCache); err != nil {
+					return err
+				}
+				return healthreport.Save(sctx, report)
+			})
+			if err != nil {
+				statslogutil.StatsLogger().Warn("generate stats health report failed", zap.Error(err))
+				continue
+			}
+			if uri := vardef.StatsHealthReportStorageURI.Load(); report != nil && uri != "" {
+				if err := healthreport.WriteToExternalStorage(do.ctx, uri, report); err != nil {
+					statslogutil.StatsLogger().Warn("write stats health report to external storage failed",
+						zap.String("uri", ast.RedactURL(uri)), zap.Error(err))
+				}
+			}
+		case <-do.exit:
+			return
+		}
+	}
+}
+
+// statsDriftDetectorWorker checks whether the data distributions of the hot tables have drifted from their stats by a
+// tiny sample every tidb_stats_drift_check_interval, and records the results into mysql.stats_drift. The drifted tables
+// are analyzed by the auto analyze at an elevated priority. Only the stats owner checks the tables.
+func (do *Domain) statsDriftDetectorWorker() {
+	defer util.Recover(metrics.LabelDomain, "statsDriftDetectorWorker", nil, false)
+	const checkInterval = time.Minute
+	ticker := time.NewTicker(checkInterval)
+	defer func() {
+		ticker.Stop()
+		logutil.BgLogger().Info("statsDriftDetectorWorker exited.")
+	}()
+	var lastCheck time.Time
+	for {
+		select {
+		case <-ticker.C:
+			interval := vardef.StatsDriftCheckInterval.Load()
+			if interval <= 0 || !do.statsOwner.IsOwner() || time.Since(lastCheck) < interval {
+				continue
+			}
+			lastCheck = time.Now()
+			statsHandle := do.StatsHandle()
+			var drifts []drift.ColumnDrift
+			err := handleutil.CallWithSCtx(statsHandle.SPool(), func(sctx sessionctx.Context) (err error) {
+				drifts, err = drift.Check(sctx, statsHandle)
+				return err
+			})
+			if err == nil && len(drifts) > 0 {
+				err = handleutil.CallWithSCtx(statsHandle.SPool(), func(sctx sessionctx.Context) error {
+					return drift.Save(sctx, drifts)
+				}, handleutil.FlagWrapTxn)
+			}
+			if err != nil {
+				statslogutil.StatsLogger().Warn("check stats drift failed", zap.Error(err))
+			}
+		case <-do.exit:
+			return
+		}
+	}
+}
+
+// regionRowDeltaWorker observes the rows changed out of the sessions by the approximate keys of the regions of the
+// tables on the stats owner, see tidb_stats_region_delta_interval.
+func (do *Domain) regionRowDeltaWorker() {
+	defer util.Recover(metrics.LabelDomain, "regionRowDeltaWorker", nil, false)
+	ticker := time.NewTicker(time.Minute)
+	defer func() {
+		ticker.Stop()
+		logutil.BgLogger().Info("regionRowDeltaWorker exited.")
+	}()
+	var lastObserve time.Time
+	for {
+		select {
+		case <-ticker.C:
+			interval := vardef.StatsRegionDeltaInterval.Load()
+			if interval <= 0 || !do.statsOwner.IsOwner() || time.Since(lastObserve) < interval {
+				continue
+			}
+			lastObserve = time.Now()
+			if err := do.StatsHandle().ObserveRegionRowDeltas(); err != nil {
+				statslogutil.StatsLogger().Warn("observe the row deltas by the regions failed", zap.Error(err))
+			}
+		case <-do.exit:
+			return
+		}
+	}
+}
+
 func isAnalyzeTableSQL(sql string) bool {
 	// Get rid of the comments.
 	normalizedSQL := parser.Normalize(sql, "ON")
@@ -2954,6 +3174,7 @@ func (do *Domain) ServerMemoryLimitHandle() *servermemorylimit.Handle {
 const (
 	privilegeKey          = "/tidb/privilege"
 	sysVarCacheKey        = "/tidb/sysvars"
+	statsEvictKey         = "/tidb/stats/evict"
 	tiflashComputeNodeKey = "/tiflash/new_tiflash_compute_nodes"
 )
 
@@ -3024,6 +3245,92 @@ func (do *Domain) NotifyUpdateSysVarCache(updateLocal bool) {
 	}
 }
 
+// StatsEvictEvent is the message definition for NotifyEvictStats(), encoded in json.
+type StatsEvictEvent struct {
+	// Origin is the DDL ID of the TiDB instance sending the event, which has evicted the stats by itself.
+	Origin    string
+	TableIDs  []int64
+	ColumnIDs []int64
+}
+
+// EvictStats evicts the stats of the tables or the partitions, or only the given columns of them, from the stats
+// cache of this TiDB instance. The stats in the storage are untouched. It returns the number of the evicted tables.
+func (do *Domain) EvictStats(tableIDs, colIDs []int64) int {
+	h := do.StatsHandle()
+	if h == nil {
+		return 0
+	}
+	evicted := 0
+	for _, id := range tableIDs {
+		if h.EvictTableStats(id, colIDs) {
+			evicted++
+		}
+	}
+	return evicted
+}
+
+// NotifyEvictStats updates the stats evict key in etcd, the other TiDB instances that watch the key will evict the
+// stats from their stats cache too.
+func (do *Domain) NotifyEvictStats(tableIDs, colIDs []int64) error {
+	if do.etcdClient == nil {
+		return nil
+	}
+	data, err := json.Marshal(StatsEvictEvent{Origin: do.ddl.GetID(), TableIDs: tableIDs, ColumnIDs: colIDs})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = do.etcdClient.KV.Put(do.ctx, statsEvictKey, string(data))
+	return errors.Trace(err)
+}
+
+// evictStatsWatcher evicts the stats from the stats cache when they are evicted by the other TiDB instances with
+// `ADMIN EVICT GLOBAL STATS`.
+func (do *Domain) evictStatsWatcher() {
+	defer util.Recover(metrics.LabelDomain, "evictStatsWatcher", nil, false)
+	defer func() {
+		logutil.BgLogger().Info("evictStatsWatcher exited.")
+	}()
+	watchCh := do.etcdClient.Watch(do.ctx, statsEvictKey)
+	var count int
+	for {
+		select {
+		case <-do.exit:
+			return
+		case resp, ok := <-watchCh:
+			if !ok {
+				if do.ctx.Err() != nil {
+					return
+				}
+				logutil.BgLogger().Error("evict stats watch channel closed")
+				watchCh = do.etcdClient.Watch(do.ctx, statsEvictKey)
+				count++
+				if count > 10 {
+					time.Sleep(time.Duration(count) * time.Second)
+				}
+				continue
+			}
+			count = 0
+			for _, ev := range resp.Events {
+				if ev.Kv == nil || len(ev.Kv.Value) == 0 {
+					continue
+				}
+				var event StatsEvictEvent
+				if err := json.Unmarshal(ev.Kv.Value, &event); err != nil {
+					logutil.BgLogger().Warn("decode stats evict event failed", zap.Error(err))
+					continue
+				}
+				if event.Origin == do.ddl.GetID() {
+					continue
+				}
+				evicted := do.EvictStats(event.TableIDs, event.ColumnIDs)
+				statslogutil.StatsLogger().Info("evict stats notified by other instance",
+					zap.String("origin", event.Origin), zap.Int64s("tableIDs", event.TableIDs),
+					zap.Int64s("columnIDs", event.ColumnIDs), zap.Int("evicted", evicted))
+			}
+		}
+	}
+}
+
 // LoadSigningCertLoop loads the signing cert periodically to make sure it's fresh new.
 func (do *Domain) LoadSigningCertLoop(signingCert, signingKey string) {
 	sessionstates.SetCertPath(signingCert)
@@ -16,9 +16,11 @@ package domain
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,3 +32,28 @@ func TestErrorCode(t *testing.T) {
 func TestServerIDConstant(t *testing.T) {
 	require.Less(t, lostConnectionToPDTimeout, serverIDTTL)
 }
+
+func TestNextStatsLoadInterval(t *testing.T) {
+	lease := 3 * time.Second
+	// The interval is the stats lease by default.
+	require.Equal(t, lease, nextStatsLoadInterval(lease, lease, 100))
+	require.Equal(t, lease, nextStatsLoadInterval(lease, lease, 0))
+
+	vardef.StatsLeaseMin.Store(time.Second)
+	vardef.StatsLeaseMax.Store(10 * time.Second)
+	defer func() {
+		vardef.StatsLeaseMin.Store(vardef.DefTiDBStatsLeaseMin)
+		vardef.StatsLeaseMax.Store(vardef.DefTiDBStatsLeaseMax)
+	}()
+	// Shortened when many tables are changing.
+	require.Equal(t, 1500*time.Millisecond, nextStatsLoadInterval(lease, lease, statsLoadBusyTables))
+	require.Equal(t, time.Second, nextStatsLoadInterval(1500*time.Millisecond, lease, statsLoadBusyTables))
+	// Kept when a few tables are changing.
+	require.Equal(t, lease, nextStatsLoadInterval(lease, lease, 1))
+	// Lengthened when no table is changing.
+	require.Equal(t, 6*time.Second, nextStatsLoadInterval(lease, lease, 0))
+	require.Equal(t, 10*time.Second, nextStatsLoadInterval(6*time.Second, lease, 0))
+	// The bounds are applied even if the interval doesn't change.
+	vardef.StatsLeaseMax.Store(2 * time.Second)
+	require.Equal(t, 2*time.Second, nextStatsLoadInterval(lease, lease, 1))
+}
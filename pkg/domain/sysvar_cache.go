@@ -83,6 +83,23 @@ func (do *Domain) GetGlobalVar(name string) (string, error) {
 	return "", variable.ErrUnknownSystemVar.GenWithStackByArgs(name)
 }
 
+// GetGlobalVars gets the global vars of the names from the sysvar cache at once. The vars not in the cache are absent
+// from the returned map.
+func (do *Domain) GetGlobalVars(names []string) (map[string]string, error) {
+	if err := do.rebuildSysVarCacheIfNeeded(); err != nil {
+		return nil, err
+	}
+	vals := make(map[string]string, len(names))
+	do.sysVarCache.RLock()
+	defer do.sysVarCache.RUnlock()
+	for _, name := range names {
+		if val, ok := do.sysVarCache.global[name]; ok {
+			vals[name] = val
+		}
+	}
+	return vals, nil
+}
+
 func (*Domain) fetchTableValues(sctx sessionctx.Context) (map[string]string, error) {
 	tableContents := make(map[string]string)
 	// Copy all variables from the table to tableContents
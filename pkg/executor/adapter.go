@@ -547,7 +547,10 @@ func (a *ExecStmt) Exec(ctx context.Context) (_ sqlexec.RecordSet, err error) {
 
 	// must set plan according to the `Execute` plan before getting planDigest
 	a.inheritContextFromExecuteStmt()
-	if rm := domain.GetDomain(sctx).RunawayManager(); vardef.EnableResourceControl.Load() && rm != nil {
+	// Analyze is a background task accounted under the quota of the stats tasks, so it's never identified as a runaway
+	// query, and it's controlled by tidb_max_auto_analyze_time and KILL instead.
+	_, isAnalyze := a.StmtNode.(*ast.AnalyzeTableStmt)
+	if rm := domain.GetDomain(sctx).RunawayManager(); vardef.EnableResourceControl.Load() && rm != nil && !isAnalyze {
 		sessionVars := sctx.GetSessionVars()
 		stmtCtx := sessionVars.StmtCtx
 		_, planDigest := GetPlanDigest(stmtCtx)
@@ -1425,6 +1428,11 @@ func (a *ExecStmt) FinishExecuteStmt(txnTS uint64, err error, hasMoreResults boo
 	a.SummaryStmt(succ)
 	a.observeStmtFinishedForTopSQL()
 	a.UpdatePlanCacheRuntimeInfo()
+	a.recordCardinalityFeedback(succ)
+	a.recordEstimationErrors(succ)
+	if sc := sessVars.StmtCtx; len(sc.PredicateSelectivity) > 0 && !sessVars.InRestrictedSQL {
+		a.Ctx.UpdatePredicateSelectivity(sc.PredicateSelectivity)
+	}
 	if sessVars.StmtCtx.IsTiFlash.Load() {
 		if succ {
 			executor_metrics.TotalTiFlashQuerySuccCounter.Inc()
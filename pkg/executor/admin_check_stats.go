@@ -0,0 +1,107 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/planner/core/resolve"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+var _ exec.Executor = &AdminCheckStatsTableExec{}
+
+// AdminCheckStatsTableExec validates the loaded stats of the tables for the logical sanity and outputs the
+// violations, built from the `admin check stats table` statement.
+type AdminCheckStatsTableExec struct {
+	exec.BaseExecutor
+
+	tables []*resolve.TableNameW
+	done   bool
+}
+
+// Next implements the Executor Next interface.
+func (e *AdminCheckStatsTableExec) Next(_ context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+	h := domain.GetDomain(e.Ctx()).StatsHandle()
+	for _, tbl := range e.tables {
+		tblInfo := tbl.TableInfo
+		pi := tblInfo.GetPartitionInfo()
+		if pi == nil || e.Ctx().GetSessionVars().IsDynamicPartitionPruneEnabled() {
+			partitionName := ""
+			if pi != nil {
+				partitionName = "global"
+			}
+			if err := e.appendViolations(req, tbl, partitionName, h.GetTableStats(tblInfo)); err != nil {
+				return err
+			}
+		}
+		if pi == nil {
+			continue
+		}
+		for _, def := range pi.Definitions {
+			if err := e.appendViolations(req, tbl, def.Name.O, h.GetPartitionStats(tblInfo, def.ID)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *AdminCheckStatsTableExec) appendViolations(req *chunk.Chunk, tbl *resolve.TableNameW, partitionName string,
+	statsTbl *statistics.Table) error {
+	if statsTbl.Pseudo {
+		return nil
+	}
+	violations, err := statsTbl.CheckSanity(e.Ctx().GetSessionVars().StmtCtx.TimeZone())
+	if err != nil {
+		return err
+	}
+	for _, v := range violations {
+		req.AppendString(0, tbl.DBInfo.Name.O)
+		req.AppendString(1, tbl.TableInfo.Name.O)
+		req.AppendString(2, partitionName)
+		if v.IsIndex {
+			name := ""
+			if idxInfo := tbl.TableInfo.FindIndexByID(v.ID); idxInfo != nil {
+				name = idxInfo.Name.O
+			}
+			req.AppendString(3, name)
+			req.AppendInt64(4, 1)
+		} else {
+			name := ""
+			if colInfo := tbl.TableInfo.FindColumnByID(v.ID); colInfo != nil {
+				name = colInfo.Name.O
+			}
+			req.AppendString(3, name)
+			req.AppendInt64(4, 0)
+		}
+		req.AppendString(5, v.Kind)
+		req.AppendString(6, v.Detail)
+		if v.Stale {
+			req.AppendString(7, "re-analyze")
+		} else {
+			req.AppendString(7, "repair: drop the stats and re-analyze")
+		}
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
@@ -45,7 +46,9 @@ import (
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/intest"
 	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tidb/pkg/util/sqlescape"
+	"github.com/pingcap/tidb/pkg/util/sqlkiller"
 	"github.com/pingcap/tipb/go-tipb"
 	"github.com/tiancaiamao/gp"
 	"go.uber.org/zap"
@@ -76,6 +79,23 @@ var (
 	MaxRegionSampleSize = int64(1000)
 )
 
+// killTop1AnalyzeJob kills the analyze job using the most memory, and returns the bytes used by it. It's called by the
+// server memory controller when tidb_server_memory_limit is exceeded and the analyze jobs use more memory than the
+// top1 session.
+func killTop1AnalyzeJob() int64 {
+	var target *memory.Tracker
+	for _, t := range GlobalAnalyzeMemoryTracker.SearchTrackerConsumedMoreThanNBytes(0) {
+		if t.Killer != nil && (target == nil || t.BytesConsumed() > target.BytesConsumed()) {
+			target = t
+		}
+	}
+	if target == nil {
+		return 0
+	}
+	target.Killer.SendKillSignal(sqlkiller.ServerMemoryExceeded)
+	return target.BytesConsumed()
+}
+
 type taskType int
 
 const (
@@ -109,6 +129,10 @@ func (e *AnalyzeExec) Next(ctx context.Context, _ *chunk.Chunk) error {
 		}
 	}
 
+	// Collect the plans executed before the new stats are saved.
+	guard := newPlanChangeGuard(e.Ctx(), tasks)
+	revalidator := newBindingRevalidator(e.Ctx(), tasks, infoSchema)
+
 	// Get the min number of goroutines for parallel execution.
 	buildStatsConcurrency, err := getBuildStatsConcurrency(e.Ctx())
 	if err != nil {
@@ -195,7 +219,12 @@ TASKLOOP:
 	if err != nil {
 		sessionVars.StmtCtx.AppendWarning(err)
 	}
-	return statsHandle.Update(ctx, infoSchema, tableAndPartitionIDs...)
+	if err := statsHandle.Update(ctx, infoSchema, tableAndPartitionIDs...); err != nil {
+		return err
+	}
+	guard.check(e.Ctx())
+	revalidator.check()
+	return nil
 }
 
 func (e *AnalyzeExec) waitFinish(ctx context.Context, g *errgroup.Group, resultsCh chan *statistics.AnalyzeResults) error {
@@ -436,8 +465,12 @@ func (e *AnalyzeExec) handleResultsErrorWithConcurrency(
 	saveResultsCh := make(chan *statistics.AnalyzeResults, saveStatsConcurrency)
 	errCh := make(chan error, saveStatsConcurrency)
 	enableAnalyzeSnapshot := e.Ctx().GetSessionVars().EnableAnalyzeSnapshot
+	trigger := analyzeTriggerManual
+	if e.Ctx().GetSessionVars().InRestrictedSQL {
+		trigger = analyzeTriggerAuto
+	}
 	for i := 0; i < saveStatsConcurrency; i++ {
-		worker := newAnalyzeSaveStatsWorker(saveResultsCh, errCh, &e.Ctx().GetSessionVars().SQLKiller)
+		worker := newAnalyzeSaveStatsWorker(saveResultsCh, errCh, &e.Ctx().GetSessionVars().SQLKiller, trigger)
 		ctx1 := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
 		wg.Run(func() {
 			worker.run(ctx1, statsHandle, enableAnalyzeSnapshot)
@@ -516,19 +549,21 @@ func (e *AnalyzeExec) analyzeWorker(taskCh <-chan *analyzeTask, resultsCh chan<-
 		}
 		failpoint.Inject("handleAnalyzeWorkerPanic", nil)
 		statsHandle.StartAnalyzeJob(task.job)
+		analyzeStart := time.Now()
+		var results *statistics.AnalyzeResults
 		switch task.taskType {
 		case colTask:
-			select {
-			case <-e.errExitCh:
-				return
-			case resultsCh <- analyzeColumnsPushDownEntry(e.gp, task.colExec):
-			}
+			results = analyzeColumnsPushDownEntry(e.gp, task.colExec)
 		case idxTask:
-			select {
-			case <-e.errExitCh:
-				return
-			case resultsCh <- analyzeIndexPushdown(task.idxExec):
-			}
+			results = analyzeIndexPushdown(task.idxExec)
+		}
+		if task.job != nil && task.job.ScanDuration == 0 {
+			task.job.ScanDuration = time.Since(analyzeStart)
+		}
+		select {
+		case <-e.errExitCh:
+			return
+		case resultsCh <- results:
 		}
 	}
 }
@@ -0,0 +1,272 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/bindinfo"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/statistics"
+	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/hint"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"go.uber.org/zap"
+)
+
+const (
+	// revalidationRowCountRatio is the ratio of the row count change beyond which the stats change is significant.
+	revalidationRowCountRatio = 2.0
+	// revalidationNDVChangeRatio is the relative NDV change of a column or an index beyond which the stats change is
+	// significant.
+	revalidationNDVChangeRatio = 0.5
+	// suboptimalBindingCostRatio is the ratio of the estimated cost of the bound plan to the plan chosen without the
+	// binding beyond which the binding is flagged as suboptimal.
+	suboptimalBindingCostRatio = 2.0
+)
+
+// bindingRevalidator re-evaluates the global bindings on the analyzed tables whose row count or NDVs shift
+// significantly after analyze. For each affected binding, the bound plan is compared with the plan chosen without the
+// binding, and the binding is flagged as suboptimal in mysql.binding_revalidation if the bound plan becomes grossly
+// more expensive. A flagged binding is still in effect, and the flag is cleared once its plan is no longer suboptimal.
+type bindingRevalidator struct {
+	sctx   sessionctx.Context
+	tables []*revalidatedTable
+}
+
+// revalidatedTable is an analyzed table checked by the bindingRevalidator, with its key stats before analyze.
+type revalidatedTable struct {
+	dbName    string
+	tableName string
+	tblInfo   *model.TableInfo
+	rowCount  int64
+	colNDVs   map[int64]int64
+	idxNDVs   map[int64]int64
+}
+
+// newBindingRevalidator records the key stats of the tables of the tasks before the new stats are saved.
+// It returns nil if tidb_enable_binding_revalidation is off or no global binding is on the tables.
+func newBindingRevalidator(sctx sessionctx.Context, tasks []*analyzeTask, is infoschema.InfoSchema) *bindingRevalidator {
+	if !vardef.EnableBindingRevalidation.Load() {
+		return nil
+	}
+	bindings := domain.GetDomain(sctx).BindHandle().GetAllGlobalBindings()
+	statsHandle := domain.GetDomain(sctx).StatsHandle()
+	r := &bindingRevalidator{sctx: sctx}
+	visited := make(map[int64]struct{}, len(tasks))
+	for _, task := range tasks {
+		if task.job == nil {
+			continue
+		}
+		tableID := getTableIDFromTask(task).TableID
+		if _, ok := visited[tableID]; ok {
+			continue
+		}
+		visited[tableID] = struct{}{}
+		tblInfo, ok := is.TableInfoByID(tableID)
+		if !ok {
+			continue
+		}
+		table := &revalidatedTable{
+			dbName:    strings.ToLower(task.job.DBName),
+			tableName: tblInfo.Name.L,
+			tblInfo:   tblInfo,
+		}
+		if len(table.bindings(bindings)) == 0 {
+			continue
+		}
+		table.rowCount, table.colNDVs, table.idxNDVs = collectKeyStats(statsHandle.GetTableStats(tblInfo))
+		r.tables = append(r.tables, table)
+	}
+	if len(r.tables) == 0 {
+		return nil
+	}
+	return r
+}
+
+// collectKeyStats returns the row count and the NDVs of the analyzed columns and indexes of the table stats.
+func collectKeyStats(tbl *statistics.Table) (rowCount int64, colNDVs, idxNDVs map[int64]int64) {
+	colNDVs = make(map[int64]int64, tbl.ColNum())
+	idxNDVs = make(map[int64]int64, tbl.IdxNum())
+	if tbl.Pseudo {
+		return 0, colNDVs, idxNDVs
+	}
+	tbl.ForEachColumnImmutable(func(id int64, col *statistics.Column) bool {
+		if col.IsStatsInitialized() {
+			colNDVs[id] = col.Histogram.NDV
+		}
+		return false
+	})
+	tbl.ForEachIndexImmutable(func(id int64, idx *statistics.Index) bool {
+		if idx.IsAnalyzed() {
+			idxNDVs[id] = idx.Histogram.NDV
+		}
+		return false
+	})
+	return tbl.RealtimeCount, colNDVs, idxNDVs
+}
+
+// bindings returns the enabled global bindings referencing the table.
+func (t *revalidatedTable) bindings(bindings []*bindinfo.Binding) []*bindinfo.Binding {
+	var res []*bindinfo.Binding
+	for _, binding := range bindings {
+		if !binding.IsBindingEnabled() {
+			continue
+		}
+		for _, tableName := range binding.TableNames {
+			schema := tableName.Schema.L
+			if schema == "" {
+				schema = strings.ToLower(binding.Db)
+			}
+			if schema == t.dbName && tableName.Name.L == t.tableName {
+				res = append(res, binding)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// significantlyChanged returns whether the row count changes by more than revalidationRowCountRatio times, or the
+// NDV of any column or index changes by more than revalidationNDVChangeRatio in the new stats.
+func (t *revalidatedTable) significantlyChanged(tbl *statistics.Table) bool {
+	rowCount, colNDVs, idxNDVs := collectKeyStats(tbl)
+	minCount, maxCount := min(rowCount, t.rowCount), max(rowCount, t.rowCount)
+	if maxCount > minCount && float64(maxCount) >= float64(minCount)*revalidationRowCountRatio {
+		return true
+	}
+	ndvChanged := func(oldNDVs, newNDVs map[int64]int64) bool {
+		for id, oldNDV := range oldNDVs {
+			newNDV, ok := newNDVs[id]
+			if ok && oldNDV > 0 && math.Abs(float64(newNDV-oldNDV)) > float64(oldNDV)*revalidationNDVChangeRatio {
+				return true
+			}
+		}
+		return false
+	}
+	return ndvChanged(t.colNDVs, colNDVs) || ndvChanged(t.idxNDVs, idxNDVs)
+}
+
+// check compares the key stats after the new stats are loaded, and re-evaluates the bindings on the tables whose
+// stats change significantly in the background.
+func (r *bindingRevalidator) check() {
+	if r == nil {
+		return
+	}
+	dom := domain.GetDomain(r.sctx)
+	statsHandle := dom.StatsHandle()
+	bindings := make(map[string]*bindinfo.Binding)
+	for _, table := range r.tables {
+		if !table.significantlyChanged(statsHandle.GetTableStats(table.tblInfo)) {
+			continue
+		}
+		for _, binding := range table.bindings(dom.BindHandle().GetAllGlobalBindings()) {
+			bindings[binding.SQLDigest] = binding
+		}
+	}
+	if len(bindings) == 0 {
+		return
+	}
+	go util.WithRecovery(func() {
+		revalidateBindings(dom, bindings)
+	}, nil)
+}
+
+// revalidateBindings records whether the bound plan of each binding is grossly suboptimal in
+// mysql.binding_revalidation. The status of the bindings in mysql.bind_info is kept unchanged, so the bindings are
+// still in effect and the older versions are not confused by an unknown status.
+func revalidateBindings(dom *domain.Domain, bindings map[string]*bindinfo.Binding) {
+	err := handleutil.CallWithSCtx(dom.StatsHandle().SPool(), func(se sessionctx.Context) error {
+		for _, binding := range bindings {
+			boundCost, freeCost, err := explainBindingCosts(se, binding)
+			if err != nil {
+				logutil.BgLogger().Warn("failed to explain the binding for the revalidation",
+					zap.String("bindSQL", binding.BindSQL), zap.Error(err))
+				continue
+			}
+			suboptimal := boundCost > freeCost*suboptimalBindingCostRatio
+			_, _, err = handleutil.ExecRows(se, `INSERT INTO mysql.binding_revalidation
+				(sql_digest, suboptimal, bound_cost, free_cost, update_time) VALUES (%?, %?, %?, %?, NOW())
+				ON DUPLICATE KEY UPDATE suboptimal = VALUES(suboptimal), bound_cost = VALUES(bound_cost),
+				free_cost = VALUES(free_cost), update_time = VALUES(update_time)`,
+				binding.SQLDigest, suboptimal, boundCost, freeCost)
+			if err != nil {
+				logutil.BgLogger().Warn("failed to record the result of the binding revalidation",
+					zap.String("bindSQL", binding.BindSQL), zap.Error(err))
+				continue
+			}
+			logutil.BgLogger().Info("the binding is revalidated after the stats change",
+				zap.String("bindSQL", binding.BindSQL),
+				zap.Float64("boundCost", boundCost),
+				zap.Float64("freeCost", freeCost),
+				zap.Bool("suboptimal", suboptimal))
+		}
+		// Clean up the results of the dropped bindings.
+		_, _, err := handleutil.ExecRows(se, `DELETE FROM mysql.binding_revalidation WHERE sql_digest NOT IN
+			(SELECT sql_digest FROM mysql.bind_info WHERE status != %? AND sql_digest IS NOT NULL)`, bindinfo.StatusDeleted)
+		return err
+	})
+	if err != nil {
+		logutil.BgLogger().Warn("failed to revalidate the bindings", zap.Error(err))
+	}
+}
+
+// explainBindingCosts returns the estimated cost of the bound plan of the binding, and the estimated cost of the plan
+// chosen for the same statement without any hint.
+func explainBindingCosts(se sessionctx.Context, binding *bindinfo.Binding) (boundCost, freeCost float64, err error) {
+	sessionVars := se.GetSessionVars()
+	originDB, originUsePlanBaselines := sessionVars.CurrentDB, sessionVars.UsePlanBaselines
+	sessionVars.CurrentDB, sessionVars.UsePlanBaselines = binding.Db, false
+	defer func() {
+		sessionVars.CurrentDB, sessionVars.UsePlanBaselines = originDB, originUsePlanBaselines
+	}()
+	explainCost := func(withHints bool) (float64, error) {
+		node, err := parser.New().ParseOneStmt(binding.BindSQL, binding.Charset, binding.Collation)
+		if err != nil {
+			return 0, err
+		}
+		if !withHints {
+			node = hint.BindHint(node, &hint.HintsSet{})
+		}
+		explainStmt := &ast.ExplainStmt{Stmt: node, Format: types.ExplainFormatVerbose}
+		rows, _, err := se.GetRestrictedSQLExecutor().ExecRestrictedStmt(handleutil.StatsCtx, explainStmt, handleutil.UseCurrentSessionOpt...)
+		if err != nil {
+			return 0, err
+		}
+		if len(rows) == 0 {
+			return 0, errors.New("empty explain result")
+		}
+		// The columns are id, estRows, estCost, task, access object and operator info.
+		return strconv.ParseFloat(rows[0].GetString(2), 64)
+	}
+	if boundCost, err = explainCost(true); err != nil {
+		return 0, 0, err
+	}
+	if freeCost, err = explainCost(false); err != nil {
+		return 0, 0, err
+	}
+	return boundCost, freeCost, nil
+}
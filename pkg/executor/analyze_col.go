@@ -125,7 +125,7 @@ func (e *AnalyzeColumnsExec) buildResp(ranges []*ranger.Range) (distsql.SelectRe
 		SetConcurrency(e.concurrency).
 		SetMemTracker(e.memTracker).
 		SetResourceGroupName(e.ctx.GetSessionVars().StmtCtx.ResourceGroupName).
-		SetExplicitRequestSourceType(e.ctx.GetSessionVars().ExplicitRequestSourceType).
+		SetExplicitRequestSourceType(analyzeRequestSourceType(e.ctx)).
 		Build()
 	if err != nil {
 		return nil, err
@@ -283,7 +283,7 @@ func (e *AnalyzeColumnsExec) buildStats(ranges []*ranger.Range, needExtStats boo
 		fms = append(fms, collectors[i].FMSketch)
 	}
 	if needExtStats {
-		extStats, err = statistics.BuildExtendedStats(e.ctx, e.TableID.GetStatisticsID(), e.colsInfo, collectors)
+		extStats, err = statistics.BuildExtendedStats(e.ctx, e.TableID.GetStatisticsID(), e.colsInfo, collectors, nil)
 		if err != nil {
 			return nil, nil, nil, nil, nil, err
 		}
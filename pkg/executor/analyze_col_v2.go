@@ -64,6 +64,20 @@ func (e *AnalyzeColumnsExecV2) analyzeColumnsPushDownV2(gp *gp.Pool) *statistics
 	} else {
 		ranges = ranger.FullIntRange(false)
 	}
+	var incremental *incrementalAnalyze
+	if e.Incremental {
+		var err error
+		incremental, err = e.prepareIncrementalAnalyze()
+		if err != nil {
+			return &statistics.AnalyzeResults{Err: err, Job: e.job}
+		}
+		if !incremental.appended() {
+			return incremental.keepOldStats(e)
+		}
+		if incremental.hasRows {
+			ranges = incremental.ranges()
+		}
+	}
 
 	collExtStats := e.ctx.GetSessionVars().EnableExtendedStats
 	// specialIndexes holds indexes that include virtual or prefix columns. For these indexes,
@@ -107,7 +121,7 @@ func (e *AnalyzeColumnsExecV2) analyzeColumnsPushDownV2(gp *gp.Pool) *statistics
 	})
 	defer wg.Wait()
 
-	count, hists, topNs, fmSketches, extStats, err := e.buildSamplingStats(gp, ranges, collExtStats, specialIndexesOffsets, idxNDVPushDownCh, samplingStatsConcurrency)
+	count, hists, topNs, fmSketches, extStats, fkFanouts, err := e.buildSamplingStats(gp, ranges, collExtStats, specialIndexesOffsets, idxNDVPushDownCh, samplingStatsConcurrency)
 	if err != nil {
 		e.memTracker.Release(e.memTracker.BytesConsumed())
 		return &statistics.AnalyzeResults{Err: err, Job: e.job}
@@ -123,7 +137,6 @@ func (e *AnalyzeColumnsExecV2) analyzeColumnsPushDownV2(gp *gp.Pool) *statistics
 	// Because the process of analyzing will keep the order of results be the same as the colsInfo in the analyze task,
 	// and in `buildAnalyzeFullSamplingTask` we always place the _tidb_rowid at the last of colsInfo, so if there are
 	// stats for _tidb_rowid, it must be at the end of the column stats.
-	// Virtual column has no histogram yet. So we check nil here.
 	if hists[cLen-1] != nil && hists[cLen-1].ID == -1 {
 		cLen--
 	}
@@ -133,7 +146,7 @@ func (e *AnalyzeColumnsExecV2) analyzeColumnsPushDownV2(gp *gp.Pool) *statistics
 		Fms:   fmSketches[:cLen],
 	}
 
-	return &statistics.AnalyzeResults{
+	results := &statistics.AnalyzeResults{
 		TableID:       e.tableID,
 		Ars:           []*statistics.AnalyzeResult{colResult, colGroupResult},
 		Job:           e.job,
@@ -141,9 +154,17 @@ func (e *AnalyzeColumnsExecV2) analyzeColumnsPushDownV2(gp *gp.Pool) *statistics
 		Count:         count,
 		Snapshot:      e.snapshot,
 		ExtStats:      extStats,
+		FKFanouts:     fkFanouts,
 		BaseCount:     e.baseCount,
 		BaseModifyCnt: e.baseModifyCnt,
 	}
+	if incremental != nil {
+		if err := incremental.merge(e.ctx, results, e.opts); err != nil {
+			e.memTracker.Release(e.memTracker.BytesConsumed())
+			return &statistics.AnalyzeResults{Err: err, Job: e.job}
+		}
+	}
+	return results
 }
 
 // decodeSampleDataWithVirtualColumn constructs the virtual column by evaluating from the decoded normal columns.
@@ -216,11 +237,13 @@ func (e *AnalyzeColumnsExecV2) buildSamplingStats(
 	topns []*statistics.TopN,
 	fmSketches []*statistics.FMSketch,
 	extStats *statistics.ExtendedStatsColl,
+	fkFanouts []*statistics.FKFanout,
 	err error,
 ) {
+	scanStart := time.Now()
 	// Open memory tracker and resultHandler.
 	if err = e.open(ranges); err != nil {
-		return 0, nil, nil, nil, nil, err
+		return 0, nil, nil, nil, nil, nil, err
 	}
 	defer func() {
 		if err1 := e.resultHandler.Close(); err1 != nil {
@@ -297,15 +320,23 @@ func (e *AnalyzeColumnsExecV2) buildSamplingStats(
 		if err1 := mergeEg.Wait(); err1 != nil {
 			err = stderrors.Join(err, err1)
 		}
-		return 0, nil, nil, nil, nil, getAnalyzePanicErr(err)
+		return 0, nil, nil, nil, nil, nil, getAnalyzePanicErr(err)
 	}
 	err = mergeEg.Wait()
 	defer e.memTracker.Release(rootRowCollector.Base().MemSize)
 	if err != nil {
-		return 0, nil, nil, nil, nil, err
+		return 0, nil, nil, nil, nil, nil, err
+	}
+	buildStart := time.Now()
+	if e.job != nil {
+		e.job.ScanDuration = buildStart.Sub(scanStart)
+		defer func() {
+			e.job.BuildDuration = time.Since(buildStart)
+		}()
 	}
 
 	// Decode the data from sample collectors.
+	var virtualColNDVs map[int]int64
 	virtualColIdx := buildVirtualColumnIndex(e.schemaForVirtualColEval, e.colsInfo)
 	// Filling virtual columns is necessary here because these samples are used to build statistics for indexes that constructed by virtual columns.
 	if len(virtualColIdx) > 0 {
@@ -315,7 +346,16 @@ func (e *AnalyzeColumnsExecV2) buildSamplingStats(
 		}
 		err = e.decodeSampleDataWithVirtualColumn(rootRowCollector, fieldTps, virtualColIdx, e.schemaForVirtualColEval)
 		if err != nil {
-			return 0, nil, nil, nil, nil, err
+			return 0, nil, nil, nil, nil, nil, err
+		}
+		// The virtual columns are not stored in TiKV, so the null counts, the FM sketches and the total sizes
+		// collected for them are meaningless. Rebuild them from the evaluated samples to build their stats.
+		virtualColNDVs = make(map[int]int64, len(virtualColIdx))
+		for _, offset := range virtualColIdx {
+			virtualColNDVs[offset], err = rootRowCollector.Base().RebuildColumnBySamples(sc, offset)
+			if err != nil {
+				return 0, nil, nil, nil, nil, nil, err
+			}
 		}
 	} else {
 		// If there's no virtual column, normal decode way is enough.
@@ -323,7 +363,7 @@ func (e *AnalyzeColumnsExecV2) buildSamplingStats(
 			for i := range sample.Columns {
 				sample.Columns[i], err = tablecodec.DecodeColumnValue(sample.Columns[i].GetBytes(), &e.colsInfo[i].FieldType, sc.TimeZone())
 				if err != nil {
-					return 0, nil, nil, nil, nil, err
+					return 0, nil, nil, nil, nil, nil, err
 				}
 			}
 		}
@@ -333,7 +373,7 @@ func (e *AnalyzeColumnsExecV2) buildSamplingStats(
 	for _, sample := range rootRowCollector.Base().Samples {
 		sample.Handle, err = e.handleCols.BuildHandleByDatums(sample.Columns)
 		if err != nil {
-			return 0, nil, nil, nil, nil, err
+			return 0, nil, nil, nil, nil, nil, err
 		}
 	}
 	colLen := len(e.colsInfo)
@@ -371,6 +411,7 @@ func (e *AnalyzeColumnsExecV2) buildSamplingStats(
 			tp:               &col.FieldType,
 			isColumn:         true,
 			slicePos:         i,
+			virtualColNDV:    virtualColNDVs[i],
 		}
 		fmSketches = append(fmSketches, rootRowCollector.Base().FMSketches[i])
 	}
@@ -379,7 +420,7 @@ func (e *AnalyzeColumnsExecV2) buildSamplingStats(
 	if indexPushedDownResult.err != nil {
 		close(exitCh)
 		e.samplingBuilderWg.Wait()
-		return 0, nil, nil, nil, nil, indexPushedDownResult.err
+		return 0, nil, nil, nil, nil, nil, indexPushedDownResult.err
 	}
 	for _, offset := range indexesWithVirtualColOffsets {
 		ret := indexPushedDownResult.results[e.indexes[offset].ID]
@@ -424,16 +465,20 @@ func (e *AnalyzeColumnsExecV2) buildSamplingStats(
 		e.memTracker.Release(totalSampleCollectorSize)
 	}()
 	if err != nil {
-		return 0, nil, nil, nil, nil, err
+		return 0, nil, nil, nil, nil, nil, err
 	}
 
 	count = rootRowCollector.Base().Count
 	if needExtStats {
-		extStats, err = statistics.BuildExtendedStats(e.ctx, e.TableID.GetStatisticsID(), e.colsInfo, sampleCollectors)
+		extStats, err = statistics.BuildExtendedStats(e.ctx, e.TableID.GetStatisticsID(), e.colsInfo, sampleCollectors, rootRowCollector.Base().Samples)
 		if err != nil {
-			return 0, nil, nil, nil, nil, err
+			return 0, nil, nil, nil, nil, nil, err
 		}
 	}
+	fkFanouts, err = statistics.BuildFKFanouts(e.ctx.GetSessionVars().StmtCtx.TimeZone(), e.tableInfo, e.colsInfo, sampleCollectors, rootRowCollector.Base().Samples)
+	if err != nil {
+		return 0, nil, nil, nil, nil, nil, err
+	}
 
 	return
 }
@@ -700,11 +745,6 @@ workLoop:
 			}
 			var collector *statistics.SampleCollector
 			if task.isColumn {
-				if e.colsInfo[task.slicePos].IsGenerated() && !e.colsInfo[task.slicePos].GeneratedStored {
-					hists[task.slicePos] = nil
-					topns[task.slicePos] = nil
-					continue
-				}
 				sampleNum := task.rootRowCollector.Base().Samples.Len()
 				sampleItems := make([]*statistics.SampleItem, 0, sampleNum)
 				// consume mandatory memory at the beginning, including empty SampleItems of all sample rows, if exceeds, fast fail
@@ -821,6 +861,11 @@ workLoop:
 				releaseCollectorMemory()
 				continue
 			}
+			if task.isColumn && e.colsInfo[task.slicePos].IsVirtualGenerated() {
+				// The FM sketch of the virtual column only sees the sampled values, use the NDV estimated from
+				// the samples instead.
+				hist.NDV = max(hist.NDV, task.virtualColNDV)
+			}
 			finalMemSize := hist.MemoryUsage() + topn.MemoryUsage()
 			e.memTracker.Consume(finalMemSize)
 			hists[task.slicePos] = hist
@@ -849,6 +894,8 @@ type samplingBuildTask struct {
 	tp               *types.FieldType
 	isColumn         bool
 	slicePos         int
+	// virtualColNDV is the NDV estimated from the samples for the virtual column.
+	virtualColNDV int64
 }
 
 func readDataAndSendTask(ctx sessionctx.Context, handler *tableResultHandler, mergeTaskCh chan []byte, memTracker *memory.Tracker) error {
@@ -161,7 +161,7 @@ func (e *AnalyzeIndexExec) fetchAnalyzeResult(ranges []*ranger.Range, isNullRang
 		SetKeepOrder(true).
 		SetConcurrency(e.concurrency).
 		SetResourceGroupName(e.ctx.GetSessionVars().StmtCtx.ResourceGroupName).
-		SetExplicitRequestSourceType(e.ctx.GetSessionVars().ExplicitRequestSourceType).
+		SetExplicitRequestSourceType(analyzeRequestSourceType(e.ctx)).
 		Build()
 	if err != nil {
 		return err
@@ -0,0 +1,223 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"math"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	statsStorage "github.com/pingcap/tidb/pkg/statistics/handle/storage"
+	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/ranger"
+	"go.uber.org/zap"
+)
+
+// incrementalAnalyze is the state of ANALYZE INCREMENTAL TABLE on a physical table. The planner only accepts the tables
+// whose handles increase in the insert order, so the rows appended to an append-only table have larger handles than the
+// analyzed ones, and only the rows with larger handles than the last analyzed handle recorded in mysql.stats_meta are
+// sampled, and their stats are merged into the stats of the last analyze. The whole table is analyzed if it isn't
+// analyzed incrementally last time, or the old stats can't be merged.
+type incrementalAnalyze struct {
+	// maxHandle is the max handle at the analyze snapshot, which is recorded as the last analyzed handle. The rows with
+	// larger handles are left to the next analyze, even if they're read by the analyze without the snapshot.
+	maxHandle int64
+	// hasRows is false if the table is empty at the analyze snapshot, in which case no handle is recorded.
+	hasRows bool
+	// lastHandle is the last analyzed handle. Only the rows in (lastHandle, maxHandle] are sampled if oldStats isn't nil.
+	lastHandle int64
+	// oldStats is the stats of the last analyze, which is nil if the whole table is analyzed.
+	oldStats *statistics.Table
+}
+
+// prepareIncrementalAnalyze reads the max handle at the analyze snapshot, the last analyzed handle and the old stats of
+// the physical table.
+func (e *AnalyzeColumnsExecV2) prepareIncrementalAnalyze() (*incrementalAnalyze, error) {
+	physicalID := e.tableID.GetStatisticsID()
+	ia := &incrementalAnalyze{}
+	var err error
+	ia.maxHandle, ia.hasRows, err = maxHandleAtSnapshot(e.ctx.GetStore(), physicalID, e.snapshot)
+	if err != nil || !ia.hasRows {
+		return ia, err
+	}
+	statsHandle := domain.GetDomain(e.ctx).StatsHandle()
+	var analyzedIncrementally bool
+	err = handleutil.CallWithSCtx(statsHandle.SPool(), func(sctx sessionctx.Context) (err error) {
+		ia.lastHandle, analyzedIncrementally, err = statsStorage.LastAnalyzedHandle(sctx, physicalID)
+		return err
+	})
+	if err != nil || !analyzedIncrementally {
+		return ia, err
+	}
+	oldStats, err := statsHandle.TableStatsFromStorage(e.tableInfo, physicalID, true, 0)
+	if err != nil {
+		return nil, err
+	}
+	if reason := e.checkOldStatsForIncrementalAnalyze(oldStats); reason != "" {
+		logutil.BgLogger().Info("analyze the whole table instead of the appended rows",
+			zap.String("category", "stats"),
+			zap.Int64("physicalID", physicalID),
+			zap.String("reason", reason))
+		return ia, nil
+	}
+	ia.oldStats = oldStats
+	return ia, nil
+}
+
+// checkOldStatsForIncrementalAnalyze returns the reason why the stats of the appended rows can't be merged into the
+// old stats, or an empty string if they can.
+func (e *AnalyzeColumnsExecV2) checkOldStatsForIncrementalAnalyze(oldStats *statistics.Table) string {
+	if oldStats == nil || oldStats.Pseudo {
+		return "no stats"
+	}
+	for _, colInfo := range e.colsInfo {
+		if colInfo.ID == model.ExtraHandleID {
+			continue
+		}
+		col := oldStats.GetCol(colInfo.ID)
+		if col == nil || col.GetStatsVer() != statistics.Version2 {
+			return "column " + colInfo.Name.O + " has no version 2 stats"
+		}
+	}
+	for _, idxInfo := range e.indexes {
+		idx := oldStats.GetIdx(idxInfo.ID)
+		if idx == nil || idx.GetStatsVer() != statistics.Version2 {
+			return "index " + idxInfo.Name.O + " has no version 2 stats"
+		}
+	}
+	return ""
+}
+
+// appended returns whether any row is appended since the last analyze.
+func (ia *incrementalAnalyze) appended() bool {
+	return ia.oldStats == nil || ia.maxHandle > ia.lastHandle
+}
+
+// ranges returns the handle ranges of the rows to sample.
+func (ia *incrementalAnalyze) ranges() []*ranger.Range {
+	ran := &ranger.Range{
+		LowVal:    []types.Datum{types.NewIntDatum(math.MinInt64)},
+		HighVal:   []types.Datum{types.NewIntDatum(ia.maxHandle)},
+		Collators: collate.GetBinaryCollatorSlice(1),
+	}
+	if ia.oldStats != nil {
+		ran.LowVal[0].SetInt64(ia.lastHandle)
+		ran.LowExclude = true
+	}
+	return []*ranger.Range{ran}
+}
+
+// merge merges the stats of the appended rows in the results into the old stats, and records the max handle in the
+// results. The results are kept if the whole table is analyzed.
+func (ia *incrementalAnalyze) merge(sctx sessionctx.Context, results *statistics.AnalyzeResults, opts map[ast.AnalyzeOptionType]uint64) error {
+	if ia.hasRows {
+		results.LastAnalyzedHandle = &ia.maxHandle
+	}
+	if ia.oldStats == nil {
+		return nil
+	}
+	sc := sctx.GetSessionVars().StmtCtx
+	oldCount := int64(-1)
+	for _, result := range results.Ars {
+		for i, hg := range result.Hist {
+			if hg == nil {
+				continue
+			}
+			var (
+				oldHist  *statistics.Histogram
+				oldTopN  *statistics.TopN
+				oldFMS   *statistics.FMSketch
+				oldTotal float64
+			)
+			if result.IsIndex == 1 {
+				idx := ia.oldStats.GetIdx(hg.ID)
+				if idx == nil {
+					return errors.Errorf("the old stats of index %d are not found", hg.ID)
+				}
+				oldHist, oldTopN, oldFMS, oldTotal = &idx.Histogram, idx.TopN, idx.FMSketch, idx.TotalRowCount()
+			} else {
+				col := ia.oldStats.GetCol(hg.ID)
+				if col == nil {
+					return errors.Errorf("the old stats of column %d are not found", hg.ID)
+				}
+				oldHist, oldTopN, oldFMS, oldTotal = &col.Histogram, col.TopN, col.FMSketch, col.TotalRowCount()
+			}
+			if oldCount < 0 {
+				oldCount = int64(oldTotal)
+			}
+			hist, topN, fms, err := statistics.MergeIncrementalStats(sc, oldHist, hg, oldTopN, result.TopNs[i], oldFMS, result.Fms[i],
+				opts[ast.AnalyzeOptNumBuckets], opts[ast.AnalyzeOptNumTopN], result.IsIndex == 1)
+			if err != nil {
+				return err
+			}
+			result.Hist[i], result.TopNs[i], result.Fms[i] = hist, topN, fms
+		}
+	}
+	if oldCount < 0 {
+		oldCount = ia.oldStats.RealtimeCount
+	}
+	results.Count += oldCount
+	return nil
+}
+
+// keepOldStats returns the results which keep the old stats when no row is appended since the last analyze, only the
+// snapshot in mysql.stats_meta is updated.
+func (ia *incrementalAnalyze) keepOldStats(e *AnalyzeColumnsExecV2) *statistics.AnalyzeResults {
+	count := int64(ia.oldStats.GetAnalyzeRowCount())
+	if count < 0 {
+		count = ia.oldStats.RealtimeCount
+	}
+	return &statistics.AnalyzeResults{
+		TableID:            e.tableID,
+		Job:                e.job,
+		StatsVer:           e.StatsVersion,
+		Count:              count,
+		Snapshot:           e.snapshot,
+		BaseCount:          e.baseCount,
+		BaseModifyCnt:      e.baseModifyCnt,
+		LastAnalyzedHandle: &ia.lastHandle,
+	}
+}
+
+// maxHandleAtSnapshot returns the max int handle of the physical table at the snapshot. ok is false if the table is
+// empty.
+func maxHandleAtSnapshot(store kv.Storage, physicalID int64, snapshotTS uint64) (handle int64, ok bool, err error) {
+	snap := store.GetSnapshot(kv.NewVersion(snapshotTS))
+	snap.SetOption(kv.RequestSourceInternal, true)
+	snap.SetOption(kv.RequestSourceType, kv.InternalTxnStats)
+	prefix := tablecodec.GenTableRecordPrefix(physicalID)
+	it, err := snap.IterReverse(prefix.PrefixNext(), prefix)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	defer it.Close()
+	if !it.Valid() || !it.Key().HasPrefix(prefix) {
+		return 0, false, nil
+	}
+	h, err := tablecodec.DecodeRowKey(it.Key())
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	return h.IntValue(), true, nil
+}
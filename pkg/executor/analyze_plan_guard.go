@@ -0,0 +1,279 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/bindinfo"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/metrics"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	utilparser "github.com/pingcap/tidb/pkg/util/parser"
+	"go.uber.org/zap"
+)
+
+// planChangeGuard checks whether analyze flips the plans of the most frequently executed statements touching the
+// analyzed tables into slower ones. The estimated costs can't tell it, since the new plan is always the cheapest one
+// under the new stats and the costs under the different stats are not comparable. So the actual runtime of the plans
+// in the statement summary is compared instead. The plans executed before analyze are collected from the statement
+// summary, then the statement summary is watched in the background after analyze. Once a new plan of a statement has
+// been executed for planChangeGuardMinExecCount times, its average latency is compared with the one of the most
+// executed old plan. If it is higher beyond tidb_plan_change_guard_latency_ratio, the old plan is captured as a global
+// binding and an alert is raised, so analyze never silently flips it.
+type planChangeGuard struct {
+	stmts map[string]*guardedStmt
+}
+
+// guardedStmt is a statement checked by the planChangeGuard, with its plans executed before analyze.
+type guardedStmt struct {
+	schema    string
+	query     string
+	charset   string
+	collation string
+	// oldPlans are the digests of the plans executed before analyze.
+	oldPlans map[string]struct{}
+	// baseline is the most executed plan before analyze, which is compared with the new plan.
+	baseline guardedPlan
+}
+
+// guardedPlan is the execution statistics of a plan in the statement summary.
+type guardedPlan struct {
+	digest     string
+	hints      string
+	execCount  uint64
+	avgLatency uint64
+}
+
+const (
+	// planChangeGuardCheckInterval is the interval to check the statement summary after analyze.
+	planChangeGuardCheckInterval = time.Minute
+	// planChangeGuardCheckRounds is the number of the checks after analyze, the statements whose new plans are not
+	// executed enough in time are not checked anymore.
+	planChangeGuardCheckRounds = 30
+	// planChangeGuardMinExecCount is the number of the executions of the new plan before it's compared.
+	planChangeGuardMinExecCount = 5
+)
+
+// newPlanChangeGuard collects the plans of the top statements touching the tables of the tasks from the statement
+// summary. It returns nil if tidb_enable_plan_change_guard is off or nothing needs to be checked.
+func newPlanChangeGuard(sctx sessionctx.Context, tasks []*analyzeTask) *planChangeGuard {
+	if !vardef.EnablePlanChangeGuard.Load() {
+		return nil
+	}
+	tableNames := make(map[string]struct{}, len(tasks))
+	for _, task := range tasks {
+		if task.job != nil {
+			tableNames[strings.ToLower(task.job.DBName+"."+task.job.TableName)] = struct{}{}
+		}
+	}
+	g := &planChangeGuard{stmts: make(map[string]*guardedStmt)}
+	statsHandle := domain.GetDomain(sctx).StatsHandle()
+	err := handleutil.CallWithSCtx(statsHandle.SPool(), func(se sessionctx.Context) error {
+		return g.fetchStmts(se, tableNames)
+	})
+	if err != nil {
+		logutil.BgLogger().Warn("failed to collect the plans for the plan change guard", zap.Error(err))
+		return nil
+	}
+	if len(g.stmts) == 0 {
+		return nil
+	}
+	return g
+}
+
+// fetchStmts collects the plans of the most frequently executed SELECT statements of each table in the statement
+// summary.
+func (g *planChangeGuard) fetchStmts(se sessionctx.Context, tableNames map[string]struct{}) error {
+	topN := int(vardef.PlanChangeGuardTopN.Load())
+	for tableName := range tableNames {
+		rows, _, err := handleutil.ExecRows(se, `select digest, plan_digest, schema_name, query_sample_text, charset,
+			collation, plan_hint, exec_count, avg_latency
+			from information_schema.statements_summary
+			where stmt_type = 'Select' and prepared = 0 and sample_user != '' and find_in_set(%?, table_names) > 0
+			order by exec_count desc`, tableName)
+		if err != nil {
+			return err
+		}
+		digests := make(map[string]struct{}, topN)
+		for _, row := range rows {
+			digest := row.GetString(0)
+			plan := guardedPlan{
+				digest:     row.GetString(1),
+				hints:      row.GetString(6),
+				execCount:  row.GetUint64(7),
+				avgLatency: row.GetUint64(8),
+			}
+			stmt, ok := g.stmts[digest]
+			if !ok {
+				if len(digests) >= topN {
+					continue
+				}
+				// The rows are ordered by the execution count, so the first plan is the most executed one.
+				stmt = &guardedStmt{
+					schema:    row.GetString(2),
+					query:     row.GetString(3),
+					charset:   row.GetString(4),
+					collation: row.GetString(5),
+					oldPlans:  make(map[string]struct{}),
+					baseline:  plan,
+				}
+				g.stmts[digest] = stmt
+			}
+			digests[digest] = struct{}{}
+			stmt.oldPlans[plan.digest] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// check starts to watch the new plans of the statements in the background after the new stats are loaded.
+func (g *planChangeGuard) check(sctx sessionctx.Context) {
+	if g == nil {
+		return
+	}
+	dom := domain.GetDomain(sctx)
+	dom.RunInBackground(func(ctx context.Context) {
+		g.watch(kv.WithInternalSourceType(ctx, kv.InternalTxnStats), dom)
+	}, "planChangeGuard")
+}
+
+// watch checks the new plans of the statements every planChangeGuardCheckInterval until all of them are checked, or
+// planChangeGuardCheckRounds are reached.
+func (g *planChangeGuard) watch(ctx context.Context, dom *domain.Domain) {
+	interval := planChangeGuardCheckInterval
+	failpoint.Inject("mockPlanChangeGuardCheckInterval", func(val failpoint.Value) {
+		interval = time.Duration(val.(int)) * time.Millisecond
+	})
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range planChangeGuardCheckRounds {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		err := handleutil.CallWithSCtxCtx(ctx, dom.StatsHandle().SPool(), func(se sessionctx.Context) error {
+			return g.checkNewPlans(ctx, se, dom)
+		})
+		if err != nil {
+			logutil.BgLogger().Warn("failed to check the plans for the plan change guard", zap.Error(err))
+			return
+		}
+		if len(g.stmts) == 0 {
+			return
+		}
+	}
+}
+
+// checkNewPlans compares the new plans executed enough with the old plans, and captures the old plans of the regressed
+// statements. The checked statements are removed from the guard.
+func (g *planChangeGuard) checkNewPlans(ctx context.Context, se sessionctx.Context, dom *domain.Domain) error {
+	digests := make([]string, 0, len(g.stmts))
+	for digest := range g.stmts {
+		digests = append(digests, digest)
+	}
+	rows, _, err := handleutil.ExecRowsWithCtx(ctx, se, `select digest, plan_digest, plan_hint, exec_count, avg_latency
+		from information_schema.statements_summary
+		where digest in (%?) and sample_user != '' order by exec_count desc`, digests)
+	if err != nil {
+		return err
+	}
+	ratio := vardef.PlanChangeGuardLatencyRatio.Load()
+	for _, row := range rows {
+		stmt, ok := g.stmts[row.GetString(0)]
+		if !ok {
+			continue
+		}
+		plan := guardedPlan{
+			digest:     row.GetString(1),
+			hints:      row.GetString(2),
+			execCount:  row.GetUint64(3),
+			avgLatency: row.GetUint64(4),
+		}
+		if _, ok := stmt.oldPlans[plan.digest]; ok || plan.execCount < planChangeGuardMinExecCount {
+			continue
+		}
+		delete(g.stmts, row.GetString(0))
+		metrics.PlanChangeGuardCounter.WithLabelValues("changed").Inc()
+		if float64(plan.avgLatency) <= float64(stmt.baseline.avgLatency)*ratio {
+			continue
+		}
+		metrics.PlanChangeGuardCounter.WithLabelValues("regressed").Inc()
+		captured, err := captureGuardedStmtBaseline(se, dom, stmt)
+		if err != nil {
+			logutil.BgLogger().Warn("failed to capture the baseline of the regressed statement",
+				zap.String("sql", stmt.query), zap.Error(err))
+		}
+		if captured {
+			metrics.PlanChangeGuardCounter.WithLabelValues("captured").Inc()
+		}
+		logutil.BgLogger().Warn("the plan of the statement regresses after analyze",
+			zap.String("sql", stmt.query),
+			zap.String("oldPlanHints", stmt.baseline.hints),
+			zap.String("newPlanHints", plan.hints),
+			zap.Duration("oldPlanAvgLatency", time.Duration(stmt.baseline.avgLatency)),
+			zap.Duration("newPlanAvgLatency", time.Duration(plan.avgLatency)),
+			zap.Bool("baselineCaptured", captured))
+	}
+	return nil
+}
+
+// captureGuardedStmtBaseline creates a global binding with the old plan of the statement. The existing bindings of
+// the statement are never overridden.
+func captureGuardedStmtBaseline(se sessionctx.Context, dom *domain.Domain, stmt *guardedStmt) (bool, error) {
+	if stmt.baseline.hints == "" {
+		return false, nil
+	}
+	p := parser.New()
+	originNode, err := p.ParseOneStmt(stmt.query, stmt.charset, stmt.collation)
+	if err != nil {
+		return false, err
+	}
+	normdOrigSQL, sqlDigest := bindinfo.NormalizeStmtForBinding(originNode, stmt.schema, false)
+	bindHandle := dom.BindHandle()
+	for _, binding := range bindHandle.GetAllGlobalBindings() {
+		if binding.SQLDigest == sqlDigest && binding.IsBindingEnabled() {
+			return false, nil
+		}
+	}
+	bindSQL := bindinfo.GenerateBindingSQL(originNode, stmt.baseline.hints, stmt.schema)
+	hintNode, err := p.ParseOneStmt(bindSQL, stmt.charset, stmt.collation)
+	if err != nil {
+		return false, err
+	}
+	binding := &bindinfo.Binding{
+		OriginalSQL: normdOrigSQL,
+		Db:          utilparser.GetDefaultDB(originNode, stmt.schema),
+		BindSQL:     bindinfo.RestoreDBForBinding(hintNode, stmt.schema),
+		Status:      bindinfo.StatusEnabled,
+		Source:      bindinfo.SourcePlanGuard,
+		Charset:     stmt.charset,
+		Collation:   stmt.collation,
+		SQLDigest:   sqlDigest,
+	}
+	if err := bindHandle.CreateGlobalBinding(se, []*bindinfo.Binding{binding}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessiontxn"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/handle"
+	statsStorage "github.com/pingcap/tidb/pkg/statistics/handle/storage"
+	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+var _ exec.Executor = &AnalyzeQuickExec{}
+
+// AnalyzeQuickExec represents the executor of ANALYZE TABLE ... QUICK. It derives the row count, the average row size
+// and the rough index histograms from the approximate size and keys of the regions reported by TiKV without scanning
+// the data. The stats of the tables never analyzed are marked as fully modified, so they're analyzed by auto analyze
+// later, while the full stats of the analyzed tables are kept and only their row counts are refreshed.
+type AnalyzeQuickExec struct {
+	exec.BaseExecutor
+	tasks []core.AnalyzeQuickTask
+}
+
+// Next implements the Executor Next interface.
+func (e *AnalyzeQuickExec) Next(ctx context.Context, _ *chunk.Chunk) error {
+	statsHandle := domain.GetDomain(e.Ctx()).StatsHandle()
+	infoSchema := sessiontxn.GetTxnManager(e.Ctx()).GetTxnInfoSchema()
+
+	tableAndPartitionIDs := make([]int64, 0, len(e.tasks))
+	for _, task := range e.tasks {
+		tableAndPartitionIDs = append(tableAndPartitionIDs, task.TableID.TableID)
+		if task.TableID.IsPartitionTable() {
+			tableAndPartitionIDs = append(tableAndPartitionIDs, task.TableID.PartitionID)
+		}
+	}
+	lockedIDs, err := statsHandle.GetLockedTables(tableAndPartitionIDs...)
+	if err != nil {
+		return err
+	}
+	var (
+		skippedTables       []string
+		needAnalyzeTableCnt uint
+	)
+	for _, task := range e.tasks {
+		_, isLocked := lockedIDs[task.TableID.TableID]
+		if !isLocked && task.TableID.IsPartitionTable() {
+			_, isLocked = lockedIDs[task.TableID.PartitionID]
+		}
+		if isLocked {
+			name := fmt.Sprintf("%s.%s", task.DBName, task.TableName)
+			if task.PartitionName != "" {
+				name = fmt.Sprintf("%s partition (%s)", name, task.PartitionName)
+			}
+			skippedTables = append(skippedTables, name)
+			continue
+		}
+		needAnalyzeTableCnt++
+		if err := analyzeQuick(statsHandle, task); err != nil {
+			return err
+		}
+	}
+	warnLockedTableMsg(e.Ctx().GetSessionVars(), needAnalyzeTableCnt, skippedTables)
+	if needAnalyzeTableCnt == 0 {
+		return nil
+	}
+	return statsHandle.Update(ctx, infoSchema, tableAndPartitionIDs...)
+}
+
+// analyzeQuick builds the quick stats of the physical table of the task and saves them to storage.
+func analyzeQuick(statsHandle *handle.Handle, task core.AnalyzeQuickTask) error {
+	physicalID := task.TableID.GetStatisticsID()
+	var (
+		records []statistics.RegionProperties
+		indexes map[int64][]statistics.RegionProperties
+	)
+	err := handleutil.CallWithSCtx(statsHandle.SPool(), func(sctx sessionctx.Context) (err error) {
+		records, indexes, err = statsStorage.RegionPropertiesOfTable(sctx, task.TblInfo, physicalID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	qs := statistics.BuildQuickStats(task.TblInfo, physicalID, records, indexes)
+	return statsHandle.SaveQuickStatsToStorage(physicalID, qs, handleutil.StatsMetaHistorySourceQuickAnalyze)
+}
@@ -28,10 +28,20 @@ import (
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/tiancaiamao/gp"
+	tikvutil "github.com/tikv/client-go/v2/util"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
+// analyzeRequestSourceType returns the explicit source type of the analyze requests, which are accounted under the
+// background quota of the stats tasks unless the session specifies the source type.
+func analyzeRequestSourceType(sctx sessionctx.Context) string {
+	if tp := sctx.GetSessionVars().ExplicitRequestSourceType; tp != tikvutil.ExplicitTypeEmpty {
+		return tp
+	}
+	return tikvutil.ExplicitTypeStats
+}
+
 func adaptiveAnlayzeDistSQLConcurrency(ctx context.Context, sctx sessionctx.Context) int {
 	concurrency := sctx.GetSessionVars().AnalyzeDistSQLScanConcurrency()
 	if concurrency > 0 {
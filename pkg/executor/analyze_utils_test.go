@@ -27,3 +27,12 @@ func TestGetAnalyzePanicErr(t *testing.T) {
 	errMsg := fmt.Sprintf("%s", getAnalyzePanicErr(exeerrors.ErrMemoryExceedForQuery.GenWithStackByArgs(123)))
 	require.NotContains(t, errMsg, `%!(EXTRA`)
 }
+
+func TestAnalyzeTableSizeBucket(t *testing.T) {
+	require.Equal(t, "<100K", analyzeTableSizeBucket(0))
+	require.Equal(t, "<100K", analyzeTableSizeBucket(99_999))
+	require.Equal(t, "100K-1M", analyzeTableSizeBucket(100_000))
+	require.Equal(t, "1M-10M", analyzeTableSizeBucket(5_000_000))
+	require.Equal(t, "10M-100M", analyzeTableSizeBucket(10_000_000))
+	require.Equal(t, ">=100M", analyzeTableSizeBucket(1_000_000_000))
+}
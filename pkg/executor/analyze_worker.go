@@ -16,7 +16,9 @@ package executor
 
 import (
 	"context"
+	"time"
 
+	"github.com/pingcap/tidb/pkg/metrics"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/handle"
 	"github.com/pingcap/tidb/pkg/statistics/handle/util"
@@ -25,20 +27,63 @@ import (
 	"go.uber.org/zap"
 )
 
+// The triggers of the analyze in the metrics.
+const (
+	analyzeTriggerManual = "manual"
+	analyzeTriggerAuto   = "auto"
+)
+
+// analyzeTableSizeBucket returns the table size bucket of the analyzed table or partition in the metrics.
+func analyzeTableSizeBucket(rowCount int64) string {
+	switch {
+	case rowCount < 100_000:
+		return "<100K"
+	case rowCount < 1_000_000:
+		return "100K-1M"
+	case rowCount < 10_000_000:
+		return "1M-10M"
+	case rowCount < 100_000_000:
+		return "10M-100M"
+	default:
+		return ">=100M"
+	}
+}
+
+// observeAnalyzeMetrics reports the duration, the scanned rows and the time of each phase of the finished job.
+func observeAnalyzeMetrics(results *statistics.AnalyzeResults, trigger string, saveDuration time.Duration) {
+	job := results.Job
+	if job == nil {
+		return
+	}
+	tableSize := analyzeTableSizeBucket(results.Count)
+	if !job.StartTime.IsZero() {
+		metrics.AnalyzeDurationHistogram.WithLabelValues(trigger, tableSize).Observe(time.Since(job.StartTime).Seconds())
+	}
+	metrics.AnalyzeScannedRowsHistogram.WithLabelValues(trigger, tableSize).Observe(float64(results.Count))
+	metrics.AnalyzePhaseDurationHistogram.WithLabelValues("scan", trigger, tableSize).Observe(job.ScanDuration.Seconds())
+	if job.BuildDuration > 0 {
+		metrics.AnalyzePhaseDurationHistogram.WithLabelValues("build", trigger, tableSize).Observe(job.BuildDuration.Seconds())
+	}
+	metrics.AnalyzePhaseDurationHistogram.WithLabelValues("save", trigger, tableSize).Observe(saveDuration.Seconds())
+}
+
 type analyzeSaveStatsWorker struct {
 	resultsCh <-chan *statistics.AnalyzeResults
 	errCh     chan<- error
 	killer    *sqlkiller.SQLKiller
+	trigger   string
 }
 
 func newAnalyzeSaveStatsWorker(
 	resultsCh <-chan *statistics.AnalyzeResults,
 	errCh chan<- error,
-	killer *sqlkiller.SQLKiller) *analyzeSaveStatsWorker {
+	killer *sqlkiller.SQLKiller,
+	trigger string) *analyzeSaveStatsWorker {
 	worker := &analyzeSaveStatsWorker{
 		resultsCh: resultsCh,
 		errCh:     errCh,
 		killer:    killer,
+		trigger:   trigger,
 	}
 	return worker
 }
@@ -57,12 +102,14 @@ func (worker *analyzeSaveStatsWorker) run(ctx context.Context, statsHandle *hand
 			worker.errCh <- err
 			return
 		}
+		saveStart := time.Now()
 		err := statsHandle.SaveTableStatsToStorage(results, analyzeSnapshot, util.StatsMetaHistorySourceAnalyze)
 		if err != nil {
 			logutil.Logger(ctx).Error("save table stats to storage failed", zap.Error(err))
 			finishJobWithLog(statsHandle, results.Job, err)
 			worker.errCh <- err
 		} else {
+			observeAnalyzeMetrics(results, worker.trigger, time.Since(saveStart))
 			finishJobWithLog(statsHandle, results.Job, nil)
 		}
 		results.DestroyAndPutToPool()
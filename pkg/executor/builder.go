@@ -175,6 +175,8 @@ func (b *executorBuilder) build(p base.Plan) exec.Executor {
 		return b.buildCheckIndexRange(v)
 	case *plannercore.ChecksumTable:
 		return b.buildChecksumTable(v)
+	case *plannercore.AdminCheckStatsTable:
+		return b.buildAdminCheckStatsTable(v)
 	case *plannercore.ReloadExprPushdownBlacklist:
 		return b.buildReloadExprPushdownBlacklist(v)
 	case *plannercore.ReloadOptRuleBlacklist:
@@ -726,6 +728,13 @@ func (b *executorBuilder) buildCheckIndexRange(v *plannercore.CheckIndexRange) e
 	return e
 }
 
+func (b *executorBuilder) buildAdminCheckStatsTable(v *plannercore.AdminCheckStatsTable) exec.Executor {
+	return &AdminCheckStatsTableExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		tables:       v.Tables,
+	}
+}
+
 func (b *executorBuilder) buildChecksumTable(v *plannercore.ChecksumTable) exec.Executor {
 	e := &ChecksumTableExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
@@ -898,8 +907,11 @@ func (b *executorBuilder) buildShow(v *plannercore.PhysicalShow) exec.Executor {
 		IfNotExists:           v.IfNotExists,
 		GlobalScope:           v.GlobalScope,
 		Extended:              v.Extended,
+		WithStats:             v.WithStats,
+		RawStats:              v.RawStats,
 		Extractor:             v.Extractor,
 		ImportJobID:           v.ImportJobID,
+		StatsFilter:           v.StatsFilter,
 	}
 	if e.Tp == ast.ShowMasterStatus || e.Tp == ast.ShowBinlogStatus {
 		// show master status need start ts.
@@ -1090,6 +1102,9 @@ func (b *executorBuilder) buildLockStats(v *plannercore.LockStats) exec.Executor
 	e := &lockstats.LockExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, nil, v.ID()),
 		Tables:       v.Tables,
+		ColumnNames:  v.ColumnNames,
+		ExpireOption: v.ExpireOption,
+		Comment:      v.Comment,
 	}
 	return e
 }
@@ -1098,6 +1113,8 @@ func (b *executorBuilder) buildUnlockStats(v *plannercore.UnlockStats) exec.Exec
 	e := &lockstats.UnlockExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, nil, v.ID()),
 		Tables:       v.Tables,
+		ColumnNames:  v.ColumnNames,
+		Comment:      v.Comment,
 	}
 	return e
 }
@@ -2334,6 +2351,8 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Ex
 			strings.ToLower(infoschema.TableMemoryUsageOpsHistory),
 			strings.ToLower(infoschema.ClusterTableMemoryUsage),
 			strings.ToLower(infoschema.ClusterTableMemoryUsageOpsHistory),
+			strings.ToLower(infoschema.TableMemoryUsageConsumers),
+			strings.ToLower(infoschema.ClusterTableMemoryUsageConsumers),
 			strings.ToLower(infoschema.TableResourceGroups),
 			strings.ToLower(infoschema.TableRunawayWatches),
 			strings.ToLower(infoschema.TableCheckConstraints),
@@ -2342,6 +2361,16 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Ex
 			strings.ToLower(infoschema.TableTiDBIndexUsage),
 			strings.ToLower(infoschema.TableTiDBPlanCache),
 			strings.ToLower(infoschema.ClusterTableTiDBPlanCache),
+			strings.ToLower(infoschema.TableStatsHealthSummary),
+			strings.ToLower(infoschema.TableStatsCacheUsage),
+			strings.ToLower(infoschema.TableAnalyzeFailureSummary),
+			strings.ToLower(infoschema.TableSessionEstimationErrors),
+			strings.ToLower(infoschema.TableStatsDrift),
+			strings.ToLower(infoschema.TableStatsDeltaPending),
+			strings.ToLower(infoschema.TableEstimationErrorRanking),
+			strings.ToLower(infoschema.TableUnusedIndexAdvice),
+			strings.ToLower(infoschema.ClusterTableUnusedIndexAdvice),
+			strings.ToLower(infoschema.TableMissingIndexCandidates),
 			strings.ToLower(infoschema.ClusterTableTiDBIndexUsage):
 			memTracker := memory.NewTracker(v.ID(), -1)
 			memTracker.AttachTo(b.ctx.GetSessionVars().StmtCtx.MemTracker)
@@ -3144,6 +3173,12 @@ func (b *executorBuilder) buildAnalyzeColumnsPushdown(
 }
 
 func (b *executorBuilder) buildAnalyze(v *plannercore.Analyze) exec.Executor {
+	if len(v.QuickTasks) > 0 {
+		return &AnalyzeQuickExec{
+			BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+			tasks:        v.QuickTasks,
+		}
+	}
 	gp := domain.GetDomain(b.ctx).StatsHandle().GPool()
 	e := &AnalyzeExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
@@ -3158,6 +3193,10 @@ func (b *executorBuilder) buildAnalyze(v *plannercore.Analyze) exec.Executor {
 	if b.ctx.GetSessionVars().InRestrictedSQL {
 		autoAnalyze = "auto "
 	}
+	b.lockCachedTablesForAnalyze(v)
+	if b.err != nil {
+		return nil
+	}
 	exprCtx := b.ctx.GetExprCtx()
 	for _, task := range v.ColTasks {
 		// ColumnInfos2ColumnsAndNames will use the `colInfos` to find the unique id for the column,
@@ -3197,6 +3236,43 @@ func (b *executorBuilder) buildAnalyze(v *plannercore.Analyze) exec.Executor {
 	return e
 }
 
+// lockCachedTablesForAnalyze goes through the read path of the table cache for the cached tables to be analyzed.
+// The read lease covering the analyze snapshot makes the writes to the cached table wait for the lease to expire,
+// instead of leaving locks which conflict with the reads of analyze.
+func (b *executorBuilder) lockCachedTablesForAnalyze(v *plannercore.Analyze) {
+	tblInfos := make(map[int64]*model.TableInfo)
+	for _, task := range v.ColTasks {
+		if task.TblInfo.TableCacheStatusType == model.TableCacheStatusEnable {
+			tblInfos[task.TblInfo.ID] = task.TblInfo
+		}
+	}
+	for _, task := range v.IdxTasks {
+		if task.TblInfo.TableCacheStatusType == model.TableCacheStatusEnable {
+			tblInfos[task.TblInfo.ID] = task.TblInfo
+		}
+	}
+	if len(tblInfos) == 0 {
+		return
+	}
+	startTS, err := b.getSnapshotTS()
+	if err != nil {
+		b.err = err
+		return
+	}
+	leaseDuration := time.Duration(vardef.TableCacheLease.Load()) * time.Second
+	for _, tblInfo := range tblInfos {
+		tbl, ok := b.is.TableByID(context.Background(), tblInfo.ID)
+		if !ok {
+			b.err = errors.Trace(infoschema.ErrTableNotExists.GenWithStackByArgs(b.ctx.GetSessionVars().CurrentDB, tblInfo.Name))
+			return
+		}
+		// The lease already covers the snapshot if the cache can be read.
+		if cacheData, loading := tbl.(table.CachedTable).TryReadFromCache(startTS, leaseDuration); cacheData == nil && !loading {
+			tbl.(table.CachedTable).UpdateLockForRead(context.Background(), b.ctx.GetStore(), startTS, leaseDuration)
+		}
+	}
+}
+
 // retrieveColumnIdxsUsedByChild retrieve column indices map from child physical plan schema columns.
 //
 //	E.g. columnIdxsUsedByChild = [2, 3, 1] means child[col2, col3, col1] -> parent[col0, col1, col2].
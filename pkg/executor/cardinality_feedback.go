@@ -0,0 +1,113 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"github.com/pingcap/tidb/pkg/domain"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+)
+
+// recordCardinalityFeedback reports the estimated and the actual row counts of the table readers of the statement
+// to the stats handle, which are used to correct the estimation of the later executions of the same statement.
+// Only the readers that are executed exactly once and run to the end are reported, see collectFeedbackReaders.
+func (a *ExecStmt) recordCardinalityFeedback(succ bool) {
+	if !succ || !vardef.EnableCardinalityFeedback.Load() {
+		return
+	}
+	sessVars := a.Ctx.GetSessionVars()
+	sc := sessVars.StmtCtx
+	// The estimation of the cached plan is not recorded in CardinalityFeedbackEstRows.
+	if sessVars.InRestrictedSQL || sc.UseCache() || sc.RuntimeStatsColl == nil || len(sc.CardinalityFeedbackEstRows) == 0 {
+		return
+	}
+	pp, ok := a.Plan.(base.PhysicalPlan)
+	if !ok {
+		return
+	}
+	_, digest := sc.SQLDigest()
+	statsHandle := domain.GetDomain(a.Ctx).StatsHandle()
+	if digest == nil || statsHandle == nil {
+		return
+	}
+	readers := make(map[int64][]base.PhysicalPlan)
+	collectFeedbackReaders(pp, readers)
+	for tableID, plans := range readers {
+		estRows, ok := sc.CardinalityFeedbackEstRows[tableID]
+		if !ok || estRows < 0 || len(plans) != 1 || !sc.RuntimeStatsColl.ExistsRootStats(plans[0].ID()) {
+			continue
+		}
+		actRows := sc.RuntimeStatsColl.GetPlanActRows(plans[0].ID())
+		statsHandle.RecordCardinalityFeedback(digest.String(), tableID, estRows, float64(actRows))
+	}
+}
+
+// collectFeedbackReaders collects the table readers whose actual row counts are comparable with the estimated
+// row counts of their data sources, grouped by the logical table ID.
+// The estimation covers all the conditions of the data source, so if some of them can't be pushed down, the root
+// Selection evaluating them is collected instead of the reader under it.
+// The readers under a Limit/TopN may stop early, and the inner side of an index join or an apply is executed
+// many times, so they are skipped.
+func collectFeedbackReaders(p base.PhysicalPlan, readers map[int64][]base.PhysicalPlan) {
+	switch x := p.(type) {
+	case *plannercore.PhysicalTableReader, *plannercore.PhysicalIndexReader, *plannercore.PhysicalIndexLookUpReader:
+		if tableID, ok := feedbackReaderTableID(x); ok {
+			readers[tableID] = append(readers[tableID], x)
+		}
+		return
+	case *plannercore.PhysicalSelection:
+		if tableID, ok := feedbackReaderTableID(x.Children()[0]); ok {
+			readers[tableID] = append(readers[tableID], x)
+			return
+		}
+	case *plannercore.PhysicalLimit, *plannercore.PhysicalTopN:
+		return
+	case *plannercore.PhysicalIndexJoin:
+		collectFeedbackReaders(x.Children()[1-x.InnerChildIdx], readers)
+		return
+	case *plannercore.PhysicalIndexHashJoin:
+		collectFeedbackReaders(x.Children()[1-x.InnerChildIdx], readers)
+		return
+	case *plannercore.PhysicalIndexMergeJoin:
+		collectFeedbackReaders(x.Children()[1-x.InnerChildIdx], readers)
+		return
+	case *plannercore.PhysicalApply:
+		collectFeedbackReaders(x.Children()[0], readers)
+		return
+	}
+	for _, child := range p.Children() {
+		collectFeedbackReaders(child, readers)
+	}
+}
+
+// feedbackReaderTableID returns the logical table ID of the data source read by the reader.
+func feedbackReaderTableID(p base.PhysicalPlan) (int64, bool) {
+	switch x := p.(type) {
+	case *plannercore.PhysicalTableReader:
+		if ts, ok := x.TablePlans[0].(*plannercore.PhysicalTableScan); ok {
+			return ts.Table.ID, true
+		}
+	case *plannercore.PhysicalIndexReader:
+		if is, ok := x.IndexPlans[0].(*plannercore.PhysicalIndexScan); ok {
+			return is.Table.ID, true
+		}
+	case *plannercore.PhysicalIndexLookUpReader:
+		if is, ok := x.IndexPlans[0].(*plannercore.PhysicalIndexScan); ok {
+			return is.Table.ID, true
+		}
+	}
+	return 0, false
+}
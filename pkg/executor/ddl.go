@@ -288,6 +288,9 @@ func (e *DDLExec) executeCreateTable(s *ast.CreateTableStmt) error {
 }
 
 func (e *DDLExec) createSessionTemporaryTable(s *ast.CreateTableStmt) error {
+	if s.WithStatistics {
+		return dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("with statistics")
+	}
 	is := e.Ctx().GetInfoSchema().(infoschema.InfoSchema)
 	dbInfo, ok := is.SchemaByName(s.Table.Schema)
 	if !ok {
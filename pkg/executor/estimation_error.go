@@ -0,0 +1,101 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"math/rand"
+
+	"github.com/pingcap/tidb/pkg/domain"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+)
+
+// recordEstimationErrors records the estimated and the actual row counts of the operators accessing tables of the
+// sampled statements, which are dumped to mysql.stats_estimation_errors by the stats handle for the offline analysis.
+// When tidb_record_estimation_errors is on, the row counts of every statement are also recorded into the session, see
+// information_schema.session_estimation_errors.
+// The operators of the same readers as the cardinality feedback are recorded, see collectFeedbackReaders.
+func (a *ExecStmt) recordEstimationErrors(succ bool) {
+	sessVars := a.Ctx.GetSessionVars()
+	rate := vardef.EstimationErrorSampleRate.Load()
+	sampled := rate > 0 && (rate >= 1 || rand.Float64() < rate)
+	if !succ || (!sampled && !sessVars.RecordEstimationErrors) {
+		return
+	}
+	sc := sessVars.StmtCtx
+	if sessVars.InRestrictedSQL || sc.RuntimeStatsColl == nil {
+		return
+	}
+	pp, ok := a.Plan.(base.PhysicalPlan)
+	if !ok {
+		return
+	}
+	var sqlDigest string
+	statsHandle := domain.GetDomain(a.Ctx).StatsHandle()
+	if _, digest := sc.SQLDigest(); digest != nil {
+		sqlDigest = digest.String()
+	}
+	sampled = sampled && sqlDigest != "" && statsHandle != nil
+	if !sampled && !sessVars.RecordEstimationErrors {
+		return
+	}
+	readers := make(map[int64][]base.PhysicalPlan)
+	collectFeedbackReaders(pp, readers)
+	for tableID, plans := range readers {
+		for _, reader := range plans {
+			operators := []base.PhysicalPlan{reader}
+			switch x := reader.(type) {
+			case *plannercore.PhysicalTableReader:
+				operators = append(operators, x.TablePlans...)
+			case *plannercore.PhysicalIndexReader:
+				operators = append(operators, x.IndexPlans...)
+			case *plannercore.PhysicalIndexLookUpReader:
+				operators = append(operators, x.IndexPlans...)
+				operators = append(operators, x.TablePlans...)
+			}
+			for _, op := range operators {
+				var actRows int64
+				if sc.RuntimeStatsColl.ExistsRootStats(op.ID()) {
+					actRows = sc.RuntimeStatsColl.GetPlanActRows(op.ID())
+				} else if copStats := sc.RuntimeStatsColl.GetCopStats(op.ID()); copStats != nil {
+					actRows = copStats.GetActRows()
+				} else {
+					continue
+				}
+				operator := estimationErrorOperator(op)
+				if sampled {
+					key := statstypes.EstimationErrorKey{SQLDigest: sqlDigest, Operator: operator, TableID: tableID}
+					statsHandle.RecordEstimationError(key, op.StatsCount(), float64(actRows))
+				}
+				if sessVars.RecordEstimationErrors {
+					key := variable.SessionEstimationErrorKey{TableID: tableID, Operator: operator}
+					sessVars.EstimationErrors.Record(key, op.StatsCount(), float64(actRows))
+				}
+			}
+		}
+	}
+}
+
+// estimationErrorOperator returns the operator type recorded with the estimation error. The index scans are
+// distinguished by the index name.
+func estimationErrorOperator(p base.PhysicalPlan) string {
+	if is, ok := p.(*plannercore.PhysicalIndexScan); ok {
+		return p.TP() + "(" + is.Index.Name.O + ")"
+	}
+	return p.TP()
+}
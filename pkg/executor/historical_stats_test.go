@@ -186,7 +186,16 @@ func TestGCHistoryStatsAfterDropTable(t *testing.T) {
 	is = dom.InfoSchema()
 	h.GCStats(is, 0)
 
-	// assert stats_history tables delete the record of dropped table
+	// assert stats_history tables keep the record of dropped table for recovering it
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_meta_history where table_id = '%d' order by create_time",
+		tableInfo.Meta().ID)).Check(testkit.Rows("1"))
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_history where table_id = '%d'",
+		tableInfo.Meta().ID)).Check(testkit.Rows("1"))
+
+	// assert stats_history tables delete the record of dropped table after it is outdated
+	tk.MustExec("set @@global.tidb_historical_stats_duration = '1s'")
+	time.Sleep(2 * time.Second)
+	require.NoError(t, h.ClearOutdatedHistoryStats())
 	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_meta_history where table_id = '%d' order by create_time",
 		tableInfo.Meta().ID)).Check(testkit.Rows("0"))
 	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_history where table_id = '%d'",
@@ -240,6 +249,44 @@ func TestAssertHistoricalStatsAfterAlterTable(t *testing.T) {
 	require.Equal(t, jsTable.Version, originVersion)
 }
 
+func TestDumpHistoricalStatsAfterRenameTable(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("set global tidb_enable_historical_stats = 1")
+	tk.MustExec("create database test2")
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index idx(b))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("analyze table test.t")
+	is := dom.InfoSchema()
+	tableInfo, err := is.TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	// dump historical stats
+	h := dom.StatsHandle()
+	require.NoError(t, dom.GetHistoricalStatsWorker().DumpHistoricalStats(tableInfo.Meta().ID, h))
+
+	// rename the table to another database, the historical stats are kept with the same table ID
+	tk.MustExec("rename table test.t to test2.t2")
+	is = dom.InfoSchema()
+	newTableInfo, err := is.TableByName(context.Background(), ast.NewCIStr("test2"), ast.NewCIStr("t2"))
+	require.NoError(t, err)
+	require.Equal(t, tableInfo.Meta().ID, newTableInfo.Meta().ID)
+	time.Sleep(1 * time.Second)
+	snapshot := oracle.GoTimeToTS(time.Now())
+	jsTable, fallbackTbls, err := h.DumpHistoricalStatsBySnapshot("test2", newTableInfo.Meta(), snapshot)
+	require.NoError(t, err)
+	require.Empty(t, fallbackTbls)
+	require.NotNil(t, jsTable)
+	require.True(t, jsTable.IsHistoricalStats)
+	require.Equal(t, "test2", jsTable.DatabaseName)
+	require.Equal(t, "t2", jsTable.TableName)
+	require.Equal(t, int64(3), jsTable.Count)
+
+	// the historical stats can be loaded to the renamed table
+	require.NoError(t, h.LoadStatsFromJSON(context.Background(), is, jsTable, 0))
+	tk.MustQuery("show stats_meta where db_name = 'test2' and table_name = 't2'").CheckAt([]int{5}, testkit.Rows("3"))
+}
+
 func TestGCOutdatedHistoryStats(t *testing.T) {
 	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/domain/sendHistoricalStats", "return(true)"))
 	defer func() {
@@ -25,6 +25,7 @@ import (
 )
 
 // ProcessChunk processes a chunk, and write kv pairs to dataEngine and indexEngine.
+// the rows are sampled into rowSampler if it's not nil.
 func ProcessChunk(
 	ctx context.Context,
 	chunk *checkpoints.ChunkCheckpoint,
@@ -32,6 +33,7 @@ func ProcessChunk(
 	dataEngine, indexEngine *backend.OpenedEngine,
 	logger *zap.Logger,
 	groupChecksum *verification.KVGroupChecksum,
+	rowSampler *RowSampler,
 ) error {
 	// if the key are ordered, LocalWrite can optimize the writing.
 	// table has auto-incremented _tidb_rowid must satisfy following restrictions:
@@ -64,10 +66,11 @@ func ProcessChunk(
 		}
 	}()
 
-	return ProcessChunkWithWriter(ctx, chunk, tableImporter, dataWriter, indexWriter, logger, groupChecksum)
+	return ProcessChunkWithWriter(ctx, chunk, tableImporter, dataWriter, indexWriter, logger, groupChecksum, rowSampler)
 }
 
 // ProcessChunkWithWriter processes a chunk, and write kv pairs to dataWriter and indexWriter.
+// the rows are sampled into rowSampler if it's not nil.
 func ProcessChunkWithWriter(
 	ctx context.Context,
 	chunk *checkpoints.ChunkCheckpoint,
@@ -75,8 +78,9 @@ func ProcessChunkWithWriter(
 	dataWriter, indexWriter backend.EngineWriter,
 	logger *zap.Logger,
 	groupChecksum *verification.KVGroupChecksum,
+	rowSampler *RowSampler,
 ) error {
-	encoder, err := tableImporter.getKVEncoder(chunk)
+	encoder, err := tableImporter.getKVEncoder(chunk, rowSampler)
 	if err != nil {
 		return err
 	}
@@ -1408,6 +1408,8 @@ func getDataSourceType(p *plannercore.ImportInto) DataSourceType {
 type JobImportResult struct {
 	Affected uint64
 	Warnings []contextutil.SQLWarn
+	// RowSampler holds the rows sampled during import to build the stats, it's nil if the rows are not sampled.
+	RowSampler *RowSampler
 }
 
 // GetMsgFromBRError get msg from BR error.
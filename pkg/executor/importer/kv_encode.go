@@ -46,6 +46,8 @@ type tableKVEncoder struct {
 	columnsAndUserVars []*ast.ColumnNameOrUserVar
 	fieldMappings      []*FieldMapping
 	insertColumns      []*table.Column
+	// sampler samples the encoded rows to build the stats, it's nil if the stats are not built during import.
+	sampler *chunkRowSampler
 }
 
 var _ KVEncoder = &tableKVEncoder{}
@@ -56,6 +58,18 @@ func NewTableKVEncoder(
 	config *encode.EncodingConfig,
 	ti *TableImporter,
 ) (KVEncoder, error) {
+	en, err := newTableKVEncoder(config, ti, nil)
+	if err != nil {
+		return nil, err
+	}
+	return en, nil
+}
+
+func newTableKVEncoder(
+	config *encode.EncodingConfig,
+	ti *TableImporter,
+	rowSampler *RowSampler,
+) (*tableKVEncoder, error) {
 	baseKVEncoder, err := kv.NewBaseKVEncoder(config)
 	if err != nil {
 		return nil, err
@@ -65,13 +79,17 @@ func NewTableKVEncoder(
 		return nil, err
 	}
 
-	return &tableKVEncoder{
+	en := &tableKVEncoder{
 		BaseKVEncoder:      baseKVEncoder,
 		columnAssignments:  colAssignExprs,
 		columnsAndUserVars: ti.ColumnsAndUserVars,
 		fieldMappings:      ti.FieldMappings,
 		insertColumns:      ti.InsertColumns,
-	}, nil
+	}
+	if rowSampler != nil {
+		en.sampler = rowSampler.newChunkSampler(baseKVEncoder.SessionCtx.GetExprCtx().GetEvalCtx().Location())
+	}
+	return en, nil
 }
 
 // Encode implements the KVEncoder interface.
@@ -85,6 +103,11 @@ func (en *tableKVEncoder) Encode(row []types.Datum, rowID int64) (*kv.Pairs, err
 	if err != nil {
 		return nil, err
 	}
+	if en.sampler != nil {
+		if err = en.sampler.collect(record); err != nil {
+			return nil, err
+		}
+	}
 
 	return en.Record2KV(record, row, rowID)
 }
@@ -205,6 +228,9 @@ func (en *tableKVEncoder) fillRow(row []types.Datum, hasValue []bool, rowID int6
 }
 
 func (en *tableKVEncoder) Close() error {
+	if en.sampler != nil {
+		en.sampler.finish()
+	}
 	en.SessionCtx.Close()
 	return nil
 }
@@ -0,0 +1,395 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/domain"
+	tidbkv "github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	statsutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tidb/pkg/util/memory"
+	"github.com/pingcap/tidb/pkg/util/ranger"
+	"github.com/pingcap/tipb/go-tipb"
+)
+
+const (
+	// the max number of rows sampled during import, the same as the default of ANALYZE.
+	statsSampleSize = 10000
+	statsNumBuckets = 256
+	statsNumTopN    = 100
+)
+
+// RowSampler samples the rows of the table while they are encoded. The samples are used to build the stats of the
+// table when the import finishes, so the freshly imported table gets the histograms immediately, instead of a full
+// ANALYZE which reads the data just imported again.
+// Each chunk samples its rows by its own collector, which is merged into the RowSampler when the chunk finishes,
+// it's concurrent safe.
+type RowSampler struct {
+	tableInfo *model.TableInfo
+	columns   []*model.ColumnInfo
+	indexes   []*model.IndexInfo
+
+	mu        sync.Mutex
+	collector *statistics.ReservoirRowSampleCollector
+}
+
+// NewRowSampler creates a RowSampler for the table. It returns nil when the stats of the table can't be built from
+// the samples, i.e. the partitioned table whose stats are kept by partition.
+func NewRowSampler(tableInfo *model.TableInfo) *RowSampler {
+	if tableInfo.Partition != nil {
+		return nil
+	}
+	indexes := make([]*model.IndexInfo, 0, len(tableInfo.Indices))
+	for _, idx := range tableInfo.Indices {
+		// the stats of the multi-valued index are built by an independent task of ANALYZE, we leave them to it.
+		if idx.State == model.StatePublic && !idx.MVIndex {
+			indexes = append(indexes, idx)
+		}
+	}
+	columns := tableInfo.Cols()
+	return &RowSampler{
+		tableInfo: tableInfo,
+		columns:   columns,
+		indexes:   indexes,
+		collector: newRowSampleCollector(len(columns) + len(indexes)),
+	}
+}
+
+func newRowSampleCollector(totalLen int) *statistics.ReservoirRowSampleCollector {
+	collector := statistics.NewReservoirRowSampleCollector(statsSampleSize, totalLen)
+	for range totalLen {
+		collector.Base().FMSketches = append(collector.Base().FMSketches, statistics.NewFMSketch(statistics.MaxSketchSize))
+	}
+	return collector
+}
+
+// Count returns the number of the rows sampled.
+func (s *RowSampler) Count() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.collector.Base().Count
+}
+
+// Merge merges the samples of another RowSampler of the same table.
+func (s *RowSampler) Merge(other *RowSampler) {
+	other.mu.Lock()
+	defer other.mu.Unlock()
+	s.mergeCollector(other.collector)
+}
+
+func (s *RowSampler) mergeCollector(collector *statistics.ReservoirRowSampleCollector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collector.MergeCollector(collector)
+}
+
+// Marshal encodes the samples, so they can be passed between the nodes.
+func (s *RowSampler) Marshal() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.collector.Base().ToProto().Marshal()
+}
+
+// MergeMarshaled merges the samples encoded by Marshal.
+func (s *RowSampler) MergeMarshaled(data []byte) error {
+	pbCollector := &tipb.RowSampleCollector{}
+	if err := pbCollector.Unmarshal(data); err != nil {
+		return errors.Trace(err)
+	}
+	if len(pbCollector.FmSketch) != len(s.columns)+len(s.indexes) {
+		return errors.Errorf("the samples of %d columns and indexes don't match the table with %d columns and %d indexes",
+			len(pbCollector.FmSketch), len(s.columns), len(s.indexes))
+	}
+	collector := statistics.NewReservoirRowSampleCollector(statsSampleSize, 0)
+	collector.Base().FromProto(pbCollector, memory.NewTracker(memory.LabelForAnalyzeMemory, -1))
+	s.mergeCollector(collector)
+	return nil
+}
+
+// newChunkSampler creates a sampler for the rows of a chunk, the timezone is the one the rows are encoded with.
+func (s *RowSampler) newChunkSampler(tz *time.Location) *chunkRowSampler {
+	collators := make([]collate.Collator, len(s.columns))
+	for i, col := range s.columns {
+		collators[i] = sampleCollator(&col.FieldType)
+	}
+	return &chunkRowSampler{
+		parent:    s,
+		sc:        stmtctx.NewStmtCtxWithTimeZone(tz),
+		rng:       rand.New(rand.NewSource(time.Now().UnixNano())), // #nosec G404
+		collators: collators,
+		ndvValues: make([]types.Datum, len(s.columns)),
+		collector: newRowSampleCollector(len(s.columns) + len(s.indexes)),
+	}
+}
+
+// sampleCollator returns the collator whose key replaces the string when building the stats, since only the collate
+// key keeps the order of the new collation. It returns nil for the other types.
+func sampleCollator(ft *types.FieldType) collate.Collator {
+	if ft.EvalType() == types.ETString && ft.GetType() != mysql.TypeEnum && ft.GetType() != mysql.TypeSet {
+		return collate.GetCollator(ft.GetCollate())
+	}
+	return nil
+}
+
+// chunkRowSampler samples the rows of a chunk, it's not concurrent safe.
+type chunkRowSampler struct {
+	parent    *RowSampler
+	sc        *stmtctx.StatementContext
+	rng       *rand.Rand
+	collators []collate.Collator
+	// ndvValues are the values of the current row inserted into the FM sketches.
+	ndvValues []types.Datum
+	collector *statistics.ReservoirRowSampleCollector
+}
+
+// collect collects a row whose datums are in the order of the table columns. Like the samples collected by TiKV,
+// the columns of the sampled row are kept encoded.
+func (c *chunkRowSampler) collect(row []types.Datum) error {
+	base := c.collector.Base()
+	base.Count++
+	sample := make([]types.Datum, len(c.parent.columns))
+	for i := range c.parent.columns {
+		encoded, err := codec.EncodeValue(c.sc.TimeZone(), nil, row[i])
+		if err = c.sc.HandleError(err); err != nil {
+			return err
+		}
+		sample[i].SetBytes(encoded)
+		if row[i].IsNull() {
+			c.ndvValues[i].SetNull()
+			base.NullCount[i]++
+			continue
+		}
+		// Minus one is to remove the flag byte.
+		base.TotalSizes[i] += int64(len(encoded)) - 1
+		if c.ndvValues[i], err = c.ndvValue(i, row[i], encoded); err != nil {
+			return err
+		}
+		if err = base.FMSketches[i].InsertValue(c.sc, c.ndvValues[i]); err != nil {
+			return err
+		}
+	}
+	colLen := len(c.parent.columns)
+	values := make([]types.Datum, 0, len(c.parent.columns))
+	for i, idx := range c.parent.indexes {
+		if len(idx.Columns) == 1 && row[idx.Columns[0].Offset].IsNull() {
+			base.NullCount[colLen+i]++
+			continue
+		}
+		values = values[:0]
+		for _, idxCol := range idx.Columns {
+			d := c.ndvValues[idxCol.Offset]
+			if !d.IsNull() {
+				base.TotalSizes[colLen+i] += int64(len(sample[idxCol.Offset].GetBytes())) - 1
+			}
+			if idxCol.Length != types.UnspecifiedLength && !d.IsNull() {
+				var err error
+				row[idxCol.Offset].Copy(&d)
+				ranger.CutDatumByPrefixLen(&d, idxCol.Length, &c.parent.columns[idxCol.Offset].FieldType)
+				if d, err = c.ndvValue(idxCol.Offset, d, nil); err != nil {
+					return err
+				}
+			}
+			values = append(values, d)
+		}
+		if err := base.FMSketches[colLen+i].InsertRowValue(c.sc, values); err != nil {
+			return err
+		}
+	}
+	c.collector.SampleRow(sample, c.rng)
+	return nil
+}
+
+// ndvValue returns the value of the column inserted into the FM sketch. The string is replaced by its collate key,
+// so the strings equal in the collation are counted once.
+func (c *chunkRowSampler) ndvValue(offset int, d types.Datum, encoded []byte) (types.Datum, error) {
+	var err error
+	if collator := c.collators[offset]; collator != nil {
+		encoded, err = codec.EncodeValue(c.sc.TimeZone(), nil, types.NewBytesDatum(collator.Key(d.GetString())))
+	} else if encoded == nil {
+		encoded, err = codec.EncodeValue(c.sc.TimeZone(), nil, d)
+	}
+	if err = c.sc.HandleError(err); err != nil {
+		return types.Datum{}, err
+	}
+	return types.NewBytesDatum(encoded), nil
+}
+
+// finish merges the samples of the chunk into the RowSampler.
+func (c *chunkRowSampler) finish() {
+	c.parent.mergeCollector(c.collector)
+}
+
+// buildStats builds the stats of the table from the samples in the same way as ANALYZE of the stats version 2.
+func (s *RowSampler) buildStats(sctx sessionctx.Context, snapshot uint64) (*statistics.AnalyzeResults, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	base := s.collector.Base()
+	rows, err := s.decodeSamples(sctx)
+	if err != nil {
+		return nil, err
+	}
+	colLen := len(s.columns)
+	totalLen := colLen + len(s.indexes)
+	hists := make([]*statistics.Histogram, 0, totalLen)
+	topNs := make([]*statistics.TopN, 0, totalLen)
+	for i, col := range s.columns {
+		collator := sampleCollator(&col.FieldType)
+		items := make([]*statistics.SampleItem, 0, len(rows))
+		for j, row := range rows {
+			val := row[i]
+			// If this value is very big, we think that it is not a value that can occur many times. So we don't record it.
+			if val.IsNull() || len(val.GetBytes()) > statistics.MaxSampleValueLength {
+				continue
+			}
+			if collator != nil {
+				val.SetBytes(collator.Key(val.GetString()))
+			}
+			items = append(items, &statistics.SampleItem{Value: val, Ordinal: j})
+		}
+		hist, topN, err := s.buildHistAndTopN(sctx, col.ID, i, items, &col.FieldType, true)
+		if err != nil {
+			return nil, err
+		}
+		hists, topNs = append(hists, hist), append(topNs, topN)
+	}
+	tz := sctx.GetSessionVars().StmtCtx.TimeZone()
+	errCtx := sctx.GetSessionVars().StmtCtx.ErrCtx()
+	for i, idx := range s.indexes {
+		items := make([]*statistics.SampleItem, 0, len(rows))
+	rowLoop:
+		for _, row := range rows {
+			if len(idx.Columns) == 1 && row[idx.Columns[0].Offset].IsNull() {
+				continue
+			}
+			var key []byte
+			for _, idxCol := range idx.Columns {
+				d := row[idxCol.Offset]
+				// If the index value contains one value which is too long, we think that it's a value that doesn't occur many times.
+				if len(d.GetBytes()) > statistics.MaxSampleValueLength {
+					continue rowLoop
+				}
+				if idxCol.Length != types.UnspecifiedLength {
+					row[idxCol.Offset].Copy(&d)
+					ranger.CutDatumByPrefixLen(&d, idxCol.Length, &s.columns[idxCol.Offset].FieldType)
+				}
+				key, err = codec.EncodeKey(tz, key, d)
+				if err = errCtx.HandleError(err); err != nil {
+					return nil, err
+				}
+			}
+			items = append(items, &statistics.SampleItem{Value: types.NewBytesDatum(key)})
+		}
+		hist, topN, err := s.buildHistAndTopN(sctx, idx.ID, colLen+i, items, types.NewFieldType(mysql.TypeBlob), false)
+		if err != nil {
+			return nil, err
+		}
+		hists, topNs = append(hists, hist), append(topNs, topN)
+	}
+	return &statistics.AnalyzeResults{
+		TableID: statistics.AnalyzeTableID{TableID: s.tableInfo.ID, PartitionID: statistics.NonPartitionTableID},
+		Ars: []*statistics.AnalyzeResult{
+			{Hist: hists[:colLen], TopNs: topNs[:colLen], Fms: base.FMSketches[:colLen]},
+			{Hist: hists[colLen:], TopNs: topNs[colLen:], Fms: base.FMSketches[colLen:], IsIndex: 1},
+		},
+		Count:    base.Count,
+		StatsVer: statistics.Version2,
+		Snapshot: snapshot,
+	}, nil
+}
+
+// decodeSamples decodes the columns of the sampled rows.
+func (s *RowSampler) decodeSamples(sctx sessionctx.Context) ([][]types.Datum, error) {
+	samples := s.collector.Base().Samples
+	fts := make([]*types.FieldType, 0, len(s.columns))
+	for _, col := range s.columns {
+		fts = append(fts, &col.FieldType)
+	}
+	chk := chunk.NewChunkWithCapacity(fts, len(samples))
+	decoder := codec.NewDecoder(chk, sctx.GetSessionVars().Location())
+	for _, sample := range samples {
+		for i, col := range sample.Columns {
+			if _, err := decoder.DecodeOne(col.GetBytes(), i, fts[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	rows := make([][]types.Datum, 0, chk.NumRows())
+	for i := range chk.NumRows() {
+		rows = append(rows, chk.GetRow(i).GetDatumRow(fts))
+	}
+	return rows, nil
+}
+
+func (s *RowSampler) buildHistAndTopN(
+	sctx sessionctx.Context,
+	id int64,
+	offset int,
+	items []*statistics.SampleItem,
+	tp *types.FieldType,
+	isColumn bool,
+) (*statistics.Histogram, *statistics.TopN, error) {
+	base := s.collector.Base()
+	collector := &statistics.SampleCollector{
+		Samples:   items,
+		NullCount: base.NullCount[offset],
+		Count:     base.Count - base.NullCount[offset],
+		FMSketch:  base.FMSketches[offset],
+		TotalSize: base.TotalSizes[offset],
+	}
+	return statistics.BuildHistAndTopN(sctx, statsNumBuckets, statsNumTopN, id, collector, tp, isColumn, nil, false)
+}
+
+// saveTableStats builds the stats of the table from the samples and saves them through the stats handle. It returns
+// false if the stats are not saved, e.g. the stats of the table are locked, then the caller should fall back to
+// update the modify count and leave the table to the auto analyze.
+func (s *RowSampler) saveTableStats(se sessionctx.Context) (bool, error) {
+	if se.GetSessionVars().AnalyzeVersion != statistics.Version2 || s.Count() == 0 {
+		return false, nil
+	}
+	dom := domain.GetDomain(se)
+	if dom == nil || dom.StatsHandle() == nil {
+		return false, nil
+	}
+	statsHandle := dom.StatsHandle()
+	locked, err := statsHandle.GetLockedTables(s.tableInfo.ID)
+	if err != nil || len(locked) > 0 {
+		return false, err
+	}
+	ver, err := se.GetStore().CurrentVersion(tidbkv.GlobalTxnScope)
+	if err != nil {
+		return false, err
+	}
+	results, err := s.buildStats(se, ver.Ver)
+	if err != nil {
+		return false, err
+	}
+	if err = statsHandle.SaveTableStatsToStorage(results, false, statsutil.StatsMetaHistorySourceImport); err != nil {
+		return false, err
+	}
+	return true, nil
+}
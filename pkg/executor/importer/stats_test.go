@@ -0,0 +1,96 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowSampler(t *testing.T) {
+	colA := &model.ColumnInfo{ID: 1, Name: ast.NewCIStr("a"), Offset: 0, State: model.StatePublic,
+		FieldType: *types.NewFieldType(mysql.TypeLonglong)}
+	colB := &model.ColumnInfo{ID: 2, Name: ast.NewCIStr("b"), Offset: 1, State: model.StatePublic,
+		FieldType: *types.NewFieldType(mysql.TypeVarchar)}
+	colB.SetCharset(mysql.DefaultCharset)
+	colB.SetCollate(mysql.DefaultCollationName)
+	colB.SetFlen(20)
+	tblInfo := &model.TableInfo{
+		ID:      100,
+		Name:    ast.NewCIStr("t"),
+		Columns: []*model.ColumnInfo{colA, colB},
+		Indices: []*model.IndexInfo{
+			{ID: 1, Name: ast.NewCIStr("idx_b"), State: model.StatePublic, Columns: []*model.IndexColumn{
+				{Name: colB.Name, Offset: 1, Length: types.UnspecifiedLength}}},
+			{ID: 2, Name: ast.NewCIStr("idx_prefix"), State: model.StatePublic, Columns: []*model.IndexColumn{
+				{Name: colB.Name, Offset: 1, Length: 1}}},
+			// the multi-valued index is skipped.
+			{ID: 3, Name: ast.NewCIStr("idx_mv"), State: model.StatePublic, MVIndex: true, Columns: []*model.IndexColumn{
+				{Name: colA.Name, Offset: 0, Length: types.UnspecifiedLength}}},
+		},
+		State: model.StatePublic,
+	}
+	rowSampler := NewRowSampler(tblInfo)
+	require.Len(t, rowSampler.indexes, 2)
+
+	// the rows are sampled by 2 chunks, one of which is passed by the marshaled samples.
+	another := NewRowSampler(tblInfo)
+	for i, s := range []*RowSampler{rowSampler, another} {
+		chunkSampler := s.newChunkSampler(time.UTC)
+		for j := range 50 {
+			row := []types.Datum{types.NewIntDatum(int64(i*50 + j)), types.NewStringDatum(fmt.Sprintf("v%d", j%10))}
+			if j%10 == 9 {
+				row[1].SetNull()
+			}
+			require.NoError(t, chunkSampler.collect(row))
+		}
+		chunkSampler.finish()
+	}
+	data, err := another.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, rowSampler.MergeMarshaled(data))
+	require.Equal(t, int64(100), rowSampler.Count())
+
+	results, err := rowSampler.buildStats(mock.NewContext(), 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), results.Count)
+	require.Equal(t, statistics.Version2, results.StatsVer)
+	require.Len(t, results.Ars, 2)
+	cols, idxs := results.Ars[0], results.Ars[1]
+	require.Equal(t, 0, cols.IsIndex)
+	require.Equal(t, 1, idxs.IsIndex)
+	require.Len(t, cols.Hist, 2)
+	require.Len(t, idxs.Hist, 2)
+
+	require.Equal(t, int64(100), cols.Hist[0].NDV)
+	require.Equal(t, int64(0), cols.Hist[0].NullCount)
+	require.Equal(t, int64(9), cols.Hist[1].NDV)
+	require.Equal(t, int64(10), cols.Hist[1].NullCount)
+
+	require.Equal(t, int64(1), idxs.Hist[0].ID)
+	require.Equal(t, int64(9), idxs.Hist[0].NDV)
+	require.Equal(t, int64(10), idxs.Hist[0].NullCount)
+	require.Equal(t, int64(2), idxs.Hist[1].ID)
+	require.Equal(t, int64(1), idxs.Hist[1].NDV)
+}
@@ -55,6 +55,7 @@ import (
 	"github.com/pingcap/tidb/pkg/table/tables"
 	tidbutil "github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/etcd"
+	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/promutil"
 	"github.com/pingcap/tidb/pkg/util/sqlexec"
 	"github.com/pingcap/tidb/pkg/util/sqlkiller"
@@ -320,7 +321,7 @@ func (ti *TableImporter) getParser(ctx context.Context, chunk *checkpoints.Chunk
 	return parser, nil
 }
 
-func (ti *TableImporter) getKVEncoder(chunk *checkpoints.ChunkCheckpoint) (KVEncoder, error) {
+func (ti *TableImporter) getKVEncoder(chunk *checkpoints.ChunkCheckpoint, rowSampler *RowSampler) (KVEncoder, error) {
 	cfg := &encode.EncodingConfig{
 		SessionOptions: encode.SessionOptions{
 			SQLMode:        ti.SQLMode,
@@ -332,7 +333,11 @@ func (ti *TableImporter) getKVEncoder(chunk *checkpoints.ChunkCheckpoint) (KVEnc
 		Table:  ti.encTable,
 		Logger: log.Logger{Logger: ti.logger.With(zap.String("path", chunk.FileMeta.Path))},
 	}
-	return NewTableKVEncoder(cfg, ti)
+	en, err := newTableKVEncoder(cfg, ti, rowSampler)
+	if err != nil {
+		return nil, err
+	}
+	return en, nil
 }
 
 func (e *LoadDataController) calculateSubtaskCnt() int {
@@ -661,8 +666,9 @@ func (ti *TableImporter) ImportSelectedRows(ctx context.Context, se sessionctx.C
 	}
 
 	var (
-		mu       sync.Mutex
-		checksum = verify.NewKVGroupChecksumWithKeyspace(ti.keyspace)
+		mu         sync.Mutex
+		checksum   = verify.NewKVGroupChecksumWithKeyspace(ti.keyspace)
+		rowSampler = NewRowSampler(ti.tableInfo.Core)
 	)
 	eg, egCtx := tidbutil.NewErrorGroupWithRecoverWithCtx(ctx)
 	for i := 0; i < ti.ThreadCnt; i++ {
@@ -674,7 +680,7 @@ func (ti *TableImporter) ImportSelectedRows(ctx context.Context, se sessionctx.C
 				defer mu.Unlock()
 				checksum.Add(chunkChecksum)
 			}()
-			return ProcessChunk(egCtx, &chunkCheckpoint, ti, dataEngine, indexEngine, ti.logger, chunkChecksum)
+			return ProcessChunk(egCtx, &chunkCheckpoint, ti, dataEngine, indexEngine, ti.logger, chunkChecksum, rowSampler)
 		})
 	}
 	if err = eg.Wait(); err != nil {
@@ -718,7 +724,8 @@ func (ti *TableImporter) ImportSelectedRows(ctx context.Context, se sessionctx.C
 	}
 
 	return &JobImportResult{
-		Affected: uint64(dataKVCount),
+		Affected:   uint64(dataKVCount),
+		RowSampler: rowSampler,
 	}, nil
 }
 
@@ -966,11 +973,23 @@ func GetImportRootDir(tidbCfg *tidb.Config) string {
 }
 
 // FlushTableStats flushes the stats of the table.
-// stats will be flushed in domain.updateStatsWorker, default interval is [1, 2) minutes,
+// if the rows are sampled during import, the stats are built from the samples and saved
+// directly, so the table doesn't need to be analyzed again.
+// otherwise, stats will be flushed in domain.updateStatsWorker, default interval is [1, 2) minutes,
 // see DumpStatsDeltaToKV for more details. then the background analyzer will analyze
 // the table.
 // the stats stay in memory until the next flush, so it might be lost if the tidb-server restarts.
 func FlushTableStats(ctx context.Context, se sessionctx.Context, tableID int64, result *JobImportResult) error {
+	if result.RowSampler != nil {
+		saved, err := result.RowSampler.saveTableStats(se)
+		if err != nil {
+			logutil.Logger(ctx).Warn("save the stats built during import failed, fall back to analyze the table later",
+				zap.Int64("table-id", tableID), zap.Error(err))
+		}
+		if saved {
+			return nil
+		}
+	}
 	if err := sessiontxn.NewTxn(ctx, se); err != nil {
 		return err
 	}
@@ -16,10 +16,12 @@ package executor
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"math"
 	"slices"
 	"strconv"
@@ -51,6 +53,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	plannercore "github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/indexadvisor"
 	"github.com/pingcap/tidb/pkg/privilege"
 	"github.com/pingcap/tidb/pkg/privilege/privileges"
 	"github.com/pingcap/tidb/pkg/resourcegroup/runaway"
@@ -60,6 +63,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/sessiontxn"
 	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/asyncload"
 	"github.com/pingcap/tidb/pkg/statistics/handle/cache"
 	"github.com/pingcap/tidb/pkg/store/helper"
 	"github.com/pingcap/tidb/pkg/table"
@@ -83,6 +87,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/set"
 	"github.com/pingcap/tidb/pkg/util/stringutil"
 	"github.com/pingcap/tidb/pkg/util/syncutil"
+	"github.com/tikv/client-go/v2/oracle"
 	"github.com/tikv/client-go/v2/tikv"
 	"github.com/tikv/client-go/v2/tikvrpc"
 	"github.com/tikv/client-go/v2/txnkv/txnlock"
@@ -219,6 +224,10 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			err = e.setDataForMemoryUsageOpsHistory()
 		case infoschema.ClusterTableMemoryUsageOpsHistory:
 			err = e.setDataForClusterMemoryUsageOpsHistory(sctx)
+		case infoschema.TableMemoryUsageConsumers:
+			err = e.setDataForMemoryUsageConsumers()
+		case infoschema.ClusterTableMemoryUsageConsumers:
+			err = e.setDataForClusterMemoryUsageConsumers(sctx)
 		case infoschema.TableResourceGroups:
 			err = e.setDataFromResourceGroups()
 		case infoschema.TableRunawayWatches:
@@ -237,6 +246,26 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			err = e.setDataFromPlanCache(ctx, sctx, false)
 		case infoschema.ClusterTableTiDBPlanCache:
 			err = e.setDataFromPlanCache(ctx, sctx, true)
+		case infoschema.TableStatsHealthSummary:
+			err = e.setDataForStatsHealthSummary(ctx, sctx)
+		case infoschema.TableStatsCacheUsage:
+			err = e.setDataForStatsCacheUsage(ctx, sctx)
+		case infoschema.TableAnalyzeFailureSummary:
+			err = e.setDataForAnalyzeFailureSummary(sctx)
+		case infoschema.TableSessionEstimationErrors:
+			e.setDataForSessionEstimationErrors(sctx)
+		case infoschema.TableStatsDrift:
+			err = e.setDataForStatsDrift(ctx, sctx)
+		case infoschema.TableStatsDeltaPending:
+			e.setDataForStatsDeltaPending(sctx)
+		case infoschema.TableEstimationErrorRanking:
+			err = e.setDataForEstimationErrorRanking(ctx, sctx)
+		case infoschema.TableUnusedIndexAdvice:
+			err = e.setDataForUnusedIndexAdvice(ctx, sctx)
+		case infoschema.ClusterTableUnusedIndexAdvice:
+			err = e.setDataForClusterUnusedIndexAdvice(ctx, sctx)
+		case infoschema.TableMissingIndexCandidates:
+			err = e.setDataForMissingIndexCandidates(sctx)
 		}
 		if err != nil {
 			return nil, err
@@ -2484,11 +2513,13 @@ func (e *tableStorageStatsRetriever) setDataForTableStorageStats(ctx context.Con
 
 // dataForAnalyzeStatusHelper is a helper function which can be used in show_stats.go
 func dataForAnalyzeStatusHelper(ctx context.Context, e *memtableRetriever, sctx sessionctx.Context) (rows [][]types.Datum, err error) {
-	const maxAnalyzeJobs = 30
-	const sql = "SELECT table_schema, table_name, partition_name, job_info, processed_rows, CONVERT_TZ(start_time, @@TIME_ZONE, '+00:00'), CONVERT_TZ(end_time, @@TIME_ZONE, '+00:00'), state, fail_reason, instance, process_id FROM mysql.analyze_jobs ORDER BY update_time DESC LIMIT %?"
+	// The analyze jobs of all the instances are persisted in mysql.analyze_jobs, and the ones older than
+	// tidb_analyze_job_history_retention are removed by the stats owner periodically.
+	const sql = "SELECT table_schema, table_name, partition_name, job_info, processed_rows, CONVERT_TZ(start_time, @@TIME_ZONE, '+00:00'), CONVERT_TZ(end_time, @@TIME_ZONE, '+00:00'), state, fail_reason, instance, process_id, fail_category FROM mysql.analyze_jobs WHERE update_time >= CONVERT_TZ(%?, '+00:00', @@TIME_ZONE) ORDER BY update_time DESC"
 	exec := sctx.GetRestrictedSQLExecutor()
 	kctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
-	chunkRows, _, err := exec.ExecRestrictedSQL(kctx, nil, sql, maxAnalyzeJobs)
+	retainedSince := time.Now().Add(-vardef.AnalyzeJobHistoryRetention.Load()).UTC().Format(types.TimeFormat)
+	chunkRows, _, err := exec.ExecRestrictedSQL(kctx, nil, sql, retainedSince)
 	if err != nil {
 		return nil, err
 	}
@@ -2529,6 +2560,10 @@ func dataForAnalyzeStatusHelper(ctx context.Context, e *memtableRetriever, sctx
 		if !chunkRow.IsNull(10) {
 			procID = chunkRow.GetUint64(10)
 		}
+		var failCategory any
+		if !chunkRow.IsNull(11) {
+			failCategory = chunkRow.GetString(11)
+		}
 
 		var remainDurationStr, progressDouble, estimatedRowCntStr any
 		if state == statistics.AnalyzeRunning && !strings.HasPrefix(jobInfo, "merge global stats") {
@@ -2563,6 +2598,7 @@ func dataForAnalyzeStatusHelper(ctx context.Context, e *memtableRetriever, sctx
 			remainDurationStr,  // REMAINING_SECONDS
 			progressDouble,     // PROGRESS
 			estimatedRowCntStr, // ESTIMATED_TOTAL_ROWS
+			failCategory,       // FAIL_CATEGORY
 		)
 		rows = append(rows, row)
 		if e != nil {
@@ -2650,6 +2686,510 @@ func (e *memtableRetriever) setDataForAnalyzeStatus(ctx context.Context, sctx se
 	return
 }
 
+// setDataForSessionEstimationErrors fills the estimation errors recorded by the current session. The dropped tables
+// are skipped.
+func (e *memtableRetriever) setDataForSessionEstimationErrors(sctx sessionctx.Context) {
+	loc := sctx.GetSessionVars().Location()
+	entries := sctx.GetSessionVars().EstimationErrors.GetAll()
+	slices.SortFunc(entries, func(a, b variable.SessionEstimationError) int {
+		return cmp.Compare(b.MaxQError, a.MaxQError)
+	})
+	rows := make([][]types.Datum, 0, len(entries))
+	for _, entry := range entries {
+		item, ok := e.is.TableItemByID(entry.TableID)
+		if !ok {
+			continue
+		}
+		row := []types.Datum{
+			types.NewStringDatum(item.DBName.O),                                                                  // TABLE_SCHEMA
+			types.NewStringDatum(item.TableName.O),                                                               // TABLE_NAME
+			types.NewIntDatum(entry.TableID),                                                                     // TIDB_TABLE_ID
+			types.NewStringDatum(entry.Operator),                                                                 // OPERATOR
+			types.NewIntDatum(entry.Executions),                                                                  // EXECUTIONS
+			types.NewFloat64Datum(entry.SumEstRows / float64(entry.Executions)),                                  // AVG_EST_ROWS
+			types.NewFloat64Datum(entry.SumActRows / float64(entry.Executions)),                                  // AVG_ACT_ROWS
+			types.NewFloat64Datum(entry.MaxQError),                                                               // MAX_Q_ERROR
+			types.NewTimeDatum(types.NewTime(types.FromGoTime(entry.LastSeenAt.In(loc)), mysql.TypeDatetime, 0)), // LAST_SEEN_AT
+		}
+		rows = append(rows, row)
+		e.recordMemoryConsume(row)
+	}
+	e.rows = rows
+}
+
+// setDataForStatsDrift fills the drifts of the columns checked by the stats drift detector, see
+// tidb_stats_drift_check_interval. The dropped tables and columns are skipped.
+func (e *memtableRetriever) setDataForStatsDrift(ctx context.Context, sctx sessionctx.Context) error {
+	const sql = `SELECT table_id, hist_id, sample_rows, distance, drifted, CONVERT_TZ(check_time, @@TIME_ZONE, '+00:00')
+		FROM mysql.stats_drift ORDER BY distance DESC, table_id, hist_id`
+	exec := sctx.GetRestrictedSQLExecutor()
+	kctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	chunkRows, _, err := exec.ExecRestrictedSQL(kctx, nil, sql)
+	if err != nil {
+		return err
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	rows := make([][]types.Datum, 0, len(chunkRows))
+	for _, chunkRow := range chunkRows {
+		tableID := chunkRow.GetInt64(0)
+		tbl, ok := e.is.TableByID(ctx, tableID)
+		if !ok {
+			continue
+		}
+		col := tbl.Meta().FindColumnByID(chunkRow.GetInt64(1))
+		item, ok := e.is.TableItemByID(tableID)
+		if !ok || col == nil {
+			continue
+		}
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, item.DBName.L, item.TableName.L, "", mysql.AllPrivMask) {
+			continue
+		}
+		checkTime, err := chunkRow.GetTime(5).GoTime(time.UTC)
+		if err != nil {
+			return err
+		}
+		drifted := "NO"
+		if chunkRow.GetInt64(4) != 0 {
+			drifted = "YES"
+		}
+		row := types.MakeDatums(
+			item.DBName.O,          // TABLE_SCHEMA
+			item.TableName.O,       // TABLE_NAME
+			tableID,                // TIDB_TABLE_ID
+			col.Name.O,             // COLUMN_NAME
+			chunkRow.GetInt64(2),   // SAMPLE_ROWS
+			chunkRow.GetFloat64(3), // DRIFT_DISTANCE
+			drifted,                // DRIFTED
+			types.NewTime(types.FromGoTime(checkTime.In(sctx.GetSessionVars().TimeZone)), mysql.TypeDatetime, 0), // CHECK_TIME
+		)
+		rows = append(rows, row)
+		e.recordMemoryConsume(row)
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForStatsDeltaPending fills the stats deltas of the current instance which haven't been persisted for longer
+// than tidb_stats_delta_pending_threshold, the oldest first. The dropped tables are skipped.
+func (e *memtableRetriever) setDataForStatsDeltaPending(sctx sessionctx.Context) {
+	h := domain.GetDomain(sctx).StatsHandle()
+	if h == nil {
+		return
+	}
+	now := time.Now()
+	threshold := vardef.StatsDeltaPendingThreshold.Load()
+	deltas := h.PendingStatsDeltas()
+	slices.SortFunc(deltas, func(a, b variable.TableDelta) int {
+		return a.InitTime.Compare(b.InitTime)
+	})
+	checker := privilege.GetPrivilegeManager(sctx)
+	loc := sctx.GetSessionVars().Location()
+	rows := make([][]types.Datum, 0, len(deltas))
+	for _, delta := range deltas {
+		pending := now.Sub(delta.InitTime)
+		if pending < threshold {
+			break
+		}
+		var schemaName, tableName ast.CIStr
+		var partitionName string
+		if item, ok := e.is.TableItemByID(delta.TableID); ok {
+			schemaName, tableName = item.DBName, item.TableName
+		} else {
+			tbl, db, partDef := e.is.FindTableByPartitionID(delta.TableID)
+			if tbl == nil {
+				continue
+			}
+			schemaName, tableName, partitionName = db.Name, tbl.Meta().Name, partDef.Name.O
+		}
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schemaName.L, tableName.L, "", mysql.AllPrivMask) {
+			continue
+		}
+		row := types.MakeDatums(
+			schemaName.O,  // TABLE_SCHEMA
+			tableName.O,   // TABLE_NAME
+			partitionName, // PARTITION_NAME
+			delta.TableID, // TIDB_TABLE_ID
+			delta.Count,   // MODIFY_COUNT
+			delta.Delta,   // ROW_COUNT_DELTA
+			types.NewTime(types.FromGoTime(delta.InitTime.In(loc)), mysql.TypeDatetime, 0), // FIRST_CHANGE_TIME
+			int64(pending.Seconds()), // PENDING_SECONDS
+		)
+		rows = append(rows, row)
+		e.recordMemoryConsume(row)
+	}
+	e.rows = rows
+}
+
+// setDataForEstimationErrorRanking aggregates the estimation errors kept in mysql.stats_estimation_errors within
+// tidb_estimation_error_window by the table and the operator, and ranks the tables by their cumulative errors, so that
+// the tables worth tuning the stats most come first. The error of a statement is counted as the difference between
+// the sums of its actual and estimated rows. The dropped tables are skipped.
+func (e *memtableRetriever) setDataForEstimationErrorRanking(ctx context.Context, sctx sessionctx.Context) error {
+	const sql = `SELECT table_id, operator, COUNT(*), SUM(sample_count), SUM(sum_est_rows), SUM(sum_act_rows),
+		SUM(ABS(sum_act_rows - sum_est_rows)), MAX(max_q_error), CONVERT_TZ(MAX(last_seen_at), @@TIME_ZONE, '+00:00')
+		FROM mysql.stats_estimation_errors
+		WHERE last_seen_at >= CONVERT_TZ(%?, '+00:00', @@TIME_ZONE)
+		GROUP BY table_id, operator`
+	exec := sctx.GetRestrictedSQLExecutor()
+	kctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	since := time.Now().Add(-sctx.GetSessionVars().EstimationErrorWindow).UTC().Format(types.TimeFormat)
+	chunkRows, _, err := exec.ExecRestrictedSQL(kctx, nil, sql, since)
+	if err != nil {
+		return err
+	}
+
+	type operatorErrors struct {
+		operator        string
+		columnNames     string
+		digestCount     int64
+		sampleCount     int64
+		sumEstRows      float64
+		sumActRows      float64
+		cumulativeError float64
+		maxQError       float64
+		lastSeenAt      any
+	}
+	type tableErrors struct {
+		dbName          string
+		tableName       string
+		tableID         int64
+		operators       []operatorErrors
+		cumulativeError float64
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	var tables []*tableErrors
+	tableIdx := make(map[int64]int)
+	for _, chunkRow := range chunkRows {
+		tableID := chunkRow.GetInt64(0)
+		tbl, ok := e.is.TableByID(ctx, tableID)
+		if !ok {
+			continue
+		}
+		item, ok := e.is.TableItemByID(tableID)
+		if !ok {
+			continue
+		}
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, item.DBName.L, item.TableName.L, "", mysql.AllPrivMask) {
+			continue
+		}
+		idx, ok := tableIdx[tableID]
+		if !ok {
+			idx = len(tables)
+			tableIdx[tableID] = idx
+			tables = append(tables, &tableErrors{dbName: item.DBName.O, tableName: item.TableName.O, tableID: tableID})
+		}
+		op := operatorErrors{
+			operator:        chunkRow.GetString(1),
+			digestCount:     chunkRow.GetInt64(2),
+			sumEstRows:      chunkRow.GetFloat64(4),
+			sumActRows:      chunkRow.GetFloat64(5),
+			cumulativeError: chunkRow.GetFloat64(6),
+			maxQError:       chunkRow.GetFloat64(7),
+		}
+		sampleCount, err := chunkRow.GetMyDecimal(3).ToInt()
+		if err != nil {
+			return err
+		}
+		op.sampleCount = sampleCount
+		// The index scans are recorded as `IndexRangeScan(idx)`, see estimationErrorOperator.
+		if start := strings.IndexByte(op.operator, '('); start > 0 && strings.HasSuffix(op.operator, ")") {
+			if idxInfo := tbl.Meta().FindIndexByName(strings.ToLower(op.operator[start+1 : len(op.operator)-1])); idxInfo != nil {
+				names := make([]string, 0, len(idxInfo.Columns))
+				for _, col := range idxInfo.Columns {
+					names = append(names, col.Name.O)
+				}
+				op.columnNames = strings.Join(names, ",")
+			}
+		}
+		if !chunkRow.IsNull(8) {
+			t, err := chunkRow.GetTime(8).GoTime(time.UTC)
+			if err != nil {
+				return err
+			}
+			op.lastSeenAt = types.NewTime(types.FromGoTime(t.In(sctx.GetSessionVars().Location())), mysql.TypeDatetime, 0)
+		}
+		tables[idx].operators = append(tables[idx].operators, op)
+		tables[idx].cumulativeError += op.cumulativeError
+	}
+	slices.SortStableFunc(tables, func(a, b *tableErrors) int {
+		return cmp.Or(cmp.Compare(b.cumulativeError, a.cumulativeError), cmp.Compare(a.tableID, b.tableID))
+	})
+
+	var rows [][]types.Datum
+	for i, tbl := range tables {
+		slices.SortFunc(tbl.operators, func(a, b operatorErrors) int {
+			return cmp.Or(cmp.Compare(b.cumulativeError, a.cumulativeError), cmp.Compare(a.operator, b.operator))
+		})
+		for _, op := range tbl.operators {
+			row := types.MakeDatums(
+				i+1,                 // TABLE_RANK
+				tbl.dbName,          // TABLE_SCHEMA
+				tbl.tableName,       // TABLE_NAME
+				tbl.tableID,         // TIDB_TABLE_ID
+				tbl.cumulativeError, // TABLE_CUMULATIVE_ERROR
+				op.operator,         // OPERATOR
+				op.columnNames,      // COLUMN_NAMES
+				op.digestCount,      // DIGEST_COUNT
+				op.sampleCount,      // SAMPLE_COUNT
+				op.sumEstRows,       // SUM_EST_ROWS
+				op.sumActRows,       // SUM_ACT_ROWS
+				op.cumulativeError,  // CUMULATIVE_ERROR
+				op.maxQError,        // MAX_Q_ERROR
+				op.lastSeenAt,       // LAST_SEEN_AT
+			)
+			rows = append(rows, row)
+			e.recordMemoryConsume(row)
+		}
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForUnusedIndexAdvice fills the secondary indexes of the user tables not used by the current instance within
+// tidb_unused_index_window, the ones on the most write-heavy tables first, since an index costs a write of its own for
+// every modified row. The primary keys and the unique indexes are skipped, since they're kept for the constraints. The
+// index usage is only known since the collection starts with the instance, so the confidence of the advice is how much
+// of the window has been covered by the collection. The usage on the other instances isn't seen here, an index is
+// unused by the cluster only if all the instances report it in CLUSTER_UNUSED_INDEX_ADVICE.
+func (e *memtableRetriever) setDataForUnusedIndexAdvice(ctx context.Context, sctx sessionctx.Context) error {
+	h := domain.GetDomain(sctx).StatsHandle()
+	if h == nil {
+		return nil
+	}
+	now := time.Now()
+	window := sctx.GetSessionVars().UnusedIndexWindow
+	collectedSince := h.GetIndexUsageStartTime()
+	failpoint.Inject("mockIndexUsageStartTime", func(val failpoint.Value) {
+		collectedSince = now.Add(-time.Duration(val.(int)) * time.Hour)
+	})
+	confidence := min(float64(now.Sub(collectedSince))/float64(window), 1)
+
+	type unusedIndex struct {
+		schema     string
+		table      *model.TableInfo
+		index      string
+		lastUsedAt time.Time
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	var indexes []unusedIndex
+	tableInfos := make(map[int64]*model.TableInfo)
+	for _, schema := range e.is.AllSchemaNames() {
+		if util.IsMemOrSysDB(schema.L) {
+			continue
+		}
+		tables, err := e.is.SchemaTableInfos(ctx, schema)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, tbl := range tables {
+			if tbl.IsView() || tbl.IsSequence() {
+				continue
+			}
+			if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schema.L, tbl.Name.L, "", mysql.AllPrivMask) {
+				continue
+			}
+			found := false
+			for _, idx := range tbl.Indices {
+				if idx.State != model.StatePublic || idx.Primary || idx.Unique {
+					continue
+				}
+				usage := h.GetIndexUsage(tbl.ID, idx.ID)
+				if !usage.LastUsedAt.IsZero() && now.Sub(usage.LastUsedAt) < window {
+					continue
+				}
+				indexes = append(indexes, unusedIndex{schema: schema.O, table: tbl, index: idx.Name.O, lastUsedAt: usage.LastUsedAt})
+				found = true
+			}
+			if found {
+				tableInfos[tbl.ID] = tbl
+			}
+		}
+	}
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	// The modified rows are counted since the last analyze, or since the last DDL of the table if it's never analyzed,
+	// and averaged over a day at least.
+	type tableWrites struct {
+		rows       int64
+		rowsPerDay float64
+	}
+	writes := make(map[int64]tableWrites, len(tableInfos))
+	exec := sctx.GetRestrictedSQLExecutor()
+	kctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	chunkRows, _, err := exec.ExecRestrictedSQL(kctx, nil,
+		"SELECT table_id, count, modify_count, snapshot FROM mysql.stats_meta WHERE table_id IN (%?)", slices.Collect(maps.Keys(tableInfos)))
+	if err != nil {
+		return err
+	}
+	for _, chunkRow := range chunkRows {
+		tableID, snapshot := chunkRow.GetInt64(0), chunkRow.GetUint64(3)
+		if snapshot == 0 {
+			snapshot = tableInfos[tableID].UpdateTS
+		}
+		days := max(now.Sub(oracle.GetTimeFromTS(snapshot)).Hours()/24, 1)
+		writes[tableID] = tableWrites{rows: int64(chunkRow.GetUint64(1)), rowsPerDay: float64(chunkRow.GetInt64(2)) / days}
+	}
+	slices.SortFunc(indexes, func(a, b unusedIndex) int {
+		return cmp.Or(cmp.Compare(writes[b.table.ID].rowsPerDay, writes[a.table.ID].rowsPerDay),
+			cmp.Compare(a.schema, b.schema), cmp.Compare(a.table.Name.L, b.table.Name.L), cmp.Compare(a.index, b.index))
+	})
+
+	loc := sctx.GetSessionVars().Location()
+	collectedSinceTime := types.NewTime(types.FromGoTime(collectedSince.In(loc)), mysql.TypeDatetime, 0)
+	rows := make([][]types.Datum, 0, len(indexes))
+	for _, idx := range indexes {
+		var lastAccessTime any
+		if !idx.lastUsedAt.IsZero() {
+			lastAccessTime = types.NewTime(types.FromGoTime(idx.lastUsedAt.In(loc)), mysql.TypeDatetime, 0)
+		}
+		row := types.MakeDatums(
+			idx.schema,                      // TABLE_SCHEMA
+			idx.table.Name.O,                // TABLE_NAME
+			idx.index,                       // INDEX_NAME
+			lastAccessTime,                  // LAST_ACCESS_TIME
+			writes[idx.table.ID].rows,       // TABLE_ROWS
+			writes[idx.table.ID].rowsPerDay, // WRITE_ROWS_PER_DAY
+			collectedSinceTime,              // COLLECTED_SINCE
+			confidence,                      // CONFIDENCE
+		)
+		rows = append(rows, row)
+		e.recordMemoryConsume(row)
+	}
+	e.rows = rows
+	return nil
+}
+
+func (e *memtableRetriever) setDataForClusterUnusedIndexAdvice(ctx context.Context, sctx sessionctx.Context) error {
+	err := e.setDataForUnusedIndexAdvice(ctx, sctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	rows, err := infoschema.AppendHostInfoToRows(sctx, e.rows)
+	if err != nil {
+		return err
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForMissingIndexCandidates fills the columns without an index on them which are filtered by the point or
+// narrow range predicates, ranked by the benefit of an index estimated from the column stats and the statements summary.
+func (e *memtableRetriever) setDataForMissingIndexCandidates(sctx sessionctx.Context) error {
+	cols, err := indexadvisor.LoadPredicateColumns(sctx, e.is)
+	if err != nil {
+		return err
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	rows := make([][]types.Datum, 0, len(cols))
+	for _, c := range cols {
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, c.SchemaName, c.TableName, "", mysql.AllPrivMask) {
+			continue
+		}
+		row := types.MakeDatums(
+			c.SchemaName,            // TABLE_SCHEMA
+			c.TableName,             // TABLE_NAME
+			c.ColumnName,            // COLUMN_NAME
+			c.PointPredicates,       // POINT_PREDICATES
+			c.NarrowRangePredicates, // NARROW_RANGE_PREDICATES
+			c.WideRangePredicates,   // WIDE_RANGE_PREDICATES
+			c.TableRows,             // TABLE_ROWS
+			c.NDV,                   // NDV
+			c.Selectivity(),         // SELECTIVITY
+			c.QueryFrequency,        // QUERY_FREQUENCY
+			c.EstimatedBenefit,      // ESTIMATED_BENEFIT
+			fmt.Sprintf("CREATE INDEX idx_%s ON %s.%s(%s);", c.ColumnName, c.SchemaName, c.TableName, c.ColumnName), // CREATE_STATEMENT
+		)
+		rows = append(rows, row)
+		e.recordMemoryConsume(row)
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForAnalyzeFailureSummary aggregates the analyze jobs retained in mysql.analyze_jobs by the table and the fail
+// category, so that what breaks the analyze can be seen at a glance. The failures not classified are counted as other.
+func (e *memtableRetriever) setDataForAnalyzeFailureSummary(sctx sessionctx.Context) error {
+	const sql = `SELECT table_schema, table_name, state, IFNULL(fail_category, %?), COUNT(*), CONVERT_TZ(MAX(end_time), @@TIME_ZONE, '+00:00')
+		FROM mysql.analyze_jobs
+		WHERE state IN (%?, %?) AND update_time >= CONVERT_TZ(%?, '+00:00', @@TIME_ZONE)
+		GROUP BY table_schema, table_name, state, IFNULL(fail_category, %?)
+		ORDER BY table_schema, table_name, IFNULL(fail_category, %?)`
+	exec := sctx.GetRestrictedSQLExecutor()
+	kctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	retainedSince := time.Now().Add(-vardef.AnalyzeJobHistoryRetention.Load()).UTC().Format(types.TimeFormat)
+	chunkRows, _, err := exec.ExecRestrictedSQL(kctx, nil, sql, statistics.AnalyzeFailureOther,
+		statistics.AnalyzeFinished, statistics.AnalyzeFailed, retainedSince, statistics.AnalyzeFailureOther, statistics.AnalyzeFailureOther)
+	if err != nil {
+		return err
+	}
+
+	type failure struct {
+		category     string
+		lastFailTime any
+		failedJobs   int64
+	}
+	type tableJobs struct {
+		dbName    string
+		tableName string
+		failures  []failure
+		totalJobs int64
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	// The tables are kept in the order of the query result.
+	var tables []*tableJobs
+	tableIdx := make(map[[2]string]int)
+	for _, chunkRow := range chunkRows {
+		dbName, tableName := chunkRow.GetString(0), chunkRow.GetString(1)
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, dbName, tableName, "", mysql.AllPrivMask) {
+			continue
+		}
+		key := [2]string{dbName, tableName}
+		idx, ok := tableIdx[key]
+		if !ok {
+			idx = len(tables)
+			tableIdx[key] = idx
+			tables = append(tables, &tableJobs{dbName: dbName, tableName: tableName})
+		}
+		tbl := tables[idx]
+		jobs := chunkRow.GetInt64(4)
+		tbl.totalJobs += jobs
+		if chunkRow.GetEnum(2).String() != statistics.AnalyzeFailed {
+			continue
+		}
+		var lastFailTime any
+		if !chunkRow.IsNull(5) {
+			t, err := chunkRow.GetTime(5).GoTime(time.UTC)
+			if err != nil {
+				return err
+			}
+			lastFailTime = types.NewTime(types.FromGoTime(t.In(sctx.GetSessionVars().TimeZone)), mysql.TypeDatetime, 0)
+		}
+		tbl.failures = append(tbl.failures, failure{category: chunkRow.GetString(3), failedJobs: jobs, lastFailTime: lastFailTime})
+	}
+
+	rows := make([][]types.Datum, 0, len(tables))
+	for _, tbl := range tables {
+		for _, f := range tbl.failures {
+			failureRate := float64(f.failedJobs) / float64(tbl.totalJobs)
+			row := types.MakeDatums(
+				tbl.dbName,     // TABLE_SCHEMA
+				tbl.tableName,  // TABLE_NAME
+				f.category,     // FAIL_CATEGORY
+				f.failedJobs,   // FAILED_JOBS
+				tbl.totalJobs,  // TOTAL_JOBS
+				failureRate,    // FAILURE_RATE
+				f.lastFailTime, // LAST_FAIL_TIME
+			)
+			rows = append(rows, row)
+			e.recordMemoryConsume(row)
+		}
+	}
+	e.rows = rows
+	return nil
+}
+
 // setDataForPseudoProfiling returns pseudo data for table profiling when system variable `profiling` is set to `ON`.
 func (e *memtableRetriever) setDataForPseudoProfiling(sctx sessionctx.Context) {
 	if v, ok := sctx.GetSessionVars().GetSystemVar("profiling"); ok && variable.TiDBOptOn(v) {
@@ -2963,6 +3503,39 @@ func (e *memtableRetriever) setDataForClusterMemoryUsageOpsHistory(ctx sessionct
 	return nil
 }
 
+func (e *memtableRetriever) setDataForMemoryUsageConsumers() error {
+	for _, usage := range servermemorylimit.MemoryConsumersUsage() {
+		shrinkLast := types.NewDatum(nil)
+		if !usage.ShrinkLast.IsZero() {
+			shrinkLast.SetMysqlTime(types.NewTime(types.FromGoTime(usage.ShrinkLast), mysql.TypeDatetime, 0))
+		}
+		row := []types.Datum{
+			types.NewStringDatum(usage.Name),     // NAME
+			types.NewIntDatum(usage.Bytes),       // MEMORY_USAGE
+			types.NewIntDatum(usage.MaxBytes),    // MEMORY_MAX_USED
+			types.NewDatum(usage.Shrinkable),     // SHRINKABLE
+			shrinkLast,                           // SHRINK_LAST
+			types.NewIntDatum(usage.ShrinkTotal), // SHRINK_TOTAL
+		}
+		e.rows = append(e.rows, row)
+		e.recordMemoryConsume(row)
+	}
+	return nil
+}
+
+func (e *memtableRetriever) setDataForClusterMemoryUsageConsumers(ctx sessionctx.Context) error {
+	err := e.setDataForMemoryUsageConsumers()
+	if err != nil {
+		return err
+	}
+	rows, err := infoschema.AppendHostInfoToRows(ctx, e.rows)
+	if err != nil {
+		return err
+	}
+	e.rows = rows
+	return nil
+}
+
 // tidbTrxTableRetriever is the memtable retriever for the TIDB_TRX and CLUSTER_TIDB_TRX table.
 type tidbTrxTableRetriever struct {
 	dummyCloser
@@ -4058,6 +4631,205 @@ func (e *memtableRetriever) setDataFromPlanCache(_ context.Context, sctx session
 	return nil
 }
 
+// setDataForStatsHealthSummary summarizes the stats of each table, so that whether the stats of the cluster are in
+// good shape can be answered in one place. The stats of the partitions are aggregated to their table.
+func (e *memtableRetriever) setDataForStatsHealthSummary(ctx context.Context, sctx sessionctx.Context) error {
+	ex, ok := e.extractor.(*plannercore.InfoSchemaStatsHealthSummaryExtractor)
+	if !ok {
+		return errors.Errorf("wrong extractor type: %T, expected InfoSchemaStatsHealthSummaryExtractor", e.extractor)
+	}
+	if ex.SkipRequest {
+		return nil
+	}
+	schemas, tables, err := ex.ListSchemasAndTables(ctx, e.is)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	h := domain.GetDomain(sctx).StatsHandle()
+	physicalIDs := make([]int64, 0, len(tables))
+	filteredSchemas := make([]ast.CIStr, 0, len(tables))
+	filteredTables := make([]*model.TableInfo, 0, len(tables))
+	for i, tbl := range tables {
+		if tbl.IsView() || tbl.IsSequence() {
+			continue
+		}
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schemas[i].L, tbl.Name.L, "", mysql.AllPrivMask) {
+			continue
+		}
+		filteredSchemas = append(filteredSchemas, schemas[i])
+		filteredTables = append(filteredTables, tbl)
+		physicalIDs = append(physicalIDs, tbl.ID)
+		if pi := tbl.GetPartitionInfo(); pi != nil {
+			for _, def := range pi.Definitions {
+				physicalIDs = append(physicalIDs, def.ID)
+			}
+		}
+	}
+	if len(filteredTables) == 0 {
+		return nil
+	}
+	lockedTables, err := h.GetLockedTables(physicalIDs...)
+	if err != nil {
+		return err
+	}
+	pendingLoads := make(map[int64]int64)
+	for _, item := range asyncload.AsyncLoadHistogramNeededItems.AllItems() {
+		pendingLoads[item.TableID]++
+	}
+
+	rows := make([][]types.Datum, 0, len(filteredTables))
+	for i, tbl := range filteredTables {
+		var (
+			healthy, lastAnalyzeTime types.Datum
+			minHealthy               int64 = math.MaxInt64
+			minAnalyzeVersion        uint64
+			pending                  = pendingLoads[tbl.ID]
+			lockedPartitions         int
+		)
+		summarize := func(statsTbl *statistics.Table) {
+			if health, ok := statsTbl.GetStatsHealthy(); ok {
+				minHealthy = min(minHealthy, health)
+			}
+			if statsTbl.IsAnalyzed() && (minAnalyzeVersion == 0 || statsTbl.LastAnalyzeVersion < minAnalyzeVersion) {
+				minAnalyzeVersion = statsTbl.LastAnalyzeVersion
+			}
+		}
+		pi := tbl.GetPartitionInfo()
+		// Like SHOW STATS_HEALTHY, the global stats are only considered in the dynamic prune mode.
+		if pi == nil || sctx.GetSessionVars().IsDynamicPartitionPruneEnabled() {
+			summarize(h.GetTableStats(tbl))
+		}
+		if pi != nil {
+			for _, def := range pi.Definitions {
+				summarize(h.GetPartitionStats(tbl, def.ID))
+				pending += pendingLoads[def.ID]
+				if _, ok := lockedTables[def.ID]; ok {
+					lockedPartitions++
+				}
+			}
+		}
+		if minHealthy != math.MaxInt64 {
+			healthy.SetInt64(minHealthy)
+		}
+		if minAnalyzeVersion > 0 {
+			lastAnalyzeTime.SetMysqlTime(types.NewTime(types.FromGoTime(oracle.GetTimeFromTS(minAnalyzeVersion)), mysql.TypeDatetime, 0))
+		}
+		lockState := "unlocked"
+		if _, ok := lockedTables[tbl.ID]; ok || (pi != nil && lockedPartitions == len(pi.Definitions)) {
+			lockState = "locked"
+		} else if lockedPartitions > 0 {
+			lockState = "partially locked"
+		}
+		row := []types.Datum{
+			types.NewStringDatum(filteredSchemas[i].O),       // TABLE_SCHEMA
+			types.NewStringDatum(tbl.Name.O),                 // TABLE_NAME
+			types.NewIntDatum(tbl.ID),                        // TIDB_TABLE_ID
+			healthy,                                          // HEALTHY
+			lastAnalyzeTime,                                  // LAST_ANALYZE_TIME
+			types.NewIntDatum(h.GetPseudoStatsUsage(tbl.ID)), // PSEUDO_STATS_USAGE_LAST_HOUR
+			types.NewIntDatum(pending),                       // PENDING_STATS_LOADS
+			types.NewStringDatum(lockState),                  // LOCK_STATE
+		}
+		rows = append(rows, row)
+		e.recordMemoryConsume(row)
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForStatsCacheUsage lists the memory usage, the load time and the eviction count of the stats of the tables,
+// the partitions and their columns and indexes in the stats cache of this instance.
+func (e *memtableRetriever) setDataForStatsCacheUsage(ctx context.Context, sctx sessionctx.Context) error {
+	ex, ok := e.extractor.(*plannercore.InfoSchemaStatsCacheUsageExtractor)
+	if !ok {
+		return errors.Errorf("wrong extractor type: %T, expected InfoSchemaStatsCacheUsageExtractor", e.extractor)
+	}
+	if ex.SkipRequest {
+		return nil
+	}
+	schemas, tables, err := ex.ListSchemasAndTables(ctx, e.is)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	h := domain.GetDomain(sctx).StatsHandle()
+	// Don't get the tables from the cache one by one, which affects the eviction of the cache.
+	cached := make(map[int64]*statistics.Table)
+	for _, statsTbl := range h.Values() {
+		cached[statsTbl.PhysicalID] = statsTbl
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	loc := sctx.GetSessionVars().Location()
+	toTime := func(t time.Time) types.Datum {
+		if t.IsZero() {
+			return types.Datum{}
+		}
+		return types.NewTimeDatum(types.NewTime(types.FromGoTime(t.In(loc)), mysql.TypeDatetime, 0))
+	}
+	var rows [][]types.Datum
+	appendRows := func(schema ast.CIStr, tbl *model.TableInfo, partitionName string, physicalID int64) {
+		statsTbl, ok := cached[physicalID]
+		if !ok {
+			return
+		}
+		usage, _ := h.GetTableCacheUsage(physicalID)
+		appendRow := func(itemType, itemName string, loadStatus, loadTime types.Datum, memUsage, evictCount int64) {
+			row := []types.Datum{
+				types.NewStringDatum(schema.O),      // TABLE_SCHEMA
+				types.NewStringDatum(tbl.Name.O),    // TABLE_NAME
+				types.NewStringDatum(partitionName), // PARTITION_NAME
+				types.NewIntDatum(physicalID),       // TIDB_TABLE_ID
+				types.NewStringDatum(itemType),      // ITEM_TYPE
+				types.NewStringDatum(itemName),      // ITEM_NAME
+				loadStatus,                          // LOAD_STATUS
+				types.NewIntDatum(memUsage),         // MEMORY_USAGE
+				loadTime,                            // LOAD_TIME
+				types.NewIntDatum(evictCount),       // EVICT_COUNT
+			}
+			rows = append(rows, row)
+			e.recordMemoryConsume(row)
+		}
+		appendRow("table", "", types.Datum{}, toTime(usage.LoadTime), statsTbl.MemoryUsage().TotalMemUsage, usage.EvictCount)
+		statsTbl.ForEachColumnImmutable(func(id int64, col *statistics.Column) bool {
+			if col.Info == nil || !col.IsStatsInitialized() {
+				return false
+			}
+			itemID := model.TableItemID{TableID: physicalID, ID: id}
+			appendRow("column", col.Info.Name.O, types.NewStringDatum(col.StatsLoadedStatus.StatusToString()),
+				toTime(usage.ItemLoadTimes[itemID]), col.MemoryUsage().TotalMemoryUsage(), usage.ItemEvictCounts[itemID])
+			return false
+		})
+		statsTbl.ForEachIndexImmutable(func(id int64, idx *statistics.Index) bool {
+			if idx.Info == nil || !idx.IsStatsInitialized() {
+				return false
+			}
+			itemID := model.TableItemID{TableID: physicalID, ID: id, IsIndex: true}
+			appendRow("index", idx.Info.Name.O, types.NewStringDatum(idx.StatsLoadedStatus.StatusToString()),
+				toTime(usage.ItemLoadTimes[itemID]), idx.MemoryUsage().TotalMemoryUsage(), usage.ItemEvictCounts[itemID])
+			return false
+		})
+	}
+	for i, tbl := range tables {
+		if tbl.IsView() || tbl.IsSequence() {
+			continue
+		}
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schemas[i].L, tbl.Name.L, "", mysql.AllPrivMask) {
+			continue
+		}
+		pi := tbl.GetPartitionInfo()
+		if pi == nil {
+			appendRows(schemas[i], tbl, "", tbl.ID)
+			continue
+		}
+		appendRows(schemas[i], tbl, "global", tbl.ID)
+		for _, def := range pi.Definitions {
+			appendRows(schemas[i], tbl, def.Name.O, def.ID)
+		}
+	}
+	e.rows = rows
+	return nil
+}
+
 func checkRule(rule *label.Rule) (dbName, tableName string, partitionName string, err error) {
 	s := strings.Split(rule.ID, "/")
 	if len(s) < 3 {
@@ -29,10 +29,13 @@ import (
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/domain/infosync"
 	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/statistics/asyncload"
 	statstestutil "github.com/pingcap/tidb/pkg/statistics/handle/ddl/testutil"
+	"github.com/pingcap/tidb/pkg/statistics/handle/usage/indexusage"
 	"github.com/pingcap/tidb/pkg/store/mockstore"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/testkit/testfailpoint"
@@ -316,7 +319,8 @@ func TestForAnalyzeStatus(t *testing.T) {
 		"  `PROCESS_ID` bigint(21) unsigned DEFAULT NULL,\n" +
 		"  `REMAINING_SECONDS` varchar(512) DEFAULT NULL,\n" +
 		"  `PROGRESS` double(22,6) DEFAULT NULL,\n" +
-		"  `ESTIMATED_TOTAL_ROWS` bigint(21) unsigned DEFAULT NULL\n" +
+		"  `ESTIMATED_TOTAL_ROWS` bigint(21) unsigned DEFAULT NULL,\n" +
+		"  `FAIL_CATEGORY` varchar(32) DEFAULT NULL\n" +
 		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"
 	tk.MustQuery("show create table information_schema.analyze_status").Check(testkit.Rows("ANALYZE_STATUS " + analyzeStatusTable))
 	tk.MustExec("delete from mysql.analyze_jobs")
@@ -353,7 +357,7 @@ func TestForAnalyzeStatus(t *testing.T) {
 	rows := tk.MustQuery("select * from information_schema.analyze_status where TABLE_NAME='t1'").Sort().Rows()
 	require.Greater(t, len(rows), 0)
 	for _, row := range rows {
-		require.Len(t, row, 14) // test length of row
+		require.Len(t, row, 15) // test length of row
 		// test `End_time` field
 		str, ok := row[6].(string)
 		require.True(t, ok)
@@ -733,7 +737,304 @@ func TestIndexUsageTable(t *testing.T) {
 				where TABLE_SCHEMA = 'test' and TABLE_NAME = 'idt1' and INDEX_NAME = 'idx_4';`).Check(testkit.Rows())
 }
 
+func TestStatsHealthSummaryTable(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a))")
+	tk.MustExec("create table pt(a int, b int) partition by hash(a) partitions 2")
+	tk.MustExec("create table t2(a int)")
+	tk.MustExec("create view v as select * from t")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+	tk.MustExec("insert into pt values (1, 1), (2, 2)")
+	tk.MustExec("analyze table t, pt all columns")
+	tk.MustExec("lock stats t")
+	tk.MustExec("lock stats pt partition p0")
+
+	// The columns and indexes waiting for the async loading are counted by their tables. The queue is shared by the
+	// whole process and the table IDs are reused by the other tests, so clear it first.
+	for _, neededItem := range asyncload.AsyncLoadHistogramNeededItems.AllItems() {
+		asyncload.AsyncLoadHistogramNeededItems.Delete(neededItem.TableItemID)
+	}
+	ptInfo, err := dom.InfoSchema().TableInfoByName(ast.NewCIStr("test"), ast.NewCIStr("pt"))
+	require.NoError(t, err)
+	item := model.TableItemID{TableID: ptInfo.Partition.Definitions[1].ID, ID: ptInfo.Columns[1].ID}
+	asyncload.AsyncLoadHistogramNeededItems.Insert(item, true)
+	defer asyncload.AsyncLoadHistogramNeededItems.Delete(item)
+
+	// The table without stats is estimated by the pseudo stats.
+	tk.MustExec("explain select * from t2 where a > 1")
+	tk.MustExec("explain select * from t2 where a > 1")
+
+	tk.MustQuery(`select table_name, healthy, last_analyze_time is not null, pending_stats_loads, lock_state
+				from information_schema.stats_health_summary where table_schema = 'test' and table_name in ('t', 'pt')`).Sort().Check(
+		testkit.Rows(
+			"pt 100 1 1 partially locked",
+			"t 100 1 0 locked"))
+	tk.MustQuery(`select pseudo_stats_usage_last_hour >= 2, lock_state from information_schema.stats_health_summary
+				where table_name = 't2'`).Check(testkit.Rows("1 unlocked"))
+	tk.MustQuery("select count(*) from information_schema.stats_health_summary where table_schema = 'test'").Check(testkit.Rows("3"))
+	tk.MustQuery("select pseudo_stats_usage_last_hour from information_schema.stats_health_summary where table_name = 't'").Check(testkit.Rows("0"))
+}
+
+func TestStatsCacheUsageTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a))")
+	tk.MustExec("create table pt(a int, b int) partition by hash(a) partitions 2")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+	tk.MustExec("insert into pt values (1, 1), (2, 2)")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec("analyze table t, pt all columns")
+
+	tk.MustQuery(`select partition_name, item_type, item_name from information_schema.stats_cache_usage
+				where table_schema = 'test' and table_name = 't' order by item_type, item_name`).Check(testkit.Rows(
+		" column a",
+		" column b",
+		" index ia",
+		" table ",
+	))
+	tk.MustQuery(`select memory_usage > 0, load_time is not null, evict_count from information_schema.stats_cache_usage
+				where table_schema = 'test' and table_name = 't' and item_type = 'table'`).Check(testkit.Rows("1 1 0"))
+	tk.MustQuery(`select partition_name from information_schema.stats_cache_usage
+				where table_schema = 'test' and table_name = 'pt' and item_type = 'table' order by partition_name`).Check(testkit.Rows(
+		"global",
+		"p0",
+		"p1",
+	))
+	tk.MustQuery("select count(*) from information_schema.stats_cache_usage where table_name = 'not_exist'").Check(testkit.Rows("0"))
+}
+
+func TestAnalyzeFailureSummaryTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("delete from mysql.analyze_jobs")
+	tk.MustExec(`insert into mysql.analyze_jobs (update_time, end_time, table_schema, table_name, job_info, state, fail_reason, fail_category, instance) values
+		(now(), now(), 'test', 't1', 'analyze table', 'failed', 'Region is unavailable', 'region', ''),
+		(now(), now(), 'test', 't1', 'analyze table', 'failed', 'Region is unavailable', 'region', ''),
+		(now(), now(), 'test', 't1', 'analyze table', 'failed', 'Out Of Memory Quota!', 'memory_quota', ''),
+		(now(), now(), 'test', 't1', 'analyze table', 'finished', null, null, ''),
+		(now(), now(), 'test', 't1', 'analyze table', 'running', null, null, ''),
+		(now(), now(), 'test', 't2', 'analyze table', 'failed', 'unknown', null, ''),
+		(now(), now(), 'test', 't2', 'analyze table', 'finished', null, null, ''),
+		(now(), now(), 'test', 't3', 'analyze table', 'finished', null, null, ''),
+		(now() - interval 10 day, now() - interval 10 day, 'test', 't3', 'analyze table', 'failed', 'killed', 'killed', '')`)
+
+	// The running jobs, the tables without failures and the jobs beyond the retention are not counted.
+	tk.MustQuery(`select table_name, fail_category, failed_jobs, total_jobs, failure_rate, last_fail_time is not null
+				from information_schema.analyze_failure_summary`).Check(testkit.Rows(
+		"t1 memory_quota 1 4 0.25 1",
+		"t1 region 2 4 0.5 1",
+		"t2 other 1 2 0.5 1",
+	))
+	tk.MustQuery(`select fail_category, sum(failed_jobs) from information_schema.analyze_failure_summary
+				group by fail_category order by fail_category`).Check(testkit.Rows(
+		"memory_quota 1",
+		"other 1",
+		"region 2",
+	))
+}
+
+func TestSessionEstimationErrorsTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("analyze table t")
+
+	// Nothing is recorded unless the recording is on.
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1", "2 2", "3 3"))
+	tk.MustQuery("select count(*) from information_schema.session_estimation_errors").Check(testkit.Rows("0"))
+
+	tk.MustExec("set @@tidb_record_estimation_errors = on")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1", "2 2", "3 3"))
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1", "2 2", "3 3"))
+	tk.MustQuery(`select table_schema, table_name, executions, avg_est_rows, avg_act_rows, max_q_error
+				from information_schema.session_estimation_errors where operator = 'TableFullScan'`).Check(testkit.Rows(
+		"test t 2 3 3 1",
+	))
+
+	// The other sessions don't see the recorded errors.
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustQuery("select count(*) from information_schema.session_estimation_errors").Check(testkit.Rows("0"))
+
+	// Turning the recording on again starts a fresh recording.
+	tk.MustExec("set @@tidb_record_estimation_errors = off")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1", "2 2", "3 3"))
+	tk.MustQuery(`select executions from information_schema.session_estimation_errors where operator = 'TableFullScan'`).Check(testkit.Rows("2"))
+	tk.MustExec("set @@tidb_record_estimation_errors = on")
+	tk.MustQuery("select count(*) from information_schema.session_estimation_errors").Check(testkit.Rows("0"))
+}
+
+func TestStatsDriftTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("create table t2 (a int)")
+	tblID := tk.MustQuery("select tidb_table_id from information_schema.tables where table_schema = 'test' and table_name = 't'").Rows()[0][0]
+	tbl2ID := tk.MustQuery("select tidb_table_id from information_schema.tables where table_schema = 'test' and table_name = 't2'").Rows()[0][0]
+	tk.MustExec(fmt.Sprintf(`insert into mysql.stats_drift (table_id, hist_id, stats_version, sample_rows, distance, drifted) values
+		(%[1]v, 1, 1, 1000, 0.05, 0), (%[1]v, 2, 1, 900, 0.6, 1), (%[1]v, 3, 1, 1000, 0.9, 1), (%[2]v, 1, 1, 500, 0.3, 1)`, tblID, tbl2ID))
+
+	// The dropped columns and tables are skipped.
+	tk.MustExec("drop table t2")
+	tk.MustQuery(`select table_schema, table_name, column_name, sample_rows, drift_distance, drifted, check_time is not null
+				from information_schema.stats_drift`).Check(testkit.Rows(
+		"test t b 900 0.6 YES 1",
+		"test t a 1000 0.05 NO 1",
+	))
+}
+
+func TestStatsDeltaPendingTable(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_stats_delta_pending_threshold = '0s'")
+	defer tk.MustExec("set global tidb_stats_delta_pending_threshold = default")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("create table tp (a int) partition by range (a) (partition p0 values less than (10), partition p1 values less than (20))")
+	tk.MustExec("create table t2 (a int)")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+
+	tk.MustExec("insert into t values (1), (2), (3)")
+	tk.MustExec("delete from t where a = 1")
+	tk.MustExec("insert into tp values (1), (11), (12)")
+	tk.MustExec("insert into t2 values (1)")
+	// The dropped tables are skipped.
+	tk.MustExec("drop table t2")
+	tk.MustQuery(`select table_schema, table_name, partition_name, modify_count, row_count_delta, first_change_time is not null, pending_seconds >= 0
+				from information_schema.stats_delta_pending order by table_name, partition_name`).Check(testkit.Rows(
+		"test t  4 2 1 1",
+		"test tp p0 1 1 1 1",
+		"test tp p1 2 2 1 1",
+	))
+	tk.MustExec("set global tidb_stats_delta_pending_threshold = '1h'")
+	tk.MustQuery("select count(*) from information_schema.stats_delta_pending").Check(testkit.Rows("0"))
+
+	// The persisted deltas are no longer pending.
+	tk.MustExec("set global tidb_stats_delta_pending_threshold = '0s'")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.MustQuery("select count(*) from information_schema.stats_delta_pending").Check(testkit.Rows("0"))
+}
+
+func TestEstimationErrorRankingTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int, b int, key idx_ab(a, b))")
+	tk.MustExec("create table t2 (a int)")
+	insertErrors := func(tableName, digest, operator string, samples int, estRows, actRows, qError float64, lastSeenAt string) {
+		tk.MustExec(`insert into mysql.stats_estimation_errors select ?, tidb_table_id, ?, ?, ?, ?, ?, `+lastSeenAt+`
+			from information_schema.tables where table_schema = 'test' and table_name = ?`,
+			digest, operator, samples, estRows, actRows, qError, tableName)
+	}
+	insertErrors("t1", "d1", "IndexRangeScan(idx_ab)", 2, 10, 110, 50, "now()")
+	insertErrors("t1", "d2", "IndexRangeScan(idx_ab)", 1, 5, 25, 5, "now()")
+	insertErrors("t1", "d1", "TableFullScan", 1, 100, 90, 1.1, "now()")
+	insertErrors("t2", "d3", "TableFullScan", 3, 300, 350, 2, "now()")
+	insertErrors("t2", "d4", "TableFullScan", 1, 10, 10000, 100, "now() - interval 2 day")
+	// The errors of the dropped tables are skipped.
+	tk.MustExec("insert into mysql.stats_estimation_errors values ('d5', 999999, 'TableFullScan', 1, 1, 1000, 500, now())")
+
+	tk.MustQuery(`select table_rank, table_name, table_cumulative_error, operator, column_names, digest_count, sample_count,
+				sum_est_rows, sum_act_rows, cumulative_error, max_q_error from information_schema.estimation_error_ranking`).Check(testkit.Rows(
+		"1 t1 130 IndexRangeScan(idx_ab) a,b 2 3 15 135 120 50",
+		"1 t1 130 TableFullScan  1 1 100 90 10 1.1",
+		"2 t2 50 TableFullScan  1 3 300 350 50 2",
+	))
+
+	// The errors out of the window are not counted.
+	tk.MustExec("set @@tidb_estimation_error_window = '72h'")
+	tk.MustQuery(`select distinct table_rank, table_name, table_cumulative_error from information_schema.estimation_error_ranking order by table_rank`).Check(testkit.Rows(
+		"1 t2 10040",
+		"2 t1 130",
+	))
+}
+
+func TestUnusedIndexAdviceTable(t *testing.T) {
+	testfailpoint.Enable(t, "github.com/pingcap/tidb/pkg/executor/mockIndexUsageStartTime", "return(84)")
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int, b int, c int, key ia(a), key ib(b), unique key uc(c))")
+	tk.MustExec("create table t2 (a int, key ia(a))")
+	tk.MustExec("insert into t1 values (1, 1, 1), (2, 2, 2)")
+	tk.MustExec("insert into t2 values (1)")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+
+	t1, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t1"))
+	require.NoError(t, err)
+	ia, ib := t1.Meta().FindIndexByName("ia"), t1.Meta().FindIndexByName("ib")
+	c := h.NewSessionIndexUsageCollector()
+	c.Update(t1.Meta().ID, ia.ID, indexusage.NewSample(1, 1, 1, 2))
+	c.Update(t1.Meta().ID, ib.ID, indexusage.Sample{QueryTotal: 1, LastUsedAt: time.Now().Add(-8 * 24 * time.Hour)})
+	c.Flush()
+	require.Eventually(t, func() bool {
+		return h.GetIndexUsage(t1.Meta().ID, ia.ID).QueryTotal == 1 && h.GetIndexUsage(t1.Meta().ID, ib.ID).QueryTotal == 1
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// The index used recently and the unique index aren't reported, the more write-heavy table comes first.
+	tk.MustQuery(`select table_name, index_name, last_access_time is null, table_rows, write_rows_per_day, confidence
+		from information_schema.unused_index_advice where table_schema = 'test'`).Check(testkit.Rows(
+		"t1 ib 0 2 2 0.5",
+		"t2 ia 1 1 1 0.5",
+	))
+
+	// The index used within the window isn't reported.
+	tk.MustExec("set @@tidb_unused_index_window = '240h'")
+	tk.MustQuery(`select table_name, index_name, confidence from information_schema.unused_index_advice
+		where table_schema = 'test'`).Check(testkit.Rows("t2 ia 0.35"))
+}
+
+func TestMissingIndexCandidatesTable(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int, d int, key(c))")
+	tk.MustExec("insert into t with recursive s(n) as (select 1 union all select n + 1 from s where n < 1000) select n, n, n, n from s")
+	tk.MustExec("analyze table t all columns")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpColStatsUsageToKV())
+
+	tk.MustQuery("select count(*) from t where a = 1").Check(testkit.Rows("1"))
+	tk.MustQuery("select count(*) from t where a = 2").Check(testkit.Rows("1"))
+	tk.MustQuery("select count(*) from t where b between 10 and 12").Check(testkit.Rows("3"))
+	tk.MustQuery("select count(*) from t use index() where c = 1").Check(testkit.Rows("1"))
+	tk.MustQuery("select count(*) from t where d > 100").Check(testkit.Rows("900"))
+	require.NoError(t, h.DumpColStatsUsageToKV())
+
+	// The indexed column and the column filtered by the wide range predicates only aren't candidates.
+	tk.MustQuery(`select column_name, point_predicates, narrow_range_predicates, wide_range_predicates, table_rows, ndv,
+		selectivity, estimated_benefit > 0, create_statement from information_schema.missing_index_candidates
+		where table_schema = 'test'`).Check(testkit.Rows(
+		"a 2 0 0 1000 1000 0.001 1 CREATE INDEX idx_a ON test.t(a);",
+		"b 0 1 0 1000 1000 0.001 1 CREATE INDEX idx_b ON test.t(b);",
+	))
+
+	tk.MustExec("create index ib on t(b)")
+	tk.MustQuery(`select column_name from information_schema.missing_index_candidates where table_schema = 'test'`).
+		Check(testkit.Rows("a"))
+}
+
 // https://github.com/pingcap/tidb/issues/32459.
+func TestMemoryUsageConsumersTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustQuery(`select name, shrinkable, shrink_last, shrink_total from information_schema.memory_usage_consumers
+				where name in ('stats cache', 'analyze', 'global stats merge', 'stats delta') order by name`).Check(testkit.Rows(
+		"analyze 1 <nil> 0",
+		"global stats merge 0 <nil> 0",
+		"stats cache 1 <nil> 0",
+		"stats delta 0 <nil> 0",
+	))
+	tk.MustQuery("select count(*) from information_schema.memory_usage_consumers where memory_usage < 0 or memory_max_used < memory_usage").Check(testkit.Rows("0"))
+}
+
 func TestJoinSystemTableContainsView(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
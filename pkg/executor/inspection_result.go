@@ -103,6 +103,7 @@ var inspectionRules = []inspectionRule{
 	&nodeLoadInspection{inspectionName: "node-load"},
 	&criticalErrorInspection{inspectionName: "critical-error"},
 	&thresholdCheckInspection{inspectionName: "threshold-check"},
+	&statsInspection{inspectionName: "stats"},
 }
 
 type inspectionResultRetriever struct {
@@ -742,3 +742,37 @@ func TestConfigCheckOfStorageBlockCacheSize(t *testing.T) {
 		"config storage.block-cache.capacity tikv 192.168.3.33  32212254720 < 24159191040 warning There are 2 TiKV server in 192.168.3.33 node, the total 'storage.block-cache.capacity' of TiKV is more than (0.45 * total node memory)",
 	))
 }
+
+func TestStatsInspection(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	// t1 has failed 3 times after the last success, t2 has only failed twice.
+	tk.MustExec(`insert into mysql.analyze_jobs (table_schema, table_name, job_info, start_time, end_time, state, instance) values
+		('test', 't1', 'auto analyze table all columns', '2025-01-01 09:00:00', '2025-01-01 09:01:00', 'failed', ''),
+		('test', 't1', 'auto analyze table all columns', '2025-01-01 10:00:00', '2025-01-01 10:01:00', 'finished', ''),
+		('test', 't1', 'auto analyze table all columns', '2025-01-01 11:00:00', '2025-01-01 11:01:00', 'failed', ''),
+		('test', 't1', 'auto analyze table all columns', '2025-01-01 12:00:00', '2025-01-01 12:01:00', 'failed', ''),
+		('test', 't1', 'auto analyze table all columns', '2025-01-01 13:00:00', '2025-01-01 13:01:00', 'failed', ''),
+		('test', 't2', 'auto analyze table all columns', '2025-01-01 11:00:00', '2025-01-01 11:01:00', 'failed', ''),
+		('test', 't2', 'auto analyze table all columns', '2025-01-01 12:00:00', '2025-01-01 12:01:00', 'failed', '')`)
+	tk.MustQuery("select item, value, reference, severity from information_schema.inspection_result where rule='stats' and item='auto-analyze-failure'").Check(testkit.Rows(
+		"auto-analyze-failure 3 < 3 warning",
+	))
+	tk.MustQuery("select count(*) from information_schema.inspection_result where rule='stats' and item='auto-analyze-failure' and details like '%`test`.`t1`%since 2025-01-01 11:00:00%'").Check(testkit.Rows("1"))
+
+	// The global stats are missing if the partitions are analyzed in the static prune mode.
+	tk.MustExec("create table tp (a int, b int) partition by hash(a) partitions 2")
+	tk.MustExec("insert into tp values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'static'")
+	tk.MustExec("analyze table tp")
+	tk.MustQuery("select count(*) from information_schema.inspection_result where rule='stats' and item='stale-global-stats'").Check(testkit.Rows("0"))
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustQuery("select item, value, reference from information_schema.inspection_result where rule='stats' and item='stale-global-stats'").Check(testkit.Rows(
+		"stale-global-stats missing up to date",
+	))
+	// The global stats are merged from the analyzed columns, so analyze all of them.
+	tk.MustExec("analyze table tp all columns")
+	tk.MustQuery("select count(*) from information_schema.inspection_result where rule='stats' and item='stale-global-stats'").Check(testkit.Rows("0"))
+}
@@ -0,0 +1,265 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/pingcap/tidb/pkg/domain/infosync"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/size"
+)
+
+const (
+	// pseudoStatsUsageThreshold is the number of the pseudo stats usages of a table in the last hour, above which the
+	// missing stats of the table are considered to hurt the queries.
+	pseudoStatsUsageThreshold = 100
+	// autoAnalyzeFailureThreshold is the number of the consecutive failures of the auto analyze of a table, above
+	// which the table is considered to be unable to be analyzed automatically.
+	autoAnalyzeFailureThreshold = 3
+	// statsSizeThreshold is the size of the buckets, the TopN and the CM sketches of a table, above which the stats
+	// are considered to be oversized, which slows down the loading and the caching of the stats.
+	statsSizeThreshold = 64 * size.MB
+)
+
+// statsInspection is used to check the health of the statistics, e.g. the pseudo stats used by heavy traffic,
+// the failing auto analyze, the oversized stats and the stale global stats.
+type statsInspection struct{ inspectionName }
+
+func (c statsInspection) inspect(ctx context.Context, sctx sessionctx.Context, filter inspectionFilter) []inspectionResult {
+	inspects := []func(context.Context, sessionctx.Context, inspectionFilter) []inspectionResult{
+		c.inspectPseudoStatsUsage,
+		c.inspectAutoAnalyzeFailure,
+		c.inspectStatsSize,
+		c.inspectStaleGlobalStats,
+	}
+	var results []inspectionResult
+	for _, inspect := range inspects {
+		re := inspect(ctx, sctx, filter)
+		results = append(results, re...)
+	}
+	return results
+}
+
+func (statsInspection) inspectPseudoStatsUsage(ctx context.Context, sctx sessionctx.Context, filter inspectionFilter) []inspectionResult {
+	const name = "pseudo-stats-usage"
+	if !filter.enable(name) {
+		return nil
+	}
+	exec := sctx.GetRestrictedSQLExecutor()
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, "select table_schema, table_name, pseudo_stats_usage_last_hour from information_schema.stats_health_summary where pseudo_stats_usage_last_hour >= %?", pseudoStatsUsageThreshold)
+	if err != nil {
+		sctx.GetSessionVars().StmtCtx.AppendWarning(fmt.Errorf("check pseudo stats usage failed: %v", err))
+		return nil
+	}
+	// The pseudo stats usages are counted by each TiDB instance.
+	var instance string
+	if serverInfo, err := infosync.GetServerInfo(); err == nil {
+		instance = net.JoinHostPort(serverInfo.IP, strconv.Itoa(int(serverInfo.Port)))
+	}
+	results := make([]inspectionResult, 0, len(rows))
+	for _, row := range rows {
+		usage := row.GetInt64(2)
+		results = append(results, inspectionResult{
+			tp:       "tidb",
+			instance: instance,
+			item:     name,
+			actual:   strconv.FormatInt(usage, 10),
+			expected: fmt.Sprintf("< %d", pseudoStatsUsageThreshold),
+			severity: "warning",
+			detail: fmt.Sprintf("the optimizer used the pseudo stats of `%s`.`%s` %d times in the last hour, "+
+				"execute the sql to collect the stats: analyze table `%s`.`%s`",
+				row.GetString(0), row.GetString(1), usage, row.GetString(0), row.GetString(1)),
+			degree: float64(usage),
+		})
+	}
+	return results
+}
+
+func (statsInspection) inspectAutoAnalyzeFailure(ctx context.Context, sctx sessionctx.Context, filter inspectionFilter) []inspectionResult {
+	const name = "auto-analyze-failure"
+	if !filter.enable(name) {
+		return nil
+	}
+	// The failures after the last success of the auto analyze of each table or partition.
+	sql := `select table_schema, table_name, partition_name, count(*), min(start_time)
+		from mysql.analyze_jobs j
+		where state = 'failed' and job_info like 'auto analyze%%'
+			and end_time > ifnull((select max(end_time) from mysql.analyze_jobs s
+				where s.state = 'finished' and s.job_info like 'auto analyze%%' and s.table_schema = j.table_schema
+					and s.table_name = j.table_name and s.partition_name = j.partition_name), '1970-01-01')
+		group by table_schema, table_name, partition_name
+		having count(*) >= %?`
+	exec := sctx.GetRestrictedSQLExecutor()
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, sql, autoAnalyzeFailureThreshold)
+	if err != nil {
+		sctx.GetSessionVars().StmtCtx.AppendWarning(fmt.Errorf("check auto analyze failure failed: %v", err))
+		return nil
+	}
+	results := make([]inspectionResult, 0, len(rows))
+	for _, row := range rows {
+		tableName := fmt.Sprintf("`%s`.`%s`", row.GetString(0), row.GetString(1))
+		if partition := row.GetString(2); partition != "" {
+			tableName += fmt.Sprintf(" partition (`%s`)", partition)
+		}
+		failures := row.GetInt64(3)
+		var since string
+		if !row.IsNull(4) {
+			since = row.GetTime(4).String()
+		}
+		results = append(results, inspectionResult{
+			tp:       "tidb",
+			item:     name,
+			actual:   strconv.FormatInt(failures, 10),
+			expected: fmt.Sprintf("< %d", autoAnalyzeFailureThreshold),
+			severity: "warning",
+			detail: fmt.Sprintf("the auto analyze of %s has failed %d times since %s without success, "+
+				"execute the sql to see the fail reasons and fix them: select * from mysql.analyze_jobs "+
+				"where table_schema = '%s' and table_name = '%s' and state = 'failed'",
+				tableName, failures, since, row.GetString(0), row.GetString(1)),
+			degree: float64(failures),
+		})
+	}
+	return results
+}
+
+func (statsInspection) inspectStatsSize(ctx context.Context, sctx sessionctx.Context, filter inspectionFilter) []inspectionResult {
+	const name = "stats-size"
+	if !filter.enable(name) {
+		return nil
+	}
+	sql := `select table_id, sum(size) from (
+			select table_id, length(lower_bound) + length(upper_bound) as size from mysql.stats_buckets
+			union all select table_id, length(value) from mysql.stats_top_n
+			union all select table_id, ifnull(length(cm_sketch), 0) from mysql.stats_histograms
+		) t group by table_id having sum(size) > %?`
+	exec := sctx.GetRestrictedSQLExecutor()
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, sql, statsSizeThreshold)
+	if err != nil {
+		sctx.GetSessionVars().StmtCtx.AppendWarning(fmt.Errorf("check stats size failed: %v", err))
+		return nil
+	}
+	is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+	results := make([]inspectionResult, 0, len(rows))
+	for _, row := range rows {
+		tableName, ok := inspectionStatsTableName(is, row.GetInt64(0))
+		if !ok {
+			// The stats of the dropped tables are removed by the stats GC.
+			continue
+		}
+		statsSize, err := row.GetMyDecimal(1).ToInt()
+		if err != nil {
+			continue
+		}
+		results = append(results, inspectionResult{
+			tp:       "tidb",
+			item:     name,
+			actual:   fmt.Sprintf("%.2fMiB", float64(statsSize)/float64(size.MB)),
+			expected: fmt.Sprintf("<= %dMiB", statsSizeThreshold/size.MB),
+			severity: "warning",
+			detail: fmt.Sprintf("the stats of %s are oversized, which slows down the loading of the stats, "+
+				"analyze the table with fewer buckets and TopN, or only analyze the predicate columns", tableName),
+			degree: float64(statsSize),
+		})
+	}
+	return results
+}
+
+func (statsInspection) inspectStaleGlobalStats(ctx context.Context, sctx sessionctx.Context, filter inspectionFilter) []inspectionResult {
+	const name = "stale-global-stats"
+	if !filter.enable(name) || !sctx.GetSessionVars().IsDynamicPartitionPruneEnabled() {
+		return nil
+	}
+	// The versions are read from the storage since the stats cache may not be updated after analyze yet. Like the stats
+	// cache does, the last analyze version of a table is the latest version of its analyzed columns and indexes, or the
+	// snapshot of the last analyze if none of them is analyzed, e.g. the table has no predicate columns.
+	const sql = `select m.table_id, ifnull(max(h.version), m.snapshot) from mysql.stats_meta m
+		left join mysql.stats_histograms h on h.table_id = m.table_id and h.stats_ver > 0
+		group by m.table_id, m.snapshot`
+	exec := sctx.GetRestrictedSQLExecutor()
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, sql)
+	if err != nil {
+		sctx.GetSessionVars().StmtCtx.AppendWarning(fmt.Errorf("check stale global stats failed: %v", err))
+		return nil
+	}
+	analyzeVersions := make(map[int64]uint64, len(rows))
+	for _, row := range rows {
+		analyzeVersions[row.GetInt64(0)] = row.GetUint64(1)
+	}
+	is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+	var results []inspectionResult
+	for _, db := range is.AllSchemaNames() {
+		if util.IsMemOrSysDB(db.L) {
+			continue
+		}
+		tbls, err := is.SchemaTableInfos(ctx, db)
+		if err != nil {
+			sctx.GetSessionVars().StmtCtx.AppendWarning(fmt.Errorf("check stale global stats failed: %v", err))
+			return results
+		}
+		for _, tbl := range tbls {
+			pi := tbl.GetPartitionInfo()
+			if pi == nil {
+				continue
+			}
+			var latestPartitionVersion uint64
+			for _, def := range pi.Definitions {
+				latestPartitionVersion = max(latestPartitionVersion, analyzeVersions[def.ID])
+			}
+			if latestPartitionVersion == 0 {
+				continue
+			}
+			globalVersion := analyzeVersions[tbl.ID]
+			if globalVersion >= latestPartitionVersion {
+				continue
+			}
+			actual := "missing"
+			if globalVersion > 0 {
+				actual = "stale"
+			}
+			results = append(results, inspectionResult{
+				tp:       "tidb",
+				item:     name,
+				actual:   actual,
+				expected: "up to date",
+				severity: "warning",
+				detail: fmt.Sprintf("the global stats of `%s`.`%s` are older than the stats of its partitions, "+
+					"the plans of the dynamic prune mode may be suboptimal, execute the sql to rebuild the global stats: "+
+					"analyze table `%s`.`%s`", db.O, tbl.Name.O, db.O, tbl.Name.O),
+			})
+		}
+	}
+	return results
+}
+
+// inspectionStatsTableName returns the name of the table or the partition of the physical ID.
+func inspectionStatsTableName(is infoschema.InfoSchema, physicalID int64) (string, bool) {
+	if tbl, ok := is.TableByID(context.Background(), physicalID); ok {
+		db, ok := is.SchemaByID(tbl.Meta().DBID)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("`%s`.`%s`", db.Name.O, tbl.Meta().Name.O), true
+	}
+	if tbl, db, def := is.FindTableByPartitionID(physicalID); tbl != nil {
+		return fmt.Sprintf("`%s`.`%s` partition (`%s`)", db.Name.O, tbl.Meta().Name.O, def.Name.O), true
+	}
+	return "", false
+}
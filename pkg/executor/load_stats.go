@@ -17,11 +17,14 @@ package executor
 import (
 	"context"
 	"encoding/json"
+	"maps"
+	"slices"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/executor/internal/exec"
 	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/plugin"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/statistics/util"
 	"github.com/pingcap/tidb/pkg/util/chunk"
@@ -77,8 +80,9 @@ func (*LoadStatsExec) Open(context.Context) error {
 	return nil
 }
 
-// Update updates the stats of the corresponding table according to the data.
-func (e *LoadStatsInfo) Update(data []byte) error {
+// Update updates the stats of the corresponding table according to the data. ctx is the context of the LOAD STATS
+// statement, which is passed to the audit plugins.
+func (e *LoadStatsInfo) Update(ctx context.Context, data []byte) error {
 	jsonTbl := &util.JSONTable{}
 	if err := json.Unmarshal(data, jsonTbl); err != nil {
 		return errors.Trace(err)
@@ -92,5 +96,18 @@ func (e *LoadStatsInfo) Update(data []byte) error {
 	if h == nil {
 		return errors.New("Load Stats: handle is nil")
 	}
-	return h.LoadStatsFromJSON(context.Background(), e.Ctx.GetInfoSchema().(infoschema.InfoSchema), jsonTbl, 0)
+	is := e.Ctx.GetInfoSchema().(infoschema.InfoSchema)
+	if jsonTbl.PredicateColumnsOnly {
+		return h.LoadColumnStatsUsageFromJSON(is, jsonTbl)
+	}
+	if err := h.LoadStatsFromJSON(context.Background(), is, jsonTbl, 0); err != nil {
+		return err
+	}
+	info := &plugin.StatsEventInfo{DBName: jsonTbl.DatabaseName, TableName: jsonTbl.TableName, Scope: plugin.StatsScopeTable}
+	if len(jsonTbl.Partitions) > 0 {
+		info.Scope = plugin.StatsScopePartition
+		info.Items = slices.Sorted(maps.Keys(jsonTbl.Partitions))
+	}
+	plugin.NotifyStatsEvent(ctx, e.Ctx.GetSessionVars(), plugin.LoadStats, []*plugin.StatsEventInfo{info})
+	return nil
 }
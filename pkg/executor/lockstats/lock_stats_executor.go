@@ -17,15 +17,21 @@ package lockstats
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/executor/internal/exec"
 	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/plugin"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessiontxn/staleread"
 	"github.com/pingcap/tidb/pkg/statistics/handle/types"
 	"github.com/pingcap/tidb/pkg/table/tables"
 	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/tikv/client-go/v2/oracle"
 )
 
 var _ exec.Executor = &LockExec{}
@@ -37,10 +43,17 @@ type LockExec struct {
 	// It might contain partition names if we are locking partitions.
 	// When locking partitions, Tables will only contain one table name.
 	Tables []*ast.TableName
+	// ColumnNames is the list of columns to be locked.
+	// When locking columns, Tables will only contain one table name.
+	ColumnNames []ast.CIStr
+	// ExpireOption is the expiration of the lock. It's nil if the lock never expires.
+	ExpireOption *ast.LockStatsExpireOption
+	// Comment is the reason of the lock.
+	Comment string
 }
 
 // Next implements the Executor Next interface.
-func (e *LockExec) Next(_ context.Context, _ *chunk.Chunk) error {
+func (e *LockExec) Next(ctx context.Context, _ *chunk.Chunk) error {
 	do := domain.GetDomain(e.Ctx())
 	h := do.StatsHandle()
 	if h == nil {
@@ -50,8 +63,27 @@ func (e *LockExec) Next(_ context.Context, _ *chunk.Chunk) error {
 		return errors.New("Lock Stats: table should not empty")
 	}
 	is := do.InfoSchema()
+	expireVersion, err := e.expireVersion(ctx)
+	if err != nil {
+		return err
+	}
+	audit := statsLockAudit(e.Ctx(), e.Comment)
 
-	if e.onlyLockPartitions() {
+	if len(e.ColumnNames) > 0 {
+		table := e.Tables[0]
+		tid, colIDNames, err := populateColumnIDAndNames(table, e.ColumnNames, is)
+		if err != nil {
+			return err
+		}
+		tableName := fmt.Sprintf("%s.%s", table.Schema.L, table.Name.L)
+		msg, err := h.LockColumns(tid, tableName, colIDNames, audit)
+		if err != nil {
+			return err
+		}
+		if msg != "" {
+			e.Ctx().GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError(msg))
+		}
+	} else if e.onlyLockPartitions() {
 		table := e.Tables[0]
 		tid, pidNames, err := populatePartitionIDAndNames(table, table.PartitionNames, is)
 		if err != nil {
@@ -59,7 +91,7 @@ func (e *LockExec) Next(_ context.Context, _ *chunk.Chunk) error {
 		}
 
 		tableName := fmt.Sprintf("%s.%s", table.Schema.L, table.Name.L)
-		msg, err := h.LockPartitions(tid, tableName, pidNames)
+		msg, err := h.LockPartitions(tid, tableName, pidNames, expireVersion, audit)
 		if err != nil {
 			return err
 		}
@@ -72,7 +104,7 @@ func (e *LockExec) Next(_ context.Context, _ *chunk.Chunk) error {
 			return err
 		}
 
-		msg, err := h.LockTables(tableWithPartitions)
+		msg, err := h.LockTables(tableWithPartitions, expireVersion, audit)
 		if err != nil {
 			return err
 		}
@@ -81,9 +113,66 @@ func (e *LockExec) Next(_ context.Context, _ *chunk.Chunk) error {
 		}
 	}
 
+	notifyStatsEvent(ctx, e.Ctx(), plugin.LockStats, e.Tables, e.ColumnNames)
 	return nil
 }
 
+// expireVersion returns the TSO after which the lock expires, 0 means the lock never expires.
+func (e *LockExec) expireVersion(ctx context.Context) (uint64, error) {
+	if e.ExpireOption == nil {
+		return 0, nil
+	}
+	tsExpr := e.ExpireOption.Expr
+	if e.ExpireOption.Tp == ast.LockStatsExpireFor {
+		tsExpr = &ast.FuncCallExpr{
+			FnName: ast.NewCIStr("DATE_ADD"),
+			Args: []ast.ExprNode{
+				&ast.FuncCallExpr{FnName: ast.NewCIStr("CURRENT_TIMESTAMP")},
+				e.ExpireOption.Expr,
+				&ast.TimeUnitExpr{Unit: e.ExpireOption.Unit},
+			},
+		}
+	}
+	expireVersion, err := staleread.CalculateAsOfTsExpr(ctx, e.Ctx().GetPlanCtx(), tsExpr)
+	if err != nil {
+		return 0, err
+	}
+	if expireVersion <= oracle.GoTimeToTS(time.Now()) {
+		return 0, errors.New("Lock Stats: the expiration should be in the future")
+	}
+	return expireVersion, nil
+}
+
+// statsLockAudit returns the audit information of the lock or unlock operation executed by the session.
+func statsLockAudit(sctx sessionctx.Context, comment string) *types.StatsLockAudit {
+	audit := &types.StatsLockAudit{Reason: comment}
+	if user := sctx.GetSessionVars().User; user != nil {
+		audit.User = user.String()
+	}
+	return audit
+}
+
+// notifyStatsEvent notifies the audit plugins of the tables whose stats are locked or unlocked.
+func notifyStatsEvent(ctx context.Context, sctx sessionctx.Context, event plugin.StatsEvent, tables []*ast.TableName, columnNames []ast.CIStr) {
+	infos := make([]*plugin.StatsEventInfo, 0, len(tables))
+	for _, table := range tables {
+		info := &plugin.StatsEventInfo{DBName: table.Schema.O, TableName: table.Name.O, Scope: plugin.StatsScopeTable}
+		if len(columnNames) > 0 {
+			info.Scope = plugin.StatsScopeColumn
+			for _, col := range columnNames {
+				info.Items = append(info.Items, col.O)
+			}
+		} else if len(table.PartitionNames) > 0 {
+			info.Scope = plugin.StatsScopePartition
+			for _, partition := range table.PartitionNames {
+				info.Items = append(info.Items, partition.O)
+			}
+		}
+		infos = append(infos, info)
+	}
+	plugin.NotifyStatsEvent(ctx, sctx.GetSessionVars(), event, infos)
+}
+
 func (e *LockExec) onlyLockPartitions() bool {
 	return len(e.Tables) == 1 && len(e.Tables[0].PartitionNames) > 0
 }
@@ -130,6 +219,29 @@ func populatePartitionIDAndNames(
 	return tbl.Meta().ID, pidNames, nil
 }
 
+// populateColumnIDAndNames returns the table ID and column IDs for the given table name and column names.
+func populateColumnIDAndNames(
+	table *ast.TableName,
+	columnNames []ast.CIStr,
+	is infoschema.InfoSchema,
+) (int64, map[int64]string, error) {
+	tbl, err := is.TableByName(context.Background(), table.Schema, table.Name)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	colIDNames := make(map[int64]string, len(columnNames))
+	for _, columnName := range columnNames {
+		col := model.FindColumnInfo(tbl.Meta().Columns, columnName.L)
+		if col == nil {
+			return 0, nil, infoschema.ErrColumnNotExists.GenWithStackByArgs(columnName.O, table.Name.O)
+		}
+		colIDNames[col.ID] = col.Name.L
+	}
+
+	return tbl.Meta().ID, colIDNames, nil
+}
+
 // populateTableAndPartitionIDs returns the lockstats.TableInfo for the given table names.
 func populateTableAndPartitionIDs(
 	tables []*ast.TableName,
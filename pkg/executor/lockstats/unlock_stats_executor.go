@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/executor/internal/exec"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/plugin"
 	"github.com/pingcap/tidb/pkg/util/chunk"
 )
 
@@ -34,10 +35,15 @@ type UnlockExec struct {
 	// It might contain partition names if we are unlocking partitions.
 	// When unlocking partitions, Tables will only contain one table name.
 	Tables []*ast.TableName
+	// ColumnNames is the list of columns to be unlocked.
+	// When unlocking columns, Tables will only contain one table name.
+	ColumnNames []ast.CIStr
+	// Comment is the reason of the unlock.
+	Comment string
 }
 
 // Next implements the Executor Next interface.
-func (e *UnlockExec) Next(context.Context, *chunk.Chunk) error {
+func (e *UnlockExec) Next(ctx context.Context, _ *chunk.Chunk) error {
 	do := domain.GetDomain(e.Ctx())
 	h := do.StatsHandle()
 	if h == nil {
@@ -47,15 +53,30 @@ func (e *UnlockExec) Next(context.Context, *chunk.Chunk) error {
 		return errors.New("Unlock Stats: table should not empty ")
 	}
 	is := do.InfoSchema()
+	audit := statsLockAudit(e.Ctx(), e.Comment)
 
-	if e.onlyUnlockPartitions() {
+	if len(e.ColumnNames) > 0 {
+		table := e.Tables[0]
+		tid, colIDNames, err := populateColumnIDAndNames(table, e.ColumnNames, is)
+		if err != nil {
+			return err
+		}
+		tableName := fmt.Sprintf("%s.%s", table.Schema.O, table.Name.O)
+		msg, err := h.RemoveLockedColumns(tid, tableName, colIDNames, audit)
+		if err != nil {
+			return err
+		}
+		if msg != "" {
+			e.Ctx().GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError(msg))
+		}
+	} else if e.onlyUnlockPartitions() {
 		table := e.Tables[0]
 		tid, pidNames, err := populatePartitionIDAndNames(table, table.PartitionNames, is)
 		if err != nil {
 			return err
 		}
 		tableName := fmt.Sprintf("%s.%s", table.Schema.O, table.Name.O)
-		msg, err := h.RemoveLockedPartitions(tid, tableName, pidNames)
+		msg, err := h.RemoveLockedPartitions(tid, tableName, pidNames, audit)
 		if err != nil {
 			return err
 		}
@@ -67,7 +88,7 @@ func (e *UnlockExec) Next(context.Context, *chunk.Chunk) error {
 		if err != nil {
 			return err
 		}
-		msg, err := h.RemoveLockedTables(tableWithPartitions)
+		msg, err := h.RemoveLockedTables(tableWithPartitions, audit)
 		if err != nil {
 			return err
 		}
@@ -76,6 +97,7 @@ func (e *UnlockExec) Next(context.Context, *chunk.Chunk) error {
 		}
 	}
 
+	notifyStatsEvent(ctx, e.Ctx(), plugin.UnlockStats, e.Tables, e.ColumnNames)
 	return nil
 }
 
@@ -165,10 +165,14 @@ func (e *SetExecutor) setSysVariable(ctx context.Context, name string, v *expres
 			return nil
 		})
 		showValStr := valStr
-		if name == vardef.TiDBCloudStorageURI {
+		if name == vardef.TiDBCloudStorageURI || name == vardef.TiDBStatsHealthReportStorageURI {
 			showValStr = ast.RedactURL(showValStr)
 		}
 		logutil.BgLogger().Info("set global var", zap.Uint64("conn", sessionVars.ConnectionID), zap.String("name", name), zap.String("val", showValStr))
+		if name == vardef.TiDBPartitionPruneMode && vardef.BuildGlobalStatsOnDynamicPruneMode.Load() && vardef.RunAutoAnalyze.Load() &&
+			variable.PartitionPruneMode(strings.ToLower(valStr)).Update() == variable.Dynamic {
+			sessionVars.StmtCtx.AppendNote(errors.NewNoStackError("The missing global stats of the partitioned tables will be built by auto-analyze, use SHOW ANALYZE STATUS to check the progress"))
+		}
 		if name == vardef.TiDBServiceScope {
 			dom := domain.GetDomain(e.Ctx())
 			oldConfig := config.GetGlobalConfig()
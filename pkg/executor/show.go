@@ -62,6 +62,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/sessiontxn"
+	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
 	"github.com/pingcap/tidb/pkg/store/helper"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/table/tables"
@@ -114,8 +115,12 @@ type ShowExec struct {
 	IfNotExists bool // Used for `show create database if not exists`
 	GlobalScope bool // GlobalScope is used by show variables
 	Extended    bool // Used for `show extended columns from ...`
+	WithStats   bool // Used for `show create table ... with stats`
+	RawStats    bool // Used for `show stats_buckets|stats_topn raw`
 
 	ImportJobID *int64
+
+	StatsFilter *handleutil.ShowStatsFilter // Used for SHOW STATS_META/HISTOGRAMS/BUCKETS/TOPN.
 }
 
 type showTableRegionRowItem struct {
@@ -184,7 +189,7 @@ func (e *ShowExec) fetchAll(ctx context.Context) error {
 	case ast.ShowConfig:
 		return e.fetchShowClusterConfigs()
 	case ast.ShowCreateTable:
-		return e.fetchShowCreateTable()
+		return e.fetchShowCreateTable(ctx)
 	case ast.ShowCreateSequence:
 		return e.fetchShowCreateSequence()
 	case ast.ShowCreateUser:
@@ -1518,7 +1523,7 @@ func (e *ShowExec) fetchShowClusterConfigs() error {
 	return nil
 }
 
-func (e *ShowExec) fetchShowCreateTable() error {
+func (e *ShowExec) fetchShowCreateTable(ctx context.Context) error {
 	tb, err := e.getTable()
 	if err != nil {
 		return errors.Trace(err)
@@ -1534,6 +1539,11 @@ func (e *ShowExec) fetchShowCreateTable() error {
 		e.appendRow([]any{tableInfo.Name.O, buf.String(), tableInfo.Charset, tableInfo.Collate})
 		return nil
 	}
+	if e.WithStats && !tableInfo.IsSequence() {
+		if err = e.appendStatsForShowCreateTable(ctx, tableInfo, &buf); err != nil {
+			return err
+		}
+	}
 
 	e.appendRow([]any{tableInfo.Name.O, buf.String()})
 	return nil
@@ -15,13 +15,18 @@
 package executor
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
@@ -96,6 +101,17 @@ func (e *ShowExec) appendTableForStatsExtended(dbName string, tbl *model.TableIn
 		case ast.StatsTypeCardinality:
 			statsType = "cardinality"
 			statsVal = item.StringVals
+		case ast.StatsTypeTrigram:
+			statsType = "trigram"
+			statsVal = item.StringVals
+		case ast.StatsTypeJoinSketch:
+			statsType = "join_sketch"
+			statsVal = item.StringVals
+		case ast.StatsTypeExpression:
+			statsType = "expression"
+			if item.ExprStats != nil {
+				statsVal = item.ExprStats.Expr
+			}
 		}
 		e.appendRow([]any{
 			dbName,
@@ -110,31 +126,52 @@ func (e *ShowExec) appendTableForStatsExtended(dbName string, tbl *model.TableIn
 	}
 }
 
-func (e *ShowExec) fetchShowStatsMeta(ctx context.Context) error {
+// forEachStatsTableToShow calls fn with the stats of the tables and the partitions matched by e.StatsFilter, and stops
+// once the number of the rows reaches the limit of e.StatsFilter.
+func (e *ShowExec) forEachStatsTableToShow(ctx context.Context, fn func(dbName, tblName, partitionName string, statsTbl *statistics.Table) error) error {
 	do := domain.GetDomain(e.Ctx())
 	h := do.StatsHandle()
+	filter := e.StatsFilter
 	dbs := do.InfoSchema().AllSchemaNames()
 	for _, db := range dbs {
+		if !filter.MatchDB(db.O) {
+			continue
+		}
 		tables, err := do.InfoSchema().SchemaTableInfos(ctx, db)
 		if err != nil {
 			return errors.Trace(err)
 		}
 		for _, tbl := range tables {
+			if !filter.MatchTable(tbl.Name.O) {
+				continue
+			}
 			pi := tbl.GetPartitionInfo()
 			if pi == nil || e.Ctx().GetSessionVars().IsDynamicPartitionPruneEnabled() {
 				partitionName := ""
 				if pi != nil {
 					partitionName = "global"
 				}
-				e.appendTableForStatsMeta(db.O, tbl.Name.O, partitionName, h.GetTableStats(tbl))
-				if pi != nil {
-					for _, def := range pi.Definitions {
-						e.appendTableForStatsMeta(db.O, tbl.Name.O, def.Name.O, h.GetPartitionStats(tbl, def.ID))
+				if filter.MatchPartition(partitionName) {
+					if err := fn(db.O, tbl.Name.O, partitionName, h.GetTableStats(tbl)); err != nil {
+						return err
+					}
+					if filter.LimitReached(e.result.NumRows()) {
+						return nil
 					}
 				}
-			} else {
-				for _, def := range pi.Definitions {
-					e.appendTableForStatsMeta(db.O, tbl.Name.O, def.Name.O, h.GetPartitionStats(tbl, def.ID))
+				if pi == nil {
+					continue
+				}
+			}
+			for _, def := range pi.Definitions {
+				if !filter.MatchPartition(def.Name.O) {
+					continue
+				}
+				if err := fn(db.O, tbl.Name.O, def.Name.O, h.GetPartitionStats(tbl, def.ID)); err != nil {
+					return err
+				}
+				if filter.LimitReached(e.result.NumRows()) {
+					return nil
 				}
 			}
 		}
@@ -142,6 +179,13 @@ func (e *ShowExec) fetchShowStatsMeta(ctx context.Context) error {
 	return nil
 }
 
+func (e *ShowExec) fetchShowStatsMeta(ctx context.Context) error {
+	return e.forEachStatsTableToShow(ctx, func(dbName, tblName, partitionName string, statsTbl *statistics.Table) error {
+		e.appendTableForStatsMeta(dbName, tblName, partitionName, statsTbl)
+		return nil
+	})
+}
+
 func (e *ShowExec) appendTableForStatsMeta(dbName, tblName, partitionName string, statsTbl *statistics.Table) {
 	if statsTbl.Pseudo {
 		return
@@ -169,12 +213,21 @@ func (e *ShowExec) appendTableForStatsMeta(dbName, tblName, partitionName string
 	}
 }
 
-func (e *ShowExec) appendTableForStatsLocked(dbName, tblName, partitionName string) {
+func (e *ShowExec) appendTableForStatsLocked(dbName, tblName, partitionName string, lockVersion uint64) {
+	// The lock time is unknown if the lock is not recorded in the lock history,
+	// e.g. the lock is created before the lock history is introduced.
+	var lockTime, lockAge any
+	if lockVersion > 0 {
+		lockTime = e.versionToTime(lockVersion)
+		lockAge = time.Since(oracle.GetTimeFromTS(lockVersion)).Round(time.Second).String()
+	}
 	e.appendRow([]any{
 		dbName,
 		tblName,
 		partitionName,
 		"locked",
+		lockTime,
+		lockAge,
 	})
 }
 
@@ -187,6 +240,7 @@ func (e *ShowExec) fetchShowStatsLocked(ctx context.Context) error {
 		dbName        string
 		tblName       string
 		partitionName string
+		tableID       int64
 	}
 	tableInfo := make(map[int64]*LockedTableInfo)
 
@@ -202,15 +256,15 @@ func (e *ShowExec) fetchShowStatsLocked(ctx context.Context) error {
 				if pi != nil {
 					partitionName = "global"
 				}
-				tableInfo[tbl.ID] = &LockedTableInfo{db.O, tbl.Name.O, partitionName}
+				tableInfo[tbl.ID] = &LockedTableInfo{db.O, tbl.Name.O, partitionName, tbl.ID}
 				if pi != nil {
 					for _, def := range pi.Definitions {
-						tableInfo[def.ID] = &LockedTableInfo{db.O, tbl.Name.O, def.Name.O}
+						tableInfo[def.ID] = &LockedTableInfo{db.O, tbl.Name.O, def.Name.O, tbl.ID}
 					}
 				}
 			} else {
 				for _, def := range pi.Definitions {
-					tableInfo[def.ID] = &LockedTableInfo{db.O, tbl.Name.O, def.Name.O}
+					tableInfo[def.ID] = &LockedTableInfo{db.O, tbl.Name.O, def.Name.O, tbl.ID}
 				}
 			}
 		}
@@ -225,13 +279,27 @@ func (e *ShowExec) fetchShowStatsLocked(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	// The logical tables are also queried, because the partitions of a locked table may have no record.
+	versionIDs := make([]int64, 0, len(tids)*2)
+	for tid, info := range tableInfo {
+		versionIDs = append(versionIDs, tid, info.tableID)
+	}
+	lockVersions, err := h.GetTablesLockVersion(versionIDs...)
+	if err != nil {
+		return err
+	}
 
 	// Sort the table IDs to make the output stable.
 	slices.Sort(tids)
 	for _, tid := range tids {
 		if _, ok := lockedTables[tid]; ok {
 			info := tableInfo[tid]
-			e.appendTableForStatsLocked(info.dbName, info.tblName, info.partitionName)
+			lockVersion := lockVersions[tid]
+			// The partitions created after the whole table is locked are locked along with the table.
+			if lockVersion == 0 && info.tableID != tid {
+				lockVersion = lockVersions[info.tableID]
+			}
+			e.appendTableForStatsLocked(info.dbName, info.tblName, info.partitionName, lockVersion)
 		}
 	}
 
@@ -239,35 +307,10 @@ func (e *ShowExec) fetchShowStatsLocked(ctx context.Context) error {
 }
 
 func (e *ShowExec) fetchShowStatsHistogram(ctx context.Context) error {
-	do := domain.GetDomain(e.Ctx())
-	h := do.StatsHandle()
-	dbs := do.InfoSchema().AllSchemaNames()
-	for _, db := range dbs {
-		tables, err := do.InfoSchema().SchemaTableInfos(ctx, db)
-		if err != nil {
-			return errors.Trace(err)
-		}
-		for _, tbl := range tables {
-			pi := tbl.GetPartitionInfo()
-			if pi == nil || e.Ctx().GetSessionVars().IsDynamicPartitionPruneEnabled() {
-				partitionName := ""
-				if pi != nil {
-					partitionName = "global"
-				}
-				e.appendTableForStatsHistograms(db.O, tbl.Name.O, partitionName, h.GetTableStats(tbl))
-				if pi != nil {
-					for _, def := range pi.Definitions {
-						e.appendTableForStatsHistograms(db.O, tbl.Name.O, def.Name.O, h.GetPartitionStats(tbl, def.ID))
-					}
-				}
-			} else {
-				for _, def := range pi.Definitions {
-					e.appendTableForStatsHistograms(db.O, tbl.Name.O, def.Name.O, h.GetPartitionStats(tbl, def.ID))
-				}
-			}
-		}
-	}
-	return nil
+	return e.forEachStatsTableToShow(ctx, func(dbName, tblName, partitionName string, statsTbl *statistics.Table) error {
+		e.appendTableForStatsHistograms(dbName, tblName, partitionName, statsTbl)
+		return nil
+	})
 }
 
 func (e *ShowExec) appendTableForStatsHistograms(dbName, tblName, partitionName string, statsTbl *statistics.Table) {
@@ -275,7 +318,7 @@ func (e *ShowExec) appendTableForStatsHistograms(dbName, tblName, partitionName
 		return
 	}
 	statsTbl.ForEachColumnImmutable(func(_ int64, col *statistics.Column) bool {
-		if !col.IsStatsInitialized() {
+		if !col.IsStatsInitialized() || !e.StatsFilter.MatchColumn(col.Info.Name.O) {
 			return false
 		}
 		e.histogramToRow(dbName, tblName, partitionName, col.Info.Name.O, 0, col.Histogram, cardinality.AvgColSize(col, statsTbl.RealtimeCount, false),
@@ -283,7 +326,7 @@ func (e *ShowExec) appendTableForStatsHistograms(dbName, tblName, partitionName
 		return false
 	})
 	statsTbl.ForEachIndexImmutable(func(_ int64, idx *statistics.Index) bool {
-		if !idx.IsStatsInitialized() {
+		if !idx.IsStatsInitialized() || !e.StatsFilter.MatchColumn(idx.Info.Name.O) {
 			return false
 		}
 		e.histogramToRow(dbName, tblName, partitionName, idx.Info.Name.O, 1, idx.Histogram, 0,
@@ -319,57 +362,30 @@ func (*ShowExec) versionToTime(version uint64) types.Time {
 }
 
 func (e *ShowExec) fetchShowStatsBuckets(ctx context.Context) error {
-	do := domain.GetDomain(e.Ctx())
-	h := do.StatsHandle()
-	dbs := do.InfoSchema().AllSchemaNames()
-	for _, db := range dbs {
-		tables, err := do.InfoSchema().SchemaTableInfos(ctx, db)
-		if err != nil {
-			return errors.Trace(err)
-		}
-		for _, tbl := range tables {
-			pi := tbl.GetPartitionInfo()
-			if pi == nil || e.Ctx().GetSessionVars().IsDynamicPartitionPruneEnabled() {
-				partitionName := ""
-				if pi != nil {
-					partitionName = "global"
-				}
-				if err := e.appendTableForStatsBuckets(db.O, tbl.Name.O, partitionName, h.GetTableStats(tbl)); err != nil {
-					return err
-				}
-				if pi != nil {
-					for _, def := range pi.Definitions {
-						if err := e.appendTableForStatsBuckets(db.O, tbl.Name.O, def.Name.O, h.GetPartitionStats(tbl, def.ID)); err != nil {
-							return err
-						}
-					}
-				}
-			} else {
-				for _, def := range pi.Definitions {
-					if err := e.appendTableForStatsBuckets(db.O, tbl.Name.O, def.Name.O, h.GetPartitionStats(tbl, def.ID)); err != nil {
-						return err
-					}
-				}
-			}
-		}
-	}
-	return nil
+	return e.forEachStatsTableToShow(ctx, e.appendTableForStatsBuckets)
 }
 
 func (e *ShowExec) appendTableForStatsBuckets(dbName, tblName, partitionName string, statsTbl *statistics.Table) error {
 	if statsTbl.Pseudo {
 		return nil
 	}
-	colNameToType := make(map[string]byte, statsTbl.ColNum())
+	colNameToType := make(map[string]*types.FieldType, statsTbl.ColNum())
 	for _, col := range statsTbl.StableOrderColSlice() {
-		err := e.bucketsToRows(dbName, tblName, partitionName, col.Info.Name.O, 0, col.Histogram, nil)
+		// The types of all the columns are needed to output the bounds of the indexes.
+		colNameToType[col.Info.Name.O] = col.Histogram.Tp
+		if !e.StatsFilter.MatchColumn(col.Info.Name.O) {
+			continue
+		}
+		err := e.bucketsToRows(dbName, tblName, partitionName, col.Info.Name.O, 0, col.Histogram, []*types.FieldType{col.Histogram.Tp})
 		if err != nil {
 			return errors.Trace(err)
 		}
-		colNameToType[col.Info.Name.O] = col.Histogram.Tp.GetType()
 	}
 	for _, idx := range statsTbl.StableOrderIdxSlice() {
-		idxColumnTypes := make([]byte, 0, len(idx.Info.Columns))
+		if !e.StatsFilter.MatchColumn(idx.Info.Name.O) {
+			continue
+		}
+		idxColumnTypes := make([]*types.FieldType, 0, len(idx.Info.Columns))
 		for i := 0; i < len(idx.Info.Columns); i++ {
 			idxColumnTypes = append(idxColumnTypes, colNameToType[idx.Info.Columns[i].Name.O])
 		}
@@ -382,57 +398,30 @@ func (e *ShowExec) appendTableForStatsBuckets(dbName, tblName, partitionName str
 }
 
 func (e *ShowExec) fetchShowStatsTopN(ctx context.Context) error {
-	do := domain.GetDomain(e.Ctx())
-	h := do.StatsHandle()
-	dbs := do.InfoSchema().AllSchemaNames()
-	for _, db := range dbs {
-		tables, err := do.InfoSchema().SchemaTableInfos(ctx, db)
-		if err != nil {
-			return errors.Trace(err)
-		}
-		for _, tbl := range tables {
-			pi := tbl.GetPartitionInfo()
-			if pi == nil || e.Ctx().GetSessionVars().IsDynamicPartitionPruneEnabled() {
-				partitionName := ""
-				if pi != nil {
-					partitionName = "global"
-				}
-				if err := e.appendTableForStatsTopN(db.O, tbl.Name.O, partitionName, h.GetTableStats(tbl)); err != nil {
-					return err
-				}
-				if pi != nil {
-					for _, def := range pi.Definitions {
-						if err := e.appendTableForStatsTopN(db.O, tbl.Name.O, def.Name.O, h.GetPartitionStats(tbl, def.ID)); err != nil {
-							return err
-						}
-					}
-				}
-			} else {
-				for _, def := range pi.Definitions {
-					if err := e.appendTableForStatsTopN(db.O, tbl.Name.O, def.Name.O, h.GetPartitionStats(tbl, def.ID)); err != nil {
-						return err
-					}
-				}
-			}
-		}
-	}
-	return nil
+	return e.forEachStatsTableToShow(ctx, e.appendTableForStatsTopN)
 }
 
 func (e *ShowExec) appendTableForStatsTopN(dbName, tblName, partitionName string, statsTbl *statistics.Table) error {
 	if statsTbl.Pseudo {
 		return nil
 	}
-	colNameToType := make(map[string]byte, statsTbl.ColNum())
+	colNameToType := make(map[string]*types.FieldType, statsTbl.ColNum())
 	for _, col := range statsTbl.StableOrderColSlice() {
-		err := e.topNToRows(dbName, tblName, partitionName, col.Info.Name.O, 1, 0, col.TopN, []byte{col.Histogram.Tp.GetType()})
+		// The types of all the columns are needed to output the values of the indexes.
+		colNameToType[col.Info.Name.O] = col.Histogram.Tp
+		if !e.StatsFilter.MatchColumn(col.Info.Name.O) {
+			continue
+		}
+		err := e.topNToRows(dbName, tblName, partitionName, col.Info.Name.O, 1, 0, col.TopN, []*types.FieldType{col.Histogram.Tp})
 		if err != nil {
 			return errors.Trace(err)
 		}
-		colNameToType[col.Info.Name.O] = col.Histogram.Tp.GetType()
 	}
 	for _, idx := range statsTbl.StableOrderIdxSlice() {
-		idxColumnTypes := make([]byte, 0, len(idx.Info.Columns))
+		if !e.StatsFilter.MatchColumn(idx.Info.Name.O) {
+			continue
+		}
+		idxColumnTypes := make([]*types.FieldType, 0, len(idx.Info.Columns))
 		for i := 0; i < len(idx.Info.Columns); i++ {
 			idxColumnTypes = append(idxColumnTypes, colNameToType[idx.Info.Columns[i].Name.O])
 		}
@@ -444,16 +433,22 @@ func (e *ShowExec) appendTableForStatsTopN(dbName, tblName, partitionName string
 	return nil
 }
 
-func (e *ShowExec) topNToRows(dbName, tblName, partitionName, colName string, numOfCols int, isIndex int, topN *statistics.TopN, columnTypes []byte) error {
+func (e *ShowExec) topNToRows(dbName, tblName, partitionName, colName string, numOfCols int, isIndex int, topN *statistics.TopN, columnTypes []*types.FieldType) error {
 	if topN == nil {
 		return nil
 	}
 	var tmpDatum types.Datum
 	for i := 0; i < len(topN.TopN); i++ {
-		tmpDatum.SetBytes(topN.TopN[i].Encoded)
-		valStr, err := statistics.ValueToString(e.Ctx().GetSessionVars(), &tmpDatum, numOfCols, columnTypes)
-		if err != nil {
-			return err
+		var valStr string
+		if e.RawStats {
+			valStr = rawStatsValueToString(topN.TopN[i].Encoded)
+		} else {
+			tmpDatum.SetBytes(topN.TopN[i].Encoded)
+			var err error
+			valStr, err = statistics.ValueToReadableString(e.Ctx().GetSessionVars(), &tmpDatum, numOfCols, columnTypes)
+			if err != nil {
+				return err
+			}
 		}
 		e.appendRow([]any{
 			dbName,
@@ -470,17 +465,28 @@ func (e *ShowExec) topNToRows(dbName, tblName, partitionName, colName string, nu
 
 // bucketsToRows converts histogram buckets to rows. If the histogram is built from index, then numOfCols equals to number
 // of index columns, else numOfCols is 0.
-func (e *ShowExec) bucketsToRows(dbName, tblName, partitionName, colName string, numOfCols int, hist statistics.Histogram, idxColumnTypes []byte) error {
+func (e *ShowExec) bucketsToRows(dbName, tblName, partitionName, colName string, numOfCols int, hist statistics.Histogram, fts []*types.FieldType) error {
 	isIndex := 0
 	if numOfCols > 0 {
 		isIndex = 1
 	}
+	boundToString := func(bound *types.Datum) (string, error) {
+		if !e.RawStats {
+			return statistics.ValueToReadableString(e.Ctx().GetSessionVars(), bound, numOfCols, fts)
+		}
+		// The bounds are kept as blobs in mysql.stats_buckets, see saveBucketsToStorage.
+		blob, err := bound.ConvertTo(e.Ctx().GetSessionVars().StmtCtx.TypeCtx(), types.NewFieldType(mysql.TypeBlob))
+		if err != nil {
+			return "", err
+		}
+		return rawStatsValueToString(blob.GetBytes()), nil
+	}
 	for i := 0; i < hist.Len(); i++ {
-		lowerBoundStr, err := statistics.ValueToString(e.Ctx().GetSessionVars(), hist.GetLower(i), numOfCols, idxColumnTypes)
+		lowerBoundStr, err := boundToString(hist.GetLower(i))
 		if err != nil {
 			return errors.Trace(err)
 		}
-		upperBoundStr, err := statistics.ValueToString(e.Ctx().GetSessionVars(), hist.GetUpper(i), numOfCols, idxColumnTypes)
+		upperBoundStr, err := boundToString(hist.GetUpper(i))
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -501,6 +507,11 @@ func (e *ShowExec) bucketsToRows(dbName, tblName, partitionName, colName string,
 	return nil
 }
 
+// rawStatsValueToString outputs the exact bytes of the stats values for `show stats_buckets|stats_topn raw`.
+func rawStatsValueToString(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
 func (e *ShowExec) fetchShowStatsHealthy(ctx context.Context) {
 	do := domain.GetDomain(e.Ctx())
 	h := do.StatsHandle()
@@ -556,6 +567,95 @@ func (e *ShowExec) appendTableForStatsHealthy(dbName, tblName, partitionName str
 	})
 }
 
+// appendStatsForShowCreateTable appends the stats summary of the table to the result of
+// `show create table ... with stats` as a comment, so that the statement is still executable.
+// The summary contains the row count, the healthy, the NDV of each index and the persisted analyze options.
+func (e *ShowExec) appendStatsForShowCreateTable(ctx context.Context, tblInfo *model.TableInfo, buf *bytes.Buffer) error {
+	statsTbl := domain.GetDomain(e.Ctx()).StatsHandle().GetTableStats(tblInfo)
+	buf.WriteString("\n/* STATS\n")
+	if statsTbl.Pseudo {
+		buf.WriteString("  pseudo: true\n")
+	} else {
+		fmt.Fprintf(buf, "  row_count: %d\n", statsTbl.RealtimeCount)
+		fmt.Fprintf(buf, "  modify_count: %d\n", statsTbl.ModifyCount)
+		if healthy, ok := statsTbl.GetStatsHealthy(); ok {
+			fmt.Fprintf(buf, "  healthy: %d\n", healthy)
+		}
+		if statsTbl.IsAnalyzed() {
+			fmt.Fprintf(buf, "  last_analyze_time: %s\n", e.versionToTime(statsTbl.LastAnalyzeVersion))
+		} else {
+			buf.WriteString("  last_analyze_time: NULL\n")
+		}
+		for _, idxInfo := range tblInfo.Indices {
+			if idxInfo.State != model.StatePublic {
+				continue
+			}
+			if idx := statsTbl.GetIdx(idxInfo.ID); idx != nil && idx.IsAnalyzed() {
+				fmt.Fprintf(buf, "  index `%s` ndv: %d\n", idxInfo.Name.O, idx.NDV)
+			} else {
+				fmt.Fprintf(buf, "  index `%s` ndv: NULL\n", idxInfo.Name.O)
+			}
+		}
+	}
+	opts, err := e.savedAnalyzeOptions(ctx, tblInfo)
+	if err != nil {
+		return err
+	}
+	if opts != "" {
+		fmt.Fprintf(buf, "  analyze_options: %s\n", opts)
+	}
+	buf.WriteString("*/")
+	return nil
+}
+
+// savedAnalyzeOptions returns the analyze options persisted in mysql.analyze_options in the syntax of the analyze
+// statement, e.g. `PREDICATE COLUMNS WITH 2 BUCKETS, 1 TOPN`. It's empty if there are no persisted options.
+func (e *ShowExec) savedAnalyzeOptions(ctx context.Context, tblInfo *model.TableInfo) (string, error) {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnStats)
+	exec := e.Ctx().GetRestrictedSQLExecutor()
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, "select sample_num,sample_rate,buckets,topn,column_choice,column_ids from mysql.analyze_options where table_id = %?", tblInfo.ID)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(rows) == 0 {
+		return "", nil
+	}
+	row := rows[0]
+	var parts []string
+	switch row.GetEnum(4).Name {
+	case "ALL":
+		parts = append(parts, "ALL COLUMNS")
+	case "PREDICATE":
+		parts = append(parts, "PREDICATE COLUMNS")
+	case "LIST":
+		var names []string
+		for _, colIDStr := range strings.Split(row.GetString(5), ",") {
+			colID, _ := strconv.ParseInt(colIDStr, 10, 64)
+			if colInfo := model.FindColumnInfoByID(tblInfo.Columns, colID); colInfo != nil {
+				names = append(names, "`"+colInfo.Name.O+"`")
+			}
+		}
+		parts = append(parts, "COLUMNS "+strings.Join(names, ", "))
+	}
+	var withOpts []string
+	if sampleNum := row.GetInt64(0); sampleNum > 0 {
+		withOpts = append(withOpts, fmt.Sprintf("%d SAMPLES", sampleNum))
+	}
+	if sampleRate := row.GetFloat64(1); sampleRate > 0 {
+		withOpts = append(withOpts, fmt.Sprintf("%v SAMPLERATE", sampleRate))
+	}
+	if buckets := row.GetInt64(2); buckets > 0 {
+		withOpts = append(withOpts, fmt.Sprintf("%d BUCKETS", buckets))
+	}
+	if topn := row.GetInt64(3); topn >= 0 {
+		withOpts = append(withOpts, fmt.Sprintf("%d TOPN", topn))
+	}
+	if len(withOpts) > 0 {
+		parts = append(parts, "WITH "+strings.Join(withOpts, ", "))
+	}
+	return strings.Join(parts, " "), nil
+}
+
 func (e *ShowExec) fetchShowHistogramsInFlight() {
 	statsHandle := domain.GetDomain(e.Ctx()).StatsHandle()
 	e.appendRow([]any{statsStorage.CleanFakeItemsForShowHistInFlights(statsHandle)})
@@ -49,6 +49,42 @@ func TestShowStatsMeta(t *testing.T) {
 	require.Equal(t, "t", result.Rows()[0][1])
 }
 
+func TestShowStatsWithFilterOrderAndLimit(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2, t3")
+	tk.MustExec("create table t1 (a int, b int, index ia(a))")
+	tk.MustExec("create table t2 (a int, b int)")
+	tk.MustExec("create table t3 (a int, b int) partition by hash(a) partitions 2")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2), (2, 3)")
+	tk.MustExec("insert into t2 values (1, 1)")
+	tk.MustExec("insert into t3 values (1, 1), (2, 2)")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec("analyze table t1, t2, t3 all columns")
+
+	tk.MustQuery("show stats_meta where db_name = 'test' and table_name in ('t1', 't2') order by row_count desc").
+		CheckAt([]int{1, 2, 5}, [][]any{{"t1", "", "3"}, {"t2", "", "1"}})
+	tk.MustQuery("show stats_meta where table_name = 't3' and partition_name != 'global' order by partition_name").
+		CheckAt([]int{1, 2}, [][]any{{"t3", "p0"}, {"t3", "p1"}})
+	tk.MustQuery("show stats_meta where table_name = 't3' and partition_name = 'global'").
+		CheckAt([]int{1, 2}, [][]any{{"t3", "global"}})
+	tk.MustQuery("show stats_meta where table_name = 't1' and table_name = 't2'").Check(testkit.Rows())
+	require.Len(t, tk.MustQuery("show stats_meta where db_name = 'test' limit 2").Rows(), 2)
+	require.Len(t, tk.MustQuery("show stats_meta where db_name = 'test' limit 3, 10").Rows(), 2)
+
+	tk.MustQuery("show stats_histograms where table_name = 't1' and column_name in ('b', 'ia') order by column_name").
+		CheckAt([]int{1, 3, 4, 6}, [][]any{{"t1", "b", "0", "3"}, {"t1", "ia", "1", "2"}})
+	tk.MustQuery("show stats_histograms where table_name = 't1' and distinct_count > 2").
+		CheckAt([]int{1, 3}, [][]any{{"t1", "b"}})
+	tk.MustQuery("show stats_topn where table_name = 't1' and column_name = 'a' order by count desc, value limit 1").
+		CheckAt([]int{3, 5, 6}, [][]any{{"a", "2", "2"}})
+	tk.MustExec("analyze table t1 with 0 topn")
+	tk.MustQuery("show stats_buckets where table_name = 't1' and column_name = 'ia' order by bucket_id desc limit 1").
+		CheckAt([]int{3, 4, 8, 9}, [][]any{{"ia", "1", "2", "2"}})
+}
+
 func TestShowStatsLocked(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 
@@ -147,6 +183,26 @@ func TestShowStatsBuckets(t *testing.T) {
 	result.Check(testkit.Rows("test t  idx 1 0 1 1 (2020-01-01 00:00:00, 1) (2020-01-01 00:00:00, 1) 0"))
 }
 
+func TestShowStatsBucketsReadableValues(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("set @@tidb_analyze_version=1")
+	tk.MustExec("create table t (a bit(8), b enum('x', 'y'), key idx(a, b))")
+	tk.MustExec("insert into t values (5, 'y')")
+	tk.MustExec("analyze table t with 0 topn")
+	tk.MustQuery("show stats_buckets").Sort().Check(testkit.Rows(
+		"test t  a 0 0 1 1 b'101' b'101' 0",
+		"test t  b 0 0 1 1 y y 0",
+		"test t  idx 1 0 1 1 (b'101', y) (b'101', y) 0",
+	))
+	tk.MustQuery("show stats_buckets raw where column_name = 'idx'").Check(testkit.Rows(
+		"test t  idx 1 0 1 1 0x040000000000000005040000000000000002 0x040000000000000005040000000000000002 0",
+	))
+}
+
 func TestShowStatsBucketWithDateNullValue(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -372,10 +428,10 @@ func TestShowColumnStatsUsage(t *testing.T) {
 	require.NoError(t, err)
 	t2, err := is.TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t2"))
 	require.NoError(t, err)
-	tk.MustExec(fmt.Sprintf("insert into mysql.column_stats_usage values (%d, %d, null, '2021-10-20 08:00:00')", t1.Meta().ID, t1.Meta().Columns[0].ID))
-	tk.MustExec(fmt.Sprintf("insert into mysql.column_stats_usage values (%d, %d, '2021-10-20 09:00:00', null)", t2.Meta().ID, t2.Meta().Columns[0].ID))
+	tk.MustExec(fmt.Sprintf("insert into mysql.column_stats_usage (table_id, column_id, last_used_at, last_analyzed_at) values (%d, %d, null, '2021-10-20 08:00:00')", t1.Meta().ID, t1.Meta().Columns[0].ID))
+	tk.MustExec(fmt.Sprintf("insert into mysql.column_stats_usage (table_id, column_id, last_used_at, last_analyzed_at) values (%d, %d, '2021-10-20 09:00:00', null)", t2.Meta().ID, t2.Meta().Columns[0].ID))
 	p0 := t2.Meta().GetPartitionInfo().Definitions[0]
-	tk.MustExec(fmt.Sprintf("insert into mysql.column_stats_usage values (%d, %d, '2021-10-20 09:00:00', null)", p0.ID, t2.Meta().Columns[0].ID))
+	tk.MustExec(fmt.Sprintf("insert into mysql.column_stats_usage (table_id, column_id, last_used_at, last_analyzed_at) values (%d, %d, '2021-10-20 09:00:00', null)", p0.ID, t2.Meta().Columns[0].ID))
 
 	result := tk.MustQuery("show column_stats_usage where db_name = 'test' and table_name = 't1'").Sort()
 	rows := result.Rows()
@@ -468,3 +524,54 @@ func TestShowAnalyzeStatus(t *testing.T) {
 	require.Len(t, rows, 2)
 	require.Equal(t, "merge global stats for test.t2's index idx", rows[0][3])
 }
+
+func TestShowAnalyzeStatusHistory(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("delete from mysql.analyze_jobs")
+	tk.MustExec(`insert into mysql.analyze_jobs (update_time, table_schema, table_name, job_info, state, fail_reason, fail_category, instance) values
+		(now() - interval 2 day, 'test', 't1', 'analyze table', 'failed', 'Region is unavailable', 'storage', ''),
+		(now() - interval 10 day, 'test', 't2', 'analyze table', 'finished', null, null, '')`)
+	tk.MustQuery("select table_name, state, fail_category from information_schema.analyze_status").Check(testkit.Rows(
+		"t1 failed storage",
+	))
+
+	// The jobs within the retention are shown.
+	tk.MustExec("set @@global.tidb_analyze_job_history_retention = '240h'")
+	defer tk.MustExec("set @@global.tidb_analyze_job_history_retention = default")
+	rows := tk.MustQuery("show analyze status").Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, "t1", rows[0][1])
+	require.Equal(t, "storage", rows[0][14])
+	require.Equal(t, "t2", rows[1][1])
+	require.Equal(t, "<nil>", rows[1][14])
+}
+
+func TestShowCreateTableWithStats(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index ia(a))")
+	// The stats section is omitted without `with stats`, and the table without stats is shown as pseudo.
+	require.NotContains(t, tk.MustQuery("show create table t").Rows()[0][1], "/* STATS")
+	require.Contains(t, tk.MustQuery("show create table t with stats").Rows()[0][1], "/* STATS\n  pseudo: true\n*/")
+
+	h := dom.StatsHandle()
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4)")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.MustExec("analyze table t all columns with 2 buckets, 1 topn")
+	tk.MustExec("insert into t values (5, 5), (6, 6)")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	result := tk.MustQuery("show create table t with stats").Rows()[0][1].(string)
+	require.Contains(t, result, "CREATE TABLE `t` (")
+	require.Contains(t, result, "  row_count: 6\n  modify_count: 2\n  healthy: 50\n")
+	require.NotContains(t, result, "last_analyze_time: NULL")
+	require.Contains(t, result, "  index `ia` ndv: 4\n")
+	require.Contains(t, result, "  analyze_options: ALL COLUMNS WITH 2 BUCKETS, 1 TOPN\n*/")
+	// The statement is still executable with the stats section.
+	tk.MustExec("drop table t")
+	tk.MustExec(result)
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/meta"
+	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
@@ -184,7 +185,7 @@ func (e *SimpleExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 	case *ast.ShutdownStmt:
 		err = e.executeShutdown()
 	case *ast.AdminStmt:
-		err = e.executeAdmin(x)
+		err = e.executeAdmin(ctx, x)
 	case *ast.SetResourceGroupStmt:
 		err = e.executeSetResourceGroupName(x)
 	case *ast.AlterRangeStmt:
@@ -2770,6 +2771,9 @@ func (e *SimpleExec) executeAlterInstance(s *ast.AlterInstanceStmt) error {
 }
 
 func (e *SimpleExec) executeDropStats(ctx context.Context, s *ast.DropStatsStmt) (err error) {
+	if len(s.ColumnNames) > 0 || len(s.IndexNames) > 0 {
+		return e.executeDropColumnAndIndexStats(ctx, s)
+	}
 	h := domain.GetDomain(e.Ctx()).StatsHandle()
 	var statsIDs []int64
 	// TODO: GLOBAL option will be deprecated. Also remove this condition when the syntax is removed
@@ -2798,6 +2802,70 @@ func (e *SimpleExec) executeDropStats(ctx context.Context, s *ast.DropStatsStmt)
 	if err := h.DeleteTableStatsFromKV(statsIDs, true); err != nil {
 		return err
 	}
+	infos := make([]*plugin.StatsEventInfo, 0, len(s.Tables))
+	for _, table := range s.Tables {
+		tnW := e.ResolveCtx.GetTableName(table)
+		info := &plugin.StatsEventInfo{DBName: tnW.DBInfo.Name.O, TableName: tnW.TableInfo.Name.O, Scope: plugin.StatsScopeTable}
+		if s.IsGlobalStats {
+			info.Scope = plugin.StatsScopeGlobal
+		} else if len(s.PartitionNames) > 0 {
+			info.Scope = plugin.StatsScopePartition
+			for _, partition := range s.PartitionNames {
+				info.Items = append(info.Items, partition.O)
+			}
+		}
+		infos = append(infos, info)
+	}
+	plugin.NotifyStatsEvent(ctx, e.Ctx().GetSessionVars(), plugin.DropStats, infos)
+	return h.Update(ctx, e.Ctx().GetInfoSchema().(infoschema.InfoSchema))
+}
+
+// executeDropColumnAndIndexStats drops the stats of the columns and indexes of the table and all its partitions, the
+// other stats of the table are kept.
+func (e *SimpleExec) executeDropColumnAndIndexStats(ctx context.Context, s *ast.DropStatsStmt) error {
+	tnW := e.ResolveCtx.GetTableName(s.Tables[0])
+	tblInfo := tnW.TableInfo
+	colIDs := make([]int64, 0, len(s.ColumnNames))
+	for _, name := range s.ColumnNames {
+		col := model.FindColumnInfo(tblInfo.Columns, name.L)
+		if col == nil || col.State != model.StatePublic {
+			return infoschema.ErrColumnNotExists.GenWithStackByArgs(name.O, tblInfo.Name.O)
+		}
+		colIDs = append(colIDs, col.ID)
+	}
+	idxIDs := make([]int64, 0, len(s.IndexNames))
+	for _, name := range s.IndexNames {
+		idx := tblInfo.FindIndexByName(name.L)
+		if idx == nil || idx.State != model.StatePublic {
+			return infoschema.ErrKeyNotExists.GenWithStackByArgs(name.O, tblInfo.Name.O)
+		}
+		idxIDs = append(idxIDs, idx.ID)
+	}
+	statsIDs, _, err := core.GetPhysicalIDsAndPartitionNames(tblInfo, nil)
+	if err != nil {
+		return err
+	}
+	statsIDs = append(statsIDs, tblInfo.ID)
+	h := domain.GetDomain(e.Ctx()).StatsHandle()
+	if err := h.DeleteColumnAndIndexStatsFromKV(statsIDs, colIDs, idxIDs); err != nil {
+		return err
+	}
+	infos := make([]*plugin.StatsEventInfo, 0, 2)
+	if len(s.ColumnNames) > 0 {
+		info := &plugin.StatsEventInfo{DBName: tnW.DBInfo.Name.O, TableName: tblInfo.Name.O, Scope: plugin.StatsScopeColumn}
+		for _, name := range s.ColumnNames {
+			info.Items = append(info.Items, name.O)
+		}
+		infos = append(infos, info)
+	}
+	if len(s.IndexNames) > 0 {
+		info := &plugin.StatsEventInfo{DBName: tnW.DBInfo.Name.O, TableName: tblInfo.Name.O, Scope: plugin.StatsScopeIndex}
+		for _, name := range s.IndexNames {
+			info.Items = append(info.Items, name.O)
+		}
+		infos = append(infos, info)
+	}
+	plugin.NotifyStatsEvent(ctx, e.Ctx().GetSessionVars(), plugin.DropStats, infos)
 	return h.Update(ctx, e.Ctx().GetInfoSchema().(infoschema.InfoSchema))
 }
 
@@ -2871,7 +2939,7 @@ func (e *SimpleExec) executeSetSessionStates(ctx context.Context, s *ast.SetSess
 	return e.Ctx().DecodeSessionStates(ctx, e.Ctx(), &sessionStates)
 }
 
-func (e *SimpleExec) executeAdmin(s *ast.AdminStmt) error {
+func (e *SimpleExec) executeAdmin(ctx context.Context, s *ast.AdminStmt) error {
 	switch s.Tp {
 	case ast.AdminReloadStatistics:
 		return e.executeAdminReloadStatistics(s)
@@ -2881,6 +2949,10 @@ func (e *SimpleExec) executeAdmin(s *ast.AdminStmt) error {
 		return e.executeAdminSetBDRRole(s)
 	case ast.AdminUnsetBDRRole:
 		return e.executeAdminUnsetBDRRole()
+	case ast.AdminEvictStats:
+		return e.executeAdminEvictStats(s)
+	case ast.AdminFlushStatsDelta:
+		return e.executeAdminFlushStatsDelta(ctx, s)
 	}
 	return nil
 }
@@ -2917,6 +2989,71 @@ func (e *SimpleExec) executeAdminFlushPlanCache(s *ast.AdminStmt) error {
 	return nil
 }
 
+// executeAdminEvictStats evicts the stats of the table, its partitions or its columns from the stats cache of this
+// instance, or of all the instances for the GLOBAL scope. The stats in the storage are untouched.
+func (e *SimpleExec) executeAdminEvictStats(s *ast.AdminStmt) error {
+	tblInfo := e.ResolveCtx.GetTableName(s.Tables[0]).TableInfo
+	tableIDs, _, err := core.GetPhysicalIDsAndPartitionNames(tblInfo, s.PartitionNames)
+	if err != nil {
+		return err
+	}
+	if tblInfo.GetPartitionInfo() != nil && len(s.PartitionNames) == 0 {
+		// Evict the global stats along with the partitions.
+		tableIDs = append(tableIDs, tblInfo.ID)
+	}
+	colIDs := make([]int64, 0, len(s.ColumnNames))
+	for _, name := range s.ColumnNames {
+		col := model.FindColumnInfo(tblInfo.Columns, name.L)
+		if col == nil {
+			return infoschema.ErrColumnNotExists.GenWithStackByArgs(name.O, tblInfo.Name.O)
+		}
+		colIDs = append(colIDs, col.ID)
+	}
+	do := domain.GetDomain(e.Ctx())
+	if do.EvictStats(tableIDs, colIDs) == 0 {
+		e.Ctx().GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError("No stats are evicted from the stats cache of this instance"))
+	}
+	if s.StatementScope == ast.StatementScopeGlobal {
+		return do.NotifyEvictStats(tableIDs, colIDs)
+	}
+	return nil
+}
+
+// executeAdminFlushStatsDelta dumps the modify count and count deltas and the column stats usage collected in memory
+// to the storage immediately, for all the tables or only the given tables.
+func (e *SimpleExec) executeAdminFlushStatsDelta(ctx context.Context, s *ast.AdminStmt) error {
+	h := domain.GetDomain(e.Ctx()).StatsHandle()
+	if len(s.Tables) == 0 {
+		if err := h.DumpStatsDeltaToKV(true); err != nil {
+			return err
+		}
+		if err := h.DumpColStatsUsageToKV(); err != nil {
+			return err
+		}
+	} else {
+		var tableIDs []int64
+		for _, table := range s.Tables {
+			tblInfo := e.ResolveCtx.GetTableName(table).TableInfo
+			physicalIDs, _, err := core.GetPhysicalIDsAndPartitionNames(tblInfo, nil)
+			if err != nil {
+				return err
+			}
+			tableIDs = append(tableIDs, physicalIDs...)
+			if tblInfo.GetPartitionInfo() != nil {
+				tableIDs = append(tableIDs, tblInfo.ID)
+			}
+		}
+		if err := h.DumpStatsDeltaToKVByIDs(tableIDs...); err != nil {
+			return err
+		}
+		if err := h.DumpColStatsUsageToKVByIDs(tableIDs...); err != nil {
+			return err
+		}
+	}
+	// Reload the stats meta so that SHOW STATS_META reflects the flushed deltas.
+	return h.Update(ctx, e.Ctx().GetInfoSchema().(infoschema.InfoSchema))
+}
+
 func (e *SimpleExec) executeAdminSetBDRRole(s *ast.AdminStmt) error {
 	if s.Tp != ast.AdminSetBDRRole {
 		return errors.New("This AdminStmt is not ADMIN SET BDR_ROLE")
@@ -182,3 +182,63 @@ func TestIssue58875(t *testing.T) {
 		}
 	}
 }
+
+func TestTemporaryTableStats(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("create temporary table tmp_t (a int, b varchar(10))")
+	// The pseudo stats are used before any row is written.
+	tk.MustQuery("explain format = 'brief' select * from tmp_t").Check(testkit.Rows(
+		"Projection 10000.00 root  test.tmp_t.a, test.tmp_t.b",
+		"└─UnionScan 10000.00 root  ",
+		"  └─TableReader 10000.00 root  data:TableFullScan",
+		"    └─TableFullScan 10000.00 cop[tikv] table:tmp_t keep order:false, stats:pseudo"))
+	for i := range 10 {
+		tk.MustExec("insert into tmp_t values (?, ?), (?, ?), (?, null)", i*10+1, "a", i*10+2, "b", i*10+3)
+	}
+	tk.MustQuery("explain format = 'brief' select * from tmp_t").Check(testkit.Rows(
+		"Projection 30.00 root  test.tmp_t.a, test.tmp_t.b",
+		"└─UnionScan 30.00 root  ",
+		"  └─TableReader 30.00 root  data:TableFullScan",
+		"    └─TableFullScan 30.00 cop[tikv] table:tmp_t keep order:false"))
+	tk.MustQuery("explain format = 'brief' select * from tmp_t where b is null").CheckAt([]int{0, 1}, [][]any{
+		{"Projection", "10.00"}, {"└─UnionScan", "10.00"}, {"  └─TableReader", "10.00"},
+		{"    └─Selection", "10.00"}, {"      └─TableFullScan", "30.00"},
+	})
+	tk.MustQuery("explain format = 'brief' select * from tmp_t where b = 'a'").CheckAt([]int{0, 1}, [][]any{
+		{"Projection", "10.00"}, {"└─UnionScan", "10.00"}, {"  └─TableReader", "10.00"},
+		{"    └─Selection", "10.00"}, {"      └─TableFullScan", "30.00"},
+	})
+	tk.MustQuery("explain format = 'brief' select * from tmp_t where a > 1000").CheckAt([]int{0, 1}, [][]any{
+		{"Projection", "1.00"}, {"└─UnionScan", "1.00"}, {"  └─TableReader", "1.00"},
+		{"    └─Selection", "1.00"}, {"      └─TableFullScan", "30.00"},
+	})
+
+	// The stats collected in the txn are discarded if the txn is rolled back.
+	tk.MustExec("begin")
+	tk.MustExec("insert into tmp_t values (1, 'c'), (2, 'd')")
+	tk.MustExec("delete from tmp_t where a = 3")
+	tk.MustQuery("explain format = 'brief' select * from tmp_t").CheckAt([]int{1}, [][]any{{"31.00"}, {"31.00"}, {"31.00"}, {"31.00"}})
+	tk.MustExec("rollback")
+	tk.MustQuery("explain format = 'brief' select * from tmp_t").CheckAt([]int{1}, [][]any{{"30.00"}, {"30.00"}, {"30.00"}, {"30.00"}})
+
+	// The stats collected by the failed statement are discarded.
+	tk.MustExec("create temporary table tmp_pk (a int primary key)")
+	tk.MustExec("insert into tmp_pk values (1), (2)")
+	require.Error(t, tk.ExecToErr("insert into tmp_pk values (3), (1)"))
+	rows := tk.MustQuery("explain format = 'brief' select * from tmp_pk").Rows()
+	require.Equal(t, "2.00", rows[len(rows)-1][1])
+
+	tk.MustExec("truncate table tmp_t")
+	tk.MustQuery("explain format = 'brief' select * from tmp_t").CheckAt([]int{1}, [][]any{{"10000.00"}, {"10000.00"}, {"10000.00"}, {"10000.00"}})
+
+	// The rows of the global temporary tables are deleted when the txn ends.
+	tk.MustExec("create global temporary table tmp_g (a int) on commit delete rows")
+	tk.MustExec("begin")
+	tk.MustExec("insert into tmp_g values (1), (2), (3)")
+	tk.MustQuery("explain format = 'brief' select * from tmp_g").CheckAt([]int{1}, [][]any{{"3.00"}, {"3.00"}, {"3.00"}, {"3.00"}})
+	tk.MustExec("commit")
+	tk.MustQuery("explain format = 'brief' select * from tmp_g").CheckAt([]int{1}, [][]any{{"10000.00"}, {"10000.00"}})
+}
@@ -2140,3 +2140,101 @@ func TestAdminCheckGeneratedColumns(t *testing.T) {
 		require.Error(t, err)
 	}
 }
+
+func TestAdminCheckStatsTable(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index ia(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (3, null)")
+	tk.MustExec("analyze table t all columns")
+	tk.MustQuery("admin check stats table t").Check(testkit.Rows())
+
+	// The stats become inconsistent with the table after all the rows are deleted, which can be fixed by re-analyzing.
+	tk.MustExec("delete from t")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	rows := tk.MustQuery("admin check stats table t").Rows()
+	require.NotEmpty(t, rows)
+	for _, row := range rows {
+		require.Equal(t, "test", row[0])
+		require.Equal(t, "t", row[1])
+		require.Equal(t, "re-analyze", row[7])
+	}
+	tk.MustQuery("admin check stats table t").CheckContain("ndv_exceeds_row_count")
+
+	tk.MustExec("analyze table t all columns")
+	tk.MustQuery("admin check stats table t").Check(testkit.Rows())
+}
+
+func TestAdminEvictStats(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index ia(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("analyze table t all columns")
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tblInfo := tbl.Meta()
+	colA, colB := tblInfo.Columns[0].ID, tblInfo.Columns[1].ID
+
+	// Load the stats of the columns into the cache.
+	h := dom.StatsHandle()
+	tk.MustExec("select * from t where a > 1 and b > 1")
+	require.Eventually(t, func() bool {
+		statsTbl, ok := h.Get(tblInfo.ID)
+		return ok && statsTbl.GetCol(colA).IsFullLoad() && statsTbl.GetCol(colB).IsFullLoad()
+	}, 5*time.Second, 100*time.Millisecond)
+
+	tk.MustExec("admin evict stats table t columns a")
+	statsTbl, ok := h.Get(tblInfo.ID)
+	require.True(t, ok)
+	require.False(t, statsTbl.GetCol(colA).IsFullLoad())
+	require.True(t, statsTbl.GetCol(colB).IsFullLoad())
+	tk.MustQuery(`select item_name, evict_count from information_schema.stats_cache_usage
+				where table_schema = 'test' and table_name = 't' and item_type = 'column' order by item_name`).Check(testkit.Rows("a 1", "b 0"))
+	// Nothing is left to evict.
+	tk.MustExec("admin evict stats table t columns a")
+	require.Len(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings(), 1)
+
+	tk.MustExec("admin evict global stats table t")
+	statsTbl, ok = h.Get(tblInfo.ID)
+	require.True(t, ok)
+	require.False(t, statsTbl.GetCol(colB).IsFullLoad())
+	// The stats in the storage are untouched.
+	tk.MustQuery("select count(*) > 0 from mysql.stats_buckets where table_id = ?", tblInfo.ID).Check(testkit.Rows("1"))
+
+	tk.MustGetErrCode("admin evict stats table t columns c", mysql.ErrBadField)
+	tk.MustGetErrCode("admin evict stats table t partition p0", mysql.ErrPartitionMgmtOnNonpartitioned)
+
+	tk.MustExec("create table pt (a int, b int) partition by hash(a) partitions 2")
+	tk.MustExec("insert into pt values (1, 1), (2, 2)")
+	tk.MustExec("analyze table pt all columns")
+	tk.MustExec("admin evict stats table pt partition p0")
+	tk.MustExec("admin evict stats table pt columns b")
+	tk.MustGetErrMsg("admin evict stats table pt partition p2", "can not found the specified partition name p2 in the table definition")
+}
+
+func TestAdminFlushStatsDelta(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int)")
+	tk.MustExec("create table t2 (a int)")
+	tk.MustExec("analyze table t1, t2 all columns")
+	tk.MustExec("insert into t1 values (1), (2)")
+	tk.MustExec("insert into t2 values (1)")
+
+	// Only the delta of t1 is flushed.
+	tk.MustExec("admin flush stats delta table t1")
+	tk.MustQuery("show stats_meta where db_name = 'test' and table_name = 't1'").CheckAt([]int{4, 5}, testkit.Rows("2 2"))
+	tk.MustQuery("show stats_meta where db_name = 'test' and table_name = 't2'").CheckAt([]int{4, 5}, testkit.Rows("0 0"))
+
+	tk.MustExec("admin flush stats delta")
+	tk.MustQuery("show stats_meta where db_name = 'test' and table_name = 't2'").CheckAt([]int{4, 5}, testkit.Rows("1 1"))
+}
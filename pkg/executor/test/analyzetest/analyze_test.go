@@ -25,6 +25,7 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	_ "github.com/pingcap/tidb/pkg/autoid_service"
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/domain/infosync"
 	"github.com/pingcap/tidb/pkg/errno"
@@ -32,6 +33,7 @@ import (
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/planner/core"
@@ -559,16 +561,23 @@ func TestIssue20874(t *testing.T) {
 	tk.MustExec("set @@tidb_analyze_version=1")
 	tk.MustExec("analyze table t")
 	tk.MustQuery("show stats_buckets where db_name = 'test' and table_name = 't'").Sort().Check(testkit.Rows(
-		"test t  a 0 0 1 1 \x02\xd2 \x02\xd2 0",
-		"test t  a 0 1 2 1 \x0e\x0f \x0e\x0f 0",
-		"test t  a 0 2 3 1 \x0e3 \x0e3 0",
-		"test t  b 0 0 1 1 \x00A \x00A 0",
-		"test t  b 0 1 3 2 \x00C \x00C 0",
-		"test t  idxa 1 0 1 1 \x02\xd2 \x02\xd2 0",
-		"test t  idxa 1 1 2 1 \x0e\x0f \x0e\x0f 0",
-		"test t  idxa 1 2 3 1 \x0e3 \x0e3 0",
-		"test t  idxb 1 0 1 1 \x00A \x00A 0",
-		"test t  idxb 1 1 3 2 \x00C \x00C 0",
+		"test t  a 0 0 1 1 # # 0",
+		"test t  a 0 1 2 1 $ $ 0",
+		"test t  a 0 2 3 1 A A 0",
+		"test t  b 0 0 1 1 A A 0",
+		"test t  b 0 1 3 2 C C 0",
+		"test t  idxa 1 0 1 1 # # 0",
+		"test t  idxa 1 1 2 1 $ $ 0",
+		"test t  idxa 1 2 3 1 A A 0",
+		"test t  idxb 1 0 1 1 A A 0",
+		"test t  idxb 1 1 3 2 C C 0",
+	))
+	tk.MustQuery("show stats_buckets raw where db_name = 'test' and table_name = 't' and column_name in ('a', 'idxb')").Sort().Check(testkit.Rows(
+		"test t  a 0 0 1 1 0x02d2 0x02d2 0",
+		"test t  a 0 1 2 1 0x0e0f 0x0e0f 0",
+		"test t  a 0 2 3 1 0x0e33 0x0e33 0",
+		"test t  idxb 1 0 1 1 0x010041000000000000f9 0x010041000000000000f9 0",
+		"test t  idxb 1 1 3 2 0x010043000000000000f9 0x010043000000000000f9 0",
 	))
 	tk.MustQuery("select is_index, hist_id, distinct_count, null_count, tot_col_size, stats_ver, correlation from mysql.stats_histograms").Sort().Check(testkit.Rows(
 		"0 1 3 0 9 1 1",
@@ -579,16 +588,20 @@ func TestIssue20874(t *testing.T) {
 	tk.MustExec("set @@tidb_analyze_version=2")
 	tk.MustExec("analyze table t")
 	tk.MustQuery("show stats_topn where db_name = 'test' and table_name = 't'").Sort().Check(testkit.Rows(
-		"test t  a 0 \x02\xd2 1",
-		"test t  a 0 \x0e\x0f 1",
-		"test t  a 0 \x0e3 1",
-		"test t  b 0 \x00A 1",
-		"test t  b 0 \x00C 2",
-		"test t  idxa 1 \x02\xd2 1",
-		"test t  idxa 1 \x0e\x0f 1",
-		"test t  idxa 1 \x0e3 1",
-		"test t  idxb 1 \x00A 1",
-		"test t  idxb 1 \x00C 2",
+		"test t  a 0 # 1",
+		"test t  a 0 $ 1",
+		"test t  a 0 A 1",
+		"test t  b 0 A 1",
+		"test t  b 0 C 2",
+		"test t  idxa 1 # 1",
+		"test t  idxa 1 $ 1",
+		"test t  idxa 1 A 1",
+		"test t  idxb 1 A 1",
+		"test t  idxb 1 C 2",
+	))
+	tk.MustQuery("show stats_topn raw where db_name = 'test' and table_name = 't' and column_name = 'b'").Sort().Check(testkit.Rows(
+		"test t  b 0 0x010041000000000000f9 1",
+		"test t  b 0 0x010043000000000000f9 2",
 	))
 	tk.MustQuery("select is_index, hist_id, distinct_count, null_count, tot_col_size, stats_ver, correlation from mysql.stats_histograms").Sort().Check(testkit.Rows(
 		"0 1 3 0 6 2 1",
@@ -1706,10 +1719,11 @@ func TestAnalyzeColumnsWithVirtualColumnIndex(t *testing.T) {
 				require.Equal(t, "b", rows[0][3])
 				tk.MustExec("analyze table t predicate columns with 2 topn, 2 buckets")
 			}
-			// virtual column c is skipped when dumping stats into disk, so only the stats of column b are updated
-			rows := tk.MustQuery("show column_stats_usage where db_name = 'test' and table_name = 't' and last_analyzed_at is not null").Rows()
-			require.Equal(t, 1, len(rows))
+			// virtual column c is evaluated from the samples, so the stats of both column b and c are updated
+			rows := tk.MustQuery("show column_stats_usage where db_name = 'test' and table_name = 't' and last_analyzed_at is not null").Sort().Rows()
+			require.Equal(t, 2, len(rows))
 			require.Equal(t, "b", rows[0][3])
+			require.Equal(t, "c", rows[1][3])
 
 			tk.MustQuery(fmt.Sprintf("select modify_count, count from mysql.stats_meta where table_id = %d", tblID)).Sort().Check(
 				testkit.Rows("0 9"))
@@ -1717,17 +1731,21 @@ func TestAnalyzeColumnsWithVirtualColumnIndex(t *testing.T) {
 				// db, tbl, part, col, is_idx, value, count
 				testkit.Rows("test t  b 0 4 2",
 					"test t  b 0 5 3",
+					"test t  c 0 5 2",
+					"test t  c 0 6 3",
 					"test t  idx 1 5 2",
 					"test t  idx 1 6 3"))
 			tk.MustQuery(fmt.Sprintf("select is_index, hist_id, distinct_count, null_count, stats_ver, truncate(correlation,2) from mysql.stats_histograms where table_id = %d", tblID)).Sort().Check(
 				testkit.Rows("0 1 0 0 0 0", // column a is not analyzed
 					"0 2 5 1 2 1",
-					"0 3 0 0 0 0", // column c is not analyzed
+					"0 3 5 1 2 1",
 					"1 1 5 1 2 0"))
 			tk.MustQuery("show stats_buckets where db_name = 'test' and table_name = 't'").Sort().Check(
 				// db, tbl, part, col, is_index, bucket_id, count, repeats, lower, upper, ndv
 				testkit.Rows("test t  b 0 0 2 1 1 2 0",
 					"test t  b 0 1 3 1 3 3 0",
+					"test t  c 0 0 2 1 2 3 0",
+					"test t  c 0 1 3 1 4 4 0",
 					"test t  idx 1 0 2 1 2 3 0",
 					"test t  idx 1 1 3 1 4 4 0"))
 		}(val)
@@ -3156,3 +3174,218 @@ func TestIssue55438(t *testing.T) {
 	tk.MustExec("CREATE INDEX i0 ON t0(c1);")
 	tk.MustExec("analyze table t0")
 }
+
+func TestPlanChangeGuard(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index ia(a))")
+	values := make([]string, 0, 1000)
+	for i := range 1000 {
+		values = append(values, fmt.Sprintf("(%d, %d)", i%2, i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("set global tidb_enable_plan_change_guard = on")
+	defer tk.MustExec("set global tidb_enable_plan_change_guard = default")
+	// Clear the statement summary, which is shared by the tests.
+	tk.MustExec("set global tidb_enable_stmt_summary = 0")
+	tk.MustExec("set global tidb_enable_stmt_summary = 1")
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/executor/mockPlanChangeGuardCheckInterval", "return(100)"))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/executor/mockPlanChangeGuardCheckInterval"))
+	}()
+
+	// The index is used with the pseudo stats.
+	query := "select * from t where a = 1"
+	require.Contains(t, tk.MustQuery("explain format='hint' " + query).Rows()[0][0], "use_index(@`sel_1` `test`.`t` `ia`)")
+	for range 5 {
+		tk.MustQuery(query)
+	}
+
+	// Half of the rows match, so the table is scanned after analyze. The table scan is made slow, so the average
+	// latency of the new plan regresses and the old plan is captured.
+	tk.MustExec("analyze table t all columns")
+	require.NotContains(t, tk.MustQuery("explain format='hint' " + query).Rows()[0][0], "use_index(@`sel_1` `test`.`t` `ia`)")
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/executor/mockSleepInTableReaderNext", "return(100)"))
+	for range 5 {
+		tk.MustQuery(query)
+	}
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/executor/mockSleepInTableReaderNext"))
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery("show global bindings").Rows()) > 0
+	}, 10*time.Second, 100*time.Millisecond)
+	rows := tk.MustQuery("show global bindings").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "select * from `test` . `t` where `a` = ?", rows[0][0])
+	require.Contains(t, rows[0][1], "use_index(@`sel_1` `test`.`t` `ia`)")
+	require.Equal(t, "plan_guard", rows[0][8])
+	tk.MustQuery(query)
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+}
+
+func TestBindingRevalidation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index ia(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5)")
+	tk.MustExec("analyze table t all columns")
+	tk.MustExec("set global tidb_enable_binding_revalidation = on")
+	defer tk.MustExec("set global tidb_enable_binding_revalidation = default")
+	tk.MustExec("create global binding for select * from t where a = 1 using select /*+ ignore_index(t, ia) */ * from t where a = 1")
+
+	bindingStatus := func() string {
+		rows := tk.MustQuery("show global bindings").Rows()
+		require.Len(t, rows, 1)
+		return rows[0][3].(string)
+	}
+	suboptimal := func() string {
+		rows := tk.MustQuery("select suboptimal from mysql.binding_revalidation").Rows()
+		if len(rows) == 0 {
+			return "unknown"
+		}
+		return rows[0][0].(string)
+	}
+	require.Equal(t, "enabled", bindingStatus())
+
+	// The stats don't change significantly, the binding isn't revalidated.
+	tk.MustExec("insert into t values (6, 6)")
+	tk.MustExec("analyze table t all columns")
+	require.Equal(t, "unknown", suboptimal())
+
+	// The row count grows a lot, the bound full table scan becomes much more expensive than the index lookup.
+	values := make([]string, 0, 1000)
+	for i := range 1000 {
+		values = append(values, fmt.Sprintf("(%d, %d)", i+10, i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("analyze table t all columns")
+	require.Eventually(t, func() bool {
+		return suboptimal() == "1"
+	}, 10*time.Second, 100*time.Millisecond)
+	// The suboptimal binding is still in effect, and its status is unchanged.
+	require.Equal(t, "enabled", bindingStatus())
+	tk.MustQuery("select * from t where a = 1").Check(testkit.Rows("1 1"))
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+
+	// Most of the rows have the same value now, the full table scan is not suboptimal anymore.
+	tk.MustExec("insert into t select 1, b from t")
+	for range 2 {
+		tk.MustExec("insert into t select 1, b from t where a = 1")
+	}
+	tk.MustExec("analyze table t all columns")
+	require.Eventually(t, func() bool {
+		return suboptimal() == "0"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// The result of the dropped binding is cleaned up by the next revalidation.
+	tk.MustExec("create global binding for select * from t where b = 1 using select /*+ use_index(t, ia) */ * from t where b = 1")
+	tk.MustExec("drop global binding for select * from t where a = 1")
+	tk.MustExec("delete from t where a = 1 and b > 100")
+	tk.MustExec("analyze table t all columns")
+	require.Eventually(t, func() bool {
+		rows := tk.MustQuery("select sql_digest from mysql.binding_revalidation").Rows()
+		return len(rows) == 1 && rows[0][0] == tk.MustQuery("show global bindings").Rows()[0][9]
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestAnalyzeQuick(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a bigint, b bigint, index ia(a))")
+	tk.MustExec("insert into t values (1, 1)")
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tid := tbl.Meta().ID
+
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/statistics/handle/storage/mockRegionProperties", "return(1000)"))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/statistics/handle/storage/mockRegionProperties"))
+	}()
+	tk.MustExec("analyze table t quick")
+	// The stats are regarded as fully modified, so the full analyze runs later.
+	tk.MustQuery(fmt.Sprintf("select count, modify_count from mysql.stats_meta where table_id = %d", tid)).Check(testkit.Rows("1000 1000"))
+	// 100 bytes per row are divided evenly between the two columns.
+	tk.MustQuery(fmt.Sprintf("select hist_id, tot_col_size from mysql.stats_histograms where table_id = %d and is_index = 0 order by hist_id", tid)).
+		Check(testkit.Rows("1 50000", "2 50000"))
+	require.Equal(t, int64(1000), dom.StatsHandle().GetTableStats(tbl.Meta()).RealtimeCount)
+
+	// The full stats of the analyzed table are kept, only the count is refreshed.
+	tk.MustExec("analyze table t all columns")
+	snapshot := tk.MustQuery(fmt.Sprintf("select snapshot from mysql.stats_meta where table_id = %d", tid)).Rows()[0][0]
+	histograms := fmt.Sprintf("select is_index, hist_id, distinct_count, tot_col_size, stats_ver from mysql.stats_histograms where table_id = %d order by is_index, hist_id", tid)
+	analyzed := tk.MustQuery(histograms).Rows()
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_top_n where table_id = %d", tid)).Check(testkit.Rows("3"))
+	tk.MustExec("analyze table t quick")
+	tk.MustQuery(fmt.Sprintf("select count, modify_count, snapshot from mysql.stats_meta where table_id = %d", tid)).
+		Check(testkit.Rows(fmt.Sprintf("1000 999 %v", snapshot)))
+	tk.MustQuery(histograms).Check(analyzed)
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_top_n where table_id = %d", tid)).Check(testkit.Rows("3"))
+
+	tk.MustExec("lock stats t")
+	tk.MustExec("analyze table t quick")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1105 skip analyze locked table: test.t"))
+}
+
+func TestAnalyzeIncremental(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_analyze_version = 2")
+	tk.MustExec("create table t (a bigint auto_increment primary key, b int, index ib(b)) auto_id_cache 1")
+	tk.MustExec("insert into t values (1, 1), (2, 1), (3, 2), (4, 3)")
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tid := tbl.Meta().ID
+	checkMeta := func(expected ...string) {
+		tk.MustQuery(fmt.Sprintf("select count, last_analyzed_handle from mysql.stats_meta where table_id = %d", tid)).Check(testkit.Rows(expected...))
+	}
+
+	// The whole table is analyzed and the max handle is recorded at the first time.
+	tk.MustExec("analyze incremental table t")
+	checkMeta("4 4")
+	// Only the appended rows are sampled, and their stats are merged into the old ones.
+	tk.MustExec("insert into t values (5, 1), (6, 4), (7, 5)")
+	tk.MustExec("analyze incremental table t")
+	checkMeta("7 7")
+	tk.MustQuery(fmt.Sprintf("select distinct_count from mysql.stats_histograms where table_id = %d and is_index = 1", tid)).Check(testkit.Rows("5"))
+	// The old stats are kept if nothing is appended.
+	tk.MustExec("analyze incremental table t")
+	checkMeta("7 7")
+	tk.MustQuery(fmt.Sprintf("select distinct_count from mysql.stats_histograms where table_id = %d and is_index = 1", tid)).Check(testkit.Rows("5"))
+	// The other analyzes clear the max handle.
+	tk.MustExec("analyze table t")
+	checkMeta("7 <nil>")
+
+	tk.MustGetErrMsg("analyze incremental table t index ib", "the incremental analyze feature has already been removed in TiDB v7.5.0, so this will have no effect")
+	// The tables whose handles may not increase in the insert order are rejected.
+	for _, tc := range []struct {
+		create string
+		reason string
+	}{
+		{"create table t1 (a varchar(10) primary key clustered)", "the clustered index"},
+		{"create table t1 (a bigint auto_random primary key clustered)", "the AUTO_RANDOM primary key"},
+		{"create table t1 (a int) shard_row_id_bits = 4", "SHARD_ROW_ID_BITS"},
+		{"create table t1 (a bigint unsigned primary key clustered)", "the unsigned integer primary key"},
+		{"create table t1 (a bigint primary key clustered)", "the integer primary key without AUTO_INCREMENT"},
+		{"create table t1 (a bigint auto_increment primary key clustered)", "the AUTO_INCREMENT primary key cached by each TiDB instance"},
+		{"create table t1 (a int)", "the _tidb_rowid cached by each TiDB instance"},
+	} {
+		tk.MustExec("drop table if exists t1")
+		tk.MustExec(tc.create)
+		tk.MustGetErrMsg("analyze incremental table t1",
+			"ANALYZE INCREMENTAL TABLE doesn't support the table t1 with "+tc.reason+", whose handles may not increase in the insert order")
+	}
+	// The IDs allocated without the cache of each TiDB instance increase in the insert order.
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1 (a bigint auto_increment primary key clustered, b int) auto_id_cache 1")
+	tk.MustExec("create table t2 (a int) auto_id_cache 1")
+	tk.MustExec("insert into t1 (b) values (1), (2)")
+	tk.MustExec("insert into t2 values (1), (2)")
+	tk.MustExec("analyze incremental table t1")
+	tk.MustExec("analyze incremental table t2")
+	tk.MustExec("set @@tidb_analyze_version = 1")
+	tk.MustGetErrMsg("analyze incremental table t", "ANALYZE INCREMENTAL TABLE is only supported by the version 2 stats")
+}
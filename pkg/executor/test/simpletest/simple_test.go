@@ -629,6 +629,43 @@ func TestDropStats(t *testing.T) {
 	h.SetLease(0)
 }
 
+func TestDropColumnAndIndexStats(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_partition_prune_mode='dynamic'")
+	tk.MustExec("create table t (a int, b int, c int, index ia(a), index ib(b)) partition by hash(a) partitions 2")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3)")
+	tk.MustExec("analyze table t")
+	is := dom.InfoSchema()
+	tbl, err := is.TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tableInfo := tbl.Meta()
+	ids := fmt.Sprintf("%d, %d, %d", tableInfo.ID, tableInfo.Partition.Definitions[0].ID, tableInfo.Partition.Definitions[1].ID)
+	checkAnalyzed := func(expected ...string) {
+		tk.MustQuery("select is_index, hist_id, count(*) from mysql.stats_histograms where stats_ver > 0 and table_id in (" + ids + ") " +
+			"group by is_index, hist_id order by is_index, hist_id").Check(testkit.Rows(expected...))
+	}
+	checkAnalyzed("0 1 3", "0 2 3", "0 3 3", "1 1 3", "1 2 3")
+
+	tk.MustGetErrMsg("drop stats t columns (d)", "[schema:1054]Unknown column 'd' in 't'")
+	tk.MustGetErrMsg("drop stats t columns (a), indexes (ic)", "[schema:1176]Key 'ic' doesn't exist in table 't'")
+	checkAnalyzed("0 1 3", "0 2 3", "0 3 3", "1 1 3", "1 2 3")
+
+	tk.MustExec("drop stats t columns (a, c), indexes (ib)")
+	checkAnalyzed("0 2 3", "1 1 3")
+	tk.MustQuery("select distinct is_index, hist_id from mysql.stats_buckets where table_id in (" + ids + ") order by is_index, hist_id").Check(
+		testkit.Rows("0 2", "1 1"))
+	statsTbl := dom.StatsHandle().GetTableStats(tableInfo)
+	require.Equal(t, statistics.Version2, statsTbl.StatsVer)
+	require.Equal(t, int64(statistics.Version0), statsTbl.GetCol(1).StatsVer)
+	require.Equal(t, int64(statistics.Version0), statsTbl.GetIdx(2).StatsVer)
+	require.Equal(t, int64(statistics.Version2), statsTbl.GetIdx(1).StatsVer)
+
+	tk.MustExec("analyze table t")
+	checkAnalyzed("0 1 3", "0 2 3", "0 3 3", "1 1 3", "1 2 3")
+}
+
 func TestDropStatsForMultipleTable(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
 	testKit := testkit.NewTestKit(t, store)
@@ -990,6 +990,8 @@ var funcs = map[string]functionClass{
 	ast.TiDBDecodeBinaryPlan: &tidbDecodePlanFunctionClass{baseFunctionClass{ast.TiDBDecodeBinaryPlan, 1, 1}},
 	ast.TiDBDecodeSQLDigests: &tidbDecodeSQLDigestsFunctionClass{baseFunctionClass: baseFunctionClass{ast.TiDBDecodeSQLDigests, 1, 2}},
 	ast.TiDBEncodeSQLDigest:  &tidbEncodeSQLDigestFunctionClass{baseFunctionClass{ast.TiDBEncodeSQLDigest, 1, 1}},
+	ast.StatsHealthy:         &statsHealthyFunctionClass{baseFunctionClass{ast.StatsHealthy, 1, 2}},
+	ast.LastAnalyzeTime:      &lastAnalyzeTimeFunctionClass{baseFunctionClass{ast.LastAnalyzeTime, 1, 2}},
 
 	// TiDB Sequence function.
 	ast.NextVal: &nextValFunctionClass{baseFunctionClass{ast.NextVal, 1, 1}},
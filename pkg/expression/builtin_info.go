@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/tidb/pkg/expression/expropt"
 	infoschema "github.com/pingcap/tidb/pkg/infoschema/context"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
@@ -72,6 +73,8 @@ var (
 	_ functionClass = &setValFunctionClass{}
 	_ functionClass = &formatBytesFunctionClass{}
 	_ functionClass = &formatNanoTimeFunctionClass{}
+	_ functionClass = &statsHealthyFunctionClass{}
+	_ functionClass = &lastAnalyzeTimeFunctionClass{}
 )
 
 var (
@@ -96,6 +99,8 @@ var (
 	_ builtinFunc = &builtinSetValSig{}
 	_ builtinFunc = &builtinFormatBytesSig{}
 	_ builtinFunc = &builtinFormatNanoTimeSig{}
+	_ builtinFunc = &builtinStatsHealthySig{}
+	_ builtinFunc = &builtinLastAnalyzeTimeSig{}
 )
 
 type databaseFunctionClass struct {
@@ -1789,3 +1794,152 @@ func (b *builtinFormatNanoTimeSig) evalString(ctx EvalContext, row chunk.Row) (s
 	}
 	return GetFormatNanoTime(val), false, nil
 }
+
+// tableStatsHealthReader reads the stats health of the table specified by the arguments of STATS_HEALTHY() and
+// LAST_ANALYZE_TIME(), which are either the table name in the current database or the database name and the table
+// name.
+type tableStatsHealthReader struct {
+	expropt.SQLExecutorPropReader
+	expropt.PrivilegeCheckerPropReader
+}
+
+// RequiredOptionalEvalProps implements the RequireOptionalEvalProps interface.
+func (r tableStatsHealthReader) RequiredOptionalEvalProps() OptionalEvalPropKeySet {
+	return r.SQLExecutorPropReader.RequiredOptionalEvalProps() |
+		r.PrivilegeCheckerPropReader.RequiredOptionalEvalProps()
+}
+
+// read returns the row of the table in information_schema.stats_health_summary, the isNull is true if the table
+// doesn't exist or the user has no privilege on it.
+func (r tableStatsHealthReader) read(ctx EvalContext, row chunk.Row, args []Expression) (chunk.Row, bool, error) {
+	dbName := ctx.CurrentDB()
+	tblArg := args[0]
+	if len(args) > 1 {
+		name, isNull, err := args[0].EvalString(ctx, row)
+		if isNull || err != nil {
+			return chunk.Row{}, true, err
+		}
+		dbName, tblArg = name, args[1]
+	}
+	tblName, isNull, err := tblArg.EvalString(ctx, row)
+	if isNull || err != nil {
+		return chunk.Row{}, true, err
+	}
+	if dbName == "" {
+		return chunk.Row{}, true, plannererrors.ErrNoDB
+	}
+	dbName, tblName = strings.ToLower(dbName), strings.ToLower(tblName)
+	privChecker, err := r.GetPrivilegeChecker(ctx)
+	if err != nil {
+		return chunk.Row{}, true, err
+	}
+	if !privChecker.RequestVerification(dbName, tblName, "", mysql.AllPrivMask) {
+		return chunk.Row{}, true, nil
+	}
+	exec, err := r.GetSQLExecutor(ctx)
+	if err != nil {
+		return chunk.Row{}, true, err
+	}
+	goCtx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	rows, _, err := exec.ExecRestrictedSQL(goCtx, nil,
+		"select healthy, last_analyze_time from information_schema.stats_health_summary where table_schema = %? and table_name = %?",
+		dbName, tblName)
+	if err != nil || len(rows) == 0 {
+		return chunk.Row{}, true, err
+	}
+	return rows[0], false, nil
+}
+
+type statsHealthyFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *statsHealthyFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := make([]types.EvalType, len(args))
+	for i := range args {
+		argTps[i] = types.ETString
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(3)
+	sig := &builtinStatsHealthySig{baseBuiltinFunc: bf}
+	return sig, nil
+}
+
+type builtinStatsHealthySig struct {
+	baseBuiltinFunc
+	tableStatsHealthReader
+}
+
+func (b *builtinStatsHealthySig) Clone() builtinFunc {
+	newSig := &builtinStatsHealthySig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// RequiredOptionalEvalProps implements the RequireOptionalEvalProps interface.
+func (b *builtinStatsHealthySig) RequiredOptionalEvalProps() OptionalEvalPropKeySet {
+	return b.tableStatsHealthReader.RequiredOptionalEvalProps()
+}
+
+// evalInt evals a builtinStatsHealthySig, it returns the stats health of the table, or of the least healthy
+// partition of the partitioned table, like information_schema.stats_health_summary.
+func (b *builtinStatsHealthySig) evalInt(ctx EvalContext, row chunk.Row) (int64, bool, error) {
+	res, isNull, err := b.read(ctx, row, b.args)
+	if isNull || err != nil || res.IsNull(0) {
+		return 0, true, err
+	}
+	return res.GetInt64(0), false, nil
+}
+
+type lastAnalyzeTimeFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *lastAnalyzeTimeFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := make([]types.EvalType, len(args))
+	for i := range args {
+		argTps[i] = types.ETString
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETDatetime, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	bf.setDecimalAndFlenForDatetime(0)
+	sig := &builtinLastAnalyzeTimeSig{baseBuiltinFunc: bf}
+	return sig, nil
+}
+
+type builtinLastAnalyzeTimeSig struct {
+	baseBuiltinFunc
+	tableStatsHealthReader
+}
+
+func (b *builtinLastAnalyzeTimeSig) Clone() builtinFunc {
+	newSig := &builtinLastAnalyzeTimeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// RequiredOptionalEvalProps implements the RequireOptionalEvalProps interface.
+func (b *builtinLastAnalyzeTimeSig) RequiredOptionalEvalProps() OptionalEvalPropKeySet {
+	return b.tableStatsHealthReader.RequiredOptionalEvalProps()
+}
+
+// evalTime evals a builtinLastAnalyzeTimeSig, it returns the last analyze time of the table, or of the least
+// recently analyzed partition of the partitioned table. It's NULL if the table is never analyzed.
+func (b *builtinLastAnalyzeTimeSig) evalTime(ctx EvalContext, row chunk.Row) (types.Time, bool, error) {
+	res, isNull, err := b.read(ctx, row, b.args)
+	if isNull || err != nil || res.IsNull(1) {
+		return types.ZeroTime, true, err
+	}
+	return res.GetTime(1), false, nil
+}
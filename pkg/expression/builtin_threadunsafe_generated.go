@@ -181,6 +181,16 @@ func (s *builtinSetValSig) SafeToShareAcrossSession() bool {
 	return false
 }
 
+// SafeToShareAcrossSession implements BuiltinFunc.SafeToShareAcrossSession.
+func (s *builtinStatsHealthySig) SafeToShareAcrossSession() bool {
+	return false
+}
+
+// SafeToShareAcrossSession implements BuiltinFunc.SafeToShareAcrossSession.
+func (s *builtinLastAnalyzeTimeSig) SafeToShareAcrossSession() bool {
+	return false
+}
+
 // SafeToShareAcrossSession implements BuiltinFunc.SafeToShareAcrossSession.
 func (s *builtinJSONSchemaValidSig) SafeToShareAcrossSession() bool {
 	return false
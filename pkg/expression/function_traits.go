@@ -31,6 +31,8 @@ var UnCacheableFunctions = map[string]struct{}{
 	ast.RowCount:             {},
 	ast.Version:              {},
 	ast.Like:                 {},
+	ast.StatsHealthy:         {},
+	ast.LastAnalyzeTime:      {},
 
 	// functions below are incompatible with (non-prep) plan cache, we'll fix them one by one later.
 	ast.JSONExtract:      {}, // cannot pass TestFuncJSON
@@ -105,6 +107,7 @@ var IllegalFunctions4GeneratedColumns = map[string]struct{}{
 	ast.IsFreeLock:           {},
 	ast.IsUsedLock:           {},
 	ast.JSONMerge:            {},
+	ast.LastAnalyzeTime:      {},
 	ast.LastInsertId:         {},
 	ast.LoadFile:             {},
 	ast.LocalTime:            {},
@@ -122,6 +125,7 @@ var IllegalFunctions4GeneratedColumns = map[string]struct{}{
 	ast.SessionUser:          {},
 	ast.SetVar:               {},
 	ast.Sleep:                {},
+	ast.StatsHealthy:         {},
 	ast.Sysdate:              {},
 	ast.SystemUser:           {},
 	ast.TiDBBoundedStaleness: {},
@@ -4219,3 +4219,26 @@ func TestIssue57608(t *testing.T) {
 		))
 	}
 }
+
+func TestStatsHealthyAndLastAnalyzeTime(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4)")
+	tk.MustExec("analyze table t all columns")
+	tk.MustQuery("select stats_healthy('t'), stats_healthy('TEST', 'T'), last_analyze_time('test', 't') is not null").
+		Check(testkit.Rows("100 100 1"))
+	tk.MustQuery("select stats_healthy('no_such_table'), last_analyze_time('test', 'no_such_table')").Check(testkit.Rows("<nil> <nil>"))
+	// The functions can guard the queries against the stale stats.
+	tk.MustQuery("select count(*) from t where stats_healthy('t') >= 80").Check(testkit.Rows("4"))
+
+	// The stats of the tables without any privilege are invisible.
+	tk.MustExec("create user 'stats_user'@'localhost'")
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "stats_user", Hostname: "localhost"}, nil, nil, nil))
+	tk1.MustQuery("select stats_healthy('test', 't'), last_analyze_time('test', 't')").Check(testkit.Rows("<nil> <nil>"))
+	tk.MustExec("grant select on test.t to 'stats_user'@'localhost'")
+	tk1.MustQuery("select stats_healthy('test', 't')").Check(testkit.Rows("100"))
+	tk1.MustGetErrCode("select stats_healthy('t')", errno.ErrNoDB)
+}
@@ -55,10 +55,14 @@ const (
 	ClusterTableMemoryUsage = "CLUSTER_MEMORY_USAGE"
 	// ClusterTableMemoryUsageOpsHistory is the memory control operators history of tidb cluster.
 	ClusterTableMemoryUsageOpsHistory = "CLUSTER_MEMORY_USAGE_OPS_HISTORY"
+	// ClusterTableMemoryUsageConsumers is the memory usage of the memory consumers of tidb cluster.
+	ClusterTableMemoryUsageConsumers = "CLUSTER_MEMORY_USAGE_CONSUMERS"
 	// ClusterTableTiDBIndexUsage is a table to show the usage stats of indexes across the whole cluster.
 	ClusterTableTiDBIndexUsage = "CLUSTER_TIDB_INDEX_USAGE"
 	// ClusterTableTiDBPlanCache is the plan cache status of tidb cluster.
 	ClusterTableTiDBPlanCache = "CLUSTER_TIDB_PLAN_CACHE"
+	// ClusterTableUnusedIndexAdvice is the unused indexes reported by each instance of tidb cluster.
+	ClusterTableUnusedIndexAdvice = "CLUSTER_UNUSED_INDEX_ADVICE"
 )
 
 // memTableToAllTiDBClusterTables means add memory table to cluster table that will send cop request to all TiDB nodes.
@@ -74,8 +78,10 @@ var memTableToAllTiDBClusterTables = map[string]string{
 	TableTrxSummary:               ClusterTableTrxSummary,
 	TableMemoryUsage:              ClusterTableMemoryUsage,
 	TableMemoryUsageOpsHistory:    ClusterTableMemoryUsageOpsHistory,
+	TableMemoryUsageConsumers:     ClusterTableMemoryUsageConsumers,
 	TableTiDBIndexUsage:           ClusterTableTiDBIndexUsage,
 	TableTiDBPlanCache:            ClusterTableTiDBPlanCache,
+	TableUnusedIndexAdvice:        ClusterTableUnusedIndexAdvice,
 }
 
 var memTableToAllTiDBClusterTablesWithLowerCase = make(map[string]string)
@@ -218,6 +218,32 @@ const (
 	TableTiDBIndexUsage = "TIDB_INDEX_USAGE"
 	// TableTiDBPlanCache is the plan cache table.
 	TableTiDBPlanCache = "TIDB_PLAN_CACHE"
+	// TableStatsHealthSummary is the summary of the stats health of each table.
+	TableStatsHealthSummary = "STATS_HEALTH_SUMMARY"
+	// TableStatsCacheUsage is the memory usage, the load time and the eviction count of the stats in the stats cache.
+	TableStatsCacheUsage = "STATS_CACHE_USAGE"
+	// TableAnalyzeFailureSummary is the failure rate of the analyze jobs of each table by the fail category.
+	TableAnalyzeFailureSummary = "ANALYZE_FAILURE_SUMMARY"
+	// TableSessionEstimationErrors is the estimation errors recorded by the current session when
+	// tidb_record_estimation_errors is on.
+	TableSessionEstimationErrors = "SESSION_ESTIMATION_ERRORS"
+	// TableStatsDrift is the drift of the data distributions of the columns of the hot tables from their stats.
+	TableStatsDrift = "STATS_DRIFT"
+	// TableStatsDeltaPending is the stats deltas of the current instance which haven't been persisted for longer than
+	// tidb_stats_delta_pending_threshold.
+	TableStatsDeltaPending = "STATS_DELTA_PENDING"
+	// TableEstimationErrorRanking is the tables and their operators ranked by the cumulative estimation errors kept in
+	// mysql.stats_estimation_errors within tidb_estimation_error_window.
+	TableEstimationErrorRanking = "ESTIMATION_ERROR_RANKING"
+	// TableUnusedIndexAdvice is the secondary indexes not used by the current instance within tidb_unused_index_window,
+	// ranked by the maintenance cost of their tables.
+	TableUnusedIndexAdvice = "UNUSED_INDEX_ADVICE"
+	// TableMissingIndexCandidates is the columns filtered by the selective predicates without an index on them, ranked
+	// by the estimated benefit of an index.
+	TableMissingIndexCandidates = "MISSING_INDEX_CANDIDATES"
+	// TableMemoryUsageConsumers is the memory usage of the consumers registered with the server memory controller,
+	// e.g. the stats cache and the analyze jobs.
+	TableMemoryUsageConsumers = "MEMORY_USAGE_CONSUMERS"
 )
 
 const (
@@ -346,6 +372,18 @@ var tableIDMap = map[string]int64{
 	ClusterTableTiDBPlanCache:            autoid.InformationSchemaDBID + 97,
 	TableTiDBStatementsStats:             autoid.InformationSchemaDBID + 98,
 	ClusterTableTiDBStatementsStats:      autoid.InformationSchemaDBID + 99,
+	TableStatsHealthSummary:              autoid.InformationSchemaDBID + 100,
+	TableStatsCacheUsage:                 autoid.InformationSchemaDBID + 101,
+	TableAnalyzeFailureSummary:           autoid.InformationSchemaDBID + 102,
+	TableSessionEstimationErrors:         autoid.InformationSchemaDBID + 103,
+	TableStatsDrift:                      autoid.InformationSchemaDBID + 104,
+	TableStatsDeltaPending:               autoid.InformationSchemaDBID + 105,
+	TableEstimationErrorRanking:          autoid.InformationSchemaDBID + 106,
+	TableUnusedIndexAdvice:               autoid.InformationSchemaDBID + 107,
+	ClusterTableUnusedIndexAdvice:        autoid.InformationSchemaDBID + 108,
+	TableMissingIndexCandidates:          autoid.InformationSchemaDBID + 109,
+	TableMemoryUsageConsumers:            autoid.InformationSchemaDBID + 110,
+	ClusterTableMemoryUsageConsumers:     autoid.InformationSchemaDBID + 111,
 }
 
 // columnInfo represents the basic column information of all kinds of INFORMATION_SCHEMA tables
@@ -1043,6 +1081,7 @@ var tableAnalyzeStatusCols = []columnInfo{
 	{name: "REMAINING_SECONDS", tp: mysql.TypeVarchar, size: 512},
 	{name: "PROGRESS", tp: mysql.TypeDouble, size: 22, decimal: 6},
 	{name: "ESTIMATED_TOTAL_ROWS", tp: mysql.TypeLonglong, size: 21, flag: mysql.UnsignedFlag},
+	{name: "FAIL_CATEGORY", tp: mysql.TypeVarchar, size: 32},
 }
 
 // TableTiKVRegionStatusCols is TiKV region status mem table columns.
@@ -1760,6 +1799,15 @@ var tableMemoryUsageOpsHistoryCols = []columnInfo{
 	{name: "SQL_TEXT", tp: mysql.TypeVarchar, size: 256},
 }
 
+var tableMemoryUsageConsumersCols = []columnInfo{
+	{name: "NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "MEMORY_USAGE", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag, comment: "The memory usage of the consumer in bytes"},
+	{name: "MEMORY_MAX_USED", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag, comment: "The max memory usage of the consumer in bytes"},
+	{name: "SHRINKABLE", tp: mysql.TypeTiny, size: 1, flag: mysql.NotNullFlag, comment: "Whether the consumer can be shrunk when tidb_server_memory_limit is exceeded"},
+	{name: "SHRINK_LAST", tp: mysql.TypeDatetime, size: 64, comment: "The last time the consumer is shrunk"},
+	{name: "SHRINK_TOTAL", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag, comment: "How many times the consumer is shrunk"},
+}
+
 var tableResourceGroupsCols = []columnInfo{
 	{name: "NAME", tp: mysql.TypeVarchar, size: resourcegroup.MaxGroupNameLength, flag: mysql.NotNullFlag},
 	{name: "RU_PER_SEC", tp: mysql.TypeVarchar, size: 21},
@@ -1821,6 +1869,117 @@ var tableTiDBIndexUsage = []columnInfo{
 	{name: "LAST_ACCESS_TIME", tp: mysql.TypeDatetime, size: 21},
 }
 
+var tableStatsHealthSummaryCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TIDB_TABLE_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "HEALTHY", tp: mysql.TypeLonglong, size: 21, comment: "The stats health of the table, or of the least healthy partition of the partitioned table"},
+	{name: "LAST_ANALYZE_TIME", tp: mysql.TypeDatetime, size: 19, comment: "The last analyze time of the table, or of the least recently analyzed partition of the partitioned table"},
+	{name: "PSEUDO_STATS_USAGE_LAST_HOUR", tp: mysql.TypeLonglong, size: 21, comment: "How many times the optimizer of this instance used the pseudo stats of the table in the last hour"},
+	{name: "PENDING_STATS_LOADS", tp: mysql.TypeLonglong, size: 21, comment: "The number of the columns and indexes of the table waiting for the async stats loading"},
+	{name: "LOCK_STATE", tp: mysql.TypeVarchar, size: 16},
+}
+
+var tableAnalyzeFailureSummaryCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "FAIL_CATEGORY", tp: mysql.TypeVarchar, size: 32},
+	{name: "FAILED_JOBS", tp: mysql.TypeLonglong, size: 21, comment: "The number of the failed analyze jobs of the table in the category"},
+	{name: "TOTAL_JOBS", tp: mysql.TypeLonglong, size: 21, comment: "The number of the finished and the failed analyze jobs of the table"},
+	{name: "FAILURE_RATE", tp: mysql.TypeDouble, size: 22, decimal: 6, comment: "FAILED_JOBS / TOTAL_JOBS"},
+	{name: "LAST_FAIL_TIME", tp: mysql.TypeDatetime, size: 19},
+}
+
+var tableSessionEstimationErrorsCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TIDB_TABLE_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "OPERATOR", tp: mysql.TypeVarchar, size: 128},
+	{name: "EXECUTIONS", tp: mysql.TypeLonglong, size: 21, comment: "The number of the recorded executions of the operator"},
+	{name: "AVG_EST_ROWS", tp: mysql.TypeDouble, size: 22},
+	{name: "AVG_ACT_ROWS", tp: mysql.TypeDouble, size: 22},
+	{name: "MAX_Q_ERROR", tp: mysql.TypeDouble, size: 22, comment: "The max of max((est+1)/(act+1), (act+1)/(est+1)) of the executions"},
+	{name: "LAST_SEEN_AT", tp: mysql.TypeDatetime, size: 19},
+}
+
+var tableStatsDriftCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TIDB_TABLE_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "COLUMN_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "SAMPLE_ROWS", tp: mysql.TypeLonglong, size: 21, comment: "The number of the sampled non-null values of the column"},
+	{name: "DRIFT_DISTANCE", tp: mysql.TypeDouble, size: 22, comment: "The Kolmogorov-Smirnov distance between the sampled and the analyzed distributions"},
+	{name: "DRIFTED", tp: mysql.TypeVarchar, size: 3},
+	{name: "CHECK_TIME", tp: mysql.TypeDatetime, size: 19},
+}
+
+var tableStatsDeltaPendingCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "PARTITION_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TIDB_TABLE_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "MODIFY_COUNT", tp: mysql.TypeLonglong, size: 21, comment: "The number of the modified rows not yet persisted"},
+	{name: "ROW_COUNT_DELTA", tp: mysql.TypeLonglong, size: 21, comment: "The change of the row count not yet persisted"},
+	{name: "FIRST_CHANGE_TIME", tp: mysql.TypeDatetime, size: 19},
+	{name: "PENDING_SECONDS", tp: mysql.TypeLonglong, size: 21},
+}
+
+var tableEstimationErrorRankingCols = []columnInfo{
+	{name: "TABLE_RANK", tp: mysql.TypeLonglong, size: 21, comment: "The rank of the table by TABLE_CUMULATIVE_ERROR"},
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TIDB_TABLE_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "TABLE_CUMULATIVE_ERROR", tp: mysql.TypeDouble, size: 22, comment: "The sum of CUMULATIVE_ERROR of the operators of the table"},
+	{name: "OPERATOR", tp: mysql.TypeVarchar, size: 128},
+	{name: "COLUMN_NAMES", tp: mysql.TypeVarchar, size: 256, comment: "The columns of the index scanned by the operator"},
+	{name: "DIGEST_COUNT", tp: mysql.TypeLonglong, size: 21, comment: "The number of the statements running the operator"},
+	{name: "SAMPLE_COUNT", tp: mysql.TypeLonglong, size: 21},
+	{name: "SUM_EST_ROWS", tp: mysql.TypeDouble, size: 22},
+	{name: "SUM_ACT_ROWS", tp: mysql.TypeDouble, size: 22},
+	{name: "CUMULATIVE_ERROR", tp: mysql.TypeDouble, size: 22, comment: "The sum of |sum_act_rows - sum_est_rows| of the statements"},
+	{name: "MAX_Q_ERROR", tp: mysql.TypeDouble, size: 22},
+	{name: "LAST_SEEN_AT", tp: mysql.TypeDatetime, size: 19},
+}
+
+var tableUnusedIndexAdviceCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "INDEX_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "LAST_ACCESS_TIME", tp: mysql.TypeDatetime, size: 19, comment: "NULL if the index isn't used since COLLECTED_SINCE"},
+	{name: "TABLE_ROWS", tp: mysql.TypeLonglong, size: 21},
+	{name: "WRITE_ROWS_PER_DAY", tp: mysql.TypeDouble, size: 22, comment: "The rows modified per day since the last analyze, which the index is maintained for"},
+	{name: "COLLECTED_SINCE", tp: mysql.TypeDatetime, size: 19, comment: "The time since which the index usage is collected by the instance"},
+	{name: "CONFIDENCE", tp: mysql.TypeDouble, size: 22, comment: "The coverage of tidb_unused_index_window by the index usage collection, from 0 to 1"},
+}
+
+var tableMissingIndexCandidatesCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "COLUMN_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "POINT_PREDICATES", tp: mysql.TypeLonglong, size: 21},
+	{name: "NARROW_RANGE_PREDICATES", tp: mysql.TypeLonglong, size: 21},
+	{name: "WIDE_RANGE_PREDICATES", tp: mysql.TypeLonglong, size: 21},
+	{name: "TABLE_ROWS", tp: mysql.TypeLonglong, size: 21},
+	{name: "NDV", tp: mysql.TypeLonglong, size: 21},
+	{name: "SELECTIVITY", tp: mysql.TypeDouble, size: 22, comment: "The estimated selectivity of a point predicate on the column"},
+	{name: "QUERY_FREQUENCY", tp: mysql.TypeLonglong, size: 21, comment: "The executions of the SELECT statements on the table in the statements summary of the last day"},
+	{name: "ESTIMATED_BENEFIT", tp: mysql.TypeDouble, size: 22, comment: "The estimated rows not to be scanned by the statements on the table with the index"},
+	{name: "CREATE_STATEMENT", tp: mysql.TypeVarchar, size: 256},
+}
+
+var tableStatsCacheUsageCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "PARTITION_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TIDB_TABLE_ID", tp: mysql.TypeLonglong, size: 21, comment: "The ID of the table or the partition"},
+	{name: "ITEM_TYPE", tp: mysql.TypeVarchar, size: 16, comment: "table, column or index"},
+	{name: "ITEM_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "LOAD_STATUS", tp: mysql.TypeVarchar, size: 16},
+	{name: "MEMORY_USAGE", tp: mysql.TypeLonglong, size: 21, comment: "The memory usage of the stats in bytes"},
+	{name: "LOAD_TIME", tp: mysql.TypeDatetime, size: 19, comment: "The last time the stats are loaded into the stats cache of this instance"},
+	{name: "EVICT_COUNT", tp: mysql.TypeLonglong, size: 21, comment: "How many times the stats are evicted from the stats cache of this instance"},
+}
+
 var tablePlanCache = []columnInfo{
 	{name: "SQL_DIGEST", tp: mysql.TypeVarchar, size: 64},
 	{name: "SQL_TEXT", tp: mysql.TypeLongBlob, size: types.UnspecifiedLength},
@@ -2485,6 +2644,16 @@ var tableNameToColumns = map[string][]columnInfo{
 	TableKeywords:                           tableKeywords,
 	TableTiDBIndexUsage:                     tableTiDBIndexUsage,
 	TableTiDBPlanCache:                      tablePlanCache,
+	TableStatsHealthSummary:                 tableStatsHealthSummaryCols,
+	TableStatsCacheUsage:                    tableStatsCacheUsageCols,
+	TableAnalyzeFailureSummary:              tableAnalyzeFailureSummaryCols,
+	TableSessionEstimationErrors:            tableSessionEstimationErrorsCols,
+	TableStatsDrift:                         tableStatsDriftCols,
+	TableStatsDeltaPending:                  tableStatsDeltaPendingCols,
+	TableEstimationErrorRanking:             tableEstimationErrorRankingCols,
+	TableUnusedIndexAdvice:                  tableUnusedIndexAdviceCols,
+	TableMissingIndexCandidates:             tableMissingIndexCandidatesCols,
+	TableMemoryUsageConsumers:               tableMemoryUsageConsumersCols,
 }
 
 func createInfoSchemaTable(_ autoid.Allocators, _ func() (pools.Resource, error), meta *model.TableInfo) (table.Table, error) {
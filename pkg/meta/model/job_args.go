@@ -213,12 +213,15 @@ type CreateTableArgs struct {
 	OldViewTblID   int64 `json:"old_view_tbl_id,omitempty"`
 	// used for create table.
 	FKCheck bool `json:"fk_check,omitempty"`
+	// StatsReferTableID is the ID of the table whose statistics are copied to the
+	// new table, it's used for CREATE TABLE ... LIKE ... WITH STATISTICS.
+	StatsReferTableID int64 `json:"stats_refer_table_id,omitempty"`
 }
 
 func (a *CreateTableArgs) getArgsV1(job *Job) []any {
 	switch job.Type {
 	case ActionCreateTable:
-		return []any{a.TableInfo, a.FKCheck}
+		return []any{a.TableInfo, a.FKCheck, a.StatsReferTableID}
 	case ActionCreateView:
 		return []any{a.TableInfo, a.OnExistReplace, a.OldViewTblID}
 	case ActionCreateSequence:
@@ -231,7 +234,7 @@ func (a *CreateTableArgs) decodeV1(job *Job) error {
 	a.TableInfo = &TableInfo{}
 	switch job.Type {
 	case ActionCreateTable:
-		return errors.Trace(job.decodeArgs(a.TableInfo, &a.FKCheck))
+		return errors.Trace(job.decodeArgs(a.TableInfo, &a.FKCheck, &a.StatsReferTableID))
 	case ActionCreateView:
 		return errors.Trace(job.decodeArgs(a.TableInfo, &a.OnExistReplace, &a.OldViewTblID))
 	case ActionCreateSequence:
@@ -343,6 +346,9 @@ type TruncateTableArgs struct {
 	NewTableID      int64   `json:"new_table_id,omitempty"`
 	NewPartitionIDs []int64 `json:"new_partition_ids,omitempty"`
 	OldPartitionIDs []int64 `json:"old_partition_ids,omitempty"`
+	// StatsRefillFactor is used to scale the stats of the truncated table, which
+	// are kept as the initial stats of the new table. 0 means the stats are not kept.
+	StatsRefillFactor float64 `json:"stats_refill_factor,omitempty"`
 
 	// context vars
 	NewPartIDsWithPolicy           []int64 `json:"-"`
@@ -356,14 +362,15 @@ func (a *TruncateTableArgs) getArgsV1(job *Job) []any {
 		// add a placeholder here, they will be filled by job submitter.
 		// the last param is not required for execution, we need it to calculate
 		// number of new IDs to generate.
-		return []any{a.NewTableID, a.FKCheck, a.NewPartitionIDs, len(a.OldPartitionIDs)}
+		return []any{a.NewTableID, a.FKCheck, a.NewPartitionIDs, len(a.OldPartitionIDs), a.StatsRefillFactor}
 	}
 	return []any{a.OldPartitionIDs, a.NewPartitionIDs}
 }
 
 func (a *TruncateTableArgs) decodeV1(job *Job) error {
 	if job.Type == ActionTruncateTable {
-		return errors.Trace(job.decodeArgs(&a.NewTableID, &a.FKCheck, &a.NewPartitionIDs))
+		var oldPartitionCnt int
+		return errors.Trace(job.decodeArgs(&a.NewTableID, &a.FKCheck, &a.NewPartitionIDs, &oldPartitionCnt, &a.StatsRefillFactor))
 	}
 	return errors.Trace(job.decodeArgs(&a.OldPartitionIDs, &a.NewPartitionIDs))
 }
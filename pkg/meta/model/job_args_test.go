@@ -250,10 +250,11 @@ func TestFinishedDropTableArgs(t *testing.T) {
 
 func TestTruncateTableArgs(t *testing.T) {
 	inArgs := &TruncateTableArgs{
-		NewTableID:      1,
-		FKCheck:         true,
-		OldPartitionIDs: []int64{11, 2},
-		NewPartitionIDs: []int64{2, 3},
+		NewTableID:        1,
+		FKCheck:           true,
+		OldPartitionIDs:   []int64{11, 2},
+		NewPartitionIDs:   []int64{2, 3},
+		StatsRefillFactor: 0.5,
 	}
 	for _, tp := range []ActionType{ActionTruncateTable, ActionTruncateTablePartition} {
 		for _, v := range []JobVersion{JobVersion1, JobVersion2} {
@@ -264,6 +265,7 @@ func TestTruncateTableArgs(t *testing.T) {
 			if tp == ActionTruncateTable {
 				require.Equal(t, int64(1), args.NewTableID)
 				require.Equal(t, true, args.FKCheck)
+				require.Equal(t, 0.5, args.StatsRefillFactor)
 			} else {
 				require.Equal(t, []int64{11, 2}, args.OldPartitionIDs)
 			}
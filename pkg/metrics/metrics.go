@@ -245,6 +245,21 @@ func RegisterMetrics() {
 	prometheus.MustRegister(StatsHealthyGauge)
 	prometheus.MustRegister(StatsDeltaLoadHistogram)
 	prometheus.MustRegister(StatsDeltaUpdateHistogram)
+	prometheus.MustRegister(StatsDeltaPendingTablesGauge)
+	prometheus.MustRegister(StatsDeltaOldestPendingAgeGauge)
+	prometheus.MustRegister(StatsDeltaFlushBatchSizeHistogram)
+	prometheus.MustRegister(StatsDeltaFlushErrorCounter)
+	prometheus.MustRegister(CardinalityFeedbackCounter)
+	prometheus.MustRegister(DynamicSamplingCounter)
+	prometheus.MustRegister(PlanChangeGuardCounter)
+	prometheus.MustRegister(EstimationErrorCounter)
+	prometheus.MustRegister(AnalyzeDurationHistogram)
+	prometheus.MustRegister(AnalyzeScannedRowsHistogram)
+	prometheus.MustRegister(AnalyzePhaseDurationHistogram)
+	prometheus.MustRegister(UnhealthyHotTablesGauge)
+	prometheus.MustRegister(OldestFailedAutoAnalyzeGauge)
+	prometheus.MustRegister(SyncLoadTimeoutRatioGauge)
+	prometheus.MustRegister(GlobalStatsMergeBacklogGauge)
 	prometheus.MustRegister(TxnStatusEnteringCounter)
 	prometheus.MustRegister(TxnDurationHistogram)
 	prometheus.MustRegister(LastCheckpoint)
@@ -277,4 +277,7 @@ const (
 	LblFairLockingStmtUsed      = "stmt-used"
 	LblFairLockingStmtEffective = "stmt-effective"
 	LblScope                    = "scope"
+
+	LblTrigger   = "trigger"
+	LblTableSize = "table_size"
 )
@@ -35,9 +35,27 @@ var (
 	StatsDeltaLoadHistogram   prometheus.Histogram
 	StatsDeltaUpdateHistogram prometheus.Histogram
 
+	StatsDeltaPendingTablesGauge      prometheus.Gauge
+	StatsDeltaOldestPendingAgeGauge   prometheus.Gauge
+	StatsDeltaFlushBatchSizeHistogram prometheus.Histogram
+	StatsDeltaFlushErrorCounter       prometheus.Counter
+
 	HistoricalStatsCounter        *prometheus.CounterVec
 	PlanReplayerTaskCounter       *prometheus.CounterVec
 	PlanReplayerRegisterTaskGauge prometheus.Gauge
+	CardinalityFeedbackCounter    *prometheus.CounterVec
+	DynamicSamplingCounter        *prometheus.CounterVec
+	PlanChangeGuardCounter        *prometheus.CounterVec
+	EstimationErrorCounter        *prometheus.CounterVec
+
+	AnalyzeDurationHistogram      *prometheus.HistogramVec
+	AnalyzeScannedRowsHistogram   *prometheus.HistogramVec
+	AnalyzePhaseDurationHistogram *prometheus.HistogramVec
+
+	UnhealthyHotTablesGauge      prometheus.Gauge
+	OldestFailedAutoAnalyzeGauge prometheus.Gauge
+	SyncLoadTimeoutRatioGauge    prometheus.Gauge
+	GlobalStatsMergeBacklogGauge prometheus.Gauge
 )
 
 // InitStatsMetrics initializes stats metrics.
@@ -162,4 +180,103 @@ func InitStatsMetrics() {
 			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 24), // 10ms ~ 24h
 		},
 	)
+	StatsDeltaPendingTablesGauge = NewGauge(prometheus.GaugeOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "stats_delta_pending_tables",
+		Help:      "Gauge of the tables whose stats delta is kept in memory and not yet persisted after the last flush.",
+	})
+	StatsDeltaOldestPendingAgeGauge = NewGauge(prometheus.GaugeOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "stats_delta_oldest_pending_age_seconds",
+		Help:      "Gauge of the age (s) of the oldest stats delta not yet persisted after the last flush.",
+	})
+	StatsDeltaFlushBatchSizeHistogram = NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "tidb",
+			Subsystem: "statistics",
+			Name:      "stats_delta_flush_batch_size",
+			Help:      "Bucketed histogram of the tables whose stats delta is persisted by each batch of the flush.",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 10), // 1 ~ 256K
+		},
+	)
+	StatsDeltaFlushErrorCounter = NewCounter(prometheus.CounterOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "stats_delta_flush_error",
+		Help:      "Counter of the batches of the stats delta flush that failed.",
+	})
+	CardinalityFeedbackCounter = NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "cardinality_feedback",
+		Help:      "Counter of the runtime cardinality feedback recorded, evicted and applied.",
+	}, []string{LblType})
+	DynamicSamplingCounter = NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "dynamic_sampling",
+		Help:      "Counter of the dynamic sampling of the tables without usable stats, by whether the sample is cached or newly taken.",
+	}, []string{LblType})
+	PlanChangeGuardCounter = NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "plan_change_guard",
+		Help:      "Counter of the plan changes detected, the regressions and the baselines captured after analyze.",
+	}, []string{LblType})
+	EstimationErrorCounter = NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "estimation_error",
+		Help:      "Counter of the sampled estimation errors of the operators recorded and dropped.",
+	}, []string{LblType})
+	AnalyzeDurationHistogram = NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tidb",
+			Subsystem: "statistics",
+			Name:      "analyze_duration_seconds",
+			Help:      "Bucketed histogram of processing time (s) of the analyze jobs by the trigger and the table size.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 24), // 10ms ~ 24h
+		}, []string{LblTrigger, LblTableSize})
+	AnalyzeScannedRowsHistogram = NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tidb",
+			Subsystem: "statistics",
+			Name:      "analyze_scanned_rows",
+			Help:      "Bucketed histogram of the rows scanned by the analyze jobs by the trigger and the table size.",
+			Buckets:   prometheus.ExponentialBuckets(1000, 4, 12), // 1K ~ 4G
+		}, []string{LblTrigger, LblTableSize})
+	AnalyzePhaseDurationHistogram = NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tidb",
+			Subsystem: "statistics",
+			Name:      "analyze_phase_duration_seconds",
+			Help:      "Bucketed histogram of processing time (s) of the scan, build and save phases of the analyze jobs.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 24), // 10ms ~ 24h
+		}, []string{LblPhase, LblTrigger, LblTableSize})
+	UnhealthyHotTablesGauge = NewGauge(prometheus.GaugeOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "unhealthy_hot_tables",
+		Help:      "Gauge of the tables used by the queries in the last hour whose stats healthy is below tidb_stats_alert_healthy_threshold, only reported by the stats owner.",
+	})
+	OldestFailedAutoAnalyzeGauge = NewGauge(prometheus.GaugeOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "oldest_failed_auto_analyze_age_seconds",
+		Help:      "Gauge of the age (s) of the oldest auto analyze failure not followed by a success, only reported by the stats owner.",
+	})
+	SyncLoadTimeoutRatioGauge = NewGauge(prometheus.GaugeOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "sync_load_timeout_ratio",
+		Help:      "Gauge of the ratio of the timed out sync loads since the last update.",
+	})
+	GlobalStatsMergeBacklogGauge = NewGauge(prometheus.GaugeOpts{
+		Namespace: "tidb",
+		Subsystem: "statistics",
+		Name:      "global_stats_merge_backlog",
+		Help:      "Gauge of the partitioned tables whose global stats are older than the stats of their partitions in the dynamic prune mode.",
+	})
 }
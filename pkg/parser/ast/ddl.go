@@ -1141,6 +1141,9 @@ type CreateTableStmt struct {
 	OnCommitDelete bool
 	Table          *TableName
 	ReferTable     *TableName
+	// WithStatistics means the statistics of ReferTable are copied to the new table,
+	// it's only used with ReferTable.
+	WithStatistics bool
 	Cols           []*ColumnDef
 	Constraints    []*Constraint
 	Options        []*TableOption
@@ -1172,6 +1175,9 @@ func (n *CreateTableStmt) Restore(ctx *format.RestoreCtx) error {
 		if err := n.ReferTable.Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while splicing CreateTableStmt ReferTable")
 		}
+		if n.WithStatistics {
+			ctx.WriteKeyWord(" WITH STATISTICS")
+		}
 	}
 	lenCols := len(n.Cols)
 	lenConstraints := len(n.Constraints)
@@ -3297,6 +3303,15 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 			ctx.WriteKeyWord("IF NOT EXISTS ")
 		}
 		ctx.WriteName(n.Statistics.StatsName)
+		if n.Statistics.StatsType == StatsTypeExpression {
+			ctx.WriteKeyWord(" ON ")
+			ctx.WritePlain("(")
+			if err := n.Statistics.Expr.Restore(ctx); err != nil {
+				return errors.Annotate(err, "An error occurred while restore AddStatisticsSpec.Expr")
+			}
+			ctx.WritePlain(")")
+			return nil
+		}
 		switch n.Statistics.StatsType {
 		case StatsTypeCardinality:
 			ctx.WriteKeyWord(" CARDINALITY(")
@@ -3304,6 +3319,10 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 			ctx.WriteKeyWord(" DEPENDENCY(")
 		case StatsTypeCorrelation:
 			ctx.WriteKeyWord(" CORRELATION(")
+		case StatsTypeTrigram:
+			ctx.WriteKeyWord(" TRIGRAM(")
+		case StatsTypeJoinSketch:
+			ctx.WriteKeyWord(" JOIN_SKETCH(")
 		}
 		for i, col := range n.Statistics.Columns {
 			if i != 0 {
@@ -3894,6 +3913,13 @@ func (n *AlterTableSpec) Accept(v Visitor) (Node, bool) {
 			return n, false
 		}
 	}
+	if n.Statistics != nil && n.Statistics.Expr != nil {
+		node, ok := n.Statistics.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Statistics.Expr = node.(ExprNode)
+	}
 	return v.Leave(n)
 }
 
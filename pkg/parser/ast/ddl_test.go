@@ -541,6 +541,10 @@ func TestAlterTableSpecRestore(t *testing.T) {
 		{"add stats_extended s1 cardinality(a,b)", "ADD STATS_EXTENDED `s1` CARDINALITY(`a`, `b`)"},
 		{"add stats_extended if not exists s1 cardinality(a,b)", "ADD STATS_EXTENDED IF NOT EXISTS `s1` CARDINALITY(`a`, `b`)"},
 		{"add stats_extended s1 correlation(a,b)", "ADD STATS_EXTENDED `s1` CORRELATION(`a`, `b`)"},
+		{"add stats_extended s1 trigram(a)", "ADD STATS_EXTENDED `s1` TRIGRAM(`a`)"},
+		{"add stats_extended s1 join_sketch(a)", "ADD STATS_EXTENDED `s1` JOIN_SKETCH(`a`)"},
+		{"add stats_extended s1 on (date(a))", "ADD STATS_EXTENDED `s1` ON (DATE(`a`))"},
+		{"add stats_extended if not exists s1 on (a + b)", "ADD STATS_EXTENDED IF NOT EXISTS `s1` ON (`a`+`b`)"},
 		{"add stats_extended if not exists s1 correlation(a,b)", "ADD STATS_EXTENDED IF NOT EXISTS `s1` CORRELATION(`a`, `b`)"},
 		{"add stats_extended s1 dependency(a,b)", "ADD STATS_EXTENDED `s1` DEPENDENCY(`a`, `b`)"},
 		{"add stats_extended if not exists s1 dependency(a,b)", "ADD STATS_EXTENDED IF NOT EXISTS `s1` DEPENDENCY(`a`, `b`)"},
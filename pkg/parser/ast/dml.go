@@ -3085,7 +3085,10 @@ type ShowStmt struct {
 	Roles             []*auth.RoleIdentity // Used for show grants .. using
 	IfNotExists       bool                 // Used for `show create database if not exists`
 	Extended          bool                 // Used for `show extended columns from ...`
+	WithStats         bool                 // Used for `show create table ... with stats`
+	RawStats          bool                 // Used for `show stats_buckets|stats_topn raw`
 	Limit             *Limit               // Used for partial Show STMTs to limit Result Set row numbers.
+	OrderBy           *OrderByClause       // Used for `show stats_meta|stats_histograms|stats_buckets|stats_topn ... order by`
 
 	CountWarningsOrErrors bool // Used for showing count(*) warnings | errors
 
@@ -3136,6 +3139,21 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 		return nil
 	}
+	restoreShowOrderByAndLimitOpt := func() error {
+		if n.OrderBy != nil {
+			ctx.WritePlain(" ")
+			if err := n.OrderBy.Restore(ctx); err != nil {
+				return errors.Annotate(err, "An error occurred while restore ShowStmt.OrderBy")
+			}
+		}
+		if n.Limit != nil {
+			ctx.WritePlain(" ")
+			if err := n.Limit.Restore(ctx); err != nil {
+				return errors.Annotate(err, "An error occurred while restore ShowStmt.Limit")
+			}
+		}
+		return nil
+	}
 
 	ctx.WriteKeyWord("SHOW ")
 	switch n.Tp {
@@ -3146,6 +3164,9 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 		if err := n.Table.Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore ShowStmt.Table")
 		}
+		if n.WithStats {
+			ctx.WriteKeyWord(" WITH STATS")
+		}
 	case ShowCreateProcedure:
 		ctx.WriteKeyWord("CREATE PROCEDURE ")
 		if err := n.Procedure.Restore(ctx); err != nil {
@@ -3212,6 +3233,9 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 		if err := restoreShowLikeOrWhereOpt(); err != nil {
 			return err
 		}
+		if err := restoreShowOrderByAndLimitOpt(); err != nil {
+			return err
+		}
 	case ShowStatsLocked:
 		ctx.WriteKeyWord("STATS_LOCKED")
 		if err := restoreShowLikeOrWhereOpt(); err != nil {
@@ -3222,16 +3246,31 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 		if err := restoreShowLikeOrWhereOpt(); err != nil {
 			return err
 		}
+		if err := restoreShowOrderByAndLimitOpt(); err != nil {
+			return err
+		}
 	case ShowStatsTopN:
 		ctx.WriteKeyWord("STATS_TOPN")
+		if n.RawStats {
+			ctx.WriteKeyWord(" RAW")
+		}
 		if err := restoreShowLikeOrWhereOpt(); err != nil {
 			return err
 		}
+		if err := restoreShowOrderByAndLimitOpt(); err != nil {
+			return err
+		}
 	case ShowStatsBuckets:
 		ctx.WriteKeyWord("STATS_BUCKETS")
+		if n.RawStats {
+			ctx.WriteKeyWord(" RAW")
+		}
 		if err := restoreShowLikeOrWhereOpt(); err != nil {
 			return err
 		}
+		if err := restoreShowOrderByAndLimitOpt(); err != nil {
+			return err
+		}
 	case ShowStatsHealthy:
 		ctx.WriteKeyWord("STATS_HEALTHY")
 		if err := restoreShowLikeOrWhereOpt(); err != nil {
@@ -3473,6 +3512,13 @@ func (n *ShowStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.Where = node.(ExprNode)
 	}
+	if n.OrderBy != nil {
+		node, ok := n.OrderBy.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.OrderBy = node.(*OrderByClause)
+	}
 	if n.Limit != nil {
 		node, ok := n.Limit.Accept(v)
 		if !ok {
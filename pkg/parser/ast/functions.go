@@ -269,6 +269,8 @@ const (
 	FormatBytes          = "format_bytes"
 	FormatNanoTime       = "format_nano_time"
 	CurrentResourceGroup = "current_resource_group"
+	StatsHealthy         = "stats_healthy"
+	LastAnalyzeTime      = "last_analyze_time"
 
 	// control functions
 	If     = "if"
@@ -931,6 +931,8 @@ const (
 	SetCharset = "SetCharset"
 	// TiDBCloudStorageURI is the const for set tidb_cloud_storage_uri stmt.
 	TiDBCloudStorageURI = "tidb_cloud_storage_uri"
+	// TiDBStatsHealthReportStorageURI is the const for set tidb_stats_health_report_storage_uri stmt.
+	TiDBStatsHealthReportStorageURI = "tidb_stats_health_report_storage_uri"
 )
 
 // VariableAssignment is a variable assignment struct.
@@ -969,7 +971,7 @@ func (n *VariableAssignment) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteName(n.Name)
 		ctx.WritePlain("=")
 	}
-	if n.Name == TiDBCloudStorageURI {
+	if n.Name == TiDBCloudStorageURI || n.Name == TiDBStatsHealthReportStorageURI {
 		// need to redact the url for safety when `show processlist;`
 		ctx.WritePlain(RedactURL(n.Value.(ValueExpr).GetString()))
 	} else if err := n.Value.Restore(ctx); err != nil {
@@ -2372,6 +2374,9 @@ const (
 	StatsTypeCardinality uint8 = iota
 	StatsTypeDependency
 	StatsTypeCorrelation
+	StatsTypeTrigram
+	StatsTypeExpression
+	StatsTypeJoinSketch
 )
 
 // StatisticsSpec is the specification for ADD /DROP STATISTICS.
@@ -2379,6 +2384,8 @@ type StatisticsSpec struct {
 	StatsName string
 	StatsType uint8
 	Columns   []*ColumnName
+	// Expr is the expression to collect statistics on for the StatsTypeExpression type.
+	Expr ExprNode
 }
 
 // CreateStatisticsStmt is a statement to create extended statistics.
@@ -2387,6 +2394,8 @@ type StatisticsSpec struct {
 //	CREATE STATISTICS stats1 (cardinality) ON t(a, b, c);
 //	CREATE STATISTICS stats2 (dependency) ON t(a, b);
 //	CREATE STATISTICS stats3 (correlation) ON t(a, b);
+//	CREATE STATISTICS stats4 (trigram) ON t(a);
+//	CREATE STATISTICS stats5 (join_sketch) ON t(a);
 type CreateStatisticsStmt struct {
 	stmtNode
 
@@ -2411,6 +2420,10 @@ func (n *CreateStatisticsStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord(" (dependency) ")
 	case StatsTypeCorrelation:
 		ctx.WriteKeyWord(" (correlation) ")
+	case StatsTypeTrigram:
+		ctx.WriteKeyWord(" (trigram) ")
+	case StatsTypeJoinSketch:
+		ctx.WriteKeyWord(" (join_sketch) ")
 	}
 	ctx.WriteKeyWord("ON ")
 	if err := n.Table.Restore(ctx); err != nil {
@@ -2552,6 +2565,9 @@ const (
 	AdminUnsetBDRRole
 	AdminAlterDDLJob
 	AdminWorkloadRepoCreate
+	AdminCheckStatsTable
+	AdminEvictStats
+	AdminFlushStatsDelta
 )
 
 // HandleRange represents a range where handle value >= Begin and < End.
@@ -2677,6 +2693,8 @@ type AdminStmt struct {
 	LimitSimple     LimitSimple
 	BDRRole         BDRRole
 	AlterJobOptions []*AlterJobOption
+	PartitionNames  []CIStr
+	ColumnNames     []CIStr
 }
 
 // Restore implements Node interface.
@@ -2727,6 +2745,36 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 		if err := restoreTables(); err != nil {
 			return err
 		}
+	case AdminCheckStatsTable:
+		ctx.WriteKeyWord("CHECK STATS TABLE ")
+		if err := restoreTables(); err != nil {
+			return err
+		}
+	case AdminEvictStats:
+		ctx.WriteKeyWord("EVICT ")
+		if n.StatementScope == StatementScopeGlobal {
+			ctx.WriteKeyWord("GLOBAL ")
+		}
+		ctx.WriteKeyWord("STATS TABLE ")
+		if err := restoreTables(); err != nil {
+			return err
+		}
+		for i, partition := range n.PartitionNames {
+			if i == 0 {
+				ctx.WriteKeyWord(" PARTITION ")
+			} else {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteName(partition.O)
+		}
+		for i, column := range n.ColumnNames {
+			if i == 0 {
+				ctx.WriteKeyWord(" COLUMNS ")
+			} else {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteName(column.O)
+		}
 	case AdminCheckIndex:
 		ctx.WriteKeyWord("CHECK INDEX ")
 		if err := restoreTables(); err != nil {
@@ -2819,6 +2867,14 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("RELOAD BINDINGS")
 	case AdminReloadStatistics:
 		ctx.WriteKeyWord("RELOAD STATS_EXTENDED")
+	case AdminFlushStatsDelta:
+		ctx.WriteKeyWord("FLUSH STATS DELTA")
+		if len(n.Tables) > 0 {
+			ctx.WriteKeyWord(" TABLE ")
+			if err := restoreTables(); err != nil {
+				return err
+			}
+		}
 	case AdminFlushPlanCache:
 		if n.StatementScope == StatementScopeSession {
 			ctx.WriteKeyWord("FLUSH SESSION PLAN_CACHE")
@@ -3910,6 +3966,9 @@ type TableOptimizerHint struct {
 	// - READ_FROM_STORAGE   => CIStr
 	// - USE_TOJA            => bool
 	// - NTH_PLAN            => int64
+	// - STATS_SNAPSHOT_TS   => uint64
+	// - SET_TABLE_ROWS      => float64
+	// - SET_COLUMN_NDV      => ast.HintColumnStats
 	HintData interface{}
 	// QBName is the default effective query block of this hint.
 	QBName  CIStr
@@ -3929,6 +3988,12 @@ type HintSetVar struct {
 	Value   string
 }
 
+// HintColumnStats is the payload of the hints overriding the statistics of a column, e.g. `SET_COLUMN_NDV`.
+type HintColumnStats struct {
+	Column CIStr
+	Value  float64
+}
+
 // HintTable is table in the hint. It may have query block info.
 type HintTable struct {
 	DBName        CIStr
@@ -3987,7 +4052,7 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 	}
 	// Hints with args except query block.
 	switch n.HintName.L {
-	case "max_execution_time":
+	case "max_execution_time", "stats_snapshot_ts":
 		ctx.WritePlainf("%d", n.HintData.(uint64))
 	case "resource_group":
 		ctx.WriteName(n.HintData.(string))
@@ -4049,6 +4114,21 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteString(hintData.From)
 		ctx.WritePlain(", ")
 		ctx.WriteString(hintData.To)
+	case "set_table_rows":
+		n.Tables[0].Restore(ctx)
+		ctx.WritePlain(", ")
+		ctx.WritePlain(formatHintStatsValue(n.HintData.(float64)))
+	case "set_column_ndv":
+		hintData := n.HintData.(HintColumnStats)
+		if n.Tables[0].DBName.L != "" {
+			ctx.WriteName(n.Tables[0].DBName.String())
+			ctx.WritePlain(".")
+		}
+		ctx.WriteName(n.Tables[0].TableName.String())
+		ctx.WritePlain(".")
+		ctx.WriteName(hintData.Column.String())
+		ctx.WritePlain(", ")
+		ctx.WritePlain(formatHintStatsValue(hintData.Value))
 	case "set_var":
 		hintData := n.HintData.(HintSetVar)
 		ctx.WritePlain(hintData.VarName)
@@ -4059,6 +4139,16 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 	return nil
 }
 
+// formatHintStatsValue formats the statistics value in the hints, the fractional values are formatted in the scientific
+// notation since the hint parser only accepts them in it.
+func formatHintStatsValue(v float64) string {
+	str := strconv.FormatFloat(v, 'g', -1, 64)
+	if strings.Contains(str, ".") && !strings.Contains(str, "e") {
+		return strconv.FormatFloat(v, 'e', -1, 64)
+	}
+	return str
+}
+
 // Accept implements Node Accept interface.
 func (n *TableOptimizerHint) Accept(v Visitor) (Node, bool) {
 	newNode, skipChildren := v.Enter(n)
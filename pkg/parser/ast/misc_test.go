@@ -238,6 +238,12 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"QUERY_TYPE(@sel1 OLTP)", "QUERY_TYPE(@`sel1` OLTP)"},
 		{"NTH_PLAN(10)", "NTH_PLAN(10)"},
 		{"NTH_PLAN(@sel1 30)", "NTH_PLAN(@`sel1` 30)"},
+		{"STATS_SNAPSHOT_TS(449997000000000000)", "STATS_SNAPSHOT_TS(449997000000000000)"},
+		{"STATS_SNAPSHOT_TS(@sel1 449997000000000000)", "STATS_SNAPSHOT_TS(@`sel1` 449997000000000000)"},
+		{"SET_TABLE_ROWS(t1, 1e9)", "SET_TABLE_ROWS(`t1`, 1e+09)"},
+		{"SET_TABLE_ROWS(@sel1 test.t1 PARTITION(p0), 100)", "SET_TABLE_ROWS(@`sel1` `test`.`t1` PARTITION(`p0`), 100)"},
+		{"SET_COLUMN_NDV(t1.c1, 50000)", "SET_COLUMN_NDV(`t1`.`c1`, 50000)"},
+		{"SET_COLUMN_NDV(@sel1 test.t1.c1, 2.5e0)", "SET_COLUMN_NDV(@`sel1` `test`.`t1`.`c1`, 2.5e+00)"},
 		{"MEMORY_QUOTA(1 GB)", "MEMORY_QUOTA(1024 MB)"},
 		{"MEMORY_QUOTA(@sel1 1 GB)", "MEMORY_QUOTA(@`sel1` 1024 MB)"},
 		{"HASH_AGG()", "HASH_AGG()"},
@@ -42,6 +42,9 @@ type AnalyzeTableStmt struct {
 	// ColumnNames indicate the columns whose statistics need to be collected.
 	ColumnNames  []CIStr
 	ColumnChoice ColumnChoice
+	// Quick is set in "ANALYZE TABLE ... QUICK" statement, which derives the approximate stats from the regions
+	// without scanning the data.
+	Quick bool
 }
 
 // AnalyzeOptType is the type for analyze options.
@@ -151,6 +154,9 @@ func (n *AnalyzeTableStmt) Restore(ctx *format.RestoreCtx) error {
 			ctx.WriteName(columnName.O)
 		}
 	}
+	if n.Quick {
+		ctx.WriteKeyWord(" QUICK")
+	}
 	if n.IndexFlag {
 		ctx.WriteKeyWord(" INDEX")
 	}
@@ -193,13 +199,17 @@ func (n *AnalyzeTableStmt) Accept(v Visitor) (Node, bool) {
 }
 
 // DropStatsStmt is used to drop table statistics.
-// if the PartitionNames is not empty, or IsGlobalStats is true, it will contain exactly one table
+// if the PartitionNames, ColumnNames or IndexNames is not empty, or IsGlobalStats is true, it will contain exactly one table
 type DropStatsStmt struct {
 	stmtNode
 
 	Tables         []*TableName
 	PartitionNames []CIStr
 	IsGlobalStats  bool
+	// ColumnNames and IndexNames are the columns and indexes whose stats are dropped, the other stats of the table
+	// are kept if any of them is not empty.
+	ColumnNames []CIStr
+	IndexNames  []CIStr
 }
 
 // Restore implements Node interface.
@@ -220,6 +230,29 @@ func (n *DropStatsStmt) Restore(ctx *format.RestoreCtx) error {
 		return nil
 	}
 
+	restoreNames := func(keyword string, names []CIStr) {
+		ctx.WriteKeyWord(keyword)
+		ctx.WritePlain(" (")
+		for i, name := range names {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteName(name.O)
+		}
+		ctx.WritePlain(")")
+	}
+	if len(n.ColumnNames) != 0 {
+		ctx.WritePlain(" ")
+		restoreNames("COLUMNS", n.ColumnNames)
+	}
+	if len(n.IndexNames) != 0 {
+		if len(n.ColumnNames) != 0 {
+			ctx.WritePlain(",")
+		}
+		ctx.WritePlain(" ")
+		restoreNames("INDEXES", n.IndexNames)
+	}
+
 	if len(n.PartitionNames) != 0 {
 		ctx.WriteKeyWord(" PARTITION ")
 	}
@@ -278,6 +311,52 @@ type LockStatsStmt struct {
 	stmtNode
 
 	Tables []*TableName
+	// ColumnNames is the columns whose stats are locked. When it's not empty, Tables only contains one table.
+	ColumnNames []CIStr
+	// ExpireOption is the expiration of the lock. It's nil if the lock never expires.
+	ExpireOption *LockStatsExpireOption
+	// Comment is the reason of the lock, it's recorded in the stats lock history.
+	Comment string
+}
+
+// LockStatsExpireType is the type of the expiration of the stats lock.
+type LockStatsExpireType int
+
+const (
+	// LockStatsExpireUntil is the expiration `UNTIL TIMESTAMP expr`.
+	LockStatsExpireUntil LockStatsExpireType = iota
+	// LockStatsExpireFor is the expiration `FOR INTERVAL expr unit`.
+	LockStatsExpireFor
+)
+
+// LockStatsExpireOption is the expiration of the stats lock, after which the stats are unlocked automatically.
+type LockStatsExpireOption struct {
+	Tp LockStatsExpireType
+	// Expr is the timestamp for LockStatsExpireUntil, or the interval for LockStatsExpireFor.
+	Expr ExprNode
+	// Unit is the time unit of the interval for LockStatsExpireFor.
+	Unit TimeUnitType
+}
+
+// Restore implements Node interface.
+func (n *LockStatsExpireOption) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case LockStatsExpireUntil:
+		ctx.WriteKeyWord("UNTIL TIMESTAMP ")
+		if err := n.Expr.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore LockStatsExpireOption.Expr")
+		}
+	case LockStatsExpireFor:
+		ctx.WriteKeyWord("FOR INTERVAL ")
+		if err := n.Expr.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore LockStatsExpireOption.Expr")
+		}
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(n.Unit.String())
+	default:
+		return errors.Errorf("invalid LockStatsExpireOption: %d", n.Tp)
+	}
+	return nil
 }
 
 // Restore implements Node interface.
@@ -291,6 +370,16 @@ func (n *LockStatsStmt) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore LockStatsStmt.Tables[%d]", index)
 		}
 	}
+	if len(n.ColumnNames) > 0 {
+		restoreStatsColumnNames(ctx, n.ColumnNames)
+	}
+	if n.ExpireOption != nil {
+		ctx.WritePlain(" ")
+		if err := n.ExpireOption.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore LockStatsStmt.ExpireOption")
+		}
+	}
+	restoreStatsLockComment(ctx, n.Comment)
 	return nil
 }
 
@@ -308,6 +397,13 @@ func (n *LockStatsStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.Tables[i] = node.(*TableName)
 	}
+	if n.ExpireOption != nil {
+		node, ok := n.ExpireOption.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.ExpireOption.Expr = node.(ExprNode)
+	}
 	return v.Leave(n)
 }
 
@@ -316,6 +412,10 @@ type UnlockStatsStmt struct {
 	stmtNode
 
 	Tables []*TableName
+	// ColumnNames is the columns whose stats are unlocked. When it's not empty, Tables only contains one table.
+	ColumnNames []CIStr
+	// Comment is the reason of the unlock, it's recorded in the stats lock history.
+	Comment string
 }
 
 // Restore implements Node interface.
@@ -329,9 +429,33 @@ func (n *UnlockStatsStmt) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore UnlockStatsStmt.Tables[%d]", index)
 		}
 	}
+	if len(n.ColumnNames) > 0 {
+		restoreStatsColumnNames(ctx, n.ColumnNames)
+	}
+	restoreStatsLockComment(ctx, n.Comment)
 	return nil
 }
 
+func restoreStatsColumnNames(ctx *format.RestoreCtx, columnNames []CIStr) {
+	ctx.WriteKeyWord(" COLUMNS ")
+	ctx.WritePlain("(")
+	for i, columnName := range columnNames {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		ctx.WriteName(columnName.O)
+	}
+	ctx.WritePlain(")")
+}
+
+func restoreStatsLockComment(ctx *format.RestoreCtx, comment string) {
+	if comment == "" {
+		return
+	}
+	ctx.WriteKeyWord(" COMMENT ")
+	ctx.WriteString(comment)
+}
+
 // Accept implements Node Accept interface.
 func (n *UnlockStatsStmt) Accept(v Visitor) (Node, bool) {
 	newNode, skipChildren := v.Enter(n)
@@ -29,6 +29,7 @@ type yyhintSymType struct {
 	offset      int
 	ident       string
 	number      uint64
+	float       float64
 	hint        *ast.TableOptimizerHint
 	hints       []*ast.TableOptimizerHint
 	table       ast.HintTable
@@ -40,239 +41,248 @@ type yyhintXError struct {
 }
 
 const (
-	yyhintDefault             = 57434
+	yyhintDefault             = 57438
 	yyhintEOFCode             = 57344
 	yyhintErrCode             = 57345
-	hintAggToCop              = 57380
-	hintBCJoin                = 57402
-	hintBKA                   = 57355
-	hintBNL                   = 57357
-	hintDupsWeedOut           = 57430
-	hintFalse                 = 57426
-	hintFirstMatch            = 57431
-	hintForceIndex            = 57416
-	hintGB                    = 57429
-	hintHashAgg               = 57382
-	hintHashJoin              = 57359
-	hintHashJoinBuild         = 57360
-	hintHashJoinProbe         = 57361
-	hintHypoIndex             = 57379
+	hintAggToCop              = 57381
+	hintBCJoin                = 57403
+	hintBKA                   = 57356
+	hintBNL                   = 57358
+	hintDupsWeedOut           = 57434
+	hintFalse                 = 57430
+	hintFirstMatch            = 57435
+	hintFloatLit              = 57351
+	hintForceIndex            = 57417
+	hintGB                    = 57433
+	hintHashAgg               = 57383
+	hintHashJoin              = 57360
+	hintHashJoinBuild         = 57361
+	hintHashJoinProbe         = 57362
+	hintHypoIndex             = 57380
 	hintIdentifier            = 57347
-	hintIgnoreIndex           = 57385
-	hintIgnorePlanCache       = 57381
-	hintIndexHashJoin         = 57389
-	hintIndexJoin             = 57386
-	hintIndexMerge            = 57365
-	hintIndexMergeJoin        = 57393
-	hintInlHashJoin           = 57388
-	hintInlJoin               = 57391
-	hintInlMergeJoin          = 57392
+	hintIgnoreIndex           = 57386
+	hintIgnorePlanCache       = 57382
+	hintIndexHashJoin         = 57390
+	hintIndexJoin             = 57387
+	hintIndexMerge            = 57366
+	hintIndexMergeJoin        = 57394
+	hintInlHashJoin           = 57389
+	hintInlJoin               = 57392
+	hintInlMergeJoin          = 57393
 	hintIntLit                = 57346
 	hintInvalid               = 57348
-	hintJoinFixedOrder        = 57351
-	hintJoinOrder             = 57352
-	hintJoinPrefix            = 57353
-	hintJoinSuffix            = 57354
-	hintLeading               = 57418
-	hintLimitToCop            = 57415
-	hintLooseScan             = 57432
-	hintMB                    = 57428
-	hintMRR                   = 57367
-	hintMaterialization       = 57433
-	hintMaxExecutionTime      = 57375
-	hintMemoryQuota           = 57395
-	hintMerge                 = 57363
-	hintMpp1PhaseAgg          = 57383
-	hintMpp2PhaseAgg          = 57384
-	hintNoBKA                 = 57356
-	hintNoBNL                 = 57358
-	hintNoDecorrelate         = 57420
-	hintNoHashJoin            = 57362
-	hintNoICP                 = 57369
-	hintNoIndexHashJoin       = 57390
-	hintNoIndexJoin           = 57387
-	hintNoIndexMerge          = 57366
-	hintNoIndexMergeJoin      = 57394
-	hintNoMRR                 = 57368
-	hintNoMerge               = 57364
-	hintNoOrderIndex          = 57409
-	hintNoRangeOptimization   = 57370
-	hintNoSMJoin              = 57401
-	hintNoSemijoin            = 57374
-	hintNoSkipScan            = 57372
-	hintNoSwapJoinInputs      = 57396
-	hintNthPlan               = 57414
-	hintOLAP                  = 57421
-	hintOLTP                  = 57422
-	hintOrderIndex            = 57408
-	hintPartition             = 57423
-	hintQBName                = 57378
-	hintQueryType             = 57397
-	hintReadConsistentReplica = 57398
-	hintReadFromStorage       = 57399
-	hintResourceGroup         = 57377
-	hintSMJoin                = 57400
-	hintSemiJoinRewrite       = 57419
-	hintSemijoin              = 57373
-	hintSetVar                = 57376
-	hintShuffleJoin           = 57403
+	hintJoinFixedOrder        = 57352
+	hintJoinOrder             = 57353
+	hintJoinPrefix            = 57354
+	hintJoinSuffix            = 57355
+	hintLeading               = 57419
+	hintLimitToCop            = 57416
+	hintLooseScan             = 57436
+	hintMB                    = 57432
+	hintMRR                   = 57368
+	hintMaterialization       = 57437
+	hintMaxExecutionTime      = 57376
+	hintMemoryQuota           = 57396
+	hintMerge                 = 57364
+	hintMpp1PhaseAgg          = 57384
+	hintMpp2PhaseAgg          = 57385
+	hintNoBKA                 = 57357
+	hintNoBNL                 = 57359
+	hintNoDecorrelate         = 57421
+	hintNoHashJoin            = 57363
+	hintNoICP                 = 57370
+	hintNoIndexHashJoin       = 57391
+	hintNoIndexJoin           = 57388
+	hintNoIndexMerge          = 57367
+	hintNoIndexMergeJoin      = 57395
+	hintNoMRR                 = 57369
+	hintNoMerge               = 57365
+	hintNoOrderIndex          = 57410
+	hintNoRangeOptimization   = 57371
+	hintNoSMJoin              = 57402
+	hintNoSemijoin            = 57375
+	hintNoSkipScan            = 57373
+	hintNoSwapJoinInputs      = 57397
+	hintNthPlan               = 57415
+	hintOLAP                  = 57425
+	hintOLTP                  = 57426
+	hintOrderIndex            = 57409
+	hintPartition             = 57427
+	hintQBName                = 57379
+	hintQueryType             = 57398
+	hintReadConsistentReplica = 57399
+	hintReadFromStorage       = 57400
+	hintResourceGroup         = 57378
+	hintSMJoin                = 57401
+	hintSemiJoinRewrite       = 57420
+	hintSemijoin              = 57374
+	hintSetColumnNDV          = 57424
+	hintSetTableRows          = 57423
+	hintSetVar                = 57377
+	hintShuffleJoin           = 57404
 	hintSingleAtIdentifier    = 57349
-	hintSkipScan              = 57371
-	hintStraightJoin          = 57417
-	hintStreamAgg             = 57404
+	hintSkipScan              = 57372
+	hintStatsSnapshotTS       = 57422
+	hintStraightJoin          = 57418
+	hintStreamAgg             = 57405
 	hintStringLit             = 57350
-	hintSwapJoinInputs        = 57405
-	hintTiFlash               = 57425
-	hintTiKV                  = 57424
-	hintTimeRange             = 57412
-	hintTrue                  = 57427
-	hintUseCascades           = 57413
-	hintUseIndex              = 57407
-	hintUseIndexMerge         = 57406
-	hintUsePlanCache          = 57410
-	hintUseToja               = 57411
+	hintSwapJoinInputs        = 57406
+	hintTiFlash               = 57429
+	hintTiKV                  = 57428
+	hintTimeRange             = 57413
+	hintTrue                  = 57431
+	hintUseCascades           = 57414
+	hintUseIndex              = 57408
+	hintUseIndexMerge         = 57407
+	hintUsePlanCache          = 57411
+	hintUseToja               = 57412
 
 	yyhintMaxDepth = 200
-	yyhintTabOfs   = -219
+	yyhintTabOfs   = -228
 )
 
 var (
 	yyhintXLAT = map[int]int{
-		41:    0,   // ')' (163x)
-		57380: 1,   // hintAggToCop (152x)
-		57402: 2,   // hintBCJoin (152x)
-		57355: 3,   // hintBKA (152x)
-		57357: 4,   // hintBNL (152x)
-		57416: 5,   // hintForceIndex (152x)
-		57382: 6,   // hintHashAgg (152x)
-		57359: 7,   // hintHashJoin (152x)
-		57360: 8,   // hintHashJoinBuild (152x)
-		57361: 9,   // hintHashJoinProbe (152x)
-		57379: 10,  // hintHypoIndex (152x)
-		57347: 11,  // hintIdentifier (152x)
-		57385: 12,  // hintIgnoreIndex (152x)
-		57381: 13,  // hintIgnorePlanCache (152x)
-		57389: 14,  // hintIndexHashJoin (152x)
-		57386: 15,  // hintIndexJoin (152x)
-		57365: 16,  // hintIndexMerge (152x)
-		57393: 17,  // hintIndexMergeJoin (152x)
-		57388: 18,  // hintInlHashJoin (152x)
-		57391: 19,  // hintInlJoin (152x)
-		57392: 20,  // hintInlMergeJoin (152x)
-		57351: 21,  // hintJoinFixedOrder (152x)
-		57352: 22,  // hintJoinOrder (152x)
-		57353: 23,  // hintJoinPrefix (152x)
-		57354: 24,  // hintJoinSuffix (152x)
-		57418: 25,  // hintLeading (152x)
-		57415: 26,  // hintLimitToCop (152x)
-		57375: 27,  // hintMaxExecutionTime (152x)
-		57395: 28,  // hintMemoryQuota (152x)
-		57363: 29,  // hintMerge (152x)
-		57383: 30,  // hintMpp1PhaseAgg (152x)
-		57384: 31,  // hintMpp2PhaseAgg (152x)
-		57367: 32,  // hintMRR (152x)
-		57356: 33,  // hintNoBKA (152x)
-		57358: 34,  // hintNoBNL (152x)
-		57420: 35,  // hintNoDecorrelate (152x)
-		57362: 36,  // hintNoHashJoin (152x)
-		57369: 37,  // hintNoICP (152x)
-		57390: 38,  // hintNoIndexHashJoin (152x)
-		57387: 39,  // hintNoIndexJoin (152x)
-		57366: 40,  // hintNoIndexMerge (152x)
-		57394: 41,  // hintNoIndexMergeJoin (152x)
-		57364: 42,  // hintNoMerge (152x)
-		57368: 43,  // hintNoMRR (152x)
-		57409: 44,  // hintNoOrderIndex (152x)
-		57370: 45,  // hintNoRangeOptimization (152x)
-		57374: 46,  // hintNoSemijoin (152x)
-		57372: 47,  // hintNoSkipScan (152x)
-		57401: 48,  // hintNoSMJoin (152x)
-		57396: 49,  // hintNoSwapJoinInputs (152x)
-		57414: 50,  // hintNthPlan (152x)
-		57408: 51,  // hintOrderIndex (152x)
-		57378: 52,  // hintQBName (152x)
-		57397: 53,  // hintQueryType (152x)
-		57398: 54,  // hintReadConsistentReplica (152x)
-		57399: 55,  // hintReadFromStorage (152x)
-		57377: 56,  // hintResourceGroup (152x)
-		57373: 57,  // hintSemijoin (152x)
-		57419: 58,  // hintSemiJoinRewrite (152x)
-		57376: 59,  // hintSetVar (152x)
-		57403: 60,  // hintShuffleJoin (152x)
-		57371: 61,  // hintSkipScan (152x)
-		57400: 62,  // hintSMJoin (152x)
-		57417: 63,  // hintStraightJoin (152x)
-		57404: 64,  // hintStreamAgg (152x)
-		57405: 65,  // hintSwapJoinInputs (152x)
-		57412: 66,  // hintTimeRange (152x)
-		57413: 67,  // hintUseCascades (152x)
-		57407: 68,  // hintUseIndex (152x)
-		57406: 69,  // hintUseIndexMerge (152x)
-		57410: 70,  // hintUsePlanCache (152x)
-		57411: 71,  // hintUseToja (152x)
-		44:    72,  // ',' (146x)
-		57430: 73,  // hintDupsWeedOut (125x)
-		57431: 74,  // hintFirstMatch (125x)
-		57432: 75,  // hintLooseScan (125x)
-		57433: 76,  // hintMaterialization (125x)
-		57425: 77,  // hintTiFlash (125x)
-		57424: 78,  // hintTiKV (125x)
-		57426: 79,  // hintFalse (124x)
-		57421: 80,  // hintOLAP (124x)
-		57422: 81,  // hintOLTP (124x)
-		57427: 82,  // hintTrue (124x)
-		57429: 83,  // hintGB (123x)
-		57428: 84,  // hintMB (123x)
-		57349: 85,  // hintSingleAtIdentifier (104x)
-		57346: 86,  // hintIntLit (101x)
-		93:    87,  // ']' (94x)
-		46:    88,  // '.' (93x)
-		57423: 89,  // hintPartition (88x)
-		61:    90,  // '=' (85x)
-		40:    91,  // '(' (80x)
-		57344: 92,  // $end (29x)
-		57454: 93,  // QueryBlockOpt (21x)
-		57446: 94,  // Identifier (18x)
-		57350: 95,  // hintStringLit (6x)
-		57436: 96,  // CommaOpt (5x)
-		57442: 97,  // HintTable (4x)
-		57443: 98,  // HintTableList (4x)
-		91:    99,  // '[' (3x)
-		43:    100, // '+' (2x)
-		45:    101, // '-' (2x)
-		57435: 102, // BooleanHintName (2x)
-		57437: 103, // HintIndexList (2x)
-		57439: 104, // HintStorageType (2x)
-		57440: 105, // HintStorageTypeAndTable (2x)
-		57444: 106, // HintTableListOpt (2x)
-		57449: 107, // JoinOrderOptimizerHintName (2x)
-		57450: 108, // NullaryHintName (2x)
-		57452: 109, // PartitionList (2x)
-		57453: 110, // PartitionListOpt (2x)
-		57456: 111, // StorageOptimizerHintOpt (2x)
-		57457: 112, // SubqueryOptimizerHintName (2x)
-		57460: 113, // SubqueryStrategy (2x)
-		57461: 114, // SupportedIndexLevelOptimizerHintName (2x)
-		57462: 115, // SupportedTableLevelOptimizerHintName (2x)
-		57463: 116, // TableOptimizerHintOpt (2x)
-		57465: 117, // UnsupportedIndexLevelOptimizerHintName (2x)
-		57466: 118, // UnsupportedTableLevelOptimizerHintName (2x)
-		57467: 119, // Value (2x)
-		57468: 120, // ViewName (2x)
-		57438: 121, // HintQueryType (1x)
-		57441: 122, // HintStorageTypeAndTableList (1x)
-		57445: 123, // HintTrueOrFalse (1x)
-		57447: 124, // IndexNameList (1x)
-		57448: 125, // IndexNameListOpt (1x)
-		57451: 126, // OptimizerHintList (1x)
-		57455: 127, // Start (1x)
-		57458: 128, // SubqueryStrategies (1x)
-		57459: 129, // SubqueryStrategiesOpt (1x)
-		57464: 130, // UnitOfBytes (1x)
-		57469: 131, // ViewNameList (1x)
-		57434: 132, // $default (0x)
-		57345: 133, // error (0x)
-		57348: 134, // hintInvalid (0x)
+		41:    0,   // ')' (172x)
+		57381: 1,   // hintAggToCop (165x)
+		57403: 2,   // hintBCJoin (165x)
+		57356: 3,   // hintBKA (165x)
+		57358: 4,   // hintBNL (165x)
+		57417: 5,   // hintForceIndex (165x)
+		57383: 6,   // hintHashAgg (165x)
+		57360: 7,   // hintHashJoin (165x)
+		57361: 8,   // hintHashJoinBuild (165x)
+		57362: 9,   // hintHashJoinProbe (165x)
+		57380: 10,  // hintHypoIndex (165x)
+		57347: 11,  // hintIdentifier (165x)
+		57386: 12,  // hintIgnoreIndex (165x)
+		57382: 13,  // hintIgnorePlanCache (165x)
+		57390: 14,  // hintIndexHashJoin (165x)
+		57387: 15,  // hintIndexJoin (165x)
+		57366: 16,  // hintIndexMerge (165x)
+		57394: 17,  // hintIndexMergeJoin (165x)
+		57389: 18,  // hintInlHashJoin (165x)
+		57392: 19,  // hintInlJoin (165x)
+		57393: 20,  // hintInlMergeJoin (165x)
+		57352: 21,  // hintJoinFixedOrder (165x)
+		57353: 22,  // hintJoinOrder (165x)
+		57354: 23,  // hintJoinPrefix (165x)
+		57355: 24,  // hintJoinSuffix (165x)
+		57419: 25,  // hintLeading (165x)
+		57416: 26,  // hintLimitToCop (165x)
+		57376: 27,  // hintMaxExecutionTime (165x)
+		57396: 28,  // hintMemoryQuota (165x)
+		57364: 29,  // hintMerge (165x)
+		57384: 30,  // hintMpp1PhaseAgg (165x)
+		57385: 31,  // hintMpp2PhaseAgg (165x)
+		57368: 32,  // hintMRR (165x)
+		57357: 33,  // hintNoBKA (165x)
+		57359: 34,  // hintNoBNL (165x)
+		57421: 35,  // hintNoDecorrelate (165x)
+		57363: 36,  // hintNoHashJoin (165x)
+		57370: 37,  // hintNoICP (165x)
+		57391: 38,  // hintNoIndexHashJoin (165x)
+		57388: 39,  // hintNoIndexJoin (165x)
+		57367: 40,  // hintNoIndexMerge (165x)
+		57395: 41,  // hintNoIndexMergeJoin (165x)
+		57365: 42,  // hintNoMerge (165x)
+		57369: 43,  // hintNoMRR (165x)
+		57410: 44,  // hintNoOrderIndex (165x)
+		57371: 45,  // hintNoRangeOptimization (165x)
+		57375: 46,  // hintNoSemijoin (165x)
+		57373: 47,  // hintNoSkipScan (165x)
+		57402: 48,  // hintNoSMJoin (165x)
+		57397: 49,  // hintNoSwapJoinInputs (165x)
+		57415: 50,  // hintNthPlan (165x)
+		57409: 51,  // hintOrderIndex (165x)
+		57379: 52,  // hintQBName (165x)
+		57398: 53,  // hintQueryType (165x)
+		57399: 54,  // hintReadConsistentReplica (165x)
+		57400: 55,  // hintReadFromStorage (165x)
+		57378: 56,  // hintResourceGroup (165x)
+		57374: 57,  // hintSemijoin (165x)
+		57420: 58,  // hintSemiJoinRewrite (165x)
+		57424: 59,  // hintSetColumnNDV (165x)
+		57423: 60,  // hintSetTableRows (165x)
+		57377: 61,  // hintSetVar (165x)
+		57404: 62,  // hintShuffleJoin (165x)
+		57372: 63,  // hintSkipScan (165x)
+		57401: 64,  // hintSMJoin (165x)
+		57422: 65,  // hintStatsSnapshotTS (165x)
+		57418: 66,  // hintStraightJoin (165x)
+		57405: 67,  // hintStreamAgg (165x)
+		57406: 68,  // hintSwapJoinInputs (165x)
+		57413: 69,  // hintTimeRange (165x)
+		57414: 70,  // hintUseCascades (165x)
+		57408: 71,  // hintUseIndex (165x)
+		57407: 72,  // hintUseIndexMerge (165x)
+		57411: 73,  // hintUsePlanCache (165x)
+		57412: 74,  // hintUseToja (165x)
+		44:    75,  // ',' (156x)
+		57434: 76,  // hintDupsWeedOut (134x)
+		57435: 77,  // hintFirstMatch (134x)
+		57436: 78,  // hintLooseScan (134x)
+		57437: 79,  // hintMaterialization (134x)
+		57429: 80,  // hintTiFlash (134x)
+		57428: 81,  // hintTiKV (134x)
+		57430: 82,  // hintFalse (133x)
+		57425: 83,  // hintOLAP (133x)
+		57426: 84,  // hintOLTP (133x)
+		57431: 85,  // hintTrue (133x)
+		57433: 86,  // hintGB (132x)
+		57432: 87,  // hintMB (132x)
+		57349: 88,  // hintSingleAtIdentifier (110x)
+		57346: 89,  // hintIntLit (109x)
+		46:    90,  // '.' (98x)
+		93:    91,  // ']' (97x)
+		57427: 92,  // hintPartition (91x)
+		61:    93,  // '=' (88x)
+		40:    94,  // '(' (83x)
+		57344: 95,  // $end (33x)
+		57459: 96,  // QueryBlockOpt (24x)
+		57451: 97,  // Identifier (22x)
+		57350: 98,  // hintStringLit (6x)
+		57440: 99,  // CommaOpt (5x)
+		57447: 100, // HintTable (5x)
+		57448: 101, // HintTableList (4x)
+		91:    102, // '[' (3x)
+		57351: 103, // hintFloatLit (3x)
+		57443: 104, // HintStatsValue (3x)
+		43:    105, // '+' (2x)
+		45:    106, // '-' (2x)
+		57439: 107, // BooleanHintName (2x)
+		57441: 108, // HintIndexList (2x)
+		57444: 109, // HintStorageType (2x)
+		57445: 110, // HintStorageTypeAndTable (2x)
+		57449: 111, // HintTableListOpt (2x)
+		57454: 112, // JoinOrderOptimizerHintName (2x)
+		57455: 113, // NullaryHintName (2x)
+		57457: 114, // PartitionList (2x)
+		57458: 115, // PartitionListOpt (2x)
+		57461: 116, // StorageOptimizerHintOpt (2x)
+		57462: 117, // SubqueryOptimizerHintName (2x)
+		57465: 118, // SubqueryStrategy (2x)
+		57466: 119, // SupportedIndexLevelOptimizerHintName (2x)
+		57467: 120, // SupportedTableLevelOptimizerHintName (2x)
+		57468: 121, // TableOptimizerHintOpt (2x)
+		57470: 122, // UnsupportedIndexLevelOptimizerHintName (2x)
+		57471: 123, // UnsupportedTableLevelOptimizerHintName (2x)
+		57472: 124, // Value (2x)
+		57473: 125, // ViewName (2x)
+		57442: 126, // HintQueryType (1x)
+		57446: 127, // HintStorageTypeAndTableList (1x)
+		57450: 128, // HintTrueOrFalse (1x)
+		57452: 129, // IndexNameList (1x)
+		57453: 130, // IndexNameListOpt (1x)
+		57456: 131, // OptimizerHintList (1x)
+		57460: 132, // Start (1x)
+		57463: 133, // SubqueryStrategies (1x)
+		57464: 134, // SubqueryStrategiesOpt (1x)
+		57469: 135, // UnitOfBytes (1x)
+		57474: 136, // ViewNameList (1x)
+		57438: 137, // $default (0x)
+		57345: 138, // error (0x)
+		57348: 139, // hintInvalid (0x)
 	}
 
 	yyhintSymNames = []string{
@@ -335,10 +345,13 @@ var (
 		"hintResourceGroup",
 		"hintSemijoin",
 		"hintSemiJoinRewrite",
+		"hintSetColumnNDV",
+		"hintSetTableRows",
 		"hintSetVar",
 		"hintShuffleJoin",
 		"hintSkipScan",
 		"hintSMJoin",
+		"hintStatsSnapshotTS",
 		"hintStraightJoin",
 		"hintStreamAgg",
 		"hintSwapJoinInputs",
@@ -363,8 +376,8 @@ var (
 		"hintMB",
 		"hintSingleAtIdentifier",
 		"hintIntLit",
-		"']'",
 		"'.'",
+		"']'",
 		"hintPartition",
 		"'='",
 		"'('",
@@ -376,6 +389,8 @@ var (
 		"HintTable",
 		"HintTableList",
 		"'['",
+		"hintFloatLit",
+		"HintStatsValue",
 		"'+'",
 		"'-'",
 		"BooleanHintName",
@@ -415,611 +430,657 @@ var (
 
 	yyhintReductions = []struct{ xsym, components int }{
 		{0, 1},
-		{127, 1},
-		{126, 1},
-		{126, 3},
-		{126, 1},
-		{126, 3},
-		{116, 4},
-		{116, 4},
-		{116, 4},
-		{116, 4},
-		{116, 4},
-		{116, 4},
-		{116, 5},
-		{116, 5},
-		{116, 5},
-		{116, 6},
-		{116, 4},
-		{116, 4},
-		{116, 6},
-		{116, 6},
-		{116, 6},
-		{116, 5},
-		{116, 4},
-		{116, 5},
+		{132, 1},
+		{131, 1},
+		{131, 3},
+		{131, 1},
+		{131, 3},
+		{121, 4},
+		{121, 4},
+		{121, 4},
+		{121, 4},
+		{121, 4},
+		{121, 4},
+		{121, 5},
+		{121, 5},
+		{121, 5},
+		{121, 7},
+		{121, 9},
+		{121, 11},
+		{121, 5},
+		{121, 6},
+		{121, 4},
+		{121, 4},
+		{121, 6},
+		{121, 6},
+		{121, 6},
+		{121, 5},
+		{121, 4},
+		{121, 5},
+		{121, 5},
+		{121, 4},
+		{121, 6},
+		{121, 6},
 		{116, 5},
-		{116, 4},
-		{116, 6},
-		{116, 6},
-		{111, 5},
-		{122, 1},
-		{122, 3},
-		{105, 4},
-		{93, 0},
-		{93, 1},
+		{127, 1},
+		{127, 3},
+		{110, 4},
 		{96, 0},
 		{96, 1},
-		{110, 0},
-		{110, 4},
-		{109, 1},
-		{109, 3},
-		{106, 1},
-		{106, 1},
-		{98, 2},
-		{98, 3},
-		{97, 3},
-		{97, 5},
-		{131, 3},
-		{131, 1},
-		{120, 2},
-		{120, 1},
-		{103, 4},
-		{125, 0},
+		{99, 0},
+		{99, 1},
+		{115, 0},
+		{115, 4},
+		{114, 1},
+		{114, 3},
+		{111, 1},
+		{111, 1},
+		{101, 2},
+		{101, 3},
+		{100, 3},
+		{100, 5},
+		{136, 3},
+		{136, 1},
+		{125, 2},
 		{125, 1},
-		{124, 1},
-		{124, 3},
-		{129, 0},
+		{108, 4},
+		{130, 0},
+		{130, 1},
 		{129, 1},
+		{129, 3},
+		{134, 0},
+		{134, 1},
+		{133, 1},
+		{133, 3},
+		{124, 1},
+		{124, 1},
+		{124, 1},
+		{124, 2},
+		{124, 2},
+		{104, 1},
+		{104, 1},
+		{135, 1},
+		{135, 1},
+		{128, 1},
 		{128, 1},
-		{128, 3},
+		{112, 1},
+		{112, 1},
+		{112, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{122, 1},
+		{122, 1},
+		{122, 1},
+		{122, 1},
+		{122, 1},
+		{122, 1},
+		{122, 1},
 		{119, 1},
 		{119, 1},
 		{119, 1},
-		{119, 2},
-		{119, 2},
-		{130, 1},
-		{130, 1},
-		{123, 1},
-		{123, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{118, 1},
+		{119, 1},
+		{119, 1},
+		{119, 1},
+		{117, 1},
+		{117, 1},
 		{118, 1},
 		{118, 1},
 		{118, 1},
 		{118, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{112, 1},
-		{112, 1},
+		{107, 1},
+		{107, 1},
 		{113, 1},
 		{113, 1},
 		{113, 1},
 		{113, 1},
-		{102, 1},
-		{102, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{121, 1},
-		{121, 1},
-		{104, 1},
-		{104, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
-		{94, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{126, 1},
+		{126, 1},
+		{109, 1},
+		{109, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
+		{97, 1},
 	}
 
 	yyhintXErrors = map[yyhintXError]string{}
 
-	yyhintParseTab = [318][]uint16{
+	yyhintParseTab = [349][]uint16{
 		// 0
-		{1: 295, 253, 246, 248, 283, 291, 267, 269, 270, 272, 241, 281, 299, 260, 256, 273, 265, 259, 255, 264, 224, 243, 244, 245, 271, 296, 231, 236, 258, 292, 293, 274, 247, 249, 302, 268, 276, 261, 257, 297, 266, 250, 275, 285, 277, 287, 279, 252, 263, 232, 284, 235, 240, 298, 242, 234, 286, 301, 233, 254, 278, 251, 300, 294, 262, 237, 289, 280, 282, 290, 288, 102: 238, 107: 225, 239, 111: 223, 230, 114: 229, 227, 222, 228, 226, 126: 221, 220},
-		{92: 219},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 408, 92: 218, 96: 534},
-		{1: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 92: 217},
-		{1: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 92: 215},
+		{1: 307, 265, 258, 260, 295, 303, 279, 281, 282, 284, 253, 293, 311, 272, 268, 285, 277, 271, 267, 276, 233, 255, 256, 257, 283, 308, 240, 248, 270, 304, 305, 286, 259, 261, 314, 280, 288, 273, 269, 309, 278, 262, 287, 297, 289, 299, 291, 264, 275, 244, 296, 247, 252, 310, 254, 246, 298, 313, 243, 242, 245, 266, 290, 263, 241, 312, 306, 274, 249, 301, 292, 294, 302, 300, 107: 250, 112: 234, 251, 116: 232, 239, 119: 238, 236, 231, 237, 235, 131: 230, 229},
+		{95: 228},
+		{1: 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 423, 95: 227, 99: 574},
+		{1: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 95: 226},
+		{1: 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 95: 224},
 		// 5
-		{91: 531},
-		{91: 528},
-		{91: 525},
-		{91: 520},
-		{91: 517},
+		{94: 571},
+		{94: 568},
+		{94: 565},
+		{94: 560},
+		{94: 557},
 		// 10
-		{91: 506},
-		{91: 494},
-		{91: 490},
-		{91: 486},
-		{91: 481},
+		{94: 546},
+		{94: 534},
+		{94: 530},
+		{94: 526},
+		{94: 520},
 		// 15
-		{91: 478},
-		{91: 466},
-		{91: 459},
-		{91: 454},
-		{91: 448},
+		{94: 505},
+		{94: 501},
+		{94: 496},
+		{94: 493},
+		{94: 481},
 		// 20
-		{91: 445},
-		{91: 439},
-		{91: 419},
-		{91: 303},
-		{91: 151},
+		{94: 474},
+		{94: 469},
+		{94: 463},
+		{94: 460},
+		{94: 454},
 		// 25
-		{91: 150},
-		{91: 149},
-		{91: 148},
-		{91: 147},
-		{91: 146},
+		{94: 434},
+		{94: 315},
+		{94: 154},
+		{94: 153},
+		{94: 152},
 		// 30
-		{91: 145},
-		{91: 144},
-		{91: 143},
-		{91: 142},
-		{91: 141},
+		{94: 151},
+		{94: 150},
+		{94: 149},
+		{94: 148},
+		{94: 147},
 		// 35
-		{91: 140},
-		{91: 139},
-		{91: 138},
-		{91: 137},
-		{91: 136},
+		{94: 146},
+		{94: 145},
+		{94: 144},
+		{94: 143},
+		{94: 142},
 		// 40
-		{91: 135},
-		{91: 134},
-		{91: 133},
-		{91: 132},
-		{91: 131},
+		{94: 141},
+		{94: 140},
+		{94: 139},
+		{94: 138},
+		{94: 137},
 		// 45
-		{91: 130},
-		{91: 129},
-		{91: 128},
-		{91: 127},
-		{91: 126},
+		{94: 136},
+		{94: 135},
+		{94: 134},
+		{94: 133},
+		{94: 132},
 		// 50
-		{91: 125},
-		{91: 124},
-		{91: 123},
-		{91: 122},
-		{91: 121},
+		{94: 131},
+		{94: 130},
+		{94: 129},
+		{94: 128},
+		{94: 127},
 		// 55
-		{91: 120},
-		{91: 119},
-		{91: 118},
-		{91: 117},
-		{91: 116},
+		{94: 126},
+		{94: 125},
+		{94: 124},
+		{94: 123},
+		{94: 122},
 		// 60
-		{91: 115},
-		{91: 114},
-		{91: 113},
-		{91: 112},
-		{91: 111},
+		{94: 121},
+		{94: 120},
+		{94: 119},
+		{94: 118},
+		{94: 117},
 		// 65
-		{91: 110},
-		{91: 109},
-		{91: 108},
-		{91: 107},
-		{91: 102},
+		{94: 116},
+		{94: 115},
+		{94: 114},
+		{94: 113},
+		{94: 112},
 		// 70
-		{91: 101},
-		{91: 100},
-		{91: 99},
-		{91: 98},
-		{91: 97},
+		{94: 111},
+		{94: 110},
+		{94: 105},
+		{94: 104},
+		{94: 103},
 		// 75
-		{91: 96},
-		{91: 95},
-		{91: 94},
-		{91: 93},
-		{91: 92},
+		{94: 102},
+		{94: 101},
+		{94: 100},
+		{94: 99},
+		{94: 98},
 		// 80
-		{91: 91},
-		{91: 90},
-		{91: 89},
-		{91: 88},
-		{77: 187, 187, 85: 305, 93: 304},
+		{94: 97},
+		{94: 96},
+		{94: 95},
+		{94: 94},
+		{94: 93},
 		// 85
-		{77: 310, 309, 104: 308, 307, 122: 306},
-		{186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 86: 186, 186, 186, 186},
-		{416, 72: 417},
-		{190, 72: 190},
-		{99: 311},
+		{94: 92},
+		{94: 91},
+		{80: 192, 192, 88: 317, 96: 316},
+		{80: 322, 321, 109: 320, 319, 127: 318},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 89: 191, 191, 191, 191},
 		// 90
-		{99: 85},
-		{99: 84},
-		{1: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 313, 98: 312},
-		{72: 414, 87: 413},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 315, 97: 314},
+		{431, 75: 432},
+		{195, 75: 195},
+		{102: 323},
+		{102: 88},
+		{102: 87},
 		// 95
-		{177, 72: 177, 87: 177},
-		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 87: 187, 400, 187, 93: 399},
-		{83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83},
-		{82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82},
-		{81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81},
+		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 76: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 317, 96: 325, 101: 324},
+		{75: 429, 91: 428},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 327, 100: 326},
+		{182, 75: 182, 91: 182},
+		{192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 317, 90: 415, 192, 192, 96: 414},
 		// 100
-		{80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80},
-		{79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79},
-		{78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78},
-		{77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77},
-		{76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76},
+		{86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86},
+		{85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85},
+		{84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84},
+		{83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83},
+		{82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82},
 		// 105
-		{75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75},
-		{74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74},
-		{73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73},
-		{72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72},
-		{71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71},
+		{81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81},
+		{80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80},
+		{79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79},
+		{78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78},
+		{77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77},
 		// 110
-		{70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70},
-		{69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69},
-		{68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68},
-		{67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67},
-		{66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66},
+		{76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76},
+		{75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75},
+		{74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74},
+		{73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73},
+		{72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72},
 		// 115
-		{65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65},
-		{64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64},
-		{63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63},
-		{62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62},
-		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
+		{71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71},
+		{70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70},
+		{69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69},
+		{68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68},
+		{67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67},
 		// 120
-		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
-		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
-		{58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
-		{57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
-		{56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
+		{66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66},
+		{65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65},
+		{64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64},
+		{63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63},
+		{62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62},
 		// 125
-		{55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
-		{54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
-		{53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
-		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
-		{51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
+		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
+		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
+		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
+		{58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
+		{57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
 		// 130
-		{50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
-		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
-		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
-		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47},
-		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46},
+		{56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
+		{55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
+		{54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
+		{53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
+		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
 		// 135
-		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
-		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44},
-		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43},
-		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42},
-		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41},
+		{51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
+		{50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
+		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
+		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
+		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47},
 		// 140
-		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40},
-		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39},
-		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38},
-		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37},
-		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36},
+		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46},
+		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44},
+		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43},
+		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42},
 		// 145
-		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35},
-		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34},
-		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33},
-		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32},
-		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31},
+		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41},
+		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39},
+		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38},
+		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37},
 		// 150
-		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
-		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29},
-		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
-		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27},
-		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26},
+		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36},
+		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35},
+		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34},
+		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33},
+		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32},
 		// 155
-		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25},
-		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24},
-		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23},
-		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22},
-		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21},
+		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31},
+		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29},
+		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27},
 		// 160
-		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20},
-		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19},
-		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18},
-		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17},
-		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16},
+		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26},
+		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25},
+		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24},
+		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23},
+		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22},
 		// 165
-		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15},
-		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14},
-		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13},
-		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12},
-		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11},
+		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21},
+		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20},
+		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19},
+		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18},
+		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17},
 		// 170
-		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
-		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
-		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8},
-		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7},
-		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
+		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16},
+		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15},
+		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14},
+		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13},
+		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12},
 		// 175
-		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
-		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
-		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
-		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
-		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11},
+		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
+		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
+		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8},
+		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7},
 		// 180
-		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 87: 183, 89: 403, 110: 412},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 401},
-		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 87: 187, 89: 187, 93: 402},
-		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 87: 183, 89: 403, 110: 404},
-		{91: 405},
+		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
+		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
+		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
 		// 185
-		{174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 87: 174},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 407, 109: 406},
-		{409, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 408, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 96: 410},
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181},
-		{184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 73: 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 86: 184, 95: 184},
+		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		{188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 91: 188, 418, 115: 427},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 416},
+		{192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 317, 91: 192, 192, 96: 417},
+		{188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 91: 188, 418, 115: 419},
 		// 190
-		{182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 87: 182},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 411},
-		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 86: 180},
-		{175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 87: 175},
-		{188, 72: 188},
+		{94: 420},
+		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 91: 179},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 422, 114: 421},
+		{424, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 423, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 99: 425},
+		{186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186},
 		// 195
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 315, 97: 415},
-		{176, 72: 176, 87: 176},
-		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 92: 191},
-		{77: 310, 309, 104: 308, 418},
-		{189, 72: 189},
+		{189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 76: 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 89: 189, 98: 189},
+		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 91: 187},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 426},
+		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 89: 185},
+		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 91: 180},
 		// 200
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 305, 187, 93: 420, 422, 109: 421},
-		{86: 437},
-		{433, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 408, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 86: 185, 96: 434},
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 86: 181, 90: 423},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 86: 427, 94: 426, 425, 100: 428, 429, 119: 424},
+		{193, 75: 193},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 327, 100: 430},
+		{181, 75: 181, 91: 181},
+		{1: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 95: 196},
+		{80: 322, 321, 109: 320, 433},
 		// 205
-		{432},
-		{160},
-		{159},
-		{158},
-		{86: 431},
+		{194, 75: 194},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 317, 192, 96: 435, 437, 114: 436},
+		{89: 452},
+		{448, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 423, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 89: 190, 99: 449},
+		{186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 89: 186, 93: 438},
 		// 210
-		{86: 430},
-		{156},
-		{157},
-		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 92: 192},
-		{1: 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 92: 194},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 89: 442, 97: 441, 440, 105: 443, 444, 124: 439},
+		{447},
+		{165},
+		{164},
+		{163},
 		// 215
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 86: 435, 94: 411},
-		{436},
-		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 92: 193},
-		{438},
-		{1: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 92: 195},
+		{89: 446},
+		{89: 445},
+		{161},
+		{162},
+		{1: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 95: 197},
 		// 220
-		{80: 187, 187, 85: 305, 93: 440},
-		{80: 442, 443, 121: 441},
-		{444},
-		{87},
-		{86},
+		{1: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 95: 199},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 89: 450, 97: 426},
+		{451},
+		{1: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 95: 198},
+		{453},
 		// 225
-		{1: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 92: 196},
-		{187, 85: 305, 93: 446},
-		{447},
-		{1: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 92: 197},
-		{79: 187, 82: 187, 85: 305, 93: 449},
+		{1: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 95: 200},
+		{83: 192, 192, 88: 317, 96: 455},
+		{83: 457, 458, 126: 456},
+		{459},
+		{90},
 		// 230
-		{79: 452, 82: 451, 123: 450},
-		{453},
-		{153},
-		{152},
-		{1: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 92: 198},
+		{89},
+		{1: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 95: 201},
+		{192, 88: 317, 96: 461},
+		{462},
+		{1: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 95: 202},
 		// 235
-		{95: 455},
-		{72: 408, 95: 185, 456},
-		{95: 457},
-		{458},
-		{1: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 92: 199},
-		// 240
-		{85: 305, 187, 93: 460},
-		{86: 461},
-		{83: 464, 463, 130: 462},
-		{465},
+		{82: 192, 85: 192, 88: 317, 96: 464},
+		{82: 467, 85: 466, 128: 465},
+		{468},
+		{156},
 		{155},
+		// 240
+		{1: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 95: 203},
+		{98: 470},
+		{75: 423, 98: 190, 471},
+		{98: 472},
+		{473},
 		// 245
-		{154},
-		{1: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 92: 200},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 467},
-		{468, 72: 469},
-		{1: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 92: 202},
+		{1: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 95: 204},
+		{88: 317, 192, 96: 475},
+		{89: 476},
+		{86: 479, 478, 135: 477},
+		{480},
 		// 250
-		{187, 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 305, 88: 187, 93: 473, 472, 120: 471, 131: 470},
-		{475, 88: 476},
-		{172, 88: 172},
-		{187, 85: 305, 88: 187, 93: 474},
-		{170, 88: 170},
+		{158},
+		{157},
+		{1: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 95: 205},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 482},
+		{483, 75: 484},
 		// 255
-		{171, 88: 171},
-		{1: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 92: 201},
-		{187, 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 305, 88: 187, 93: 473, 472, 120: 477},
-		{173, 88: 173},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 479},
+		{1: 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 95: 207},
+		{192, 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 317, 90: 192, 96: 488, 487, 125: 486, 136: 485},
+		{490, 90: 491},
+		{177, 90: 177},
+		{192, 88: 317, 90: 192, 96: 489},
 		// 260
-		{480},
-		{1: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 92: 203},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 482},
-		{90: 483},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 86: 427, 94: 426, 425, 100: 428, 429, 119: 484},
+		{175, 90: 175},
+		{176, 90: 176},
+		{1: 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 95: 206},
+		{192, 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 317, 90: 192, 96: 488, 487, 125: 492},
+		{178, 90: 178},
 		// 265
-		{485},
-		{1: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 92: 204},
-		{85: 305, 187, 93: 487},
-		{86: 488},
-		{489},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 494},
+		{495},
+		{1: 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 95: 208},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 497},
+		{93: 498},
 		// 270
-		{1: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 92: 205},
-		{85: 305, 187, 93: 491},
-		{86: 492},
-		{493},
-		{1: 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 92: 206},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 89: 442, 97: 441, 440, 105: 443, 444, 124: 499},
+		{500},
+		{1: 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 95: 209},
+		{88: 317, 192, 96: 502},
+		{89: 503},
 		// 275
-		{187, 73: 187, 187, 187, 187, 85: 305, 93: 495},
-		{164, 73: 499, 500, 501, 502, 113: 498, 128: 497, 496},
-		{505},
-		{163, 72: 503},
-		{162, 72: 162},
+		{504},
+		{1: 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 95: 210},
+		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 76: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 317, 96: 506},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 507},
+		{90: 508},
 		// 280
-		{106, 72: 106},
-		{105, 72: 105},
-		{104, 72: 104},
-		{103, 72: 103},
-		{73: 499, 500, 501, 502, 113: 504},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 509},
+		{75: 510, 90: 511},
+		{89: 515, 103: 516, 518},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 512},
+		{75: 513},
 		// 285
-		{161, 72: 161},
-		{1: 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 92: 207},
-		{1: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 508, 103: 507},
-		{516},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 315, 97: 509},
+		{89: 515, 103: 516, 514},
+		{517},
+		{160},
+		{159},
+		{1: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 95: 211},
 		// 290
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 408, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 96: 510},
-		{168, 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 513, 124: 512, 511},
-		{169},
-		{167, 72: 514},
-		{166, 72: 166},
-		// 295
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 515},
-		{165, 72: 165},
-		{1: 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 92: 208},
-		{1: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 508, 103: 518},
 		{519},
+		{1: 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 95: 212},
+		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 76: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 317, 96: 521},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 327, 100: 522},
+		{75: 523},
+		// 295
+		{89: 515, 103: 516, 524},
+		{525},
+		{1: 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 95: 213},
+		{88: 317, 192, 96: 527},
+		{89: 528},
 		// 300
-		{1: 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 92: 209},
-		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 523, 98: 522, 106: 521},
-		{524},
-		{179, 72: 414},
-		{178, 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 315, 97: 314},
+		{529},
+		{1: 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 95: 214},
+		{88: 317, 192, 96: 531},
+		{89: 532},
+		{533},
 		// 305
-		{1: 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 92: 210},
-		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 523, 98: 522, 106: 526},
-		{527},
-		{1: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 92: 211},
-		{1: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 313, 98: 529},
+		{1: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 95: 215},
+		{192, 76: 192, 192, 192, 192, 88: 317, 96: 535},
+		{169, 76: 539, 540, 541, 542, 118: 538, 133: 537, 536},
+		{545},
+		{168, 75: 543},
 		// 310
-		{530, 72: 414},
-		{1: 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 92: 212},
-		{187, 85: 305, 93: 532},
-		{533},
-		{1: 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 92: 213},
+		{167, 75: 167},
+		{109, 75: 109},
+		{108, 75: 108},
+		{107, 75: 107},
+		{106, 75: 106},
 		// 315
-		{1: 295, 253, 246, 248, 283, 291, 267, 269, 270, 272, 241, 281, 299, 260, 256, 273, 265, 259, 255, 264, 224, 243, 244, 245, 271, 296, 231, 236, 258, 292, 293, 274, 247, 249, 302, 268, 276, 261, 257, 297, 266, 250, 275, 285, 277, 287, 279, 252, 263, 232, 284, 235, 240, 298, 242, 234, 286, 301, 233, 254, 278, 251, 300, 294, 262, 237, 289, 280, 282, 290, 288, 102: 238, 107: 225, 239, 111: 536, 230, 114: 229, 227, 535, 228, 226},
-		{1: 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 92: 216},
-		{1: 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 92: 214},
+		{76: 539, 540, 541, 542, 118: 544},
+		{166, 75: 166},
+		{1: 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 95: 216},
+		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 76: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 317, 96: 548, 108: 547},
+		{556},
+		// 320
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 327, 100: 549},
+		{190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 423, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 99: 550},
+		{173, 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 553, 129: 552, 551},
+		{174},
+		{172, 75: 554},
+		// 325
+		{171, 75: 171},
+		{1: 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 555},
+		{170, 75: 170},
+		{1: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 95: 217},
+		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 76: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 317, 96: 548, 108: 558},
+		// 330
+		{559},
+		{1: 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 95: 218},
+		{192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 76: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 317, 96: 563, 101: 562, 111: 561},
+		{564},
+		{184, 75: 429},
+		// 335
+		{183, 358, 381, 333, 335, 394, 361, 337, 338, 339, 357, 328, 364, 360, 366, 369, 343, 372, 365, 368, 371, 329, 330, 331, 332, 396, 359, 353, 374, 341, 362, 363, 345, 334, 336, 398, 340, 347, 367, 370, 344, 373, 342, 346, 388, 348, 352, 350, 380, 375, 393, 387, 356, 376, 377, 378, 355, 351, 397, 401, 400, 354, 382, 349, 379, 399, 395, 383, 384, 391, 392, 386, 385, 389, 390, 76: 410, 411, 412, 413, 405, 404, 406, 402, 403, 407, 409, 408, 97: 327, 100: 326},
+		{1: 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 95: 219},
+		{192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 76: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 317, 96: 563, 101: 562, 111: 566},
+		{567},
+		{1: 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 95: 220},
+		// 340
+		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 76: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 317, 96: 325, 101: 569},
+		{570, 75: 429},
+		{1: 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 95: 221},
+		{192, 88: 317, 96: 572},
+		{573},
+		// 345
+		{1: 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 95: 222},
+		{1: 307, 265, 258, 260, 295, 303, 279, 281, 282, 284, 253, 293, 311, 272, 268, 285, 277, 271, 267, 276, 233, 255, 256, 257, 283, 308, 240, 248, 270, 304, 305, 286, 259, 261, 314, 280, 288, 273, 269, 309, 278, 262, 287, 297, 289, 299, 291, 264, 275, 244, 296, 247, 252, 310, 254, 246, 298, 313, 243, 242, 245, 266, 290, 263, 241, 312, 306, 274, 249, 301, 292, 294, 302, 300, 107: 250, 112: 234, 251, 116: 576, 239, 119: 238, 236, 575, 237, 235},
+		{1: 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 95: 225},
+		{1: 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 95: 223},
 	}
 )
 
@@ -1059,7 +1120,7 @@ func yyhintlex1(yylex yyhintLexer, lval *yyhintSymType) (n int) {
 }
 
 func yyhintParse(yylex yyhintLexer, parser *hintParser) int {
-	const yyError = 133
+	const yyError = 138
 
 	yyEx, _ := yylex.(yyhintLexerEx)
 	var yyn int
@@ -1310,10 +1371,45 @@ yynewstate:
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: ast.NewCIStr(yyS[yypt-4].ident),
 				QBName:   ast.NewCIStr(yyS[yypt-2].ident),
-				HintData: int64(yyS[yypt-1].number),
+				HintData: yyS[yypt-1].number,
 			}
 		}
 	case 15:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: ast.NewCIStr(yyS[yypt-6].ident),
+				QBName:   ast.NewCIStr(yyS[yypt-4].ident),
+				Tables:   []ast.HintTable{yyS[yypt-3].table},
+				HintData: yyS[yypt-1].float,
+			}
+		}
+	case 16:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: ast.NewCIStr(yyS[yypt-8].ident),
+				QBName:   ast.NewCIStr(yyS[yypt-6].ident),
+				Tables:   []ast.HintTable{{TableName: ast.NewCIStr(yyS[yypt-5].ident)}},
+				HintData: ast.HintColumnStats{Column: ast.NewCIStr(yyS[yypt-3].ident), Value: yyS[yypt-1].float},
+			}
+		}
+	case 17:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: ast.NewCIStr(yyS[yypt-10].ident),
+				QBName:   ast.NewCIStr(yyS[yypt-8].ident),
+				Tables:   []ast.HintTable{{DBName: ast.NewCIStr(yyS[yypt-7].ident), TableName: ast.NewCIStr(yyS[yypt-5].ident)}},
+				HintData: ast.HintColumnStats{Column: ast.NewCIStr(yyS[yypt-3].ident), Value: yyS[yypt-1].float},
+			}
+		}
+	case 18:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: ast.NewCIStr(yyS[yypt-4].ident),
+				QBName:   ast.NewCIStr(yyS[yypt-2].ident),
+				HintData: int64(yyS[yypt-1].number),
+			}
+		}
+	case 19:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: ast.NewCIStr(yyS[yypt-5].ident),
@@ -1323,21 +1419,21 @@ yynewstate:
 				},
 			}
 		}
-	case 16:
+	case 20:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: ast.NewCIStr(yyS[yypt-3].ident),
 				HintData: yyS[yypt-1].ident,
 			}
 		}
-	case 17:
+	case 21:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: ast.NewCIStr(yyS[yypt-3].ident),
 				QBName:   ast.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 18:
+	case 22:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: ast.NewCIStr(yyS[yypt-5].ident),
@@ -1345,7 +1441,7 @@ yynewstate:
 				Tables:   yyS[yypt-1].hint.Tables,
 			}
 		}
-	case 19:
+	case 23:
 		{
 			maxValue := uint64(math.MaxInt64) / yyS[yypt-1].number
 			if yyS[yypt-2].number <= maxValue {
@@ -1360,7 +1456,7 @@ yynewstate:
 				parser.yyVAL.hint = nil
 			}
 		}
-	case 20:
+	case 24:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: ast.NewCIStr(yyS[yypt-5].ident),
@@ -1370,21 +1466,21 @@ yynewstate:
 				},
 			}
 		}
-	case 21:
+	case 25:
 		{
 			h := yyS[yypt-1].hint
 			h.HintName = ast.NewCIStr(yyS[yypt-4].ident)
 			h.QBName = ast.NewCIStr(yyS[yypt-2].ident)
 			parser.yyVAL.hint = h
 		}
-	case 22:
+	case 26:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: ast.NewCIStr(yyS[yypt-3].ident),
 				QBName:   ast.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 23:
+	case 27:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: ast.NewCIStr(yyS[yypt-4].ident),
@@ -1392,27 +1488,27 @@ yynewstate:
 				HintData: ast.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 24:
+	case 28:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-4].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 25:
+	case 29:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-3].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 26:
+	case 30:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-5].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 27:
+	case 31:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-5].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 28:
+	case 32:
 		{
 			hs := yyS[yypt-1].hints
 			name := ast.NewCIStr(yyS[yypt-4].ident)
@@ -1423,60 +1519,60 @@ yynewstate:
 			}
 			parser.yyVAL.hints = hs
 		}
-	case 29:
+	case 33:
 		{
 			parser.yyVAL.hints = []*ast.TableOptimizerHint{yyS[yypt-0].hint}
 		}
-	case 30:
+	case 34:
 		{
 			parser.yyVAL.hints = append(yyS[yypt-2].hints, yyS[yypt-0].hint)
 		}
-	case 31:
+	case 35:
 		{
 			h := yyS[yypt-1].hint
 			h.HintData = ast.NewCIStr(yyS[yypt-3].ident)
 			parser.yyVAL.hint = h
 		}
-	case 32:
+	case 36:
 		{
 			parser.yyVAL.ident = ""
 		}
-	case 36:
+	case 40:
 		{
 			parser.yyVAL.modelIdents = nil
 		}
-	case 37:
+	case 41:
 		{
 			parser.yyVAL.modelIdents = yyS[yypt-1].modelIdents
 		}
-	case 38:
+	case 42:
 		{
 			parser.yyVAL.modelIdents = []ast.CIStr{ast.NewCIStr(yyS[yypt-0].ident)}
 		}
-	case 39:
+	case 43:
 		{
 			parser.yyVAL.modelIdents = append(yyS[yypt-2].modelIdents, ast.NewCIStr(yyS[yypt-0].ident))
 		}
-	case 41:
+	case 45:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				QBName: ast.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 42:
+	case 46:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Tables: []ast.HintTable{yyS[yypt-0].table},
 				QBName: ast.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 43:
+	case 47:
 		{
 			h := yyS[yypt-2].hint
 			h.Tables = append(h.Tables, yyS[yypt-0].table)
 			parser.yyVAL.hint = h
 		}
-	case 44:
+	case 48:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				TableName:     ast.NewCIStr(yyS[yypt-2].ident),
@@ -1484,7 +1580,7 @@ yynewstate:
 				PartitionList: yyS[yypt-0].modelIdents,
 			}
 		}
-	case 45:
+	case 49:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				DBName:        ast.NewCIStr(yyS[yypt-4].ident),
@@ -1493,63 +1589,63 @@ yynewstate:
 				PartitionList: yyS[yypt-0].modelIdents,
 			}
 		}
-	case 46:
+	case 50:
 		{
 			h := yyS[yypt-2].hint
 			h.Tables = append(h.Tables, yyS[yypt-0].table)
 			parser.yyVAL.hint = h
 		}
-	case 47:
+	case 51:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Tables: []ast.HintTable{yyS[yypt-0].table},
 			}
 		}
-	case 48:
+	case 52:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				TableName: ast.NewCIStr(yyS[yypt-1].ident),
 				QBName:    ast.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 49:
+	case 53:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				QBName: ast.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 50:
+	case 54:
 		{
 			h := yyS[yypt-0].hint
 			h.Tables = []ast.HintTable{yyS[yypt-2].table}
 			h.QBName = ast.NewCIStr(yyS[yypt-3].ident)
 			parser.yyVAL.hint = h
 		}
-	case 51:
+	case 55:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{}
 		}
-	case 53:
+	case 57:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Indexes: []ast.CIStr{ast.NewCIStr(yyS[yypt-0].ident)},
 			}
 		}
-	case 54:
+	case 58:
 		{
 			h := yyS[yypt-2].hint
 			h.Indexes = append(h.Indexes, ast.NewCIStr(yyS[yypt-0].ident))
 			parser.yyVAL.hint = h
 		}
-	case 61:
+	case 65:
 		{
 			parser.yyVAL.ident = strconv.FormatUint(yyS[yypt-0].number, 10)
 		}
-	case 62:
+	case 66:
 		{
 			parser.yyVAL.ident = strconv.FormatUint(yyS[yypt-0].number, 10)
 		}
-	case 63:
+	case 67:
 		{
 			if yyS[yypt-0].number > 9223372036854775808 {
 				yylex.AppendError(yylex.Errorf("the Signed Value should be at the range of [-9223372036854775808, 9223372036854775807]."))
@@ -1561,19 +1657,32 @@ yynewstate:
 				parser.yyVAL.ident = strconv.FormatInt(-int64(yyS[yypt-0].number), 10)
 			}
 		}
-	case 64:
+	case 68:
+		{
+			parser.yyVAL.float = float64(yyS[yypt-0].number)
+		}
+	case 69:
+		{
+			v, err := strconv.ParseFloat(yyS[yypt-0].ident, 64)
+			if err != nil {
+				yylex.AppendError(ErrWarnOptimizerHintInvalidToken.GenWithStackByArgs("floating point number", yyS[yypt-0].ident, hintFloatLit))
+				return 1
+			}
+			parser.yyVAL.float = v
+		}
+	case 70:
 		{
 			parser.yyVAL.number = 1024 * 1024
 		}
-	case 65:
+	case 71:
 		{
 			parser.yyVAL.number = 1024 * 1024 * 1024
 		}
-	case 66:
+	case 72:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: true}
 		}
-	case 67:
+	case 73:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: false}
 		}
@@ -27,6 +27,7 @@ import (
 	offset  int
 	ident   string
 	number  uint64
+	float   float64
 	hint    *ast.TableOptimizerHint
 	hints []*ast.TableOptimizerHint
 	table 	ast.HintTable
@@ -50,6 +51,9 @@ import (
 	/*yy:token "'%c'" */
 	hintStringLit
 
+	/*yy:token "%de1" */
+	hintFloatLit "a floating-point number in scientific notation"
+
 	/* MySQL 8.0 hint names */
 	hintJoinFixedOrder      "JOIN_FIXED_ORDER"
 	hintJoinOrder           "JOIN_ORDER"
@@ -123,6 +127,9 @@ import (
 	hintLeading               "LEADING"
 	hintSemiJoinRewrite       "SEMI_JOIN_REWRITE"
 	hintNoDecorrelate         "NO_DECORRELATE"
+	hintStatsSnapshotTS       "STATS_SNAPSHOT_TS"
+	hintSetTableRows          "SET_TABLE_ROWS"
+	hintSetColumnNDV          "SET_COLUMN_NDV"
 
 	/* Other keywords */
 	hintOLAP            "OLAP"
@@ -159,6 +166,9 @@ import (
 	UnitOfBytes "unit of bytes (MB or GB)"
 	CommaOpt    "optional ','"
 
+%type	<float>
+	HintStatsValue "the value of the statistics in optimizer hint"
+
 %type	<hints>
 	OptimizerHintList           "optimizer hint list"
 	StorageOptimizerHintOpt     "storage level optimizer hint"
@@ -266,6 +276,41 @@ TableOptimizerHintOpt:
 			HintData: $4,
 		}
 	}
+|	"STATS_SNAPSHOT_TS" '(' QueryBlockOpt hintIntLit ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: ast.NewCIStr($1),
+			QBName:   ast.NewCIStr($3),
+			HintData: $4,
+		}
+	}
+|	"SET_TABLE_ROWS" '(' QueryBlockOpt HintTable ',' HintStatsValue ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: ast.NewCIStr($1),
+			QBName:   ast.NewCIStr($3),
+			Tables:   []ast.HintTable{$4},
+			HintData: $6,
+		}
+	}
+|	"SET_COLUMN_NDV" '(' QueryBlockOpt Identifier '.' Identifier ',' HintStatsValue ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: ast.NewCIStr($1),
+			QBName:   ast.NewCIStr($3),
+			Tables:   []ast.HintTable{{TableName: ast.NewCIStr($4)}},
+			HintData: ast.HintColumnStats{Column: ast.NewCIStr($6), Value: $8},
+		}
+	}
+|	"SET_COLUMN_NDV" '(' QueryBlockOpt Identifier '.' Identifier '.' Identifier ',' HintStatsValue ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: ast.NewCIStr($1),
+			QBName:   ast.NewCIStr($3),
+			Tables:   []ast.HintTable{{DBName: ast.NewCIStr($4), TableName: ast.NewCIStr($6)}},
+			HintData: ast.HintColumnStats{Column: ast.NewCIStr($8), Value: $10},
+		}
+	}
 |	"NTH_PLAN" '(' QueryBlockOpt hintIntLit ')'
 	{
 		$$ = &ast.TableOptimizerHint{
@@ -591,6 +636,21 @@ Value:
 		}
 	}
 
+HintStatsValue:
+	hintIntLit
+	{
+		$$ = float64($1)
+	}
+|	hintFloatLit
+	{
+		v, err := strconv.ParseFloat($1, 64)
+		if err != nil {
+			yylex.AppendError(ErrWarnOptimizerHintInvalidToken.GenWithStackByArgs("floating point number", $1, hintFloatLit))
+			return 1
+		}
+		$$ = v
+	}
+
 UnitOfBytes:
 	"MB"
 	{
@@ -777,6 +837,9 @@ Identifier:
 |	"LEADING"
 |	"SEMI_JOIN_REWRITE"
 |	"NO_DECORRELATE"
+|	"STATS_SNAPSHOT_TS"
+|	"SET_TABLE_ROWS"
+|	"SET_COLUMN_NDV"
 /* other keywords */
 |	"OLAP"
 |	"OLTP"
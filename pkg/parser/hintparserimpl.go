@@ -99,6 +99,12 @@ func (hs *hintScanner) Lex(lval *yyhintSymType) int {
 		return '='
 
 	case floatLit:
+		// The floating point numbers in scientific notation are only accepted as the statistics values, e.g.
+		// SET_TABLE_ROWS(t, 1e9), they are rejected by the grammar elsewhere.
+		if strings.ContainsAny(lit, "eE") {
+			lval.ident = lit
+			return hintFloatLit
+		}
 		errorTokenType = "floating point number"
 	case decLit:
 		errorTokenType = "decimal number"
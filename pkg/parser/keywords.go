@@ -345,6 +345,7 @@ var Keywords = []KeywordsType{
 	{"DECLARE", false, "unreserved"},
 	{"DEFINER", false, "unreserved"},
 	{"DELAY_KEY_WRITE", false, "unreserved"},
+	{"DELTA", false, "unreserved"},
 	{"DIGEST", false, "unreserved"},
 	{"DIRECTORY", false, "unreserved"},
 	{"DISABLE", false, "unreserved"},
@@ -370,6 +371,7 @@ var Keywords = []KeywordsType{
 	{"ESCAPE", false, "unreserved"},
 	{"EVENT", false, "unreserved"},
 	{"EVENTS", false, "unreserved"},
+	{"EVICT", false, "unreserved"},
 	{"EVOLVE", false, "unreserved"},
 	{"EXCHANGE", false, "unreserved"},
 	{"EXCLUSIVE", false, "unreserved"},
@@ -507,6 +509,7 @@ var Keywords = []KeywordsType{
 	{"QUERY", false, "unreserved"},
 	{"QUICK", false, "unreserved"},
 	{"RATE_LIMIT", false, "unreserved"},
+	{"RAW", false, "unreserved"},
 	{"REBUILD", false, "unreserved"},
 	{"RECOMMEND", false, "unreserved"},
 	{"RECOVER", false, "unreserved"},
@@ -655,6 +658,7 @@ var Keywords = []KeywordsType{
 	{"HISTOGRAMS_IN_FLIGHT", false, "tidb"},
 	{"JOB", false, "tidb"},
 	{"JOBS", false, "tidb"},
+	{"JOIN_SKETCH", false, "tidb"},
 	{"NODE_ID", false, "tidb"},
 	{"NODE_STATE", false, "tidb"},
 	{"OPTIMISTIC", false, "tidb"},
@@ -679,5 +683,6 @@ var Keywords = []KeywordsType{
 	{"TIDB", false, "tidb"},
 	{"TIFLASH", false, "tidb"},
 	{"TOPN", false, "tidb"},
+	{"TRIGRAM", false, "tidb"},
 	{"WIDTH", false, "tidb"},
 }
@@ -328,6 +328,7 @@ var tokenMap = map[string]int{
 	"DEFINED":                  defined,
 	"DEFINER":                  definer,
 	"DELAY_KEY_WRITE":          delayKeyWrite,
+	"DELTA":                    delta,
 	"DELAYED":                  delayed,
 	"DELETE":                   deleteKwd,
 	"DEPENDENCY":               dependency,
@@ -373,6 +374,7 @@ var tokenMap = map[string]int{
 	"ESCAPED":                  escaped,
 	"EVENT":                    event,
 	"EVENTS":                   events,
+	"EVICT":                    evict,
 	"EVOLVE":                   evolve,
 	"EXACT":                    exact,
 	"EXEC_ELAPSED":             execElapsed,
@@ -483,6 +485,7 @@ var tokenMap = map[string]int{
 	"ISSUER":                   issuer,
 	"JOB":                      job,
 	"JOBS":                     jobs,
+	"JOIN_SKETCH":              joinSketch,
 	"JOIN":                     join,
 	"JSON_ARRAYAGG":            jsonArrayagg,
 	"JSON_OBJECTAGG":           jsonObjectAgg,
@@ -648,6 +651,7 @@ var tokenMap = map[string]int{
 	"QUICK":                    quick,
 	"RANGE":                    rangeKwd,
 	"RATE_LIMIT":               rateLimit,
+	"RAW":                      raw,
 	"READ":                     read,
 	"READ_ONLY":                readOnly,
 	"REAL":                     realType,
@@ -857,6 +861,7 @@ var tokenMap = map[string]int{
 	"TRANSACTION":              transaction,
 	"TRIGGER":                  trigger,
 	"TRIGGERS":                 triggers,
+	"TRIGRAM":                  trigram,
 	"TRIM":                     trim,
 	"TRUE":                     trueKwd,
 	"TRUNCATE":                 truncate,
@@ -1080,6 +1085,9 @@ var hintTokenMap = map[string]int{
 	"LEADING":                 hintLeading,
 	"SEMI_JOIN_REWRITE":       hintSemiJoinRewrite,
 	"NO_DECORRELATE":          hintNoDecorrelate,
+	"STATS_SNAPSHOT_TS":       hintStatsSnapshotTS,
+	"SET_TABLE_ROWS":          hintSetTableRows,
+	"SET_COLUMN_NDV":          hintSetColumnNDV,
 
 	// TiDB hint aliases
 	"TIDB_HJ":   hintHashJoin,
@@ -398,6 +398,7 @@ import (
 	declare               "DECLARE"
 	definer               "DEFINER"
 	delayKeyWrite         "DELAY_KEY_WRITE"
+	delta                 "DELTA"
 	digest                "DIGEST"
 	directory             "DIRECTORY"
 	disable               "DISABLE"
@@ -422,6 +423,7 @@ import (
 	identSQLErrors        "ERRORS"
 	escape                "ESCAPE"
 	event                 "EVENT"
+	evict                 "EVICT"
 	events                "EVENTS"
 	evolve                "EVOLVE"
 	exchange              "EXCHANGE"
@@ -561,6 +563,7 @@ import (
 	query                 "QUERY"
 	quick                 "QUICK"
 	rateLimit             "RATE_LIMIT"
+	raw                   "RAW"
 	rebuild               "REBUILD"
 	recommend             "RECOMMEND"
 	recover               "RECOVER"
@@ -867,6 +870,7 @@ import (
 	histogramsInFlight         "HISTOGRAMS_IN_FLIGHT"
 	job                        "JOB"
 	jobs                       "JOBS"
+	joinSketch                 "JOIN_SKETCH"
 	nodeID                     "NODE_ID"
 	nodeState                  "NODE_STATE"
 	optimistic                 "OPTIMISTIC"
@@ -891,6 +895,7 @@ import (
 	tidb                       "TIDB"
 	tiFlash                    "TIFLASH"
 	topn                       "TOPN"
+	trigram                    "TRIGRAM"
 	width                      "WIDTH"
 
 %token	<item>
@@ -1187,6 +1192,9 @@ import (
 	GlobalOrLocal                          "{GLOBAL|LOCAL}"
 	GlobalScope                            "The scope of variable"
 	StatementScope                         "The scope of statement"
+	EvictStatsScopeOpt                     "The scope of ADMIN EVICT STATS"
+	EvictStatsPartitionOpt                 "The partitions of ADMIN EVICT STATS"
+	EvictStatsColumnsOpt                   "The columns of ADMIN EVICT STATS"
 	GroupByClause                          "GROUP BY clause"
 	HavingClause                           "HAVING clause"
 	AsOfClause                             "AS OF clause"
@@ -1221,6 +1229,7 @@ import (
 	JoinType                               "join type"
 	KillOrKillTiDB                         "Kill or Kill TiDB"
 	LocationLabelList                      "location label name list"
+	LockStatsExpireOpt                     "Lock stats expiration optional"
 	LikeTableWithOrWithoutParen            "LIKE table_name or ( LIKE table_name )"
 	LimitClause                            "LIMIT clause"
 	LimitOption                            "Limit option could be integer or parameter marker."
@@ -1244,6 +1253,8 @@ import (
 	OnDuplicateKeyUpdate                   "ON DUPLICATE KEY UPDATE value list"
 	OnCommitOpt                            "ON COMMIT DELETE |PRESERVE ROWS"
 	DuplicateOpt                           "[IGNORE|REPLACE] in CREATE TABLE ... SELECT statement or LOAD DATA statement"
+	DropStatsItem                          "DROP STATS column or index list"
+	DropStatsItemList                      "DROP STATS column and index lists"
 	FormatOpt                              "FORMAT 'SQL FILE'..."
 	OfTablesOpt                            "OF table_name [, ...]"
 	OptFull                                "Full or empty"
@@ -1329,6 +1340,7 @@ import (
 	SetOprClause                           "Union/Except/Intersect select clause"
 	SetOprClauseList                       "Union/Except/Intersect select clause list"
 	ShowTargetFilterable                   "Show target that can be filtered by WHERE or LIKE"
+	ShowStatsTarget                        "Show target of the stats that can be filtered by WHERE or LIKE, sorted by ORDER BY and limited by LIMIT"
 	ShowTableAliasOpt                      "Show table alias option"
 	ShowLikeOrWhereOpt                     "Show like or where clause option"
 	ShowPlacementTarget                    "Show placement target"
@@ -1418,6 +1430,7 @@ import (
 	WithClause                             "With Clause"
 	WithList                               "With list"
 	WithReadLockOpt                        "With Read Lock opt"
+	WithStatisticsOpt                      "With Statistics opt"
 	WithGrantOptionOpt                     "With Grant Option opt"
 	WithValidation                         "with validation"
 	WithValidationOpt                      "optional with validation"
@@ -1592,6 +1605,7 @@ import (
 	FunctionNameDateArithMultiForms "Date arith function call names (adddate or subdate)"
 	VariableName                    "A simple Identifier like xx or the xx.xx form"
 	ConfigItemName                  "A config item like aa or aa.bb or aa.bb-cc.dd"
+	LockStatsCommentOpt             "Lock stats comment optional"
 	AuthString                      "Password string value"
 	AuthPlugin                      "Authentication plugin name"
 	CharsetName                     "Character set name"
@@ -2346,6 +2360,19 @@ AlterTableSpec:
 			Statistics:  statsSpec,
 		}
 	}
+|	"ADD" "STATS_EXTENDED" IfNotExists Identifier "ON" '(' Expression ')'
+	{
+		statsSpec := &ast.StatisticsSpec{
+			StatsName: $4,
+			StatsType: ast.StatsTypeExpression,
+			Expr:      $7,
+		}
+		$$ = &ast.AlterTableSpec{
+			Tp:          ast.AlterTableAddStatistics,
+			IfNotExists: $3.(bool),
+			Statistics:  statsSpec,
+		}
+	}
 |	AttributesOpt
 	{
 		$$ = &ast.AlterTableSpec{
@@ -3243,10 +3270,26 @@ AnalyzeTableStmt:
 	{
 		$$ = &ast.AnalyzeTableStmt{TableNames: $4.([]*ast.TableName), NoWriteToBinLog: $2.(bool), ColumnChoice: $5.(ast.ColumnChoice), AnalyzeOpts: $6.([]ast.AnalyzeOpt)}
 	}
+|	"ANALYZE" NoWriteToBinLogAliasOpt "TABLE" TableName "QUICK"
+	{
+		$$ = &ast.AnalyzeTableStmt{TableNames: []*ast.TableName{$4.(*ast.TableName)}, NoWriteToBinLog: $2.(bool), Quick: true}
+	}
+|	"ANALYZE" NoWriteToBinLogAliasOpt "TABLE" TableName "PARTITION" PartitionNameList "QUICK"
+	{
+		$$ = &ast.AnalyzeTableStmt{TableNames: []*ast.TableName{$4.(*ast.TableName)}, NoWriteToBinLog: $2.(bool), PartitionNames: $6.([]ast.CIStr), Quick: true}
+	}
 |	"ANALYZE" NoWriteToBinLogAliasOpt "TABLE" TableName "INDEX" IndexNameList AnalyzeOptionListOpt
 	{
 		$$ = &ast.AnalyzeTableStmt{TableNames: []*ast.TableName{$4.(*ast.TableName)}, NoWriteToBinLog: $2.(bool), IndexNames: $6.([]ast.CIStr), IndexFlag: true, AnalyzeOpts: $7.([]ast.AnalyzeOpt)}
 	}
+|	"ANALYZE" NoWriteToBinLogAliasOpt "INCREMENTAL" "TABLE" TableName AnalyzeOptionListOpt
+	{
+		$$ = &ast.AnalyzeTableStmt{TableNames: []*ast.TableName{$5.(*ast.TableName)}, NoWriteToBinLog: $2.(bool), Incremental: true, AnalyzeOpts: $6.([]ast.AnalyzeOpt)}
+	}
+|	"ANALYZE" NoWriteToBinLogAliasOpt "INCREMENTAL" "TABLE" TableName "PARTITION" PartitionNameList AnalyzeOptionListOpt
+	{
+		$$ = &ast.AnalyzeTableStmt{TableNames: []*ast.TableName{$5.(*ast.TableName)}, NoWriteToBinLog: $2.(bool), PartitionNames: $7.([]ast.CIStr), Incremental: true, AnalyzeOpts: $8.([]ast.AnalyzeOpt)}
+	}
 |	"ANALYZE" NoWriteToBinLogAliasOpt "INCREMENTAL" "TABLE" TableName "INDEX" IndexNameList AnalyzeOptionListOpt
 	{
 		$$ = &ast.AnalyzeTableStmt{TableNames: []*ast.TableName{$5.(*ast.TableName)}, NoWriteToBinLog: $2.(bool), IndexNames: $7.([]ast.CIStr), IndexFlag: true, Incremental: true, AnalyzeOpts: $8.([]ast.AnalyzeOpt)}
@@ -4176,6 +4219,14 @@ StatsType:
 	{
 		$$ = ast.StatsTypeCorrelation
 	}
+|	"TRIGRAM"
+	{
+		$$ = ast.StatsTypeTrigram
+	}
+|	"JOIN_SKETCH"
+	{
+		$$ = ast.StatsTypeJoinSketch
+	}
 
 BindingStatusType:
 	"ENABLED"
@@ -4530,19 +4581,20 @@ CreateTableStmt:
 		}
 		$$ = stmt
 	}
-|	"CREATE" OptTemporary "TABLE" IfNotExists TableName LikeTableWithOrWithoutParen OnCommitOpt
+|	"CREATE" OptTemporary "TABLE" IfNotExists TableName LikeTableWithOrWithoutParen WithStatisticsOpt OnCommitOpt
 	{
 		tmp := &ast.CreateTableStmt{
 			Table:            $5.(*ast.TableName),
 			ReferTable:       $6.(*ast.TableName),
+			WithStatistics:   $7.(bool),
 			IfNotExists:      $4.(bool),
 			TemporaryKeyword: $2.(ast.TemporaryKeyword),
 		}
-		if ($7 != nil && tmp.TemporaryKeyword != ast.TemporaryGlobal) || (tmp.TemporaryKeyword == ast.TemporaryGlobal && $7 == nil) {
+		if ($8 != nil && tmp.TemporaryKeyword != ast.TemporaryGlobal) || (tmp.TemporaryKeyword == ast.TemporaryGlobal && $8 == nil) {
 			yylex.AppendError(yylex.Errorf("GLOBAL TEMPORARY and ON COMMIT DELETE ROWS must appear together"))
 		} else {
 			if tmp.TemporaryKeyword == ast.TemporaryGlobal {
-				tmp.OnCommitDelete = $7.(bool)
+				tmp.OnCommitDelete = $8.(bool)
 			}
 		}
 		$$ = tmp
@@ -5427,6 +5479,33 @@ DropStatsStmt:
 			IsGlobalStats: true,
 		}
 	}
+|	"DROP" "STATS" TableName DropStatsItemList
+	{
+		stmt := $4.(*ast.DropStatsStmt)
+		stmt.Tables = []*ast.TableName{$3.(*ast.TableName)}
+		$$ = stmt
+	}
+
+DropStatsItemList:
+	DropStatsItem
+|	DropStatsItemList ',' DropStatsItem
+	{
+		stmt := $1.(*ast.DropStatsStmt)
+		item := $3.(*ast.DropStatsStmt)
+		stmt.ColumnNames = append(stmt.ColumnNames, item.ColumnNames...)
+		stmt.IndexNames = append(stmt.IndexNames, item.IndexNames...)
+		$$ = stmt
+	}
+
+DropStatsItem:
+	"COLUMNS" '(' IdentList ')'
+	{
+		$$ = &ast.DropStatsStmt{ColumnNames: $3.([]ast.CIStr)}
+	}
+|	"INDEXES" '(' IdentList ')'
+	{
+		$$ = &ast.DropStatsStmt{IndexNames: $3.([]ast.CIStr)}
+	}
 
 RestrictOrCascadeOpt:
 	{}
@@ -6840,6 +6919,7 @@ UnReservedKeyword:
 |	"ERROR"
 |	"ERRORS"
 |	"ESCAPE"
+|	"EVICT"
 |	"EVOLVE"
 |	"EXECUTE"
 |	"EXTENDED"
@@ -6870,6 +6950,7 @@ UnReservedKeyword:
 |	"PRE_SPLIT_REGIONS"
 |	"PROXY"
 |	"QUICK"
+|	"RAW"
 |	"REBUILD"
 |	"RECOMMEND"
 |	"REDUNDANT"
@@ -6930,6 +7011,7 @@ UnReservedKeyword:
 |	"GRANTS"
 |	"TRIGGERS"
 |	"DELAY_KEY_WRITE"
+|	"DELTA"
 |	"ISOLATION"
 |	"JSON"
 |	"REPEATABLE"
@@ -7182,6 +7264,7 @@ TiDBKeyword:
 |	"DEPTH"
 |	"JOBS"
 |	"JOB"
+|	"JOIN_SKETCH"
 |	"NODE_ID"
 |	"NODE_STATE"
 |	"SAMPLES"
@@ -7200,6 +7283,7 @@ TiDBKeyword:
 |	"TIDB"
 |	"TIFLASH"
 |	"TOPN"
+|	"TRIGRAM"
 |	"SPLIT"
 |	"OPTIMISTIC"
 |	"PESSIMISTIC"
@@ -8135,6 +8219,7 @@ FunctionNameConflict:
 |	"RIGHT"
 |	"ROW_COUNT"
 |	"SECOND"
+|	"STATS_HEALTHY"
 |	"TIME"
 |	"TIMESTAMP"
 |	"TRUNCATE"
@@ -11249,6 +11334,23 @@ AdminStmt:
 			Tables: $4.([]*ast.TableName),
 		}
 	}
+|	"ADMIN" "CHECK" "STATS" "TABLE" TableNameList
+	{
+		$$ = &ast.AdminStmt{
+			Tp:     ast.AdminCheckStatsTable,
+			Tables: $5.([]*ast.TableName),
+		}
+	}
+|	"ADMIN" "EVICT" EvictStatsScopeOpt "STATS" "TABLE" TableName EvictStatsPartitionOpt EvictStatsColumnsOpt
+	{
+		$$ = &ast.AdminStmt{
+			Tp:             ast.AdminEvictStats,
+			StatementScope: $3.(ast.StatementScope),
+			Tables:         []*ast.TableName{$6.(*ast.TableName)},
+			PartitionNames: $7.([]ast.CIStr),
+			ColumnNames:    $8.([]ast.CIStr),
+		}
+	}
 |	"ADMIN" "CHECK" "INDEX" TableName Identifier
 	{
 		$$ = &ast.AdminStmt{
@@ -11412,6 +11514,19 @@ AdminStmt:
 			Tp: ast.AdminReloadStatistics,
 		}
 	}
+|	"ADMIN" "FLUSH" "STATS" "DELTA"
+	{
+		$$ = &ast.AdminStmt{
+			Tp: ast.AdminFlushStatsDelta,
+		}
+	}
+|	"ADMIN" "FLUSH" "STATS" "DELTA" "TABLE" TableNameList
+	{
+		$$ = &ast.AdminStmt{
+			Tp:     ast.AdminFlushStatsDelta,
+			Tables: $6.([]*ast.TableName),
+		}
+	}
 |	"ADMIN" "FLUSH" StatementScope "PLAN_CACHE"
 	{
 		$$ = &ast.AdminStmt{
@@ -11539,6 +11654,24 @@ ShowStmt:
 		}
 		$$ = stmt
 	}
+|	"SHOW" ShowStatsTarget ShowLikeOrWhereOpt OrderByOptional SelectStmtLimitOpt
+	{
+		stmt := $2.(*ast.ShowStmt)
+		if $3 != nil {
+			if x, ok := $3.(*ast.PatternLikeOrIlikeExpr); ok && x.Expr == nil {
+				stmt.Pattern = x
+			} else {
+				stmt.Where = $3.(ast.ExprNode)
+			}
+		}
+		if $4 != nil {
+			stmt.OrderBy = $4.(*ast.OrderByClause)
+		}
+		if $5 != nil {
+			stmt.Limit = $5.(*ast.Limit)
+		}
+		$$ = stmt
+	}
 |	"SHOW" "CREATE" "TABLE" TableName
 	{
 		$$ = &ast.ShowStmt{
@@ -11546,6 +11679,14 @@ ShowStmt:
 			Table: $4.(*ast.TableName),
 		}
 	}
+|	"SHOW" "CREATE" "TABLE" TableName "WITH" "STATS"
+	{
+		$$ = &ast.ShowStmt{
+			Tp:        ast.ShowCreateTable,
+			Table:     $4.(*ast.TableName),
+			WithStats: true,
+		}
+	}
 |	"SHOW" "CREATE" "VIEW" TableName
 	{
 		$$ = &ast.ShowStmt{
@@ -12001,22 +12142,6 @@ ShowTargetFilterable:
 	{
 		$$ = &ast.ShowStmt{Tp: ast.ShowStatsExtended}
 	}
-|	"STATS_META"
-	{
-		$$ = &ast.ShowStmt{Tp: ast.ShowStatsMeta, Table: &ast.TableName{Name: ast.NewCIStr("STATS_META"), Schema: ast.NewCIStr(mysql.SystemDB)}}
-	}
-|	"STATS_HISTOGRAMS"
-	{
-		$$ = &ast.ShowStmt{Tp: ast.ShowStatsHistograms, Table: &ast.TableName{Name: ast.NewCIStr("STATS_HISTOGRAMS"), Schema: ast.NewCIStr(mysql.SystemDB)}}
-	}
-|	"STATS_TOPN"
-	{
-		$$ = &ast.ShowStmt{Tp: ast.ShowStatsTopN}
-	}
-|	"STATS_BUCKETS"
-	{
-		$$ = &ast.ShowStmt{Tp: ast.ShowStatsBuckets, Table: &ast.TableName{Name: ast.NewCIStr("STATS_BUCKETS"), Schema: ast.NewCIStr(mysql.SystemDB)}}
-	}
 |	"STATS_HEALTHY"
 	{
 		$$ = &ast.ShowStmt{Tp: ast.ShowStatsHealthy}
@@ -12058,6 +12183,32 @@ ShowTargetFilterable:
 		$$ = &ast.ShowStmt{Tp: ast.ShowImportJobs}
 	}
 
+ShowStatsTarget:
+	"STATS_META"
+	{
+		$$ = &ast.ShowStmt{Tp: ast.ShowStatsMeta, Table: &ast.TableName{Name: ast.NewCIStr("STATS_META"), Schema: ast.NewCIStr(mysql.SystemDB)}}
+	}
+|	"STATS_HISTOGRAMS"
+	{
+		$$ = &ast.ShowStmt{Tp: ast.ShowStatsHistograms, Table: &ast.TableName{Name: ast.NewCIStr("STATS_HISTOGRAMS"), Schema: ast.NewCIStr(mysql.SystemDB)}}
+	}
+|	"STATS_TOPN"
+	{
+		$$ = &ast.ShowStmt{Tp: ast.ShowStatsTopN}
+	}
+|	"STATS_BUCKETS"
+	{
+		$$ = &ast.ShowStmt{Tp: ast.ShowStatsBuckets, Table: &ast.TableName{Name: ast.NewCIStr("STATS_BUCKETS"), Schema: ast.NewCIStr(mysql.SystemDB)}}
+	}
+|	"STATS_TOPN" "RAW"
+	{
+		$$ = &ast.ShowStmt{Tp: ast.ShowStatsTopN, RawStats: true}
+	}
+|	"STATS_BUCKETS" "RAW"
+	{
+		$$ = &ast.ShowStmt{Tp: ast.ShowStatsBuckets, Table: &ast.TableName{Name: ast.NewCIStr("STATS_BUCKETS"), Schema: ast.NewCIStr(mysql.SystemDB)}, RawStats: true}
+	}
+
 ShowLikeOrWhereOpt:
 	{
 		$$ = nil
@@ -12105,6 +12256,37 @@ StatementScope:
 		$$ = ast.StatementScopeSession
 	}
 
+EvictStatsScopeOpt:
+	{
+		$$ = ast.StatementScopeInstance
+	}
+|	"INSTANCE"
+	{
+		$$ = ast.StatementScopeInstance
+	}
+|	"GLOBAL"
+	{
+		$$ = ast.StatementScopeGlobal
+	}
+
+EvictStatsPartitionOpt:
+	{
+		$$ = []ast.CIStr{}
+	}
+|	"PARTITION" PartitionNameList
+	{
+		$$ = $2
+	}
+
+EvictStatsColumnsOpt:
+	{
+		$$ = []ast.CIStr{}
+	}
+|	"COLUMNS" IdentList
+	{
+		$$ = $2
+	}
+
 OptFull:
 	{
 		$$ = false
@@ -12255,6 +12437,15 @@ WithReadLockOpt:
 		$$ = true
 	}
 
+WithStatisticsOpt:
+	{
+		$$ = false
+	}
+|	"WITH" "STATISTICS"
+	{
+		$$ = true
+	}
+
 Statement:
 	EmptyStmt
 |	AdminStmt
@@ -15323,50 +15514,99 @@ LoadStatsStmt:
 	}
 
 LockStatsStmt:
-	"LOCK" "STATS" TableNameList
+	"LOCK" "STATS" TableNameList LockStatsExpireOpt LockStatsCommentOpt
 	{
 		$$ = &ast.LockStatsStmt{
-			Tables: $3.([]*ast.TableName),
+			Tables:       $3.([]*ast.TableName),
+			ExpireOption: $4.(*ast.LockStatsExpireOption),
+			Comment:      $5,
 		}
 	}
-|	"LOCK" "STATS" TableName "PARTITION" PartitionNameList
+|	"LOCK" "STATS" TableName "PARTITION" PartitionNameList LockStatsExpireOpt LockStatsCommentOpt
 	{
 		x := $3.(*ast.TableName)
 		x.PartitionNames = $5.([]ast.CIStr)
 		$$ = &ast.LockStatsStmt{
-			Tables: []*ast.TableName{x},
+			Tables:       []*ast.TableName{x},
+			ExpireOption: $6.(*ast.LockStatsExpireOption),
+			Comment:      $7,
 		}
 	}
-|	"LOCK" "STATS" TableName "PARTITION" '(' PartitionNameList ')'
+|	"LOCK" "STATS" TableName "PARTITION" '(' PartitionNameList ')' LockStatsExpireOpt LockStatsCommentOpt
 	{
 		x := $3.(*ast.TableName)
 		x.PartitionNames = $6.([]ast.CIStr)
 		$$ = &ast.LockStatsStmt{
-			Tables: []*ast.TableName{x},
+			Tables:       []*ast.TableName{x},
+			ExpireOption: $8.(*ast.LockStatsExpireOption),
+			Comment:      $9,
+		}
+	}
+|	"LOCK" "STATS" TableName "COLUMNS" '(' IdentList ')' LockStatsCommentOpt
+	{
+		$$ = &ast.LockStatsStmt{
+			Tables:      []*ast.TableName{$3.(*ast.TableName)},
+			ColumnNames: $6.([]ast.CIStr),
+			Comment:     $8,
 		}
 	}
 
+LockStatsExpireOpt:
+	%prec empty
+	{
+		$$ = (*ast.LockStatsExpireOption)(nil)
+	}
+|	"UNTIL" "TIMESTAMP" Expression
+	{
+		$$ = &ast.LockStatsExpireOption{Tp: ast.LockStatsExpireUntil, Expr: $3.(ast.ExprNode)}
+	}
+|	"FOR" "INTERVAL" Expression TimeUnit
+	{
+		$$ = &ast.LockStatsExpireOption{Tp: ast.LockStatsExpireFor, Expr: $3.(ast.ExprNode), Unit: $4.(ast.TimeUnitType)}
+	}
+
+LockStatsCommentOpt:
+	%prec empty
+	{
+		$$ = ""
+	}
+|	"COMMENT" stringLit
+	{
+		$$ = $2
+	}
+
 UnlockStatsStmt:
-	"UNLOCK" "STATS" TableNameList
+	"UNLOCK" "STATS" TableNameList LockStatsCommentOpt
 	{
 		$$ = &ast.UnlockStatsStmt{
-			Tables: $3.([]*ast.TableName),
+			Tables:  $3.([]*ast.TableName),
+			Comment: $4,
 		}
 	}
-|	"UNLOCK" "STATS" TableName "PARTITION" PartitionNameList
+|	"UNLOCK" "STATS" TableName "PARTITION" PartitionNameList LockStatsCommentOpt
 	{
 		x := $3.(*ast.TableName)
 		x.PartitionNames = $5.([]ast.CIStr)
 		$$ = &ast.UnlockStatsStmt{
-			Tables: []*ast.TableName{x},
+			Tables:  []*ast.TableName{x},
+			Comment: $6,
 		}
 	}
-|	"UNLOCK" "STATS" TableName "PARTITION" '(' PartitionNameList ')'
+|	"UNLOCK" "STATS" TableName "PARTITION" '(' PartitionNameList ')' LockStatsCommentOpt
 	{
 		x := $3.(*ast.TableName)
 		x.PartitionNames = $6.([]ast.CIStr)
 		$$ = &ast.UnlockStatsStmt{
-			Tables: []*ast.TableName{x},
+			Tables:  []*ast.TableName{x},
+			Comment: $8,
+		}
+	}
+|	"UNLOCK" "STATS" TableName "COLUMNS" '(' IdentList ')' LockStatsCommentOpt
+	{
+		$$ = &ast.UnlockStatsStmt{
+			Tables:      []*ast.TableName{$3.(*ast.TableName)},
+			ColumnNames: $6.([]ast.CIStr),
+			Comment:     $8,
 		}
 	}
 
@@ -93,7 +93,7 @@ func TestSimple(t *testing.T) {
 		"enable", "disable", "reverse", "space", "privileges", "get_lock", "release_lock", "sleep", "no", "greatest", "least",
 		"binlog", "hex", "unhex", "function", "indexes", "from_unixtime", "processlist", "events", "less", "than", "timediff",
 		"ln", "log", "log2", "log10", "timestampdiff", "pi", "proxy", "quote", "none", "super", "shared", "exclusive",
-		"always", "stats", "stats_meta", "stats_histogram", "stats_buckets", "stats_healthy", "tidb_version", "replication", "slave", "client",
+		"always", "stats", "stats_meta", "stats_histogram", "stats_buckets", "stats_healthy", "raw", "tidb_version", "replication", "slave", "client",
 		"max_connections_per_hour", "max_queries_per_hour", "max_updates_per_hour", "max_user_connections", "event", "reload", "routine", "temporary",
 		"following", "preceding", "unbounded", "respect", "nulls", "current", "last", "against", "expansion",
 		"chain", "error", "general", "nvarchar", "pack_keys", "p", "shard_row_id_bits", "pre_split_regions",
@@ -499,6 +499,18 @@ func TestAdminStmt(t *testing.T) {
 		{"admin show t1 next_row_id", true, "ADMIN SHOW `t1` NEXT_ROW_ID"},
 		{"admin create workload snapshot;", true, "ADMIN CREATE WORKLOAD SNAPSHOT"},
 		{"admin check table t1, t2;", true, "ADMIN CHECK TABLE `t1`, `t2`"},
+		{"admin check stats table t1, test.t2;", true, "ADMIN CHECK STATS TABLE `t1`, `test`.`t2`"},
+		{"admin check stats table;", false, ""},
+		{"admin evict stats table t", true, "ADMIN EVICT STATS TABLE `t`"},
+		{"admin evict instance stats table test.t", true, "ADMIN EVICT STATS TABLE `test`.`t`"},
+		{"admin evict global stats table t partition p0, p1", true, "ADMIN EVICT GLOBAL STATS TABLE `t` PARTITION `p0`, `p1`"},
+		{"admin evict stats table t columns a, b", true, "ADMIN EVICT STATS TABLE `t` COLUMNS `a`, `b`"},
+		{"admin evict global stats table t partition p0 columns a", true, "ADMIN EVICT GLOBAL STATS TABLE `t` PARTITION `p0` COLUMNS `a`"},
+		{"admin evict stats table t1, t2", false, ""},
+		{"admin evict session stats table t", false, ""},
+		{"admin flush stats delta", true, "ADMIN FLUSH STATS DELTA"},
+		{"admin flush stats delta table t1, test.t2", true, "ADMIN FLUSH STATS DELTA TABLE `t1`, `test`.`t2`"},
+		{"admin flush stats delta table", false, ""},
 		{"admin check index tableName idxName;", true, "ADMIN CHECK INDEX `tableName` idxName"},
 		{"admin check index tableName idxName (1, 2), (4, 5);", true, "ADMIN CHECK INDEX `tableName` idxName (1,2), (4,5)"},
 		{"admin checksum table t1, t2;", true, "ADMIN CHECKSUM TABLE `t1`, `t2`"},
@@ -1272,21 +1284,34 @@ func TestDBAStmt(t *testing.T) {
 		// for show stats_meta.
 		{"show stats_meta", true, "SHOW STATS_META"},
 		{"show stats_meta where table_name = 't'", true, "SHOW STATS_META WHERE `table_name`=_UTF8MB4't'"},
+		{"show stats_meta where db_name = 'test' order by modify_count desc limit 10", true, "SHOW STATS_META WHERE `db_name`=_UTF8MB4'test' ORDER BY `modify_count` DESC LIMIT 10"},
+		{"show stats_meta like 'test%' order by table_name", true, "SHOW STATS_META LIKE _UTF8MB4'test%' ORDER BY `table_name`"},
+		{"show stats_meta limit 10, 20", true, "SHOW STATS_META LIMIT 10,20"},
+		{"show stats_healthy limit 10", false, ""},
 		// for show stats_locked.
 		{"show stats_locked", true, "SHOW STATS_LOCKED"},
 		{"show stats_locked where table_name = 't'", true, "SHOW STATS_LOCKED WHERE `table_name`=_UTF8MB4't'"},
 		// for show stats_histograms
 		{"show stats_histograms", true, "SHOW STATS_HISTOGRAMS"},
 		{"show stats_histograms where col_name = 'a'", true, "SHOW STATS_HISTOGRAMS WHERE `col_name`=_UTF8MB4'a'"},
+		{"show stats_histograms order by distinct_count desc, table_name limit 5 offset 10", true, "SHOW STATS_HISTOGRAMS ORDER BY `distinct_count` DESC,`table_name` LIMIT 10,5"},
 		// for show stats_buckets
 		{"show stats_buckets", true, "SHOW STATS_BUCKETS"},
 		{"show stats_buckets where col_name = 'a'", true, "SHOW STATS_BUCKETS WHERE `col_name`=_UTF8MB4'a'"},
+		{"show stats_buckets where table_name = 't' limit 1", true, "SHOW STATS_BUCKETS WHERE `table_name`=_UTF8MB4't' LIMIT 1"},
+		{"show stats_buckets raw", true, "SHOW STATS_BUCKETS RAW"},
+		{"show stats_buckets raw where col_name = 'a' limit 1", true, "SHOW STATS_BUCKETS RAW WHERE `col_name`=_UTF8MB4'a' LIMIT 1"},
 		// for show stats_healthy.
+		{"show create table t with stats", true, "SHOW CREATE TABLE `t` WITH STATS"},
+		{"show create table test.t with stats", true, "SHOW CREATE TABLE `test`.`t` WITH STATS"},
 		{"show stats_healthy", true, "SHOW STATS_HEALTHY"},
 		{"show stats_healthy where table_name = 't'", true, "SHOW STATS_HEALTHY WHERE `table_name`=_UTF8MB4't'"},
 		// for show stats_topn.
 		{"show stats_topn", true, "SHOW STATS_TOPN"},
 		{"show stats_topn where table_name = 't'", true, "SHOW STATS_TOPN WHERE `table_name`=_UTF8MB4't'"},
+		{"show stats_topn raw where table_name = 't'", true, "SHOW STATS_TOPN RAW WHERE `table_name`=_UTF8MB4't'"},
+		{"show stats_meta raw", false, ""},
+		{"show stats_topn where table_name = 't' order by count desc limit 3", true, "SHOW STATS_TOPN WHERE `table_name`=_UTF8MB4't' ORDER BY `count` DESC LIMIT 3"},
 		// for show histograms_in_flight.
 		{"show histograms_in_flight", true, "SHOW HISTOGRAMS_IN_FLIGHT"},
 		// for show column_stats_usage.
@@ -1314,10 +1339,25 @@ func TestDBAStmt(t *testing.T) {
 		{"lock stats test.t", true, "LOCK STATS `test`.`t`"},
 		{"lock stats t, t2", true, "LOCK STATS `t`, `t2`"},
 		{"lock stats t partition (p0, p1)", true, "LOCK STATS `t` PARTITION(`p0`, `p1`)"},
+		{"lock stats t until timestamp '2025-01-01 00:00:00'", true, "LOCK STATS `t` UNTIL TIMESTAMP _UTF8MB4'2025-01-01 00:00:00'"},
+		{"lock stats t, t2 for interval 7 day", true, "LOCK STATS `t`, `t2` FOR INTERVAL 7 DAY"},
+		{"lock stats t partition p0 for interval 1 hour", true, "LOCK STATS `t` PARTITION(`p0`) FOR INTERVAL 1 HOUR"},
+		{"lock stats t partition (p0, p1) until timestamp now() + interval 1 day", true, "LOCK STATS `t` PARTITION(`p0`, `p1`) UNTIL TIMESTAMP DATE_ADD(NOW(), INTERVAL 1 DAY)"},
+		{"lock stats t for interval 7", false, ""},
+		{"lock stats t until '2025-01-01 00:00:00'", false, ""},
+		{"lock stats test.t columns (a, b)", true, "LOCK STATS `test`.`t` COLUMNS (`a`, `b`)"},
+		{"lock stats t columns ()", false, ""},
+		{"lock stats t, t2 columns (a)", false, ""},
+		{"lock stats t comment 'imported from prod'", true, "LOCK STATS `t` COMMENT 'imported from prod'"},
+		{"lock stats t partition p0 for interval 1 day comment 'x'", true, "LOCK STATS `t` PARTITION(`p0`) FOR INTERVAL 1 DAY COMMENT 'x'"},
+		{"lock stats t columns (a) comment 'x'", true, "LOCK STATS `t` COLUMNS (`a`) COMMENT 'x'"},
+		{"lock stats t comment 'x' for interval 1 day", false, ""},
 		// for unlock stats
 		{"unlock stats test.t", true, "UNLOCK STATS `test`.`t`"},
 		{"unlock stats t, t2", true, "UNLOCK STATS `t`, `t2`"},
 		{"unlock stats t partition (p0, p1)", true, "UNLOCK STATS `t` PARTITION(`p0`, `p1`)"},
+		{"unlock stats t columns (a)", true, "UNLOCK STATS `t` COLUMNS (`a`)"},
+		{"unlock stats t, t2 comment 'x'", true, "UNLOCK STATS `t`, `t2` COMMENT 'x'"},
 		// set
 		// user defined
 		{"SET @ = 1", true, "SET @``=1"},
@@ -1689,6 +1729,8 @@ func TestBuiltin(t *testing.T) {
 
 		{`SELECT tidb_version();`, true, "SELECT TIDB_VERSION()"},
 		{`SELECT tidb_is_ddl_owner();`, true, "SELECT TIDB_IS_DDL_OWNER()"},
+		{`SELECT stats_healthy('t'), stats_healthy('test', 't');`, true, "SELECT STATS_HEALTHY(_UTF8MB4't'),STATS_HEALTHY(_UTF8MB4'test', _UTF8MB4't')"},
+		{`SELECT last_analyze_time('test', 't');`, true, "SELECT LAST_ANALYZE_TIME(_UTF8MB4'test', _UTF8MB4't')"},
 		{`SELECT tidb_decode_plan();`, true, "SELECT TIDB_DECODE_PLAN()"},
 		{`SELECT tidb_decode_key('abc');`, true, "SELECT TIDB_DECODE_KEY(_UTF8MB4'abc')"},
 		{`SELECT tidb_decode_base64_key('abc');`, true, "SELECT TIDB_DECODE_BASE64_KEY(_UTF8MB4'abc')"},
@@ -2815,6 +2857,13 @@ func TestDDL(t *testing.T) {
 		{"drop stats t global", true, "DROP STATS `t` GLOBAL"},
 		{"drop stats t partition p0", true, "DROP STATS `t` PARTITION `p0`"},
 		{"drop stats t partition p0, p1, p2", true, "DROP STATS `t` PARTITION `p0`,`p1`,`p2`"},
+		{"drop stats t columns (a)", true, "DROP STATS `t` COLUMNS (`a`)"},
+		{"drop stats t columns (a, b), indexes (idx_a)", true, "DROP STATS `t` COLUMNS (`a`, `b`), INDEXES (`idx_a`)"},
+		{"drop stats t indexes (idx_a), columns (a), indexes (idx_b)", true, "DROP STATS `t` COLUMNS (`a`), INDEXES (`idx_a`, `idx_b`)"},
+		{"drop stats test.t indexes (idx_a)", true, "DROP STATS `test`.`t` INDEXES (`idx_a`)"},
+		{"drop stats t1, t2 columns (a)", false, ""},
+		{"drop stats t columns ()", false, ""},
+		{"drop stats t partition p0 columns (a)", false, ""},
 		// for issue 974
 		{`CREATE TABLE address (
 		id bigint(20) NOT NULL AUTO_INCREMENT,
@@ -2928,6 +2977,9 @@ func TestDDL(t *testing.T) {
 		{"create table a (like b)", true, "CREATE TABLE `a` LIKE `b`"},
 		{"create table if not exists a like b", true, "CREATE TABLE IF NOT EXISTS `a` LIKE `b`"},
 		{"create table if not exists a (like b)", true, "CREATE TABLE IF NOT EXISTS `a` LIKE `b`"},
+		{"create table a like b with statistics", true, "CREATE TABLE `a` LIKE `b` WITH STATISTICS"},
+		{"create table if not exists a (like b) with statistics", true, "CREATE TABLE IF NOT EXISTS `a` LIKE `b` WITH STATISTICS"},
+		{"create table a with statistics", false, ""},
 		{"create table if not exists a like (b)", false, ""},
 		{"create table a (t int) like b", false, ""},
 		{"create table a (t int) like (b)", false, ""},
@@ -4675,6 +4727,46 @@ func TestOptimizerHints(t *testing.T) {
 	_, _, err = p.Parse("select /*+ MEMORY_QUOTA(18446744073709551612 MB), memory_quota(8689934592 GB) */ 1", "", "")
 	require.NoError(t, err)
 
+	// Test SET_TABLE_ROWS and SET_COLUMN_NDV
+	stmt, _, err = p.Parse("select /*+ SET_TABLE_ROWS(t1, 1e9), set_table_rows(@sel_1 test.t2 partition(p0), 100), SET_COLUMN_NDV(t1.c1, 50000), set_column_ndv(@sel_1 test.t2.c2, 1.5e3) */ c1, c2 from t1, t2 where t1.c1 = t2.c1", "", "")
+	require.NoError(t, err)
+	selectStmt = stmt[0].(*ast.SelectStmt)
+
+	hints = selectStmt.TableHints
+	require.Len(t, hints, 4)
+	require.Equal(t, "set_table_rows", hints[0].HintName.L)
+	require.Len(t, hints[0].Tables, 1)
+	require.Equal(t, "t1", hints[0].Tables[0].TableName.L)
+	require.Equal(t, float64(1e9), hints[0].HintData.(float64))
+	require.Equal(t, "set_table_rows", hints[1].HintName.L)
+	require.Equal(t, "sel_1", hints[1].QBName.L)
+	require.Len(t, hints[1].Tables, 1)
+	require.Equal(t, "test", hints[1].Tables[0].DBName.L)
+	require.Equal(t, "t2", hints[1].Tables[0].TableName.L)
+	require.Equal(t, "p0", hints[1].Tables[0].PartitionList[0].L)
+	require.Equal(t, float64(100), hints[1].HintData.(float64))
+	require.Equal(t, "set_column_ndv", hints[2].HintName.L)
+	require.Equal(t, "t1", hints[2].Tables[0].TableName.L)
+	require.Equal(t, "c1", hints[2].HintData.(ast.HintColumnStats).Column.L)
+	require.Equal(t, float64(50000), hints[2].HintData.(ast.HintColumnStats).Value)
+	require.Equal(t, "set_column_ndv", hints[3].HintName.L)
+	require.Equal(t, "sel_1", hints[3].QBName.L)
+	require.Equal(t, "test", hints[3].Tables[0].DBName.L)
+	require.Equal(t, "t2", hints[3].Tables[0].TableName.L)
+	require.Equal(t, "c2", hints[3].HintData.(ast.HintColumnStats).Column.L)
+	require.Equal(t, float64(1500), hints[3].HintData.(ast.HintColumnStats).Value)
+
+	// The fractional values are only accepted in the scientific notation.
+	stmt, warns, err := p.Parse("select /*+ set_table_rows(t1, 1.5) */ c1 from t1", "", "")
+	require.NoError(t, err)
+	require.NotEmpty(t, warns)
+	require.Empty(t, stmt[0].(*ast.SelectStmt).TableHints)
+	// The scientific notation is only accepted as the statistics values.
+	stmt, warns, err = p.Parse("select /*+ set_var(a=1e3) */ c1 from t1", "", "")
+	require.NoError(t, err)
+	require.NotEmpty(t, warns)
+	require.Empty(t, stmt[0].(*ast.SelectStmt).TableHints)
+
 	// Test HASH_AGG
 	stmt, _, err = p.Parse("select /*+ HASH_AGG(), hash_agg() */ c1, c2 from t1, t2 where t1.c1 = t2.c1", "", "")
 	require.NoError(t, err)
@@ -6067,8 +6159,17 @@ func TestAnalyze(t *testing.T) {
 		{"analyze table t partition a with 4 buckets", true, "ANALYZE TABLE `t` PARTITION `a` WITH 4 BUCKETS"},
 		{"analyze table t partition a index b", true, "ANALYZE TABLE `t` PARTITION `a` INDEX `b`"},
 		{"analyze table t partition a index b with 4 buckets", true, "ANALYZE TABLE `t` PARTITION `a` INDEX `b` WITH 4 BUCKETS"},
+		{"analyze table t quick", true, "ANALYZE TABLE `t` QUICK"},
+		{"analyze no_write_to_binlog table t quick", true, "ANALYZE NO_WRITE_TO_BINLOG TABLE `t` QUICK"},
+		{"analyze table t partition a, b quick", true, "ANALYZE TABLE `t` PARTITION `a`,`b` QUICK"},
+		{"analyze table t quick with 4 buckets", false, ""},
+		{"analyze table t, t1 quick", false, ""},
 		{"analyze incremental table t index", true, "ANALYZE INCREMENTAL TABLE `t` INDEX"},
 		{"analyze incremental table t index idx", true, "ANALYZE INCREMENTAL TABLE `t` INDEX `idx`"},
+		{"analyze incremental table t", true, "ANALYZE INCREMENTAL TABLE `t`"},
+		{"analyze no_write_to_binlog incremental table t with 4 buckets", true, "ANALYZE NO_WRITE_TO_BINLOG INCREMENTAL TABLE `t` WITH 4 BUCKETS"},
+		{"analyze incremental table t partition a, b", true, "ANALYZE INCREMENTAL TABLE `t` PARTITION `a`,`b`"},
+		{"analyze incremental table t, t1", false, ""},
 		{"analyze table t update histogram on b with 1024 buckets", true, "ANALYZE TABLE `t` UPDATE HISTOGRAM ON `b` WITH 1024 BUCKETS"},
 		{"analyze table t drop histogram on b", true, "ANALYZE TABLE `t` DROP HISTOGRAM ON `b`"},
 		{"analyze table t update histogram on c1, c2;", true, "ANALYZE TABLE `t` UPDATE HISTOGRAM ON `c1`,`c2`"},
@@ -7043,6 +7144,8 @@ func TestStatisticsOps(t *testing.T) {
 		{"create statistics stats1 (cardinality) on t(a,b,c)", true, "CREATE STATISTICS `stats1` (CARDINALITY) ON `t`(`a`, `b`, `c`)"},
 		{"create statistics stats2 (dependency) on t(a,b)", true, "CREATE STATISTICS `stats2` (DEPENDENCY) ON `t`(`a`, `b`)"},
 		{"create statistics stats3 (correlation) on t(a,b)", true, "CREATE STATISTICS `stats3` (CORRELATION) ON `t`(`a`, `b`)"},
+		{"create statistics stats4 (trigram) on t(a)", true, "CREATE STATISTICS `stats4` (TRIGRAM) ON `t`(`a`)"},
+		{"create statistics stats5 (join_sketch) on t(a)", true, "CREATE STATISTICS `stats5` (JOIN_SKETCH) ON `t`(`a`)"},
 		{"create statistics stats3 on t(a,b)", false, ""},
 		{"create statistics if not exists stats1 (cardinality) on t(a,b,c)", true, "CREATE STATISTICS IF NOT EXISTS `stats1` (CARDINALITY) ON `t`(`a`, `b`, `c`)"},
 		{"create statistics if not exists stats2 (dependency) on t(a,b)", true, "CREATE STATISTICS IF NOT EXISTS `stats2` (DEPENDENCY) ON `t`(`a`, `b`)"},
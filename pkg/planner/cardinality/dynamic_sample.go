@@ -0,0 +1,110 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality
+
+import (
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/planner/planctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+// dynamicSampleSelectivity estimates the selectivity of the expressions by evaluating them on the rows sampled from
+// the table without usable stats, see statistics.DynamicSample. The expressions which can't be evaluated on the
+// sampled rows, e.g. the ones referencing the correlated columns, are estimated by the pseudo selectivity.
+func dynamicSampleSelectivity(sctx planctx.PlanContext, coll *statistics.HistColl, exprs []expression.Expression) (float64, bool) {
+	sample := coll.DynamicSample
+	if sample.RowCount() == 0 {
+		return 0, false
+	}
+	exprCtx := sctx.GetExprCtx()
+	if expression.MaybeOverOptimized4PlanCache(exprCtx, exprs) {
+		return 0, false
+	}
+	var (
+		cols      []*expression.Column
+		offsets   []int
+		evalExprs []expression.Expression
+		remained  []expression.Expression
+	)
+	colSeen := make(map[int64]struct{})
+	for _, expr := range exprs {
+		exprCols := expression.ExtractColumns(expr)
+		ok := len(expression.ExtractCorColumns(expr)) == 0 && !expression.IsMutableEffectsExpr(expr)
+		for _, col := range exprCols {
+			if !ok {
+				break
+			}
+			ok = col.ID != 0 && sample.ColumnOffset(col.ID) >= 0
+		}
+		if !ok {
+			remained = append(remained, expr)
+			continue
+		}
+		evalExprs = append(evalExprs, expr)
+		for _, col := range exprCols {
+			if _, seen := colSeen[col.UniqueID]; seen {
+				continue
+			}
+			colSeen[col.UniqueID] = struct{}{}
+			cols = append(cols, col)
+			offsets = append(offsets, sample.ColumnOffset(col.ID))
+		}
+	}
+	if len(evalExprs) == 0 {
+		return 0, false
+	}
+	schema := expression.NewSchema(cols...)
+	fieldTypes := make([]*types.FieldType, 0, len(cols))
+	for _, col := range cols {
+		fieldTypes = append(fieldTypes, col.RetType)
+	}
+	c := chunk.NewChunkWithCapacity(fieldTypes, sample.RowCount())
+	for _, row := range sample.Rows {
+		for i, offset := range offsets {
+			c.AppendDatum(i, &row[offset])
+		}
+	}
+	resolvedExprs := make([]expression.Expression, 0, len(evalExprs))
+	for _, expr := range evalExprs {
+		resolved, err := expr.ResolveIndices(schema)
+		if err != nil {
+			return 0, false
+		}
+		resolvedExprs = append(resolvedExprs, resolved)
+	}
+	// The warnings of evaluating the expressions on the sampled rows are not the warnings of the statement.
+	evalCtx := exprCtx.GetEvalCtx()
+	warnCount := evalCtx.WarningCount()
+	selected, err := expression.VectorizedFilter(evalCtx, sctx.GetSessionVars().EnableVectorizedExpression,
+		resolvedExprs, chunk.NewIterator4Chunk(c), nil)
+	evalCtx.TruncateWarnings(warnCount)
+	if err != nil {
+		return 0, false
+	}
+	matched := 0
+	for _, s := range selected {
+		if s {
+			matched++
+		}
+	}
+	// The matched rows may just be missed by the sample, so we don't return a zero selectivity.
+	selectivity := max(float64(matched), 0.5) / float64(sample.RowCount())
+	if len(remained) > 0 {
+		selectivity *= pseudoSelectivity(sctx, coll, remained)
+	}
+	return selectivity, true
+}
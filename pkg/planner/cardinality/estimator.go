@@ -0,0 +1,195 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/planner/planctx"
+	"github.com/pingcap/tidb/pkg/planner/property"
+	planutil "github.com/pingcap/tidb/pkg/planner/util"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/util/ranger"
+)
+
+// CardinalityEstimator estimates the cardinalities for the optimizer from the statistics. The histogram-based
+// estimator is the default one, and the alternative estimators, e.g. the learned models or the sketch-first ones,
+// can be registered by RegisterCardinalityEstimator and chosen by tidb_opt_cardinality_estimator, so that they can be
+// A/B tested without forking the planner. An alternative estimator can fall back to DefaultCardinalityEstimator for
+// the estimations it doesn't handle.
+type CardinalityEstimator interface {
+	// Selectivity calculates the selectivity of the CNF expressions on the HistColl, that is, the row count after
+	// the filters divided by the row count before the filters.
+	Selectivity(sctx planctx.PlanContext, coll *statistics.HistColl, exprs []expression.Expression,
+		filledPaths []*planutil.AccessPath) (result float64, retStatsNodes []*StatsNode, err error)
+	// GetRowCountByColumnRanges estimates the row count of the ranges on the column.
+	GetRowCountByColumnRanges(sctx planctx.PlanContext, coll *statistics.HistColl, colUniqueID int64,
+		colRanges []*ranger.Range) (float64, error)
+	// GetRowCountByIntColumnRanges estimates the row count of the ranges on the integer handle column.
+	GetRowCountByIntColumnRanges(sctx planctx.PlanContext, coll *statistics.HistColl, colUniqueID int64,
+		intRanges []*ranger.Range) (float64, error)
+	// GetRowCountByIndexRanges estimates the row count of the ranges on the index, together with the row count
+	// adjusted by the correlation.
+	GetRowCountByIndexRanges(sctx planctx.PlanContext, coll *statistics.HistColl, idxID int64,
+		indexRanges []*ranger.Range) (result float64, corrResult float64, err error)
+	// EstimateFullJoinRowCount estimates the row count of a full join.
+	EstimateFullJoinRowCount(sctx planctx.PlanContext, isCartesian bool, leftProfile, rightProfile *property.StatsInfo,
+		leftJoinKeys, rightJoinKeys []*expression.Column, leftSchema, rightSchema *expression.Schema,
+		leftNAJoinKeys, rightNAJoinKeys []*expression.Column) float64
+}
+
+// histogramEstimator is the default CardinalityEstimator, which estimates by the histograms, the TopNs and the other
+// statistics of the columns and the indexes.
+type histogramEstimator struct{}
+
+// Selectivity implements the CardinalityEstimator interface.
+func (histogramEstimator) Selectivity(sctx planctx.PlanContext, coll *statistics.HistColl, exprs []expression.Expression,
+	filledPaths []*planutil.AccessPath) (float64, []*StatsNode, error) {
+	return getSelectivity(sctx, coll, exprs, filledPaths)
+}
+
+// GetRowCountByColumnRanges implements the CardinalityEstimator interface.
+func (histogramEstimator) GetRowCountByColumnRanges(sctx planctx.PlanContext, coll *statistics.HistColl, colUniqueID int64,
+	colRanges []*ranger.Range) (float64, error) {
+	return getRowCountByColumnRanges(sctx, coll, colUniqueID, colRanges)
+}
+
+// GetRowCountByIntColumnRanges implements the CardinalityEstimator interface.
+func (histogramEstimator) GetRowCountByIntColumnRanges(sctx planctx.PlanContext, coll *statistics.HistColl, colUniqueID int64,
+	intRanges []*ranger.Range) (float64, error) {
+	return getRowCountByIntColumnRanges(sctx, coll, colUniqueID, intRanges)
+}
+
+// GetRowCountByIndexRanges implements the CardinalityEstimator interface.
+func (histogramEstimator) GetRowCountByIndexRanges(sctx planctx.PlanContext, coll *statistics.HistColl, idxID int64,
+	indexRanges []*ranger.Range) (float64, float64, error) {
+	return getRowCountByIndexRanges(sctx, coll, idxID, indexRanges)
+}
+
+// EstimateFullJoinRowCount implements the CardinalityEstimator interface.
+func (histogramEstimator) EstimateFullJoinRowCount(sctx planctx.PlanContext, isCartesian bool,
+	leftProfile, rightProfile *property.StatsInfo, leftJoinKeys, rightJoinKeys []*expression.Column,
+	leftSchema, rightSchema *expression.Schema, leftNAJoinKeys, rightNAJoinKeys []*expression.Column) float64 {
+	return estimateFullJoinRowCount(sctx, isCartesian, leftProfile, rightProfile, leftJoinKeys, rightJoinKeys,
+		leftSchema, rightSchema, leftNAJoinKeys, rightNAJoinKeys)
+}
+
+// DefaultCardinalityEstimator is the histogram-based CardinalityEstimator.
+var DefaultCardinalityEstimator CardinalityEstimator = histogramEstimator{}
+
+var cardinalityEstimators = struct {
+	sync.RWMutex
+	m map[string]CardinalityEstimator
+}{m: map[string]CardinalityEstimator{vardef.DefTiDBOptCardinalityEstimator: DefaultCardinalityEstimator}}
+
+func init() {
+	variable.CardinalityEstimatorRegistered = func(name string) bool {
+		cardinalityEstimators.RLock()
+		defer cardinalityEstimators.RUnlock()
+		_, ok := cardinalityEstimators.m[name]
+		return ok
+	}
+}
+
+// RegisterCardinalityEstimator registers a CardinalityEstimator by the name, which is case-insensitive. It's usually
+// called in the init function of the package implementing the estimator.
+func RegisterCardinalityEstimator(name string, estimator CardinalityEstimator) error {
+	name = strings.ToLower(name)
+	cardinalityEstimators.Lock()
+	defer cardinalityEstimators.Unlock()
+	if _, ok := cardinalityEstimators.m[name]; ok {
+		return errors.Errorf("cardinality estimator %s is already registered", name)
+	}
+	cardinalityEstimators.m[name] = estimator
+	return nil
+}
+
+// UnregisterCardinalityEstimator unregisters the CardinalityEstimator by the name. The default one can't be
+// unregistered.
+func UnregisterCardinalityEstimator(name string) {
+	name = strings.ToLower(name)
+	if name == vardef.DefTiDBOptCardinalityEstimator {
+		return
+	}
+	cardinalityEstimators.Lock()
+	defer cardinalityEstimators.Unlock()
+	delete(cardinalityEstimators.m, name)
+}
+
+// GetCardinalityEstimator returns the CardinalityEstimator chosen by tidb_opt_cardinality_estimator, whose name is
+// case-insensitive. The default one is returned if the chosen one is not registered, e.g. it's unregistered after
+// being chosen.
+func GetCardinalityEstimator(sctx planctx.PlanContext) CardinalityEstimator {
+	name := strings.ToLower(sctx.GetSessionVars().CardinalityEstimator)
+	if name == "" || name == vardef.DefTiDBOptCardinalityEstimator {
+		return DefaultCardinalityEstimator
+	}
+	cardinalityEstimators.RLock()
+	defer cardinalityEstimators.RUnlock()
+	if estimator, ok := cardinalityEstimators.m[name]; ok {
+		return estimator
+	}
+	return DefaultCardinalityEstimator
+}
+
+// Selectivity is a function calculate the selectivity of the expressions on the specified HistColl by the
+// CardinalityEstimator chosen by tidb_opt_cardinality_estimator.
+// The definition of selectivity is (row count after filter / row count before filter).
+// And exprs must be CNF now, in other words, `exprs[0] and exprs[1] and ... and exprs[len - 1]`
+// should be held when you call this.
+func Selectivity(
+	ctx planctx.PlanContext,
+	coll *statistics.HistColl,
+	exprs []expression.Expression,
+	filledPaths []*planutil.AccessPath,
+) (
+	result float64,
+	retStatsNodes []*StatsNode,
+	err error,
+) {
+	return GetCardinalityEstimator(ctx).Selectivity(ctx, coll, exprs, filledPaths)
+}
+
+// GetRowCountByColumnRanges estimates the row count by a slice of Range by the chosen CardinalityEstimator.
+func GetRowCountByColumnRanges(sctx planctx.PlanContext, coll *statistics.HistColl, colUniqueID int64, colRanges []*ranger.Range) (result float64, err error) {
+	return GetCardinalityEstimator(sctx).GetRowCountByColumnRanges(sctx, coll, colUniqueID, colRanges)
+}
+
+// GetRowCountByIntColumnRanges estimates the row count by a slice of IntColumnRange by the chosen
+// CardinalityEstimator.
+func GetRowCountByIntColumnRanges(sctx planctx.PlanContext, coll *statistics.HistColl, colUniqueID int64, intRanges []*ranger.Range) (result float64, err error) {
+	return GetCardinalityEstimator(sctx).GetRowCountByIntColumnRanges(sctx, coll, colUniqueID, intRanges)
+}
+
+// GetRowCountByIndexRanges estimates the row count by a slice of Range by the chosen CardinalityEstimator.
+func GetRowCountByIndexRanges(sctx planctx.PlanContext, coll *statistics.HistColl, idxID int64, indexRanges []*ranger.Range) (result float64, corrResult float64, err error) {
+	return GetCardinalityEstimator(sctx).GetRowCountByIndexRanges(sctx, coll, idxID, indexRanges)
+}
+
+// EstimateFullJoinRowCount estimates the row count of a full join by the chosen CardinalityEstimator.
+func EstimateFullJoinRowCount(sctx planctx.PlanContext,
+	isCartesian bool,
+	leftProfile, rightProfile *property.StatsInfo,
+	leftJoinKeys, rightJoinKeys []*expression.Column,
+	leftSchema, rightSchema *expression.Schema,
+	leftNAJoinKeys, rightNAJoinKeys []*expression.Column) float64 {
+	return GetCardinalityEstimator(sctx).EstimateFullJoinRowCount(sctx, isCartesian, leftProfile, rightProfile,
+		leftJoinKeys, rightJoinKeys, leftSchema, rightSchema, leftNAJoinKeys, rightNAJoinKeys)
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/planner/cardinality"
+	"github.com/pingcap/tidb/pkg/planner/planctx"
+	planutil "github.com/pingcap/tidb/pkg/planner/util"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+// halfSelectivityEstimator estimates the selectivity of any filters as 0.5, and falls back to the default estimator
+// for the others.
+type halfSelectivityEstimator struct {
+	cardinality.CardinalityEstimator
+}
+
+func (halfSelectivityEstimator) Selectivity(_ planctx.PlanContext, _ *statistics.HistColl, _ []expression.Expression,
+	_ []*planutil.AccessPath) (float64, []*cardinality.StatsNode, error) {
+	return 0.5, nil, nil
+}
+
+func TestCardinalityEstimator(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int)")
+	values := make([]string, 0, 100)
+	for i := range 100 {
+		values = append(values, fmt.Sprintf("(%d, %d)", i, i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("analyze table t all columns")
+	getEstRows := func(sql string) string {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Selection") {
+				return row[1].(string)
+			}
+		}
+		require.FailNow(t, "no selection in the plan", sql)
+		return ""
+	}
+	sql := "select * from t where b < 10"
+	tk.MustQuery("select @@tidb_opt_cardinality_estimator").Check(testkit.Rows("histogram"))
+	require.Equal(t, "10.00", getEstRows(sql))
+
+	// Only the registered estimators can be chosen.
+	tk.MustGetErrMsg("set @@tidb_opt_cardinality_estimator = 'half'",
+		"[variable:1231]Variable 'tidb_opt_cardinality_estimator' can't be set to the value of 'half'")
+	require.NoError(t, cardinality.RegisterCardinalityEstimator("Half", halfSelectivityEstimator{cardinality.DefaultCardinalityEstimator}))
+	defer cardinality.UnregisterCardinalityEstimator("half")
+	require.Error(t, cardinality.RegisterCardinalityEstimator("half", cardinality.DefaultCardinalityEstimator))
+	tk.MustExec("set @@tidb_opt_cardinality_estimator = 'HALF'")
+	tk.MustQuery("select @@tidb_opt_cardinality_estimator").Check(testkit.Rows("half"))
+	require.Equal(t, "50.00", getEstRows(sql))
+	// The name is case-insensitive even if it isn't normalized by the sysvar.
+	tk.Session().GetSessionVars().CardinalityEstimator = "Half"
+	require.IsType(t, halfSelectivityEstimator{}, cardinality.GetCardinalityEstimator(tk.Session().GetPlanCtx()))
+	require.Equal(t, "50.00", getEstRows(sql))
+
+	// The default estimator is used once the chosen one is unregistered.
+	cardinality.UnregisterCardinalityEstimator("half")
+	require.Equal(t, "10.00", getEstRows(sql))
+	tk.MustExec("set @@tidb_opt_cardinality_estimator = default")
+}
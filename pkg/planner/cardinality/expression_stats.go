@@ -0,0 +1,135 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality
+
+import (
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/planner/planctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+// exprStatsSelectivity estimates the selectivity of the predicate on an expression which has the expression stats,
+// e.g. `DATE(created_at) = '2025-01-01'`, by evaluating the predicate on the sampled values of the expression.
+// Such predicates can't be converted to the ranges of the columns, so they are not covered by the column stats.
+func exprStatsSelectivity(sctx planctx.PlanContext, coll *statistics.HistColl, cond expression.Expression) (float64, bool) {
+	exprCtx := sctx.GetExprCtx()
+	if expression.MaybeOverOptimized4PlanCache(exprCtx, []expression.Expression{cond}) {
+		return 0, false
+	}
+	placeholderID := sctx.GetSessionVars().AllocPlanColumnID()
+	for _, s := range coll.ExpressionStats {
+		placeholder := &expression.Column{UniqueID: placeholderID, Index: 0, RetType: s.Expr.GetType(exprCtx.GetEvalCtx())}
+		substituted, ok := substituteExprWithColumn(exprCtx, cond, s.Expr, placeholder)
+		if !ok {
+			doc, path, isJSONPath := jsonPathOfStats(sctx, s.Expr)
+			if !isJSONPath {
+				continue
+			}
+			substituted, ok = substituteJSONPath(sctx, cond, doc, path, placeholder)
+			if !ok {
+				continue
+			}
+		}
+		// The predicate can only be evaluated on the sampled values if it doesn't reference other columns.
+		onlyPlaceholder := true
+		for _, col := range expression.ExtractColumns(substituted) {
+			if col.UniqueID != placeholderID {
+				onlyPlaceholder = false
+				break
+			}
+		}
+		if !onlyPlaceholder {
+			continue
+		}
+		selectivity, err := evalSelectivityOnExprStats(sctx, s.Stats, placeholder.RetType, substituted)
+		if err != nil {
+			continue
+		}
+		recordUsedJSONPathStats(sctx, coll, s)
+		return selectivity, true
+	}
+	return 0, false
+}
+
+// substituteExprWithColumn replaces the target expression in expr with the column.
+// The returned bool indicates whether any substitution happens.
+func substituteExprWithColumn(ctx expression.BuildContext, expr, target expression.Expression, col *expression.Column) (expression.Expression, bool) {
+	if expr.Equal(ctx.GetEvalCtx(), target) {
+		return col, true
+	}
+	sf, ok := expr.(*expression.ScalarFunction)
+	if !ok || sf.FuncName.L == ast.Grouping {
+		return expr, false
+	}
+	args := sf.GetArgs()
+	newArgs := make([]expression.Expression, len(args))
+	substituted := false
+	for i, arg := range args {
+		var argSubstituted bool
+		newArgs[i], argSubstituted = substituteExprWithColumn(ctx, arg, target, col)
+		substituted = substituted || argSubstituted
+	}
+	if !substituted {
+		return expr, false
+	}
+	if sf.FuncName.L == ast.Cast {
+		return expression.BuildCastFunction(ctx, newArgs[0], sf.RetType), true
+	}
+	newExpr, err := expression.NewFunction(ctx, sf.FuncName.L, sf.RetType, newArgs...)
+	if err != nil {
+		return expr, false
+	}
+	return newExpr, true
+}
+
+// evalSelectivityOnExprStats evaluates the predicate, whose only column is the expression, on the sampled values.
+func evalSelectivityOnExprStats(sctx planctx.PlanContext, stats *statistics.ExpressionStats, tp *types.FieldType, cond expression.Expression) (float64, error) {
+	typeCtx := sctx.GetSessionVars().StmtCtx.TypeCtx()
+	c := chunk.NewChunkWithCapacity([]*types.FieldType{tp}, len(stats.Values)+1)
+	for _, val := range stats.Values {
+		d := types.NewStringDatum(val)
+		d, err := d.ConvertTo(typeCtx, tp)
+		if err != nil {
+			return 0, err
+		}
+		c.AppendDatum(0, &d)
+	}
+	// The last row stands for the rows on which the expression is evaluated to null.
+	c.AppendNull(0)
+	selected, err := expression.VectorizedFilter(sctx.GetExprCtx().GetEvalCtx(), sctx.GetSessionVars().EnableVectorizedExpression,
+		[]expression.Expression{cond}, chunk.NewIterator4Chunk(c), nil)
+	if err != nil {
+		return 0, err
+	}
+	nullRatio := stats.NullRatio()
+	var selectivity float64
+	if len(stats.Values) > 0 {
+		matched := 0
+		for _, s := range selected[:len(stats.Values)] {
+			if s {
+				matched++
+			}
+		}
+		selectivity = (1 - nullRatio) * float64(matched) / float64(len(stats.Values))
+	}
+	if selected[len(stats.Values)] {
+		selectivity += nullRatio
+	}
+	// The matched values may just be missed by the samples, so we don't return a zero selectivity.
+	return max(selectivity, 0.5/float64(len(stats.Values)+1)), nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality
+
+import (
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/planner/planctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tidb/pkg/util/mathutil"
+)
+
+const (
+	// inListProbeBatchSize is the number of the constants probed against the stats in a batch. The probing stops
+	// after a batch once the estimated rows cover all the non-null rows of the column.
+	inListProbeBatchSize = 64
+	// inListMaxProbes is the max number of the constants probed against the stats for an IN list. The selectivity
+	// of the constants beyond it is extrapolated by the average selectivity of the probed ones.
+	inListMaxProbes = 1024
+)
+
+// inListSelectivity estimates the selectivity of `col IN (constants...)` by probing the constants against the TopN
+// and the histogram of the column one by one. The IN lists are usually estimated by the point ranges, but the long
+// lists exceeding tidb_opt_range_max_size can't be converted to ranges and fall back to the default selectivity.
+// It only works when tidb_opt_in_list_probe_estimation is on.
+func inListSelectivity(sctx planctx.PlanContext, coll *statistics.HistColl, expr *expression.ScalarFunction) (float64, bool) {
+	if !sctx.GetSessionVars().InListProbeEstimation || expr.FuncName.L != ast.In || coll.RealtimeCount <= 0 {
+		return 0, false
+	}
+	args := expr.GetArgs()
+	col, ok := args[0].(*expression.Column)
+	if !ok {
+		return 0, false
+	}
+	c := coll.GetCol(col.UniqueID)
+	if statistics.ColumnStatsIsInvalid(c, sctx, coll, col.UniqueID) || c.TotalRowCount() <= 0 {
+		return 0, false
+	}
+	sc := sctx.GetSessionVars().StmtCtx
+	evalCtx := sctx.GetExprCtx().GetEvalCtx()
+	collator := collate.GetCollator(col.RetType.GetCollate())
+	vals := make([]types.Datum, 0, len(args)-1)
+	encodedVals := make([][]byte, 0, len(args)-1)
+	seen := make(map[string]struct{}, len(args)-1)
+	for _, arg := range args[1:] {
+		con, ok := arg.(*expression.Constant)
+		if !ok {
+			return 0, false
+		}
+		d, err := con.Eval(evalCtx, chunk.Row{})
+		if err != nil {
+			return 0, false
+		}
+		if d.IsNull() {
+			continue
+		}
+		val, err := d.ConvertTo(sc.TypeCtx(), col.RetType)
+		if err != nil {
+			return 0, false
+		}
+		// The constant can't match any value of the column if it's changed by the conversion.
+		if cmp, err := val.Compare(sc.TypeCtx(), &d, collator); err != nil || cmp != 0 {
+			continue
+		}
+		if val.Kind() == types.KindString {
+			val.SetBytes(collator.Key(val.GetString()))
+		}
+		encoded, err := codec.EncodeKey(sc.TimeZone(), nil, val)
+		if err != nil {
+			return 0, false
+		}
+		if _, ok := seen[string(encoded)]; ok {
+			continue
+		}
+		seen[string(encoded)] = struct{}{}
+		vals = append(vals, val)
+		encodedVals = append(encodedVals, encoded)
+	}
+	if len(vals) == 0 {
+		return 0, true
+	}
+
+	notNullSel := c.NotNullCount() / c.TotalRowCount()
+	var selectivity float64
+	probed := 0
+	for probed < len(vals) && probed < inListMaxProbes && selectivity < notNullSel {
+		end := min(probed+inListProbeBatchSize, len(vals), inListMaxProbes)
+		for i := probed; i < end; i++ {
+			sel, err := equalSelectivityOnColumn(sctx, coll, c, vals[i], encodedVals[i])
+			if err != nil {
+				return 0, false
+			}
+			selectivity += sel
+		}
+		probed = end
+	}
+	if probed < len(vals) && selectivity < notNullSel {
+		selectivity += selectivity / float64(probed) * float64(len(vals)-probed)
+	}
+	return mathutil.Clamp(selectivity, 0, notNullSel), true
+}
+
+// equalSelectivityOnColumn returns the selectivity of `col = val`, which is looked up from the InListSelCache of the
+// HistColl first if it's set, i.e. the statement uses the plan cache.
+func equalSelectivityOnColumn(sctx planctx.PlanContext, coll *statistics.HistColl, c *statistics.Column, val types.Datum, encodedVal []byte) (float64, error) {
+	if coll.InListSelCache != nil {
+		if sel, ok := coll.InListSelCache.Get(coll, c, encodedVal); ok {
+			return sel, nil
+		}
+	}
+	cnt, err := equalRowCountOnColumn(sctx, c, val, encodedVal, coll.RealtimeCount, coll.ModifyCount)
+	if err != nil {
+		return 0, err
+	}
+	sel := cnt * c.GetIncreaseFactor(coll.RealtimeCount) / float64(coll.RealtimeCount)
+	if coll.InListSelCache != nil {
+		coll.InListSelCache.Put(coll, c, encodedVal, sel)
+	}
+	return sel, nil
+}
@@ -15,15 +15,20 @@
 package cardinality
 
 import (
+	"cmp"
 	"math"
+	"slices"
 
 	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/planner/planctx"
 	"github.com/pingcap/tidb/pkg/planner/property"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/types"
 )
 
-// EstimateFullJoinRowCount estimates the row count of a full join.
-func EstimateFullJoinRowCount(sctx planctx.PlanContext,
+// estimateFullJoinRowCount estimates the row count of a full join.
+func estimateFullJoinRowCount(sctx planctx.PlanContext,
 	isCartesian bool,
 	leftProfile, rightProfile *property.StatsInfo,
 	leftJoinKeys, rightJoinKeys []*expression.Column,
@@ -32,6 +37,14 @@ func EstimateFullJoinRowCount(sctx planctx.PlanContext,
 	if isCartesian {
 		return leftProfile.RowCount * rightProfile.RowCount
 	}
+	// The join sketches are built from the join keys on both sides, so they're preferred to the fanouts of the
+	// foreign keys, which are only built from the child side.
+	if count, ok := estimateJoinRowCountBySketches(leftProfile, rightProfile, leftJoinKeys, rightJoinKeys); ok {
+		return count
+	}
+	if count, ok := estimateJoinRowCountByFKFanouts(leftProfile, rightProfile, leftJoinKeys, rightJoinKeys); ok {
+		return count
+	}
 	var leftKeyNDV, rightKeyNDV float64
 	var leftColCnt, rightColCnt int
 	if len(leftJoinKeys) > 0 || len(rightJoinKeys) > 0 {
@@ -49,3 +62,173 @@ func EstimateFullJoinRowCount(sctx planctx.PlanContext,
 	// This estimation logic is referred to Presto.
 	return count * math.Pow(0.9, float64(len(leftJoinKeys)-max(leftColCnt, rightColCnt)))
 }
+
+// estimateJoinRowCountByFKFanouts estimates the row count of the equal join between the child and the parent tables
+// on a single-column foreign key by the fanout of the foreign key. Every non-null child row references one parent
+// key, and the coverage fraction of the parent rows remaining after the filters are referenced by any child row. The
+// NDV based estimation assumes the child keys are contained by the remaining parent keys instead, which overestimates
+// the join when only a few parent keys are referenced. The fanout of the referenced parent keys follows their
+// distribution, see FKFanout.TypicalFanout, so a few parent keys don't get the average inflated by the heavy ones.
+func estimateJoinRowCountByFKFanouts(leftProfile, rightProfile *property.StatsInfo, leftJoinKeys, rightJoinKeys []*expression.Column) (float64, bool) {
+	if len(leftJoinKeys) != 1 || len(rightJoinKeys) != 1 || leftProfile.HistColl == nil || rightProfile.HistColl == nil {
+		return 0, false
+	}
+	if count, ok := estimateFKJoinRowCount(leftProfile, rightProfile, leftJoinKeys[0], rightJoinKeys[0]); ok {
+		return count, true
+	}
+	return estimateFKJoinRowCount(rightProfile, leftProfile, rightJoinKeys[0], leftJoinKeys[0])
+}
+
+// estimateFKJoinRowCount estimates the row count of the equal join if childKey is a foreign key referencing parentKey.
+// The fanout is collected when the child table is analyzed, so it's scaled to the current rows of the child table,
+// and then by the fractions of the rows remaining on both sides, which assumes the filters are independent of the
+// fanouts.
+func estimateFKJoinRowCount(child, parent *property.StatsInfo, childKey, parentKey *expression.Column) (float64, bool) {
+	fanout := child.HistColl.FKFanouts[childKey.UniqueID]
+	if fanout == nil || fanout.ParentID != parent.HistColl.PhysicalID || fanout.ParentColID != parentKey.ID {
+		return 0, false
+	}
+	childTableRows, parentTableRows := float64(child.HistColl.RealtimeCount), float64(parent.HistColl.RealtimeCount)
+	if parent.HistColl.Pseudo || childTableRows <= 0 || parentTableRows <= 0 || fanout.RowCount <= 0 {
+		return 0, false
+	}
+	childSel := min(child.RowCount/childTableRows, 1)
+	parentKeys := parent.RowCount * fanout.Coverage(parentTableRows)
+	count := parentKeys * fanout.TypicalFanout(parentKeys) * (childTableRows / fanout.RowCount) * childSel
+	// Each child row joins with at most one parent row.
+	return min(count, child.RowCount), true
+}
+
+// estimateJoinRowCountBySketches estimates the row count of the equal join on a single key by the join sketches of the
+// two key columns. The join size of the tables when the sketches are built is scaled by the fractions of the rows
+// remained on both sides, which assumes the filters are independent of the join keys.
+func estimateJoinRowCountBySketches(leftProfile, rightProfile *property.StatsInfo, leftJoinKeys, rightJoinKeys []*expression.Column) (float64, bool) {
+	if len(leftJoinKeys) != 1 || len(rightJoinKeys) != 1 || leftProfile.HistColl == nil || rightProfile.HistColl == nil {
+		return 0, false
+	}
+	if !joinSketchKeyTypesMatch(leftJoinKeys[0].RetType, rightJoinKeys[0].RetType) {
+		return 0, false
+	}
+	leftSketch := leftProfile.HistColl.JoinSketches[leftJoinKeys[0].UniqueID]
+	rightSketch := rightProfile.HistColl.JoinSketches[rightJoinKeys[0].UniqueID]
+	size, ok := statistics.EstimateJoinSize(leftSketch, rightSketch)
+	if !ok || leftSketch.RowCount <= 0 || rightSketch.RowCount <= 0 {
+		return 0, false
+	}
+	count := size * (leftProfile.RowCount / leftSketch.RowCount) * (rightProfile.RowCount / rightSketch.RowCount)
+	// The matched values may just be missed by the samples, so we don't return a zero row count.
+	return min(max(count, 1), leftProfile.RowCount*rightProfile.RowCount), true
+}
+
+// joinSketchKeyTypesMatch checks whether the same values of the two join keys are hashed to the same values in the
+// join sketches. The sketches hash the encoded values, so the keys must be of the same eval type, the decimals must
+// have the same fraction digits and the strings must have the same collation, otherwise the equal values might be
+// encoded differently.
+func joinSketchKeyTypesMatch(lTp, rTp *types.FieldType) bool {
+	evalType := lTp.EvalType()
+	if evalType != rTp.EvalType() {
+		return false
+	}
+	switch evalType {
+	case types.ETDecimal:
+		return lTp.GetDecimal() == rTp.GetDecimal()
+	case types.ETString:
+		return lTp.GetCollate() == rTp.GetCollate()
+	case types.ETJson:
+		return false
+	}
+	return true
+}
+
+// extendedStatsCorrelation returns the absolute value of the correlation between the two columns recorded by the
+// extended stats. The ok is false if there is no such extended stats.
+func extendedStatsCorrelation(extStats *statistics.ExtendedStatsColl, colID1, colID2 int64) (corr float64, ok bool) {
+	for _, item := range extStats.Stats {
+		if item.Tp != ast.StatsTypeCorrelation || len(item.ColIDs) != 2 {
+			continue
+		}
+		if (item.ColIDs[0] == colID1 && item.ColIDs[1] == colID2) || (item.ColIDs[0] == colID2 && item.ColIDs[1] == colID1) {
+			return math.Min(math.Abs(item.ScalarVals), 1), true
+		}
+	}
+	return 0, false
+}
+
+// EstimateColGroupNDVByExtendedStats estimates the NDV of a group of columns of one table by the extended stats,
+// which is used to estimate the join on multiple columns.
+// If the cardinality of the exact column group is recorded, it's used directly. Otherwise, the columns are added to
+// the group in the descending order of their NDVs, and each column multiplies the group NDV by ndv^(1-|corr|), where
+// corr is its max correlation with the columns already in the group. That is, a fully correlated column(functional
+// dependency) doesn't add any new distinct values, while an uncorrelated one follows the independence assumption.
+// The ok is false if any column has no correlation stats with the columns before it.
+func EstimateColGroupNDVByExtendedStats(extStats *statistics.ExtendedStatsColl, cols []*expression.Column, colNDVs map[int64]float64, rowCount float64) (ndv float64, ok bool) {
+	if extStats == nil || len(extStats.Stats) == 0 || len(cols) < 2 {
+		return 0, false
+	}
+	for _, item := range extStats.Stats {
+		if item.Tp != ast.StatsTypeCardinality || len(item.ColIDs) != len(cols) || item.ScalarVals <= 0 {
+			continue
+		}
+		match := true
+		for _, col := range cols {
+			if !slices.Contains(item.ColIDs, col.ID) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return math.Min(item.ScalarVals, math.Max(rowCount, 1)), true
+		}
+	}
+	sorted := slices.Clone(cols)
+	slices.SortStableFunc(sorted, func(a, b *expression.Column) int {
+		return cmp.Compare(colNDVs[b.UniqueID], colNDVs[a.UniqueID])
+	})
+	ndv = math.Max(colNDVs[sorted[0].UniqueID], 1)
+	for i := 1; i < len(sorted); i++ {
+		maxCorr, found := 0.0, false
+		for j := range i {
+			if corr, ok := extendedStatsCorrelation(extStats, sorted[i].ID, sorted[j].ID); ok {
+				maxCorr, found = math.Max(maxCorr, corr), true
+			}
+		}
+		if !found {
+			return 0, false
+		}
+		ndv *= math.Pow(math.Max(colNDVs[sorted[i].UniqueID], 1), 1-maxCorr)
+	}
+	return math.Min(ndv, math.Max(rowCount, 1)), true
+}
+
+// AdjustColNDVsByExtendedStats adjusts the NDVs of the columns after the filters are applied.
+// By default, the NDVs are scaled by the selectivity as the row count, which assumes the columns are fully dependent
+// on the filtered columns. If the extended stats tell how a column correlates with the filtered columns, its NDV is
+// interpolated between the fully dependent one and the independent one, which follows the Yao's formula
+// ndv * (1 - (1 - sel)^(rowCount / ndv)).
+func AdjustColNDVsByExtendedStats(extStats *statistics.ExtendedStatsColl, cols, filterCols []*expression.Column, origStats, stats *property.StatsInfo) {
+	if extStats == nil || len(extStats.Stats) == 0 || len(filterCols) == 0 || origStats.RowCount <= 0 {
+		return
+	}
+	sel := math.Min(stats.RowCount/origStats.RowCount, 1)
+	for _, col := range cols {
+		if slices.ContainsFunc(filterCols, func(c *expression.Column) bool { return c.ID == col.ID }) {
+			continue
+		}
+		origNDV, ok := origStats.ColNDVs[col.UniqueID]
+		if !ok || origNDV <= 0 {
+			continue
+		}
+		maxCorr, found := 0.0, false
+		for _, filterCol := range filterCols {
+			if corr, ok := extendedStatsCorrelation(extStats, col.ID, filterCol.ID); ok {
+				maxCorr, found = math.Max(maxCorr, corr), true
+			}
+		}
+		if !found {
+			continue
+		}
+		dependentNDV := origNDV * sel
+		independentNDV := origNDV * (1 - math.Pow(1-sel, origStats.RowCount/origNDV))
+		stats.ColNDVs[col.UniqueID] = math.Min(maxCorr*dependentNDV+(1-maxCorr)*independentNDV, math.Max(stats.RowCount, 1))
+	}
+}
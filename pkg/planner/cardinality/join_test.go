@@ -0,0 +1,90 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/planner/cardinality"
+	"github.com/pingcap/tidb/pkg/planner/property"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateColGroupNDVByExtendedStats(t *testing.T) {
+	a := &expression.Column{ID: 1, UniqueID: 101}
+	b := &expression.Column{ID: 2, UniqueID: 102}
+	c := &expression.Column{ID: 3, UniqueID: 103}
+	colNDVs := map[int64]float64{101: 100, 102: 10, 103: 1000}
+	extStats := statistics.NewExtendedStatsColl()
+
+	// No extended stats.
+	_, ok := cardinality.EstimateColGroupNDVByExtendedStats(extStats, []*expression.Column{a, b}, colNDVs, 1e6)
+	require.False(t, ok)
+
+	// Fully correlated columns don't add distinct values.
+	extStats.Stats["s1"] = &statistics.ExtendedStatsItem{Tp: ast.StatsTypeCorrelation, ColIDs: []int64{1, 2}, ScalarVals: -1}
+	ndv, ok := cardinality.EstimateColGroupNDVByExtendedStats(extStats, []*expression.Column{a, b}, colNDVs, 1e6)
+	require.True(t, ok)
+	require.InDelta(t, 100, ndv, 1e-9)
+
+	// Uncorrelated columns follow the independence assumption.
+	extStats.Stats["s1"].ScalarVals = 0
+	ndv, ok = cardinality.EstimateColGroupNDVByExtendedStats(extStats, []*expression.Column{a, b}, colNDVs, 1e6)
+	require.True(t, ok)
+	require.InDelta(t, 1000, ndv, 1e-9)
+
+	// The NDV is bounded by the row count.
+	ndv, ok = cardinality.EstimateColGroupNDVByExtendedStats(extStats, []*expression.Column{a, b}, colNDVs, 500)
+	require.True(t, ok)
+	require.InDelta(t, 500, ndv, 1e-9)
+
+	// The column c has no correlation stats with a or b.
+	_, ok = cardinality.EstimateColGroupNDVByExtendedStats(extStats, []*expression.Column{a, b, c}, colNDVs, 1e6)
+	require.False(t, ok)
+
+	// The cardinality of the column group is used directly.
+	extStats.Stats["s2"] = &statistics.ExtendedStatsItem{Tp: ast.StatsTypeCardinality, ColIDs: []int64{3, 1, 2}, ScalarVals: 1234}
+	ndv, ok = cardinality.EstimateColGroupNDVByExtendedStats(extStats, []*expression.Column{a, b, c}, colNDVs, 1e6)
+	require.True(t, ok)
+	require.InDelta(t, 1234, ndv, 1e-9)
+}
+
+func TestAdjustColNDVsByExtendedStats(t *testing.T) {
+	a := &expression.Column{ID: 1, UniqueID: 101}
+	b := &expression.Column{ID: 2, UniqueID: 102}
+	c := &expression.Column{ID: 3, UniqueID: 103}
+	origStats := &property.StatsInfo{
+		RowCount: 10000,
+		ColNDVs:  map[int64]float64{101: 100, 102: 100, 103: 100},
+	}
+	// The filter on a keeps 1% of the rows.
+	stats := origStats.Scale(0.01)
+	extStats := statistics.NewExtendedStatsColl()
+	extStats.Stats["s1"] = &statistics.ExtendedStatsItem{Tp: ast.StatsTypeCorrelation, ColIDs: []int64{1, 2}, ScalarVals: 0}
+	cardinality.AdjustColNDVsByExtendedStats(extStats, []*expression.Column{a, b, c}, []*expression.Column{a}, origStats, stats)
+	// a is the filtered column and c has no correlation stats, they are kept.
+	require.InDelta(t, 1, stats.ColNDVs[101], 1e-9)
+	require.InDelta(t, 1, stats.ColNDVs[103], 1e-9)
+	// b is independent of a, so most of its distinct values are still there, but bounded by the row count.
+	require.InDelta(t, 63.397, stats.ColNDVs[102], 1e-3)
+
+	extStats.Stats["s1"].ScalarVals = 0.5
+	stats = origStats.Scale(0.01)
+	cardinality.AdjustColNDVsByExtendedStats(extStats, []*expression.Column{a, b, c}, []*expression.Column{a}, origStats, stats)
+	require.InDelta(t, (63.397+1)/2, stats.ColNDVs[102], 1e-3)
+}
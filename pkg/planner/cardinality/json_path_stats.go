@@ -0,0 +1,162 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/planner/planctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+// The JSON path stats are the expression stats on `JSON_EXTRACT(col, path)`, e.g. `col->'$.a'`. Besides the
+// predicates on the same path, which are covered by substituteExprWithColumn, they can also estimate:
+//  1. The predicates on the deeper paths, e.g. `col->'$.a.b' = 1` can be estimated by the stats on `col->'$.a'`
+//     by evaluating `placeholder->'$.b' = 1` on the sampled values.
+//  2. The JSON_CONTAINS with the path argument, e.g. `JSON_CONTAINS(col, '1', '$.a')`, which is equivalent to
+//     `JSON_CONTAINS(col->'$.a', '1')`.
+// The predicates without usable path stats keep the pseudo selectivity.
+
+// jsonPathOfStats returns the JSON document and the path of the expression stats if they're JSON path stats.
+func jsonPathOfStats(sctx planctx.PlanContext, expr expression.Expression) (doc expression.Expression, path string, ok bool) {
+	sf, ok := expr.(*expression.ScalarFunction)
+	if !ok || sf.FuncName.L != ast.JSONExtract || len(sf.GetArgs()) != 2 {
+		return nil, "", false
+	}
+	path, ok = constantString(sctx, sf.GetArgs()[1])
+	if !ok {
+		return nil, "", false
+	}
+	return sf.GetArgs()[0], path, true
+}
+
+// constantString returns the string value of the expression if it's a constant which is not a parameter.
+func constantString(sctx planctx.PlanContext, expr expression.Expression) (string, bool) {
+	con, ok := expr.(*expression.Constant)
+	if !ok || expression.MaybeOverOptimized4PlanCache(sctx.GetExprCtx(), []expression.Expression{con}) {
+		return "", false
+	}
+	str, isNull, err := con.EvalString(sctx.GetExprCtx().GetEvalCtx(), chunk.Row{})
+	if err != nil || isNull {
+		return "", false
+	}
+	return str, true
+}
+
+// jsonPathSuffix returns the path which extracts the value at path from the value at prefix, e.g. `$.b` for the
+// prefix `$.a` and the path `$.a.b`, and `$` if they're the same path. The prefix can't match multiple values,
+// otherwise the values at the prefix are wrapped into an array.
+func jsonPathSuffix(prefix, path string) (string, bool) {
+	prefixExpr, err := types.ParseJSONPathExpr(prefix)
+	if err != nil || prefixExpr.CouldMatchMultipleValues() {
+		return "", false
+	}
+	pathExpr, err := types.ParseJSONPathExpr(path)
+	if err != nil {
+		return "", false
+	}
+	// The legs are restored one by one, so the restored prefix is also the prefix of the restored path.
+	prefix, path = prefixExpr.String(), pathExpr.String()
+	if path == prefix {
+		return "$", true
+	}
+	if len(path) < len(prefix) || !strings.HasPrefix(path, prefix) || !strings.ContainsRune(".[*", rune(path[len(prefix)])) {
+		return "", false
+	}
+	return "$" + path[len(prefix):], true
+}
+
+// substituteJSONPath replaces the JSON_EXTRACT on the deeper paths of the JSON path stats in expr with the
+// JSON_EXTRACT on the placeholder, which stands for the value at the path of the stats.
+// The returned bool indicates whether any substitution happens.
+func substituteJSONPath(sctx planctx.PlanContext, expr, doc expression.Expression, statsPath string, placeholder *expression.Column) (expression.Expression, bool) {
+	sf, ok := expr.(*expression.ScalarFunction)
+	if !ok || sf.FuncName.L == ast.Grouping {
+		return expr, false
+	}
+	exprCtx := sctx.GetExprCtx()
+	args := sf.GetArgs()
+	switch sf.FuncName.L {
+	case ast.JSONExtract:
+		if len(args) != 2 || !args[0].Equal(exprCtx.GetEvalCtx(), doc) {
+			break
+		}
+		path, ok := constantString(sctx, args[1])
+		if !ok {
+			return expr, false
+		}
+		suffix, ok := jsonPathSuffix(statsPath, path)
+		if !ok {
+			return expr, false
+		}
+		if suffix == "$" {
+			return placeholder, true
+		}
+		newExpr, err := expression.NewFunction(exprCtx, ast.JSONExtract, sf.RetType, placeholder, expression.NewStrConst(suffix))
+		if err != nil {
+			return expr, false
+		}
+		return newExpr, true
+	case ast.JSONContains:
+		if len(args) != 3 {
+			break
+		}
+		// Move the path into the JSON document, see the comments at the top of the file.
+		extracted, err := expression.NewFunction(exprCtx, ast.JSONExtract, placeholder.RetType, args[0], args[2])
+		if err != nil {
+			return expr, false
+		}
+		newExpr, err := expression.NewFunction(exprCtx, ast.JSONContains, sf.RetType, extracted, args[1])
+		if err != nil {
+			return expr, false
+		}
+		return substituteJSONPath(sctx, newExpr, doc, statsPath, placeholder)
+	}
+	newArgs := make([]expression.Expression, len(args))
+	substituted := false
+	for i, arg := range args {
+		var argSubstituted bool
+		newArgs[i], argSubstituted = substituteJSONPath(sctx, arg, doc, statsPath, placeholder)
+		substituted = substituted || argSubstituted
+	}
+	if !substituted {
+		return expr, false
+	}
+	if sf.FuncName.L == ast.Cast {
+		return expression.BuildCastFunction(exprCtx, newArgs[0], sf.RetType), true
+	}
+	newExpr, err := expression.NewFunction(exprCtx, sf.FuncName.L, sf.RetType, newArgs...)
+	if err != nil {
+		return expr, false
+	}
+	return newExpr, true
+}
+
+// recordUsedJSONPathStats records the JSON path stats used in the estimation, which are shown in the execution plan.
+func recordUsedJSONPathStats(sctx planctx.PlanContext, coll *statistics.HistColl, s *statistics.ExpressionStatsInQuery) {
+	if _, _, ok := jsonPathOfStats(sctx, s.Expr); !ok {
+		return
+	}
+	usedInfo := sctx.GetSessionVars().StmtCtx.GetUsedStatsInfo(true).GetUsedInfo(coll.PhysicalID)
+	if usedInfo == nil || slices.Contains(usedInfo.UsedJSONPathStats, s.Stats.Expr) {
+		return
+	}
+	usedInfo.UsedJSONPathStats = append(usedInfo.UsedJSONPathStats, s.Stats.Expr)
+}
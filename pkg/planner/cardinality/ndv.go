@@ -18,6 +18,7 @@ import (
 	"math"
 
 	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/planner/planctx"
 	"github.com/pingcap/tidb/pkg/planner/property"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/util/logutil"
@@ -28,7 +29,7 @@ const distinctFactor = 0.8
 
 // EstimateColumnNDV computes estimated NDV of specified column using the original
 // histogram of `DataSource` which is retrieved from storage(not the derived one).
-func EstimateColumnNDV(tbl *statistics.Table, colID int64) (ndv float64) {
+func EstimateColumnNDV(sctx planctx.PlanContext, tbl *statistics.Table, colID int64) (ndv float64) {
 	hist := tbl.GetCol(colID)
 	if hist != nil && hist.IsStatsInitialized() {
 		ndv = float64(hist.Histogram.NDV)
@@ -38,6 +39,8 @@ func EstimateColumnNDV(tbl *statistics.Table, colID int64) (ndv float64) {
 			factor := float64(tbl.RealtimeCount) / float64(analyzeCount)
 			ndv *= factor
 		}
+	} else if schemaNDV, ok := pseudoColumnNDV(sctx, hist, float64(tbl.RealtimeCount)); ok {
+		ndv = schemaNDV
 	} else {
 		ndv = float64(tbl.RealtimeCount) * distinctFactor
 	}
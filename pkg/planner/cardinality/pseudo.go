@@ -41,6 +41,32 @@ func PseudoAvgCountPerValue(t *statistics.Table) float64 {
 	return float64(t.RealtimeCount) / pseudoEqualRate
 }
 
+// pseudoColumnNDV infers the NDV of the column without stats from its definition. The unique columns have the NDV
+// equal to the row count, the NDVs of the enum and set columns are bounded by their elements, and the NOT NULL
+// boolean-like tinyint columns have 2 distinct values. It returns false if nothing can be inferred, or
+// tidb_opt_schema_informed_pseudo_stats is off.
+func pseudoColumnNDV(sctx planctx.PlanContext, c *statistics.Column, rowCount float64) (float64, bool) {
+	if !sctx.GetSessionVars().SchemaInformedPseudoStats || c == nil || c.Info == nil || rowCount <= 0 {
+		return 0, false
+	}
+	ft := &c.Info.FieldType
+	var ndv float64
+	switch {
+	case c.IsHandle || mysql.HasUniKeyFlag(ft.GetFlag()):
+		return rowCount, true
+	case ft.GetType() == mysql.TypeEnum:
+		ndv = float64(len(ft.GetElems()))
+	case ft.GetType() == mysql.TypeSet:
+		ndv = math.Exp2(float64(len(ft.GetElems())))
+	case ft.GetType() == mysql.TypeTiny && ft.GetFlen() == 1 && mysql.HasNotNullFlag(ft.GetFlag()):
+		ndv = 2
+	}
+	if ndv <= 0 {
+		return 0, false
+	}
+	return math.Min(ndv, rowCount), true
+}
+
 func pseudoSelectivity(sctx planctx.PlanContext, coll *statistics.HistColl, exprs []expression.Expression) float64 {
 	minFactor := selectionFactor
 	colExists := make(map[string]bool)
@@ -56,15 +82,20 @@ func pseudoSelectivity(sctx planctx.PlanContext, coll *statistics.HistColl, expr
 		statistics.ColumnStatsIsInvalid((*statistics.Column)(nil), sctx, coll, colID)
 		switch fun.FuncName.L {
 		case ast.EQ, ast.NullEQ, ast.In:
-			minFactor = math.Min(minFactor, 1.0/pseudoEqualRate)
 			col := coll.GetCol(colID)
 			if col == nil {
+				minFactor = math.Min(minFactor, 1.0/pseudoEqualRate)
 				continue
 			}
 			colExists[col.Info.Name.L] = true
 			if mysql.HasUniKeyFlag(col.Info.GetFlag()) {
 				return 1.0 / float64(coll.RealtimeCount)
 			}
+			if ndv, ok := pseudoColumnNDV(sctx, col, float64(coll.RealtimeCount)); ok {
+				minFactor = math.Min(minFactor, 1.0/ndv)
+			} else {
+				minFactor = math.Min(minFactor, 1.0/pseudoEqualRate)
+			}
 		case ast.GE, ast.GT, ast.LE, ast.LT:
 			minFactor = math.Min(minFactor, 1.0/pseudoLessRate)
 			// FIXME: To resolve the between case.
@@ -192,7 +223,7 @@ func getPseudoRowCountByIndexRanges(tc types.Context, indexRanges []*ranger.Rang
 		if i >= len(indexRange.LowVal) {
 			i = len(indexRange.LowVal) - 1
 		}
-		rowCount, err := getPseudoRowCountByColumnRanges(tc, tableRowCount, []*ranger.Range{indexRange}, i)
+		rowCount, err := getPseudoRowCountByColumnRanges(tc, tableRowCount, []*ranger.Range{indexRange}, i, 0)
 		if err != nil {
 			return 0, errors.Trace(err)
 		}
@@ -211,7 +242,12 @@ func getPseudoRowCountByIndexRanges(tc types.Context, indexRanges []*ranger.Rang
 }
 
 // getPseudoRowCountByColumnRanges calculate the row count by the ranges if there's no statistics information for this column.
-func getPseudoRowCountByColumnRanges(tc types.Context, tableRowCount float64, columnRanges []*ranger.Range, colIdx int) (float64, error) {
+// The ndv is the NDV of the column inferred from its definition, it's used to estimate the point ranges if positive.
+func getPseudoRowCountByColumnRanges(tc types.Context, tableRowCount float64, columnRanges []*ranger.Range, colIdx int, ndv float64) (float64, error) {
+	equalRate := float64(pseudoEqualRate)
+	if ndv > 0 {
+		equalRate = ndv
+	}
 	var rowCount float64
 	for _, ran := range columnRanges {
 		if ran.LowVal[colIdx].Kind() == types.KindNull && ran.HighVal[colIdx].Kind() == types.KindMaxValue {
@@ -232,7 +268,7 @@ func getPseudoRowCountByColumnRanges(tc types.Context, tableRowCount float64, co
 				return 0, errors.Trace(err)
 			}
 			if compare == 0 {
-				rowCount += tableRowCount / pseudoEqualRate
+				rowCount += tableRowCount / equalRate
 			} else {
 				rowCount += tableRowCount / pseudoBetweenRate
 			}
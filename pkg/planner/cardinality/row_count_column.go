@@ -15,6 +15,8 @@
 package cardinality
 
 import (
+	"math"
+
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/planner/planctx"
 	"github.com/pingcap/tidb/pkg/planner/util/debugtrace"
@@ -25,6 +27,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/mathutil"
 	"github.com/pingcap/tidb/pkg/util/ranger"
+	"github.com/tikv/client-go/v2/oracle"
 )
 
 func init() {
@@ -33,8 +36,8 @@ func init() {
 	statistics.GetRowCountByIndexRanges = GetRowCountByIndexRanges
 }
 
-// GetRowCountByColumnRanges estimates the row count by a slice of Range.
-func GetRowCountByColumnRanges(sctx planctx.PlanContext, coll *statistics.HistColl, colUniqueID int64, colRanges []*ranger.Range) (result float64, err error) {
+// getRowCountByColumnRanges estimates the row count by a slice of Range.
+func getRowCountByColumnRanges(sctx planctx.PlanContext, coll *statistics.HistColl, colUniqueID int64, colRanges []*ranger.Range) (result float64, err error) {
 	var name string
 	if sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
 		debugtrace.EnterContextCommon(sctx)
@@ -55,7 +58,11 @@ func GetRowCountByColumnRanges(sctx planctx.PlanContext, coll *statistics.HistCo
 		name = c.Info.Name.O
 	}
 	if statistics.ColumnStatsIsInvalid(c, sctx, coll, colUniqueID) {
-		result, err = getPseudoRowCountByColumnRanges(sc.TypeCtx(), float64(coll.RealtimeCount), colRanges, 0)
+		if sc.EnableOptimizerDebugTrace {
+			debugTraceStatsDerivation(sctx, debugTracePseudo, "Realtime Count", coll.RealtimeCount)
+		}
+		ndv, _ := pseudoColumnNDV(sctx, c, float64(coll.RealtimeCount))
+		result, err = getPseudoRowCountByColumnRanges(sc.TypeCtx(), float64(coll.RealtimeCount), colRanges, 0, ndv)
 		if err == nil && sc.EnableOptimizerCETrace && c != nil {
 			ceTraceRange(sctx, coll.PhysicalID, []string{c.Info.Name.O}, colRanges, "Column Stats-Pseudo", uint64(result))
 		}
@@ -63,20 +70,21 @@ func GetRowCountByColumnRanges(sctx planctx.PlanContext, coll *statistics.HistCo
 	}
 	if sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
 		debugtrace.RecordAnyValuesWithNames(sctx,
+			"Stats Version", debugTraceHistVersion(&c.Histogram),
 			"Histogram NotNull Count", c.Histogram.NotNullCount(),
 			"TopN total count", c.TopN.TotalCount(),
 			"Increase Factor", c.GetIncreaseFactor(coll.RealtimeCount),
 		)
 	}
-	result, err = GetColumnRowCount(sctx, c, colRanges, coll.RealtimeCount, coll.ModifyCount, false)
+	result, err = getColumnRowCount(sctx, c, colRanges, coll.RealtimeCount, coll.ModifyCount, coll.StatsVersion, false)
 	if sc.EnableOptimizerCETrace {
 		ceTraceRange(sctx, coll.PhysicalID, []string{c.Info.Name.O}, colRanges, "Column Stats", uint64(result))
 	}
 	return result, errors.Trace(err)
 }
 
-// GetRowCountByIntColumnRanges estimates the row count by a slice of IntColumnRange.
-func GetRowCountByIntColumnRanges(sctx planctx.PlanContext, coll *statistics.HistColl, colUniqueID int64, intRanges []*ranger.Range) (result float64, err error) {
+// getRowCountByIntColumnRanges estimates the row count by a slice of IntColumnRange.
+func getRowCountByIntColumnRanges(sctx planctx.PlanContext, coll *statistics.HistColl, colUniqueID int64, intRanges []*ranger.Range) (result float64, err error) {
 	var name string
 	if sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
 		debugtrace.EnterContextCommon(sctx)
@@ -100,6 +108,9 @@ func GetRowCountByIntColumnRanges(sctx planctx.PlanContext, coll *statistics.His
 		if len(intRanges) == 0 {
 			return 0, nil
 		}
+		if sc.EnableOptimizerDebugTrace {
+			debugTraceStatsDerivation(sctx, debugTracePseudo, "Realtime Count", coll.RealtimeCount)
+		}
 		if intRanges[0].LowVal[0].Kind() == types.KindInt64 {
 			result = getPseudoRowCountBySignedIntRanges(intRanges, float64(coll.RealtimeCount))
 		} else {
@@ -112,12 +123,13 @@ func GetRowCountByIntColumnRanges(sctx planctx.PlanContext, coll *statistics.His
 	}
 	if sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
 		debugtrace.RecordAnyValuesWithNames(sctx,
+			"Stats Version", debugTraceHistVersion(&c.Histogram),
 			"Histogram NotNull Count", c.Histogram.NotNullCount(),
 			"TopN total count", c.TopN.TotalCount(),
 			"Increase Factor", c.GetIncreaseFactor(coll.RealtimeCount),
 		)
 	}
-	result, err = GetColumnRowCount(sctx, c, intRanges, coll.RealtimeCount, coll.ModifyCount, true)
+	result, err = getColumnRowCount(sctx, c, intRanges, coll.RealtimeCount, coll.ModifyCount, coll.StatsVersion, true)
 	if sc.EnableOptimizerCETrace {
 		ceTraceRange(sctx, coll.PhysicalID, []string{c.Info.Name.O}, intRanges, "Column Stats", uint64(result))
 	}
@@ -126,7 +138,8 @@ func GetRowCountByIntColumnRanges(sctx planctx.PlanContext, coll *statistics.His
 
 // equalRowCountOnColumn estimates the row count by a slice of Range and a Datum.
 func equalRowCountOnColumn(sctx planctx.PlanContext, c *statistics.Column, val types.Datum, encodedVal []byte, realtimeRowCount, modifyCount int64) (result float64, err error) {
-	if sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
+	debugTrace := sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace
+	if debugTrace {
 		debugtrace.EnterContextCommon(sctx)
 		debugtrace.RecordAnyValuesWithNames(sctx, "Value", val.String(), "Encoded", encodedVal)
 		defer func() {
@@ -143,12 +156,23 @@ func equalRowCountOnColumn(sctx planctx.PlanContext, c *statistics.Column, val t
 			return 0.0, nil
 		}
 		if c.Histogram.NDV > 0 && c.OutOfRange(val) {
-			return outOfRangeEQSelectivity(sctx, c.Histogram.NDV, realtimeRowCount, int64(c.TotalRowCount())) * c.TotalRowCount(), nil
+			sel := outOfRangeEQSelectivity(sctx, c.Histogram.NDV, realtimeRowCount, int64(c.TotalRowCount()))
+			if debugTrace {
+				debugTraceStatsDerivation(sctx, debugTraceOutOfRangeEqual,
+					"NDV", c.Histogram.NDV, "Selectivity", sel, "Total Row Count", c.TotalRowCount())
+			}
+			return sel * c.TotalRowCount(), nil
 		}
 		if c.CMSketch != nil {
+			if debugTrace {
+				debugTraceStatsDerivation(sctx, debugTraceVer1CMSketch)
+			}
 			count, err := statistics.QueryValue(sctx, c.CMSketch, c.TopN, val)
 			return float64(count), errors.Trace(err)
 		}
+		if debugTrace {
+			debugTraceStatsDerivation(sctx, debugTraceVer1Histogram)
+		}
 		histRowCount, _ := c.Histogram.EqualRowCount(sctx, val, false)
 		return histRowCount, nil
 	}
@@ -162,12 +186,18 @@ func equalRowCountOnColumn(sctx planctx.PlanContext, c *statistics.Column, val t
 	if c.TopN != nil {
 		rowcount, ok := c.TopN.QueryTopN(sctx, encodedVal)
 		if ok {
+			if debugTrace {
+				debugTraceStatsDerivation(sctx, debugTraceTopNHit)
+			}
 			return float64(rowcount), nil
 		}
 	}
 	// 2. try to find this value in bucket.Repeat(the last value in every bucket)
 	histCnt, matched := c.Histogram.EqualRowCount(sctx, val, true)
 	if matched {
+		if debugTrace {
+			debugTraceStatsDerivation(sctx, debugTraceBucketRepeatHit)
+		}
 		return histCnt, nil
 	}
 	// 3. use uniform distribution assumption for the rest (even when this value is not covered by the range of stats)
@@ -181,6 +211,10 @@ func equalRowCountOnColumn(sctx planctx.PlanContext, c *statistics.Column, val t
 		// If histNDV is zero - we have all NDV's in TopN - and no histograms. This function uses
 		// c.NotNullCount rather than c.Histogram.NotNullCount() since the histograms are empty.
 		// c.Histogram.NDV stores the full NDV regardless of histograms empty or populated.
+		if debugTrace {
+			debugTraceStatsDerivation(sctx, debugTraceAllNDVInTopN,
+				"NDV", c.Histogram.NDV, "TopN Min Count", c.TopN.MinCount(), "Modify Count", modifyCount)
+		}
 		if histNDV > 0 && modifyCount == 0 {
 			return max(float64(c.TopN.MinCount()-1), 1), nil
 		}
@@ -189,11 +223,21 @@ func equalRowCountOnColumn(sctx planctx.PlanContext, c *statistics.Column, val t
 	}
 	// branch 2: some NDV's are in histograms
 	// return the average histogram rows (which excludes topN) and NDV that excluded topN
+	if debugTrace {
+		debugTraceStatsDerivation(sctx, debugTraceUniformInHistogram,
+			"Histogram NotNull Count", c.Histogram.NotNullCount(), "Histogram NDV", histNDV)
+	}
 	return c.Histogram.NotNullCount() / histNDV, nil
 }
 
 // GetColumnRowCount estimates the row count by a slice of Range.
 func GetColumnRowCount(sctx planctx.PlanContext, c *statistics.Column, ranges []*ranger.Range, realtimeRowCount, modifyCount int64, pkIsHandle bool) (float64, error) {
+	return getColumnRowCount(sctx, c, ranges, realtimeRowCount, modifyCount, 0, pkIsHandle)
+}
+
+// getColumnRowCount estimates the row count by a slice of Range. statsVersion is the version of the stats meta the
+// realtimeRowCount and modifyCount are read from, 0 if it's unknown.
+func getColumnRowCount(sctx planctx.PlanContext, c *statistics.Column, ranges []*ranger.Range, realtimeRowCount, modifyCount int64, statsVersion uint64, pkIsHandle bool) (float64, error) {
 	sc := sctx.GetSessionVars().StmtCtx
 	debugTrace := sc.EnableOptimizerDebugTrace
 	if debugTrace {
@@ -315,7 +359,19 @@ func GetColumnRowCount(sctx planctx.PlanContext, c *statistics.Column, ranges []
 			if c.StatsVer == statistics.Version2 {
 				histNDV -= int64(c.TopN.Num())
 			}
-			cnt += c.Histogram.OutOfRangeRowCount(sctx, &lowVal, &highVal, realtimeRowCount, modifyCount, histNDV)
+			outOfRangeCnt, ok := 0.0, false
+			if sctx.GetSessionVars().OutOfRangeExtrapolation {
+				insertedRows := insertedRowsSinceAnalyze(sctx, &c.Histogram, realtimeRowCount, modifyCount, statsVersion)
+				outOfRangeCnt, ok = c.Histogram.ExtrapolatedOutOfRangeRowCount(&lowVal, &highVal, c.Correlation, insertedRows)
+			}
+			if !ok {
+				outOfRangeCnt = c.Histogram.OutOfRangeRowCount(sctx, &lowVal, &highVal, realtimeRowCount, modifyCount, histNDV)
+			}
+			if debugTrace {
+				debugTraceStatsDerivation(sctx, debugTraceOutOfRangeAdjustment,
+					"Histogram NDV", histNDV, "Modify Count", modifyCount, "Row Count", outOfRangeCnt)
+			}
+			cnt += outOfRangeCnt
 		}
 
 		if debugTrace {
@@ -405,3 +461,26 @@ func ColumnEqualRowCount(sctx planctx.PlanContext, t *statistics.Table, value ty
 	result *= c.GetIncreaseFactor(t.RealtimeCount)
 	return result, errors.Trace(err)
 }
+
+// insertedRowsSinceAnalyze estimates the rows inserted since the histogram is built from the growth of the row count
+// and the modify count. The modifications not explained by the growth are assumed to be half inserts and half
+// deletes, like a table keeping a sliding window of the recent rows.
+// Since the stats delta is only dumped periodically, the inserts are further extrapolated from the stats meta version
+// to the start of the statement, by their velocity between the analyze and the stats meta version. The extrapolation
+// decays exponentially with the time it covers, so at most the inserts observed are added.
+func insertedRowsSinceAnalyze(sctx planctx.PlanContext, hg *statistics.Histogram, realtimeRowCount, modifyCount int64, statsVersion uint64) float64 {
+	growth := float64(realtimeRowCount) - hg.TotalRowCount()
+	inserted := mathutil.Clamp((float64(modifyCount)+growth)/2, 0, float64(modifyCount))
+	// The start time of the statement is used instead of the current time, so the estimations in a statement agree.
+	now := sctx.GetSessionVars().StartTime
+	if inserted <= 0 || now.IsZero() || hg.LastUpdateVersion == 0 || statsVersion <= hg.LastUpdateVersion {
+		return inserted
+	}
+	analyzeTime := oracle.GetTimeFromTS(hg.LastUpdateVersion)
+	observed := oracle.GetTimeFromTS(statsVersion).Sub(analyzeTime)
+	unobserved := now.Sub(analyzeTime) - observed
+	if observed <= 0 || unobserved <= 0 {
+		return inserted
+	}
+	return inserted * (2 - math.Exp(-float64(unobserved)/float64(observed)))
+}
@@ -37,8 +37,8 @@ import (
 	"github.com/pingcap/tidb/pkg/util/ranger"
 )
 
-// GetRowCountByIndexRanges estimates the row count by a slice of Range.
-func GetRowCountByIndexRanges(sctx planctx.PlanContext, coll *statistics.HistColl, idxID int64, indexRanges []*ranger.Range) (result float64, corrResult float64, err error) {
+// getRowCountByIndexRanges estimates the row count by a slice of Range.
+func getRowCountByIndexRanges(sctx planctx.PlanContext, coll *statistics.HistColl, idxID int64, indexRanges []*ranger.Range) (result float64, corrResult float64, err error) {
 	var name string
 	if sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
 		debugtrace.EnterContextCommon(sctx)
@@ -65,6 +65,9 @@ func GetRowCountByIndexRanges(sctx planctx.PlanContext, coll *statistics.HistCol
 		if idx != nil && idx.Info.Unique {
 			colsLen = len(idx.Info.Columns)
 		}
+		if sc.EnableOptimizerDebugTrace {
+			debugTraceStatsDerivation(sctx, debugTracePseudo, "Realtime Count", coll.RealtimeCount)
+		}
 		result, err = getPseudoRowCountByIndexRanges(sc.TypeCtx(), indexRanges, float64(coll.RealtimeCount), colsLen)
 		if err == nil && sc.EnableOptimizerCETrace && idx != nil {
 			ceTraceRange(sctx, coll.PhysicalID, colNames, indexRanges, "Index Stats-Pseudo", uint64(result))
@@ -74,6 +77,7 @@ func GetRowCountByIndexRanges(sctx planctx.PlanContext, coll *statistics.HistCol
 	realtimeCnt, modifyCount := coll.GetScaledRealtimeAndModifyCnt(idx)
 	if sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
 		debugtrace.RecordAnyValuesWithNames(sctx,
+			"Stats Version", debugTraceHistVersion(&idx.Histogram),
 			"Histogram NotNull Count", idx.Histogram.NotNullCount(),
 			"TopN total count", idx.TopN.TotalCount(),
 			"Increase Factor", idx.GetIncreaseFactor(realtimeCnt),
@@ -182,9 +186,9 @@ func getIndexRowCountForStatsV1(sctx planctx.PlanContext, coll *statistics.HistC
 			// prefer index stats over column stats
 			if idxIDs, ok := coll.ColUniqueID2IdxIDs[colUniqueID]; ok && len(idxIDs) > 0 {
 				idxID := idxIDs[0]
-				count, _, err = GetRowCountByIndexRanges(sctx, coll, idxID, []*ranger.Range{&rang})
+				count, _, err = getRowCountByIndexRanges(sctx, coll, idxID, []*ranger.Range{&rang})
 			} else {
-				count, err = GetRowCountByColumnRanges(sctx, coll, colUniqueID, []*ranger.Range{&rang})
+				count, err = getRowCountByColumnRanges(sctx, coll, colUniqueID, []*ranger.Range{&rang})
 			}
 			if err != nil {
 				return 0, errors.Trace(err)
@@ -354,7 +358,18 @@ func getIndexRowCountForStatsV2(sctx planctx.PlanContext, idx *statistics.Index,
 					histNDV -= int64(idx.TopN.Num())
 				}
 			}
-			count += idx.Histogram.OutOfRangeRowCount(sctx, &l, &r, realtimeRowCount, modifyCount, histNDV)
+			outOfRangeCnt, ok := 0.0, false
+			if sctx.GetSessionVars().OutOfRangeExtrapolation && isSingleColIdx && coll != nil {
+				outOfRangeCnt, ok = extrapolatedOutOfRangeRowCountByColumn(sctx, coll, idx, indexRange, realtimeRowCount, modifyCount)
+			}
+			if !ok {
+				outOfRangeCnt = idx.Histogram.OutOfRangeRowCount(sctx, &l, &r, realtimeRowCount, modifyCount, histNDV)
+			}
+			if debugTrace {
+				debugTraceStatsDerivation(sctx, debugTraceOutOfRangeAdjustment,
+					"Histogram NDV", histNDV, "Modify Count", modifyCount, "Row Count", outOfRangeCnt)
+			}
+			count += outOfRangeCnt
 		}
 
 		if debugTrace {
@@ -379,7 +394,8 @@ func getIndexRowCountForStatsV2(sctx planctx.PlanContext, idx *statistics.Index,
 var nullKeyBytes, _ = codec.EncodeKey(time.UTC, nil, types.NewDatum(nil))
 
 func equalRowCountOnIndex(sctx planctx.PlanContext, idx *statistics.Index, b []byte, realtimeRowCount, modifyCount int64) (result float64) {
-	if sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
+	debugTrace := sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace
+	if debugTrace {
 		debugtrace.EnterContextCommon(sctx)
 		debugtrace.RecordAnyValuesWithNames(sctx, "Encoded Value", b)
 		defer func() {
@@ -395,11 +411,22 @@ func equalRowCountOnIndex(sctx planctx.PlanContext, idx *statistics.Index, b []b
 	val := types.NewBytesDatum(b)
 	if idx.StatsVer < statistics.Version2 {
 		if idx.Histogram.NDV > 0 && outOfRangeOnIndex(idx, val) {
-			return outOfRangeEQSelectivity(sctx, idx.Histogram.NDV, realtimeRowCount, int64(idx.TotalRowCount())) * idx.TotalRowCount()
+			sel := outOfRangeEQSelectivity(sctx, idx.Histogram.NDV, realtimeRowCount, int64(idx.TotalRowCount()))
+			if debugTrace {
+				debugTraceStatsDerivation(sctx, debugTraceOutOfRangeEqual,
+					"NDV", idx.Histogram.NDV, "Selectivity", sel, "Total Row Count", idx.TotalRowCount())
+			}
+			return sel * idx.TotalRowCount()
 		}
 		if idx.CMSketch != nil {
+			if debugTrace {
+				debugTraceStatsDerivation(sctx, debugTraceVer1CMSketch)
+			}
 			return float64(idx.QueryBytes(sctx, b))
 		}
+		if debugTrace {
+			debugTraceStatsDerivation(sctx, debugTraceVer1Histogram)
+		}
 		histRowCount, _ := idx.Histogram.EqualRowCount(sctx, val, false)
 		return histRowCount
 	}
@@ -408,12 +435,18 @@ func equalRowCountOnIndex(sctx planctx.PlanContext, idx *statistics.Index, b []b
 	if idx.TopN != nil {
 		count, found := idx.TopN.QueryTopN(sctx, b)
 		if found {
+			if debugTrace {
+				debugTraceStatsDerivation(sctx, debugTraceTopNHit)
+			}
 			return float64(count)
 		}
 	}
 	// 2. try to find this value in bucket.Repeat(the last value in every bucket)
 	histCnt, matched := idx.Histogram.EqualRowCount(sctx, val, true)
 	if matched {
+		if debugTrace {
+			debugTraceStatsDerivation(sctx, debugTraceBucketRepeatHit)
+		}
 		return histCnt
 	}
 	// 3. use uniform distribution assumption for the rest (even when this value is not covered by the range of stats)
@@ -425,10 +458,18 @@ func equalRowCountOnIndex(sctx planctx.PlanContext, idx *statistics.Index, b []b
 		if notNullCount <= 0 {
 			notNullCount = idx.TotalRowCount() - float64(idx.Histogram.NullCount)
 		}
+		if debugTrace {
+			debugTraceStatsDerivation(sctx, debugTraceAllNDVInTopN,
+				"NDV", idx.Histogram.NDV, "NotNull Count", notNullCount, "Modify Count", modifyCount)
+		}
 		increaseFactor := idx.GetIncreaseFactor(realtimeRowCount)
 		return outOfRangeFullNDV(float64(idx.Histogram.NDV), idx.TotalRowCount(), notNullCount, float64(realtimeRowCount), increaseFactor, modifyCount)
 	}
 	// return the average histogram rows (which excludes topN) and NDV that excluded topN
+	if debugTrace {
+		debugTraceStatsDerivation(sctx, debugTraceUniformInHistogram,
+			"Histogram NotNull Count", idx.Histogram.NotNullCount(), "Histogram NDV", histNDV)
+	}
 	return idx.Histogram.NotNullCount() / histNDV
 }
 
@@ -476,12 +517,12 @@ func expBackoffEstimation(sctx planctx.PlanContext, idx *statistics.Index, coll
 		)
 		if !statistics.ColumnStatsIsInvalid(coll.GetCol(colID), sctx, coll, colID) {
 			foundStats = true
-			count, err = GetRowCountByColumnRanges(sctx, coll, colID, tmpRan)
+			count, err = getRowCountByColumnRanges(sctx, coll, colID, tmpRan)
 			selectivity = count / float64(coll.RealtimeCount)
 		}
 		if idxIDs, ok := coll.ColUniqueID2IdxIDs[colID]; ok && !foundStats && len(indexRange.LowVal) > 1 {
 			// Note the `len(indexRange.LowVal) > 1` condition here, it means we only recursively call
-			// `GetRowCountByIndexRanges()` when the input `indexRange` is a multi-column range. This
+			// `getRowCountByIndexRanges()` when the input `indexRange` is a multi-column range. This
 			// check avoids infinite recursion.
 			for _, idxID := range idxIDs {
 				idxStats := coll.GetIdx(idxID)
@@ -489,7 +530,7 @@ func expBackoffEstimation(sctx planctx.PlanContext, idx *statistics.Index, coll
 					continue
 				}
 				foundStats = true
-				count, _, err = GetRowCountByIndexRanges(sctx, coll, idxID, tmpRan)
+				count, _, err = getRowCountByIndexRanges(sctx, coll, idxID, tmpRan)
 				if err == nil {
 					break
 				}
@@ -590,3 +631,30 @@ func getOrdinalOfRangeCond(sc *stmtctx.StatementContext, ran *ranger.Range) int
 	}
 	return len(ran.LowVal)
 }
+
+// extrapolatedOutOfRangeRowCountByColumn extrapolates the out-of-range part of the range on a single column index by
+// the histogram of the column, since the encoded keys of the index lose the precision when converted to scalars.
+// It returns false if the histogram of the column is not loaded or can't be extrapolated.
+func extrapolatedOutOfRangeRowCountByColumn(sctx planctx.PlanContext, coll *statistics.HistColl, idx *statistics.Index,
+	indexRange *ranger.Range, realtimeRowCount, modifyCount int64) (float64, bool) {
+	colIDs := coll.Idx2ColUniqueIDs[idx.Histogram.ID]
+	if len(colIDs) == 0 || len(indexRange.LowVal) != 1 || len(indexRange.HighVal) != 1 {
+		return 0, false
+	}
+	c := coll.GetCol(colIDs[0])
+	if c == nil || c.IsAllEvicted() {
+		return 0, false
+	}
+	lowVal, highVal := *indexRange.LowVal[0].Clone(), *indexRange.HighVal[0].Clone()
+	if lowVal.IsNull() {
+		lowVal = types.MinNotNullDatum()
+	}
+	if highVal.Kind() == types.KindString {
+		highVal.SetBytes(collate.GetCollator(highVal.Collation()).Key(highVal.GetString()))
+	}
+	if lowVal.Kind() == types.KindString {
+		lowVal.SetBytes(collate.GetCollator(lowVal.Collation()).Key(lowVal.GetString()))
+	}
+	insertedRows := insertedRowsSinceAnalyze(sctx, &c.Histogram, realtimeRowCount, modifyCount, coll.StatsVersion)
+	return c.Histogram.ExtrapolatedOutOfRangeRowCount(&lowVal, &highVal, c.Correlation, insertedRows)
+}
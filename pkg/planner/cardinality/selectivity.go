@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/tidb/pkg/planner/planctx"
 	planutil "github.com/pingcap/tidb/pkg/planner/util"
 	"github.com/pingcap/tidb/pkg/planner/util/debugtrace"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/chunk"
@@ -42,12 +43,12 @@ var (
 	outOfRangeBetweenRate int64 = 100
 )
 
-// Selectivity is a function calculate the selectivity of the expressions on the specified HistColl.
+// getSelectivity is a function calculate the selectivity of the expressions on the specified HistColl.
 // The definition of selectivity is (row count after filter / row count before filter).
 // And exprs must be CNF now, in other words, `exprs[0] and exprs[1] and ... and exprs[len - 1]`
 // should be held when you call this.
 // Currently, the time complexity is o(n^2).
-func Selectivity(
+func getSelectivity(
 	ctx planctx.PlanContext,
 	coll *statistics.HistColl,
 	exprs []expression.Expression,
@@ -74,6 +75,15 @@ func Selectivity(
 	ret := 1.0
 	sc := ctx.GetSessionVars().StmtCtx
 	tableID := coll.PhysicalID
+	if coll.Pseudo && coll.DynamicSample != nil {
+		if ret, ok := dynamicSampleSelectivity(ctx, coll, exprs); ok {
+			if sc.EnableOptimizerCETrace {
+				ceTraceExpr(ctx, tableID, "Table Stats-Dynamic-Sample-Expression",
+					expression.ComposeCNFCondition(ctx.GetExprCtx(), exprs...), ret*float64(coll.RealtimeCount))
+			}
+			return ret, nil, nil
+		}
+	}
 	// TODO: If len(exprs) is bigger than 63, we could use bitset structure to replace the int64.
 	// This will simplify some code and speed up if we use this rather than a boolean slice.
 	if len(exprs) > 63 || (coll.ColNum() == 0 && coll.IdxNum() == 0) {
@@ -104,15 +114,22 @@ func Selectivity(
 		var sel float64
 		if statistics.ColumnStatsIsInvalid(colHist, ctx, coll, c.ID) {
 			sel = 1.0 / pseudoEqualRate
+			if ndv, ok := pseudoColumnNDV(ctx, colHist, float64(coll.RealtimeCount)); ok {
+				sel = 1 / ndv
+			}
 		} else if colHist.Histogram.NDV > 0 {
 			sel = 1 / float64(colHist.Histogram.NDV)
 		} else {
 			sel = 1.0 / pseudoEqualRate
 		}
+		ret *= sel
 		if sc.EnableOptimizerDebugTrace {
-			debugtrace.RecordAnyValuesWithNames(ctx, "Expression", expr.StringWithCtx(ctx.GetExprCtx().GetEvalCtx(), errors.RedactLogDisable), "Selectivity", sel)
+			debugtrace.RecordAnyValuesWithNames(ctx,
+				"Expression", expr.StringWithCtx(ctx.GetExprCtx().GetEvalCtx(), errors.RedactLogDisable),
+				"Selectivity", sel,
+				"Accumulated Selectivity", ret,
+			)
 		}
-		ret *= sel
 	}
 
 	extractedCols := make([]*expression.Column, 0, coll.ColNum())
@@ -136,18 +153,20 @@ func Selectivity(
 			if colStats.IsHandle {
 				nodes[len(nodes)-1].Tp = PkType
 				var cnt float64
-				cnt, err = GetRowCountByIntColumnRanges(ctx, coll, id, ranges)
+				cnt, err = getRowCountByIntColumnRanges(ctx, coll, id, ranges)
 				if err != nil {
 					return 0, nil, errors.Trace(err)
 				}
 				nodes[len(nodes)-1].Selectivity = cnt / float64(coll.RealtimeCount)
+				recordPredicateSelectivity(ctx, tableID, col.ID, maskCovered, ranges, nodes[len(nodes)-1].Selectivity)
 				continue
 			}
-			cnt, err := GetRowCountByColumnRanges(ctx, coll, id, ranges)
+			cnt, err := getRowCountByColumnRanges(ctx, coll, id, ranges)
 			if err != nil {
 				return 0, nil, errors.Trace(err)
 			}
 			nodes[len(nodes)-1].Selectivity = cnt / float64(coll.RealtimeCount)
+			recordPredicateSelectivity(ctx, tableID, col.ID, maskCovered, ranges, nodes[len(nodes)-1].Selectivity)
 		} else if !col.IsHidden {
 			// TODO: We are able to remove this path if we remove the async stats load.
 			statistics.ColumnStatsIsInvalid(nil, ctx, coll, col.ID)
@@ -202,7 +221,7 @@ func Selectivity(
 			if err != nil {
 				return 0, nil, errors.Trace(err)
 			}
-			cnt, corrCnt, err := GetRowCountByIndexRanges(ctx, coll, id, ranges)
+			cnt, corrCnt, err := getRowCountByIndexRanges(ctx, coll, id, ranges)
 			if err != nil {
 				return 0, nil, errors.Trace(err)
 			}
@@ -257,6 +276,7 @@ func Selectivity(
 				"Expressions", strs,
 				"Selectivity", set.Selectivity,
 				"partial cover", set.partCover,
+				"Accumulated Selectivity", ret,
 			)
 		}
 	}
@@ -358,7 +378,7 @@ OUTER:
 				cnfItems = append(cnfItems, cond)
 			}
 
-			curSelectivity, _, err := Selectivity(ctx, coll, cnfItems, nil)
+			curSelectivity, _, err := getSelectivity(ctx, coll, cnfItems, nil)
 			if err != nil {
 				logutil.BgLogger().Debug("something wrong happened, use the default selectivity", zap.Error(err))
 				curSelectivity = selectionFactor
@@ -386,6 +406,49 @@ OUTER:
 		}
 	}
 
+	// Try to cover remaining conditions with the expression stats, e.g. the conditions on DATE(col).
+	if len(coll.ExpressionStats) > 0 {
+		for i, expr := range remainedExprs {
+			if mask&(1<<uint64(i)) == 0 {
+				continue
+			}
+			if _, ok := notCoveredConstants[i]; ok {
+				continue
+			}
+			sel, ok := exprStatsSelectivity(ctx, coll, expr)
+			if !ok {
+				continue
+			}
+			ret *= sel
+			mask &^= 1 << uint64(i)
+			delete(notCoveredDNF, i)
+			delete(notCoveredStrMatch, i)
+			delete(notCoveredNegateStrMatch, i)
+			delete(notCoveredOtherExpr, i)
+			if sc.EnableOptimizerDebugTrace {
+				debugtrace.RecordAnyValuesWithNames(ctx, "Expression", remainedExprStrs[i], "Expression Stats Selectivity", sel, "Accumulated Selectivity", ret)
+			}
+		}
+	}
+
+	// Try to cover remaining IN lists by probing the constants against the TopN and histogram of the column.
+	for i, expr := range notCoveredOtherExpr {
+		scalarCond, ok := expr.(*expression.ScalarFunction)
+		if !ok {
+			continue
+		}
+		sel, ok := inListSelectivity(ctx, coll, scalarCond)
+		if !ok {
+			continue
+		}
+		ret *= sel
+		mask &^= 1 << uint64(i)
+		delete(notCoveredOtherExpr, i)
+		if sc.EnableOptimizerDebugTrace {
+			debugtrace.RecordAnyValuesWithNames(ctx, "Expression", remainedExprStrs[i], "IN List Selectivity", sel, "Accumulated Selectivity", ret)
+		}
+	}
+
 	// Try to cover remaining string matching functions by evaluating the expressions with TopN to estimate.
 	if ctx.GetSessionVars().EnableEvalTopNEstimationForStrMatch() {
 		for i, scalarCond := range notCoveredStrMatch {
@@ -400,7 +463,7 @@ OUTER:
 			mask &^= 1 << uint64(i)
 			delete(notCoveredStrMatch, i)
 			if sc.EnableOptimizerDebugTrace {
-				debugtrace.RecordAnyValuesWithNames(ctx, "Expression", remainedExprStrs[i], "Selectivity", sel)
+				debugtrace.RecordAnyValuesWithNames(ctx, "Expression", remainedExprStrs[i], "Selectivity", sel, "Accumulated Selectivity", ret)
 			}
 		}
 		for i, scalarCond := range notCoveredNegateStrMatch {
@@ -415,7 +478,23 @@ OUTER:
 			mask &^= 1 << uint64(i)
 			delete(notCoveredNegateStrMatch, i)
 			if sc.EnableOptimizerDebugTrace {
-				debugtrace.RecordAnyValuesWithNames(ctx, "Expression", remainedExprStrs[i], "Selectivity", sel)
+				debugtrace.RecordAnyValuesWithNames(ctx, "Expression", remainedExprStrs[i], "Selectivity", sel, "Accumulated Selectivity", ret)
+			}
+		}
+	}
+
+	// Try to cover remaining string matching functions with the trigram statistics of the columns.
+	if len(coll.TrigramStats) > 0 {
+		for i, scalarCond := range notCoveredStrMatch {
+			sel, ok := trigramStrMatchSelectivity(ctx, coll, scalarCond)
+			if !ok {
+				continue
+			}
+			ret *= sel
+			mask &^= 1 << uint64(i)
+			delete(notCoveredStrMatch, i)
+			if sc.EnableOptimizerDebugTrace {
+				debugtrace.RecordAnyValuesWithNames(ctx, "Expression", remainedExprStrs[i], "Trigram Selectivity", sel, "Accumulated Selectivity", ret)
 			}
 		}
 	}
@@ -437,7 +516,7 @@ OUTER:
 		}
 		ret *= minSelectivity
 		if sc.EnableOptimizerDebugTrace {
-			debugtrace.RecordAnyValuesWithNames(ctx, "Default Selectivity", minSelectivity)
+			debugtrace.RecordAnyValuesWithNames(ctx, "Default Selectivity", minSelectivity, "Accumulated Selectivity", ret)
 		}
 	}
 
@@ -765,6 +844,27 @@ func findPrefixOfIndexByCol(ctx planctx.PlanContext, cols []*expression.Column,
 	return expression.FindPrefixOfIndex(cols, idxColIDs)
 }
 
+// recordPredicateSelectivity records the class of the selectivity of the predicates on the column covered by mask,
+// which is tracked with the column stats usage, see stmtctx.PointPredicate.
+func recordPredicateSelectivity(sctx planctx.PlanContext, tableID, colID, mask int64, ranges []*ranger.Range, selectivity float64) {
+	if mask == 0 {
+		return
+	}
+	sc := sctx.GetSessionVars().StmtCtx
+	tc := sc.TypeCtx()
+	class := stmtctx.PointPredicate
+	for _, ran := range ranges {
+		if !ran.IsPointNullable(tc) {
+			class = stmtctx.WideRangePredicate
+			if selectivity <= stmtctx.NarrowRangeSelectivity {
+				class = stmtctx.NarrowRangePredicate
+			}
+			break
+		}
+	}
+	sc.RecordPredicateSelectivity(model.TableItemID{TableID: tableID, ID: colID}, class)
+}
+
 func getMaskAndRanges(ctx planctx.PlanContext, exprs []expression.Expression, rangeType ranger.RangeType,
 	lengths []int, cachedPath *planutil.AccessPath, cols ...*expression.Column) (
 	mask int64, ranges []*ranger.Range, partCover bool, minAccessCondsForDNFCond int, err error) {
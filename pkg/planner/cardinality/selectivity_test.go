@@ -21,6 +21,7 @@ import (
 	"os"
 	"runtime/pprof"
 	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -45,6 +46,7 @@ import (
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/testkit/testdata"
 	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/codec"
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/mock"
@@ -1466,3 +1468,374 @@ func TestBuiltinInEstWithoutStats(t *testing.T) {
 		require.False(t, statsTbl.ColAndIdxExistenceMap.HasAnalyzed(col.ID, false))
 	}
 }
+
+func TestTrigramStrMatchEstimation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a varchar(64) collate utf8mb4_bin, b varchar(64) collate utf8mb4_general_ci, c int)")
+	values := make([]string, 0, 100)
+	for i := range 100 {
+		if i%10 == 0 {
+			values = append(values, fmt.Sprintf("('x%dneedle', 'x%dNEEDLE', %d)", i, i, i))
+		} else {
+			values = append(values, fmt.Sprintf("('value%d', 'value%d', %d)", i, i, i))
+		}
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("set @@session.tidb_enable_extended_stats = on")
+	tk.MustGetErrMsg("alter table t add stats_extended s0 trigram(a, b)", "Only support Trigram statistics type on 1 column")
+	tk.MustGetErrMsg("alter table t add stats_extended s0 trigram(c)", "Trigram statistics type is only supported on string columns, but column 'c' is not")
+	tk.MustExec("alter table t add stats_extended s1 trigram(a)")
+	tk.MustExec("alter table t add stats_extended s2 trigram(b)")
+	tk.MustExec("analyze table t all columns")
+
+	selectionEstRows := func(sql string) string {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Selection") {
+				return row[1].(string)
+			}
+		}
+		require.FailNow(t, "no selection in the plan", sql)
+		return ""
+	}
+	require.Equal(t, "10.00", selectionEstRows("select * from t where a like '%needle%'"))
+	require.Equal(t, "10.00", selectionEstRows("select * from t where a like '%need_e%'"))
+	require.Equal(t, "10.00", selectionEstRows("select * from t where a regexp 'needle'"))
+	require.Equal(t, "10.00", selectionEstRows("select * from t where regexp_like(a, 'needle')"))
+	// The trigrams are built from the collation keys, so the case-insensitive matching is also estimated.
+	require.Equal(t, "10.00", selectionEstRows("select * from t where b like '%needle%'"))
+	require.Equal(t, "0.50", selectionEstRows("select * from t where a like '%NEEDLE%'"))
+	// The patterns without any trigram fall back to the default selectivity.
+	require.Equal(t, "80.00", selectionEstRows("select * from t where a like '%ne%'"))
+
+	// The trigram statistics are only used when the extended stats are enabled.
+	tk.MustExec("set @@session.tidb_enable_extended_stats = off")
+	require.Equal(t, "80.00", selectionEstRows("select * from t where a like '%needle%'"))
+}
+
+func TestExpressionStatsEstimation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a datetime, b int, c int)")
+	values := make([]string, 0, 100)
+	for i := range 100 {
+		// 24 rows for each day and 10 rows for each value of b % 10.
+		values = append(values, fmt.Sprintf("('2025-01-01 00:00:00' + interval %d hour, %d, %d)", i, i, i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("insert into t values (null, null, null)")
+	tk.MustExec("set @@session.tidb_enable_extended_stats = on")
+	tk.MustGetErrMsg("alter table t add stats_extended s0 on (rand())", "Expression statistics type is not supported on the non-deterministic expressions")
+	tk.MustGetErrMsg("alter table t add stats_extended s0 on (a + b + c)", "Only support Expression statistics type on the expressions of 1 or 2 columns")
+	tk.MustExec("alter table t add stats_extended s1 on (date(a))")
+	tk.MustExec("alter table t add stats_extended s2 on (b % 10)")
+	tk.MustGetErrMsg("alter table t add stats_extended s3 on (DATE(a))", "extended statistics 's1' on the same expression already exists")
+	tk.MustExec("analyze table t all columns")
+	tk.MustQuery("show stats_extended where table_name = 't'").Sort().CheckAt([]int{2, 3, 4, 5}, testkit.RowsWithSep("|",
+		"s1|[a]|expression|date(`a`)",
+		"s2|[b]|expression|`b` % 10",
+	))
+
+	selectionEstRows := func(sql string) string {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Selection") {
+				return row[1].(string)
+			}
+		}
+		require.FailNow(t, "no selection in the plan", sql)
+		return ""
+	}
+	require.Equal(t, "24.00", selectionEstRows("select * from t where date(a) = '2025-01-01'"))
+	require.Equal(t, "28.00", selectionEstRows("select * from t where date(a) > '2025-01-03'"))
+	require.Equal(t, "1.00", selectionEstRows("select * from t where date(a) is null"))
+	require.Equal(t, "10.00", selectionEstRows("select * from t where b % 10 = 3"))
+	require.Equal(t, "30.00", selectionEstRows("select * from t where b % 10 in (1, 2, 3)"))
+	// The conditions referencing other columns can't be estimated by the expression stats.
+	require.Equal(t, "80.80", selectionEstRows("select * from t where b % 10 = c"))
+
+	// The expression stats are only used when the extended stats are enabled.
+	tk.MustExec("set @@session.tidb_enable_extended_stats = off")
+	require.Equal(t, "80.80", selectionEstRows("select * from t where date(a) = '2025-01-01'"))
+}
+
+func TestSchemaInformedPseudoEstimation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	fpName := "github.com/pingcap/tidb/pkg/statistics/handle/storage/mockRegionRowCount"
+	require.NoError(t, failpoint.Enable(fpName, `return(0)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable(fpName))
+	}()
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b enum('x', 'y', 'z') not null, c bool not null, d bool, e int, unique key ue(e))")
+
+	estRows := func(sql, op string) string {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), op) {
+				return row[1].(string)
+			}
+		}
+		require.FailNow(t, "no such operator in the plan", sql)
+		return ""
+	}
+	// The pseudo stats are not inferred from the schema by default.
+	require.Equal(t, "10.00", estRows("select * from t where b = 'x'", "Selection"))
+	require.Equal(t, "8000.00", estRows("select c, count(*) from t group by c", "HashAgg"))
+
+	tk.MustExec("set @@tidb_opt_schema_informed_pseudo_stats = on")
+	// The NDVs of the enum and the NOT NULL boolean-like columns are known from their definitions.
+	require.Equal(t, "10.00", estRows("select * from t where a = 1", "Selection"))
+	require.Equal(t, "3333.33", estRows("select * from t where b = 'x'", "Selection"))
+	require.Equal(t, "5000.00", estRows("select * from t where c = 1", "Selection"))
+	require.Equal(t, "10.00", estRows("select * from t where d = 1", "Selection"))
+	require.Equal(t, "3.00", estRows("select b, count(*) from t group by b", "HashAgg"))
+	require.Equal(t, "2.00", estRows("select c, count(*) from t group by c", "HashAgg"))
+	require.Equal(t, "10000.00", estRows("select e, count(*) from t use index() group by e", "HashAgg"))
+
+	// The row count of the table never analyzed is estimated by its regions.
+	require.NoError(t, failpoint.Enable(fpName, `return(5000)`))
+	tk.MustExec("create table t2(a int)")
+	require.Eventually(t, func() bool {
+		return estRows("select * from t2", "TableFullScan") == "5000.00"
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestOutOfRangeExtrapolation(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	h := dom.StatsHandle()
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ib(b))")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	insert := func(start, end int) {
+		values := make([]string, 0, end-start)
+		for i := start; i < end; i++ {
+			values = append(values, fmt.Sprintf("(%d, %d)", i, i))
+		}
+		tk.MustExec("insert into t values " + strings.Join(values, ","))
+	}
+	// The values increase with the row ids, like an auto-increment id.
+	insert(0, 1000)
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.MustExec("analyze table t all columns with 0 topn")
+	// Keep the duration the modifications are observed much longer than the one they are extrapolated.
+	time.Sleep(time.Second)
+	insert(1000, 2000)
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+
+	estRows := func(sql, op string) float64 {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), op) {
+				rows, err := strconv.ParseFloat(row[1].(string), 64)
+				require.NoError(t, err)
+				return rows
+			}
+		}
+		require.FailNow(t, "no such operator in the plan", sql)
+		return 0
+	}
+	tk.MustExec("set @@tidb_opt_out_of_range_extrapolation = on")
+	// The 1000 rows inserted since the analyze extend the data to around 2000.
+	for _, c := range []struct{ sql, op string }{
+		{"select * from t use index() where a >= 1000", "Selection"},
+		{"select * from t use index(ib) where b >= 1000", "IndexRangeScan"},
+	} {
+		rows := estRows(c.sql, c.op)
+		require.Truef(t, rows > 950 && rows < 1200, "%s: expected around 1000, got %v", c.sql, rows)
+		rows = estRows(strings.ReplaceAll(c.sql, "1000", "1500"), c.op)
+		require.Truef(t, rows > 450 && rows < 700, "%s: expected around 500, got %v", c.sql, rows)
+	}
+	// No data is extrapolated far beyond the inserted rows or below the lower bound of the increasing column.
+	require.Equal(t, float64(1), estRows("select * from t use index() where a > 3000", "Selection"))
+	require.Equal(t, float64(1), estRows("select * from t use index() where a < -1000", "Selection"))
+
+	tk.MustExec("set @@tidb_opt_out_of_range_extrapolation = off")
+	require.Less(t, estRows("select * from t use index() where a >= 1500", "Selection"), float64(450))
+}
+
+func TestJoinSketchEstimation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a bigint unsigned, b int)")
+	values1 := make([]string, 0, 100)
+	values2 := make([]string, 0, 100)
+	for i := range 100 {
+		values1 = append(values1, fmt.Sprintf("(%d, %d)", i, i))
+		// Only the half of the values of t1.a can be matched by t2.a.
+		values2 = append(values2, fmt.Sprintf("(%d, %d)", i+50, i))
+	}
+	tk.MustExec("insert into t1 values " + strings.Join(values1, ","))
+	tk.MustExec("insert into t2 values " + strings.Join(values2, ","))
+	tk.MustExec("set @@session.tidb_enable_extended_stats = on")
+	tk.MustGetErrMsg("alter table t1 add stats_extended s0 join_sketch(a, b)", "Only support Join Sketch statistics type on 1 column")
+	tk.MustExec("alter table t1 add stats_extended s1 join_sketch(a)")
+	tk.MustExec("alter table t2 add stats_extended s2 join_sketch(a)")
+	tk.MustExec("analyze table t1 all columns")
+	tk.MustExec("analyze table t2 all columns")
+
+	joinEstRows := func(sql string) string {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Join") {
+				return row[1].(string)
+			}
+		}
+		require.FailNow(t, "no join in the plan", sql)
+		return ""
+	}
+	sql := "select /*+ hash_join(t1, t2) */ * from t1 join t2 on t1.a = t2.a"
+	require.Equal(t, "50.00", joinEstRows(sql))
+	// The join size is scaled by the filters on both sides.
+	require.Equal(t, "25.00", joinEstRows("select /*+ hash_join(t1, t2) */ * from t1 join t2 on t1.a = t2.a where t1.b < 50"))
+	// The NDV-based formula is used on the multiple join keys.
+	require.Equal(t, "100.00", joinEstRows("select /*+ hash_join(t1, t2) */ * from t1 join t2 on t1.a = t2.a and t1.b = t2.b"))
+
+	// The sketches of the keys of the different types aren't comparable, e.g. 1.0 and 1.00 are hashed differently.
+	tk.MustExec("create table t3(a decimal(10, 1))")
+	tk.MustExec("create table t4(a decimal(10, 2))")
+	tk.MustExec("insert into t3 select a from t1")
+	tk.MustExec("insert into t4 select a from t1")
+	tk.MustExec("alter table t3 add stats_extended s3 join_sketch(a)")
+	tk.MustExec("alter table t4 add stats_extended s4 join_sketch(a)")
+	tk.MustExec("analyze table t3 all columns")
+	tk.MustExec("analyze table t4 all columns")
+	require.Equal(t, "100.00", joinEstRows("select /*+ hash_join(t3, t4) */ * from t3 join t4 on t3.a = t4.a"))
+
+	tk.MustExec("set @@session.tidb_enable_extended_stats = off")
+	require.Equal(t, "100.00", joinEstRows(sql))
+}
+
+func TestLargeInListEstimation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int)")
+	values := make([]string, 0, 1000)
+	for i := range 500 {
+		values = append(values, fmt.Sprintf("(%d, %d)", i%50, i))
+		// The value 1000 is skewed.
+		values = append(values, fmt.Sprintf("(1000, %d)", i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("analyze table t all columns")
+
+	inList := make([]string, 0, 20)
+	for i := range 20 {
+		// Only the half of the constants are in the table.
+		inList = append(inList, strconv.Itoa(i*5))
+	}
+	sql := "select * from t where a in (1000, " + strings.Join(inList, ", ") + ")"
+	getEstRows := func(sql string) string {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Selection") {
+				return row[1].(string)
+			}
+		}
+		require.FailNow(t, "no selection in the plan", sql)
+		return ""
+	}
+	require.Equal(t, "600.00", getEstRows(sql))
+	// The IN list can't be converted to ranges if it exceeds tidb_opt_range_max_size, the default selectivity is
+	// used unless tidb_opt_in_list_probe_estimation is on.
+	tk.MustExec("set @@tidb_opt_range_max_size = 1")
+	require.NotEqual(t, "600.00", getEstRows(sql))
+	// The constants are probed against the stats one by one instead.
+	tk.MustExec("set @@tidb_opt_in_list_probe_estimation = on")
+	require.Equal(t, "600.00", getEstRows(sql))
+
+	// The parameterized variants of the statement get the same estimation.
+	tk.MustExec("prepare st from 'select * from t where a in (?, ?, ?)'")
+	tk.MustExec("set @a = 1000, @b = 0, @c = 1")
+	tk.MustExec("execute st using @a, @b, @c")
+	tkProcess := tk.Session().ShowProcess()
+	tk.Session().SetSessionManager(&testkit.MockSessionManager{PS: []*util.ProcessInfo{tkProcess}})
+	rows := tk.MustQuery(fmt.Sprintf("explain for connection %d", tkProcess.ID)).Rows()
+	require.True(t, strings.HasPrefix(rows[1][0].(string), "└─Selection"))
+	require.Equal(t, "520.00", rows[1][1])
+}
+
+func TestJSONPathStatsEstimation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(j json)")
+	values := make([]string, 0, 100)
+	for i := range 100 {
+		// 10 rows for each value of $.a.b, and 20 rows for each tag.
+		values = append(values, fmt.Sprintf(`('{"a": {"b": %d}, "tags": [%d, %d]}')`, i%10, i%10, i%10+10))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("set @@session.tidb_enable_extended_stats = on")
+	tk.MustExec("alter table t add stats_extended s1 on (j->'$.a')")
+	tk.MustExec("alter table t add stats_extended s2 on (j->'$.tags')")
+	tk.MustExec("analyze table t all columns")
+
+	selection := func(sql string) (estRows, scanInfo string) {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Selection") {
+				estRows = row[1].(string)
+			}
+			if strings.Contains(row[0].(string), "Scan") {
+				scanInfo = row[4].(string)
+			}
+		}
+		return estRows, scanInfo
+	}
+	estRows, scanInfo := selection("select * from t where j->'$.a.b' = 3")
+	require.Equal(t, "10.00", estRows)
+	require.Contains(t, scanInfo, "json_path_stats:[json_extract(`j`, _utf8mb4'$.a')]")
+	estRows, scanInfo = selection("select * from t where 13 member of (j->'$.tags')")
+	require.Equal(t, "10.00", estRows)
+	require.Contains(t, scanInfo, "json_path_stats:[json_extract(`j`, _utf8mb4'$.tags')]")
+	estRows, _ = selection("select * from t where json_contains(j->'$.tags', '[1, 11]')")
+	require.Equal(t, "10.00", estRows)
+	estRows, _ = selection("select * from t where json_contains(j, '2', '$.a.b')")
+	require.Equal(t, "10.00", estRows)
+	// The predicates without the path stats keep the pseudo selectivity.
+	estRows, scanInfo = selection("select * from t where j->'$.c' = 1")
+	require.Equal(t, "80.00", estRows)
+	require.NotContains(t, scanInfo, "json_path_stats")
+}
+
+func TestFKFanoutEstimation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table p(id int primary key, b int)")
+	tk.MustExec("create table c(id int primary key, pid int, foreign key fk_p(pid) references p(id))")
+	parents := make([]string, 0, 100)
+	children := make([]string, 0, 100)
+	for i := range 100 {
+		parents = append(parents, fmt.Sprintf("(%d, %d)", i, 99-i))
+		// Only the 5 parents are referenced by the children, 20 children for each of them.
+		children = append(children, fmt.Sprintf("(%d, %d)", i, i%5))
+	}
+	tk.MustExec("insert into p values " + strings.Join(parents, ","))
+	tk.MustExec("insert into c values " + strings.Join(children, ","))
+	tk.MustExec("analyze table p all columns")
+	tk.MustExec("analyze table c all columns")
+	tk.MustQuery("select parent_keys, avg_fanout, p50_fanout, max_fanout from mysql.stats_fk_fanout").Check(testkit.Rows("5 20 20 20"))
+
+	joinEstRows := func(sql string) string {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Join") {
+				return row[1].(string)
+			}
+		}
+		require.FailNow(t, "no join in the plan", sql)
+		return ""
+	}
+	sql := "select /*+ hash_join(p, c) */ * from p join c on p.id = c.pid where p.b < 50"
+	// The NDV-based formula assumes the 5 child keys are contained by the 50 remaining parent keys.
+	require.Equal(t, "100.00", joinEstRows(sql))
+	tk.MustExec("set @@session.tidb_opt_fk_fanout_estimation = on")
+	// Only the 5% parents are referenced, so the 50 remaining parents reference 50 children on average.
+	require.Equal(t, "50.00", joinEstRows(sql))
+	require.Equal(t, "50.00", joinEstRows("select /*+ hash_join(p, c) */ * from c join p on c.pid = p.id where p.b < 50"))
+	// The join on the column not referenced by the foreign key is estimated by the NDVs.
+	require.Equal(t, "100.00", joinEstRows("select /*+ hash_join(p, c) */ * from p join c on p.b = c.pid where p.b < 50"))
+}
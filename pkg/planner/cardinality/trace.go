@@ -20,6 +20,7 @@ import (
 	"errors"
 
 	perrors "github.com/pingcap/errors"
+	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
@@ -313,3 +314,60 @@ func debugTraceEndEstimateRange(
 	}
 	root.AppendStepWithNameToCurrentContext(traceInfo, "End estimate range")
 }
+
+/*
+ Below is debug trace for how the row count is derived from the stats.
+*/
+
+type debugTraceStatsDerivationType int8
+
+const (
+	debugTraceUnknownDerivation debugTraceStatsDerivationType = iota
+	debugTracePseudo
+	debugTraceTopNHit
+	debugTraceBucketRepeatHit
+	debugTraceUniformInHistogram
+	debugTraceAllNDVInTopN
+	debugTraceVer1CMSketch
+	debugTraceVer1Histogram
+	debugTraceOutOfRangeEqual
+	debugTraceOutOfRangeAdjustment
+)
+
+var statsDerivationTypeToString = map[debugTraceStatsDerivationType]string{
+	debugTraceUnknownDerivation:    "Unknown",
+	debugTracePseudo:               "Pseudo",
+	debugTraceTopNHit:              "TopN hit",
+	debugTraceBucketRepeatHit:      "Bucket repeat hit",
+	debugTraceUniformInHistogram:   "Uniform in histogram",
+	debugTraceAllNDVInTopN:         "All NDV in TopN",
+	debugTraceVer1CMSketch:         "CMSketch in ver1 stats",
+	debugTraceVer1Histogram:        "Histogram in ver1 stats",
+	debugTraceOutOfRangeEqual:      "Out of range point",
+	debugTraceOutOfRangeAdjustment: "Out of range adjustment",
+}
+
+func (d debugTraceStatsDerivationType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(statsDerivationTypeToString[d])
+}
+
+// debugTraceStatsDerivation records which part of the stats is used to derive the row count, together with the
+// intermediate values of the math. The vals arguments are in the same form as debugtrace.RecordAnyValuesWithNames.
+func debugTraceStatsDerivation(
+	s planctx.PlanContext,
+	derivation debugTraceStatsDerivationType,
+	vals ...any,
+) {
+	debugtrace.RecordAnyValuesWithNames(s, append([]any{"Stats Derivation", derivation}, vals...)...)
+}
+
+// debugTraceHistVersion returns the version of the histogram recorded as the stats version in the debug trace, i.e. the
+// version of the analyze or the stats update generating the histogram.
+func debugTraceHistVersion(hg *statistics.Histogram) uint64 {
+	failpoint.Inject("DebugTraceStableStatsVersion", func(val failpoint.Value) {
+		if val.(bool) {
+			failpoint.Return(uint64(440930000000000000))
+		}
+	})
+	return hg.LastUpdateVersion
+}
@@ -38,6 +38,7 @@ import (
 	statstestutil "github.com/pingcap/tidb/pkg/statistics/handle/ddl/testutil"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/testkit/testdata"
+	"github.com/pingcap/tidb/pkg/util/mock"
 	"github.com/pingcap/tidb/pkg/util/tracing"
 	"github.com/stretchr/testify/require"
 )
@@ -132,6 +133,11 @@ func TestTraceDebugSelectivity(t *testing.T) {
 	defer func() {
 		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/statistics/StabilizeV1AnalyzeTopN"))
 	}()
+	// 3. make the versions of the stats stable
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/planner/cardinality/DebugTraceStableStatsVersion", `return(true)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/planner/cardinality/DebugTraceStableStatsVersion"))
+	}()
 
 	tk.MustExec("use test")
 	tk.MustExec("drop table if exists t")
@@ -268,3 +274,38 @@ func TestTraceDebugSelectivity(t *testing.T) {
 		require.Equal(t, out[i].ResultForV1, res, sql, "For ver1")
 	}
 }
+
+func TestTraceDebugStatsVersion(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	h := dom.StatsHandle()
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ib(b))")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("analyze table t all columns")
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	tblInfo := dom.MustGetTableInfo(t, "test", "t")
+	statsTbl := h.GetTableStats(tblInfo)
+	col := statsTbl.GetCol(tblInfo.Columns[0].ID)
+	idx := statsTbl.GetIdx(tblInfo.Indices[0].ID)
+	require.True(t, col.IsFullLoad())
+	require.True(t, idx.IsFullLoad())
+
+	// The version of the histogram used by the estimation is recorded, rather than the version of the stats format.
+	sctx := mock.NewContext()
+	stmtCtx := sctx.GetSessionVars().StmtCtx
+	stmtCtx.EnableOptimizerDebugTrace = true
+	checkStatsVersion := func(expected uint64) {
+		traceInfo, err := json.Marshal(stmtCtx.OptimizerDebugTrace)
+		require.NoError(t, err)
+		require.Contains(t, string(traceInfo), fmt.Sprintf(`"Stats Version":%d`, expected))
+		stmtCtx.OptimizerDebugTrace = nil
+	}
+	_, err := cardinality.GetRowCountByColumnRanges(sctx, &statsTbl.HistColl, col.Info.ID, getRange(1, 2))
+	require.NoError(t, err)
+	checkStatsVersion(col.LastUpdateVersion)
+	_, _, err = cardinality.GetRowCountByIndexRanges(sctx, &statsTbl.HistColl, idx.Info.ID, getRange(1, 2))
+	require.NoError(t, err)
+	checkStatsVersion(idx.LastUpdateVersion)
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality
+
+import (
+	"regexp/syntax"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/planner/planctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/collate"
+)
+
+// trigramStrMatchSelectivity estimates the selectivity of the LIKE and REGEXP predicates by the trigram statistics
+// of the column. These predicates are usually with a leading wildcard, which can't be estimated by the ranges.
+func trigramStrMatchSelectivity(sctx planctx.PlanContext, coll *statistics.HistColl, expr *expression.ScalarFunction) (float64, bool) {
+	args := expr.GetArgs()
+	col, ok := args[0].(*expression.Column)
+	if !ok {
+		return 0, false
+	}
+	stats := coll.TrigramStats[col.UniqueID]
+	if stats == nil {
+		return 0, false
+	}
+	pattern, ok := args[1].(*expression.Constant)
+	if !ok || expression.MaybeOverOptimized4PlanCache(sctx.GetExprCtx(), []expression.Expression{pattern}) {
+		return 0, false
+	}
+	evalCtx := sctx.GetExprCtx().GetEvalCtx()
+	patternStr, isNull, err := pattern.EvalString(evalCtx, chunk.Row{})
+	if err != nil || isNull {
+		return 0, false
+	}
+	var substrs []string
+	switch expr.FuncName.L {
+	case ast.Like:
+		if len(args) != 3 {
+			return 0, false
+		}
+		escape, ok := args[2].(*expression.Constant)
+		if !ok {
+			return 0, false
+		}
+		escapeVal, isNull, err := escape.EvalInt(evalCtx, chunk.Row{})
+		if err != nil || isNull {
+			return 0, false
+		}
+		substrs = likePatternSubstrings(patternStr, rune(escapeVal))
+	case ast.Regexp, ast.RegexpLike:
+		// The match type argument of REGEXP_LIKE may change the semantics of the pattern.
+		if len(args) != 2 {
+			return 0, false
+		}
+		substrs = regexpRequiredSubstrings(patternStr)
+	default:
+		return 0, false
+	}
+	// The trigrams are built from the collation keys, see statistics.TrigramStats.
+	collator := collate.GetCollator(col.GetType(evalCtx).GetCollate())
+	keys := make([][]byte, 0, len(substrs))
+	for _, substr := range substrs {
+		keys = append(keys, collator.Key(substr))
+	}
+	selectivity, ok := stats.Selectivity(keys)
+	if !ok {
+		return 0, false
+	}
+	// The trigram statistics are built from the non-null values.
+	if colStats := coll.GetCol(col.UniqueID); colStats != nil && colStats.TotalRowCount() > 0 {
+		selectivity *= colStats.NotNullCount() / colStats.TotalRowCount()
+	}
+	return selectivity, true
+}
+
+// likePatternSubstrings splits the LIKE pattern by the wildcards and returns the literal parts.
+func likePatternSubstrings(pattern string, escape rune) []string {
+	var substrs []string
+	var sb strings.Builder
+	flush := func() {
+		if sb.Len() > 0 {
+			substrs = append(substrs, sb.String())
+			sb.Reset()
+		}
+	}
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == escape && i+1 < len(runes):
+			i++
+			sb.WriteRune(runes[i])
+		case r == '%' || r == '_':
+			flush()
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	flush()
+	return substrs
+}
+
+// regexpRequiredSubstrings returns the literals which must appear in the strings matching the regular expression.
+// Only the literals at the top level of the expression are collected, which is enough for the common patterns.
+func regexpRequiredSubstrings(pattern string) []string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	re = re.Simplify()
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+	var substrs []string
+	for _, sub := range subs {
+		if sub.Op == syntax.OpLiteral && sub.Flags&syntax.FoldCase == 0 {
+			substrs = append(substrs, string(sub.Rune))
+		}
+	}
+	return substrs
+}
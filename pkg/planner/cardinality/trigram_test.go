@@ -0,0 +1,35 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrMatchPatternSubstrings(t *testing.T) {
+	require.Equal(t, []string{"abc", "de"}, likePatternSubstrings("%abc_de%", '\\'))
+	require.Equal(t, []string{"a%bc"}, likePatternSubstrings(`%a\%bc`, '\\'))
+	require.Equal(t, []string{"a_b"}, likePatternSubstrings("a|_b%", '|'))
+	require.Nil(t, likePatternSubstrings("%%_", '\\'))
+
+	require.Equal(t, []string{"needle"}, regexpRequiredSubstrings("needle"))
+	require.Equal(t, []string{"abc", "xyz"}, regexpRequiredSubstrings("^abc.*xyz"))
+	require.Equal(t, []string{"abc"}, regexpRequiredSubstrings("abc(d|e)"))
+	require.Nil(t, regexpRequiredSubstrings("(?i)abc"))
+	require.Nil(t, regexpRequiredSubstrings("abc|xyz"))
+	require.Nil(t, regexpRequiredSubstrings("(abc"))
+}
@@ -341,3 +341,27 @@ func TestQBHintHandlerDuplicateObjects(t *testing.T) {
 	tk.MustQuery("EXPLAIN WITH t AS (SELECT /*+ inl_join(e) */ em.* FROM t_employees em JOIN t_employees e WHERE em.store_id = e.department_id) SELECT * FROM t;")
 	tk.MustQuery("show warnings").Check(testkit.Rows())
 }
+
+func TestStatsOverrideHints(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index ia(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 1), (3, 2), (4, 2), (5, 3), (6, 3), (7, 4), (8, 4)")
+	tk.MustExec("analyze table t")
+
+	tk.MustQuery("explain format = 'brief' select /*+ set_table_rows(t, 1e9) */ * from t").CheckAt([]int{1}, testkit.Rows("1000000000.00", "1000000000.00"))
+	// The estimations on the histograms are scaled to the overridden row count.
+	tk.MustQuery("explain format = 'brief' select /*+ set_table_rows(t, 800) */ a from t where a = 1").CheckAt([]int{1}, testkit.Rows("100.00", "100.00"))
+	tk.MustQuery("explain format = 'brief' select /*+ set_table_rows(t1, 100) */ * from t t1").CheckAt([]int{1}, testkit.Rows("100.00", "100.00"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustQuery("explain format = 'brief' select /*+ set_column_ndv(t.b, 2) */ b from t group by b").CheckAt([]int{1}, testkit.Rows("2.00", "2.00", "2.00", "8.00"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+
+	tk.MustQuery("explain format = 'brief' select /*+ set_table_rows(t2, 1) */ * from t")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 set_table_rows(test.t2, 1) is inapplicable, check whether the table(test.t2) exists"))
+	tk.MustQuery("explain format = 'brief' select /*+ set_column_ndv(t.c, 1) */ * from t")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 set_column_ndv(test.t.c, 1) is inapplicable, check whether the column(c) exists"))
+}
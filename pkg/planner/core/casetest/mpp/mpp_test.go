@@ -865,3 +865,55 @@ func TestIssue52828(t *testing.T) {
 	tk.MustQuery("explain SELECT MAX( OUTR . col_int ) AS X FROM C AS OUTR2 INNER JOIN B AS OUTR ON ( OUTR2 . col_decimal_not_null = OUTR . col_decimal_not_null AND OUTR2 . pk = OUTR . col_int_not_null ) " +
 		"WHERE OUTR . col_decimal_not_null IN ( SELECT INNR . col_int_not_null + 1 AS Y FROM DD AS INNR WHERE INNR . pk > INNR . pk OR INNR . col_varchar_10_not_null >= INNR . col_varchar_10 ) GROUP BY OUTR . col_datetime_not_null")
 }
+
+func TestMPPSkewedJoin(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int)")
+	tk.MustExec("insert into t1 values (1, 1), (1, 2), (1, 3), (1, 4), (1, 5), (1, 6), (2, 7), (3, 8)")
+	tk.MustExec("insert into t2 values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 7), (8, 8), (9, 9), (10, 10), (11, 11), (12, 12)")
+	tk.MustExec("analyze table t1 all columns")
+	tk.MustExec("analyze table t2 all columns")
+	testkit.SetTiFlashReplica(t, dom, "test", "t1")
+	testkit.SetTiFlashReplica(t, dom, "test", "t2")
+	tk.MustExec("set @@tidb_allow_mpp = 1")
+	tk.MustExec("set @@tidb_isolation_read_engines = 'tiflash'")
+
+	// broadcastedTable returns the table under the broadcast exchange and the explain info of the hash join.
+	broadcastedTable := func() (string, string) {
+		var table, joinInfo string
+		underBroadcast := false
+		for _, row := range tk.MustQuery("explain format = 'brief' select * from t1 join t2 on t1.a = t2.a").Rows() {
+			id, info := row[0].(string), row[4].(string)
+			if strings.Contains(id, "HashJoin") {
+				joinInfo = info
+			}
+			if strings.Contains(info, "ExchangeType: Broadcast") {
+				underBroadcast = true
+			}
+			if underBroadcast && strings.Contains(id, "TableFullScan") {
+				table = row[3].(string)
+				underBroadcast = false
+			}
+		}
+		return table, joinInfo
+	}
+
+	// The smaller child is broadcasted without the skew detection.
+	table, joinInfo := broadcastedTable()
+	require.Equal(t, "table:t1", table)
+	require.NotContains(t, joinInfo, "skew:")
+
+	// The most frequent value of t1.a accounts for 75% of the rows, so t2 is broadcasted and t1 stays where it is.
+	tk.MustExec("set @@tidb_opt_join_skew_threshold = 0.5")
+	table, joinInfo = broadcastedTable()
+	require.Equal(t, "table:t2", table)
+	require.Contains(t, joinInfo, "skew:left(0.75)")
+
+	tk.MustExec("set @@tidb_opt_join_skew_threshold = 0.8")
+	table, joinInfo = broadcastedTable()
+	require.Equal(t, "table:t1", table)
+	require.NotContains(t, joinInfo, "skew:")
+}
@@ -15,6 +15,7 @@
 package partition
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -267,3 +268,74 @@ func TestGeneratedColumnWithPartition(t *testing.T) {
 	tk.MustExec(`INSERT INTO tp (id, c1) VALUES (0, 1)`)
 	tk.MustExec(`select /*+ FORCE_INDEX(tp, idx) */id from tp where c2 = 2 group by id having id in (0)`)
 }
+
+func TestPartitionCardinalityEstimation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec(`create table t (a int, b int) partition by list (a) (
+    partition p0 values in (0, 1, 2),
+    partition p1 values in (3, 4, 5),
+    partition p2 values in (6, 7, 8))`)
+	tk.MustExec("insert into t values (0, 0), (1, 1), (2, 2), (3, 3), (6, 6)")
+	for range 5 {
+		tk.MustExec("insert into t select a, b from t where a < 3")
+	}
+	// Only analyze column b, so the range filter on a can't be estimated by the column stats.
+	tk.MustExec("analyze table t columns b")
+	tk.MustQuery("select count(*) from t partition (p1)").Check(testkit.Rows("1"))
+
+	getEstRows := func() string {
+		rows := tk.MustQuery("explain format='brief' select * from t where a > 2 and a < 6").Rows()
+		for _, row := range rows {
+			if strings.Contains(row[0].(string), "Selection") {
+				return row[1].(string)
+			}
+		}
+		return ""
+	}
+	require.NotEqual(t, "1.00", getEstRows())
+	tk.MustExec("set @@tidb_opt_partition_cardinality_estimation = on")
+	require.Equal(t, "1.00", getEstRows())
+	// The estimation can't be capped if some partitions don't have stats.
+	tk.MustExec("alter table t add partition (partition p3 values in (9, 10, 11))")
+	require.NotEqual(t, "1.00", getEstRows())
+}
+
+func TestPartitionColumnBoundsEstimation(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec(`create table t (a int, b int, c datetime) partition by list (a) (
+    partition p0 values in (0, 1, 2),
+    partition p1 values in (3, 4, 5),
+    partition p2 values in (6, 7, 8))`)
+	// Both b and c are correlated with the partition key a.
+	tk.MustExec(`insert into t values (0, 0, '2024-01-01'), (1, 10, '2024-01-02'), (2, 20, '2024-01-03'),
+    (3, 100, '2024-02-01'), (4, 110, '2024-02-02'), (5, 120, '2024-02-03'),
+    (6, 200, '2024-03-01'), (7, 210, '2024-03-02'), (8, 220, '2024-03-03')`)
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.MustExec("analyze table t all columns")
+
+	getEstRows := func() string {
+		rows := tk.MustQuery("explain format='brief' select * from t where b >= 100 and b < 150 and c >= '2024-03-01'").Rows()
+		for _, row := range rows {
+			if strings.Contains(row[0].(string), "Selection") {
+				return row[1].(string)
+			}
+		}
+		return ""
+	}
+	require.NotEqual(t, "0.00", getEstRows())
+	tk.MustExec("set @@tidb_opt_partition_cardinality_estimation = on")
+	// No partition contains the rows matching both the filters on b and c.
+	require.Equal(t, "0.00", getEstRows())
+	// The modified partitions are always touched since the new values might be out of the bounds.
+	tk.MustExec("insert into t values (4, 130, '2024-03-05')")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	require.NotEqual(t, "0.00", getEstRows())
+}
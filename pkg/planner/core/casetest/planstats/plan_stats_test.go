@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/planner"
 	plannercore "github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
@@ -486,3 +488,331 @@ func TestPartialStatsInExplain(t *testing.T) {
 		require.NoError(t, dom.StatsHandle().LoadNeededHistograms(dom.InfoSchema()))
 	}
 }
+
+func TestCardinalityFeedback(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	for i := range 10 {
+		tk.MustExec(fmt.Sprintf("insert into t values (%d, %d)", i, i))
+	}
+	for range 3 {
+		tk.MustExec("insert into t select * from t")
+	}
+	tk.MustExec("analyze table t all columns")
+	tk.RequireNoError(dom.StatsHandle().Update(context.Background(), dom.InfoSchema()))
+	tk.MustExec("set global tidb_cardinality_feedback_min_samples = 3")
+	defer func() {
+		tk.MustExec("set global tidb_enable_cardinality_feedback = default")
+		tk.MustExec("set global tidb_cardinality_feedback_min_samples = default")
+	}()
+
+	// The columns are fully correlated, so the estimation under the independence assumption is far too small.
+	query := "select * from t where a = 1 and b = 1"
+	checkCorrected := func(corrected bool) {
+		tk.MustQuery(query).Check(testkit.Rows(slices.Repeat([]string{"1 1"}, 8)...))
+		warnings := tk.MustQuery("show warnings").Rows()
+		if !corrected {
+			require.Len(t, warnings, 0)
+			return
+		}
+		require.Len(t, warnings, 1)
+		require.Contains(t, warnings[0][2], "The estimated row count of table t is corrected from 0.80 to 4.00")
+	}
+	// Nothing is collected if the feature is disabled.
+	for range 3 {
+		checkCorrected(false)
+	}
+	tk.MustExec("set global tidb_enable_cardinality_feedback = on")
+	for range 3 {
+		checkCorrected(false)
+	}
+	checkCorrected(true)
+	// The other statements are not affected.
+	tk.MustQuery("select * from t where a = 2 and b = 2 and 1 = 1").Check(testkit.Rows(slices.Repeat([]string{"2 2"}, 8)...))
+	require.Len(t, tk.MustQuery("show warnings").Rows(), 0)
+
+	// The output of the root Selection rather than the reader is compared with the estimation, if the conditions
+	// can't be pushed down.
+	tk.MustExec("insert into mysql.expr_pushdown_blacklist values('eq', 'tikv', 'for test')")
+	tk.MustExec("admin reload expr_pushdown_blacklist")
+	defer func() {
+		tk.MustExec("delete from mysql.expr_pushdown_blacklist where name = 'eq'")
+		tk.MustExec("admin reload expr_pushdown_blacklist")
+	}()
+	selQuery := "select a, b from t where a = 3 and b = 3"
+	tk.MustHavePlan(selQuery, "Selection")
+	for range 3 {
+		tk.MustQuery(selQuery).Check(testkit.Rows(slices.Repeat([]string{"3 3"}, 8)...))
+	}
+	tk.MustQuery(selQuery).Check(testkit.Rows(slices.Repeat([]string{"3 3"}, 8)...))
+	warnings := tk.MustQuery("show warnings").Rows()
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0][2], "The estimated row count of table t is corrected from 0.80 to 4.00")
+
+	// The kill switch disables the correction immediately.
+	tk.MustExec("set global tidb_enable_cardinality_feedback = off")
+	checkCorrected(false)
+}
+
+func TestDynamicSampling(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b varchar(10))")
+	tk.MustExec("create table t2 (a int, b varchar(10))")
+	values := make([]string, 0, 100)
+	for i := range 100 {
+		values = append(values, fmt.Sprintf("(%d, 'v%d')", i, i%10))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("insert into t2 values " + strings.Join(values, ","))
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+
+	selectionEstRows := func(sql string) string {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Selection") {
+				return row[1].(string)
+			}
+		}
+		require.FailNow(t, "no selection in the plan", sql)
+		return ""
+	}
+	// The pseudo stats are used by default.
+	require.Equal(t, "33.33", selectionEstRows("select * from t where a < 10"))
+
+	tk.MustExec("set @@session.tidb_opt_dynamic_sampling_rows = 1000")
+	require.Equal(t, "10.00", selectionEstRows("select * from t where a < 10"))
+	require.Equal(t, "10.00", selectionEstRows("select * from t where b = 'v3'"))
+	require.Equal(t, "1.00", selectionEstRows("select * from t where a < 10 and b = 'v3'"))
+	require.Equal(t, "0.50", selectionEstRows("select * from t where b = 'v10'"))
+	// The sample is cached in the stats cache.
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	statsTbl, ok := h.Get(tbl.Meta().ID)
+	require.True(t, ok)
+	require.NotNil(t, statsTbl.DynamicSample)
+	require.True(t, statsTbl.DynamicSample.Complete)
+	require.Equal(t, 100, statsTbl.DynamicSample.RowCount())
+	// The cached sample is outdated once the DML changes the stats meta, and the realtime count is kept.
+	tk.MustExec("insert into t select a % 10, b from t")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+	require.Equal(t, "110.00", selectionEstRows("select * from t where a < 10"))
+	statsTbl, ok = h.Get(tbl.Meta().ID)
+	require.True(t, ok)
+	require.Equal(t, 200, statsTbl.DynamicSample.RowCount())
+
+	// Only part of the rows are sampled randomly.
+	tk.MustExec("set @@session.tidb_opt_dynamic_sampling_rows = 50")
+	require.Equal(t, "100.00", selectionEstRows("select * from t2 where a < 100"))
+	tbl2, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t2"))
+	require.NoError(t, err)
+	statsTbl, ok = h.Get(tbl2.Meta().ID)
+	require.True(t, ok)
+	require.False(t, statsTbl.DynamicSample.Complete)
+	require.Equal(t, 50, statsTbl.DynamicSample.RowCount())
+	// The sampled rows are not the first ones of the table.
+	offset := statsTbl.DynamicSample.ColumnOffset(tbl2.Meta().Columns[0].ID)
+	maxA := int64(0)
+	for _, row := range statsTbl.DynamicSample.Rows {
+		maxA = max(maxA, row[offset].GetInt64())
+	}
+	require.Greater(t, maxA, int64(49))
+
+	// The table isn't sampled if the user can't read it directly, e.g. through a view with the definer's privileges.
+	tk.MustExec("create table t3 (a int, b varchar(10))")
+	tk.MustExec("insert into t3 values " + strings.Join(values, ","))
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+	tk.MustExec("create definer='root'@'%' sql security definer view v3 as select * from t3")
+	tk.MustExec("create user 'dynamic_sampling'@'%'")
+	tk.MustExec("grant select on test.v3 to 'dynamic_sampling'@'%'")
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "dynamic_sampling", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("set @@session.tidb_opt_dynamic_sampling_rows = 1000")
+	tk1.MustQuery("select count(*) from test.v3 where a < 10").Check(testkit.Rows("10"))
+	tbl3, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t3"))
+	require.NoError(t, err)
+	statsTbl, ok = h.Get(tbl3.Meta().ID)
+	require.True(t, ok)
+	require.Nil(t, statsTbl.DynamicSample)
+
+	// The dynamic sample isn't used once the table is analyzed.
+	tk.MustExec("analyze table t all columns")
+	require.Equal(t, "110.00", selectionEstRows("select * from t where a < 10"))
+	tk.MustExec("set @@session.tidb_opt_dynamic_sampling_rows = 0")
+	require.Equal(t, "33.33", selectionEstRows("select * from t2 where a < 10"))
+}
+
+func TestExplainStatsHealth(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, key ia(a))")
+	values := make([]string, 0, 100)
+	for i := range 100 {
+		values = append(values, fmt.Sprintf("(%d, %d)", i, i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+
+	scanInfo := func(sql string) string {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Scan") {
+				return row[4].(string)
+			}
+		}
+		require.FailNow(t, "no scan in the plan", sql)
+		return ""
+	}
+	// The stats health isn't shown by default.
+	require.NotContains(t, scanInfo("select * from t where b > 1"), "stats_health")
+
+	tk.MustExec("set @@session.tidb_explain_stats_health = on")
+	require.Contains(t, scanInfo("select * from t where b > 1"),
+		"stats_health:[healthy:unknown, last_analyze:never, estimation:pseudo]")
+
+	tk.MustExec("analyze table t all columns with 0 topn")
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+	info := scanInfo("select * from t use index(ia) where a < 5")
+	require.Regexp(t, `stats_health:\[healthy:100, last_analyze:\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}, estimation:normal\]`, info)
+	require.Contains(t, scanInfo("select * from t use index(ia) where a > 1000"), "estimation:out_of_range]")
+
+	// The healthy decreases after the table is modified.
+	tk.MustExec("delete from t where a < 50")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+	require.Contains(t, scanInfo("select * from t use index(ia) where a < 5"), "stats_health:[healthy:50, last_analyze:")
+}
+
+func TestRecompileCachedPlanOnStatsArrival(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int)")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3)")
+	h := dom.StatsHandle()
+	oriLease := h.Lease()
+	// The analyzed histograms are not loaded into the cache until they are needed.
+	h.SetLease(1)
+	defer func() {
+		h.SetLease(oriLease)
+	}()
+	tk.MustExec("analyze table t all columns")
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+	tk.MustExec("set @@session.tidb_stats_load_sync_wait = 0")
+
+	tk.MustExec("prepare st from 'select * from t where c > ?'")
+	tk.MustExec("set @v = 1")
+	tk.MustExec("execute st using @v")
+	tk.MustExec("execute st using @v")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+	// The plan built with the pseudo stats of t.c is rebuilt once the stats arrive.
+	require.NoError(t, h.LoadNeededHistograms(dom.InfoSchema()))
+	tk.MustExec("execute st using @v")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	tk.MustExec("execute st using @v")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+}
+
+func TestInvalidateCachedPlanOnStatsChange(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, key(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 7), (8, 8), (9, 9), (10, 10)")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.MustExec("analyze table t all columns")
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+	tk.MustExec("set @@session.tidb_plan_cache_invalidation_on_fresh_stats = off")
+	tk.MustExec("set @@session.tidb_plan_cache_stats_change_ratio = 0.5")
+
+	tk.MustExec("prepare st from 'select * from t where a > ?'")
+	tk.MustExec("set @v = 1")
+	tk.MustExec("execute st using @v")
+	tk.MustExec("execute st using @v")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+	// The plan is kept if the row count doesn't change much.
+	tk.MustExec("insert into t values (11, 11), (12, 12)")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+	tk.MustExec("execute st using @v")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+	// The plan is rebuilt once the row count changes by more than the ratio.
+	tk.MustExec("insert into t select a + 12, b + 12 from t")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+	tk.MustExec("execute st using @v")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	tk.MustExec("execute st using @v")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+	// The cached plans are kept if the ratio is 0.
+	tk.MustExec("set @@session.tidb_plan_cache_stats_change_ratio = 0")
+	tk.MustExec("insert into t select a + 24, b + 24 from t")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.RequireNoError(h.Update(context.Background(), dom.InfoSchema()))
+	tk.MustExec("execute st using @v")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+	// The plan built with the sync-loaded stats is kept.
+	tk.MustExec("set @@session.tidb_stats_load_sync_wait = default")
+	tk.MustExec("prepare st from 'select * from t where b > ?'")
+	tk.MustExec("execute st using @v")
+	tk.MustExec("execute st using @v")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk.MustExec("execute st using @v")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+}
+
+func TestExpressionIndexHiddenColumnStats(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a varchar(20), b int, key idx((lower(a))))")
+	values := make([]string, 0, 100)
+	for i := range 100 {
+		// Each of the 10 distinct values of lower(a) appears in both the upper and the lower cases.
+		a := fmt.Sprintf("v%d", i%10)
+		if i%20 < 10 {
+			a = strings.ToUpper(a)
+		}
+		values = append(values, fmt.Sprintf("('%s', %d)", a, i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("analyze table t")
+	aggEstRows := func(sql string) string {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Agg") {
+				return row[1].(string)
+			}
+		}
+		require.FailNow(t, "no aggregation in the plan", sql)
+		return ""
+	}
+	// The expression is rewritten to the hidden column of the expression index, which is estimated by its stats.
+	sql := "select lower(a), count(*) from t use index() group by lower(a)"
+	require.Equal(t, "10.00", aggEstRows(sql))
+
+	// The stats of the hidden column are missing, e.g. they were built by an older version.
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tblInfo := tbl.Meta()
+	hiddenCol := tblInfo.Columns[len(tblInfo.Columns)-1]
+	require.True(t, hiddenCol.Hidden)
+	tk.MustExec(fmt.Sprintf("delete from mysql.stats_histograms where table_id = %d and hist_id = %d and is_index = 0", tblInfo.ID, hiddenCol.ID))
+	h := dom.StatsHandle()
+	h.Clear()
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	// The NDV of the hidden column is estimated by the stats of the expression index instead of the pseudo one.
+	require.Equal(t, "10.00", aggEstRows(sql))
+}
@@ -126,6 +126,14 @@ type ChecksumTable struct {
 	Tables []*resolve.TableNameW
 }
 
+// AdminCheckStatsTable is used for validating the loaded stats of the tables, built from the
+// `admin check stats table` statement.
+type AdminCheckStatsTable struct {
+	baseSchemaProducer
+
+	Tables []*resolve.TableNameW
+}
+
 // CancelDDLJobs represents a cancel DDL jobs plan.
 type CancelDDLJobs struct {
 	baseSchemaProducer
@@ -575,6 +583,9 @@ type AnalyzeInfo struct {
 	TableID       statistics.AnalyzeTableID
 	StatsVersion  int
 	V2Options     *V2AnalyzeOptions
+	// Incremental is set by ANALYZE INCREMENTAL TABLE, which only samples the rows appended since the last analyze and
+	// merges their stats into the existing ones.
+	Incremental bool
 }
 
 // V2AnalyzeOptions is used to hold analyze options information.
@@ -605,13 +616,22 @@ type AnalyzeIndexTask struct {
 	AnalyzeInfo
 }
 
+// AnalyzeQuickTask is used for quick analyze, which derives the approximate stats of a physical table from the
+// properties of its regions without scanning the data.
+type AnalyzeQuickTask struct {
+	TblInfo *model.TableInfo
+	AnalyzeInfo
+}
+
 // Analyze represents an analyze plan
 type Analyze struct {
 	baseSchemaProducer
 
 	ColTasks []AnalyzeColumnsTask
 	IdxTasks []AnalyzeIndexTask
-	Opts     map[ast.AnalyzeOptionType]uint64
+	// QuickTasks is set for ANALYZE TABLE ... QUICK, in which case ColTasks and IdxTasks are empty.
+	QuickTasks []AnalyzeQuickTask
+	Opts       map[ast.AnalyzeOptionType]uint64
 	// OptionsMap is used to store the options for each partition.
 	OptionsMap map[int64]V2AnalyzeOptions
 }
@@ -673,14 +693,19 @@ type LoadStats struct {
 type LockStats struct {
 	baseSchemaProducer
 
-	Tables []*ast.TableName
+	Tables       []*ast.TableName
+	ColumnNames  []ast.CIStr
+	ExpireOption *ast.LockStatsExpireOption
+	Comment      string
 }
 
 // UnlockStats represents a unlock stats for table
 type UnlockStats struct {
 	baseSchemaProducer
 
-	Tables []*ast.TableName
+	Tables      []*ast.TableName
+	ColumnNames []ast.CIStr
+	Comment     string
 }
 
 // PlanReplayer represents a plan replayer plan.
@@ -0,0 +1,208 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cost
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// MinCalibrationSamples is the minimum number of samples to fit a calibration profile.
+const MinCalibrationSamples = 10
+
+// CalibrationSample is a measured execution used to fit the cost model coefficients, e.g. an analyze scan or the
+// average execution of a statement in the statement summary.
+type CalibrationSample struct {
+	// ScanRows is the number of rows scanned in the storage.
+	ScanRows float64
+	// LookupTasks is the number of the coprocessor requests sent to the storage.
+	LookupTasks float64
+	// NetRows is the number of rows transferred to TiDB.
+	NetRows float64
+	// Latency is the measured duration in nanoseconds.
+	Latency float64
+	// Weight is the weight of the sample in the fitting, e.g. the execution count of the statement.
+	Weight float64
+}
+
+// CalibrationProfile is the per-cluster cost model coefficients fitted from the measured executions.
+// The zero coefficients are not fitted and the corresponding factors of the cost model are kept.
+type CalibrationProfile struct {
+	// ScanNsPerRow is the measured duration to scan a row in the storage.
+	ScanNsPerRow float64 `json:"scan_ns_per_row"`
+	// LookupNsPerTask is the measured duration of a coprocessor request besides the scanned rows.
+	LookupNsPerTask float64 `json:"lookup_ns_per_task"`
+	// NetNsPerRow is the measured duration to transfer a row from the storage to TiDB.
+	NetNsPerRow float64 `json:"net_ns_per_row"`
+	// Samples is the number of samples the profile is fitted from.
+	Samples int `json:"samples"`
+	// UpdateTime is the time the profile is fitted.
+	UpdateTime time.Time `json:"update_time"`
+}
+
+// FitCalibrationProfile fits the coefficients of the calibration profile by the non-negative weighted least squares
+// of the latency over the scanned rows, the coprocessor requests and the transferred rows of the samples.
+// It returns nil if the samples are too few or can't determine any coefficient.
+func FitCalibrationProfile(samples []CalibrationSample) *CalibrationProfile {
+	if len(samples) < MinCalibrationSamples {
+		return nil
+	}
+	features := func(s *CalibrationSample) [3]float64 {
+		return [3]float64{s.ScanRows, s.LookupTasks, s.NetRows}
+	}
+	active := [3]bool{true, true, true}
+	for {
+		// Solve the normal equations over the active coefficients, and drop the most negative one until all the
+		// active coefficients are positive.
+		var idx []int
+		for i, ok := range active {
+			if ok {
+				idx = append(idx, i)
+			}
+		}
+		if len(idx) == 0 {
+			return nil
+		}
+		n := len(idx)
+		a := make([][]float64, n)
+		for i := range a {
+			a[i] = make([]float64, n+1)
+		}
+		for i := range samples {
+			s := &samples[i]
+			w := s.Weight
+			if w <= 0 {
+				w = 1
+			}
+			x := features(s)
+			for r := 0; r < n; r++ {
+				for c := 0; c < n; c++ {
+					a[r][c] += w * x[idx[r]] * x[idx[c]]
+				}
+				a[r][n] += w * x[idx[r]] * s.Latency
+			}
+		}
+		solution, ok := solveLinearEquations(a)
+		if !ok {
+			// The feature that never appears in the samples makes the equations singular.
+			dropped := false
+			for r := 0; r < n; r++ {
+				if a[r][r] == 0 {
+					active[idx[r]] = false
+					dropped = true
+				}
+			}
+			if !dropped {
+				return nil
+			}
+			continue
+		}
+		worst := -1
+		for r := 0; r < n; r++ {
+			if solution[r] <= 0 && (worst < 0 || solution[r] < solution[worst]) {
+				worst = r
+			}
+		}
+		if worst >= 0 {
+			active[idx[worst]] = false
+			continue
+		}
+		var coefficients [3]float64
+		for r := 0; r < n; r++ {
+			coefficients[idx[r]] = solution[r]
+		}
+		return &CalibrationProfile{
+			ScanNsPerRow:    coefficients[0],
+			LookupNsPerTask: coefficients[1],
+			NetNsPerRow:     coefficients[2],
+			Samples:         len(samples),
+			UpdateTime:      time.Now(),
+		}
+	}
+}
+
+// solveLinearEquations solves the linear equations in the augmented matrix by the Gaussian elimination.
+func solveLinearEquations(a [][]float64) ([]float64, bool) {
+	n := len(a)
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return nil, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		for r := col + 1; r < n; r++ {
+			f := a[r][col] / a[col][col]
+			for c := col; c <= n; c++ {
+				a[r][c] -= f * a[col][c]
+			}
+		}
+	}
+	res := make([]float64, n)
+	for r := n - 1; r >= 0; r-- {
+		v := a[r][n]
+		for c := r + 1; c < n; c++ {
+			v -= a[r][c] * res[c]
+		}
+		res[r] = v / a[r][r]
+	}
+	return res, true
+}
+
+// Multipliers returns the multipliers of the scan, lookup and net factors of the cost model given their default
+// costs of a row or a request. Only the relative costs are calibrated: the multipliers are normalized to a geometric
+// mean of 1, so the calibrated factors keep the scale of the other factors. The multiplier of a coefficient not
+// fitted is 1, and all the multipliers are 1 if less than 2 coefficients are fitted.
+func (p *CalibrationProfile) Multipliers(scanCost, lookupCost, netCost float64) (scan, lookup, net float64) {
+	ratios := [3]float64{1, 1, 1}
+	measured := [3]float64{p.ScanNsPerRow, p.LookupNsPerTask, p.NetNsPerRow}
+	defaults := [3]float64{scanCost, lookupCost, netCost}
+	var logSum float64
+	var fitted int
+	for i := range measured {
+		if measured[i] > 0 && defaults[i] > 0 {
+			ratios[i] = measured[i] / defaults[i]
+			logSum += math.Log(ratios[i])
+			fitted++
+		}
+	}
+	if fitted < 2 {
+		return 1, 1, 1
+	}
+	mean := math.Exp(logSum / float64(fitted))
+	for i := range measured {
+		if measured[i] > 0 && defaults[i] > 0 {
+			ratios[i] /= mean
+		}
+	}
+	return ratios[0], ratios[1], ratios[2]
+}
+
+var calibrationProfile atomic.Pointer[CalibrationProfile]
+
+// SetCalibrationProfile sets the calibration profile of the cluster loaded in this instance.
+func SetCalibrationProfile(p *CalibrationProfile) {
+	calibrationProfile.Store(p)
+}
+
+// GetCalibrationProfile returns the calibration profile of the cluster loaded in this instance, nil if none.
+func GetCalibrationProfile() *CalibrationProfile {
+	return calibrationProfile.Load()
+}
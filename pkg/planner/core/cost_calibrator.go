@@ -0,0 +1,64 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/planner/core/cost"
+	"github.com/pingcap/tidb/pkg/statistics/handle"
+	handletypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+)
+
+func init() {
+	handle.CostCalibrator = costCalibrator{}
+}
+
+// costCalibrator implements handletypes.CostCalibrator by the cost.CalibrationProfile.
+type costCalibrator struct{}
+
+// Fit implements handletypes.CostCalibrator.
+func (costCalibrator) Fit(samples []handletypes.CostCalibrationSample) ([]byte, error) {
+	costSamples := make([]cost.CalibrationSample, 0, len(samples))
+	for _, s := range samples {
+		costSamples = append(costSamples, cost.CalibrationSample(s))
+	}
+	profile := cost.FitCalibrationProfile(costSamples)
+	if profile == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(profile)
+	return data, errors.Trace(err)
+}
+
+// Apply implements handletypes.CostCalibrator.
+func (costCalibrator) Apply(data []byte) error {
+	profile := &cost.CalibrationProfile{}
+	if err := json.Unmarshal(data, profile); err != nil {
+		return errors.Trace(err)
+	}
+	cost.SetCalibrationProfile(profile)
+	return nil
+}
+
+// FittedAt implements handletypes.CostCalibrator.
+func (costCalibrator) FittedAt() time.Time {
+	if profile := cost.GetCalibrationProfile(); profile != nil {
+		return profile.UpdateTime
+	}
+	return time.Time{}
+}
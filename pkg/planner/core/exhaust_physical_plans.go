@@ -1758,11 +1758,68 @@ func isJoinChildFitMPPBCJ(p *logicalop.LogicalJoin, childIndexToBC int, mppStore
 	return rowBC*float64(mppStoreCnt) <= rowHash
 }
 
+// joinKeySkewRatio returns the ratio of the rows the most frequent value of the join key accounts for, which is
+// known from the TopN stats of the key column.
+func joinKeySkewRatio(child base.LogicalPlan, key *expression.Column) float64 {
+	histColl := child.StatsInfo().HistColl
+	if histColl == nil {
+		return 0
+	}
+	colStats := histColl.GetCol(key.UniqueID)
+	if colStats == nil || colStats.TopN == nil {
+		return 0
+	}
+	totalCount := colStats.TotalRowCount()
+	if totalCount <= 0 {
+		return 0
+	}
+	return float64(colStats.TopN.MaxCount()) / totalCount
+}
+
+// detectJoinSkew returns the skew ratios of the join keys of the two children, the ratio is 0 if the child is not
+// skewed. Only the joins on a single key are checked, since a skewed column doesn't imply skewed composite keys.
+func detectJoinSkew(p *logicalop.LogicalJoin) (ratios [2]float64) {
+	threshold := p.SCtx().GetSessionVars().JoinSkewThreshold
+	if threshold <= 0 || len(p.EqualConditions) != 1 {
+		return
+	}
+	leftKeys, rightKeys, _, _ := p.GetJoinKeys()
+	for i, key := range []*expression.Column{leftKeys[0], rightKeys[0]} {
+		if ratio := joinKeySkewRatio(p.Children()[i], key); ratio >= threshold {
+			ratios[i] = ratio
+		}
+	}
+	return
+}
+
+// skewedChildToBroadcast returns the child to broadcast to avoid shuffling the skewed child of the join, or -1 if
+// there is no such child. A shuffle join sends all the rows of the skewed value to the same node, while a broadcast
+// join leaves the skewed child where it is.
+func skewedChildToBroadcast(p *logicalop.LogicalJoin) int {
+	ratios := detectJoinSkew(p)
+	if (ratios[0] > 0) == (ratios[1] > 0) {
+		return -1
+	}
+	bcIdx := 0
+	if ratios[0] > 0 {
+		bcIdx = 1
+	}
+	onlyBCChild1 := p.JoinType == logicalop.LeftOuterJoin || p.JoinType == logicalop.SemiJoin || p.JoinType == logicalop.AntiSemiJoin
+	onlyBCChild0 := p.JoinType == logicalop.RightOuterJoin
+	if (onlyBCChild1 && bcIdx != 1) || (onlyBCChild0 && bcIdx != 0) || !checkChildFitBC(p.Children()[bcIdx]) {
+		return -1
+	}
+	return bcIdx
+}
+
 // If we can use mpp broadcast join, that's our first choice.
 func preferMppBCJ(p *logicalop.LogicalJoin) bool {
 	if len(p.EqualConditions) == 0 && p.SCtx().GetSessionVars().AllowCartesianBCJ == 2 {
 		return true
 	}
+	if skewedChildToBroadcast(p) >= 0 {
+		return true
+	}
 
 	onlyCheckChild1 := p.JoinType == logicalop.LeftOuterJoin || p.JoinType == logicalop.SemiJoin || p.JoinType == logicalop.AntiSemiJoin
 	onlyCheckChild0 := p.JoinType == logicalop.RightOuterJoin
@@ -1893,6 +1950,12 @@ func tryToGetMppHashJoin(p *logicalop.LogicalJoin, prop *property.PhysicalProper
 		if p.Children()[0].StatsInfo().Count() > p.Children()[1].StatsInfo().Count() {
 			preferredBuildIndex = 1
 		}
+		if useBCJ {
+			// Broadcast the non-skewed child so that the skewed child is not shuffled.
+			if bcIdx := skewedChildToBroadcast(p); bcIdx >= 0 {
+				preferredBuildIndex = bcIdx
+			}
+		}
 	} else if p.JoinType.IsSemiJoin() {
 		if !useBCJ && !p.IsNAAJ() && len(p.EqualConditions) > 0 && (p.JoinType == logicalop.SemiJoin || p.JoinType == logicalop.AntiSemiJoin) {
 			// TiFlash only supports Non-null_aware non-cross semi/anti_semi join to use both sides as build side
@@ -2009,6 +2072,8 @@ func tryToGetMppHashJoin(p *logicalop.LogicalJoin, prop *property.PhysicalProper
 		mppShuffleJoin:    !useBCJ,
 		// Mpp Join has quite heavy cost. Even limit might not suspend it in time, so we don't scale the count.
 	}.Init(p.SCtx(), p.StatsInfo(), p.QueryBlockOffset(), childrenProps...)
+	skewRatios := detectJoinSkew(p)
+	join.leftSkewRatio, join.rightSkewRatio = skewRatios[0], skewRatios[1]
 	join.SetSchema(p.Schema())
 	return []base.PhysicalPlan{join}
 }
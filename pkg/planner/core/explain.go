@@ -127,6 +127,14 @@ func (p *PhysicalIndexScan) OperatorInfo(normalized bool) string {
 				buffer.WriteString(", ")
 				buffer.WriteString(str)
 			}
+			if str := p.usedStatsInfo.FormatJSONPathStatsForExplain(); len(str) > 0 {
+				buffer.WriteString(", ")
+				buffer.WriteString(str)
+			}
+			if sessVars := p.SCtx().GetSessionVars(); sessVars.ExplainStatsHealth {
+				buffer.WriteString(", ")
+				buffer.WriteString(p.usedStatsInfo.FormatStatsHealthForExplain(sessVars.Location()))
+			}
 		} else if p.StatsInfo().StatsVersion == statistics.PseudoVersion {
 			// This branch is not needed in fact, we add this to prevent test result changes under planner/cascades/
 			buffer.WriteString(", stats:pseudo")
@@ -254,6 +262,14 @@ func (p *PhysicalTableScan) OperatorInfo(normalized bool) string {
 				buffer.WriteString(", ")
 				buffer.WriteString(str)
 			}
+			if str := p.usedStatsInfo.FormatJSONPathStatsForExplain(); len(str) > 0 {
+				buffer.WriteString(", ")
+				buffer.WriteString(str)
+			}
+			if sessVars := p.SCtx().GetSessionVars(); sessVars.ExplainStatsHealth {
+				buffer.WriteString(", ")
+				buffer.WriteString(p.usedStatsInfo.FormatStatsHealthForExplain(sessVars.Location()))
+			}
 		} else if p.StatsInfo().StatsVersion == statistics.PseudoVersion {
 			// This branch is not needed in fact, we add this to prevent test result changes under planner/cascades/
 			buffer.WriteString(", stats:pseudo")
@@ -715,6 +731,24 @@ func (p *PhysicalHashJoin) explainInfo(normalized bool) string {
 	if p.TiFlashFineGrainedShuffleStreamCount > 0 {
 		fmt.Fprintf(buffer, ", stream_count: %d", p.TiFlashFineGrainedShuffleStreamCount)
 	}
+	if p.leftSkewRatio > 0 || p.rightSkewRatio > 0 {
+		skewedSides := make([]string, 0, 2)
+		for i, ratio := range []float64{p.leftSkewRatio, p.rightSkewRatio} {
+			if ratio == 0 {
+				continue
+			}
+			side := "left"
+			if i == 1 {
+				side = "right"
+			}
+			if !normalized {
+				side = fmt.Sprintf("%s(%.2f)", side, ratio)
+			}
+			skewedSides = append(skewedSides, side)
+		}
+		buffer.WriteString(", skew:")
+		buffer.WriteString(strings.Join(skewedSides, " "))
+	}
 
 	// for runtime filter
 	if len(p.runtimeFilterList) > 0 {
@@ -115,7 +115,7 @@ func findBestTask4LogicalShow(lp base.LogicalPlan, prop *property.PhysicalProper
 	if !prop.IsSortItemEmpty() || planCounter.Empty() {
 		return base.InvalidTask, 0, nil
 	}
-	pShow := PhysicalShow{ShowContents: p.ShowContents, Extractor: p.Extractor}.Init(p.SCtx())
+	pShow := PhysicalShow{ShowContents: p.ShowContents, Extractor: p.Extractor, StatsExtractor: p.StatsExtractor}.Init(p.SCtx())
 	pShow.SetSchema(p.Schema())
 	planCounter.Dec(1)
 	rt := &RootTask{}
@@ -59,6 +59,8 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/handle"
+	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/table/tables"
 	"github.com/pingcap/tidb/pkg/table/temptable"
@@ -4054,6 +4056,45 @@ func addExtraPhysTblIDColumn4DS(ds *logicalop.DataSource) *expression.Column {
 	return pidCol
 }
 
+// getStatsTableAtSnapshot gets the historical stats of the physical table at the timestamp specified by the
+// STATS_SNAPSHOT_TS hint or tidb_opt_stats_snapshot_ts, or at the read timestamp of the stale read if
+// tidb_opt_stale_read_historical_stats is on. It returns nil if no timestamp is specified or there are no historical
+// stats of the table at it, then the latest stats are used.
+func getStatsTableAtSnapshot(ctx base.PlanContext, tblInfo *model.TableInfo, physicalID int64) *statistics.Table {
+	sessVars := ctx.GetSessionVars()
+	snapshot := sessVars.StatsSnapshotTS
+	if sessVars.StmtCtx.HasStatsSnapshotTS {
+		snapshot = sessVars.StmtCtx.StatsSnapshotTS
+	}
+	if snapshot == 0 && sessVars.StaleReadHistoricalStats {
+		snapshot = getStaleReadTS(sessVars)
+	}
+	if snapshot == 0 {
+		return nil
+	}
+	// The plan depends on the historical stats rather than the stats in the cache.
+	sessVars.StmtCtx.SetSkipPlanCache("the historical stats are used")
+	statsTbl, err := domain.GetDomain(ctx).StatsHandle().GetStatsAtSnapshot(tblInfo, physicalID, snapshot)
+	if err != nil {
+		sessVars.StmtCtx.AppendWarning(err)
+		return nil
+	}
+	if statsTbl == nil {
+		sessVars.StmtCtx.AppendWarning(errors.NewNoStackErrorf("no historical stats of table %s at %d, use the latest stats", tblInfo.Name.O, snapshot))
+	}
+	return statsTbl
+}
+
+// getStaleReadTS returns the read timestamp of the statement if it reads the historical data, i.e. the stale read by
+// AS OF TIMESTAMP, tidb_read_staleness or a stale read transaction, and the read by tidb_snapshot. It returns 0 for the
+// statement reading the latest data.
+func getStaleReadTS(sessVars *variable.SessionVars) uint64 {
+	if txnCtx := sessVars.TxnCtx; txnCtx != nil && (sessVars.StmtCtx.IsStaleness || txnCtx.IsStaleness) {
+		return txnCtx.StaleReadTs
+	}
+	return sessVars.SnapshotTS
+}
+
 // getStatsTable gets statistics information for a table specified by "tableID".
 // A pseudo statistics table is returned in any of the following scenario:
 // 1. tidb-server started and statistics handle has not been initialized.
@@ -4080,17 +4121,27 @@ func getStatsTable(ctx base.PlanContext, tblInfo *model.TableInfo, pid int64) *s
 			debugtrace.LeaveContextCommon(ctx)
 		}()
 	}
+	// The temporary tables are never analyzed, their stats are collected in the session.
+	if tblInfo.TempTableType != model.TempTableNone {
+		statsTbl = getTempTableStats(ctx, tblInfo)
+		return statsTbl
+	}
 	// 1. tidb-server started and statistics handle has not been initialized.
 	if statsHandle == nil {
 		return statistics.PseudoTable(tblInfo, false, true)
 	}
 
+	physicalID := pid
 	if pid == tblInfo.ID || ctx.GetSessionVars().StmtCtx.UseDynamicPartitionPrune() {
+		physicalID = tblInfo.ID
 		statsTbl = statsHandle.GetTableStats(tblInfo)
 	} else {
 		usePartitionStats = true
 		statsTbl = statsHandle.GetPartitionStats(tblInfo, pid)
 	}
+	if snapshotStatsTbl := getStatsTableAtSnapshot(ctx, tblInfo, physicalID); snapshotStatsTbl != nil {
+		statsTbl = snapshotStatsTbl
+	}
 	intest.Assert(statsTbl.ColAndIdxExistenceMap != nil, "The existence checking map must not be nil.")
 
 	allowPseudoTblTriggerLoading := false
@@ -4117,30 +4168,162 @@ func getStatsTable(ctx base.PlanContext, tblInfo *model.TableInfo, pid int64) *s
 		}
 	}
 
+	if ctx.GetSessionVars().ExtrapolateRowCountByAutoID && ctx.GetSessionVars().GetOptObjective() != vardef.OptObjectiveDeterminate {
+		statsTbl = extrapolateRowCountByAutoID(ctx, tblInfo, pid, statsTbl)
+	}
+
+	if len(ctx.GetSessionVars().InjectedStats) > 0 && physicalID == tblInfo.ID {
+		statsTbl = injectStats(ctx, tblInfo, statsTbl)
+	}
+
 	// 2. table row count from statistics is zero.
 	if statsTbl.RealtimeCount == 0 {
 		countIs0 = true
 		core_metrics.PseudoEstimationNotAvailable.Inc()
-		return statistics.PseudoTable(tblInfo, allowPseudoTblTriggerLoading, true)
+		statsHandle.RecordPseudoStatsUsage(tblInfo.ID)
+		pseudoTbl := statistics.PseudoTable(tblInfo, allowPseudoTblTriggerLoading, true)
+		if !statsTbl.IsAnalyzed() {
+			setRegionRowCount(ctx, statsHandle, tblInfo, pid, pseudoTbl)
+		}
+		return pseudoTbl
 	}
 
 	// 3. statistics is uninitialized or outdated.
+	noStatsMeta := statsTbl.Pseudo
 	pseudoStatsForUninitialized = !statsTbl.IsInitialized()
 	pseudoStatsForOutdated = ctx.GetSessionVars().GetEnablePseudoForOutdatedStats() && statsTbl.IsOutdated()
 	if pseudoStatsForUninitialized || pseudoStatsForOutdated {
 		tbl := *statsTbl
 		tbl.Pseudo = true
 		statsTbl = &tbl
+		if noStatsMeta {
+			setRegionRowCount(ctx, statsHandle, tblInfo, pid, statsTbl)
+		}
 		if pseudoStatsForUninitialized {
 			core_metrics.PseudoEstimationNotAvailable.Inc()
 		} else {
 			core_metrics.PseudoEstimationOutdate.Inc()
 		}
+		statsHandle.RecordPseudoStatsUsage(tblInfo.ID)
 	}
 
 	return statsTbl
 }
 
+// setRegionRowCount sets the row count of the pseudo stats of the table never analyzed to the one estimated by the
+// regions of the table, since the stats delta of the table may not be dumped yet. The partitioned table itself has
+// no region if the global stats are used. It's only done when tidb_opt_schema_informed_pseudo_stats is on.
+func setRegionRowCount(ctx base.PlanContext, statsHandle *handle.Handle, tblInfo *model.TableInfo, pid int64, pseudoTbl *statistics.Table) {
+	if !ctx.GetSessionVars().SchemaInformedPseudoStats || (pid == tblInfo.ID && tblInfo.GetPartitionInfo() != nil) {
+		return
+	}
+	if count, ok := statsHandle.GetRegionRowCount(pid); ok {
+		pseudoTbl.RealtimeCount = count
+	}
+}
+
+// extrapolateRowCountByAutoID adds the auto IDs allocated in this instance since the stats meta was loaded to the
+// row count. The row count in the stats meta only includes the dumped stats delta, which may lag far behind after
+// bulk inserts, while each inserted row allocates an auto ID, so the allocation progress is an independent signal
+// of the inserted rows. Only the non-partitioned tables are handled since the auto IDs are allocated per table.
+func extrapolateRowCountByAutoID(ctx base.PlanContext, tblInfo *model.TableInfo, pid int64, statsTbl *statistics.Table) *statistics.Table {
+	if statsTbl.AutoIDBase <= 0 || pid != tblInfo.ID || tblInfo.GetPartitionInfo() != nil {
+		return statsTbl
+	}
+	tbl, ok := domain.GetDomain(ctx).InfoSchema().TableByID(context.Background(), tblInfo.ID)
+	if !ok {
+		return statsTbl
+	}
+	allocated := handleutil.AutoIDBase(tbl) - statsTbl.AutoIDBase
+	if !tblInfo.ContainsAutoRandomBits() && tblInfo.GetAutoIncrementColInfo() != nil {
+		allocated /= max(int64(ctx.GetSessionVars().AutoIncrementIncrement), 1)
+	}
+	if allocated <= 0 {
+		return statsTbl
+	}
+	statsTbl = statsTbl.ShallowCopy()
+	statsTbl.RealtimeCount += allocated
+	statsTbl.ModifyCount += allocated
+	return statsTbl
+}
+
+// injectStats overlays the fake stats of the table set by tidb_inject_stats on a copy of its stats, which only
+// affects the optimization of the session. The plan isn't cached since it depends on the fake stats.
+func injectStats(ctx base.PlanContext, tblInfo *model.TableInfo, statsTbl *statistics.Table) *statistics.Table {
+	sessVars := ctx.GetSessionVars()
+	is, ok := ctx.GetInfoSchema().(infoschema.InfoSchema)
+	if !ok {
+		return statsTbl
+	}
+	db, ok := infoschema.SchemaByTable(is, tblInfo)
+	if !ok {
+		return statsTbl
+	}
+	injected, ok := sessVars.InjectedStats[db.Name.L+"."+tblInfo.Name.L]
+	if !ok {
+		return statsTbl
+	}
+	sessVars.StmtCtx.SetSkipPlanCache("the injected stats are used")
+	if len(injected.Columns) > 0 {
+		existenceMap := statsTbl.ColAndIdxExistenceMap
+		statsTbl = statsTbl.Copy()
+		statsTbl.ColAndIdxExistenceMap = existenceMap
+	} else {
+		statsTbl = statsTbl.ShallowCopy()
+	}
+	if injected.RowCount != nil {
+		statsTbl.RealtimeCount = *injected.RowCount
+	}
+	if injected.ModifyCount != nil {
+		statsTbl.ModifyCount = *injected.ModifyCount
+	}
+	for name, injectedCol := range injected.Columns {
+		colInfo := model.FindColumnInfo(tblInfo.Columns, name)
+		var col *statistics.Column
+		if colInfo != nil {
+			col = statsTbl.GetCol(colInfo.ID)
+		}
+		if col == nil {
+			sessVars.StmtCtx.AppendWarning(errors.NewNoStackErrorf("no stats of column %s of table %s to inject", name, tblInfo.Name.O))
+			continue
+		}
+		if injectedCol.NDV != nil {
+			col.Histogram.NDV = *injectedCol.NDV
+		}
+		if injectedCol.NullCount != nil {
+			col.Histogram.NullCount = *injectedCol.NullCount
+		}
+	}
+	return statsTbl
+}
+
+// getTempTableStats builds the stats of a temporary table from the stats collected when the rows are written
+// in the committed transactions of the session and in the current transaction.
+// A pseudo statistics table is returned if there is no row in the table.
+func getTempTableStats(ctx base.PlanContext, tblInfo *model.TableInfo) *statistics.Table {
+	sessVars := ctx.GetSessionVars()
+	stats := statistics.NewTempTableStats()
+	// The rows of the global temporary tables are cleared when the txn ends, so only the stats collected in
+	// the current txn are used.
+	if tblInfo.TempTableType == model.TempTableLocal && sessVars.TemporaryTableData != nil {
+		if sessionStats, ok := sessVars.TemporaryTableData.GetTableStats(tblInfo.ID).(*statistics.TempTableStats); ok {
+			stats.Merge(sessionStats)
+		}
+	}
+	sessVars.TxnCtxMu.Lock()
+	if tbl, ok := sessVars.TxnCtx.TemporaryTables[tblInfo.ID]; ok {
+		if txnStats, ok := tbl.GetStats().(*statistics.TempTableStats); ok {
+			stats.Merge(txnStats)
+		}
+	}
+	sessVars.TxnCtxMu.Unlock()
+	if stats.Count <= 0 {
+		core_metrics.PseudoEstimationNotAvailable.Inc()
+		return statistics.PseudoTable(tblInfo, false, true)
+	}
+	return stats.BuildTable(tblInfo)
+}
+
 // getLatestVersionFromStatsTable gets statistics information for a table specified by "tableID", and get the max
 // LastUpdateVersion among all Columns and Indices in it.
 // Its overall logic is quite similar to getStatsTable(). During plan cache matching, only the latest version is needed.
@@ -4606,6 +4789,7 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 		AstIndexHints:       tn.IndexHints,
 		IndexHints:          b.TableHints().IndexHintList,
 		IndexMergeHints:     indexMergeHints,
+		StatsOverrides:      b.TableHints().MatchStatsOverrides(dbName, tblName, b.getSelectOffset()),
 		PossibleAccessPaths: possiblePaths,
 		Columns:             make([]*model.ColumnInfo, 0, countCnt),
 		PartitionNames:      tn.PartitionNames,
@@ -4863,6 +5047,10 @@ func (b *PlanBuilder) buildMemTable(_ context.Context, dbName ast.CIStr, tableIn
 			p.Extractor = NewInfoSchemaSequenceExtractor()
 		case infoschema.TableTiDBIndexUsage:
 			p.Extractor = NewInfoSchemaTiDBIndexUsageExtractor()
+		case infoschema.TableStatsHealthSummary:
+			p.Extractor = NewInfoSchemaStatsHealthSummaryExtractor()
+		case infoschema.TableStatsCacheUsage:
+			p.Extractor = NewInfoSchemaStatsCacheUsageExtractor()
 		case infoschema.TableDDLJobs:
 			p.Extractor = NewInfoSchemaDDLExtractor()
 		case infoschema.TableCheckConstraints:
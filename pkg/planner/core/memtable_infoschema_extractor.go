@@ -389,6 +389,39 @@ func NewInfoSchemaViewsExtractor() *InfoSchemaViewsExtractor {
 	return e
 }
 
+// InfoSchemaStatsHealthSummaryExtractor is the predicate extractor for information_schema.stats_health_summary.
+type InfoSchemaStatsHealthSummaryExtractor struct {
+	InfoSchemaBaseExtractor
+}
+
+// NewInfoSchemaStatsHealthSummaryExtractor creates a new InfoSchemaStatsHealthSummaryExtractor.
+func NewInfoSchemaStatsHealthSummaryExtractor() *InfoSchemaStatsHealthSummaryExtractor {
+	e := &InfoSchemaStatsHealthSummaryExtractor{}
+	e.extractableColumns = extractableCols{
+		schema:  TableSchema,
+		table:   TableName,
+		tableID: TidbTableID,
+	}
+	e.colNames = []string{TableSchema, TableName, TidbTableID}
+	return e
+}
+
+// InfoSchemaStatsCacheUsageExtractor is the predicate extractor for information_schema.stats_cache_usage.
+type InfoSchemaStatsCacheUsageExtractor struct {
+	InfoSchemaBaseExtractor
+}
+
+// NewInfoSchemaStatsCacheUsageExtractor creates a new InfoSchemaStatsCacheUsageExtractor.
+func NewInfoSchemaStatsCacheUsageExtractor() *InfoSchemaStatsCacheUsageExtractor {
+	e := &InfoSchemaStatsCacheUsageExtractor{}
+	e.extractableColumns = extractableCols{
+		schema: TableSchema,
+		table:  TableName,
+	}
+	e.colNames = []string{TableSchema, TableName}
+	return e
+}
+
 // InfoSchemaKeyColumnUsageExtractor is the predicate extractor for information_schema.key_column_usage.
 type InfoSchemaKeyColumnUsageExtractor struct {
 	InfoSchemaBaseExtractor
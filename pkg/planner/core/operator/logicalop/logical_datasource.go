@@ -58,6 +58,8 @@ type DataSource struct {
 	TableAsName *ast.CIStr `hash64-equals:"true"`
 	// IndexMergeHints are the hint for indexmerge.
 	IndexMergeHints []h.HintedIndex
+	// StatsOverrides are the hints overriding the statistics of the table, e.g. set_table_rows.
+	StatsOverrides []h.HintedStats
 	// PushedDownConds are the conditions that will be pushed down to coprocessor.
 	PushedDownConds []expression.Expression `hash64-equals:"true"`
 	// AllConds contains all the filters on this table. For now it's maintained
@@ -115,6 +117,20 @@ type DataSource struct {
 
 	// AskedColumnGroup is upper asked column groups for maintained of group ndv from composite index.
 	AskedColumnGroup [][]*expression.Column
+
+	// UsedPartitions caches the partitions left after pruning by AllConds, which is used by the partition-level
+	// cardinality estimation under the dynamic prune mode.
+	UsedPartitions *UsedPartitions
+}
+
+// UsedPartitions is the result of the partition pruning by the conditions.
+type UsedPartitions struct {
+	// Conds are the conditions the partitions are pruned by, the result is outdated once they're changed.
+	Conds []expression.Expression
+	// PartitionIDs are the IDs of the partitions left after pruning, it's nil if the pruning fails.
+	PartitionIDs []int64
+	// Pruned is false if no partition is pruned.
+	Pruned bool
 }
 
 // Init initializes DataSource.
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/pkg/planner/property"
 	"github.com/pingcap/tidb/pkg/planner/util/optimizetrace"
 	"github.com/pingcap/tidb/pkg/planner/util/utilfuncp"
+	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
 	"github.com/pingcap/tidb/pkg/util/plancodec"
 	"github.com/pingcap/tidb/pkg/util/size"
 )
@@ -35,6 +36,8 @@ type LogicalShow struct {
 	ShowContents
 
 	Extractor base.ShowPredicateExtractor
+	// StatsExtractor extracts the predicates on the names into StatsFilter for the SHOW STATS_* statements.
+	StatsExtractor base.MemTablePredicateExtractor
 }
 
 // ShowContents stores the contents for the `SHOW` statement.
@@ -56,9 +59,13 @@ type ShowContents struct {
 	IfNotExists bool       // Used for `show create database if not exists`.
 	GlobalScope bool       // Used by show variables.
 	Extended    bool       // Used for `show extended columns from ...`
+	WithStats   bool       // Used for `show create table ... with stats`
+	RawStats    bool       // Used for `show stats_buckets|stats_topn raw`
 	Limit       *ast.Limit // Used for limit Result Set row number.
 
 	ImportJobID *int64 // Used for SHOW LOAD DATA JOB <jobID>
+
+	StatsFilter *handleutil.ShowStatsFilter // Used for pushing down the filter and the limit of SHOW STATS_*.
 }
 
 const emptyShowContentsSize = int64(unsafe.Sizeof(ShowContents{}))
@@ -84,7 +91,13 @@ func (p LogicalShow) Init(ctx base.PlanContext) *LogicalShow {
 
 // HashCode inherits BaseLogicalPlan.LogicalPlan.<0th> implementation.
 
-// PredicatePushDown inherits BaseLogicalPlan.LogicalPlan.<1st> implementation.
+// PredicatePushDown implements base.LogicalPlan.<1st> interface.
+func (p *LogicalShow) PredicatePushDown(predicates []expression.Expression, _ *optimizetrace.LogicalOptimizeOp) ([]expression.Expression, base.LogicalPlan) {
+	if p.StatsExtractor != nil {
+		predicates = p.StatsExtractor.Extract(p.SCtx(), p.Schema(), p.OutputNames(), predicates)
+	}
+	return predicates, p.Self()
+}
 
 // PruneColumns inherits BaseLogicalPlan.LogicalPlan.<2nd> implementation.
 
@@ -95,7 +108,18 @@ func (p *LogicalShow) FindBestTask(prop *property.PhysicalProperty, planCounter
 
 // BuildKeyInfo inherits BaseLogicalPlan.LogicalPlan.<4th> implementation.
 
-// PushDownTopN inherits BaseLogicalPlan.LogicalPlan.<5th> implementation.
+// PushDownTopN implements the base.LogicalPlan.<5th> interface.
+func (p *LogicalShow) PushDownTopN(topNLogicalPlan base.LogicalPlan, opt *optimizetrace.LogicalOptimizeOp) base.LogicalPlan {
+	if topNLogicalPlan == nil {
+		return p.Self()
+	}
+	topN := topNLogicalPlan.(*LogicalTopN)
+	// Only the limit without the order can be pushed down, since the rows are output in the order of the stats.
+	if p.StatsFilter != nil && topN.IsLimit() {
+		p.StatsFilter.Limit = topN.Offset + topN.Count
+	}
+	return topN.AttachChild(p.Self(), opt)
+}
 
 // DeriveTopN inherits BaseLogicalPlan.LogicalPlan.<6th> implementation.
 
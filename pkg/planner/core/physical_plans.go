@@ -1493,6 +1493,11 @@ type PhysicalHashJoin struct {
 	storeTp        kv.StoreType
 	mppShuffleJoin bool
 
+	// leftSkewRatio and rightSkewRatio record the ratio of the rows the most frequent value of the join key accounts for,
+	// it's 0 if the side is not detected as skewed from the TopN stats.
+	leftSkewRatio  float64
+	rightSkewRatio float64
+
 	// for runtime filter
 	runtimeFilterList []*RuntimeFilter `plan-cache-clone:"must-nil"` // plan with runtime filter is not cached
 }
@@ -1513,6 +1518,8 @@ func (p *PhysicalHashJoin) Clone(newCtx base.PlanContext) (base.PhysicalPlan, er
 	cloned.basePhysicalJoin = *base
 	cloned.Concurrency = p.Concurrency
 	cloned.UseOuterToBuild = p.UseOuterToBuild
+	cloned.leftSkewRatio = p.leftSkewRatio
+	cloned.rightSkewRatio = p.rightSkewRatio
 	for _, c := range p.EqualConditions {
 		cloned.EqualConditions = append(cloned.EqualConditions, c.Clone().(*expression.ScalarFunction))
 	}
@@ -1597,6 +1604,8 @@ func NewPhysicalHashJoin(p *logicalop.LogicalJoin, innerIdx int, useOuterToBuild
 		Concurrency:       uint(p.SCtx().GetSessionVars().HashJoinConcurrency()),
 		UseOuterToBuild:   useOuterToBuild,
 	}.Init(p.SCtx(), newStats, p.QueryBlockOffset(), prop...)
+	skewRatios := detectJoinSkew(p)
+	hashJoin.leftSkewRatio, hashJoin.rightSkewRatio = skewRatios[0], skewRatios[1]
 	return hashJoin
 }
 
@@ -2642,7 +2651,8 @@ type PhysicalShow struct {
 
 	logicalop.ShowContents
 
-	Extractor base.ShowPredicateExtractor
+	Extractor      base.ShowPredicateExtractor
+	StatsExtractor base.MemTablePredicateExtractor
 }
 
 // MemoryUsage return the memory usage of PhysicalShow
@@ -2651,7 +2661,7 @@ func (p *PhysicalShow) MemoryUsage() (sum int64) {
 		return
 	}
 
-	sum = p.physicalSchemaProducer.MemoryUsage() + p.ShowContents.MemoryUsage() + size.SizeOfInterface
+	sum = p.physicalSchemaProducer.MemoryUsage() + p.ShowContents.MemoryUsage() + size.SizeOfInterface*2
 	return
 }
 
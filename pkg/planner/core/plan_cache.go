@@ -299,6 +299,11 @@ func lookupPlanCache(ctx context.Context, sctx sessionctx.Context, cacheKey stri
 		return nil, nil, nil, false
 	}
 	pcv := v.(*PlanCacheValue)
+	if pcv.pendingStatsArrived(sctx.GetPlanCtx()) || pcv.statsChangedSubstantially(sctx) {
+		// The plan was built with the stale stats, e.g. some stats were still loading or the tables have grown a lot,
+		// rebuild it with the fresh stats.
+		return nil, nil, nil, false
+	}
 	sctx.GetSessionVars().PlanCacheValue = pcv
 	return pcv.Plan, pcv.OutputColumns, pcv.StmtHints, true
 }
@@ -88,6 +88,9 @@ func (pc *instancePlanCache) Get(key string, paramTypes any) (value any, ok bool
 
 func (pc *instancePlanCache) getPlanFromList(headNode *instancePCNode, paramTypes any) (any, bool) {
 	for node := headNode.next.Load(); node != nil; node = node.next.Load() {
+		if node.value.invalidated.Load() {
+			continue // the invalidated plan is to be replaced and evicted
+		}
 		if checkTypesCompatibility4PC(node.value.ParamTypes, paramTypes) { // v.Plan is read-only, no need to lock
 			if !pc.inEvict.Load() {
 				node.lastUsed.Store(time.Now()) // atomically update the lastUsed field
@@ -168,7 +171,7 @@ func (pc *instancePlanCache) Evict(evictAll bool) (detailInfo string, numEvicted
 	}
 	detailInfo = fmt.Sprintf("evict threshold: %v", threshold)
 	pc.foreach(func(prev, this *instancePCNode) bool { // step 3
-		if !this.lastUsed.Load().After(threshold) { // if lastUsed<=threshold, evict this value
+		if this.value.invalidated.Load() || !this.lastUsed.Load().After(threshold) { // if invalidated or lastUsed<=threshold, evict this value
 			if prev.next.CompareAndSwap(this, this.next.Load()) { // have to use CAS since
 				pc.totCost.Sub(this.value.MemoryUsage()) //  it might have been updated by other thread
 				pc.totPlan.Sub(1)
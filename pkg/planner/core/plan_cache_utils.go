@@ -27,6 +27,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+	"unsafe"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/bindinfo"
@@ -35,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
@@ -356,6 +358,9 @@ func NewPlanCacheKey(sctx sessionctx.Context, stmt *PlanCacheStmt) (key, binding
 	// this variable might affect the plan
 	hash = append(hash, bool2Byte(vars.ForeignKeyChecks))
 
+	// the plans optimized with the historical stats at different snapshots can't be shared
+	hash = codec.EncodeUint(hash, vars.StatsSnapshotTS)
+
 	// "limit ?" can affect the cached plan: "limit 1" and "limit 10000" should use different plans.
 	if len(stmt.limits) > 0 {
 		if !vars.EnablePlanCacheForParamLimit {
@@ -444,12 +449,20 @@ type PlanCacheValue struct {
 	ParamTypes       []*types.FieldType // all parameters' types, different parameters may share same plan
 	StmtHints        *hint.StmtHints    // related hints of this plan, like 'max_execution_time'.
 
+	// PendingStatsItems are the columns/indices whose stats were still loading when this plan was built, the plan is
+	// built with the pseudo stats of them and is invalidated once their stats arrive.
+	PendingStatsItems []model.StatsLoadItem
+	// TableRowCounts are the realtime row counts of the related tables when this plan was built, keyed by the table
+	// ID. They're only recorded when tidb_plan_cache_stats_change_ratio is on.
+	TableRowCounts map[int64]int64
+
 	// Runtime Info, all are READ-WRITE, use UpdateRuntimeInfo() and RuntimeInfo() to access them.
-	executions         int64 // the execution times.
-	processedKeys      int64 // the total number of processed keys in TiKV.
-	totalKeys          int64 // the total number of returned keys in TiKV.
-	sumLatency         int64 // the total latency of this plan, in nanoseconds.
-	lastUsedTimeInUnix int64 // the last time when this plan is used, in Unix timestamp.
+	executions         int64       // the execution times.
+	processedKeys      int64       // the total number of processed keys in TiKV.
+	totalKeys          int64       // the total number of returned keys in TiKV.
+	sumLatency         int64       // the total latency of this plan, in nanoseconds.
+	lastUsedTimeInUnix int64       // the last time when this plan is used, in Unix timestamp.
+	invalidated        atomic.Bool // whether this plan is invalidated since it was built with the stale stats.
 
 	testKey int64 // test-only
 }
@@ -467,6 +480,65 @@ func (v *PlanCacheValue) UpdateRuntimeInfo(proKeys, totKeys, latency int64) {
 	atomic.StoreInt64(&v.lastUsedTimeInUnix, time.Now().Unix())
 }
 
+// pendingStatsArrived returns whether the stats of any pending item have arrived, if so, the plan is invalidated and
+// should be rebuilt with the loaded stats.
+func (v *PlanCacheValue) pendingStatsArrived(sctx base.PlanContext) bool {
+	if len(v.PendingStatsItems) == 0 {
+		return false
+	}
+	if v.invalidated.Load() {
+		return true
+	}
+	if len(statsLoadNeededItems(sctx, v.PendingStatsItems)) < len(v.PendingStatsItems) {
+		v.invalidated.Store(true)
+		return true
+	}
+	return false
+}
+
+// statsChangedSubstantially returns whether the row count of any related table has changed by more than the ratio of
+// tidb_plan_cache_stats_change_ratio since this plan was built, if so, the plan is invalidated and should
+// be rebuilt with the fresh stats.
+func (v *PlanCacheValue) statsChangedSubstantially(sctx sessionctx.Context) bool {
+	ratio := sctx.GetSessionVars().PlanCacheStatsChangeRatio
+	if ratio <= 0 || len(v.TableRowCounts) == 0 {
+		return false
+	}
+	if v.invalidated.Load() {
+		return true
+	}
+	statsHandle := domain.GetDomain(sctx).StatsHandle()
+	if statsHandle == nil {
+		return false
+	}
+	for tableID, rowCount := range v.TableRowCounts {
+		statsTbl, ok := statsHandle.Get(tableID)
+		if !ok {
+			continue
+		}
+		if math.Abs(float64(statsTbl.RealtimeCount-rowCount)) > ratio*float64(max(rowCount, 1)) {
+			v.invalidated.Store(true)
+			return true
+		}
+	}
+	return false
+}
+
+// relatedTableRowCounts returns the realtime row counts of the tables in the stats cache, keyed by the table ID.
+func relatedTableRowCounts(sctx sessionctx.Context, tables []table.Table) map[int64]int64 {
+	statsHandle := domain.GetDomain(sctx).StatsHandle()
+	if statsHandle == nil || len(tables) == 0 {
+		return nil
+	}
+	rowCounts := make(map[int64]int64, len(tables))
+	for _, t := range tables {
+		if statsTbl, ok := statsHandle.Get(t.Meta().ID); ok {
+			rowCounts[t.Meta().ID] = statsTbl.RealtimeCount
+		}
+	}
+	return rowCounts
+}
+
 // RuntimeInfo returns the runtime information of the plan.
 func (v *PlanCacheValue) RuntimeInfo() (exec, procKeys, totKeys, sumLat int64, lastUsedTime time.Time) {
 	exec = atomic.LoadInt64(&v.executions)
@@ -511,6 +583,8 @@ func (v *PlanCacheValue) MemoryUsage() (sum int64) {
 	for _, name := range v.OutputColumns {
 		sum += name.MemoryUsage()
 	}
+	sum += size.SizeOfSlice + int64(cap(v.PendingStatsItems))*int64(unsafe.Sizeof(model.StatsLoadItem{}))
+	sum += size.SizeOfMap + int64(len(v.TableRowCounts))*size.SizeOfInt64*2
 	sum += int64(len(v.SQLDigest)) + int64(len(v.SQLText)) + int64(len(v.StmtType)) + int64(len(v.BinaryPlan)) +
 		int64(len(v.ParseUser)) + int64(len(v.Binding)) + int64(len(v.OptimizerEnvHash)) + int64(len(v.ParseValues))
 
@@ -578,6 +652,11 @@ func NewPlanCacheValue(
 		OutputColumns: names,
 		ParamTypes:    userParamTypes,
 		StmtHints:     stmtHints.Clone(),
+
+		PendingStatsItems: slices.Clone(sctx.GetSessionVars().StmtCtx.StatsLoad.PendingItems),
+	}
+	if sctx.GetSessionVars().PlanCacheStatsChangeRatio > 0 {
+		pcv.TableRowCounts = relatedTableRowCounts(sctx, stmt.tables)
 	}
 	pcv.MemoryUsage() // initialize the memory usage field
 	return pcv
@@ -17,6 +17,7 @@ package core
 import (
 	"fmt"
 	"math"
+	"slices"
 
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/expression/aggregation"
@@ -25,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/planner/cardinality"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/cost"
 	"github.com/pingcap/tidb/pkg/planner/property"
 	"github.com/pingcap/tidb/pkg/planner/util"
 	"github.com/pingcap/tidb/pkg/planner/util/costusage"
@@ -656,7 +658,13 @@ func (p *PhysicalIndexJoin) getIndexJoinCostVer2(taskType property.TaskType, opt
 		doubleReadCost = costusage.MulCostVer2(doubleReadCost, p.SCtx().GetSessionVars().IndexJoinDoubleReadPenaltyCostRate)
 	}
 
-	p.PlanCostVer2 = costusage.SumCostVer2(startCost, buildChildCost, buildFilterCost, buildTaskCost, costusage.DivCostVer2(costusage.SumCostVer2(doubleReadCost, probeCost, probeFilterCost, hashTableCost), probeConcurrency))
+	// The cost of the probe side grows with the build rows, it's penalized if the build rows may be underestimated.
+	probeSideCost := costusage.SumCostVer2(doubleReadCost, probeCost, probeFilterCost, hashTableCost)
+	if riskFactor := riskAwareCostFactor(build); riskFactor > 1 {
+		probeSideCost = costusage.MulCostVer2(probeSideCost, riskFactor)
+	}
+
+	p.PlanCostVer2 = costusage.SumCostVer2(startCost, buildChildCost, buildFilterCost, buildTaskCost, costusage.DivCostVer2(probeSideCost, probeConcurrency))
 	p.PlanCostInit = true
 	return p.PlanCostVer2, nil
 }
@@ -704,6 +712,10 @@ func (p *PhysicalApply) GetPlanCostVer2(taskType property.TaskType, option *opti
 		return costusage.ZeroCostVer2, err
 	}
 	probeCost := costusage.MulCostVer2(probeChildCost, buildRows)
+	if riskFactor := riskAwareCostFactor(p.Children()[0]); riskFactor > 1 {
+		probeCost = costusage.MulCostVer2(probeCost, riskFactor)
+		probeFilterCost = costusage.MulCostVer2(probeFilterCost, riskFactor)
+	}
 
 	p.PlanCostVer2 = costusage.SumCostVer2(buildChildCost, buildFilterCost, probeCost, probeFilterCost)
 	p.PlanCostInit = true
@@ -1061,9 +1073,9 @@ func getTaskScanFactorVer2(p base.PhysicalPlan, storeType kv.StoreType, taskType
 			desc = tableScan.Desc
 		}
 		if desc {
-			return defaultVer2Factors.TiKVDescScan
+			return calibrateFactorVer2(p, defaultVer2Factors.TiKVDescScan)
 		}
-		return defaultVer2Factors.TiKVScan
+		return calibrateFactorVer2(p, defaultVer2Factors.TiKVScan)
 	}
 }
 
@@ -1079,14 +1091,44 @@ func getTaskNetFactorVer2(p base.PhysicalPlan, _ property.TaskType) costusage.Co
 			return defaultVer2Factors.TiDB2FlashNet
 		}
 	}
-	return defaultVer2Factors.TiDB2KVNet
+	return calibrateFactorVer2(p, defaultVer2Factors.TiDB2KVNet)
 }
 
 func getTaskRequestFactorVer2(p base.PhysicalPlan, _ property.TaskType) costusage.CostVer2Factor {
 	if isTemporaryTable(getTableInfo(p)) {
 		return defaultVer2Factors.TiDBTemp
 	}
-	return defaultVer2Factors.TiDBRequest
+	return calibrateFactorVer2(p, defaultVer2Factors.TiDBRequest)
+}
+
+// calibrationRowSize is the row size assumed to compare the per-row durations in the calibration profile with the
+// per-byte factors.
+const calibrationRowSize = 64
+
+// calibrateFactorVer2 adjusts the TiKV scan, TiDB-to-TiKV network and request factors by the calibration profile of
+// the cluster if tidb_opt_use_cost_calibration is on. The profile only calibrates the relative costs of scanning,
+// transferring and requesting, see cost.CalibrationProfile.Multipliers.
+func calibrateFactorVer2(p base.PhysicalPlan, factor costusage.CostVer2Factor) costusage.CostVer2Factor {
+	if !p.SCtx().GetSessionVars().UseCostCalibration {
+		return factor
+	}
+	profile := cost.GetCalibrationProfile()
+	if profile == nil {
+		return factor
+	}
+	scan, lookup, net := profile.Multipliers(
+		defaultVer2Factors.TiKVScan.Value*math.Log2(calibrationRowSize),
+		defaultVer2Factors.TiDBRequest.Value,
+		defaultVer2Factors.TiDB2KVNet.Value*calibrationRowSize)
+	switch factor.Name {
+	case defaultVer2Factors.TiKVScan.Name, defaultVer2Factors.TiKVDescScan.Name:
+		factor.Value *= scan
+	case defaultVer2Factors.TiDBRequest.Name:
+		factor.Value *= lookup
+	case defaultVer2Factors.TiDB2KVNet.Name:
+		factor.Value *= net
+	}
+	return factor
 }
 
 func isTemporaryTable(tbl *model.TableInfo) bool {
@@ -1125,3 +1167,42 @@ func cols2Exprs(cols []*expression.Column) []expression.Expression {
 	}
 	return exprs
 }
+
+// riskAwareCostFactor returns the factor to multiply the costs driven by the rows of the input with, for the
+// risk-aware plan selection. It's larger than 1 only if the estimated row count of the input has low confidence.
+func riskAwareCostFactor(input base.PhysicalPlan) float64 {
+	factor := input.SCtx().GetSessionVars().RiskAwareCostFactor
+	if factor <= 1 || !hasLowConfidenceStats(input) {
+		return 1
+	}
+	return factor
+}
+
+// hasLowConfidenceStats returns whether the stats of the plan or any of its descendants have low confidence.
+func hasLowConfidenceStats(p base.PhysicalPlan) bool {
+	if stats := p.StatsInfo(); stats != nil && stats.LowConfidence {
+		return true
+	}
+	var children []base.PhysicalPlan
+	switch x := p.(type) {
+	case *PhysicalTableReader:
+		children = x.TablePlans
+	case *PhysicalIndexReader:
+		children = x.IndexPlans
+	case *PhysicalIndexLookUpReader:
+		children = append(slices.Clone(x.IndexPlans), x.TablePlans...)
+	case *PhysicalIndexMergeReader:
+		children = slices.Clone(x.TablePlans)
+		for _, partialPlans := range x.PartialPlans {
+			children = append(children, partialPlans...)
+		}
+	default:
+		children = p.Children()
+	}
+	for _, child := range children {
+		if hasLowConfidenceStats(child) {
+			return true
+		}
+	}
+	return false
+}
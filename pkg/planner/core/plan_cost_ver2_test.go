@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/tidb/pkg/planner"
 	"github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/cost"
 	"github.com/pingcap/tidb/pkg/planner/core/resolve"
 	"github.com/pingcap/tidb/pkg/planner/property"
 	"github.com/pingcap/tidb/pkg/planner/util/costusage"
@@ -202,3 +203,103 @@ func TestTableScanCostWithForce(t *testing.T) {
 	// Query costs should be equal since FORCE cost penalty does not apply to range scan
 	require.Equal(t, planCost1, planCost2)
 }
+
+func TestRiskAwareCostFactor(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int, b int)")
+	tk.MustExec("create table t2 (a int, b int, index ia(a))")
+	tk.MustExec("create table t3 (a int, b int)")
+	values := make([]string, 0, 1000)
+	for i := range 1000 {
+		values = append(values, fmt.Sprintf("(%d, %d)", i, i))
+	}
+	tk.MustExec("insert into t2 values " + strings.Join(values, ","))
+	tk.MustExec("insert into t3 values (1, 1), (2, 2)")
+	tk.MustExec("analyze table t2, t3 all columns")
+	tk.MustExec(`set @@tidb_cost_model_version=2`)
+
+	// The stats of t1 are pseudo, so the estimated row count of the build side has low confidence.
+	query := "select /*+ inl_join(t2) */ * from t1, t2 where t1.a = t2.a and t1.b = 1"
+	getCost := func(query string) float64 {
+		rs := tk.MustQuery("explain format=verbose " + query).Rows()
+		require.Contains(t, rs[0][0].(string), "IndexJoin")
+		cost, err := strconv.ParseFloat(rs[0][2].(string), 64)
+		require.NoError(t, err)
+		return cost
+	}
+	originCost := getCost(query)
+	tk.MustExec("set @@tidb_opt_risk_aware_cost_factor = 10")
+	penalizedCost := getCost(query)
+	require.Greater(t, penalizedCost, originCost)
+
+	// The risk-aware penalty makes the optimizer prefer the hash join which degrades gracefully.
+	tk.MustExec("set @@tidb_opt_risk_aware_cost_factor = 1")
+	tk.MustHavePlan("select * from t1, t2 where t1.a = t2.a and t1.b = 1", "IndexJoin")
+	tk.MustExec("set @@tidb_opt_risk_aware_cost_factor = 1000")
+	tk.MustNotHavePlan("select * from t1, t2 where t1.a = t2.a and t1.b = 1", "IndexJoin")
+
+	// The penalty doesn't apply if the stats of the build side are reliable.
+	query = "select /*+ inl_join(t2) */ * from t3, t2 where t3.a = t2.a and t3.b = 1"
+	tk.MustExec("set @@tidb_opt_risk_aware_cost_factor = 1")
+	originCost = getCost(query)
+	tk.MustExec("set @@tidb_opt_risk_aware_cost_factor = 10")
+	require.Equal(t, originCost, getCost(query))
+}
+
+func TestCostCalibration(t *testing.T) {
+	// The coefficients are recovered from the samples measured by them.
+	samples := make([]cost.CalibrationSample, 0, 20)
+	for i := range 20 {
+		s := cost.CalibrationSample{
+			ScanRows:    float64(1000 * (i + 1)),
+			LookupTasks: float64(i%3 + 1),
+			NetRows:     float64(10 * (i%5 + 1)),
+			Weight:      1,
+		}
+		s.Latency = 200*s.ScanRows + 1000000*s.LookupTasks + 50*s.NetRows
+		samples = append(samples, s)
+	}
+	require.Nil(t, cost.FitCalibrationProfile(samples[:cost.MinCalibrationSamples-1]))
+	profile := cost.FitCalibrationProfile(samples)
+	require.NotNil(t, profile)
+	require.InEpsilon(t, 200, profile.ScanNsPerRow, 1e-6)
+	require.InEpsilon(t, 1000000, profile.LookupNsPerTask, 1e-6)
+	require.InEpsilon(t, 50, profile.NetNsPerRow, 1e-6)
+	// The coefficient not appearing in the samples is not fitted.
+	for i := range samples {
+		samples[i].Latency -= 50 * samples[i].NetRows
+		samples[i].NetRows = 0
+	}
+	profile = cost.FitCalibrationProfile(samples)
+	require.NotNil(t, profile)
+	require.Zero(t, profile.NetNsPerRow)
+	scan, lookup, net := profile.Multipliers(200, 1000000, 1)
+	require.InEpsilon(t, 1, scan, 1e-6)
+	require.InEpsilon(t, 1, lookup, 1e-6)
+	require.Equal(t, 1.0, net)
+
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	defer cost.SetCalibrationProfile(nil)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("analyze table t all columns")
+	tk.MustExec(`set @@tidb_cost_model_version=2`)
+	getCost := func() float64 {
+		rs := tk.MustQuery("explain format=verbose select * from t").Rows()
+		planCost, err := strconv.ParseFloat(rs[0][2].(string), 64)
+		require.NoError(t, err)
+		return planCost
+	}
+	originCost := getCost()
+	// The scans measured in the cluster are relatively more expensive than the cost model assumes.
+	cost.SetCalibrationProfile(&cost.CalibrationProfile{ScanNsPerRow: 1000000, LookupNsPerTask: 1000000, NetNsPerRow: 1000})
+	require.Equal(t, originCost, getCost())
+	tk.MustExec("set @@tidb_opt_use_cost_calibration = 1")
+	require.Greater(t, getCost(), originCost)
+	tk.MustExec("set @@tidb_opt_use_cost_calibration = 0")
+	require.Equal(t, originCost, getCost())
+}
@@ -1510,6 +1510,14 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (base.P
 		p := &ChecksumTable{Tables: tnWs}
 		p.setSchemaAndNames(buildChecksumTableSchema())
 		ret = p
+	case ast.AdminCheckStatsTable:
+		tnWs := make([]*resolve.TableNameW, 0, len(as.Tables))
+		for _, tn := range as.Tables {
+			tnWs = append(tnWs, b.resolveCtx.GetTableName(tn))
+		}
+		p := &AdminCheckStatsTable{Tables: tnWs}
+		p.setSchemaAndNames(buildAdminCheckStatsTableSchema())
+		ret = p
 	case ast.AdminShowNextRowID:
 		p := &ShowNextRowID{TableName: as.Tables[0]}
 		p.setSchemaAndNames(buildShowNextRowID())
@@ -1584,7 +1592,7 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (base.P
 		return &Simple{Statement: as, ResolveCtx: b.resolveCtx}, nil
 	case ast.AdminFlushPlanCache:
 		return &Simple{Statement: as, ResolveCtx: b.resolveCtx}, nil
-	case ast.AdminSetBDRRole, ast.AdminUnsetBDRRole:
+	case ast.AdminSetBDRRole, ast.AdminUnsetBDRRole, ast.AdminEvictStats, ast.AdminFlushStatsDelta:
 		ret = &Simple{Statement: as, ResolveCtx: b.resolveCtx}
 	case ast.AdminShowBDRRole:
 		p := &AdminShowBDRRole{}
@@ -2237,9 +2245,39 @@ func (b *PlanBuilder) getColumnsBasedOnPredicateColumns(
 		return nil, err
 	}
 	colSet := combineColumnSets(predicate, mustAnalyzed)
+	// The virtual generated columns used in predicates get their stats built from the evaluated samples,
+	// so the columns making up them must be analyzed too.
+	addVirtualColumnDependences(tbl.TableInfo, colSet)
 	return getColumnListFromSet(tbl.TableInfo.Columns, colSet), nil
 }
 
+// addVirtualColumnDependences adds the columns which the virtual generated columns in the set depend on into the set.
+func addVirtualColumnDependences(tblInfo *model.TableInfo, colSet map[int64]struct{}) {
+	virtualCols := make([]*model.ColumnInfo, 0, len(colSet))
+	for _, col := range tblInfo.Columns {
+		if _, ok := colSet[col.ID]; ok && col.IsVirtualGenerated() {
+			virtualCols = append(virtualCols, col)
+		}
+	}
+	for len(virtualCols) > 0 {
+		col := virtualCols[len(virtualCols)-1]
+		virtualCols = virtualCols[:len(virtualCols)-1]
+		for depName := range col.Dependences {
+			depCol := model.FindColumnInfo(tblInfo.Columns, depName)
+			if depCol == nil {
+				continue
+			}
+			if _, ok := colSet[depCol.ID]; ok {
+				continue
+			}
+			colSet[depCol.ID] = struct{}{}
+			if depCol.IsVirtualGenerated() {
+				virtualCols = append(virtualCols, depCol)
+			}
+		}
+	}
+}
+
 // Helper function to combine two column sets.
 func combineColumnSets(sets ...map[int64]struct{}) map[int64]struct{} {
 	result := make(map[int64]struct{})
@@ -2428,6 +2466,37 @@ func checkIsAllSpecialGlobalIndex(as *ast.AnalyzeTableStmt, tbl *resolve.TableNa
 	return true, nil
 }
 
+// checkIncrementalAnalyzeTable checks that the handles of the table increase in the insert order, since the incremental
+// analyze only samples the rows with larger handles than the max one analyzed last time. The handles allocated in the
+// batches cached by each TiDB instance, the AUTO_RANDOM and the sharded row IDs don't, and neither do the integer primary
+// keys without AUTO_INCREMENT, whose values are given by the users.
+func checkIncrementalAnalyzeTable(tblInfo *model.TableInfo) error {
+	var reason string
+	switch {
+	case tblInfo.IsCommonHandle:
+		reason = "the clustered index"
+	case tblInfo.ContainsAutoRandomBits():
+		reason = "the AUTO_RANDOM primary key"
+	case tblInfo.PKIsHandle:
+		pkCol := tblInfo.GetPkColInfo()
+		if mysql.HasUnsignedFlag(pkCol.GetFlag()) {
+			reason = "the unsigned integer primary key"
+		} else if !mysql.HasAutoIncrementFlag(pkCol.GetFlag()) {
+			reason = "the integer primary key without AUTO_INCREMENT"
+		} else if !tblInfo.SepAutoInc() {
+			reason = "the AUTO_INCREMENT primary key cached by each TiDB instance"
+		}
+	case tblInfo.ShardRowIDBits > 0:
+		reason = "SHARD_ROW_ID_BITS"
+	case tblInfo.AutoIDCache != 1:
+		reason = "the _tidb_rowid cached by each TiDB instance"
+	}
+	if reason == "" {
+		return nil
+	}
+	return errors.Errorf("ANALYZE INCREMENTAL TABLE doesn't support the table %s with %s, whose handles may not increase in the insert order", tblInfo.Name.O, reason)
+}
+
 func (b *PlanBuilder) buildAnalyzeFullSamplingTask(
 	as *ast.AnalyzeTableStmt,
 	analyzePlan *Analyze,
@@ -2437,10 +2506,10 @@ func (b *PlanBuilder) buildAnalyzeFullSamplingTask(
 	version int,
 	persistOpts bool,
 ) error {
-	// Version 2 doesn't support incremental analyze.
-	// And incremental analyze will be deprecated in the future.
 	if as.Incremental {
-		b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError("The version 2 stats would ignore the INCREMENTAL keyword and do full sampling"))
+		if err := checkIncrementalAnalyzeTable(tbl.TableInfo); err != nil {
+			return err
+		}
 	}
 
 	isAnalyzeTable := len(as.PartitionNames) == 0
@@ -2499,6 +2568,7 @@ func (b *PlanBuilder) buildAnalyzeFullSamplingTask(
 				PartitionName: partitionNames[i],
 				TableID:       statistics.AnalyzeTableID{TableID: tbl.TableInfo.ID, PartitionID: id},
 				StatsVersion:  version,
+				Incremental:   as.Incremental,
 			}
 			if optsV2, ok := optionsMap[physicalID]; ok {
 				info.V2Options = &optsV2
@@ -2741,6 +2811,40 @@ func pickColumnList(astColChoice ast.ColumnChoice, astColList []*model.ColumnInf
 	return tblSavedColChoice, tblSavedColList
 }
 
+// buildAnalyzeQuick constructs quick analyze tasks for each physical table.
+func (b *PlanBuilder) buildAnalyzeQuick(as *ast.AnalyzeTableStmt, version int) (base.Plan, error) {
+	p := &Analyze{}
+	for _, tbl := range as.TableNames {
+		tnW := b.resolveCtx.GetTableName(tbl)
+		if tnW.TableInfo.IsView() {
+			return nil, errors.Errorf("analyze view %s is not supported now", tbl.Name.O)
+		}
+		if tnW.TableInfo.IsSequence() {
+			return nil, errors.Errorf("analyze sequence %s is not supported now", tbl.Name.O)
+		}
+		physicalIDs, partitionNames, err := GetPhysicalIDsAndPartitionNames(tnW.TableInfo, as.PartitionNames)
+		if err != nil {
+			return nil, err
+		}
+		for i, id := range physicalIDs {
+			if id == tnW.TableInfo.ID {
+				id = -1
+			}
+			p.QuickTasks = append(p.QuickTasks, AnalyzeQuickTask{
+				TblInfo: tnW.TableInfo,
+				AnalyzeInfo: AnalyzeInfo{
+					DBName:        tbl.Schema.O,
+					TableName:     tbl.Name.O,
+					PartitionName: partitionNames[i],
+					TableID:       statistics.AnalyzeTableID{TableID: tnW.TableInfo.ID, PartitionID: id},
+					StatsVersion:  version,
+				},
+			})
+		}
+	}
+	return p, nil
+}
+
 // buildAnalyzeTable constructs analyze tasks for each table.
 func (b *PlanBuilder) buildAnalyzeTable(as *ast.AnalyzeTableStmt, opts map[ast.AnalyzeOptionType]uint64, version int) (base.Plan, error) {
 	p := &Analyze{Opts: opts}
@@ -3109,13 +3213,19 @@ func (b *PlanBuilder) buildAnalyze(as *ast.AnalyzeTableStmt) (base.Plan, error)
 	if as.NoWriteToBinLog {
 		return nil, dbterror.ErrNotSupportedYet.GenWithStackByArgs("[NO_WRITE_TO_BINLOG | LOCAL]")
 	}
-	if as.Incremental {
+	if as.Incremental && as.IndexFlag {
 		return nil, errors.Errorf("the incremental analyze feature has already been removed in TiDB v7.5.0, so this will have no effect")
 	}
 	statsVersion := b.ctx.GetSessionVars().AnalyzeVersion
+	if as.Incremental && statsVersion != statistics.Version2 {
+		return nil, errors.Errorf("ANALYZE INCREMENTAL TABLE is only supported by the version 2 stats")
+	}
 	// Require INSERT and SELECT privilege for tables.
 	b.requireInsertAndSelectPriv(as.TableNames)
 
+	if as.Quick {
+		return b.buildAnalyzeQuick(as, statsVersion)
+	}
 	opts, err := handleAnalyzeOptions(as.AnalyzeOpts, statsVersion)
 	if err != nil {
 		return nil, err
@@ -3431,6 +3541,8 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (base.P
 			IfNotExists:           show.IfNotExists,
 			GlobalScope:           show.GlobalScope,
 			Extended:              show.Extended,
+			WithStats:             show.WithStats,
+			RawStats:              show.RawStats,
 			Limit:                 show.Limit,
 			ImportJobID:           show.ImportJobID,
 		},
@@ -3521,6 +3633,12 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (base.P
 	case ast.ShowReplicaStatus:
 		return nil, dbterror.ErrNotSupportedYet.GenWithStackByArgs("SHOW {REPLICA | SLAVE} STATUS")
 	}
+	switch show.Tp {
+	case ast.ShowStatsMeta, ast.ShowStatsHistograms, ast.ShowStatsBuckets, ast.ShowStatsTopN:
+		extractor := newShowStatsExtractor(show.Tp)
+		p.StatsExtractor = extractor
+		p.StatsFilter = extractor.Filter
+	}
 
 	schema, names := buildShowSchema(show, isView, isSequence)
 	p.SetSchema(schema)
@@ -3547,6 +3665,12 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (base.P
 			return nil, err
 		}
 	}
+	if show.OrderBy != nil {
+		np, err = b.buildSort(ctx, np, show.OrderBy.Items, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if show.Limit != nil {
 		np, err = b.buildLimit(np, show.Limit)
 		if err != nil {
@@ -4686,7 +4810,10 @@ func (*PlanBuilder) buildLoadStats(ld *ast.LoadStatsStmt) base.Plan {
 // buildLockStats requires INSERT and SELECT privilege for the tables same as buildAnalyze.
 func (b *PlanBuilder) buildLockStats(ld *ast.LockStatsStmt) base.Plan {
 	p := &LockStats{
-		Tables: ld.Tables,
+		Tables:       ld.Tables,
+		ColumnNames:  ld.ColumnNames,
+		ExpireOption: ld.ExpireOption,
+		Comment:      ld.Comment,
 	}
 
 	b.requireInsertAndSelectPriv(ld.Tables)
@@ -4697,7 +4824,9 @@ func (b *PlanBuilder) buildLockStats(ld *ast.LockStatsStmt) base.Plan {
 // buildUnlockStats requires INSERT and SELECT privilege for the tables same as buildAnalyze.
 func (b *PlanBuilder) buildUnlockStats(ld *ast.UnlockStatsStmt) base.Plan {
 	p := &UnlockStats{
-		Tables: ld.Tables,
+		Tables:      ld.Tables,
+		ColumnNames: ld.ColumnNames,
+		Comment:     ld.Comment,
 	}
 	b.requireInsertAndSelectPriv(ld.Tables)
 
@@ -5687,8 +5816,8 @@ func buildShowSchema(s *ast.ShowStmt, isView bool, isSequence bool) (schema *exp
 		names = []string{"Db_name", "Table_name", "Partition_name", "Column_name", "Last_used_at", "Last_analyzed_at"}
 		ftypes = []byte{mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeDatetime, mysql.TypeDatetime}
 	case ast.ShowStatsLocked:
-		names = []string{"Db_name", "Table_name", "Partition_name", "Status"}
-		ftypes = []byte{mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeVarchar}
+		names = []string{"Db_name", "Table_name", "Partition_name", "Status", "Lock_time", "Lock_age"}
+		ftypes = []byte{mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeDatetime, mysql.TypeVarchar}
 	case ast.ShowProfiles: // ShowProfiles is deprecated.
 		names = []string{"Query_ID", "Duration", "Query"}
 		ftypes = []byte{mysql.TypeLong, mysql.TypeDouble, mysql.TypeVarchar}
@@ -5706,9 +5835,10 @@ func buildShowSchema(s *ast.ShowStmt, isView bool, isSequence bool) (schema *exp
 		ftypes = []byte{mysql.TypeLonglong, mysql.TypeLonglong, mysql.TypeVarchar, mysql.TypeVarchar}
 	case ast.ShowAnalyzeStatus:
 		names = []string{"Table_schema", "Table_name", "Partition_name", "Job_info", "Processed_rows", "Start_time",
-			"End_time", "State", "Fail_reason", "Instance", "Process_ID", "Remaining_seconds", "Progress", "Estimated_total_rows"}
+			"End_time", "State", "Fail_reason", "Instance", "Process_ID", "Remaining_seconds", "Progress", "Estimated_total_rows", "Fail_category"}
 		ftypes = []byte{mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeLonglong,
-			mysql.TypeDatetime, mysql.TypeDatetime, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeLonglong, mysql.TypeVarchar, mysql.TypeDouble, mysql.TypeLonglong}
+			mysql.TypeDatetime, mysql.TypeDatetime, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeLonglong, mysql.TypeVarchar, mysql.TypeDouble, mysql.TypeLonglong,
+			mysql.TypeVarchar}
 	case ast.ShowBuiltins:
 		names = []string{"Supported_builtin_functions"}
 		ftypes = []byte{mysql.TypeVarchar}
@@ -5764,6 +5894,15 @@ func (b *PlanBuilder) buildPlanReplayer(pc *ast.PlanReplayerStmt) base.Plan {
 
 	if pc.HistoricalStatsInfo != nil {
 		p.HistoricalStatsTS = calcTSForPlanReplayer(b.ctx, pc.HistoricalStatsInfo.TsExpr)
+	} else if pc.Stmt != nil {
+		// Dump the stats the statement is optimized with if it's pinned to a stats snapshot.
+		p.HistoricalStatsTS = b.ctx.GetSessionVars().StatsSnapshotTS
+		// The STATS_SNAPSHOT_TS hint overrides the variable, and only the last one takes effect.
+		for _, tblHint := range hint.ExtractTableHintsFromStmtNode(pc.Stmt, nil) {
+			if tblHint.HintName.L == hint.HintStatsSnapshotTS {
+				p.HistoricalStatsTS = tblHint.HintData.(uint64)
+			}
+		}
 	}
 
 	schema := newColumnsWithNames(1)
@@ -5822,6 +5961,19 @@ func buildChecksumTableSchema() (*expression.Schema, []*types.FieldName) {
 	return schema.col2Schema(), schema.names
 }
 
+func buildAdminCheckStatsTableSchema() (*expression.Schema, []*types.FieldName) {
+	schema := newColumnsWithNames(8)
+	schema.Append(buildColumnWithName("", "Db_name", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "Table_name", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "Partition_name", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "Column_name", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "Is_index", mysql.TypeTiny, 1))
+	schema.Append(buildColumnWithName("", "Violation", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "Detail", mysql.TypeVarchar, 256))
+	schema.Append(buildColumnWithName("", "Suggestion", mysql.TypeVarchar, 64))
+	return schema.col2Schema(), schema.names
+}
+
 // adjustOverlongViewColname adjusts the overlong outputNames of a view to
 // `new_exp_$off` where `$off` is the offset of the output column, $off starts from 1.
 // There is still some MySQL compatible problems.
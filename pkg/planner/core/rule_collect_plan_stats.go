@@ -93,9 +93,56 @@ func (c *CollectPredicateColumnsPoint) Optimize(_ context.Context, plan base.Log
 	for _, item := range histNeededItems {
 		asyncload.AsyncLoadHistogramNeededItems.Insert(item.TableItemID, item.FullLoad)
 	}
+	recordPendingStatsItems(plan.SCtx(), histNeededItems)
 	return plan, planChanged, nil
 }
 
+// recordPendingStatsItems records the needed items whose stats are not loaded yet, so that the plan built with the
+// pseudo stats is not kept in the plan cache after the stats arrive. It only matters when the plan is to be cached.
+// It's called once the sync-load finishes or the async-load is requested, and the recorded items aren't recorded again.
+func recordPendingStatsItems(sctx base.PlanContext, neededItems []model.StatsLoadItem) {
+	stmtCtx := sctx.GetSessionVars().StmtCtx
+	if !stmtCtx.UseCache() {
+		return
+	}
+	recorded := make(map[string]struct{}, len(stmtCtx.StatsLoad.PendingItems))
+	for _, item := range stmtCtx.StatsLoad.PendingItems {
+		recorded[item.Key()] = struct{}{}
+	}
+	for _, item := range statsLoadNeededItems(sctx, neededItems) {
+		if _, ok := recorded[item.Key()]; ok {
+			continue
+		}
+		recorded[item.Key()] = struct{}{}
+		stmtCtx.StatsLoad.PendingItems = append(stmtCtx.StatsLoad.PendingItems, item)
+	}
+}
+
+// statsLoadNeededItems returns the items whose stats are still not loaded into the stats cache.
+func statsLoadNeededItems(sctx base.PlanContext, items []model.StatsLoadItem) []model.StatsLoadItem {
+	statsHandle := domain.GetDomain(sctx).StatsHandle()
+	if statsHandle == nil {
+		return nil
+	}
+	var loadNeededItems []model.StatsLoadItem
+	for _, item := range items {
+		tbl, ok := statsHandle.Get(item.TableID)
+		if !ok {
+			continue
+		}
+		var loadNeeded bool
+		if item.IsIndex {
+			_, loadNeeded = tbl.IndexIsLoadNeeded(item.ID)
+		} else {
+			_, loadNeeded, _ = tbl.ColumnIsLoadNeeded(item.ID, item.FullLoad)
+		}
+		if loadNeeded {
+			loadNeededItems = append(loadNeededItems, item)
+		}
+	}
+	return loadNeededItems
+}
+
 // markAtLeastOneFullStatsLoadForEachTable marks at least one full stats load for each table.
 // It should be called after we use c.predicateCols to update the usage of predicate columns.
 func (*CollectPredicateColumnsPoint) markAtLeastOneFullStatsLoadForEachTable(
@@ -275,7 +322,10 @@ func SyncWaitStatsLoad(plan base.LogicalPlan) error {
 	if len(stmtCtx.StatsLoad.NeededItems) <= 0 {
 		return nil
 	}
+	neededItems := stmtCtx.StatsLoad.NeededItems
 	err := domain.GetDomain(plan.SCtx()).StatsHandle().SyncWaitStatsLoad(stmtCtx)
+	// The items might be still unloaded without an error, e.g. the loading of them failed.
+	recordPendingStatsItems(plan.SCtx(), neededItems)
 	if err != nil {
 		stmtCtx.IsSyncStatsFailed = true
 		if vardef.StatsLoadPseudoTimeout.Load() {
@@ -19,11 +19,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
+	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/types"
 	driver "github.com/pingcap/tidb/pkg/types/parser_driver"
 	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tidb/pkg/util/set"
 	"github.com/pingcap/tidb/pkg/util/stringutil"
 )
 
@@ -36,7 +40,8 @@ const (
 )
 
 var (
-	_ base.ShowPredicateExtractor = &ShowBaseExtractor{}
+	_ base.ShowPredicateExtractor     = &ShowBaseExtractor{}
+	_ base.MemTablePredicateExtractor = &ShowStatsExtractor{}
 )
 
 // ShowBaseExtractor is the definition of base extractor for derived predicates.
@@ -128,3 +133,86 @@ func (e *ShowBaseExtractor) FieldPatternLike() collate.WildcardPattern {
 	fieldPatternsLike.Compile(e.fieldPattern, byte('\\'))
 	return fieldPatternsLike
 }
+
+// ShowStatsExtractor extracts the predicates on the names from the `WHERE` clause of `SHOW STATS_META`,
+// `SHOW STATS_HISTOGRAMS`, `SHOW STATS_BUCKETS` and `SHOW STATS_TOPN` into the ShowStatsFilter, so that only the
+// stats of the matched tables are read.
+//
+// e.g:
+// SHOW STATS_META WHERE db_name = 'test' AND table_name IN ('t1', 't2')
+type ShowStatsExtractor struct {
+	extractHelper
+
+	tp     ast.ShowStmtType
+	Filter *handleutil.ShowStatsFilter
+}
+
+func newShowStatsExtractor(tp ast.ShowStmtType) *ShowStatsExtractor {
+	return &ShowStatsExtractor{tp: tp, Filter: &handleutil.ShowStatsFilter{}}
+}
+
+// Extract implements the MemTablePredicateExtractor Extract interface.
+func (e *ShowStatsExtractor) Extract(ctx base.PlanContext,
+	schema *expression.Schema,
+	names []*types.FieldName,
+	predicates []expression.Expression,
+) []expression.Expression {
+	colNames := []string{"db_name", "table_name", "partition_name"}
+	if e.tp != ast.ShowStatsMeta {
+		colNames = append(colNames, "column_name")
+	}
+	remained := predicates
+	for _, colName := range colNames {
+		var skipRequest bool
+		var values set.StringSet
+		remained, skipRequest, values = e.extractCol(ctx, schema, names, remained, colName, true)
+		if skipRequest {
+			e.Filter.SkipRequest = true
+			return nil
+		}
+		if len(values) == 0 {
+			continue
+		}
+		switch colName {
+		case "db_name":
+			e.Filter.DBNames = values
+		case "table_name":
+			e.Filter.TableNames = values
+		case "partition_name":
+			e.Filter.PartitionNames = values
+		case "column_name":
+			e.Filter.ColumnNames = values
+		}
+	}
+	return remained
+}
+
+// ExplainInfo implements the base.MemTablePredicateExtractor interface.
+func (e *ShowStatsExtractor) ExplainInfo(_ base.PhysicalPlan) string {
+	if e.Filter.SkipRequest {
+		return "skip_request:true"
+	}
+	r := new(bytes.Buffer)
+	if len(e.Filter.DBNames) > 0 {
+		fmt.Fprintf(r, "db_name:[%s], ", extractStringFromStringSet(e.Filter.DBNames))
+	}
+	if len(e.Filter.TableNames) > 0 {
+		fmt.Fprintf(r, "table_name:[%s], ", extractStringFromStringSet(e.Filter.TableNames))
+	}
+	if len(e.Filter.PartitionNames) > 0 {
+		fmt.Fprintf(r, "partition_name:[%s], ", extractStringFromStringSet(e.Filter.PartitionNames))
+	}
+	if len(e.Filter.ColumnNames) > 0 {
+		fmt.Fprintf(r, "column_name:[%s], ", extractStringFromStringSet(e.Filter.ColumnNames))
+	}
+	if e.Filter.Limit > 0 {
+		fmt.Fprintf(r, "limit:%d, ", e.Filter.Limit)
+	}
+
+	// remove the last ", " in the message info
+	s := r.String()
+	if len(s) > 2 {
+		return s[:len(s)-2]
+	}
+	return s
+}
@@ -15,11 +15,13 @@
 package core
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/domain"
@@ -36,14 +38,21 @@ import (
 	"github.com/pingcap/tidb/pkg/planner/property"
 	"github.com/pingcap/tidb/pkg/planner/util"
 	"github.com/pingcap/tidb/pkg/planner/util/debugtrace"
+	"github.com/pingcap/tidb/pkg/privilege"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/asyncload"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/types"
+	tidbutil "github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
 	h "github.com/pingcap/tidb/pkg/util/hint"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/ranger"
+	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
 )
 
@@ -147,10 +156,15 @@ func deriveStats4DataSource(lp base.LogicalPlan) (*property.StatsInfo, bool, err
 	// TODO: Can we move ds.deriveStatsByFilter after pruning by heuristics? In this way some computation can be avoided
 	// when ds.PossibleAccessPaths are pruned.
 	ds.SetStats(deriveStatsByFilter(ds, ds.PushedDownConds, ds.PossibleAccessPaths))
+	adjustStatsByPartitionCardinality(ds)
+	adjustStatsByPartitionColumnBounds(ds)
 	err := derivePathStatsAndTryHeuristics(ds)
 	if err != nil {
 		return nil, false, err
 	}
+	adjustStatsByCardinalityFeedback(ds)
+	markLowConfidenceStats(ds)
+	recordStatsOutOfRange(ds)
 
 	if err := generateIndexMergePath(ds); err != nil {
 		return nil, false, err
@@ -220,7 +234,7 @@ func deriveIndexPathStats(ds *logicalop.DataSource, path *util.AccessPath, _ []e
 			selectivity := path.CountAfterAccess / float64(ds.StatisticTable.RealtimeCount)
 			for i := range accesses {
 				col := path.IdxCols[path.EqOrInCondCount+i]
-				ndv := cardinality.EstimateColumnNDV(ds.StatisticTable, col.ID)
+				ndv := cardinality.EstimateColumnNDV(ds.SCtx(), ds.StatisticTable, col.ID)
 				ndv *= selectivity
 				if ndv < 1 {
 					ndv = 1.0
@@ -362,7 +376,7 @@ func deriveCommonHandleTablePathStats(ds *logicalop.DataSource, path *util.Acces
 			selectivity := path.CountAfterAccess / float64(ds.StatisticTable.RealtimeCount)
 			for i := range accesses {
 				col := path.IdxCols[path.EqOrInCondCount+i]
-				ndv := cardinality.EstimateColumnNDV(ds.StatisticTable, col.ID)
+				ndv := cardinality.EstimateColumnNDV(ds.SCtx(), ds.StatisticTable, col.ID)
 				ndv *= selectivity
 				if ndv < 1 {
 					ndv = 1.0
@@ -478,6 +492,27 @@ func getGroupNDVs(ds *logicalop.DataSource) []property.GroupNDV {
 		}
 		return false
 	})
+	if !ds.SCtx().GetSessionVars().EnableExtendedStats || ds.StatisticTable.ExtendedStats == nil {
+		return ndvs
+	}
+	// Estimate the NDV of the column groups not covered by any index with the extended stats.
+	for _, g := range colGroups {
+		covered := slices.ContainsFunc(ndvs, func(ndv property.GroupNDV) bool {
+			return slices.EqualFunc(ndv.Cols, g, func(id int64, col *expression.Column) bool { return id == col.UniqueID })
+		})
+		if covered {
+			continue
+		}
+		ndv, ok := cardinality.EstimateColGroupNDVByExtendedStats(ds.StatisticTable.ExtendedStats, g, ds.TableStats.ColNDVs, ds.TableStats.RowCount)
+		if !ok {
+			continue
+		}
+		cols := make([]int64, 0, len(g))
+		for _, col := range g {
+			cols = append(cols, col.UniqueID)
+		}
+		ndvs = append(ndvs, property.GroupNDV{Cols: cols, NDV: ndv})
+	}
 	return ndvs
 }
 
@@ -490,6 +525,12 @@ func getTblInfoForUsedStatsByPhysicalID(sctx base.PlanContext, id int64) (fullNa
 	var partDef *model.PartitionDefinition
 
 	tbl, partDef = infoschema.FindTableByTblOrPartID(is, id)
+	if tbl == nil {
+		// The local temporary tables only exist in the session.
+		if localTempTables, ok := sctx.GetSessionVars().LocalTemporaryTables.(*infoschema.SessionTables); ok {
+			tbl, _ = localTempTables.TableByID(id)
+		}
+	}
 	if tbl == nil || tbl.Meta() == nil {
 		return
 	}
@@ -503,9 +544,29 @@ func getTblInfoForUsedStatsByPhysicalID(sctx base.PlanContext, id int64) (fullNa
 	return
 }
 
+// resolveFKParent returns the ID of the parent table and the ID of the referenced column of a single-column foreign
+// key. The partitioned parents are skipped since the join estimation works on the physical tables.
+func resolveFKParent(sctx base.PlanContext, fk *model.FKInfo) (parentID, parentColID int64, ok bool) {
+	is, ok := sctx.GetInfoSchema().(infoschema.InfoSchema)
+	if !ok || len(fk.RefCols) != 1 {
+		return 0, 0, false
+	}
+	parent, err := is.TableInfoByName(fk.RefSchema, fk.RefTable)
+	if err != nil || parent.GetPartitionInfo() != nil {
+		return 0, 0, false
+	}
+	col := model.FindColumnInfo(parent.Columns, fk.RefCols[0].L)
+	if col == nil {
+		return 0, 0, false
+	}
+	return parent.ID, col.ID, true
+}
+
 func initStats(ds *logicalop.DataSource) {
 	if ds.StatisticTable == nil {
 		ds.StatisticTable = getStatsTable(ds.SCtx(), ds.TableInfo, ds.PhysicalTableID)
+		fillDynamicSample(ds)
+		overrideTableRowsByHints(ds)
 	}
 	tableStats := &property.StatsInfo{
 		RowCount:     float64(ds.StatisticTable.RealtimeCount),
@@ -516,21 +577,57 @@ func initStats(ds *logicalop.DataSource) {
 	if ds.StatisticTable.Pseudo {
 		tableStats.StatsVersion = statistics.PseudoVersion
 	}
+	if ds.SCtx().GetSessionVars().EnableExtendedStats {
+		tableStats.HistColl.TrigramStats = ds.StatisticTable.ExtendedStats.TrigramStatsByUniqueID(ds.TblCols)
+		tableStats.HistColl.ExpressionStats = buildExpressionStatsInQuery(ds)
+		tableStats.HistColl.JoinSketches = ds.StatisticTable.ExtendedStats.JoinSketchesByUniqueID(ds.TblCols)
+	}
+	if ds.SCtx().GetSessionVars().FKFanoutEstimation {
+		tableStats.HistColl.FKFanouts = ds.StatisticTable.FKFanoutsByUniqueID(ds.TableInfo, ds.TblCols, func(fk *model.FKInfo) (int64, int64, bool) {
+			return resolveFKParent(ds.SCtx(), fk)
+		})
+	}
+	tableStats.HistColl.DynamicSample = ds.StatisticTable.DynamicSample
+	if sessVars := ds.SCtx().GetSessionVars(); sessVars.InListProbeEstimation && sessVars.StmtCtx.UseCache() {
+		// The parameterized variants of the statement probe the same constants in the IN lists repeatedly.
+		if statsHandle := domain.GetDomain(ds.SCtx()).StatsHandle(); statsHandle != nil {
+			tableStats.HistColl.InListSelCache = statsHandle.InListSelCache()
+		}
+	}
+	tableStats.HistColl.StatsVersion = tableStats.StatsVersion
 
 	statsRecord := ds.SCtx().GetSessionVars().StmtCtx.GetUsedStatsInfo(true)
 	name, tblInfo := getTblInfoForUsedStatsByPhysicalID(ds.SCtx(), ds.PhysicalTableID)
-	statsRecord.RecordUsedInfo(ds.PhysicalTableID, &stmtctx.UsedStatsInfoForTable{
+	usedInfo := &stmtctx.UsedStatsInfoForTable{
 		Name:            name,
 		TblInfo:         tblInfo,
 		Version:         tableStats.StatsVersion,
 		RealtimeCount:   tableStats.HistColl.RealtimeCount,
 		ModifyCount:     tableStats.HistColl.ModifyCount,
 		ColAndIdxStatus: ds.StatisticTable.ColAndIdxExistenceMap,
-	})
+		StatsHealthy:    -1,
+	}
+	if healthy, ok := ds.StatisticTable.GetStatsHealthy(); ok {
+		usedInfo.StatsHealthy = healthy
+	}
+	if ds.StatisticTable.LastAnalyzeVersion > 0 {
+		usedInfo.LastAnalyzeTime = oracle.GetTimeFromTS(ds.StatisticTable.LastAnalyzeVersion)
+	}
+	// The table may be accessed by more than one data source.
+	if oldInfo := statsRecord.GetUsedInfo(ds.PhysicalTableID); oldInfo != nil {
+		usedInfo.OutOfRange = oldInfo.OutOfRange
+		usedInfo.UsedJSONPathStats = oldInfo.UsedJSONPathStats
+	}
+	statsRecord.RecordUsedInfo(ds.PhysicalTableID, usedInfo)
 
 	for _, col := range ds.Schema().Columns {
-		tableStats.ColNDVs[col.UniqueID] = cardinality.EstimateColumnNDV(ds.StatisticTable, col.ID)
+		if ndv, ok := expressionIndexColumnNDV(ds, col); ok {
+			tableStats.ColNDVs[col.UniqueID] = ndv
+			continue
+		}
+		tableStats.ColNDVs[col.UniqueID] = cardinality.EstimateColumnNDV(ds.SCtx(), ds.StatisticTable, col.ID)
 	}
+	overrideColumnNDVsByHints(ds, tableStats)
 	ds.TableStats = tableStats
 	ds.TableStats.GroupNDVs = getGroupNDVs(ds)
 	ds.TblColHists = ds.StatisticTable.ID2UniqueID(ds.TblCols)
@@ -541,6 +638,173 @@ func initStats(ds *logicalop.DataSource) {
 	}
 }
 
+// expressionIndexColumnNDV estimates the NDV of the hidden column of an expression index by the index stats when the
+// column itself has no stats, e.g. they're built by an older version which skips the virtual columns. The expressions
+// are rewritten to the hidden column before the estimation, so the NDV would fall back to the pseudo one otherwise.
+// Only the indexes on the single hidden column are used, whose NDVs are exactly the ones of the column.
+func expressionIndexColumnNDV(ds *logicalop.DataSource, col *expression.Column) (float64, bool) {
+	colInfo := model.FindColumnInfoByID(ds.TableInfo.Columns, col.ID)
+	if colInfo == nil || !colInfo.Hidden {
+		return 0, false
+	}
+	if colStats := ds.StatisticTable.GetCol(col.ID); colStats != nil && colStats.IsStatsInitialized() {
+		return 0, false
+	}
+	for _, idxInfo := range ds.TableInfo.Indices {
+		if idxInfo.State != model.StatePublic || len(idxInfo.Columns) != 1 || idxInfo.Columns[0].Offset != colInfo.Offset {
+			continue
+		}
+		idxStats := ds.StatisticTable.GetIdx(idxInfo.ID)
+		if idxStats == nil || !idxStats.IsAnalyzed() || idxStats.TotalRowCount() <= 0 {
+			continue
+		}
+		ndv := float64(idxStats.Histogram.NDV) * float64(ds.StatisticTable.RealtimeCount) / idxStats.TotalRowCount()
+		return min(ndv, float64(ds.StatisticTable.RealtimeCount)), true
+	}
+	return 0, false
+}
+
+// statsOverrideHintApplicable checks whether the hint overriding the statistics is applicable on the data source.
+// The hint without partitions is applied on the whole table, otherwise on the specified partitions.
+func statsOverrideHintApplicable(ds *logicalop.DataSource, hint *h.HintedStats) bool {
+	if len(hint.Partitions) == 0 {
+		return ds.PartitionDefIdx == nil
+	}
+	if ds.PartitionDefIdx == nil {
+		return false
+	}
+	partName := ds.TableInfo.Partition.Definitions[*ds.PartitionDefIdx].Name
+	return slices.ContainsFunc(hint.Partitions, func(name ast.CIStr) bool {
+		return name.L == partName.L
+	})
+}
+
+// overrideTableRowsByHints overrides the row count of the table by the SET_TABLE_ROWS hint. The estimations on the
+// histograms are scaled to the overridden row count as if the table grew.
+func overrideTableRowsByHints(ds *logicalop.DataSource) {
+	for i := range ds.StatsOverrides {
+		hint := &ds.StatsOverrides[i]
+		if hint.HintName != h.HintSetTableRows || !statsOverrideHintApplicable(ds, hint) {
+			continue
+		}
+		statsTbl := ds.StatisticTable.ShallowCopy()
+		statsTbl.RealtimeCount = int64(hint.Value)
+		ds.StatisticTable = statsTbl
+	}
+}
+
+// overrideColumnNDVsByHints overrides the NDVs of the columns by the SET_COLUMN_NDV hint, both the ones used for the
+// estimation of the aggregations and the joins, and the ones of the histograms used for the selectivity.
+func overrideColumnNDVsByHints(ds *logicalop.DataSource, tableStats *property.StatsInfo) {
+	for i := range ds.StatsOverrides {
+		hint := &ds.StatsOverrides[i]
+		if hint.HintName != h.HintSetColumnNDV || !statsOverrideHintApplicable(ds, hint) {
+			continue
+		}
+		colInfo := ds.TableInfo.FindPublicColumnByName(hint.Column.L)
+		var col *expression.Column
+		if colInfo != nil {
+			col = expression.ColInfo2Col(ds.TblCols, colInfo)
+		}
+		if col == nil {
+			ds.SCtx().GetSessionVars().StmtCtx.SetHintWarning(fmt.Sprintf(
+				"%s is inapplicable, check whether the column(%s) exists", hint.String(), hint.Column))
+			continue
+		}
+		tableStats.ColNDVs[col.UniqueID] = hint.Value
+		if colStats := tableStats.HistColl.GetCol(col.UniqueID); colStats != nil {
+			// Copy the column since the histogram is shared with the stats cache.
+			newColStats := *colStats
+			newColStats.Histogram.NDV = int64(hint.Value)
+			tableStats.HistColl.SetCol(col.UniqueID, &newColStats)
+		}
+	}
+}
+
+// fillDynamicSample samples the rows of the table when it has no usable stats, e.g. a newly created or imported
+// table, so that the predicates pushed down to the data source are estimated by evaluating them on the sampled rows
+// rather than by the pseudo selectivity. The sample is cached in the stats cache for the following queries.
+// It's only done when tidb_opt_dynamic_sampling_rows is larger than 0.
+func fillDynamicSample(ds *logicalop.DataSource) {
+	sample := getDynamicSample(ds)
+	// The pseudo stats may be copied from the cached ones, so the cached sample is reset if it's not used.
+	if sample == nil && ds.StatisticTable.DynamicSample == nil {
+		return
+	}
+	statsTbl := *ds.StatisticTable
+	statsTbl.DynamicSample = sample
+	ds.StatisticTable = &statsTbl
+}
+
+func getDynamicSample(ds *logicalop.DataSource) *statistics.DynamicSample {
+	sessionVars := ds.SCtx().GetSessionVars()
+	maxRows := sessionVars.DynamicSamplingRows
+	if maxRows <= 0 || sessionVars.InRestrictedSQL || !ds.StatisticTable.Pseudo || len(ds.PushedDownConds) == 0 {
+		return nil
+	}
+	// The partitions can't be sampled evenly by a bounded scan of the table. The temporary tables and the system
+	// tables are not worth sampling.
+	if ds.TableInfo.GetPartitionInfo() != nil || ds.TableInfo.TempTableType != model.TempTableNone ||
+		ds.TableInfo.IsView() || tidbutil.IsMemOrSysDB(ds.DBName.L) {
+		return nil
+	}
+	// The rows are sampled by an internal session, so the user must be able to read the table directly rather than
+	// through a view with the definer's privileges.
+	if pm := privilege.GetPrivilegeManager(ds.SCtx()); pm != nil &&
+		!pm.RequestVerification(sessionVars.ActiveRoles, ds.DBName.L, ds.TableInfo.Name.L, "", mysql.SelectPriv) {
+		return nil
+	}
+	statsHandle := domain.GetDomain(ds.SCtx()).StatsHandle()
+	if statsHandle == nil {
+		return nil
+	}
+	sample, err := statsHandle.GetDynamicSample(ds.DBName.O, ds.TableInfo, maxRows)
+	if err != nil {
+		logutil.BgLogger().Warn("dynamic sampling failed, use the pseudo stats", zap.String("table", ds.TableInfo.Name.O), zap.Error(err))
+		return nil
+	}
+	return sample
+}
+
+// buildExpressionStatsInQuery builds the expressions of the expression stats on the columns of the DataSource.
+func buildExpressionStatsInQuery(ds *logicalop.DataSource) []*statistics.ExpressionStatsInQuery {
+	if ds.StatisticTable.ExtendedStats == nil {
+		return nil
+	}
+	exprCtx := ds.SCtx().GetExprCtx()
+	var res []*statistics.ExpressionStatsInQuery
+	for _, item := range ds.StatisticTable.ExtendedStats.Stats {
+		if item.Tp != ast.StatsTypeExpression || item.ExprStats == nil || item.ExprStats.SampleCount == 0 {
+			continue
+		}
+		expr, err := expression.ParseSimpleExpr(exprCtx, item.ExprStats.Expr, expression.WithTableInfo("", ds.TableInfo))
+		if err != nil {
+			continue
+		}
+		// Replace the columns of the table with the ones of the DataSource. The expression is useless if any of
+		// its columns is pruned.
+		tblCols := expression.ExtractColumns(expr)
+		dsCols := make([]expression.Expression, 0, len(tblCols))
+		for _, tblCol := range tblCols {
+			idx := slices.IndexFunc(ds.Schema().Columns, func(col *expression.Column) bool { return col.ID == tblCol.ID })
+			if idx < 0 {
+				break
+			}
+			dsCols = append(dsCols, ds.Schema().Columns[idx])
+		}
+		if len(dsCols) != len(tblCols) {
+			continue
+		}
+		expr = expression.ColumnSubstitute(exprCtx, expr, expression.NewSchema(tblCols...), dsCols)
+		res = append(res, &statistics.ExpressionStatsInQuery{Expr: expr, Stats: item.ExprStats})
+	}
+	// Make the choice deterministic when multiple expression stats can be used for a condition.
+	slices.SortFunc(res, func(a, b *statistics.ExpressionStatsInQuery) int {
+		return strings.Compare(a.Stats.Expr, b.Stats.Expr)
+	})
+	return res
+}
+
 func deriveStatsByFilter(ds *logicalop.DataSource, conds expression.CNFExprs, filledPaths []*util.AccessPath) *property.StatsInfo {
 	if ds.SCtx().GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
 		debugtrace.EnterContextCommon(ds.SCtx())
@@ -556,7 +820,437 @@ func deriveStatsByFilter(ds *logicalop.DataSource, conds expression.CNFExprs, fi
 	// Only '0' is suggested, see https://docs.pingcap.com/zh/tidb/stable/system-variables#tidb_optimizer_selectivity_level.
 	// stats.HistColl = stats.HistColl.NewHistCollBySelectivity(ds.SCtx(), nodes)
 	// }
-	return ds.TableStats.Scale(selectivity)
+	stats := ds.TableStats.Scale(selectivity)
+	if ds.SCtx().GetSessionVars().EnableExtendedStats && ds.StatisticTable.ExtendedStats != nil {
+		filterCols := expression.ExtractColumnsFromExpressions(nil, conds, nil)
+		cardinality.AdjustColNDVsByExtendedStats(ds.StatisticTable.ExtendedStats, ds.Schema().Columns, filterCols, ds.TableStats, stats)
+	}
+	return stats
+}
+
+// adjustStatsByPartitionCardinality caps the estimated row count of a LIST/HASH/KEY partitioned table under the
+// dynamic prune mode by the combined row count of the partitions left after pruning.
+// The global stats know nothing about which partitions a predicate touches, so a filter on the partition column
+// whose values are not well covered by the histogram(e.g. pseudo column stats) may be estimated way above the
+// real number of the rows in the used partitions. If the filter only matches some point values of the partition
+// column, each partition is further capped by the rows of those values according to its NDV of the column.
+func adjustStatsByPartitionCardinality(ds *logicalop.DataSource) {
+	sessionVars := ds.SCtx().GetSessionVars()
+	if !sessionVars.EnablePartitionCardinalityEstimation || !sessionVars.StmtCtx.UseDynamicPartitionPrune() {
+		return
+	}
+	pi := ds.TableInfo.GetPartitionInfo()
+	if pi == nil || ds.StatsInfo().RowCount <= 0 {
+		return
+	}
+	switch pi.Type {
+	case ast.PartitionTypeList, ast.PartitionTypeHash, ast.PartitionTypeKey:
+	default:
+		return
+	}
+	statsHandle := domain.GetDomain(ds.SCtx()).StatsHandle()
+	pt := ds.Table.GetPartitionedTable()
+	if statsHandle == nil || pt == nil {
+		return
+	}
+	var partCol *expression.Column
+	if colIDs := pt.GetPartitionColumnIDs(); len(colIDs) == 1 {
+		idx := slices.IndexFunc(ds.Schema().Columns, func(col *expression.Column) bool { return col.ID == colIDs[0] })
+		if idx >= 0 {
+			partCol = ds.Schema().Columns[idx]
+		}
+	}
+	var partColID int64
+	if partCol != nil {
+		partColID = partCol.ID
+	}
+	summary := statsHandle.GetPartitionCardinality(ds.TableInfo, partColID)
+	if !summary.IsComplete() {
+		return
+	}
+	pids, pruned := usedPartitionIDs(ds, pi, pt)
+	if !pruned {
+		return
+	}
+	var rowCount float64
+	if pointCount := partitionColumnPointCount(ds, partCol); pointCount > 0 {
+		cnt, ok := summary.RowCountOfValues(pids, pointCount)
+		if !ok {
+			return
+		}
+		rowCount = cnt
+	} else {
+		cnt, ok := summary.RowCountOfPartitions(pids)
+		if !ok {
+			return
+		}
+		rowCount = float64(cnt)
+	}
+	if rowCount >= ds.StatsInfo().RowCount {
+		return
+	}
+	ds.SetStats(ds.StatsInfo().Scale(rowCount / ds.StatsInfo().RowCount))
+}
+
+// partitionColumnPointCount returns the number of the point values of the partition column matched by the pushed
+// down conditions, or 0 if the conditions match any range of it.
+func partitionColumnPointCount(ds *logicalop.DataSource, partCol *expression.Column) int {
+	if partCol == nil {
+		return 0
+	}
+	sctx := ds.SCtx()
+	accessConds, _ := ranger.DetachCondsForColumn(sctx.GetRangerCtx(), ds.PushedDownConds, partCol)
+	if len(accessConds) == 0 {
+		return 0
+	}
+	ranges, _, _, err := ranger.BuildColumnRange(accessConds, sctx.GetRangerCtx(), partCol.RetType, types.UnspecifiedLength, sctx.GetSessionVars().RangeMaxSize)
+	if err != nil {
+		return 0
+	}
+	tc := sctx.GetSessionVars().StmtCtx.TypeCtx()
+	for _, ran := range ranges {
+		if !ran.IsPointNullable(tc) {
+			return 0
+		}
+	}
+	return len(ranges)
+}
+
+// usedPartitionIDs returns the IDs of the partitions left after pruning, pruned is false if no partition is pruned.
+// It returns nil if the pruning fails. The result is cached in the DataSource until its conditions are changed.
+func usedPartitionIDs(ds *logicalop.DataSource, pi *model.PartitionInfo, pt table.PartitionedTable) (pids []int64, pruned bool) {
+	if cached := ds.UsedPartitions; cached != nil && slices.Equal(cached.Conds, ds.AllConds) {
+		return cached.PartitionIDs, cached.Pruned
+	}
+	pids, pruned = pruneUsedPartitionIDs(ds, pi, pt)
+	ds.UsedPartitions = &logicalop.UsedPartitions{Conds: ds.AllConds, PartitionIDs: pids, Pruned: pruned}
+	return pids, pruned
+}
+
+func pruneUsedPartitionIDs(ds *logicalop.DataSource, pi *model.PartitionInfo, pt table.PartitionedTable) (pids []int64, pruned bool) {
+	names, err := (&PartitionProcessor{}).reconstructTableColNames(ds)
+	if err != nil {
+		return nil, false
+	}
+	used, err := PartitionPruning(ds.SCtx(), pt, pushDownNot(ds.SCtx().GetExprCtx(), ds.AllConds), ds.PartitionNames, ds.TblCols, names)
+	if err != nil {
+		return nil, false
+	}
+	if len(used) == 1 && used[0] == FullRange {
+		pids = make([]int64, 0, len(pi.Definitions))
+		for _, def := range pi.Definitions {
+			pids = append(pids, def.ID)
+		}
+		return pids, false
+	}
+	pids = make([]int64, 0, len(used))
+	for _, idx := range used {
+		pids = append(pids, pi.Definitions[idx].ID)
+	}
+	return pids, true
+}
+
+// encodedColumnRange is a range of a column whose bounds are encoded by codec.EncodeKey.
+type encodedColumnRange struct {
+	low, high               []byte
+	lowExclude, highExclude bool
+}
+
+// overlaps returns whether the range overlaps with the encoded [lower, upper].
+func (r *encodedColumnRange) overlaps(lower, upper []byte) bool {
+	if c := bytes.Compare(r.high, lower); c < 0 || (c == 0 && r.highExclude) {
+		return false
+	}
+	if c := bytes.Compare(r.low, upper); c > 0 || (c == 0 && r.lowExclude) {
+		return false
+	}
+	return true
+}
+
+// buildEncodedColumnRanges builds the encoded ranges of the columns filtered by the pushed down conditions, keyed by
+// the column ID. Only the columns whose encoded values keep the order of the values are considered, and the columns
+// filtered by IS NULL are skipped since the bounds only cover the non-null values.
+func buildEncodedColumnRanges(ds *logicalop.DataSource) map[int64][]encodedColumnRange {
+	sctx := ds.SCtx()
+	var res map[int64][]encodedColumnRange
+	for _, col := range expression.ExtractColumnsFromExpressions(nil, ds.PushedDownConds, nil) {
+		if col.ID <= 0 || res[col.ID] != nil || !encodedOrderPreserved(col.RetType) {
+			continue
+		}
+		accessConds, _ := ranger.DetachCondsForColumn(sctx.GetRangerCtx(), ds.PushedDownConds, col)
+		if len(accessConds) == 0 {
+			continue
+		}
+		ranges, _, _, err := ranger.BuildColumnRange(accessConds, sctx.GetRangerCtx(), col.RetType, types.UnspecifiedLength, sctx.GetSessionVars().RangeMaxSize)
+		if err != nil || len(ranges) == 0 {
+			continue
+		}
+		encoded := make([]encodedColumnRange, 0, len(ranges))
+		for _, ran := range ranges {
+			if ran.LowVal[0].IsNull() {
+				break
+			}
+			low, err := codec.EncodeKey(time.UTC, nil, ran.LowVal[0])
+			if err != nil {
+				break
+			}
+			high, err := codec.EncodeKey(time.UTC, nil, ran.HighVal[0])
+			if err != nil {
+				break
+			}
+			encoded = append(encoded, encodedColumnRange{low: low, high: high, lowExclude: ran.LowExclude, highExclude: ran.HighExclude})
+		}
+		if len(encoded) < len(ranges) {
+			continue
+		}
+		if res == nil {
+			res = make(map[int64][]encodedColumnRange)
+		}
+		res[col.ID] = encoded
+	}
+	return res
+}
+
+// encodedOrderPreserved returns whether the values of the type encoded by codec.EncodeKey keep their order, and are
+// encoded in the same way in the ranges and in the stats. The unsigned integers are excluded since the constants in
+// the ranges might be signed, and the timestamps are excluded since they're affected by the time zone.
+func encodedOrderPreserved(tp *types.FieldType) bool {
+	switch tp.GetType() {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong, mysql.TypeYear:
+		return !mysql.HasUnsignedFlag(tp.GetFlag())
+	case mysql.TypeFloat, mysql.TypeDouble, mysql.TypeDate, mysql.TypeDatetime, mysql.TypeDuration:
+		return true
+	}
+	return false
+}
+
+// adjustStatsByPartitionColumnBounds caps the estimated row count of a partitioned table under the dynamic prune mode
+// by the combined row count of the partitions which the range filters on the columns might actually touch.
+// A partition is not touched if the ranges of any filtered column don't overlap with the min/max values of the column
+// in its partition-level stats, which is common for the columns correlated with the partition key(e.g. the creation
+// time of a table partitioned by the auto-increment ID). The partitions modified after analyze are always treated as
+// touched since the new values might be out of the bounds.
+func adjustStatsByPartitionColumnBounds(ds *logicalop.DataSource) {
+	sessionVars := ds.SCtx().GetSessionVars()
+	if !sessionVars.EnablePartitionCardinalityEstimation || !sessionVars.StmtCtx.UseDynamicPartitionPrune() {
+		return
+	}
+	pi := ds.TableInfo.GetPartitionInfo()
+	if pi == nil || ds.StatsInfo().RowCount <= 0 || len(ds.PushedDownConds) == 0 {
+		return
+	}
+	statsHandle := domain.GetDomain(ds.SCtx()).StatsHandle()
+	pt := ds.Table.GetPartitionedTable()
+	if statsHandle == nil || pt == nil {
+		return
+	}
+	summary := statsHandle.GetPartitionCardinality(ds.TableInfo, 0)
+	if !summary.IsComplete() {
+		return
+	}
+	colRanges := buildEncodedColumnRanges(ds)
+	if len(colRanges) == 0 {
+		return
+	}
+	pids, _ := usedPartitionIDs(ds, pi, pt)
+	if pids == nil {
+		return
+	}
+	touched := make([]int64, 0, len(pids))
+	for _, pid := range pids {
+		statsTbl, ok := statsHandle.Get(pid)
+		if !ok || statsTbl.ModifyCount > 0 || partitionColumnBoundsOverlap(statsTbl, pid, colRanges) {
+			touched = append(touched, pid)
+		}
+	}
+	rowCount, ok := summary.RowCountOfPartitions(touched)
+	if !ok || float64(rowCount) >= ds.StatsInfo().RowCount {
+		return
+	}
+	ds.SetStats(ds.StatsInfo().Scale(float64(rowCount) / ds.StatsInfo().RowCount))
+}
+
+// partitionColumnBoundsOverlap returns whether the ranges of every filtered column overlap with the bounds of the
+// column in the partition. The column without available bounds is treated as overlapped, and its stats are loaded
+// asynchronously for the later queries if needed.
+func partitionColumnBoundsOverlap(statsTbl *statistics.Table, pid int64, colRanges map[int64][]encodedColumnRange) bool {
+	for colID, ranges := range colRanges {
+		lower, upper, ok := statsTbl.EncodedColumnBounds(colID)
+		if !ok {
+			if _, loadNeeded, analyzed := statsTbl.ColumnIsLoadNeeded(colID, true); loadNeeded && analyzed {
+				asyncload.AsyncLoadHistogramNeededItems.Insert(model.TableItemID{TableID: pid, ID: colID}, true)
+			}
+			continue
+		}
+		if !slices.ContainsFunc(ranges, func(r encodedColumnRange) bool { return r.overlaps(lower, upper) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// adjustStatsByCardinalityFeedback corrects the estimated row count of the data source by the runtime cardinality
+// feedback collected from the previous executions of the same statement. The feedback is only applied after enough
+// stable samples are collected, and the corrected row count never exceeds the row count of the table. The row counts
+// of the access paths are scaled by the same factor, so the estimations of the readers built from them agree with
+// the corrected row count.
+// The estimated row count before the correction is kept in the statement context, so the executors can report the
+// error of the estimation itself rather than the error of the corrected one.
+func adjustStatsByCardinalityFeedback(ds *logicalop.DataSource) {
+	if !vardef.EnableCardinalityFeedback.Load() {
+		return
+	}
+	sessionVars := ds.SCtx().GetSessionVars()
+	if sessionVars.InRestrictedSQL || ds.TableInfo.TempTableType != model.TempTableNone {
+		return
+	}
+	statsHandle := domain.GetDomain(ds.SCtx()).StatsHandle()
+	sc := sessionVars.StmtCtx
+	_, digest := sc.SQLDigest()
+	if statsHandle == nil || digest == nil {
+		return
+	}
+	tableID := ds.TableInfo.ID
+	if sc.CardinalityFeedbackEstRows == nil {
+		sc.CardinalityFeedbackEstRows = make(map[int64]float64)
+	}
+	if _, ok := sc.CardinalityFeedbackEstRows[tableID]; ok {
+		// The feedback can't tell which data source it belongs to if the table is read more than once.
+		sc.CardinalityFeedbackEstRows[tableID] = -1
+		return
+	}
+	rowCount := ds.StatsInfo().RowCount
+	sc.CardinalityFeedbackEstRows[tableID] = rowCount
+	factor, ok := statsHandle.GetCardinalityFeedbackFactor(digest.String(), tableID)
+	if !ok || rowCount <= 0 {
+		return
+	}
+	newRowCount := math.Max(math.Min(rowCount*factor, ds.TableStats.RowCount), 1)
+	if newRowCount == rowCount {
+		return
+	}
+	factor = newRowCount / rowCount
+	ds.SetStats(ds.StatsInfo().Scale(factor))
+	for _, path := range ds.PossibleAccessPaths {
+		path.CountAfterAccess = math.Min(path.CountAfterAccess*factor, ds.TableStats.RowCount)
+		path.CountAfterIndex = math.Min(path.CountAfterIndex*factor, ds.TableStats.RowCount)
+		path.CorrCountAfterAccess = math.Min(path.CorrCountAfterAccess*factor, ds.TableStats.RowCount)
+	}
+	// The correction depends on the feedback of the latest executions, so the plan shouldn't be cached.
+	sc.SetSkipPlanCache("the estimation is corrected by the runtime cardinality feedback")
+	sc.AppendNote(errors.NewNoStackErrorf("The estimated row count of table %s is corrected from %.2f to %.2f by the runtime cardinality feedback",
+		ds.TableInfo.Name.O, rowCount, newRowCount))
+}
+
+// markLowConfidenceStats marks the stats of the data source as low confidence for the risk-aware plan selection if
+// the estimation is derived from the pseudo or outdated stats, or any access range goes out of the histogram, where
+// the estimated row count may be far away from the real one.
+func markLowConfidenceStats(ds *logicalop.DataSource) {
+	if ds.SCtx().GetSessionVars().RiskAwareCostFactor <= 1 {
+		return
+	}
+	lowConfidence := ds.StatisticTable.Pseudo || ds.StatisticTable.IsOutdated() || accessRangesOutOfHistogram(ds)
+	ds.TableStats.LowConfidence = lowConfidence
+	ds.StatsInfo().LowConfidence = lowConfidence
+}
+
+// recordStatsOutOfRange records whether the access ranges of the data source go out of the histograms in the used
+// stats info of the table, which is shown in the execution plan when tidb_explain_stats_health is on.
+func recordStatsOutOfRange(ds *logicalop.DataSource) {
+	if !ds.SCtx().GetSessionVars().ExplainStatsHealth || ds.StatisticTable.Pseudo {
+		return
+	}
+	usedInfo := ds.SCtx().GetSessionVars().StmtCtx.GetUsedStatsInfo(true).GetUsedInfo(ds.PhysicalTableID)
+	if usedInfo != nil && !usedInfo.OutOfRange {
+		usedInfo.OutOfRange = accessRangesOutOfHistogram(ds)
+	}
+}
+
+// accessRangesOutOfHistogram returns whether the ranges of any access path of the data source go out of the histogram.
+func accessRangesOutOfHistogram(ds *logicalop.DataSource) bool {
+	for _, path := range ds.PossibleAccessPaths {
+		var col *expression.Column
+		if path.IsTablePath() {
+			if handleCol := ds.GetPKIsHandleCol(); handleCol != nil {
+				col = handleCol
+			} else if len(ds.CommonHandleCols) > 0 {
+				col = ds.CommonHandleCols[0]
+			}
+		} else if len(path.IdxCols) > 0 {
+			col = path.IdxCols[0]
+		}
+		if col == nil {
+			continue
+		}
+		if c := ds.TableStats.HistColl.GetCol(col.UniqueID); c != nil && c.Histogram.Len() > 0 {
+			if rangesOutOfHistogram(c, path.Ranges) {
+				return true
+			}
+		} else if !path.IsTablePath() && rangesOutOfIndexHistogram(ds.SCtx(), ds.StatisticTable.GetIdx(path.Index.ID), path.Ranges) {
+			// The histogram of the column may not be loaded, so we check the histogram of the index instead.
+			return true
+		}
+	}
+	return false
+}
+
+// rangesOutOfIndexHistogram is like rangesOutOfHistogram, but it checks the first column of the ranges against the
+// histogram of the index, whose bounds are the encoded index values.
+func rangesOutOfIndexHistogram(sctx base.PlanContext, idx *statistics.Index, ranges []*ranger.Range) bool {
+	if idx == nil || idx.Histogram.Len() == 0 {
+		return false
+	}
+	loc := sctx.GetSessionVars().StmtCtx.TimeZone()
+	lowerBound := idx.Histogram.Bounds.GetRow(0).GetBytes(0)
+	for _, ran := range ranges {
+		if len(ran.LowVal) == 0 || len(ran.HighVal) == 0 {
+			continue
+		}
+		for _, val := range []types.Datum{ran.LowVal[0], ran.HighVal[0]} {
+			switch val.Kind() {
+			case types.KindNull, types.KindMinNotNull, types.KindMaxValue:
+				continue
+			case types.KindString:
+				val = *val.Clone()
+				val.SetBytes(collate.GetCollator(val.Collation()).Key(val.GetString()))
+			}
+			key, err := codec.EncodeKey(loc, nil, val)
+			if err != nil {
+				continue
+			}
+			// The value is a prefix of the lower bound if it equals to the first column of the lower bound.
+			if idx.Histogram.OutOfRange(types.NewBytesDatum(key)) && !bytes.HasPrefix(lowerBound, key) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rangesOutOfHistogram returns whether any bounded side of the ranges goes out of the histogram of the column.
+// Only the first column of the ranges is checked.
+func rangesOutOfHistogram(c *statistics.Column, ranges []*ranger.Range) bool {
+	if c == nil || c.Histogram.Len() == 0 {
+		return false
+	}
+	for _, ran := range ranges {
+		if len(ran.LowVal) == 0 || len(ran.HighVal) == 0 {
+			continue
+		}
+		for _, val := range []types.Datum{ran.LowVal[0], ran.HighVal[0]} {
+			switch val.Kind() {
+			case types.KindNull, types.KindMinNotNull, types.KindMaxValue:
+				continue
+			case types.KindString:
+				val = *val.Clone()
+				val.SetBytes(collate.GetCollator(val.Collation()).Key(val.GetString()))
+			}
+			if c.Histogram.OutOfRange(val) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // We bind logic of derivePathStats and tryHeuristics together. When some path matches the heuristic rule, we don't need
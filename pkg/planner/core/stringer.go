@@ -181,11 +181,15 @@ func toString(in base.Plan, strs []string, idxs []int) ([]string, []int) {
 		str = "Show"
 		if pl := in.(*logicalop.LogicalShow); pl.Extractor != nil {
 			str = str + "(" + pl.Extractor.ExplainInfo() + ")"
+		} else if pl.StatsExtractor != nil {
+			str = str + "(" + pl.StatsExtractor.ExplainInfo(nil) + ")"
 		}
 	case *PhysicalShow:
 		str = "Show"
 		if pl := in.(*PhysicalShow); pl.Extractor != nil {
 			str = str + "(" + pl.Extractor.ExplainInfo() + ")"
+		} else if pl.StatsExtractor != nil {
+			str = str + "(" + pl.StatsExtractor.ExplainInfo(pl) + ")"
 		}
 	case *logicalop.LogicalShowDDLJobs, *PhysicalShowDDLJobs:
 		str = "ShowDDLJobs"
@@ -24,6 +24,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/util/intest"
@@ -98,7 +99,17 @@ func adviseIndexesWithOption(ctx context.Context, sctx sessionctx.Context,
 		return nil, err
 	}
 
-	results, err = prepareRecommendation(indexes, querySet, opt)
+	// the stats of the predicate columns explain the recommendations
+	predicateCols := make(map[Column]*PredicateColumn)
+	if cols, err := LoadPredicateColumns(sctx, sctx.GetDomainInfoSchema().(infoschema.InfoSchema)); err != nil {
+		advisorLogger().Warn("load predicate columns failed", zap.Error(err))
+	} else {
+		for _, c := range cols {
+			predicateCols[c.Column] = c
+		}
+	}
+
+	results, err = prepareRecommendation(indexes, querySet, opt, predicateCols)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +194,8 @@ func loadQuerySetFromStmtSummary(sctx sessionctx.Context, option *Option) (s.Set
 	return querySet, nil
 }
 
-func prepareRecommendation(indexes s.Set[Index], queries s.Set[Query], optimizer Optimizer) ([]*Recommendation, error) {
+func prepareRecommendation(indexes s.Set[Index], queries s.Set[Query], optimizer Optimizer,
+	predicateCols map[Column]*PredicateColumn) ([]*Recommendation, error) {
 	advisorLogger().Info("recommend index", zap.Int("num-index", indexes.Size()))
 	results := make([]*Recommendation, 0, indexes.Size())
 	for _, idx := range indexes.ToList() {
@@ -266,6 +278,9 @@ func prepareRecommendation(indexes s.Set[Index], queries s.Set[Query], optimizer
 		indexResult.WorkloadImpact = workloadImpact
 		indexResult.IndexDetail.Reason =
 			fmt.Sprintf(`Column %v appear in Equal or Range Predicate clause(s) in query: %v`, cols, normText)
+		if c, ok := predicateCols[idx.Columns[0]]; ok {
+			indexResult.IndexDetail.Reason += "; " + c.Reason()
+		}
 		results = append(results, indexResult)
 	}
 	return results, nil
@@ -0,0 +1,200 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexadvisor
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+// PredicateColumn is a column without a leading index on it, which is filtered by the point or narrow range
+// predicates recorded in mysql.column_stats_usage.
+type PredicateColumn struct {
+	Column
+	PointPredicates       int64
+	NarrowRangePredicates int64
+	WideRangePredicates   int64
+	TableRows             int64
+	NDV                   int64
+	// QueryFrequency is the executions of the SELECT statements on the table in the statements summary of the last day.
+	QueryFrequency int64
+	// EstimatedBenefit is the estimated rows not to be scanned by the statements on the table if the column is indexed.
+	EstimatedBenefit float64
+}
+
+// Selectivity returns the estimated selectivity of a point predicate on the column.
+func (c *PredicateColumn) Selectivity() float64 {
+	if c.NDV <= 0 {
+		return 1
+	}
+	return 1 / float64(c.NDV)
+}
+
+// estimateBenefit assumes the statements on the table filter the column as the recorded predicates do. A point
+// predicate reads 1/NDV of the rows through the index, a narrow range one reads at most 1% of them, and a wide range
+// one doesn't benefit from the index. The recorded predicates stand for the statements if the statements summary
+// knows nothing about the table.
+func (c *PredicateColumn) estimateBenefit() float64 {
+	total := c.PointPredicates + c.NarrowRangePredicates + c.WideRangePredicates
+	if total == 0 || c.TableRows == 0 {
+		return 0
+	}
+	savedRatio := (float64(c.PointPredicates)*(1-c.Selectivity()) +
+		float64(c.NarrowRangePredicates)*(1-stmtctx.NarrowRangeSelectivity)) / float64(total)
+	frequency := c.QueryFrequency
+	if frequency == 0 {
+		frequency = total
+	}
+	return round(float64(frequency)*savedRatio*float64(c.TableRows), 2)
+}
+
+// Reason describes the stats of the column behind the recommendation.
+func (c *PredicateColumn) Reason() string {
+	return fmt.Sprintf("Column %v is filtered by %v point and %v narrow range predicate(s), NDV %v of %v rows",
+		c.ColumnName, c.PointPredicates, c.NarrowRangePredicates, c.NDV, c.TableRows)
+}
+
+// LoadPredicateColumns loads the candidate columns of the missing indexes from the predicate column usage, the column
+// stats and the statements summary, ordered by the estimated benefit.
+func LoadPredicateColumns(sctx sessionctx.Context, is infoschema.InfoSchema) ([]*PredicateColumn, error) {
+	rows, err := execRestricted(sctx, `SELECT table_id, column_id, point_predicates, narrow_range_predicates, wide_range_predicates
+		FROM mysql.column_stats_usage WHERE point_predicates + narrow_range_predicates > 0`)
+	if err != nil {
+		return nil, err
+	}
+	type tableColumn struct {
+		tableID, columnID int64
+	}
+	candidates := make(map[tableColumn]*PredicateColumn, len(rows))
+	tableIDs := make([]int64, 0, len(rows))
+	tableNames := make(map[int64]string)
+	for _, row := range rows {
+		tableID, columnID := row.GetInt64(0), row.GetInt64(1)
+		tblInfo, ok := is.TableInfoByID(tableID)
+		if !ok {
+			continue
+		}
+		db, ok := infoschema.SchemaByTable(is, tblInfo)
+		if !ok || util.IsMemOrSysDB(db.Name.L) {
+			continue
+		}
+		col := model.FindColumnInfoByID(tblInfo.Columns, columnID)
+		if col == nil || col.State != model.StatePublic || !isIndexableColumnType(&col.FieldType) ||
+			hasLeadingIndex(tblInfo, col) {
+			continue
+		}
+		if _, ok := tableNames[tableID]; !ok {
+			tableIDs = append(tableIDs, tableID)
+			tableNames[tableID] = db.Name.L + "." + tblInfo.Name.L
+		}
+		candidates[tableColumn{tableID, columnID}] = &PredicateColumn{
+			Column:                NewColumn(db.Name.L, tblInfo.Name.L, col.Name.L),
+			PointPredicates:       row.GetInt64(2),
+			NarrowRangePredicates: row.GetInt64(3),
+			WideRangePredicates:   row.GetInt64(4),
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	tableRows := make(map[int64]int64, len(tableIDs))
+	if rows, err = execRestricted(sctx, "SELECT table_id, count FROM mysql.stats_meta WHERE table_id IN (%?)", tableIDs); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		tableRows[row.GetInt64(0)] = int64(row.GetUint64(1))
+	}
+	if rows, err = execRestricted(sctx, `SELECT table_id, hist_id, distinct_count FROM mysql.stats_histograms
+		WHERE is_index = 0 AND table_id IN (%?)`, tableIDs); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if c, ok := candidates[tableColumn{row.GetInt64(0), row.GetInt64(1)}]; ok {
+			c.NDV = row.GetInt64(2)
+		}
+	}
+	frequencies, err := loadTableQueryFrequencies(sctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*PredicateColumn, 0, len(candidates))
+	for key, c := range candidates {
+		c.TableRows = tableRows[key.tableID]
+		c.QueryFrequency = frequencies[tableNames[key.tableID]]
+		c.EstimatedBenefit = c.estimateBenefit()
+		results = append(results, c)
+	}
+	slices.SortFunc(results, func(a, b *PredicateColumn) int {
+		return cmp.Or(cmp.Compare(b.EstimatedBenefit, a.EstimatedBenefit), strings.Compare(a.Key(), b.Key()))
+	})
+	return results, nil
+}
+
+// hasLeadingIndex returns whether the column is the handle or the leading column of an index of the table.
+func hasLeadingIndex(tblInfo *model.TableInfo, col *model.ColumnInfo) bool {
+	if tblInfo.PKIsHandle && mysql.HasPriKeyFlag(col.GetFlag()) {
+		return true
+	}
+	for _, idx := range tblInfo.Indices {
+		if idx.State == model.StatePublic && idx.Columns[0].Offset == col.Offset {
+			return true
+		}
+	}
+	return false
+}
+
+// loadTableQueryFrequencies loads the executions of the SELECT statements on each table from the statements summary
+// of the last day.
+func loadTableQueryFrequencies(sctx sessionctx.Context) (map[string]int64, error) {
+	rows, err := execRestricted(sctx, `SELECT table_names, sum(cast(exec_count as double))
+		FROM information_schema.statements_summary_history
+		WHERE stmt_type = "Select" AND summary_begin_time >= date_sub(now(), interval 1 day)
+		GROUP BY table_names`)
+	if err != nil {
+		return nil, err
+	}
+	frequencies := make(map[string]int64)
+	for _, row := range rows {
+		for _, name := range strings.Split(row.GetString(0), ",") {
+			if name != "" {
+				frequencies[name] += int64(row.GetFloat64(1))
+			}
+		}
+	}
+	return frequencies, nil
+}
+
+func execRestricted(sctx sessionctx.Context, sql string, args ...any) ([]chunk.Row, error) {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	rows, _, err := sctx.GetRestrictedSQLExecutor().ExecRestrictedSQL(ctx, nil, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("execute %v failed: %v", sql, err)
+	}
+	return rows, nil
+}
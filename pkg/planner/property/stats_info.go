@@ -47,6 +47,10 @@ type StatsInfo struct {
 
 	// GroupNDVs stores the NDV of column groups.
 	GroupNDVs []GroupNDV
+
+	// LowConfidence indicates the RowCount is derived from the unreliable stats, e.g. the pseudo or outdated stats,
+	// or the ranges out of the histograms. It's used by the risk-aware plan selection.
+	LowConfidence bool
 }
 
 // String implements fmt.Stringer interface.
@@ -62,11 +66,12 @@ func (s *StatsInfo) Count() int64 {
 // Scale receives a selectivity and multiplies it with RowCount and NDV.
 func (s *StatsInfo) Scale(factor float64) *StatsInfo {
 	profile := &StatsInfo{
-		RowCount:     s.RowCount * factor,
-		ColNDVs:      make(map[int64]float64, len(s.ColNDVs)),
-		HistColl:     s.HistColl,
-		StatsVersion: s.StatsVersion,
-		GroupNDVs:    make([]GroupNDV, len(s.GroupNDVs)),
+		RowCount:      s.RowCount * factor,
+		ColNDVs:       make(map[int64]float64, len(s.ColNDVs)),
+		HistColl:      s.HistColl,
+		StatsVersion:  s.StatsVersion,
+		GroupNDVs:     make([]GroupNDV, len(s.GroupNDVs)),
+		LowConfidence: s.LowConfidence,
 	}
 	for id, c := range s.ColNDVs {
 		profile.ColNDVs[id] = c * factor
@@ -104,6 +104,58 @@ const (
 	PostParse
 )
 
+// StatsEvent presents the statements changing the statistics, which change the plans of the queries.
+type StatsEvent byte
+
+const (
+	// LockStats represents LOCK STATS.
+	LockStats StatsEvent = iota
+	// UnlockStats represents UNLOCK STATS.
+	UnlockStats
+	// DropStats represents DROP STATS.
+	DropStats
+	// LoadStats represents LOAD STATS.
+	LoadStats
+)
+
+func (e StatsEvent) String() string {
+	switch e {
+	case LockStats:
+		return "LockStats"
+	case UnlockStats:
+		return "UnlockStats"
+	case DropStats:
+		return "DropStats"
+	case LoadStats:
+		return "LoadStats"
+	}
+	return ""
+}
+
+// The scopes of the statistics of a table affected by a StatsEvent.
+const (
+	// StatsScopeTable means the statistics of the whole table, including all its partitions, are affected.
+	StatsScopeTable = "table"
+	// StatsScopePartition means the statistics of the partitions in StatsEventInfo.Items are affected.
+	StatsScopePartition = "partition"
+	// StatsScopeGlobal means only the global statistics of the partitioned table are affected.
+	StatsScopeGlobal = "global"
+	// StatsScopeColumn means the statistics of the columns in StatsEventInfo.Items are affected.
+	StatsScopeColumn = "column"
+	// StatsScopeIndex means the statistics of the indexes in StatsEventInfo.Items are affected.
+	StatsScopeIndex = "index"
+)
+
+// StatsEventInfo presents the statistics of a table affected by a StatsEvent.
+type StatsEventInfo struct {
+	DBName    string
+	TableName string
+	// Scope is one of StatsScopeTable, StatsScopePartition, StatsScopeGlobal, StatsScopeColumn and StatsScopeIndex.
+	Scope string
+	// Items are the names of the affected partitions, columns or indexes, it's empty for the other scopes.
+	Items []string
+}
+
 // AuditManifest presents a sub-manifest that every audit plugin must provide.
 type AuditManifest struct {
 	Manifest
@@ -116,6 +168,25 @@ type AuditManifest struct {
 	OnGlobalVariableEvent func(ctx context.Context, sctx *variable.SessionVars, varName, varValue string)
 	// OnParseEvent will be called around parse logic.
 	OnParseEvent func(ctx context.Context, sctx *variable.SessionVars, event ParseEvent) error
+	// OnStatsEvent will be called for each table after its statistics are changed by the statement.
+	OnStatsEvent func(ctx context.Context, sctx *variable.SessionVars, event StatsEvent, info *StatsEventInfo)
+}
+
+// NotifyStatsEvent calls OnStatsEvent of the audit plugins for each table whose statistics are changed.
+func NotifyStatsEvent(ctx context.Context, sctx *variable.SessionVars, event StatsEvent, infos []*StatsEventInfo) {
+	if !IsEnable(Audit) {
+		return
+	}
+	_ = ForeachPlugin(Audit, func(p *Plugin) error {
+		auditPlugin := DeclareAuditManifest(p.Manifest)
+		if auditPlugin.OnStatsEvent == nil {
+			return nil
+		}
+		for _, info := range infos {
+			auditPlugin.OnStatsEvent(ctx, sctx, event, info)
+		}
+		return nil
+	})
 }
 
 type (
@@ -16,11 +16,13 @@ package plugin_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/pingcap/tidb/pkg/executor"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/plugin"
@@ -689,7 +691,7 @@ func TestAuditLogNormal(t *testing.T) {
 		}
 		testResults = append(testResults, audit)
 	}
-	loadPlugin(t, onGeneralEvent)
+	loadPlugin(t, onGeneralEvent, nil)
 	defer plugin.Shutdown(context.Background())
 
 	require.NoError(t, conn.HandleQuery(context.Background(), "use test"))
@@ -731,7 +733,72 @@ func TestAuditLogNormal(t *testing.T) {
 	}
 }
 
-func loadPlugin(t *testing.T, onGeneralEvent func(context.Context, *variable.SessionVars, plugin.GeneralEvent, string)) {
+func TestAuditStatsEvent(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	sv := server.CreateMockServer(t, store)
+	defer sv.Close()
+	conn := server.CreateMockConn(t, sv)
+	defer conn.Close()
+
+	var (
+		events    []string
+		stmtValue any
+	)
+	onStatsEvent := func(ctx context.Context, sctx *variable.SessionVars, event plugin.StatsEvent, info *plugin.StatsEventInfo) {
+		stmtValue = ctx.Value(stmtKey{})
+		events = append(events, fmt.Sprintf("%s %s.%s %s %s", event, info.DBName, info.TableName, info.Scope, strings.Join(info.Items, ",")))
+	}
+	loadPlugin(t, nil, onStatsEvent)
+	defer plugin.Shutdown(context.Background())
+
+	ctx := context.Background()
+	require.NoError(t, conn.HandleQuery(ctx, "use test"))
+	require.NoError(t, conn.HandleQuery(ctx, "create table t1 (a int, b int)"))
+	require.NoError(t, conn.HandleQuery(ctx, "create table t2 (a int) partition by hash(a) partitions 2"))
+	tests := []struct {
+		sql    string
+		events []string
+	}{
+		{"lock stats t1, t2", []string{"LockStats test.t1 table ", "LockStats test.t2 table "}},
+		{"unlock stats t1, t2", []string{"UnlockStats test.t1 table ", "UnlockStats test.t2 table "}},
+		{"lock stats t2 partition p0, p1", []string{"LockStats test.t2 partition p0,p1"}},
+		{"unlock stats t2 partition p0", []string{"UnlockStats test.t2 partition p0"}},
+		{"lock stats t1 columns (a, b)", []string{"LockStats test.t1 column a,b"}},
+		{"drop stats t1", []string{"DropStats test.t1 table "}},
+		{"drop stats t2 global", []string{"DropStats test.t2 global "}},
+		// The failed statements don't change the stats.
+		{"lock stats t1 partition p0", nil},
+	}
+	for _, test := range tests {
+		events = events[:0]
+		_ = conn.HandleQuery(ctx, test.sql)
+		if test.events == nil {
+			require.Empty(t, events, test.sql)
+			continue
+		}
+		require.Equal(t, test.events, events, test.sql)
+	}
+
+	// The audit plugins get the context of the LOAD STATS statement.
+	require.NoError(t, conn.HandleQuery(ctx, "insert into t1 values (1, 1)"))
+	require.NoError(t, conn.HandleQuery(ctx, "analyze table t1"))
+	tk := testkit.NewTestKit(t, store)
+	tblInfo := dom.MustGetTableInfo(t, "test", "t1")
+	jsonTbl, err := dom.StatsHandle().DumpStatsToJSON("test", tblInfo, nil, true)
+	require.NoError(t, err)
+	data, err := json.Marshal(jsonTbl)
+	require.NoError(t, err)
+	events = events[:0]
+	loadStatsCtx := context.WithValue(ctx, stmtKey{}, "load stats")
+	require.NoError(t, (&executor.LoadStatsInfo{Path: "stats.json", Ctx: tk.Session()}).Update(loadStatsCtx, data))
+	require.Equal(t, []string{"LoadStats test.t1 table "}, events)
+	require.Equal(t, "load stats", stmtValue)
+}
+
+type stmtKey struct{}
+
+func loadPlugin(t *testing.T, onGeneralEvent func(context.Context, *variable.SessionVars, plugin.GeneralEvent, string),
+	onStatsEvent func(context.Context, *variable.SessionVars, plugin.StatsEvent, *plugin.StatsEventInfo)) {
 	ctx := context.Background()
 	pluginName := "audit_test"
 	pluginVersion := uint16(1)
@@ -770,6 +837,7 @@ func loadPlugin(t *testing.T, onGeneralEvent func(context.Context, *variable.Ses
 				},
 				OnGeneralEvent:    onGeneralEvent,
 				OnConnectionEvent: onConnectionEvent,
+				OnStatsEvent:      onStatsEvent,
 			}
 			return plugin.ExportManifest(m)
 		}, nil
@@ -397,6 +397,31 @@ func TestResourceGroupRunaway(t *testing.T) {
 	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/resourcegroup/runaway/checkThresholds"))
 }
 
+func TestResourceGroupRunawayExemptAnalyze(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "localhost"}, nil, nil, nil))
+
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, index ia(a))")
+	tk.MustExec("insert into t values(1)")
+
+	tk.MustExec("set global tidb_enable_resource_control='on'")
+	tk.MustExec("create resource group rg1 RU_PER_SEC=1000 QUERY_LIMIT=(EXEC_ELAPSED='50ms' ACTION=KILL WATCH EXACT)")
+	tk.MustExec("set resource group rg1")
+	require.Eventually(t, func() bool {
+		return dom.RunawayManager().IsSyncerInitialized()
+	}, 20*time.Second, 300*time.Millisecond)
+
+	testfailpoint.Enable(t, "github.com/pingcap/tidb/pkg/store/copr/sleepCoprRequest", fmt.Sprintf("return(%d)", 60))
+	err := tk.QueryToErr("select * from t")
+	require.ErrorContains(t, err, "Query execution was interrupted, identified as runaway query")
+	// The analyze isn't identified as a runaway query, nor quarantined by the watch.
+	tk.MustExec("analyze table t")
+	tk.MustExec("analyze table t")
+	tk.MustQuery("select SQL_NO_CACHE count(*) from mysql.tidb_runaway_watch where watch_text like 'analyze%'").Check(testkit.Rows("0"))
+}
+
 func TestResourceGroupRunawayExceedTiDBSide(t *testing.T) {
 	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/resourcegroup/runaway/FastRunawayGC", `return(true)`))
 	defer func() {
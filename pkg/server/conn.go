@@ -1646,7 +1646,7 @@ func (cc *clientConn) handleLoadStats(ctx context.Context, loadStatsInfo *execut
 	if len(data) == 0 {
 		return nil
 	}
-	return loadStatsInfo.Update(data)
+	return loadStatsInfo.Update(ctx, data)
 }
 
 func (cc *clientConn) handlePlanReplayerLoad(ctx context.Context, planReplayerLoadInfo *executor.PlanReplayerLoadInfo) error {
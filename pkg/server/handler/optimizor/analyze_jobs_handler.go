@@ -0,0 +1,299 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/domain/infosync"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/server/handler"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/autoanalyze/exec"
+	statsutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/sqlescape"
+	"go.uber.org/zap"
+)
+
+// AnalyzeJob is a running or queued analyze job returned by the analyze jobs API.
+type AnalyzeJob struct {
+	ID            uint64  `json:"id"`
+	TableSchema   string  `json:"table_schema"`
+	TableName     string  `json:"table_name"`
+	PartitionName string  `json:"partition_name"`
+	JobInfo       string  `json:"job_info"`
+	ProcessedRows int64   `json:"processed_rows"`
+	StartTime     string  `json:"start_time,omitempty"`
+	State         string  `json:"state"`
+	Instance      string  `json:"instance"`
+	ProcessID     *uint64 `json:"process_id,omitempty"`
+}
+
+const selectAnalyzeJobsSQL = `SELECT id, table_schema, table_name, partition_name, job_info, processed_rows,
+		start_time, state, instance, process_id
+		FROM mysql.analyze_jobs
+		WHERE state IN ('pending', 'running')`
+
+func getAnalyzeJobs(do *domain.Domain, jobID *uint64) ([]AnalyzeJob, error) {
+	var jobs []AnalyzeJob
+	err := statsutil.CallWithSCtx(do.StatsHandle().SPool(), func(sctx sessionctx.Context) error {
+		sql := selectAnalyzeJobsSQL + " ORDER BY id"
+		var args []any
+		if jobID != nil {
+			sql = selectAnalyzeJobsSQL + " AND id = %?"
+			args = append(args, *jobID)
+		}
+		rows, _, err := statsutil.ExecRows(sctx, sql, args...)
+		if err != nil {
+			return err
+		}
+		jobs = make([]AnalyzeJob, 0, len(rows))
+		for _, row := range rows {
+			job := AnalyzeJob{
+				ID:            row.GetUint64(0),
+				TableSchema:   row.GetString(1),
+				TableName:     row.GetString(2),
+				PartitionName: row.GetString(3),
+				JobInfo:       row.GetString(4),
+				ProcessedRows: row.GetInt64(5),
+				State:         row.GetString(7),
+				Instance:      row.GetString(8),
+			}
+			if !row.IsNull(6) {
+				job.StartTime = row.GetTime(6).String()
+			}
+			if !row.IsNull(9) {
+				processID := row.GetUint64(9)
+				job.ProcessID = &processID
+			}
+			jobs = append(jobs, job)
+		}
+		return nil
+	})
+	return jobs, err
+}
+
+// AnalyzeJobsHandler is the handler for listing the running and queued analyze jobs, i.e. `GET /stats/analyze/jobs`,
+// and canceling a job, i.e. `POST /stats/analyze/jobs/{jobID}/cancel`.
+type AnalyzeJobsHandler struct {
+	do *domain.Domain
+}
+
+// NewAnalyzeJobsHandler creates a new AnalyzeJobsHandler.
+func NewAnalyzeJobsHandler(do *domain.Domain) *AnalyzeJobsHandler {
+	return &AnalyzeJobsHandler{do: do}
+}
+
+// ServeHTTP lists or cancels the analyze jobs.
+func (ah AnalyzeJobsHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	params := mux.Vars(req)
+	idStr, ok := params["jobID"]
+	if !ok {
+		jobs, err := getAnalyzeJobs(ah.do, nil)
+		if err != nil {
+			handler.WriteError(w, err)
+			return
+		}
+		handler.WriteData(w, jobs)
+		return
+	}
+
+	if req.Method != http.MethodPost {
+		handler.WriteError(w, errors.Errorf("This api only support POST method"))
+		return
+	}
+	jobID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		handler.WriteError(w, errors.Errorf("invalid job ID %s", idStr))
+		return
+	}
+	if err := ah.cancelJob(jobID); err != nil {
+		handler.WriteError(w, err)
+		return
+	}
+	handler.WriteData(w, "success!")
+}
+
+// cancelJob cancels the analyze job by killing the analyze statement the job belongs to, so the other jobs of the
+// same statement are canceled too. Only the jobs running on the current instance can be canceled.
+func (ah AnalyzeJobsHandler) cancelJob(jobID uint64) error {
+	jobs, err := getAnalyzeJobs(ah.do, &jobID)
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return errors.Errorf("analyze job %d is not found or has finished", jobID)
+	}
+	job := jobs[0]
+	serverInfo, err := infosync.GetServerInfo()
+	if err != nil {
+		return err
+	}
+	instance := net.JoinHostPort(serverInfo.IP, strconv.Itoa(int(serverInfo.Port)))
+	if job.Instance != instance {
+		return errors.Errorf("analyze job %d is running on the instance %s, please cancel it there", jobID, job.Instance)
+	}
+	if job.ProcessID == nil {
+		return errors.Errorf("analyze job %d has no process to cancel", jobID)
+	}
+	sm := ah.do.InfoSyncer().GetSessionManager()
+	if sm == nil {
+		return errors.New("the session manager is not available")
+	}
+	sm.Kill(*job.ProcessID, true, false, false)
+	logutil.BgLogger().Info("cancel the analyze job by the HTTP API", zap.Uint64("jobID", jobID),
+		zap.Uint64("processID", *job.ProcessID))
+	return nil
+}
+
+// AnalyzeTableHandler is the handler for triggering an analyze for a table, i.e. `POST /stats/analyze/{db}/{table}`.
+// The options are given by the query parameters:
+//   - partitions: the comma separated partitions to analyze.
+//   - columns: the comma separated columns to analyze, or `all` / `predicate` for `ALL COLUMNS` / `PREDICATE COLUMNS`.
+//   - buckets, topn, cmsketch_depth, cmsketch_width, samples, samplerate: the same as the options of the ANALYZE
+//     statement.
+type AnalyzeTableHandler struct {
+	do *domain.Domain
+}
+
+// NewAnalyzeTableHandler creates a new AnalyzeTableHandler.
+func NewAnalyzeTableHandler(do *domain.Domain) *AnalyzeTableHandler {
+	return &AnalyzeTableHandler{do: do}
+}
+
+var analyzeOptionParams = []struct {
+	param   string
+	option  string
+	isFloat bool
+}{
+	{"buckets", "BUCKETS", false},
+	{"topn", "TOPN", false},
+	{"cmsketch_depth", "CMSKETCH DEPTH", false},
+	{"cmsketch_width", "CMSKETCH WIDTH", false},
+	{"samples", "SAMPLES", false},
+	{"samplerate", "SAMPLERATE", true},
+}
+
+// buildAnalyzeSQL builds the analyze statement from the query parameters.
+func buildAnalyzeSQL(dbName, tblName string, req *http.Request) (string, error) {
+	var sb strings.Builder
+	sqlescape.MustFormatSQL(&sb, "ANALYZE TABLE %n.%n", dbName, tblName)
+	query := req.URL.Query()
+	if partitions := query.Get("partitions"); partitions != "" {
+		sb.WriteString(" PARTITION ")
+		for i, partition := range strings.Split(partitions, ",") {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sqlescape.MustFormatSQL(&sb, "%n", strings.TrimSpace(partition))
+		}
+	}
+	switch columns := query.Get("columns"); strings.ToLower(columns) {
+	case "":
+	case "all":
+		sb.WriteString(" ALL COLUMNS")
+	case "predicate":
+		sb.WriteString(" PREDICATE COLUMNS")
+	default:
+		sb.WriteString(" COLUMNS ")
+		for i, column := range strings.Split(columns, ",") {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sqlescape.MustFormatSQL(&sb, "%n", strings.TrimSpace(column))
+		}
+	}
+	options := make([]string, 0, len(analyzeOptionParams))
+	for _, opt := range analyzeOptionParams {
+		value := query.Get(opt.param)
+		if value == "" {
+			continue
+		}
+		if opt.isFloat {
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil || v < 0 {
+				return "", errors.Errorf("invalid value %s of %s", value, opt.param)
+			}
+			options = append(options, fmt.Sprintf("%s %s", strconv.FormatFloat(v, 'f', -1, 64), opt.option))
+			continue
+		}
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return "", errors.Errorf("invalid value %s of %s", value, opt.param)
+		}
+		options = append(options, fmt.Sprintf("%d %s", v, opt.option))
+	}
+	if len(options) > 0 {
+		sb.WriteString(" WITH ")
+		sb.WriteString(strings.Join(options, ", "))
+	}
+	return sb.String(), nil
+}
+
+// ServeHTTP triggers an analyze for the table in the background. The jobs can be listed by the AnalyzeJobsHandler.
+func (ah AnalyzeTableHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if req.Method != http.MethodPost {
+		handler.WriteError(w, errors.Errorf("This api only support POST method"))
+		return
+	}
+
+	params := mux.Vars(req)
+	tbl, err := ah.do.InfoSchema().TableByName(context.Background(), ast.NewCIStr(params["db"]), ast.NewCIStr(params["table"]))
+	if err != nil {
+		handler.WriteError(w, err)
+		return
+	}
+	dbInfo, ok := ah.do.InfoSchema().SchemaByID(tbl.Meta().DBID)
+	if !ok {
+		// The database is dropped after the table is found.
+		w.WriteHeader(http.StatusNotFound)
+		_, err = w.Write([]byte(infoschema.ErrDatabaseNotExists.GenWithStackByArgs(params["db"]).Error()))
+		terror.Log(errors.Trace(err))
+		return
+	}
+	sql, err := buildAnalyzeSQL(dbInfo.Name.O, tbl.Meta().Name.O, req)
+	if err != nil {
+		handler.WriteError(w, err)
+		return
+	}
+
+	h := ah.do.StatsHandle()
+	// The analyze is cancelled when the domain is closed.
+	ah.do.RunInBackground(func(ctx context.Context) {
+		err := statsutil.CallWithSCtxCtx(ctx, h.SPool(), func(sctx sessionctx.Context) error {
+			_, _, err := exec.RunAnalyzeStmt(sctx, h, ah.do.SysProcTracker(), sctx.GetSessionVars().AnalyzeVersion, sql)
+			return err
+		})
+		if err != nil {
+			logutil.BgLogger().Warn("analyze triggered by the HTTP API failed", zap.String("sql", sql), zap.Error(err))
+		}
+	}, "analyzeTableByHTTPAPI")
+	logutil.BgLogger().Info("analyze is triggered by the HTTP API", zap.String("sql", sql))
+	handler.WriteData(w, map[string]string{"sql": sql})
+}
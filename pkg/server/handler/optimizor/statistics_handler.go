@@ -67,14 +67,42 @@ func (sh StatsHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 	}
+	predicateColumnsOnly := false
+	predicateColumnsParams := req.URL.Query()[handler.PredicateColumns]
+	if len(predicateColumnsParams) > 0 && len(predicateColumnsParams[0]) > 0 {
+		predicateColumnsOnly, err = strconv.ParseBool(predicateColumnsParams[0])
+		if err != nil {
+			handler.WriteError(w, err)
+			return
+		}
+	}
+	var sinceVersion uint64
+	sinceVersionParams := req.URL.Query()[handler.SinceVersion]
+	if len(sinceVersionParams) > 0 && len(sinceVersionParams[0]) > 0 {
+		sinceVersion, err = strconv.ParseUint(sinceVersionParams[0], 10, 64)
+		if err != nil {
+			handler.WriteError(w, err)
+			return
+		}
+	}
 	tbl, err := is.TableByName(context.Background(), ast.NewCIStr(params[handler.DBName]), ast.NewCIStr(params[handler.TableName]))
 	if err != nil {
 		handler.WriteError(w, err)
+	} else if predicateColumnsOnly {
+		js, err := h.DumpColumnStatsUsageToJSON(params[handler.DBName], tbl.Meta())
+		if err != nil {
+			handler.WriteError(w, err)
+		} else {
+			handler.WriteData(w, js)
+		}
 	} else {
 		js, err := h.DumpStatsToJSON(params[handler.DBName], tbl.Meta(), nil, dumpPartitionStats)
 		if err != nil {
 			handler.WriteError(w, err)
 		} else {
+			if sinceVersion > 0 && js != nil {
+				js.RemoveUnchangedSince(sinceVersion)
+			}
 			handler.WriteData(w, js)
 		}
 	}
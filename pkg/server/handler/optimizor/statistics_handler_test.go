@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"testing"
 	"time"
@@ -137,6 +138,60 @@ func TestDumpStatsAPI(t *testing.T) {
 	checkData(t, path1, client)
 
 	testDumpPartitionTableStats(t, client, statsHandler)
+	testDumpStatsSinceVersion(t, client, statsHandler)
+}
+
+func testDumpStatsSinceVersion(t *testing.T, client *testserverclient.TestServerClient, statHandle *optimizor.StatsHandler) {
+	dump := func(sinceVersion uint64) *statsutil.JSONTable {
+		resp, err := client.FetchStatus(fmt.Sprintf("/stats/dump/test/test2?dumpPartitionStats=true&since_version=%d", sinceVersion))
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, resp.Body.Close())
+		}()
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		jsonTable := &statsutil.JSONTable{}
+		require.NoError(t, json.Unmarshal(b, jsonTable))
+		return jsonTable
+	}
+	full := dump(0)
+	require.Len(t, full.Partitions, 2)
+	require.Zero(t, full.SinceVersion)
+	var maxVersion uint64
+	for _, partition := range full.Partitions {
+		require.NotEmpty(t, partition.Indices)
+		maxVersion = max(maxVersion, partition.Version)
+	}
+
+	// Nothing is changed since the latest version.
+	incremental := dump(maxVersion)
+	require.Equal(t, maxVersion, incremental.SinceVersion)
+	require.Empty(t, incremental.Partitions)
+
+	// Only the stats meta is changed by the DML, the histograms are not dumped again.
+	db, err := sql.Open("mysql", client.GetDSN())
+	require.NoError(t, err, "Error connecting")
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	tk := testkit.NewDBTestKit(t, db)
+	tk.MustExec("insert into test.test2 (a) values (2)")
+	h := statHandle.Domain().StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	incremental = dump(maxVersion)
+	require.Len(t, incremental.Partitions, 2)
+	for _, partition := range incremental.Partitions {
+		require.Greater(t, partition.Version, maxVersion)
+		require.Empty(t, partition.Columns)
+		require.Empty(t, partition.Indices)
+	}
+
+	resp, err := client.FetchStatus("/stats/dump/test/test2?since_version=abc")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
 }
 
 func prepareData(t *testing.T, client *testserverclient.TestServerClient, statHandle *optimizor.StatsHandler) {
@@ -347,6 +402,82 @@ func TestStatsPriorityQueueAPI(t *testing.T) {
 	require.Empty(t, snapshot.MustRetryTables)
 }
 
+func TestAnalyzeJobsAPI(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	driver := server2.NewTiDBDriver(store)
+	client := testserverclient.NewTestServerClient()
+	cfg := util.NewTestConfig()
+	cfg.Port = client.Port
+	cfg.Status.StatusPort = client.StatusPort
+	cfg.Status.ReportStatus = true
+	cfg.Socket = fmt.Sprintf("/tmp/tidb-mock-%d.sock", time.Now().UnixNano())
+
+	server, err := server2.NewServer(cfg, driver)
+	require.NoError(t, err)
+	defer server.Close()
+
+	dom, err := session.GetDomain(store)
+	require.NoError(t, err)
+	server.SetDomain(dom)
+	go func() {
+		err := server.Run(nil)
+		require.NoError(t, err)
+	}()
+	<-server2.RunInGoTestChan
+	client.Port = testutil.GetPortFromTCPAddr(server.ListenAddr())
+	client.StatusPort = testutil.GetPortFromTCPAddr(server.StatusListenerAddr())
+	client.WaitUntilServerOnline()
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index idx(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+
+	// No job is running.
+	resp, err := client.FetchStatus("/stats/analyze/jobs")
+	require.NoError(t, err)
+	js, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	var jobs []optimizor.AnalyzeJob
+	require.NoError(t, json.Unmarshal(js, &jobs))
+	require.Empty(t, jobs)
+
+	// Only POST is allowed to trigger or cancel.
+	resp, err = client.FetchStatus("/stats/analyze/test/t")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+	resp, err = client.PostStatus("/stats/analyze/test/t?buckets=abc", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+	resp, err = client.PostStatus("/stats/analyze/test/not_exist", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+	resp, err = client.PostStatus("/stats/analyze/jobs/1000/cancel", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+
+	resp, err = client.PostStatus("/stats/analyze/test/t?columns=a,b&buckets=4&topn=1", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	js, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	var result map[string]string
+	require.NoError(t, json.Unmarshal(js, &result))
+	require.Equal(t, "ANALYZE TABLE `test`.`t` COLUMNS `a`, `b` WITH 4 BUCKETS, 1 TOPN", result["sql"])
+	require.Eventually(t, func() bool {
+		rows := tk.MustQuery("select count(*) from mysql.analyze_jobs where table_name = 't' and state = 'finished'").Rows()
+		return rows[0][0] != "0"
+	}, 10*time.Second, 100*time.Millisecond)
+	tk.MustQuery("select count(*) from mysql.stats_histograms where table_id = (select tidb_table_id from " +
+		"information_schema.tables where table_schema = 'test' and table_name = 't')").Check(testkit.Rows("3"))
+}
+
 // fix issue 53966
 func TestLoadNullStatsFile(t *testing.T) {
 	// Setting up the mock store
@@ -41,6 +41,8 @@ const (
 	Snapshot           = "snapshot"
 	FileName           = "filename"
 	DumpPartitionStats = "dumpPartitionStats"
+	PredicateColumns   = "predicateColumnsOnly"
+	SinceVersion       = "since_version"
 	Begin              = "begin"
 	End                = "end"
 )
@@ -221,6 +221,10 @@ func (s *Server) startHTTPServer() {
 		Name("StatsHistoryDump")
 	router.Handle("/stats/priority-queue", s.newStatsPriorityQueueHandler()).
 		Name("StatsPriorityQueue")
+	analyzeJobsHandler := s.newAnalyzeJobsHandler()
+	router.Handle("/stats/analyze/jobs", analyzeJobsHandler).Name("StatsAnalyzeJobs")
+	router.Handle("/stats/analyze/jobs/{jobID}/cancel", analyzeJobsHandler).Name("StatsAnalyzeJobCancel")
+	router.Handle("/stats/analyze/{db}/{table}", s.newAnalyzeTableHandler()).Name("StatsAnalyzeTable")
 
 	router.Handle("/plan_replayer/dump/{filename}", s.newPlanReplayerHandler()).Name("PlanReplayerDump")
 	router.Handle("/extract_task/dump", s.newExtractServeHandler()).Name("ExtractTaskDump")
@@ -639,3 +643,31 @@ func (s *Server) newStatsPriorityQueueHandler() *optimizor.StatsPriorityQueueHan
 
 	return optimizor.NewStatsPriorityQueueHandler(do)
 }
+
+func (s *Server) newAnalyzeJobsHandler() *optimizor.AnalyzeJobsHandler {
+	store, ok := s.driver.(*TiDBDriver)
+	if !ok {
+		panic("Illegal driver")
+	}
+
+	do, err := session.GetDomain(store.store)
+	if err != nil {
+		panic("Failed to get domain")
+	}
+
+	return optimizor.NewAnalyzeJobsHandler(do)
+}
+
+func (s *Server) newAnalyzeTableHandler() *optimizor.AnalyzeTableHandler {
+	store, ok := s.driver.(*TiDBDriver)
+	if !ok {
+		panic("Illegal driver")
+	}
+
+	do, err := session.GetDomain(store.store)
+	if err != nil {
+		panic("Failed to get domain")
+	}
+
+	return optimizor.NewAnalyzeTableHandler(do)
+}
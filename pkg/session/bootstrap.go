@@ -226,6 +226,7 @@ const (
 		modify_count	BIGINT(64) NOT NULL DEFAULT 0,
 		count 			BIGINT(64) UNSIGNED NOT NULL DEFAULT 0,
 		snapshot        BIGINT(64) UNSIGNED NOT NULL DEFAULT 0,
+		last_analyzed_handle BIGINT(64) NULL DEFAULT NULL,
 		INDEX idx_ver(version),
 		UNIQUE INDEX tbl(table_id)
 	);`
@@ -405,6 +406,10 @@ const (
 		column_id BIGINT(64) NOT NULL,
 		last_used_at TIMESTAMP,
 		last_analyzed_at TIMESTAMP,
+		usage_score DOUBLE NOT NULL DEFAULT 0,
+		point_predicates BIGINT(64) NOT NULL DEFAULT 0,
+		narrow_range_predicates BIGINT(64) NOT NULL DEFAULT 0,
+		wide_range_predicates BIGINT(64) NOT NULL DEFAULT 0,
 		PRIMARY KEY (table_id, column_id) CLUSTERED
 	);`
 	// CreateTableCacheMetaTable stores the cached table meta lock information.
@@ -464,6 +469,7 @@ const (
 		fail_reason TEXT,
 		instance VARCHAR(512) NOT NULL comment 'address of the TiDB instance executing the analyze job',
 		process_id BIGINT(64) UNSIGNED comment 'ID of the process executing the analyze job',
+		fail_category VARCHAR(32) comment 'category of the fail reason of the analyze job',
 		PRIMARY KEY (id),
 		KEY (update_time),
 		INDEX idx_schema_table_state (table_schema, table_name, state),
@@ -512,8 +518,140 @@ const (
 		modify_count bigint(64) NOT NULL DEFAULT 0,
 		count bigint(64) NOT NULL DEFAULT 0,
 		version bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		expire_version bigint(64) UNSIGNED NOT NULL DEFAULT 0,
 		PRIMARY KEY (table_id));`
 
+	// CreateStatsColumnLocked stores the columns whose stats are locked.
+	CreateStatsColumnLocked = `CREATE TABLE IF NOT EXISTS mysql.stats_column_locked(
+		table_id bigint(64) NOT NULL,
+		hist_id bigint(64) NOT NULL,
+		version bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		PRIMARY KEY (table_id, hist_id));`
+
+	// CreateStatsLockHistory stores the history of the stats lock and unlock operations.
+	CreateStatsLockHistory = `CREATE TABLE IF NOT EXISTS mysql.stats_lock_history(
+		id bigint(64) UNSIGNED NOT NULL AUTO_INCREMENT,
+		table_id bigint(64) NOT NULL,
+		scope enum('table', 'partition', 'column') NOT NULL,
+		name varchar(512) NOT NULL DEFAULT '',
+		action enum('lock', 'unlock') NOT NULL,
+		user varchar(288) NOT NULL DEFAULT '',
+		reason text,
+		version bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		create_time datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		PRIMARY KEY (id),
+		KEY idx_table_id (table_id),
+		KEY idx_create_time (create_time));`
+
+	// CreateStatsIndexTombstone stores the dropped indexes whose stats are retained for a grace period.
+	CreateStatsIndexTombstone = `CREATE TABLE IF NOT EXISTS mysql.stats_index_tombstone(
+		table_id bigint(64) NOT NULL,
+		index_id bigint(64) NOT NULL,
+		index_name varchar(64) NOT NULL DEFAULT '',
+		index_columns text NOT NULL,
+		drop_version bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		PRIMARY KEY (table_id, index_id),
+		KEY idx_drop_version (drop_version));`
+
+	// CreateStatsEstimationErrors stores the sampled estimated and actual row counts of the operators accessing tables,
+	// aggregated by the statement digest, the table and the operator.
+	CreateStatsEstimationErrors = `CREATE TABLE IF NOT EXISTS mysql.stats_estimation_errors(
+		sql_digest varchar(64) NOT NULL,
+		table_id bigint(64) NOT NULL,
+		operator varchar(128) NOT NULL,
+		sample_count bigint(64) NOT NULL DEFAULT 0,
+		sum_est_rows double NOT NULL DEFAULT 0,
+		sum_act_rows double NOT NULL DEFAULT 0,
+		max_q_error double NOT NULL DEFAULT 0,
+		last_seen_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (sql_digest, table_id, operator),
+		KEY idx_table_id (table_id),
+		KEY idx_last_seen_at (last_seen_at));`
+
+	// CreateBindingRevalidation stores the latest result of the revalidation of the global bindings. The bindings
+	// whose bound plan becomes grossly suboptimal are flagged here rather than by the status in mysql.bind_info,
+	// which the older versions don't recognize.
+	CreateBindingRevalidation = `CREATE TABLE IF NOT EXISTS mysql.binding_revalidation(
+		sql_digest varchar(64) NOT NULL,
+		suboptimal tinyint(1) NOT NULL DEFAULT 0,
+		bound_cost double NOT NULL DEFAULT 0,
+		free_cost double NOT NULL DEFAULT 0,
+		update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (sql_digest));`
+
+	// CreateAutoAnalyzeDecisions stores the latest decisions of the auto analyze evaluation of each table, i.e.
+	// whether the table is analyzed or skipped and why.
+	CreateAutoAnalyzeDecisions = `CREATE TABLE IF NOT EXISTS mysql.auto_analyze_decisions(
+		id bigint(64) UNSIGNED NOT NULL AUTO_INCREMENT,
+		table_id bigint(64) NOT NULL,
+		table_schema varchar(64) NOT NULL DEFAULT '',
+		table_name varchar(64) NOT NULL DEFAULT '',
+		partition_name varchar(64) NOT NULL DEFAULT '',
+		decision enum('triggered', 'skipped') NOT NULL,
+		reason varchar(32) NOT NULL DEFAULT '',
+		detail text,
+		create_time datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		PRIMARY KEY (id),
+		KEY idx_table_id (table_id, id));`
+
+	// CreateStatsHealthReports stores the periodic stats health reports of the cluster, i.e. the most stale tables,
+	// the failed analyzes, the pseudo stats hot spots and the growth of the stats storage.
+	CreateStatsHealthReports = `CREATE TABLE IF NOT EXISTS mysql.stats_health_reports(
+		id bigint(64) UNSIGNED NOT NULL AUTO_INCREMENT,
+		report_time datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		stale_tables int NOT NULL DEFAULT 0,
+		failed_analyzes int NOT NULL DEFAULT 0,
+		pseudo_stats_hot_spots int NOT NULL DEFAULT 0,
+		stats_storage_bytes bigint(64) NOT NULL DEFAULT 0,
+		stats_storage_growth_bytes bigint(64) NOT NULL DEFAULT 0,
+		report json,
+		PRIMARY KEY (id),
+		KEY idx_report_time (report_time));`
+
+	// CreateStatsDrift stores the distances between the sampled and the analyzed distributions of the columns of the
+	// hot tables, which are checked by the stats drift detector periodically.
+	CreateStatsDrift = `CREATE TABLE IF NOT EXISTS mysql.stats_drift(
+		table_id bigint(64) NOT NULL,
+		hist_id bigint(64) NOT NULL,
+		stats_version bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		sample_rows int NOT NULL DEFAULT 0,
+		distance double NOT NULL DEFAULT 0,
+		drifted tinyint(1) NOT NULL DEFAULT 0,
+		check_time datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		PRIMARY KEY (table_id, hist_id));`
+
+	// CreateStatsChangeEvents stores the changes of the stats, i.e. the stats updated, dropped, locked and unlocked,
+	// which are replicated by TiCDC to keep the stats of the downstream synchronized.
+	CreateStatsChangeEvents = `CREATE TABLE IF NOT EXISTS mysql.stats_change_events(
+		id bigint(64) UNSIGNED NOT NULL AUTO_INCREMENT,
+		table_id bigint(64) NOT NULL,
+		event enum('update', 'drop', 'lock', 'unlock') NOT NULL,
+		source varchar(40) NOT NULL DEFAULT '',
+		version bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		create_time datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		PRIMARY KEY (id),
+		KEY idx_version (version),
+		KEY idx_table_id (table_id),
+		KEY idx_create_time (create_time));`
+
+	// CreateStatsFKFanout stores the fanouts of the single-column foreign keys, i.e. the distribution of the numbers
+	// of the child rows referencing each parent key, which are collected when the child tables are analyzed.
+	CreateStatsFKFanout = `CREATE TABLE IF NOT EXISTS mysql.stats_fk_fanout(
+		table_id bigint(64) NOT NULL,
+		fk_id bigint(64) NOT NULL,
+		col_id bigint(64) NOT NULL,
+		row_count double NOT NULL DEFAULT 0,
+		null_count double NOT NULL DEFAULT 0,
+		parent_keys double NOT NULL DEFAULT 0,
+		avg_fanout double NOT NULL DEFAULT 0,
+		p50_fanout double NOT NULL DEFAULT 0,
+		p90_fanout double NOT NULL DEFAULT 0,
+		p99_fanout double NOT NULL DEFAULT 0,
+		max_fanout double NOT NULL DEFAULT 0,
+		version bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		PRIMARY KEY (table_id, fk_id),
+		KEY idx_version (version));`
+
 	// CreatePasswordHistory is a table save history passwd.
 	CreatePasswordHistory = `CREATE TABLE  IF NOT EXISTS mysql.password_history (
          Host char(255)  NOT NULL DEFAULT '',
@@ -1268,11 +1406,56 @@ const (
 	// Add max_node_count column to tidb_global_task and tidb_global_task_history.
 	// Add extra_params to tidb_global_task and tidb_global_task_history.
 	version243 = 243
+
+	// Add expire_version column to mysql.stats_table_locked.
+	version244 = 244
+
+	// Add mysql.stats_column_locked table.
+	version245 = 245
+
+	// Add mysql.stats_lock_history table.
+	version246 = 246
+
+	// Add usage_score column to mysql.column_stats_usage.
+	version247 = 247
+
+	// Add mysql.stats_index_tombstone table.
+	version248 = 248
+
+	// Add mysql.stats_estimation_errors table.
+	version249 = 249
+
+	// Add mysql.binding_revalidation table.
+	version250 = 250
+
+	// Add mysql.auto_analyze_decisions table.
+	version251 = 251
+
+	// Add fail_category column to mysql.analyze_jobs.
+	version252 = 252
+
+	// Add mysql.stats_health_reports table.
+	version253 = 253
+
+	// Add mysql.stats_drift table.
+	version254 = 254
+
+	// Add the counts of the classes of the predicate selectivity to mysql.column_stats_usage.
+	version255 = 255
+
+	// Add mysql.stats_change_events table.
+	version256 = 256
+
+	// Add mysql.stats_fk_fanout table.
+	version257 = 257
+
+	// Add last_analyzed_handle column to mysql.stats_meta.
+	version258 = 258
 )
 
 // currentBootstrapVersion is defined as a variable, so we can modify its value for testing.
 // please make sure this is the largest version
-var currentBootstrapVersion int64 = version243
+var currentBootstrapVersion int64 = version258
 
 // DDL owner key's expired time is ManagerSessionTTL seconds, we should wait the time and give more time to have a chance to finish it.
 var internalSQLTimeout = owner.ManagerSessionTTL + 15
@@ -1451,6 +1634,21 @@ var (
 		upgradeToVer241,
 		upgradeToVer242,
 		upgradeToVer243,
+		upgradeToVer244,
+		upgradeToVer245,
+		upgradeToVer246,
+		upgradeToVer247,
+		upgradeToVer248,
+		upgradeToVer249,
+		upgradeToVer250,
+		upgradeToVer251,
+		upgradeToVer252,
+		upgradeToVer253,
+		upgradeToVer254,
+		upgradeToVer255,
+		upgradeToVer256,
+		upgradeToVer257,
+		upgradeToVer258,
 	}
 )
 
@@ -3380,6 +3578,113 @@ func upgradeToVer243(s sessiontypes.Session, ver int64) {
 	doReentrantDDL(s, "ALTER TABLE mysql.tidb_global_task_history ADD COLUMN extra_params json AFTER max_node_count;", infoschema.ErrColumnExists)
 }
 
+func upgradeToVer244(s sessiontypes.Session, ver int64) {
+	if ver >= version244 {
+		return
+	}
+	doReentrantDDL(s, "ALTER TABLE mysql.stats_table_locked ADD COLUMN `expire_version` bigint(64) UNSIGNED NOT NULL DEFAULT 0 AFTER `version`", infoschema.ErrColumnExists)
+}
+
+func upgradeToVer245(s sessiontypes.Session, ver int64) {
+	if ver >= version245 {
+		return
+	}
+	doReentrantDDL(s, CreateStatsColumnLocked)
+}
+
+func upgradeToVer246(s sessiontypes.Session, ver int64) {
+	if ver >= version246 {
+		return
+	}
+	doReentrantDDL(s, CreateStatsLockHistory)
+}
+
+func upgradeToVer247(s sessiontypes.Session, ver int64) {
+	if ver >= version247 {
+		return
+	}
+	doReentrantDDL(s, "ALTER TABLE mysql.column_stats_usage ADD COLUMN `usage_score` DOUBLE NOT NULL DEFAULT 0 AFTER `last_analyzed_at`", infoschema.ErrColumnExists)
+}
+
+func upgradeToVer248(s sessiontypes.Session, ver int64) {
+	if ver >= version248 {
+		return
+	}
+	doReentrantDDL(s, CreateStatsIndexTombstone)
+}
+
+func upgradeToVer249(s sessiontypes.Session, ver int64) {
+	if ver >= version249 {
+		return
+	}
+	doReentrantDDL(s, CreateStatsEstimationErrors)
+}
+
+func upgradeToVer250(s sessiontypes.Session, ver int64) {
+	if ver >= version250 {
+		return
+	}
+	doReentrantDDL(s, CreateBindingRevalidation)
+}
+
+func upgradeToVer251(s sessiontypes.Session, ver int64) {
+	if ver >= version251 {
+		return
+	}
+	doReentrantDDL(s, CreateAutoAnalyzeDecisions)
+}
+
+func upgradeToVer252(s sessiontypes.Session, ver int64) {
+	if ver >= version252 {
+		return
+	}
+	doReentrantDDL(s, "ALTER TABLE mysql.analyze_jobs ADD COLUMN `fail_category` VARCHAR(32) COMMENT 'category of the fail reason of the analyze job' AFTER `process_id`", infoschema.ErrColumnExists)
+}
+
+func upgradeToVer253(s sessiontypes.Session, ver int64) {
+	if ver >= version253 {
+		return
+	}
+	doReentrantDDL(s, CreateStatsHealthReports)
+}
+
+func upgradeToVer254(s sessiontypes.Session, ver int64) {
+	if ver >= version254 {
+		return
+	}
+	doReentrantDDL(s, CreateStatsDrift)
+}
+
+func upgradeToVer255(s sessiontypes.Session, ver int64) {
+	if ver >= version255 {
+		return
+	}
+	doReentrantDDL(s, "ALTER TABLE mysql.column_stats_usage ADD COLUMN `point_predicates` BIGINT(64) NOT NULL DEFAULT 0 AFTER `usage_score`", infoschema.ErrColumnExists)
+	doReentrantDDL(s, "ALTER TABLE mysql.column_stats_usage ADD COLUMN `narrow_range_predicates` BIGINT(64) NOT NULL DEFAULT 0 AFTER `point_predicates`", infoschema.ErrColumnExists)
+	doReentrantDDL(s, "ALTER TABLE mysql.column_stats_usage ADD COLUMN `wide_range_predicates` BIGINT(64) NOT NULL DEFAULT 0 AFTER `narrow_range_predicates`", infoschema.ErrColumnExists)
+}
+
+func upgradeToVer256(s sessiontypes.Session, ver int64) {
+	if ver >= version256 {
+		return
+	}
+	doReentrantDDL(s, CreateStatsChangeEvents)
+}
+
+func upgradeToVer257(s sessiontypes.Session, ver int64) {
+	if ver >= version257 {
+		return
+	}
+	doReentrantDDL(s, CreateStatsFKFanout)
+}
+
+func upgradeToVer258(s sessiontypes.Session, ver int64) {
+	if ver >= version258 {
+		return
+	}
+	doReentrantDDL(s, "ALTER TABLE mysql.stats_meta ADD COLUMN last_analyzed_handle BIGINT(64) NULL DEFAULT NULL", infoschema.ErrColumnExists)
+}
+
 // initGlobalVariableIfNotExists initialize a global variable with specific val if it does not exist.
 func initGlobalVariableIfNotExists(s sessiontypes.Session, name string, val any) {
 	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnBootstrap)
@@ -3536,6 +3841,26 @@ func doDDLWorks(s sessiontypes.Session) {
 	mustExecute(s, CreateKernelOptionsTable)
 	// create mysql.tidb_workload_values
 	mustExecute(s, CreateTiDBWorkloadValuesTable)
+	// create mysql.stats_column_locked
+	mustExecute(s, CreateStatsColumnLocked)
+	// create mysql.stats_lock_history
+	mustExecute(s, CreateStatsLockHistory)
+	// create mysql.stats_index_tombstone
+	mustExecute(s, CreateStatsIndexTombstone)
+	// create mysql.stats_estimation_errors
+	mustExecute(s, CreateStatsEstimationErrors)
+	// create mysql.binding_revalidation
+	mustExecute(s, CreateBindingRevalidation)
+	// create mysql.auto_analyze_decisions
+	mustExecute(s, CreateAutoAnalyzeDecisions)
+	// create mysql.stats_health_reports
+	mustExecute(s, CreateStatsHealthReports)
+	// create mysql.stats_drift
+	mustExecute(s, CreateStatsDrift)
+	// create mysql.stats_change_events
+	mustExecute(s, CreateStatsChangeEvents)
+	// create mysql.stats_fk_fanout
+	mustExecute(s, CreateStatsFKFanout)
 }
 
 // doBootstrapSQLFile executes SQL commands in a file as the last stage of bootstrap.
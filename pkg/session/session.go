@@ -93,6 +93,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/sessiontxn"
+	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/handle/syncload"
 	"github.com/pingcap/tidb/pkg/statistics/handle/usage"
 	"github.com/pingcap/tidb/pkg/statistics/handle/usage/indexusage"
@@ -103,6 +104,7 @@ import (
 	"github.com/pingcap/tidb/pkg/table/tblsession"
 	"github.com/pingcap/tidb/pkg/table/temptable"
 	"github.com/pingcap/tidb/pkg/tablecodec"
+	ttlcache "github.com/pingcap/tidb/pkg/ttl/cache"
 	"github.com/pingcap/tidb/pkg/ttl/ttlworker"
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/chunk"
@@ -426,6 +428,13 @@ func (s *session) UpdateColStatsUsage(colStatsUsage iter.Seq[model.TableItemID])
 	s.statsCollector.UpdateColStatsUsage(colStatsUsage, t)
 }
 
+func (s *session) UpdatePredicateSelectivity(classes map[model.TableItemID]uint8) {
+	if s.statsCollector == nil {
+		return
+	}
+	s.statsCollector.UpdatePredicateSelectivity(classes)
+}
+
 // FieldList returns fields list of a table.
 func (s *session) FieldList(tableName string) ([]*resolve.ResultField, error) {
 	is := s.GetInfoSchema().(infoschema.InfoSchema)
@@ -677,6 +686,7 @@ func (s *session) commitTxnWithTemporaryData(ctx context.Context, txn kv.Transac
 	var (
 		stage           kv.StagingHandle
 		localTempTables *infoschema.SessionTables
+		txnStats        map[int64]*statistics.TempTableStats
 	)
 
 	if sessVars.LocalTemporaryTables != nil {
@@ -706,6 +716,10 @@ func (s *session) commitTxnWithTemporaryData(ctx context.Context, txn kv.Transac
 
 		if stage == kv.InvalidStagingHandle {
 			stage = sessionData.Staging()
+			txnStats = make(map[int64]*statistics.TempTableStats)
+		}
+		if stats, ok := tbl.GetStats().(*statistics.TempTableStats); ok {
+			txnStats[tblID] = stats
 		}
 
 		tblPrefix := tablecodec.EncodeTablePrefix(tblID)
@@ -749,6 +763,14 @@ func (s *session) commitTxnWithTemporaryData(ctx context.Context, txn kv.Transac
 	if stage != kv.InvalidStagingHandle {
 		sessionData.Release(stage)
 		stage = kv.InvalidStagingHandle
+		// The stats collected in the txn are merged into the session only after the txn is committed.
+		for tblID, stats := range txnStats {
+			if sessionStats, ok := sessionData.GetTableStats(tblID).(*statistics.TempTableStats); ok {
+				sessionStats.Merge(stats)
+			} else {
+				sessionData.SetTableStats(tblID, stats.Copy())
+			}
+		}
 	}
 
 	return nil
@@ -1335,6 +1357,40 @@ func (s *session) GetGlobalSysVar(name string) (string, error) {
 	return sysVar, nil
 }
 
+// GetGlobalSysVars implements GlobalVarAccessor.GetGlobalSysVars interface.
+// The values are got from the sysvar cache at once, and validated in the same way as GetGlobalSysVar.
+func (s *session) GetGlobalSysVars(names ...string) (map[string]string, error) {
+	vals := make(map[string]string, len(names))
+	if s.Value(sessionctx.Initing) != nil {
+		// When running bootstrap or upgrade, we should not access global storage.
+		for _, name := range names {
+			vals[name] = ""
+		}
+		return vals, nil
+	}
+	cached, err := domain.GetDomain(s).GetGlobalVars(names)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		sv := variable.GetSysVar(name)
+		val, ok := cached[name]
+		if sv == nil || !ok {
+			// The sysvar cache may be stale, which is handled by GetGlobalSysVar.
+			if val, err = s.GetGlobalSysVar(name); err != nil {
+				return nil, err
+			}
+			vals[name] = val
+			continue
+		}
+		if val, err = sv.ValidateFromType(s.GetSessionVars(), val, vardef.ScopeGlobal); err != nil {
+			val = sv.Value
+		}
+		vals[name] = val
+	}
+	return vals, nil
+}
+
 // SetGlobalSysVar implements GlobalVarAccessor.SetGlobalSysVar interface.
 // it is called (but skipped) when setting instance scope
 func (s *session) SetGlobalSysVar(ctx context.Context, name string, value string) (err error) {
@@ -3663,6 +3719,21 @@ func bootstrapSessionImpl(ctx context.Context, store kv.Storage, createSessionsI
 		}
 		return s
 	}
+	ttlworker.AdjustStatsAfterTTL = func(tbl *ttlcache.PhysicalTable, expireTime time.Time) {
+		h := dom.StatsHandle()
+		if h == nil {
+			return
+		}
+		// Flush the deleted rows collected from the TTL sessions, so that the count in stats_meta is
+		// reduced along with the stats of the time column.
+		if err := h.DumpStatsDeltaToKV(true); err != nil {
+			logutil.BgLogger().Warn("dump stats delta after TTL job failed", zap.Error(err))
+		}
+		if err := h.AdjustStatsForTTL(tbl.TableInfo, tbl.ID, expireTime); err != nil {
+			logutil.BgLogger().Warn("adjust stats after TTL job failed",
+				zap.Int64("tableID", tbl.ID), zap.Error(err))
+		}
+	}
 	dom.StartTTLJobManager()
 
 	dom.LoadSigningCertLoop(cfg.Security.SessionTokenSigningCert, cfg.Security.SessionTokenSigningKey)
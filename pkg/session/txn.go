@@ -32,6 +32,7 @@ import (
 	"github.com/pingcap/tidb/pkg/session/txninfo"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessiontxn"
+	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/sli"
@@ -723,6 +724,7 @@ func (s *session) StmtCommit(ctx context.Context) {
 
 	st := &s.txn
 	st.flushStmtBuf()
+	s.endTempTableStatsStmt(true)
 }
 
 // StmtRollback implements the sessionctx.Context interface.
@@ -733,4 +735,26 @@ func (s *session) StmtRollback(ctx context.Context, isForPessimisticRetry bool)
 		logutil.Logger(ctx).Error("txnManager failed to handle OnStmtRollback", zap.Error(err))
 	}
 	s.txn.cleanup()
+	s.endTempTableStatsStmt(false)
+}
+
+// endTempTableStatsStmt merges or discards the stats of the temporary tables collected by the statement.
+func (s *session) endTempTableStatsStmt(commit bool) {
+	sessVars := s.sessionVars
+	sessVars.TxnCtxMu.Lock()
+	defer sessVars.TxnCtxMu.Unlock()
+	if sessVars.TxnCtx == nil {
+		return
+	}
+	for _, tbl := range sessVars.TxnCtx.TemporaryTables {
+		stats, ok := tbl.GetStats().(*statistics.TempTableStats)
+		if !ok {
+			continue
+		}
+		if commit {
+			stats.StmtCommit()
+		} else {
+			stats.StmtRollback()
+		}
+	}
 }
@@ -156,6 +156,10 @@ type Context interface {
 	// UpdateColStatsUsage updates the column stats usage.
 	UpdateColStatsUsage(predicateColumns iter.Seq[model.TableItemID])
 
+	// UpdatePredicateSelectivity updates the classes of the selectivity of the predicates on the columns tracked with
+	// the column stats usage, see stmtctx.PointPredicate.
+	UpdatePredicateSelectivity(classes map[model.TableItemID]uint8)
+
 	// HasDirtyContent checks whether there's dirty update on the given table.
 	HasDirtyContent(tid int64) bool
 
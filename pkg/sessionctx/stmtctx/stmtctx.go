@@ -370,6 +370,10 @@ type StatementContext struct {
 		ResultCh []<-chan singleflight.Result
 		// LoadStartTime is to record the load start time to calculate latency
 		LoadStartTime time.Time
+		// PendingItems stores the needed columns/indices whose stats were still not loaded when the plan was built,
+		// e.g. the sync-load timed out or the stats are loaded asynchronously. The cached plan built with them is
+		// invalidated once they arrive.
+		PendingItems []model.StatsLoadItem
 	}
 
 	// SysdateIsNow indicates whether sysdate() is an alias of now() in this statement
@@ -387,6 +391,14 @@ type StatementContext struct {
 	// usedStatsInfo records version of stats of each table used in the query.
 	// It's a map of table physical id -> *UsedStatsInfoForTable
 	usedStatsInfo atomic.Pointer[UsedStatsInfo]
+	// CardinalityFeedbackEstRows records the estimated row counts of the data sources before being corrected by the
+	// runtime cardinality feedback. It's a map of logical table id -> row count, and the row count is negative if
+	// the table is read by more than one data source in the query.
+	CardinalityFeedbackEstRows map[int64]float64
+	// PredicateSelectivity records the classes of the selectivity of the predicates on the columns estimated by the
+	// optimizer, which are tracked with the column stats usage. It's a map of the column of the physical table -> the
+	// bit set of the classes, see PointPredicate.
+	PredicateSelectivity map[model.TableItemID]uint8
 	// IsSyncStatsFailed indicates whether any failure happened during sync stats
 	IsSyncStatsFailed bool
 	// UseDynamicPruneMode indicates whether use UseDynamicPruneMode in query stmt
@@ -1155,6 +1167,27 @@ func (sc *StatementContext) AddSetVarHintRestore(name, val string) {
 	sc.SetVarHintRestore[name] = val
 }
 
+// The classes of the selectivity of the predicates on a column estimated by the optimizer.
+const (
+	// PointPredicate is the class of the predicates matching points, e.g. `a = 1` and `a in (1, 2)`.
+	PointPredicate uint8 = 1 << iota
+	// NarrowRangePredicate is the class of the range predicates selecting at most NarrowRangeSelectivity of the rows.
+	NarrowRangePredicate
+	// WideRangePredicate is the class of the other range predicates.
+	WideRangePredicate
+)
+
+// NarrowRangeSelectivity is the max selectivity of the NarrowRangePredicate.
+const NarrowRangeSelectivity = 0.01
+
+// RecordPredicateSelectivity records the class of the selectivity of the predicates on the column.
+func (sc *StatementContext) RecordPredicateSelectivity(col model.TableItemID, class uint8) {
+	if sc.PredicateSelectivity == nil {
+		sc.PredicateSelectivity = make(map[model.TableItemID]uint8)
+	}
+	sc.PredicateSelectivity[col] |= class
+}
+
 // GetUsedStatsInfo returns the map for recording the used stats during query.
 // If initIfNil is true, it will initialize it when this map is nil.
 func (sc *StatementContext) GetUsedStatsInfo(initIfNil bool) *UsedStatsInfo {
@@ -1240,6 +1273,14 @@ type UsedStatsInfoForTable struct {
 	ColumnStatsLoadStatus map[int64]string
 	IndexStatsLoadStatus  map[int64]string
 	ColAndIdxStatus       any
+	// StatsHealthy is the healthy of the stats, it's -1 if it's unknown, e.g. the stats are pseudo.
+	StatsHealthy int64
+	// LastAnalyzeTime is the time of the last analyze of the table, it's zero if the table is never analyzed.
+	LastAnalyzeTime time.Time
+	// OutOfRange indicates the access ranges on the table go out of the histograms in the estimation.
+	OutOfRange bool
+	// UsedJSONPathStats are the expressions of the JSON path stats used in the estimation.
+	UsedJSONPathStats []string
 }
 
 // FormatForExplain format the content in the format expected to be printed in the execution plan.
@@ -1252,6 +1293,11 @@ func (s *UsedStatsInfoForTable) FormatForExplain() string {
 	if s.Version == 0 {
 		return "stats:pseudo"
 	}
+	// The stats of the temporary tables are collected on the fly and never have the index stats,
+	// so the missing items are not reported.
+	if s.TblInfo != nil && s.TblInfo.TempTableType != model.TempTableNone {
+		return ""
+	}
 	var b strings.Builder
 	if len(s.ColumnStatsLoadStatus)+len(s.IndexStatsLoadStatus) == 0 {
 		return ""
@@ -1279,6 +1325,47 @@ func (s *UsedStatsInfoForTable) FormatForExplain() string {
 	return b.String()
 }
 
+// FormatJSONPathStatsForExplain formats the JSON path stats used in the estimation in the format expected to be
+// printed in the execution plan, e.g. json_path_stats:[json_extract(`j`, '$.a')]. It's empty if none is used.
+func (s *UsedStatsInfoForTable) FormatJSONPathStatsForExplain() string {
+	if len(s.UsedJSONPathStats) == 0 {
+		return ""
+	}
+	return "json_path_stats:[" + strings.Join(s.UsedJSONPathStats, ", ") + "]"
+}
+
+// FormatStatsHealthForExplain formats the health of the stats in the format expected to be printed in the execution
+// plan when tidb_explain_stats_health is on, e.g. stats_health:[healthy:90, last_analyze:2025-01-01 00:00:00,
+// estimation:out_of_range]. The estimation is pseudo if the stats are pseudo, out_of_range if the access ranges go
+// out of the histograms, otherwise it's normal.
+func (s *UsedStatsInfoForTable) FormatStatsHealthForExplain(loc *time.Location) string {
+	var b strings.Builder
+	b.WriteString("stats_health:[healthy:")
+	// statistics.PseudoVersion == 0
+	if s.Version == 0 || s.StatsHealthy < 0 {
+		b.WriteString("unknown")
+	} else {
+		b.WriteString(strconv.FormatInt(s.StatsHealthy, 10))
+	}
+	b.WriteString(", last_analyze:")
+	if s.LastAnalyzeTime.IsZero() {
+		b.WriteString("never")
+	} else {
+		b.WriteString(s.LastAnalyzeTime.In(loc).Format(time.DateTime))
+	}
+	b.WriteString(", estimation:")
+	switch {
+	case s.Version == 0:
+		b.WriteString("pseudo")
+	case s.OutOfRange:
+		b.WriteString("out_of_range")
+	default:
+		b.WriteString("normal")
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
 // WriteToSlowLog format the content in the format expected to be printed to the slow log, then write to w.
 // The format is table name partition name:version[realtime row count;modify count][index load status][column load status].
 func (s *UsedStatsInfoForTable) WriteToSlowLog(w io.Writer) {
@@ -314,6 +314,15 @@ const (
 	// TiDBOptEnableCorrelationAdjustment is used to indicates if enable correlation adjustment.
 	TiDBOptEnableCorrelationAdjustment = "tidb_opt_enable_correlation_adjustment"
 
+	// TiDBOptExtrapolateRowCountByAutoID indicates whether to extrapolate the row count of the table by the auto IDs
+	// allocated in this instance since the stats meta was loaded, in case the stats delta lags after bulk inserts.
+	// Note that the IDs which are skipped because of the failed inserts or the rebases are also counted.
+	TiDBOptExtrapolateRowCountByAutoID = "tidb_opt_extrapolate_row_count_by_auto_id"
+
+	// TiDBInjectStats is the fake stats of the tables in JSON which overlay their real stats in the optimization of
+	// the session, for the what-if analysis of the plans.
+	TiDBInjectStats = "tidb_inject_stats"
+
 	// TiDBOptLimitPushDownThreshold determines if push Limit or TopN down to TiKV forcibly.
 	TiDBOptLimitPushDownThreshold = "tidb_opt_limit_push_down_threshold"
 
@@ -851,6 +860,9 @@ const (
 	// TiDBPlanCacheInvalidationOnFreshStats controls if plan cache will be invalidated automatically when
 	// related stats are analyzed after the plan cache is generated.
 	TiDBPlanCacheInvalidationOnFreshStats = "tidb_plan_cache_invalidation_on_fresh_stats"
+	// TiDBPlanCacheStatsChangeRatio is the ratio of the row count change of any related table since a cached plan was built,
+	// beyond which the cached plan is invalidated. 0 means disabled.
+	TiDBPlanCacheStatsChangeRatio = "tidb_plan_cache_stats_change_ratio"
 	// TiDBSessionPlanCacheSize controls the size of session plan cache.
 	TiDBSessionPlanCacheSize = "tidb_session_plan_cache_size"
 
@@ -936,6 +948,66 @@ const (
 	// via the ordering index.
 	TiDBOptOrderingIdxSelRatio = "tidb_opt_ordering_index_selectivity_ratio"
 
+	// TiDBOptRiskAwareCostFactor enables the risk-aware plan selection when it's larger than 1. The per-row costs of
+	// the operators executed once for each row of an input whose estimated row count has low confidence, e.g. the
+	// probe side of an index join, are multiplied by this factor, so the robust alternatives are preferred.
+	TiDBOptRiskAwareCostFactor = "tidb_opt_risk_aware_cost_factor"
+
+	// TiDBOptUseCostCalibration determines whether the cost model adjusts the scan, request and network factors by
+	// the calibration profile fitted from the measured executions of the cluster.
+	TiDBOptUseCostCalibration = "tidb_opt_use_cost_calibration"
+
+	// TiDBOptDynamicSamplingRows is the max number of the rows the optimizer samples from a table without usable stats,
+	// e.g. a newly created or imported table, to estimate the selectivity of the predicates on it. The sample is
+	// taken when the query is compiled and cached in the stats cache. 0 disables the dynamic sampling, so it should
+	// only be enabled in the sessions which are not latency-critical.
+	TiDBOptDynamicSamplingRows = "tidb_opt_dynamic_sampling_rows"
+
+	// TiDBOptSchemaInformedPseudoStats indicates whether the pseudo stats of a table never analyzed are inferred from
+	// the schema and the regions of the table, i.e. the NDVs of the unique, enum, set and boolean-like columns are
+	// inferred from their definitions, and the row count is estimated by the approximate keys of the regions.
+	TiDBOptSchemaInformedPseudoStats = "tidb_opt_schema_informed_pseudo_stats"
+
+	// TiDBOptOutOfRangeExtrapolation indicates whether the optimizer estimates the out-of-range part of the ranges on
+	// an increasing (or decreasing) column by extrapolating how far the data extends beyond the histogram, from the
+	// velocity of the modifications and the time since the last analyze, instead of the fixed fraction heuristics.
+	TiDBOptOutOfRangeExtrapolation = "tidb_opt_out_of_range_extrapolation"
+
+	// TiDBOptInListProbeEstimation indicates whether the optimizer estimates the IN lists which can't be converted to
+	// ranges, e.g. the ones exceeding tidb_opt_range_max_size, by probing the constants against the TopN and the
+	// histogram one by one instead of using the default selectivity.
+	TiDBOptInListProbeEstimation = "tidb_opt_in_list_probe_estimation"
+
+	// TiDBOptCardinalityEstimator is the name of the cardinality estimator the optimizer uses. The histogram-based
+	// estimator is used by default, and the alternative ones registered to the planner can be A/B tested by it.
+	TiDBOptCardinalityEstimator = "tidb_opt_cardinality_estimator"
+
+	// TiDBOptStatsSnapshotTS is the timestamp of the historical stats which the queries of the session are optimized
+	// with, to reproduce and bisect the plan changes caused by the stats. 0 means the latest stats are used.
+	// It requires tidb_enable_historical_stats to be on when the stats are collected.
+	TiDBOptStatsSnapshotTS = "tidb_opt_stats_snapshot_ts"
+
+	// TiDBOptStaleReadHistoricalStats indicates whether the stale read queries, e.g. the ones with AS OF TIMESTAMP, are
+	// optimized with the historical stats at the read timestamp rather than the latest stats, so they aren't planned
+	// with the row counts of the data written after it. It requires tidb_enable_historical_stats to be on when the
+	// stats are collected.
+	TiDBOptStaleReadHistoricalStats = "tidb_opt_stale_read_historical_stats"
+
+	// TiDBOptFKFanoutEstimation indicates whether the equal joins between the child and the parent tables of the
+	// single-column foreign keys are estimated by the fanouts of the foreign keys, which are collected when the child
+	// tables are analyzed. The NDV based estimation assumes the containment of the join keys, which goes wrong when
+	// only a few parent keys are referenced by the child rows.
+	TiDBOptFKFanoutEstimation = "tidb_opt_fk_fanout_estimation"
+
+	// TiDBOptJoinSkewThreshold is the ratio of the rows the most frequent value of a join key, known from the TopN
+	// stats, must account for to regard the join key as skewed. A shuffle join sends all the rows of the skewed value
+	// to the same node, so the optimizer prefers the broadcast join for it. 0 disables the skew detection.
+	TiDBOptJoinSkewThreshold = "tidb_opt_join_skew_threshold"
+
+	// TiDBExplainStatsHealth indicates whether to annotate the table accesses in the execution plan with the health
+	// of the stats, the last analyze time and whether the estimation is pseudo or out of the range of the histograms.
+	TiDBExplainStatsHealth = "tidb_explain_stats_health"
+
 	// TiDBOptEnableMPPSharedCTEExecution indicates whether the optimizer try to build shared CTE scan during MPP execution.
 	TiDBOptEnableMPPSharedCTEExecution = "tidb_opt_enable_mpp_shared_cte_execution"
 	// TiDBOptFixControl makes the user able to control some details of the optimizer behavior.
@@ -960,6 +1032,10 @@ const (
 	// TiDBOptEnableHashJoin indicates whether to enable hash join.
 	TiDBOptEnableHashJoin = "tidb_opt_enable_hash_join"
 
+	// TiDBOptPartitionCardinalityEstimation indicates whether to use the partition-level row counts to estimate
+	// the rows of the partitions left after pruning for LIST/HASH partitioned tables under the dynamic prune mode.
+	TiDBOptPartitionCardinalityEstimation = "tidb_opt_partition_cardinality_estimation"
+
 	// TiDBHashJoinVersion indicates whether to use hash join implementation v2.
 	TiDBHashJoinVersion = "tidb_hash_join_version"
 
@@ -1016,6 +1092,18 @@ const (
 	// `PREDICATE`: Analyze only the columns that are used in the predicates of the query.
 	// `ALL`: Analyze all columns in the table.
 	TiDBAnalyzeColumnOptions = "tidb_analyze_column_options"
+	// TiDBPredicateColumnsUsageHalfLife is the half-life of the usage score of the predicate columns.
+	// The columns whose decayed usage score is too low are no longer treated as predicate columns.
+	// 0 means the usage score never decays, which is the default.
+	TiDBPredicateColumnsUsageHalfLife = "tidb_predicate_columns_usage_half_life"
+	// TiDBDroppedIndexStatsRetention is the period to retain the stats of the dropped indexes.
+	// The stats can be restored if an index with the same columns is added again during the period.
+	// 0 means the stats are deleted along with the index.
+	TiDBDroppedIndexStatsRetention = "tidb_dropped_index_stats_retention"
+	// TiDBTruncateTableStatsRefillFactor is the expected ratio of the row count after the truncated table is refilled
+	// to the row count before TRUNCATE TABLE. When it's greater than 0, the stats of the truncated table are scaled
+	// by it and kept as the initial stats of the new table. 0 means the stats are not kept.
+	TiDBTruncateTableStatsRefillFactor = "tidb_truncate_table_stats_refill_factor"
 	// TiDBDisableColumnTrackingTime records the last time TiDBEnableColumnTracking is set off.
 	// It is used to invalidate the collected predicate columns after turning off TiDBEnableColumnTracking, which avoids physical deletion.
 	// It doesn't have cache in memory, and we directly get/set the variable value from/to mysql.tidb.
@@ -1042,6 +1130,49 @@ const (
 	TiDBEnableAutoAnalyze = "tidb_enable_auto_analyze"
 	// TiDBEnableAutoAnalyzePriorityQueue determines whether TiDB executes automatic analysis with priority queue.
 	TiDBEnableAutoAnalyzePriorityQueue = "tidb_enable_auto_analyze_priority_queue"
+	// TiDBEnableNewPartitionStatsSeeding determines whether the newly added partitions are seeded with the stats of
+	// the most recent sibling partition and analyzed at an elevated priority.
+	TiDBEnableNewPartitionStatsSeeding = "tidb_enable_new_partition_stats_seeding"
+	// TiDBBuildGlobalStatsOnDynamicPruneMode determines whether switching tidb_partition_prune_mode to dynamic at global level
+	// builds the missing global stats of the partitioned tables by the auto-analyze worker.
+	TiDBBuildGlobalStatsOnDynamicPruneMode = "tidb_build_global_stats_on_dynamic_prune_mode"
+	// TiDBEnableTiFlashGlobalStats determines whether the row count and the NDVs of the low-cardinality columns of
+	// the global stats are recomputed by a TiFlash aggregate query when all the partitions have TiFlash replicas.
+	TiDBEnableTiFlashGlobalStats = "tidb_enable_tiflash_global_stats"
+	// TiDBEnableCardinalityFeedback determines whether the estimated and the actual row counts of the table readers are
+	// collected after execution and used to correct the estimation of the same statement. It's the kill switch of both.
+	TiDBEnableCardinalityFeedback = "tidb_enable_cardinality_feedback"
+	// TiDBCardinalityFeedbackMinSamples is the number of the stable executions needed before the collected feedback of
+	// a statement is applied to its estimation.
+	TiDBCardinalityFeedbackMinSamples = "tidb_cardinality_feedback_min_samples"
+	// TiDBEstimationErrorSampleRate is the ratio of the statements whose estimated and actual row counts of the
+	// operators accessing tables are recorded in mysql.stats_estimation_errors. 0 disables the recording.
+	TiDBEstimationErrorSampleRate = "tidb_estimation_error_sample_rate"
+	// TiDBRecordEstimationErrors determines whether the estimated and actual row counts of the operators accessing
+	// tables are recorded for every statement of the session, see information_schema.session_estimation_errors.
+	TiDBRecordEstimationErrors = "tidb_record_estimation_errors"
+	// TiDBEstimationErrorWindow is the time window of the estimation errors ranked in
+	// information_schema.estimation_error_ranking.
+	TiDBEstimationErrorWindow = "tidb_estimation_error_window"
+	// TiDBUnusedIndexWindow is how long an index isn't used to be reported by information_schema.unused_index_advice.
+	TiDBUnusedIndexWindow = "tidb_unused_index_window"
+	// TiDBEnablePlanChangeGuard determines whether the plans of the top statements touching the analyzed tables are
+	// checked after analyze, and the old plan is captured as a binding if the new one regresses.
+	TiDBEnablePlanChangeGuard = "tidb_enable_plan_change_guard"
+	// TiDBPlanChangeGuardTopN is the number of the most frequently executed statements checked for each analyzed table.
+	TiDBPlanChangeGuardTopN = "tidb_plan_change_guard_top_n"
+	// TiDBPlanChangeGuardLatencyRatio is the ratio of the average latency of the new plan to the old one beyond which
+	// the plan change is considered as a regression.
+	TiDBPlanChangeGuardLatencyRatio = "tidb_plan_change_guard_latency_ratio"
+	// TiDBEnableBindingRevalidation determines whether the global bindings on a table are re-evaluated in the
+	// background when analyze shifts the row count or the NDVs of the table significantly. The bindings whose bound
+	// plan becomes grossly suboptimal are flagged in mysql.binding_revalidation.
+	TiDBEnableBindingRevalidation = "tidb_enable_binding_revalidation"
+
+	// TiDBEnableCostCalibration determines whether the stats owner periodically fits the calibration profile of the
+	// cost model from the analyze and select statements in the statement summary of the cluster, and saves it in
+	// mysql.tidb, from which the other instances load it.
+	TiDBEnableCostCalibration = "tidb_enable_cost_calibration"
 	// TiDBMemOOMAction indicates what operation TiDB perform when a single SQL statement exceeds
 	// the memory quota specified by tidb_mem_quota_query and cannot be spilled to disk.
 	TiDBMemOOMAction = "tidb_mem_oom_action"
@@ -1115,8 +1246,45 @@ const (
 	PasswordReuseTime = "password_reuse_interval"
 	// TiDBHistoricalStatsDuration indicates the duration to remain tidb historical stats
 	TiDBHistoricalStatsDuration = "tidb_historical_stats_duration"
+	// TiDBAnalyzeJobHistoryRetention indicates the duration to retain the analyze jobs in mysql.analyze_jobs.
+	TiDBAnalyzeJobHistoryRetention = "tidb_analyze_job_history_retention"
+	// TiDBStatsHealthReportInterval indicates the interval to generate the stats health report into
+	// mysql.stats_health_reports, 0 means the report is disabled.
+	TiDBStatsHealthReportInterval = "tidb_stats_health_report_interval"
+	// TiDBStatsHealthReportStorageURI indicates the external storage to write the stats health reports to besides
+	// mysql.stats_health_reports, empty means the reports are only kept in the system table.
+	TiDBStatsHealthReportStorageURI = "tidb_stats_health_report_storage_uri"
+	// TiDBStatsDriftCheckInterval indicates the interval to check whether the data distributions of the hot tables
+	// have drifted from their stats by a tiny sample, 0 means the check is disabled.
+	TiDBStatsDriftCheckInterval = "tidb_stats_drift_check_interval"
+	// TiDBStatsRegionDeltaInterval indicates the interval to observe the rows changed out of the sessions, e.g. by the
+	// physical import, by the approximate keys of the regions of the tables, 0 means the observation is disabled.
+	TiDBStatsRegionDeltaInterval = "tidb_stats_region_delta_interval"
+	// TiDBStatsDeltaPendingThreshold indicates the age since which the stats delta of a table not yet persisted is
+	// listed by information_schema.stats_delta_pending.
+	TiDBStatsDeltaPendingThreshold = "tidb_stats_delta_pending_threshold"
+	// TiDBStatsLeaseMin indicates the min interval to load the stats into the cache, to which the interval is shortened
+	// when many tables are changing, 0 means the stats lease.
+	TiDBStatsLeaseMin = "tidb_stats_lease_min"
+	// TiDBStatsLeaseMax indicates the max interval to load the stats into the cache, to which the interval is lengthened
+	// when no table is changing, 0 means the stats lease.
+	TiDBStatsLeaseMax = "tidb_stats_lease_max"
+	// TiDBStatsAlertHealthyThreshold indicates the stats healthy below which the tables used by the queries in the
+	// last hour are counted by the metric tidb_statistics_unhealthy_hot_tables.
+	TiDBStatsAlertHealthyThreshold = "tidb_stats_alert_healthy_threshold"
+	// TiDBStatsReplicaRead is the replica read type of the internal SQLs only reading the stats, i.e. the
+	// initialization and the refresh of the stats cache and the loads of the histograms, to shift the load of them off
+	// the region leaders. The values are the same as tidb_replica_read except closest-adaptive.
+	TiDBStatsReplicaRead = "tidb_stats_replica_read"
 	// TiDBEnableHistoricalStatsForCapture indicates whether use historical stats in plan replayer capture
 	TiDBEnableHistoricalStatsForCapture = "tidb_enable_historical_stats_for_capture"
+	// TiDBEnableStatsChangeEvents indicates whether to record the stats changes, i.e. the stats updated by analyze,
+	// the stats dropped and the stats lock changed, in mysql.stats_change_events, so they can be replicated by TiCDC
+	// to keep the stats of the downstream synchronized.
+	TiDBEnableStatsChangeEvents = "tidb_enable_stats_change_events"
+	// TiDBStatsChangeEventsRetention indicates the duration to keep the stats change events, the consumers are expected
+	// to have synchronized them within it.
+	TiDBStatsChangeEventsRetention = "tidb_stats_change_events_retention"
 	// TiDBEnableResourceControl indicates whether resource control feature is enabled
 	TiDBEnableResourceControl = "tidb_enable_resource_control"
 	// TiDBResourceControlStrictMode indicates whether resource control strict mode is enabled.
@@ -1262,6 +1430,7 @@ const (
 	DefOptMPPOuterJoinFixedBuildSide        = false
 	DefOptWriteRowID                        = false
 	DefOptEnableCorrelationAdjustment       = true
+	DefOptExtrapolateRowCountByAutoID       = false
 	DefOptLimitPushDownThreshold            = 100
 	DefOptCorrelationThreshold              = 0.9
 	DefOptCorrelationExpFactor              = 1
@@ -1436,7 +1605,24 @@ const (
 	DefTiDBMemQuotaAnalyze                            = -1
 	DefTiDBEnableAutoAnalyze                          = true
 	DefTiDBEnableAutoAnalyzePriorityQueue             = true
+	DefTiDBEnableNewPartitionStatsSeeding             = false
+	DefTiDBBuildGlobalStatsOnDynamicPruneMode         = false
+	DefTiDBEnableTiFlashGlobalStats                   = false
+	DefTiDBEnableCardinalityFeedback                  = false
+	DefTiDBCardinalityFeedbackMinSamples              = 10
+	DefTiDBEstimationErrorSampleRate                  = 0.0
+	DefTiDBRecordEstimationErrors                     = false
+	DefTiDBEstimationErrorWindow                      = 24 * time.Hour
+	DefTiDBUnusedIndexWindow                          = 7 * 24 * time.Hour
+	DefTiDBEnablePlanChangeGuard                      = false
+	DefTiDBPlanChangeGuardTopN                        = 10
+	DefTiDBPlanChangeGuardLatencyRatio                = 2.0
+	DefTiDBEnableBindingRevalidation                  = false
+	DefTiDBEnableCostCalibration                      = false
 	DefTiDBAnalyzeColumnOptions                       = "PREDICATE"
+	DefTiDBPredicateColumnsUsageHalfLife              = time.Duration(0)
+	DefTiDBDroppedIndexStatsRetention                 = 24 * time.Hour
+	DefTiDBTruncateTableStatsRefillFactor             = 0.0
 	DefTiDBMemOOMAction                               = "CANCEL"
 	DefTiDBMaxAutoAnalyzeTime                         = 12 * 60 * 60
 	DefTiDBAutoAnalyzeConcurrency                     = 1
@@ -1517,7 +1703,18 @@ const (
 	DefPasswordReuseTime                              = 0
 	DefTiDBStoreBatchSize                             = 4
 	DefTiDBHistoricalStatsDuration                    = 7 * 24 * time.Hour
+	DefTiDBAnalyzeJobHistoryRetention                 = 7 * 24 * time.Hour
+	DefTiDBStatsHealthReportInterval                  = time.Duration(0)
+	DefTiDBStatsDriftCheckInterval                    = time.Duration(0)
+	DefTiDBStatsRegionDeltaInterval                   = time.Duration(0)
+	DefTiDBStatsDeltaPendingThreshold                 = 10 * time.Minute
+	DefTiDBStatsLeaseMin                              = time.Duration(0)
+	DefTiDBStatsLeaseMax                              = time.Duration(0)
+	DefTiDBStatsAlertHealthyThreshold                 = 50
+	DefTiDBStatsReplicaRead                           = "leader"
 	DefTiDBEnableHistoricalStatsForCapture            = false
+	DefTiDBEnableStatsChangeEvents                    = false
+	DefTiDBStatsChangeEventsRetention                 = 7 * 24 * time.Hour
 	DefTiDBTTLJobScheduleWindowStartTime              = "00:00 +0000"
 	DefTiDBTTLJobScheduleWindowEndTime                = "23:59 +0000"
 	DefTiDBTTLScanWorkerCount                         = 4
@@ -1534,8 +1731,21 @@ const (
 	DefTiDBOptEnableLateMaterialization               = true
 	DefTiDBOptOrderingIdxSelThresh                    = 0.0
 	DefTiDBOptOrderingIdxSelRatio                     = -1
+	DefTiDBOptRiskAwareCostFactor                     = 1.0
+	DefTiDBOptUseCostCalibration                      = false
+	DefTiDBOptDynamicSamplingRows                     = 0
+	DefTiDBOptSchemaInformedPseudoStats               = false
+	DefTiDBOptOutOfRangeExtrapolation                 = false
+	DefTiDBOptInListProbeEstimation                   = false
+	DefTiDBOptCardinalityEstimator                    = "histogram"
+	DefTiDBOptStatsSnapshotTS                         = 0
+	DefTiDBOptStaleReadHistoricalStats                = false
+	DefTiDBOptFKFanoutEstimation                      = false
+	DefTiDBOptJoinSkewThreshold                       = 0.0
+	DefTiDBExplainStatsHealth                         = false
 	DefTiDBOptEnableMPPSharedCTEExecution             = false
 	DefTiDBPlanCacheInvalidationOnFreshStats          = true
+	DefTiDBPlanCacheStatsChangeRatio                  = 0.0
 	DefTiDBEnableRowLevelChecksum                     = false
 	DefAuthenticationLDAPSASLAuthMethodName           = "SCRAM-SHA-1"
 	DefAuthenticationLDAPSASLServerPort               = 389
@@ -1557,6 +1767,7 @@ const (
 	DefTiDBEnableCheckConstraint                      = false
 	DefTiDBSkipMissingPartitionStats                  = true
 	DefTiDBOptEnableHashJoin                          = true
+	DefTiDBOptPartitionCardinalityEstimation          = false
 	DefTiDBHashJoinVersion                            = joinversion.HashJoinVersionOptimized
 	DefTiDBOptObjective                               = OptObjectiveModerate
 	DefTiDBSchemaVersionCacheLimit                    = 16
@@ -1582,6 +1793,31 @@ var (
 	ProcessGeneralLog              = atomic.NewBool(false)
 	RunAutoAnalyze                 = atomic.NewBool(DefTiDBEnableAutoAnalyze)
 	EnableAutoAnalyzePriorityQueue = atomic.NewBool(DefTiDBEnableAutoAnalyzePriorityQueue)
+	EnableNewPartitionStatsSeeding = atomic.NewBool(DefTiDBEnableNewPartitionStatsSeeding)
+	// BuildGlobalStatsOnDynamicPruneMode indicates whether to build the missing global stats when switching to dynamic prune mode.
+	BuildGlobalStatsOnDynamicPruneMode = atomic.NewBool(DefTiDBBuildGlobalStatsOnDynamicPruneMode)
+	// EnableTiFlashGlobalStats indicates whether to recompute the global stats by TiFlash.
+	EnableTiFlashGlobalStats = atomic.NewBool(DefTiDBEnableTiFlashGlobalStats)
+	// EnableCardinalityFeedback indicates whether to collect and apply the runtime cardinality feedback.
+	EnableCardinalityFeedback = atomic.NewBool(DefTiDBEnableCardinalityFeedback)
+	// CardinalityFeedbackMinSamples is the number of the samples needed before the feedback is applied.
+	CardinalityFeedbackMinSamples = atomic.NewInt64(DefTiDBCardinalityFeedbackMinSamples)
+	// EstimationErrorSampleRate is the ratio of the statements whose estimation errors are recorded.
+	EstimationErrorSampleRate = atomic.NewFloat64(DefTiDBEstimationErrorSampleRate)
+	// EnablePlanChangeGuard indicates whether to check the plan changes of the top statements after analyze.
+	EnablePlanChangeGuard = atomic.NewBool(DefTiDBEnablePlanChangeGuard)
+	// PlanChangeGuardTopN is the number of the statements checked for each analyzed table.
+	PlanChangeGuardTopN = atomic.NewInt64(DefTiDBPlanChangeGuardTopN)
+	// PlanChangeGuardLatencyRatio is the latency ratio beyond which the plan change is considered as a regression.
+	PlanChangeGuardLatencyRatio = atomic.NewFloat64(DefTiDBPlanChangeGuardLatencyRatio)
+	// EnableBindingRevalidation indicates whether to re-evaluate the bindings on the tables whose stats shift after analyze.
+	EnableBindingRevalidation = atomic.NewBool(DefTiDBEnableBindingRevalidation)
+	// EnableCostCalibration indicates whether to fit the calibration profile of the cost model periodically.
+	EnableCostCalibration = atomic.NewBool(DefTiDBEnableCostCalibration)
+	// PredicateColumnsUsageHalfLife is the half-life of the usage score of the predicate columns.
+	PredicateColumnsUsageHalfLife = atomic.NewDuration(DefTiDBPredicateColumnsUsageHalfLife)
+	// DroppedIndexStatsRetention is the period to retain the stats of the dropped indexes.
+	DroppedIndexStatsRetention = atomic.NewDuration(DefTiDBDroppedIndexStatsRetention)
 	// AnalyzeColumnOptions is a global variable that indicates the default column choice for ANALYZE.
 	// The value of this variable is a string that can be one of the following values:
 	// "PREDICATE", "ALL".
@@ -1682,7 +1918,19 @@ var (
 	IsSandBoxModeEnabled            = atomic.NewBool(false)
 	MaxPreparedStmtCountValue       = atomic.NewInt64(DefMaxPreparedStmtCount)
 	HistoricalStatsDuration         = atomic.NewDuration(DefTiDBHistoricalStatsDuration)
+	AnalyzeJobHistoryRetention      = atomic.NewDuration(DefTiDBAnalyzeJobHistoryRetention)
+	StatsHealthReportInterval       = atomic.NewDuration(DefTiDBStatsHealthReportInterval)
+	StatsHealthReportStorageURI     = atomic.NewString("")
+	StatsDriftCheckInterval         = atomic.NewDuration(DefTiDBStatsDriftCheckInterval)
+	StatsRegionDeltaInterval        = atomic.NewDuration(DefTiDBStatsRegionDeltaInterval)
+	StatsDeltaPendingThreshold      = atomic.NewDuration(DefTiDBStatsDeltaPendingThreshold)
+	StatsLeaseMin                   = atomic.NewDuration(DefTiDBStatsLeaseMin)
+	StatsLeaseMax                   = atomic.NewDuration(DefTiDBStatsLeaseMax)
+	StatsAlertHealthyThreshold      = atomic.NewInt64(DefTiDBStatsAlertHealthyThreshold)
+	StatsReplicaRead                = atomic.NewString(DefTiDBStatsReplicaRead)
 	EnableHistoricalStatsForCapture = atomic.NewBool(DefTiDBEnableHistoricalStatsForCapture)
+	EnableStatsChangeEvents         = atomic.NewBool(DefTiDBEnableStatsChangeEvents)
+	StatsChangeEventsRetention      = atomic.NewDuration(DefTiDBStatsChangeEventsRetention)
 	TTLRunningTasks                 = atomic.NewInt32(DefTiDBTTLRunningTasks)
 	// always set the default value to false because the resource control in kv-client is not inited
 	// It will be initialized to the right value after the first call of `rebuildSysVarCache`
@@ -0,0 +1,82 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variable
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// maxSessionEstimationErrors limits the number of the operators recorded by a session.
+const maxSessionEstimationErrors = 10000
+
+// SessionEstimationErrorKey identifies the estimation errors recorded by a session.
+type SessionEstimationErrorKey struct {
+	TableID  int64
+	Operator string
+}
+
+// SessionEstimationError is the aggregated estimation error of an operator accessing a table.
+type SessionEstimationError struct {
+	SessionEstimationErrorKey
+	Executions int64
+	SumEstRows float64
+	SumActRows float64
+	MaxQError  float64
+	LastSeenAt time.Time
+}
+
+// SessionEstimationErrors aggregates the estimated and actual row counts of the operators accessing tables executed
+// by a session when tidb_record_estimation_errors is on. All public methods are concurrency-safe.
+type SessionEstimationErrors struct {
+	mu      sync.Mutex
+	entries map[SessionEstimationErrorKey]*SessionEstimationError
+}
+
+// NewSessionEstimationErrors creates a SessionEstimationErrors.
+func NewSessionEstimationErrors() *SessionEstimationErrors {
+	return &SessionEstimationErrors{entries: make(map[SessionEstimationErrorKey]*SessionEstimationError)}
+}
+
+// Record records the estimated and the actual row count of an execution of the operator.
+func (e *SessionEstimationErrors) Record(key SessionEstimationErrorKey, estRows, actRows float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	entry, ok := e.entries[key]
+	if !ok {
+		if len(e.entries) >= maxSessionEstimationErrors {
+			return
+		}
+		entry = &SessionEstimationError{SessionEstimationErrorKey: key}
+		e.entries[key] = entry
+	}
+	entry.Executions++
+	entry.SumEstRows += estRows
+	entry.SumActRows += actRows
+	entry.MaxQError = math.Max(entry.MaxQError, math.Max((estRows+1)/(actRows+1), (actRows+1)/(estRows+1)))
+	entry.LastSeenAt = time.Now()
+}
+
+// GetAll returns a copy of the recorded estimation errors.
+func (e *SessionEstimationErrors) GetAll() []SessionEstimationError {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	res := make([]SessionEstimationError, 0, len(e.entries))
+	for _, entry := range e.entries {
+		res = append(res, *entry)
+	}
+	return res
+}
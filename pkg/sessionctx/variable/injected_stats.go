@@ -0,0 +1,73 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variable
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pingcap/errors"
+)
+
+// InjectedTableStats is the fake stats of a table set by tidb_inject_stats, which overlays the real stats of the
+// table in the optimization of the session. The nil fields keep the real stats.
+type InjectedTableStats struct {
+	RowCount    *int64                          `json:"row_count,omitempty"`
+	ModifyCount *int64                          `json:"modify_count,omitempty"`
+	Columns     map[string]*InjectedColumnStats `json:"columns,omitempty"`
+}
+
+// InjectedColumnStats is the fake stats of a column set by tidb_inject_stats.
+type InjectedColumnStats struct {
+	NDV       *int64 `json:"ndv,omitempty"`
+	NullCount *int64 `json:"null_count,omitempty"`
+}
+
+// ParseInjectedStats parses the value of tidb_inject_stats, a JSON object from the qualified table names to their
+// fake stats, e.g. {"test.t": {"row_count": 1000000, "columns": {"a": {"ndv": 1000}}}}. The names are lower-cased.
+func ParseInjectedStats(s string) (map[string]*InjectedTableStats, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var raw map[string]*InjectedTableStats
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, errors.Errorf("invalid injected stats: %v", err)
+	}
+	stats := make(map[string]*InjectedTableStats, len(raw))
+	for name, tbl := range raw {
+		if tbl == nil {
+			continue
+		}
+		if !strings.Contains(name, ".") {
+			return nil, errors.Errorf("invalid injected stats: table name %q isn't qualified by the database", name)
+		}
+		if (tbl.RowCount != nil && *tbl.RowCount < 0) || (tbl.ModifyCount != nil && *tbl.ModifyCount < 0) {
+			return nil, errors.Errorf("invalid injected stats: negative count of table %q", name)
+		}
+		cols := make(map[string]*InjectedColumnStats, len(tbl.Columns))
+		for colName, col := range tbl.Columns {
+			if col == nil {
+				continue
+			}
+			if (col.NDV != nil && *col.NDV < 0) || (col.NullCount != nil && *col.NullCount < 0) {
+				return nil, errors.Errorf("invalid injected stats: negative count of column %q of table %q", colName, name)
+			}
+			cols[strings.ToLower(colName)] = col
+		}
+		tbl.Columns = cols
+		stats[strings.ToLower(name)] = tbl
+	}
+	return stats, nil
+}
@@ -74,6 +74,19 @@ func (m *MockGlobalAccessor) GetGlobalSysVar(name string) (string, error) {
 	return "", ErrUnknownSystemVar.GenWithStackByArgs(name)
 }
 
+// GetGlobalSysVars implements GlobalVarAccessor.GetGlobalSysVars interface.
+func (m *MockGlobalAccessor) GetGlobalSysVars(names ...string) (map[string]string, error) {
+	vals := make(map[string]string, len(names))
+	for _, name := range names {
+		val, err := m.GetGlobalSysVar(name)
+		if err != nil {
+			return nil, err
+		}
+		vals[name] = val
+	}
+	return vals, nil
+}
+
 // SetGlobalSysVar implements GlobalVarAccessor.SetGlobalSysVar interface.
 func (m *MockGlobalAccessor) SetGlobalSysVar(ctx context.Context, name string, value string) (err error) {
 	sv := GetSysVar(name)
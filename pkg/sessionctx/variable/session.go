@@ -584,12 +584,17 @@ type TemporaryTableData interface {
 	DeleteTableKey(tblID int64, k kv.Key) error
 	// SetTableKey sets the entry for k from table
 	SetTableKey(tblID int64, k kv.Key, val []byte) error
+	// GetTableStats gets the stats of a table collected in the committed transactions (*statistics.TempTableStats)
+	GetTableStats(tblID int64) any
+	// SetTableStats sets the stats of a table collected in the committed transactions, nil removes them
+	SetTableStats(tblID int64, stats any)
 }
 
 // temporaryTableData is used for store temporary table data in session
 type temporaryTableData struct {
 	kv.MemBuffer
-	tblSize map[int64]int64
+	tblSize  map[int64]int64
+	tblStats map[int64]any
 }
 
 // NewTemporaryTableData creates a new TemporaryTableData
@@ -597,6 +602,7 @@ func NewTemporaryTableData(memBuffer kv.MemBuffer) TemporaryTableData {
 	return &temporaryTableData{
 		MemBuffer: memBuffer,
 		tblSize:   make(map[int64]int64),
+		tblStats:  make(map[int64]any),
 	}
 }
 
@@ -624,6 +630,20 @@ func (d *temporaryTableData) SetTableKey(tblID int64, k kv.Key, val []byte) erro
 	return d.MemBuffer.Set(k, val)
 }
 
+// GetTableStats gets the stats of a table collected in the committed transactions
+func (d *temporaryTableData) GetTableStats(tblID int64) any {
+	return d.tblStats[tblID]
+}
+
+// SetTableStats sets the stats of a table collected in the committed transactions, nil removes them
+func (d *temporaryTableData) SetTableStats(tblID int64, stats any) {
+	if stats == nil {
+		delete(d.tblStats, tblID)
+		return
+	}
+	d.tblStats[tblID] = stats
+}
+
 func (d *temporaryTableData) updateTblSize(tblID int64, beforeSize int) {
 	delta := int64(d.MemBuffer.Size() - beforeSize)
 	d.tblSize[tblID] = d.GetTableSize(tblID) + delta
@@ -1008,6 +1028,14 @@ type SessionVars struct {
 	// EnableCorrelationAdjustment is used to indicate if correlation adjustment is enabled.
 	EnableCorrelationAdjustment bool
 
+	// ExtrapolateRowCountByAutoID indicates whether to extrapolate the row count of the table by the auto IDs
+	// allocated since the stats meta was loaded.
+	ExtrapolateRowCountByAutoID bool
+
+	// InjectedStats is the fake stats of the tables set by tidb_inject_stats, keyed by the lower-cased qualified
+	// table names.
+	InjectedStats map[string]*InjectedTableStats
+
 	// CorrelationExpFactor is used to control the heuristic approach of row count estimation when CorrelationThreshold is not met.
 	CorrelationExpFactor int
 
@@ -1078,6 +1106,20 @@ type SessionVars struct {
 	// OptimizerSelectivityLevel defines the level of the selectivity estimation in plan.
 	OptimizerSelectivityLevel int
 
+	// RecordEstimationErrors indicates whether the estimated and actual row counts of the operators accessing tables
+	// are recorded into EstimationErrors for every statement of the session.
+	RecordEstimationErrors bool
+
+	// EstimationErrors holds the estimation errors recorded when RecordEstimationErrors is on.
+	EstimationErrors *SessionEstimationErrors
+
+	// EstimationErrorWindow is the time window of the estimation errors ranked in
+	// information_schema.estimation_error_ranking.
+	EstimationErrorWindow time.Duration
+
+	// UnusedIndexWindow is how long an index isn't used to be reported by information_schema.unused_index_advice.
+	UnusedIndexWindow time.Duration
+
 	// OptimizerEnableNewOnlyFullGroupByCheck enables the new only_full_group_by check which is implemented by maintaining functional dependency.
 	OptimizerEnableNewOnlyFullGroupByCheck bool
 
@@ -1332,6 +1374,10 @@ type SessionVars struct {
 	// UseHashJoinV2 indicates whether to use hash join v2.
 	UseHashJoinV2 bool
 
+	// EnablePartitionCardinalityEstimation indicates whether to cap the estimated rows of LIST/HASH partitioned tables
+	// by the partition-level row counts of the partitions left after pruning under the dynamic prune mode.
+	EnablePartitionCardinalityEstimation bool
+
 	// EnableHistoricalStats indicates whether to enable historical statistics.
 	EnableHistoricalStats bool
 
@@ -1447,6 +1493,10 @@ type SessionVars struct {
 	// When it is true, ANALYZE reads data on the snapshot at the beginning of ANALYZE.
 	EnableAnalyzeSnapshot bool
 
+	// TruncateTableStatsRefillFactor is the factor to scale the stats of the truncated table, which are kept as
+	// the initial stats of the new table. 0 means the stats are not kept.
+	TruncateTableStatsRefillFactor float64
+
 	// DefaultStrMatchSelectivity adjust the estimation strategy for string matching expressions that can't be estimated by building into range.
 	// when > 0: it's the selectivity for the expression.
 	// when = 0: try to use TopN to evaluate the like expression to estimate the selectivity.
@@ -1486,6 +1536,10 @@ type SessionVars struct {
 	// related stats are analyzed after the plan cache is generated.
 	PlanCacheInvalidationOnFreshStats bool
 
+	// PlanCacheStatsChangeRatio is the ratio of the row count change of any related table since a cached plan was built,
+	// beyond which the cached plan is invalidated. 0 means disabled.
+	PlanCacheStatsChangeRatio float64
+
 	// NonPreparedPlanCacheSize controls the size of non-prepared plan cache.
 	NonPreparedPlanCacheSize uint64
 
@@ -1614,6 +1668,52 @@ type SessionVars struct {
 	// 0 > value <= 1 applies that percentage as the estimate when rows are found. For example 0.1 = 10%.
 	OptOrderingIdxSelRatio float64
 
+	// RiskAwareCostFactor is the factor to multiply the per-row costs of the operators driven by an input whose
+	// estimated row count has low confidence, i.e. derived from the pseudo or outdated stats, or out of the range of
+	// the histograms. Value <= 1 disables the risk-aware plan selection.
+	RiskAwareCostFactor float64
+
+	// UseCostCalibration indicates whether the cost model uses the calibration profile of the cluster.
+	UseCostCalibration bool
+
+	// DynamicSamplingRows is the max number of the rows sampled from a table without usable stats when the query is
+	// compiled. 0 disables the dynamic sampling.
+	DynamicSamplingRows int
+
+	// SchemaInformedPseudoStats indicates whether the pseudo stats are inferred from the schema and the regions.
+	SchemaInformedPseudoStats bool
+
+	// OutOfRangeExtrapolation indicates whether the out-of-range part of the ranges on an increasing column is
+	// estimated by extrapolating the histogram with the velocity of the modifications.
+	OutOfRangeExtrapolation bool
+
+	// InListProbeEstimation indicates whether the IN lists which can't be converted to ranges are estimated by probing
+	// the constants against the stats.
+	InListProbeEstimation bool
+
+	// CardinalityEstimator is the name of the cardinality estimator the optimizer uses.
+	CardinalityEstimator string
+
+	// StatsSnapshotTS is the timestamp of the historical stats the queries are optimized with, 0 for the latest
+	// stats. It's overridden by the STATS_SNAPSHOT_TS hint.
+	StatsSnapshotTS uint64
+
+	// StaleReadHistoricalStats indicates whether the stale read queries are optimized with the historical stats at
+	// the read timestamp. The STATS_SNAPSHOT_TS hint and tidb_opt_stats_snapshot_ts take precedence over it.
+	StaleReadHistoricalStats bool
+
+	// FKFanoutEstimation indicates whether the equal joins on the foreign keys are estimated by the fanouts of the
+	// foreign keys collected in analyze rather than the NDVs of the join keys.
+	FKFanoutEstimation bool
+
+	// JoinSkewThreshold is the ratio of the rows the most frequent value of a join key must account for to regard the
+	// join key as skewed. 0 disables the skew detection.
+	JoinSkewThreshold float64
+
+	// ExplainStatsHealth indicates whether to annotate the table accesses in the execution plan with the health of
+	// the stats they use.
+	ExplainStatsHealth bool
+
 	// EnableMPPSharedCTEExecution indicates whether we enable the shared CTE execution strategy on MPP side.
 	EnableMPPSharedCTEExecution bool
 
@@ -2150,6 +2250,9 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		MetricSchemaStep:              vardef.DefTiDBMetricSchemaStep,
 		MetricSchemaRangeDuration:     vardef.DefTiDBMetricSchemaRangeDuration,
 		SequenceState:                 NewSequenceState(),
+		EstimationErrors:              NewSessionEstimationErrors(),
+		EstimationErrorWindow:         vardef.DefTiDBEstimationErrorWindow,
+		UnusedIndexWindow:             vardef.DefTiDBUnusedIndexWindow,
 		WindowingUseHighPrecision:     true,
 		PrevFoundInPlanCache:          vardef.DefTiDBFoundInPlanCache,
 		FoundInPlanCache:              vardef.DefTiDBFoundInPlanCache,
@@ -1072,6 +1072,184 @@ var defaultSysVars = []*SysVar{
 			return normalizedValue, nil
 		},
 	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBPredicateColumnsUsageHalfLife, Value: vardef.DefTiDBPredicateColumnsUsageHalfLife.String(), Type: vardef.TypeDuration, MinValue: 0, MaxValue: uint64(time.Hour * 24 * 365 * 10),
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return vardef.PredicateColumnsUsageHalfLife.Load().String(), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			d, err := time.ParseDuration(val)
+			if err != nil {
+				return err
+			}
+			vardef.PredicateColumnsUsageHalfLife.Store(d)
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBDroppedIndexStatsRetention, Value: vardef.DefTiDBDroppedIndexStatsRetention.String(), Type: vardef.TypeDuration, MinValue: 0, MaxValue: uint64(time.Hour * 24 * 365),
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return vardef.DroppedIndexStatsRetention.Load().String(), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			d, err := time.ParseDuration(val)
+			if err != nil {
+				return err
+			}
+			vardef.DroppedIndexStatsRetention.Store(d)
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBEnableNewPartitionStatsSeeding, Value: BoolToOnOff(vardef.DefTiDBEnableNewPartitionStatsSeeding), Type: vardef.TypeBool,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return BoolToOnOff(vardef.EnableNewPartitionStatsSeeding.Load()), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			vardef.EnableNewPartitionStatsSeeding.Store(TiDBOptOn(val))
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBBuildGlobalStatsOnDynamicPruneMode, Value: BoolToOnOff(vardef.DefTiDBBuildGlobalStatsOnDynamicPruneMode), Type: vardef.TypeBool,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return BoolToOnOff(vardef.BuildGlobalStatsOnDynamicPruneMode.Load()), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			vardef.BuildGlobalStatsOnDynamicPruneMode.Store(TiDBOptOn(val))
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBEnableTiFlashGlobalStats, Value: BoolToOnOff(vardef.DefTiDBEnableTiFlashGlobalStats), Type: vardef.TypeBool,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return BoolToOnOff(vardef.EnableTiFlashGlobalStats.Load()), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			vardef.EnableTiFlashGlobalStats.Store(TiDBOptOn(val))
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBEnableCardinalityFeedback, Value: BoolToOnOff(vardef.DefTiDBEnableCardinalityFeedback), Type: vardef.TypeBool,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return BoolToOnOff(vardef.EnableCardinalityFeedback.Load()), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			vardef.EnableCardinalityFeedback.Store(TiDBOptOn(val))
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBCardinalityFeedbackMinSamples, Value: strconv.Itoa(vardef.DefTiDBCardinalityFeedbackMinSamples), Type: vardef.TypeInt, MinValue: 1, MaxValue: 10000,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return strconv.FormatInt(vardef.CardinalityFeedbackMinSamples.Load(), 10), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			vardef.CardinalityFeedbackMinSamples.Store(TidbOptInt64(val, vardef.DefTiDBCardinalityFeedbackMinSamples))
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBEstimationErrorSampleRate, Value: strconv.FormatFloat(vardef.DefTiDBEstimationErrorSampleRate, 'f', -1, 64), Type: vardef.TypeFloat, MinValue: 0, MaxValue: 1,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return strconv.FormatFloat(vardef.EstimationErrorSampleRate.Load(), 'f', -1, 64), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			f, err := strconv.ParseFloat(val, 64)
+			if err == nil {
+				vardef.EstimationErrorSampleRate.Store(f)
+			}
+			return err
+		},
+	},
+	{
+		Scope: vardef.ScopeSession, Name: vardef.TiDBRecordEstimationErrors, Value: BoolToOnOff(vardef.DefTiDBRecordEstimationErrors), Type: vardef.TypeBool,
+		SetSession: func(s *SessionVars, val string) error {
+			enabled := TiDBOptOn(val)
+			// Start a fresh recording each time it is turned on.
+			if enabled && !s.RecordEstimationErrors {
+				s.EstimationErrors = NewSessionEstimationErrors()
+			}
+			s.RecordEstimationErrors = enabled
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBEstimationErrorWindow, Value: vardef.DefTiDBEstimationErrorWindow.String(), Type: vardef.TypeDuration, MinValue: int64(time.Minute), MaxValue: uint64(time.Hour * 24 * 365),
+		SetSession: func(s *SessionVars, val string) error {
+			d, err := time.ParseDuration(val)
+			if err != nil {
+				return err
+			}
+			s.EstimationErrorWindow = d
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBUnusedIndexWindow, Value: vardef.DefTiDBUnusedIndexWindow.String(), Type: vardef.TypeDuration, MinValue: int64(time.Minute), MaxValue: uint64(time.Hour * 24 * 365),
+		SetSession: func(s *SessionVars, val string) error {
+			d, err := time.ParseDuration(val)
+			if err != nil {
+				return err
+			}
+			s.UnusedIndexWindow = d
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBEnablePlanChangeGuard, Value: BoolToOnOff(vardef.DefTiDBEnablePlanChangeGuard), Type: vardef.TypeBool,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return BoolToOnOff(vardef.EnablePlanChangeGuard.Load()), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			vardef.EnablePlanChangeGuard.Store(TiDBOptOn(val))
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBPlanChangeGuardTopN, Value: strconv.Itoa(vardef.DefTiDBPlanChangeGuardTopN), Type: vardef.TypeInt, MinValue: 1, MaxValue: 1000,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return strconv.FormatInt(vardef.PlanChangeGuardTopN.Load(), 10), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			vardef.PlanChangeGuardTopN.Store(TidbOptInt64(val, vardef.DefTiDBPlanChangeGuardTopN))
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBPlanChangeGuardLatencyRatio, Value: strconv.FormatFloat(vardef.DefTiDBPlanChangeGuardLatencyRatio, 'f', -1, 64), Type: vardef.TypeFloat, MinValue: 1, MaxValue: math.MaxUint32,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return strconv.FormatFloat(vardef.PlanChangeGuardLatencyRatio.Load(), 'f', -1, 64), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			f, err := strconv.ParseFloat(val, 64)
+			if err == nil {
+				vardef.PlanChangeGuardLatencyRatio.Store(f)
+			}
+			return err
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBEnableBindingRevalidation, Value: BoolToOnOff(vardef.DefTiDBEnableBindingRevalidation), Type: vardef.TypeBool,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return BoolToOnOff(vardef.EnableBindingRevalidation.Load()), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			vardef.EnableBindingRevalidation.Store(TiDBOptOn(val))
+			return nil
+		},
+	},
+	{
+		Scope: vardef.ScopeGlobal, Name: vardef.TiDBEnableCostCalibration, Value: BoolToOnOff(vardef.DefTiDBEnableCostCalibration), Type: vardef.TypeBool,
+		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+			return BoolToOnOff(vardef.EnableCostCalibration.Load()), nil
+		},
+		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			vardef.EnableCostCalibration.Store(TiDBOptOn(val))
+			return nil
+		},
+	},
 	{
 		Scope: vardef.ScopeGlobal, Name: vardef.TiDBEnableAutoAnalyzePriorityQueue, Value: BoolToOnOff(vardef.DefTiDBEnableAutoAnalyzePriorityQueue), Type: vardef.TypeBool,
 		GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
@@ -1538,6 +1716,26 @@ var defaultSysVars = []*SysVar{
 			return BoolToOnOff(vardef.EnableHistoricalStatsForCapture.Load()), nil
 		},
 	},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBEnableStatsChangeEvents, Value: BoolToOnOff(vardef.DefTiDBEnableStatsChangeEvents), Type: vardef.TypeBool,
+		SetGlobal: func(_ context.Context, _ *SessionVars, s string) error {
+			vardef.EnableStatsChangeEvents.Store(TiDBOptOn(s))
+			return nil
+		},
+		GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return BoolToOnOff(vardef.EnableStatsChangeEvents.Load()), nil
+		},
+	},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBStatsChangeEventsRetention, Value: vardef.DefTiDBStatsChangeEventsRetention.String(), Type: vardef.TypeDuration, MinValue: int64(time.Hour), MaxValue: uint64(time.Hour * 24 * 365),
+		GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return vardef.StatsChangeEventsRetention.Load().String(), nil
+		}, SetGlobal: func(_ context.Context, _ *SessionVars, s string) error {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			vardef.StatsChangeEventsRetention.Store(d)
+			return nil
+		}},
 	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBHistoricalStatsDuration, Value: vardef.DefTiDBHistoricalStatsDuration.String(), Type: vardef.TypeDuration, MinValue: int64(time.Second), MaxValue: uint64(time.Hour * 24 * 365),
 		GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
 			return vardef.HistoricalStatsDuration.Load().String(), nil
@@ -1549,6 +1747,108 @@ var defaultSysVars = []*SysVar{
 			vardef.HistoricalStatsDuration.Store(d)
 			return nil
 		}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBAnalyzeJobHistoryRetention, Value: vardef.DefTiDBAnalyzeJobHistoryRetention.String(), Type: vardef.TypeDuration, MinValue: int64(time.Hour), MaxValue: uint64(time.Hour * 24 * 365),
+		GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+			return vardef.AnalyzeJobHistoryRetention.Load().String(), nil
+		}, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			vardef.AnalyzeJobHistoryRetention.Store(d)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBStatsHealthReportInterval, Value: vardef.DefTiDBStatsHealthReportInterval.String(), Type: vardef.TypeDuration, MinValue: 0, MaxValue: uint64(time.Hour * 24 * 365),
+		GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+			return vardef.StatsHealthReportInterval.Load().String(), nil
+		}, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			vardef.StatsHealthReportInterval.Store(d)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBStatsDriftCheckInterval, Value: vardef.DefTiDBStatsDriftCheckInterval.String(), Type: vardef.TypeDuration, MinValue: 0, MaxValue: uint64(time.Hour * 24 * 365),
+		GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+			return vardef.StatsDriftCheckInterval.Load().String(), nil
+		}, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			vardef.StatsDriftCheckInterval.Store(d)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBStatsRegionDeltaInterval, Value: vardef.DefTiDBStatsRegionDeltaInterval.String(), Type: vardef.TypeDuration, MinValue: 0, MaxValue: uint64(time.Hour * 24 * 365),
+		GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+			return vardef.StatsRegionDeltaInterval.Load().String(), nil
+		}, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			vardef.StatsRegionDeltaInterval.Store(d)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBStatsDeltaPendingThreshold, Value: vardef.DefTiDBStatsDeltaPendingThreshold.String(), Type: vardef.TypeDuration, MinValue: 0, MaxValue: uint64(time.Hour * 24 * 365),
+		GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+			return vardef.StatsDeltaPendingThreshold.Load().String(), nil
+		}, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			vardef.StatsDeltaPendingThreshold.Store(d)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBStatsLeaseMin, Value: vardef.DefTiDBStatsLeaseMin.String(), Type: vardef.TypeDuration, MinValue: 0, MaxValue: uint64(time.Hour),
+		GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+			return vardef.StatsLeaseMin.Load().String(), nil
+		}, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			vardef.StatsLeaseMin.Store(d)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBStatsLeaseMax, Value: vardef.DefTiDBStatsLeaseMax.String(), Type: vardef.TypeDuration, MinValue: 0, MaxValue: uint64(time.Hour),
+		GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+			return vardef.StatsLeaseMax.Load().String(), nil
+		}, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			vardef.StatsLeaseMax.Store(d)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBStatsHealthReportStorageURI, Value: "", Type: vardef.TypeStr,
+		GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+			uri := vardef.StatsHealthReportStorageURI.Load()
+			if len(uri) > 0 {
+				uri = ast.RedactURL(uri)
+			}
+			return uri, nil
+		}, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+			vardef.StatsHealthReportStorageURI.Store(s)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBStatsAlertHealthyThreshold, Value: strconv.Itoa(vardef.DefTiDBStatsAlertHealthyThreshold), Type: vardef.TypeInt, MinValue: 0, MaxValue: 100,
+		GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return strconv.FormatInt(vardef.StatsAlertHealthyThreshold.Load(), 10), nil
+		}, SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			vardef.StatsAlertHealthyThreshold.Store(TidbOptInt64(val, vardef.DefTiDBStatsAlertHealthyThreshold))
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBStatsReplicaRead, Value: vardef.DefTiDBStatsReplicaRead, Type: vardef.TypeEnum, PossibleValues: []string{"leader", "prefer-leader", "follower", "leader-and-follower", "closest-replicas", "learner"},
+		GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return vardef.StatsReplicaRead.Load(), nil
+		}, SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			vardef.StatsReplicaRead.Store(val)
+			return nil
+		}},
 	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBLowResolutionTSOUpdateInterval, Value: strconv.Itoa(vardef.DefTiDBLowResolutionTSOUpdateInterval), Type: vardef.TypeInt, MinValue: 10, MaxValue: 60000,
 		SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
 			vardef.LowResolutionTSOUpdateInterval.Store(uint32(TidbOptInt64(val, vardef.DefTiDBLowResolutionTSOUpdateInterval)))
@@ -1988,6 +2288,22 @@ var defaultSysVars = []*SysVar{
 		s.EnableCorrelationAdjustment = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptExtrapolateRowCountByAutoID, Value: BoolToOnOff(vardef.DefOptExtrapolateRowCountByAutoID), Type: vardef.TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.ExtrapolateRowCountByAutoID = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: vardef.ScopeSession, Name: vardef.TiDBInjectStats, Value: "", Type: vardef.TypeStr,
+		Validation: func(_ *SessionVars, normalizedValue string, _ string, _ vardef.ScopeFlag) (string, error) {
+			_, err := ParseInjectedStats(normalizedValue)
+			return normalizedValue, err
+		}, SetSession: func(s *SessionVars, val string) error {
+			stats, err := ParseInjectedStats(val)
+			if err != nil {
+				return err
+			}
+			s.InjectedStats = stats
+			return nil
+		}},
 	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptCorrelationExpFactor, Value: strconv.Itoa(vardef.DefOptCorrelationExpFactor), Type: vardef.TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt32, SetSession: func(s *SessionVars, val string) error {
 		s.CorrelationExpFactor = int(TidbOptInt64(val, vardef.DefOptCorrelationExpFactor))
 		return nil
@@ -2323,20 +2639,8 @@ var defaultSysVars = []*SysVar{
 		return nil
 	}},
 	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBReplicaRead, Value: "leader", Type: vardef.TypeEnum, PossibleValues: []string{"leader", "prefer-leader", "follower", "leader-and-follower", "closest-replicas", "closest-adaptive", "learner"}, SetSession: func(s *SessionVars, val string) error {
-		if strings.EqualFold(val, "follower") {
-			s.SetReplicaRead(kv.ReplicaReadFollower)
-		} else if strings.EqualFold(val, "leader-and-follower") {
-			s.SetReplicaRead(kv.ReplicaReadMixed)
-		} else if strings.EqualFold(val, "leader") || len(val) == 0 {
-			s.SetReplicaRead(kv.ReplicaReadLeader)
-		} else if strings.EqualFold(val, "closest-replicas") {
-			s.SetReplicaRead(kv.ReplicaReadClosest)
-		} else if strings.EqualFold(val, "closest-adaptive") {
-			s.SetReplicaRead(kv.ReplicaReadClosestAdaptive)
-		} else if strings.EqualFold(val, "learner") {
-			s.SetReplicaRead(kv.ReplicaReadLearner)
-		} else if strings.EqualFold(val, "prefer-leader") {
-			s.SetReplicaRead(kv.ReplicaReadPreferLeader)
+		if replicaRead, ok := ParseReplicaRead(val); ok {
+			s.SetReplicaRead(replicaRead)
 		}
 		return nil
 	}},
@@ -2457,6 +2761,10 @@ var defaultSysVars = []*SysVar{
 		s.DisableHashJoin = !TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptPartitionCardinalityEstimation, Value: BoolToOnOff(vardef.DefTiDBOptPartitionCardinalityEstimation), Type: vardef.TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnablePartitionCardinalityEstimation = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBEnableIndexMergeJoin, Value: BoolToOnOff(vardef.DefTiDBEnableIndexMergeJoin), Hidden: true, Type: vardef.TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.EnableIndexMergeJoin = TiDBOptOn(val)
 		return nil
@@ -2650,6 +2958,10 @@ var defaultSysVars = []*SysVar{
 		s.EnableAnalyzeSnapshot = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBTruncateTableStatsRefillFactor, Value: strconv.FormatFloat(vardef.DefTiDBTruncateTableStatsRefillFactor, 'f', -1, 64), Type: vardef.TypeFloat, MinValue: 0, MaxValue: 100, SetSession: func(s *SessionVars, val string) error {
+		s.TruncateTableStatsRefillFactor = tidbOptFloat64(val, vardef.DefTiDBTruncateTableStatsRefillFactor)
+		return nil
+	}},
 	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBGenerateBinaryPlan, Value: BoolToOnOff(vardef.DefTiDBGenerateBinaryPlan), Type: vardef.TypeBool, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
 		GenerateBinaryPlan.Store(TiDBOptOn(val))
 		return nil
@@ -3007,6 +3319,77 @@ var defaultSysVars = []*SysVar{
 			s.OptOrderingIdxSelRatio = tidbOptFloat64(val, vardef.DefTiDBOptOrderingIdxSelRatio)
 			return nil
 		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptRiskAwareCostFactor, Value: strconv.FormatFloat(vardef.DefTiDBOptRiskAwareCostFactor, 'f', -1, 64), Type: vardef.TypeFloat, MinValue: 1, MaxValue: math.MaxUint64,
+		SetSession: func(s *SessionVars, val string) error {
+			s.RiskAwareCostFactor = tidbOptFloat64(val, vardef.DefTiDBOptRiskAwareCostFactor)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptUseCostCalibration, Value: BoolToOnOff(vardef.DefTiDBOptUseCostCalibration), Type: vardef.TypeBool,
+		SetSession: func(s *SessionVars, val string) error {
+			s.UseCostCalibration = TiDBOptOn(val)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptDynamicSamplingRows, Value: strconv.Itoa(vardef.DefTiDBOptDynamicSamplingRows), Type: vardef.TypeUnsigned, MinValue: 0, MaxValue: 100000,
+		SetSession: func(s *SessionVars, val string) error {
+			s.DynamicSamplingRows = TidbOptInt(val, vardef.DefTiDBOptDynamicSamplingRows)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptSchemaInformedPseudoStats, Value: BoolToOnOff(vardef.DefTiDBOptSchemaInformedPseudoStats), Type: vardef.TypeBool,
+		SetSession: func(s *SessionVars, val string) error {
+			s.SchemaInformedPseudoStats = TiDBOptOn(val)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptOutOfRangeExtrapolation, Value: BoolToOnOff(vardef.DefTiDBOptOutOfRangeExtrapolation), Type: vardef.TypeBool,
+		SetSession: func(s *SessionVars, val string) error {
+			s.OutOfRangeExtrapolation = TiDBOptOn(val)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptInListProbeEstimation, Value: BoolToOnOff(vardef.DefTiDBOptInListProbeEstimation), Type: vardef.TypeBool,
+		SetSession: func(s *SessionVars, val string) error {
+			s.InListProbeEstimation = TiDBOptOn(val)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptCardinalityEstimator, Value: vardef.DefTiDBOptCardinalityEstimator, Type: vardef.TypeStr,
+		Validation: func(_ *SessionVars, normalizedValue string, originalValue string, _ vardef.ScopeFlag) (string, error) {
+			name := strings.ToLower(normalizedValue)
+			if CardinalityEstimatorRegistered != nil && !CardinalityEstimatorRegistered(name) {
+				return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(vardef.TiDBOptCardinalityEstimator, originalValue)
+			}
+			return name, nil
+		},
+		SetSession: func(s *SessionVars, val string) error {
+			s.CardinalityEstimator = val
+			return nil
+		}},
+	{Scope: vardef.ScopeSession, Name: vardef.TiDBOptStatsSnapshotTS, Value: strconv.Itoa(vardef.DefTiDBOptStatsSnapshotTS), Type: vardef.TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint64,
+		SetSession: func(s *SessionVars, val string) error {
+			ts, err := strconv.ParseUint(val, 10, 64)
+			if err != nil {
+				return err
+			}
+			s.StatsSnapshotTS = ts
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptStaleReadHistoricalStats, Value: BoolToOnOff(vardef.DefTiDBOptStaleReadHistoricalStats), Type: vardef.TypeBool,
+		SetSession: func(s *SessionVars, val string) error {
+			s.StaleReadHistoricalStats = TiDBOptOn(val)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptFKFanoutEstimation, Value: BoolToOnOff(vardef.DefTiDBOptFKFanoutEstimation), Type: vardef.TypeBool,
+		SetSession: func(s *SessionVars, val string) error {
+			s.FKFanoutEstimation = TiDBOptOn(val)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptJoinSkewThreshold, Value: strconv.FormatFloat(vardef.DefTiDBOptJoinSkewThreshold, 'f', -1, 64), Type: vardef.TypeFloat, MinValue: 0, MaxValue: 1,
+		SetSession: func(s *SessionVars, val string) error {
+			s.JoinSkewThreshold = tidbOptFloat64(val, vardef.DefTiDBOptJoinSkewThreshold)
+			return nil
+		}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBExplainStatsHealth, Value: BoolToOnOff(vardef.DefTiDBExplainStatsHealth), Type: vardef.TypeBool,
+		SetSession: func(s *SessionVars, val string) error {
+			s.ExplainStatsHealth = TiDBOptOn(val)
+			return nil
+		}},
 	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptEnableMPPSharedCTEExecution, Value: BoolToOnOff(vardef.DefTiDBOptEnableMPPSharedCTEExecution), Type: vardef.TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.EnableMPPSharedCTEExecution = TiDBOptOn(val)
 		return nil
@@ -3052,6 +3435,11 @@ var defaultSysVars = []*SysVar{
 		s.PlanCacheInvalidationOnFreshStats = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBPlanCacheStatsChangeRatio, Value: strconv.FormatFloat(vardef.DefTiDBPlanCacheStatsChangeRatio, 'f', -1, 64), Type: vardef.TypeFloat, MinValue: 0, MaxValue: math.MaxUint64,
+		SetSession: func(s *SessionVars, val string) error {
+			s.PlanCacheStatsChangeRatio = tidbOptFloat64(val, vardef.DefTiDBPlanCacheStatsChangeRatio)
+			return nil
+		}},
 	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiFlashReplicaRead, Value: vardef.DefTiFlashReplicaRead, Type: vardef.TypeEnum, PossibleValues: []string{vardef.DefTiFlashReplicaRead, vardef.ClosestAdaptiveStr, vardef.ClosestReplicasStr},
 		SetSession: func(s *SessionVars, val string) error {
 			s.TiFlashReplicaRead = tiflash.GetTiFlashReplicaReadByStr(val)
@@ -56,6 +56,9 @@ var (
 	DisableStatsOwner func() error = nil
 	// ChangePDMetadataCircuitBreakerErrorRateThresholdPct changes the error rate threshold of the PD metadata circuit breaker.
 	ChangePDMetadataCircuitBreakerErrorRateThresholdPct func(uint32) = nil
+	// CardinalityEstimatorRegistered is the func registered by the planner to check whether a cardinality estimator
+	// is registered.
+	CardinalityEstimatorRegistered func(name string) bool = nil
 )
 
 // Hooks functions for Cluster Resource Control.
@@ -566,6 +566,8 @@ func init() {
 type GlobalVarAccessor interface {
 	// GetGlobalSysVar gets the global system variable value for name.
 	GetGlobalSysVar(name string) (string, error)
+	// GetGlobalSysVars gets the global system variable values for the names at once.
+	GetGlobalSysVars(names ...string) (map[string]string, error)
 	// SetGlobalSysVar sets the global system variable name to value.
 	SetGlobalSysVar(ctx context.Context, name string, value string) error
 	// SetGlobalSysVarOnly sets the global system variable without calling the validation function or updating aliases.
@@ -26,6 +26,7 @@ import (
 
 	"github.com/docker/go-units"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/charset"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
@@ -185,6 +186,28 @@ func TiDBOptOn(opt string) bool {
 	return strings.EqualFold(opt, "ON") || opt == "1"
 }
 
+// ParseReplicaRead converts the value of tidb_replica_read to the replica read type, it returns false if the value
+// is invalid. The empty value means the leader read.
+func ParseReplicaRead(val string) (kv.ReplicaReadType, bool) {
+	switch {
+	case strings.EqualFold(val, "follower"):
+		return kv.ReplicaReadFollower, true
+	case strings.EqualFold(val, "leader-and-follower"):
+		return kv.ReplicaReadMixed, true
+	case strings.EqualFold(val, "leader") || len(val) == 0:
+		return kv.ReplicaReadLeader, true
+	case strings.EqualFold(val, "closest-replicas"):
+		return kv.ReplicaReadClosest, true
+	case strings.EqualFold(val, "closest-adaptive"):
+		return kv.ReplicaReadClosestAdaptive, true
+	case strings.EqualFold(val, "learner"):
+		return kv.ReplicaReadLearner, true
+	case strings.EqualFold(val, "prefer-leader"):
+		return kv.ReplicaReadPreferLeader, true
+	}
+	return kv.ReplicaReadLeader, false
+}
+
 const (
 	// OffInt is used by TiDBOptOnOffWarn
 	OffInt = 0
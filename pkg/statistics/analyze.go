@@ -86,7 +86,9 @@ func (a *AnalyzeResult) DestroyAndPutToPool() {
 type AnalyzeResults struct {
 	Err      error
 	ExtStats *ExtendedStatsColl
-	Job      *AnalyzeJob
+	// FKFanouts are the fanouts of the foreign keys of the table, which are collected in the analyze of stats version 2.
+	FKFanouts []*FKFanout
+	Job       *AnalyzeJob
 	// Ars: combine the analyze result of all columns and the analyze result of indexes.
 	// (In stats version2)
 	// For example:
@@ -102,6 +104,9 @@ type AnalyzeResults struct {
 	BaseCount int64
 	// BaseModifyCnt is the original modify_count in mysql.stats_meta at the beginning of analyze.
 	BaseModifyCnt int64
+	// LastAnalyzedHandle is the max handle of the analyzed rows, which is recorded by ANALYZE INCREMENTAL TABLE in
+	// mysql.stats_meta, so the next one only samples the rows with larger handles. It's nil for the other analyzes.
+	LastAnalyzedHandle *int64
 	// For multi-valued index analyze, there are some very different behaviors, so we add this field to indicate it.
 	//
 	// Analyze result of multi-valued index come from an independent v2 analyze index task (AnalyzeIndexExec), and it's
@@ -15,9 +15,15 @@
 package statistics
 
 import (
+	"context"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/parser/terror"
 )
 
 const (
@@ -31,6 +37,71 @@ const (
 	AnalyzeFailed = "failed"
 )
 
+// The categories of the fail reasons of the analyze jobs, recorded in mysql.analyze_jobs.fail_category.
+const (
+	// AnalyzeFailureKilled means the analyze job is killed or canceled.
+	AnalyzeFailureKilled = "killed"
+	// AnalyzeFailureTimeout means the analyze job exceeds the max execution time.
+	AnalyzeFailureTimeout = "timeout"
+	// AnalyzeFailureMemoryQuota means the analyze job exceeds the memory quota.
+	AnalyzeFailureMemoryQuota = "memory_quota"
+	// AnalyzeFailureSchemaChanged means the table, the partition, the column or the index is changed during the
+	// analyze job.
+	AnalyzeFailureSchemaChanged = "schema_changed"
+	// AnalyzeFailureStorage means the analyze job fails to read the data from the storage.
+	AnalyzeFailureStorage = "storage"
+	// AnalyzeFailureRegion means the regions read by the analyze job are unavailable, e.g. they're being split,
+	// merged or their leaders are being transferred.
+	AnalyzeFailureRegion = "region"
+	// AnalyzeFailurePermission means the user executing the analyze job has no privilege to read the table or to
+	// write the stats.
+	AnalyzeFailurePermission = "permission"
+	// AnalyzeFailurePanic means the analyze worker panics.
+	AnalyzeFailurePanic = "panic"
+	// AnalyzeFailureInterrupted means the TiDB server executing the analyze job has shut down or restarted.
+	AnalyzeFailureInterrupted = "interrupted"
+	// AnalyzeFailureOther means the fail reason is not classified.
+	AnalyzeFailureOther = "other"
+)
+
+// ClassifyAnalyzeFailure returns the category of the fail reason of an analyze job.
+func ClassifyAnalyzeFailure(err error) string {
+	if errors.Cause(err) == context.Canceled {
+		return AnalyzeFailureKilled
+	}
+	if tErr, ok := errors.Cause(err).(*terror.Error); ok {
+		switch tErr.Code() {
+		case errno.ErrQueryInterrupted:
+			return AnalyzeFailureKilled
+		case errno.ErrMaxExecTimeExceeded:
+			return AnalyzeFailureTimeout
+		case errno.ErrMemoryExceedForQuery, errno.ErrMemoryExceedForInstance:
+			return AnalyzeFailureMemoryQuota
+		case errno.ErrBadDB, errno.ErrNoSuchTable, errno.ErrInfoSchemaChanged,
+			errno.ErrAnalyzeMissColumn, errno.ErrAnalyzeMissIndex:
+			return AnalyzeFailureSchemaChanged
+		case errno.ErrRegionUnavailable:
+			return AnalyzeFailureRegion
+		case errno.ErrDBaccessDenied, errno.ErrTableaccessDenied, errno.ErrColumnaccessDenied,
+			errno.ErrSpecificAccessDenied:
+			return AnalyzeFailurePermission
+		case errno.ErrPDServerTimeout, errno.ErrTiKVServerTimeout, errno.ErrTiKVServerBusy, errno.ErrResolveLockTimeout,
+			errno.ErrGCTooEarly, errno.ErrTiKVStoreLimit, errno.ErrTiKVStaleCommand,
+			errno.ErrTiKVMaxTimestampNotSynced, errno.ErrTiFlashServerTimeout, errno.ErrTiFlashServerBusy:
+			return AnalyzeFailureStorage
+		}
+	}
+	// The panics of the analyze workers are reported as plain errors.
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "memory quota exceeds"):
+		return AnalyzeFailureMemoryQuota
+	case strings.Contains(msg, "analyze worker panic"):
+		return AnalyzeFailurePanic
+	}
+	return AnalyzeFailureOther
+}
+
 // JobType is the type of the analyze job.
 type JobType int
 
@@ -61,6 +132,11 @@ type AnalyzeJob struct {
 
 	SampleRateReason string // why this sample-rate is chosen
 	Progress         AnalyzeProgress
+
+	// ScanDuration and BuildDuration are the time spent on scanning the data and building the stats. For the analyze
+	// building the stats along with the scan, the whole time is counted as ScanDuration.
+	ScanDuration  time.Duration
+	BuildDuration time.Duration
 }
 
 // AnalyzeProgress represents the process of one analyze job.
@@ -0,0 +1,49 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/util/dbterror"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyAnalyzeFailure(t *testing.T) {
+	tests := []struct {
+		err      error
+		category string
+	}{
+		{exeerrors.ErrQueryInterrupted, AnalyzeFailureKilled},
+		{errors.Trace(context.Canceled), AnalyzeFailureKilled},
+		{exeerrors.ErrMaxExecTimeExceeded.GenWithStackByArgs(), AnalyzeFailureTimeout},
+		{errors.Trace(exeerrors.ErrMemoryExceedForQuery.GenWithStackByArgs(123)), AnalyzeFailureMemoryQuota},
+		{errors.New("analyze panic due to memory quota exceeds, please try with smaller samplerate(refer to 110000/count)"), AnalyzeFailureMemoryQuota},
+		{plannererrors.ErrAnalyzeMissIndex.GenWithStackByArgs("idx", "t"), AnalyzeFailureSchemaChanged},
+		{errors.Trace(dbterror.ClassTiKV.NewStd(errno.ErrRegionUnavailable)), AnalyzeFailureRegion},
+		{dbterror.ClassTiKV.NewStd(errno.ErrTiKVServerBusy), AnalyzeFailureStorage},
+		{plannererrors.ErrTableaccessDenied.GenWithStackByArgs("SELECT", "u", "%", "t"), AnalyzeFailurePermission},
+		{errors.Trace(errors.New("analyze worker panic")), AnalyzeFailurePanic},
+		{errors.New("unknown error"), AnalyzeFailureOther},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.category, ClassifyAnalyzeFailure(tt.err), tt.err.Error())
+	}
+}
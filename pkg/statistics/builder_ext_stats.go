@@ -22,15 +22,18 @@ import (
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"go.uber.org/zap"
 )
 
 // BuildExtendedStats build extended stats for column groups if needed based on the column samples.
+// The sampled rows are used to build the expression stats, and they could be nil if they are not available.
 func BuildExtendedStats(sctx sessionctx.Context,
-	tableID int64, cols []*model.ColumnInfo, collectors []*SampleCollector) (*ExtendedStatsColl, error) {
-	const sql = "SELECT name, type, column_ids FROM mysql.stats_extended WHERE table_id = %? and status in (%?, %?)"
+	tableID int64, cols []*model.ColumnInfo, collectors []*SampleCollector, sampleRows []*ReservoirRowSampleItem) (*ExtendedStatsColl, error) {
+	const sql = "SELECT name, type, column_ids, stats FROM mysql.stats_extended WHERE table_id = %? and status in (%?, %?)"
 
 	sqlExec := sctx.GetRestrictedSQLExecutor()
 	rows, _, err := sqlExec.ExecRestrictedSQL(kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats), nil, sql, tableID, ExtendedStatsAnalyzed, ExtendedStatsInited)
@@ -50,7 +53,11 @@ func BuildExtendedStats(sctx sessionctx.Context,
 			logutil.BgLogger().Error("invalid column_ids in mysql.stats_extended, skip collecting extended stats for this row", zap.String("column_ids", colIDs), zap.Error(err))
 			continue
 		}
-		item = fillExtendedStatsItemVals(sctx, item, cols, collectors)
+		if item.Tp == ast.StatsTypeExpression {
+			item = fillExtStatsExprVals(sctx, item, row.GetString(3), cols, sampleRows)
+		} else {
+			item = fillExtendedStatsItemVals(sctx, item, cols, collectors)
+		}
 		if item != nil {
 			statsColl.Stats[name] = item
 		}
@@ -67,6 +74,83 @@ func fillExtendedStatsItemVals(sctx sessionctx.Context, item *ExtendedStatsItem,
 		return nil
 	case ast.StatsTypeCorrelation:
 		return fillExtStatsCorrVals(sctx, item, cols, collectors)
+	case ast.StatsTypeTrigram:
+		return fillExtStatsTrigramVals(item, cols, collectors)
+	case ast.StatsTypeJoinSketch:
+		return fillExtStatsJoinSketchVals(sctx, item, cols, collectors)
+	}
+	return nil
+}
+
+func fillExtStatsExprVals(sctx sessionctx.Context, item *ExtendedStatsItem, statsStr string, cols []*model.ColumnInfo, rows []*ReservoirRowSampleItem) *ExtendedStatsItem {
+	if rows == nil {
+		return nil
+	}
+	exprStats, err := DecodeExpressionStats(statsStr)
+	if err != nil {
+		logutil.BgLogger().Error("invalid stats in mysql.stats_extended, skip collecting expression stats for this row", zap.String("stats", statsStr), zap.Error(err))
+		return nil
+	}
+	item.ExprStats, err = BuildExpressionStats(sctx.GetExprCtx(), exprStats.Expr, cols, rows)
+	if err != nil {
+		logutil.BgLogger().Warn("build expression stats failed", zap.String("expr", exprStats.Expr), zap.Error(err))
+		return nil
+	}
+	item.StringVals, err = EncodeExpressionStats(item.ExprStats)
+	if err != nil {
+		logutil.BgLogger().Error("encode expression stats failed", zap.Error(err))
+		return nil
+	}
+	return item
+}
+
+func fillExtStatsTrigramVals(item *ExtendedStatsItem, cols []*model.ColumnInfo, collectors []*SampleCollector) *ExtendedStatsItem {
+	if len(item.ColIDs) != 1 {
+		return nil
+	}
+	for i, col := range cols {
+		if col.ID != item.ColIDs[0] {
+			continue
+		}
+		if col.FieldType.EvalType() != types.ETString || col.GetType() == mysql.TypeEnum || col.GetType() == mysql.TypeSet {
+			return nil
+		}
+		// The samples of the string columns are the collation keys, see (*AnalyzeColumnsExecV2).subBuildWorker.
+		item.Trigrams = BuildTrigramStats(collectors[i].Samples)
+		vals, err := EncodeTrigramStats(item.Trigrams)
+		if err != nil {
+			logutil.BgLogger().Error("encode trigram stats failed", zap.Error(err))
+			return nil
+		}
+		item.StringVals = vals
+		return item
+	}
+	return nil
+}
+
+func fillExtStatsJoinSketchVals(sctx sessionctx.Context, item *ExtendedStatsItem, cols []*model.ColumnInfo, collectors []*SampleCollector) *ExtendedStatsItem {
+	if len(item.ColIDs) != 1 {
+		return nil
+	}
+	for i, col := range cols {
+		if col.ID != item.ColIDs[0] {
+			continue
+		}
+		collector := collectors[i]
+		// The samples of the string columns are the collation keys, so only the columns of the same collation
+		// can be matched by the sketches.
+		sketch, err := BuildJoinSketch(sctx.GetSessionVars().StmtCtx.TimeZone(), collector.Samples, collector.Count, collector.NullCount)
+		if err != nil {
+			logutil.BgLogger().Warn("build join sketch failed", zap.Error(err))
+			return nil
+		}
+		item.JoinSketch = sketch
+		item.StringVals, err = EncodeJoinSketch(sketch)
+		if err != nil {
+			logutil.BgLogger().Error("encode join sketch failed", zap.Error(err))
+			return nil
+		}
+		return item
 	}
 	return nil
 }
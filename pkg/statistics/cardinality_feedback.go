@@ -0,0 +1,156 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+
+	"github.com/pingcap/tidb/pkg/util/kvcache"
+)
+
+const (
+	// MaxCardinalityFeedbackEntries is the max number of the (digest, table) pairs kept by the feedback collector.
+	// The least recently used pairs are evicted once the limit is reached, so the memory usage is bounded.
+	MaxCardinalityFeedbackEntries = 4096
+	// maxFeedbackSamples is the max number of the samples an entry remembers. When it's reached, the weight of the
+	// old samples is halved so that the entry can follow the change of the data.
+	maxFeedbackSamples = 100
+	// maxFeedbackLogStdDev is the max standard deviation of the natural logarithm of the actual/estimated ratio for
+	// an entry to be considered stable. ln(1.5) means the ratios of most executions are within 1.5x of each other.
+	maxFeedbackLogStdDev = 0.405
+	// minFeedbackLogRatio is the min absolute value of the natural logarithm of the ratio worth correcting.
+	minFeedbackLogRatio = 0.182 // ln(1.2)
+	// MaxCardinalityFeedbackFactor bounds the correction factor in both directions.
+	MaxCardinalityFeedbackFactor = 100.0
+)
+
+// CardinalityFeedbackKey identifies the estimation corrected by the feedback.
+type CardinalityFeedbackKey struct {
+	// SQLDigest is the normalized SQL digest of the statement.
+	SQLDigest string
+	// TableID is the logical table ID of the data source.
+	TableID int64
+}
+
+// Hash implements the kvcache.Key interface.
+func (k CardinalityFeedbackKey) Hash() []byte {
+	key := make([]byte, 0, 8+len(k.SQLDigest))
+	key = binary.BigEndian.AppendUint64(key, uint64(k.TableID))
+	return append(key, k.SQLDigest...)
+}
+
+// CardinalityFeedbackEntry aggregates the feedback of one (digest, table) pair.
+// The mean and the variance of ln(actual/estimated) are maintained by Welford's online algorithm.
+type CardinalityFeedbackEntry struct {
+	Samples int64
+	mean    float64
+	m2      float64
+}
+
+func (e *CardinalityFeedbackEntry) add(logRatio float64) {
+	if e.Samples >= maxFeedbackSamples {
+		e.Samples /= 2
+		e.m2 /= 2
+	}
+	e.Samples++
+	delta := logRatio - e.mean
+	e.mean += delta / float64(e.Samples)
+	e.m2 += delta * (logRatio - e.mean)
+}
+
+// StdDev returns the standard deviation of ln(actual/estimated).
+func (e *CardinalityFeedbackEntry) StdDev() float64 {
+	if e.Samples < 2 {
+		return 0
+	}
+	return math.Sqrt(e.m2 / float64(e.Samples-1))
+}
+
+// Factor returns the correction factor, i.e. the geometric mean of actual/estimated, bounded by
+// MaxCardinalityFeedbackFactor.
+func (e *CardinalityFeedbackEntry) Factor() float64 {
+	return math.Min(math.Max(math.Exp(e.mean), 1/MaxCardinalityFeedbackFactor), MaxCardinalityFeedbackFactor)
+}
+
+// IsStable returns whether the entry has enough samples and they agree with each other well enough to be applied.
+func (e *CardinalityFeedbackEntry) IsStable(minSamples int64) bool {
+	return e.Samples >= minSamples && e.StdDev() <= maxFeedbackLogStdDev && math.Abs(e.mean) >= minFeedbackLogRatio
+}
+
+// CardinalityFeedback collects the estimated and the actual row counts of the table readers reported by the
+// executors, and provides the per-digest correction factors for the estimator.
+// It only lives in the memory of the current TiDB instance.
+type CardinalityFeedback struct {
+	mu sync.Mutex
+	// entries maps the CardinalityFeedbackKey to the *CardinalityFeedbackEntry.
+	entries *kvcache.SimpleLRUCache
+}
+
+// NewCardinalityFeedback creates a CardinalityFeedback.
+func NewCardinalityFeedback() *CardinalityFeedback {
+	return &CardinalityFeedback{
+		entries: kvcache.NewSimpleLRUCache(MaxCardinalityFeedbackEntries, 0, 0),
+	}
+}
+
+// Record adds a sample of the estimated and the actual row counts. It returns true if the least recently used entry
+// is evicted because the collector is full.
+func (f *CardinalityFeedback) Record(key CardinalityFeedbackKey, estRows, actRows float64) (evicted bool) {
+	if estRows < 0 || actRows < 0 {
+		return false
+	}
+	// Add 1 to both sides to keep the ratio finite and to damp the noise of the tiny row counts.
+	logRatio := math.Log((actRows + 1) / (estRows + 1))
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var entry *CardinalityFeedbackEntry
+	if val, ok := f.entries.Get(key); ok {
+		entry = val.(*CardinalityFeedbackEntry)
+	} else {
+		evicted = f.entries.Size() >= MaxCardinalityFeedbackEntries
+		entry = &CardinalityFeedbackEntry{}
+		f.entries.Put(key, entry)
+	}
+	entry.add(logRatio)
+	return evicted
+}
+
+// CorrectionFactor returns the factor to multiply the estimated row count with. The ok is false if there is no
+// stable feedback for the key.
+func (f *CardinalityFeedback) CorrectionFactor(key CardinalityFeedbackKey, minSamples int64) (factor float64, ok bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	val, found := f.entries.Get(key)
+	if !found || !val.(*CardinalityFeedbackEntry).IsStable(minSamples) {
+		return 1, false
+	}
+	return val.(*CardinalityFeedbackEntry).Factor(), true
+}
+
+// Len returns the number of the entries.
+func (f *CardinalityFeedback) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.entries.Size()
+}
+
+// Reset drops all the collected feedback.
+func (f *CardinalityFeedback) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.entries.DeleteAll()
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardinalityFeedback(t *testing.T) {
+	f := NewCardinalityFeedback()
+	key := CardinalityFeedbackKey{SQLDigest: "d1", TableID: 1}
+
+	// Not enough samples.
+	for range 2 {
+		require.False(t, f.Record(key, 9, 99))
+	}
+	_, ok := f.CorrectionFactor(key, 3)
+	require.False(t, ok)
+	require.False(t, f.Record(key, 9, 99))
+	factor, ok := f.CorrectionFactor(key, 3)
+	require.True(t, ok)
+	require.InDelta(t, 10, factor, 1e-9)
+
+	// The unstable feedback is not applied.
+	unstable := CardinalityFeedbackKey{SQLDigest: "d2", TableID: 1}
+	for i := range 10 {
+		if i%2 == 0 {
+			f.Record(unstable, 9, 99)
+		} else {
+			f.Record(unstable, 99, 9)
+		}
+	}
+	_, ok = f.CorrectionFactor(unstable, 3)
+	require.False(t, ok)
+
+	// The accurate estimation doesn't need to be corrected.
+	accurate := CardinalityFeedbackKey{SQLDigest: "d3", TableID: 1}
+	for range 10 {
+		f.Record(accurate, 100, 105)
+	}
+	_, ok = f.CorrectionFactor(accurate, 3)
+	require.False(t, ok)
+
+	// The factor is bounded.
+	huge := CardinalityFeedbackKey{SQLDigest: "d4", TableID: 1}
+	for range 10 {
+		f.Record(huge, 0, 1e6)
+	}
+	factor, ok = f.CorrectionFactor(huge, 3)
+	require.True(t, ok)
+	require.Equal(t, MaxCardinalityFeedbackFactor, factor)
+
+	// The number of the entries is bounded, the least recently used ones are evicted for the new ones.
+	_, ok = f.CorrectionFactor(unstable, 3)
+	require.False(t, ok)
+	_, ok = f.CorrectionFactor(accurate, 3)
+	require.False(t, ok)
+	for i := f.Len(); i < MaxCardinalityFeedbackEntries; i++ {
+		require.False(t, f.Record(CardinalityFeedbackKey{SQLDigest: "fill", TableID: int64(i)}, 1, 1))
+	}
+	_, ok = f.CorrectionFactor(key, 3)
+	require.True(t, ok)
+	newKey := CardinalityFeedbackKey{SQLDigest: "new", TableID: 1}
+	require.True(t, f.Record(newKey, 9, 99))
+	for range 2 {
+		require.False(t, f.Record(newKey, 9, 99))
+	}
+	require.Equal(t, MaxCardinalityFeedbackEntries, f.Len())
+	_, ok = f.CorrectionFactor(newKey, 3)
+	require.True(t, ok)
+	_, ok = f.CorrectionFactor(key, 3)
+	require.True(t, ok)
+	_, ok = f.CorrectionFactor(huge, 3)
+	require.False(t, ok)
+
+	f.Reset()
+	require.Equal(t, 0, f.Len())
+	_, ok = f.CorrectionFactor(key, 3)
+	require.False(t, ok)
+}
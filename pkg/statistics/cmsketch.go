@@ -622,6 +622,18 @@ func (c *TopN) MinCount() uint64 {
 	return minCount
 }
 
+// MaxCount returns the maximum count in the TopN.
+func (c *TopN) MaxCount() uint64 {
+	if c == nil {
+		return 0
+	}
+	maxCount := uint64(0)
+	for _, t := range c.TopN {
+		maxCount = max(maxCount, t.Count)
+	}
+	return maxCount
+}
+
 // TopNMeta stores the unit of the TopN.
 type TopNMeta struct {
 	Encoded []byte
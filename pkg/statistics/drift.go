@@ -0,0 +1,103 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"bytes"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
+)
+
+const (
+	// MinDriftSampleRows is the min number of the sampled non-null values to check the drift of a column. The
+	// distance computed from fewer values is dominated by the sampling noise.
+	MinDriftSampleRows = 100
+	// DriftThreshold is the distance above which the distribution of a column is considered drifted from its stats.
+	DriftThreshold = 0.2
+)
+
+// ColumnDrift returns the Kolmogorov-Smirnov distance between the distribution of the sampled values of the column
+// and the one described by its stats, i.e. the max difference between their cumulative distribution functions, which
+// ranges from 0 (the same distribution) to 1 (disjoint ranges). It also returns the number of the non-null values used.
+// Only the fully loaded version 2 stats are checked, where the TopN values are excluded from the histogram, and ok is
+// false if the column can't be checked or there are fewer than MinDriftSampleRows non-null values.
+func ColumnDrift(loc *time.Location, col *Column, values []types.Datum) (distance float64, sampleRows int, ok bool) {
+	if col == nil || col.StatsVer != Version2 || !col.IsFullLoad() {
+		return 0, 0, false
+	}
+	total := col.Histogram.NotNullCount() + float64(col.TopN.TotalCount())
+	if total <= 0 {
+		return 0, 0, false
+	}
+	type point struct {
+		value   types.Datum
+		encoded []byte
+	}
+	points := make([]point, 0, len(values))
+	for _, v := range values {
+		if v.IsNull() {
+			continue
+		}
+		// The string values are compared by their collation keys, the same as the bounds of the histogram.
+		if v.Kind() == types.KindString {
+			v.SetBytes(collate.GetCollator(v.Collation()).Key(v.GetString()))
+		}
+		encoded, err := codec.EncodeKey(loc, nil, v)
+		if err != nil {
+			continue
+		}
+		points = append(points, point{value: v, encoded: encoded})
+	}
+	if len(points) < MinDriftSampleRows {
+		return 0, len(points), false
+	}
+	slices.SortFunc(points, func(a, b point) int {
+		return bytes.Compare(a.encoded, b.encoded)
+	})
+	var topN []TopNMeta
+	if col.TopN != nil {
+		topN = col.TopN.TopN
+	}
+	// topNLess[i] is the total count of the first i TopN values.
+	topNLess := make([]float64, len(topN)+1)
+	for i, meta := range topN {
+		topNLess[i+1] = topNLess[i] + float64(meta.Count)
+	}
+	n := float64(len(points))
+	for i := 0; i < len(points); {
+		j := i + 1
+		for j < len(points) && bytes.Equal(points[j].encoded, points[i].encoded) {
+			j++
+		}
+		idx, match := col.TopN.LowerBound(points[i].encoded)
+		statsLess := col.Histogram.LessRowCount(nil, points[i].value) + topNLess[idx]
+		var statsEqual float64
+		if match {
+			statsEqual = float64(topN[idx].Count)
+		} else {
+			statsEqual, _ = col.Histogram.EqualRowCount(nil, points[i].value, true)
+		}
+		// Compare the two functions right before and at the value, since both of them jump at it.
+		distance = math.Max(distance, math.Abs(float64(i)/n-statsLess/total))
+		distance = math.Max(distance, math.Abs(float64(j)/n-math.Min(statsLess+statsEqual, total)/total))
+		i = j
+	}
+	return distance, len(points), true
+}
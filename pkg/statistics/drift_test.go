@@ -0,0 +1,73 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnDrift(t *testing.T) {
+	// The values 0..99 are uniformly distributed in 10 buckets.
+	hist := NewHistogram(1, 100, 0, 0, types.NewFieldType(mysql.TypeLonglong), 10, 0)
+	for i := range 10 {
+		lower, upper := types.NewIntDatum(int64(i*10)), types.NewIntDatum(int64(i*10+9))
+		hist.AppendBucketWithNDV(&lower, &upper, int64((i+1)*10), 1, 10)
+	}
+	col := &Column{Histogram: *hist, StatsVer: Version2, StatsLoadedStatus: NewStatsFullLoadStatus()}
+	sample := func(from, to int) []types.Datum {
+		values := make([]types.Datum, 0, to-from)
+		for v := from; v < to; v++ {
+			values = append(values, types.NewIntDatum(int64(v)))
+		}
+		return values
+	}
+
+	// The same distribution.
+	distance, rows, ok := ColumnDrift(time.UTC, col, sample(0, 100))
+	require.True(t, ok)
+	require.Equal(t, 100, rows)
+	require.Less(t, distance, DriftThreshold)
+
+	// All the values are out of the range of the histogram.
+	distance, _, ok = ColumnDrift(time.UTC, col, sample(1000, 1100))
+	require.True(t, ok)
+	require.InDelta(t, 1.0, distance, 1e-9)
+
+	// Half of the values are concentrated in the first bucket.
+	values := append(sample(0, 50), sample(0, 10)...)
+	values = append(values, sample(0, 10)...)
+	values = append(values, sample(0, 10)...)
+	values = append(values, sample(0, 10)...)
+	values = append(values, sample(0, 10)...)
+	distance, _, ok = ColumnDrift(time.UTC, col, values)
+	require.True(t, ok)
+	require.Greater(t, distance, DriftThreshold)
+
+	// The nulls are ignored and too few values can't be checked.
+	values = append(sample(0, MinDriftSampleRows-1), types.Datum{})
+	_, rows, ok = ColumnDrift(time.UTC, col, values)
+	require.False(t, ok)
+	require.Equal(t, MinDriftSampleRows-1, rows)
+
+	// The version 1 stats aren't checked.
+	col.StatsVer = Version1
+	_, _, ok = ColumnDrift(time.UTC, col, sample(0, 100))
+	require.False(t, ok)
+}
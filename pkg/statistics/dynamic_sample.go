@@ -0,0 +1,65 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"slices"
+
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+// DynamicSample holds the rows sampled from a table without usable stats, e.g. a newly created or imported table,
+// when a query on it is compiled. The predicates of the query are estimated by evaluating them on the rows.
+// It's cached with the stats of the table in the stats cache, so the following queries don't sample again. The
+// sample is keyed by the stats meta of the table when it's taken, and is outdated once the DML on the table changes
+// the stats meta, see IsOutdated.
+type DynamicSample struct {
+	// ColIDs are the IDs of the sampled columns.
+	ColIDs []int64
+	// Rows are the sampled rows, whose datums are in the same order as ColIDs.
+	Rows [][]types.Datum
+	// Complete indicates all the rows of the table are sampled.
+	Complete bool
+	// Version and ModifyCount are the ones of the stats meta of the table when the rows are sampled.
+	Version     uint64
+	ModifyCount int64
+}
+
+// IsOutdated returns whether the table is modified after the rows are sampled according to its stats meta.
+func (s *DynamicSample) IsOutdated(version uint64, modifyCount int64) bool {
+	return s.Version != version || s.ModifyCount != modifyCount
+}
+
+// ColumnOffset returns the offset of the column in the sampled rows, or -1 if the column is not sampled.
+func (s *DynamicSample) ColumnOffset(colID int64) int {
+	return slices.Index(s.ColIDs, colID)
+}
+
+// RowCount returns the number of the sampled rows.
+func (s *DynamicSample) RowCount() int {
+	return len(s.Rows)
+}
+
+// MemoryUsage returns the approximate memory usage of the sample.
+func (s *DynamicSample) MemoryUsage() int64 {
+	if s == nil {
+		return 0
+	}
+	size := int64(len(s.ColIDs)) * 8
+	if len(s.Rows) > 0 {
+		size += types.EstimatedMemUsage(s.Rows[0], len(s.Rows))
+	}
+	return size
+}
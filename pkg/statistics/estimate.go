@@ -22,14 +22,22 @@ import (
 func calculateEstimateNDV(h *topNHelper, rowCount uint64) (ndv uint64, scaleRatio uint64) {
 	sampleSize, sampleNDV, onlyOnceItems := h.sampleSize, uint64(len(h.sorted)), h.onlyOnceItems
 	scaleRatio = rowCount / sampleSize
-
 	if onlyOnceItems == sampleSize {
 		// Assume this is a unique column, so do not scale up the count of elements
 		return rowCount, 1
+	}
+	return estimateNDVBySample(sampleSize, sampleNDV, onlyOnceItems, rowCount), scaleRatio
+}
+
+// estimateNDVBySample estimates the ndv of rowCount rows from a sample of sampleSize rows, which has sampleNDV
+// distinct values and onlyOnceItems values occurring only once.
+func estimateNDVBySample(sampleSize, sampleNDV, onlyOnceItems, rowCount uint64) uint64 {
+	if onlyOnceItems == sampleSize {
+		// Assume this is a unique column, so do not scale up the count of elements
+		return rowCount
 	} else if onlyOnceItems == 0 {
 		// Assume data only consists of sampled data
-		// Nothing to do, no change with scale ratio
-		return sampleNDV, scaleRatio
+		return sampleNDV
 	}
 	// Charikar, Moses, et al. "Towards estimation error guarantees for distinct values."
 	// Proceedings of the nineteenth ACM SIGMOD-SIGACT-SIGART symposium on Principles of database systems. ACM, 2000.
@@ -42,8 +50,8 @@ func calculateEstimateNDV(h *topNHelper, rowCount uint64) (ndv uint64, scaleRati
 	rowCountN := float64(rowCount)
 	d := float64(sampleNDV)
 
-	ndv = uint64(math.Sqrt(rowCountN/n)*f1 + d - f1 + 0.5)
+	ndv := uint64(math.Sqrt(rowCountN/n)*f1 + d - f1 + 0.5)
 	ndv = max(ndv, sampleNDV)
 	ndv = min(ndv, rowCount)
-	return ndv, scaleRatio
+	return ndv
 }
@@ -0,0 +1,159 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"encoding/json"
+	"unicode/utf8"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+// MaxExpressionSampleNum is the max number of the sampled values kept in the statistics of an expression.
+const MaxExpressionSampleNum = 2048
+
+// MaxExpressionValueLength is the max length of a sampled value kept in the statistics of an expression, the longer
+// values are truncated like the bounds of the histograms.
+const MaxExpressionValueLength = 256
+
+// MaxExpressionStatsSize is the max size of the encoded statistics of an expression, which is kept below the size of
+// the BLOB column `stats` of mysql.stats_extended.
+const MaxExpressionStatsSize = 60 * 1024
+
+// ExpressionStats is the statistics of an expression on the columns of a table, e.g. `DATE(created_at)`.
+// The expression is evaluated on the sampled rows during analyze, and the predicates on the expression, which
+// can't be converted to the ranges of the columns, are estimated by evaluating them on the sampled values.
+type ExpressionStats struct {
+	// Expr is the restored string of the expression, which only references the column names of the table.
+	Expr string `json:"expr"`
+	// Values are the string representations of the non-null values of the expression on the sampled rows.
+	Values []string `json:"values,omitempty"`
+	// SampleCount is the number of the sampled rows, including the ones on which the expression is evaluated to null.
+	SampleCount int64 `json:"sample_count"`
+	// NullCount is the number of the sampled rows on which the expression is evaluated to null.
+	NullCount int64 `json:"null_count"`
+}
+
+// NullRatio returns the ratio of the sampled rows on which the expression is evaluated to null.
+func (s *ExpressionStats) NullRatio() float64 {
+	if s.SampleCount <= 0 {
+		return 0
+	}
+	return float64(s.NullCount) / float64(s.SampleCount)
+}
+
+// EncodeExpressionStats encodes the expression statistics to the string persisted in mysql.stats_extended.
+// The sampled values are thinned out evenly until the encoded size is within MaxExpressionStatsSize.
+func EncodeExpressionStats(s *ExpressionStats) (string, error) {
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(bytes) <= MaxExpressionStatsSize {
+		return string(bytes), nil
+	}
+	thinned := *s
+	for len(bytes) > MaxExpressionStatsSize && len(thinned.Values) > 0 {
+		values := make([]string, 0, len(thinned.Values)/2)
+		for i := 0; i < len(thinned.Values); i += 2 {
+			values = append(values, thinned.Values[i])
+		}
+		if len(values) == len(thinned.Values) {
+			values = values[:0]
+		}
+		thinned.Values = values
+		bytes, err = json.Marshal(&thinned)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+	}
+	if len(bytes) > MaxExpressionStatsSize {
+		return "", errors.Errorf("the statistics of the expression %s is too large", s.Expr)
+	}
+	return string(bytes), nil
+}
+
+// truncateExpressionValue truncates the sampled value to MaxExpressionValueLength without breaking a utf8 character.
+func truncateExpressionValue(val string) string {
+	if len(val) <= MaxExpressionValueLength {
+		return val
+	}
+	end := MaxExpressionValueLength
+	for end > 0 && !utf8.RuneStart(val[end]) {
+		end--
+	}
+	return val[:end]
+}
+
+// DecodeExpressionStats decodes the expression statistics from the string persisted in mysql.stats_extended.
+func DecodeExpressionStats(str string) (*ExpressionStats, error) {
+	s := &ExpressionStats{}
+	if err := json.Unmarshal([]byte(str), s); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if s.Expr == "" {
+		return nil, errors.New("invalid expression stats, the expression is empty")
+	}
+	return s, nil
+}
+
+// BuildExpressionStats evaluates the expression on the sampled rows to build its statistics.
+// The columns in the sampled rows are in the same order as cols.
+func BuildExpressionStats(ctx expression.BuildContext, exprStr string, cols []*model.ColumnInfo, rows []*ReservoirRowSampleItem) (*ExpressionStats, error) {
+	// The columns of the built expression use the offsets of the columns in the sampled rows.
+	tblInfo := &model.TableInfo{Columns: make([]*model.ColumnInfo, 0, len(cols))}
+	for i, col := range cols {
+		col = col.Clone()
+		col.Offset = i
+		tblInfo.Columns = append(tblInfo.Columns, col)
+	}
+	expr, err := expression.ParseSimpleExpr(ctx, exprStr, expression.WithTableInfo("", tblInfo))
+	if err != nil {
+		return nil, err
+	}
+	evalCtx := ctx.GetEvalCtx()
+	stats := &ExpressionStats{Expr: exprStr, Values: make([]string, 0, min(len(rows), MaxExpressionSampleNum))}
+	// Keep the sampled values evenly when there are too many rows.
+	step := max(1, (len(rows)+MaxExpressionSampleNum-1)/MaxExpressionSampleNum)
+	for i, row := range rows {
+		d, err := expr.Eval(evalCtx, chunk.MutRowFromDatums(row.Columns).ToRow())
+		if err != nil {
+			return nil, err
+		}
+		stats.SampleCount++
+		if d.IsNull() {
+			stats.NullCount++
+			continue
+		}
+		if i%step != 0 {
+			continue
+		}
+		val, err := d.ToString()
+		if err != nil {
+			return nil, err
+		}
+		stats.Values = append(stats.Values, truncateExpressionValue(val))
+	}
+	return stats, nil
+}
+
+// ExpressionStatsInQuery is the expression statistics whose expression is built on the columns of a query.
+type ExpressionStatsInQuery struct {
+	Expr  expression.Expression
+	Stats *ExpressionStats
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeExpressionStatsSize(t *testing.T) {
+	require.Equal(t, "abc", truncateExpressionValue("abc"))
+	require.Len(t, truncateExpressionValue(strings.Repeat("a", 1000)), MaxExpressionValueLength)
+	// The truncation doesn't break a utf8 character.
+	truncated := truncateExpressionValue("ab" + strings.Repeat("中", 1000))
+	require.Len(t, truncated, MaxExpressionValueLength-2)
+	require.True(t, strings.HasSuffix(truncated, "中"))
+
+	stats := &ExpressionStats{Expr: "lower(`a`)", SampleCount: MaxExpressionSampleNum}
+	for range MaxExpressionSampleNum {
+		stats.Values = append(stats.Values, strings.Repeat("x", MaxExpressionValueLength))
+	}
+	str, err := EncodeExpressionStats(stats)
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(str), MaxExpressionStatsSize)
+	decoded, err := DecodeExpressionStats(str)
+	require.NoError(t, err)
+	require.Equal(t, stats.SampleCount, decoded.SampleCount)
+	require.Less(t, len(decoded.Values), len(stats.Values))
+	require.NotEmpty(t, decoded.Values)
+	// The original statistics are not changed.
+	require.Len(t, stats.Values, MaxExpressionSampleNum)
+}
@@ -0,0 +1,232 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/meta/model"
+)
+
+// FKFanout is the distribution of the fanouts of a foreign key, i.e. the numbers of the child rows referencing each
+// parent key, which is collected from the samples when the child table is analyzed. Only the single-column foreign
+// keys are collected.
+type FKFanout struct {
+	// FKID is the ID of the foreign key in the child table.
+	FKID int64
+	// ColID is the ID of the foreign key column in the child table.
+	ColID int64
+	// RowCount is the number of the child rows, including the null ones, when the fanout is collected.
+	RowCount float64
+	// NullCount is the number of the child rows whose foreign key is null, which reference no parent.
+	NullCount float64
+	// ParentKeys is the number of the parent keys referenced by at least one child row, i.e. the NDV of the foreign
+	// key column.
+	ParentKeys float64
+	// Avg, P50, P90, P99 and Max are the average, the percentiles and the max of the fanouts of the referenced
+	// parent keys.
+	Avg, P50, P90, P99, Max float64
+	// Version is the stats version when the fanout is collected.
+	Version uint64
+}
+
+// Coverage returns the fraction of the parent rows referenced by the child rows. parentRows is the row count of the
+// parent table.
+func (f *FKFanout) Coverage(parentRows float64) float64 {
+	if parentRows <= 0 {
+		return 1
+	}
+	return min(f.ParentKeys/parentRows, 1)
+}
+
+// TypicalFanout returns the average fanout of the parent keys typically picked when n referenced parent keys are picked
+// randomly. The fanouts above the 1-1/(2n) quantile are unlikely to be picked by any of them, so they're trimmed from
+// the average, i.e. the estimation of a few parent keys follows the typical fanouts rather than the few heavy ones
+// when the fanouts are skewed, and a single parent key gets the median. The fanouts are approximated by a step
+// function between the percentiles, whose tail above P99 takes the rest of the average. The whole average is returned
+// once all the referenced parent keys are picked.
+func (f *FKFanout) TypicalFanout(n float64) float64 {
+	if n >= f.ParentKeys || f.Max <= 0 {
+		return f.Avg
+	}
+	head := 0.5*f.P50 + 0.4*(f.P50+f.P90)/2 + 0.09*(f.P90+f.P99)/2
+	steps := []struct{ quantile, fanout float64 }{
+		{0.5, f.P50},
+		{0.9, (f.P50 + f.P90) / 2},
+		{0.99, (f.P90 + f.P99) / 2},
+		{1, min(max((f.Avg-head)/0.01, f.P99), f.Max)},
+	}
+	limit := max(1-1/(2*max(n, 1)), 0.5)
+	sum, prev := 0.0, 0.0
+	for _, step := range steps {
+		if prev >= limit {
+			break
+		}
+		sum += step.fanout * (min(step.quantile, limit) - prev)
+		prev = step.quantile
+	}
+	return min(sum/limit, f.Avg)
+}
+
+// BuildFKFanouts builds the fanouts of the single-column foreign keys of the table from the sample collectors of the
+// columns and the sampled rows. The samples of the collectors are reordered in place when building the TopN, so the
+// values are read from the sampled rows instead. The foreign keys whose columns aren't analyzed are skipped.
+func BuildFKFanouts(loc *time.Location, tblInfo *model.TableInfo, cols []*model.ColumnInfo, collectors []*SampleCollector, sampleRows []*ReservoirRowSampleItem) ([]*FKFanout, error) {
+	if tblInfo == nil || len(tblInfo.ForeignKeys) == 0 {
+		return nil, nil
+	}
+	fanouts := make([]*FKFanout, 0, len(tblInfo.ForeignKeys))
+	for _, fk := range tblInfo.ForeignKeys {
+		if fk.State != model.StatePublic || len(fk.Cols) != 1 {
+			continue
+		}
+		for i, col := range cols {
+			if col.Name.L != fk.Cols[0].L || i >= len(collectors) || collectors[i] == nil {
+				continue
+			}
+			fanout, err := buildFKFanout(loc, collectors[i], sampleRows, i)
+			if err != nil {
+				return nil, err
+			}
+			fanout.FKID, fanout.ColID = fk.ID, col.ID
+			fanouts = append(fanouts, fanout)
+			break
+		}
+	}
+	return fanouts, nil
+}
+
+// buildFKFanout builds the fanout distribution from the sampled values of the foreign key column, which is the offset-th
+// column of the sampled rows. The keys sampled more than
+// once are regarded as the frequent ones, whose fanouts are scaled from the sampled counts. The other keys, including
+// the ones missed by the samples, share the remained rows evenly, which is the same assumption as the histograms
+// make for the values not in the TopN.
+func buildFKFanout(loc *time.Location, collector *SampleCollector, sampleRows []*ReservoirRowSampleItem, offset int) (*FKFanout, error) {
+	nonNullCount := float64(collector.Count)
+	fanout := &FKFanout{
+		RowCount:  nonNullCount + float64(collector.NullCount),
+		NullCount: float64(collector.NullCount),
+	}
+	counts := make(map[uint64]float64)
+	var (
+		sampleCount float64
+		buf         []byte
+	)
+	for _, row := range sampleRows {
+		if offset >= len(row.Columns) || row.Columns[offset].IsNull() {
+			continue
+		}
+		var (
+			h   uint64
+			err error
+		)
+		h, buf, err = hashJoinKey(loc, row.Columns[offset], buf)
+		if err != nil {
+			return nil, err
+		}
+		counts[h]++
+		sampleCount++
+	}
+	if sampleCount == 0 || nonNullCount <= 0 {
+		return fanout, nil
+	}
+	ndv := float64(len(counts))
+	if collector.FMSketch != nil {
+		ndv = max(ndv, float64(collector.FMSketch.NDV()))
+	}
+	fanout.ParentKeys = ndv
+	fanout.Avg = nonNullCount / ndv
+
+	scale := max(nonNullCount, sampleCount) / sampleCount
+	type group struct {
+		fanout float64
+		keys   float64
+	}
+	groups := make([]group, 0, len(counts)+1)
+	frequentRows, frequentKeys := 0.0, 0.0
+	for _, cnt := range counts {
+		if cnt <= 1 {
+			continue
+		}
+		groups = append(groups, group{fanout: cnt * scale, keys: 1})
+		frequentRows += cnt * scale
+		frequentKeys++
+	}
+	if rareKeys := ndv - frequentKeys; rareKeys > 0 {
+		groups = append(groups, group{fanout: max((nonNullCount-frequentRows)/rareKeys, 1), keys: rareKeys})
+	}
+	slices.SortFunc(groups, func(a, b group) int {
+		return cmp.Compare(a.fanout, b.fanout)
+	})
+	percentile := func(p float64) float64 {
+		target, seen := p*ndv, 0.0
+		for _, g := range groups {
+			seen += g.keys
+			if seen >= target {
+				return g.fanout
+			}
+		}
+		return groups[len(groups)-1].fanout
+	}
+	fanout.P50, fanout.P90, fanout.P99 = percentile(0.5), percentile(0.9), percentile(0.99)
+	fanout.Max = groups[len(groups)-1].fanout
+	return fanout, nil
+}
+
+// FKJoinFanout is the fanout of a foreign key together with its parent, which is used to estimate the equal join
+// between the child and the parent tables on the foreign key.
+type FKJoinFanout struct {
+	*FKFanout
+	// ParentID is the physical ID of the parent table.
+	ParentID int64
+	// ParentColID is the ID of the referenced column in the parent table.
+	ParentColID int64
+}
+
+// FKFanoutsByUniqueID collects the fanouts of the foreign keys of the table, keyed by the UniqueID of the foreign key
+// column. resolveParent returns the physical ID of the parent table and the ID of the referenced column of a foreign
+// key, and ok is false if the parent can't be resolved.
+func (t *Table) FKFanoutsByUniqueID(
+	tblInfo *model.TableInfo,
+	cols []*expression.Column,
+	resolveParent func(fk *model.FKInfo) (parentID, parentColID int64, ok bool),
+) map[int64]*FKJoinFanout {
+	if len(t.FKFanouts) == 0 || tblInfo == nil {
+		return nil
+	}
+	var res map[int64]*FKJoinFanout
+	for _, fk := range tblInfo.ForeignKeys {
+		fanout, ok := t.FKFanouts[fk.ID]
+		if !ok || fk.State != model.StatePublic || len(fk.Cols) != 1 || fanout.ParentKeys <= 0 {
+			continue
+		}
+		idx := slices.IndexFunc(cols, func(col *expression.Column) bool { return col.ID == fanout.ColID })
+		if idx < 0 {
+			continue
+		}
+		parentID, parentColID, ok := resolveParent(fk)
+		if !ok {
+			continue
+		}
+		if res == nil {
+			res = make(map[int64]*FKJoinFanout)
+		}
+		res[cols[idx].UniqueID] = &FKJoinFanout{FKFanout: fanout, ParentID: parentID, ParentColID: parentColID}
+	}
+	return res
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildFKFanouts(t *testing.T) {
+	// The key 1 is sampled 50 times and the keys 2~11 are sampled once, and the samples are the half of the rows.
+	sampleRows := make([]*ReservoirRowSampleItem, 0, 61)
+	newRow := func(b types.Datum) *ReservoirRowSampleItem {
+		return &ReservoirRowSampleItem{Columns: []types.Datum{types.NewIntDatum(0), b}}
+	}
+	for range 50 {
+		sampleRows = append(sampleRows, newRow(types.NewIntDatum(1)))
+	}
+	for i := 2; i <= 11; i++ {
+		sampleRows = append(sampleRows, newRow(types.NewIntDatum(int64(i))))
+	}
+	sampleRows = append(sampleRows, newRow(types.NewDatum(nil)))
+	collector := &SampleCollector{Count: 120, NullCount: 10}
+
+	cols := []*model.ColumnInfo{
+		{ID: 1, Name: ast.NewCIStr("a")},
+		{ID: 2, Name: ast.NewCIStr("b")},
+	}
+	tblInfo := &model.TableInfo{
+		Columns: cols,
+		ForeignKeys: []*model.FKInfo{
+			{ID: 1, Cols: []ast.CIStr{ast.NewCIStr("b")}, State: model.StatePublic},
+			// The multi-column foreign keys are skipped.
+			{ID: 2, Cols: []ast.CIStr{ast.NewCIStr("a"), ast.NewCIStr("b")}, State: model.StatePublic},
+		},
+	}
+	fanouts, err := BuildFKFanouts(time.UTC, tblInfo, cols, []*SampleCollector{nil, collector}, sampleRows)
+	require.NoError(t, err)
+	require.Len(t, fanouts, 1)
+	f := fanouts[0]
+	require.Equal(t, int64(1), f.FKID)
+	require.Equal(t, int64(2), f.ColID)
+	require.Equal(t, float64(130), f.RowCount)
+	require.Equal(t, float64(10), f.NullCount)
+	require.Equal(t, float64(11), f.ParentKeys)
+	require.Equal(t, float64(120)/11, f.Avg)
+	// The key 1 has 100 rows, and the other 10 keys share the remaining 20 rows.
+	require.Equal(t, float64(2), f.P50)
+	require.Equal(t, float64(2), f.P90)
+	require.Equal(t, float64(100), f.P99)
+	require.Equal(t, float64(100), f.Max)
+	require.Equal(t, float64(11)/22, f.Coverage(22))
+	require.Equal(t, float64(1), f.Coverage(5))
+	// A few parent keys are unlikely to pick the heavy key, while all of them get the average.
+	require.Equal(t, float64(2), f.TypicalFanout(1))
+	require.Equal(t, float64(2), f.TypicalFanout(5))
+	require.InDelta(t, (0.5*2+0.4*2+0.05*51)/0.95, f.TypicalFanout(10), 1e-9)
+	require.Equal(t, f.Avg, f.TypicalFanout(11))
+
+	// The foreign keys whose columns aren't analyzed are skipped.
+	fanouts, err = BuildFKFanouts(time.UTC, tblInfo, cols[:1], []*SampleCollector{nil}, sampleRows)
+	require.NoError(t, err)
+	require.Empty(t, fanouts)
+}
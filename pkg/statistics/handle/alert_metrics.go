@@ -0,0 +1,169 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handle
+
+import (
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	infoschemacontext "github.com/pingcap/tidb/pkg/infoschema/context"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/metrics"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/statistics/handle/syncload"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/types"
+	pkgutil "github.com/pingcap/tidb/pkg/util"
+)
+
+const (
+	// hotTableWindow is the window in which a table is considered hot if its columns are used by the queries.
+	hotTableWindow = time.Hour
+
+	selectHotTablesSQL = "SELECT DISTINCT table_id FROM mysql.column_stats_usage WHERE last_used_at >= %?"
+	// selectOldestFailedAutoAnalyzeSQL selects the oldest auto analyze failure after the last success of the auto
+	// analyze of each table or partition.
+	selectOldestFailedAutoAnalyzeSQL = `SELECT MIN(j.end_time) FROM mysql.analyze_jobs j
+		WHERE j.state = 'failed' AND j.job_info LIKE 'auto analyze%%'
+			AND j.end_time > IFNULL((SELECT MAX(s.end_time) FROM mysql.analyze_jobs s
+				WHERE s.state = 'finished' AND s.job_info LIKE 'auto analyze%%' AND s.table_schema = j.table_schema
+					AND s.table_name = j.table_name AND s.partition_name = j.partition_name), '1970-01-01')`
+)
+
+// UpdateStatsAlertMetrics updates the metrics designed for alerting on the degradation of the stats. The unhealthy
+// hot tables and the oldest failed auto analyze are read from the system tables and only reported by the stats
+// owner, so that they're not duplicated across the instances. The sync load timeout ratio and the global stats
+// merge backlog are reported by every instance.
+// Note: It's not thread-safe and is only called by the stats worker of the domain.
+func (h *Handle) UpdateStatsAlertMetrics(is infoschema.InfoSchema, isOwner bool) error {
+	total, timeouts := syncload.SyncLoadCounts()
+	var timeoutRatio float64
+	if total > h.lastSyncLoads {
+		timeoutRatio = float64(timeouts-h.lastSyncLoadTimeouts) / float64(total-h.lastSyncLoads)
+	}
+	h.lastSyncLoads, h.lastSyncLoadTimeouts = total, timeouts
+	metrics.SyncLoadTimeoutRatioGauge.Set(timeoutRatio)
+
+	return util.CallWithSCtx(h.SPool(), func(sctx sessionctx.Context) error {
+		if variable.PartitionPruneMode(sctx.GetSessionVars().PartitionPruneMode.Load()) == variable.Dynamic {
+			metrics.GlobalStatsMergeBacklogGauge.Set(float64(h.globalStatsMergeBacklog(is)))
+		} else {
+			metrics.GlobalStatsMergeBacklogGauge.Set(0)
+		}
+		if !isOwner {
+			metrics.UnhealthyHotTablesGauge.Set(0)
+			metrics.OldestFailedAutoAnalyzeGauge.Set(0)
+			return nil
+		}
+		unhealthy, err := h.unhealthyHotTables(sctx, is)
+		if err != nil {
+			return err
+		}
+		metrics.UnhealthyHotTablesGauge.Set(float64(unhealthy))
+		age, err := oldestFailedAutoAnalyzeAge(sctx)
+		if err != nil {
+			return err
+		}
+		metrics.OldestFailedAutoAnalyzeGauge.Set(age.Seconds())
+		return nil
+	})
+}
+
+// unhealthyHotTables returns the number of the tables used by the queries in the last hotTableWindow whose stats
+// healthy, or the healthy of any of their partitions, is below tidb_stats_alert_healthy_threshold.
+func (h *Handle) unhealthyHotTables(sctx sessionctx.Context, is infoschema.InfoSchema) (int, error) {
+	since := time.Now().Add(-hotTableWindow).Format(types.TimeFormat)
+	rows, _, err := util.ExecRows(sctx, selectHotTablesSQL, since)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	threshold := vardef.StatsAlertHealthyThreshold.Load()
+	unhealthy := 0
+	for _, row := range rows {
+		tbl, ok := h.TableInfoByID(is, row.GetInt64(0))
+		if !ok {
+			continue
+		}
+		physicalIDs := []int64{tbl.Meta().ID}
+		if pi := tbl.Meta().GetPartitionInfo(); pi != nil {
+			for _, def := range pi.Definitions {
+				physicalIDs = append(physicalIDs, def.ID)
+			}
+		}
+		for _, id := range physicalIDs {
+			statsTbl, ok := h.Get(id)
+			if !ok || !statsTbl.IsEligibleForAnalysis() {
+				continue
+			}
+			if healthy, ok := statsTbl.GetStatsHealthy(); ok && healthy < threshold {
+				unhealthy++
+				break
+			}
+		}
+	}
+	return unhealthy, nil
+}
+
+// globalStatsMergeBacklog returns the number of the partitioned tables whose global stats are missing or older than
+// the latest analyzed partition, i.e. the partition stats are not merged into the global stats yet.
+func (h *Handle) globalStatsMergeBacklog(is infoschema.InfoSchema) int {
+	backlog := 0
+	for _, res := range is.ListTablesWithSpecialAttribute(infoschemacontext.PartitionAttribute) {
+		if pkgutil.IsMemOrSysDB(res.DBName.L) {
+			continue
+		}
+		for _, tblInfo := range res.TableInfos {
+			if h.globalStatsMergePending(tblInfo) {
+				backlog++
+			}
+		}
+	}
+	return backlog
+}
+
+// globalStatsMergePending returns whether the global stats of the partitioned table are behind its partitions.
+func (h *Handle) globalStatsMergePending(tblInfo *model.TableInfo) bool {
+	var latestPartitionVersion uint64
+	for _, def := range tblInfo.GetPartitionInfo().Definitions {
+		if partitionStats, ok := h.Get(def.ID); ok && partitionStats.IsAnalyzed() {
+			latestPartitionVersion = max(latestPartitionVersion, partitionStats.LastAnalyzeVersion)
+		}
+	}
+	if latestPartitionVersion == 0 {
+		return false
+	}
+	globalStats, ok := h.Get(tblInfo.ID)
+	return !ok || !globalStats.IsAnalyzed() || globalStats.LastAnalyzeVersion < latestPartitionVersion
+}
+
+// oldestFailedAutoAnalyzeAge returns the age of the oldest auto analyze failure not followed by a success, it's 0 if
+// there is no such failure.
+func oldestFailedAutoAnalyzeAge(sctx sessionctx.Context) (time.Duration, error) {
+	rows, _, err := util.ExecRows(sctx, selectOldestFailedAutoAnalyzeSQL)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if len(rows) == 0 || rows[0].IsNull(0) {
+		return 0, nil
+	}
+	oldest, err := rows[0].GetTime(0).GoTime(time.Local)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return max(time.Since(oldest), 0), nil
+}
@@ -21,6 +21,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -60,6 +61,10 @@ type statsAnalyze struct {
 	// refresher is used to refresh the analyze job queue and analyze the highest priority tables.
 	// It is only used when auto-analyze priority queue is enabled.
 	refresher *refresher.Refresher
+	// missingGlobalStatsWg tracks the background job building the missing global stats.
+	missingGlobalStatsWg util.WaitGroupWrapper
+	// buildingMissingGlobalStats indicates whether the missing global stats are being built in the background.
+	buildingMissingGlobalStats atomic.Bool
 }
 
 // NewStatsAnalyze creates a new StatsAnalyze.
@@ -86,7 +91,7 @@ func (sa *statsAnalyze) InsertAnalyzeJob(job *statistics.AnalyzeJob, instance st
 }
 
 func (sa *statsAnalyze) StartAnalyzeJob(job *statistics.AnalyzeJob) {
-	err := statsutil.CallWithSCtx(sa.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+	err := statsutil.CallWithSCtxAndLabels(statsutil.StatsCtx, sa.statsHandle.SPool(), statsutil.StatsOperationAnalyze, 0, func(sctx sessionctx.Context) error {
 		startAnalyzeJob(sctx, job)
 		return nil
 	})
@@ -96,7 +101,7 @@ func (sa *statsAnalyze) StartAnalyzeJob(job *statistics.AnalyzeJob) {
 }
 
 func (sa *statsAnalyze) UpdateAnalyzeJobProgress(job *statistics.AnalyzeJob, rowCount int64) {
-	err := statsutil.CallWithSCtx(sa.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+	err := statsutil.CallWithSCtxAndLabels(statsutil.StatsCtx, sa.statsHandle.SPool(), statsutil.StatsOperationAnalyze, 0, func(sctx sessionctx.Context) error {
 		updateAnalyzeJobProgress(sctx, job, rowCount)
 		return nil
 	})
@@ -106,7 +111,7 @@ func (sa *statsAnalyze) UpdateAnalyzeJobProgress(job *statistics.AnalyzeJob, row
 }
 
 func (sa *statsAnalyze) FinishAnalyzeJob(job *statistics.AnalyzeJob, failReason error, analyzeType statistics.JobType) {
-	err := statsutil.CallWithSCtx(sa.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+	err := statsutil.CallWithSCtxAndLabels(statsutil.StatsCtx, sa.statsHandle.SPool(), statsutil.StatsOperationAnalyze, 0, func(sctx sessionctx.Context) error {
 		finishAnalyzeJob(sctx, job, failReason, analyzeType)
 		return nil
 	})
@@ -169,6 +174,7 @@ const SelectAnalyzeJobsSQL = `SELECT id, instance
 const BatchUpdateAnalyzeJobSQL = `UPDATE mysql.analyze_jobs
             SET state = 'failed',
             fail_reason = 'The TiDB Server has either shut down or the analyze query was terminated during the analyze job execution',
+            fail_category = 'interrupted',
             process_id = NULL
             WHERE id IN (%?)`
 
@@ -293,7 +299,7 @@ func CleanupCorruptedAnalyzeJobsOnDeadInstances(
 // HandleAutoAnalyze analyzes the outdated tables. (The change percent of the table exceeds the threshold)
 // It also analyzes newly created tables and newly added indexes.
 func (sa *statsAnalyze) HandleAutoAnalyze() (analyzed bool) {
-	if err := statsutil.CallWithSCtx(sa.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+	if err := statsutil.CallWithSCtxAndLabels(statsutil.StatsCtx, sa.statsHandle.SPool(), statsutil.StatsOperationAnalyze, 0, func(sctx sessionctx.Context) error {
 		analyzed = sa.handleAutoAnalyze(sctx)
 		return nil
 	}); err != nil {
@@ -333,6 +339,7 @@ func (sa *statsAnalyze) handleAutoAnalyze(sctx sessionctx.Context) bool {
 			)
 		}
 	}()
+	sa.buildMissingGlobalStatsOnDynamicPruneMode(sctx)
 	if vardef.EnableAutoAnalyzePriorityQueue.Load() {
 		// During the test, we need to fetch all DML changes before analyzing the highest priority tables.
 		if intest.InTest {
@@ -367,9 +374,100 @@ func (sa *statsAnalyze) handleAutoAnalyze(sctx sessionctx.Context) bool {
 	)
 }
 
+const (
+	// lastSeenPruneModeVarName is the row of mysql.tidb keeping the partition prune mode last seen by the auto-analyze
+	// worker, which detects the mode switched to dynamic across the owner changes.
+	lastSeenPruneModeVarName = "tidb_stats_last_seen_partition_prune_mode"
+	// missingGlobalStatsJobVarName is the row of mysql.tidb marking the pending job building the missing global stats,
+	// which is resumed by the new owner if the old one quits before finishing it.
+	missingGlobalStatsJobVarName = "tidb_stats_missing_global_stats_job"
+)
+
+// buildMissingGlobalStatsOnDynamicPruneMode builds the missing global stats of the partitioned tables once the
+// partition prune mode is switched to dynamic, see tidb_build_global_stats_on_dynamic_prune_mode for more details.
+// The auto-analyze worker of the owner persists the job once it sees the switch, and runs it in the background, so
+// neither the statement switching the mode nor the auto-analyze waits for it.
+func (sa *statsAnalyze) buildMissingGlobalStatsOnDynamicPruneMode(sctx sessionctx.Context) {
+	if sa.buildingMissingGlobalStats.Load() {
+		return
+	}
+	pending, err := checkMissingGlobalStatsJob(sctx)
+	if err != nil {
+		statslogutil.StatsLogger().Warn("Failed to check the job building the missing global stats", zap.Error(err))
+		return
+	}
+	if !pending {
+		return
+	}
+	if _, _, ok := checkAutoAnalyzeWindow(exec.GetAutoAnalyzeParameters(sctx)); !ok {
+		// Try it again in the next round.
+		return
+	}
+	if !sa.buildingMissingGlobalStats.CompareAndSwap(false, true) {
+		return
+	}
+	is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+	sa.missingGlobalStatsWg.Run(func() {
+		defer sa.buildingMissingGlobalStats.Store(false)
+		if err := sa.statsHandle.BuildMissingGlobalStats(is); err != nil {
+			statslogutil.StatsLogger().Warn("Failed to build the missing global stats", zap.Error(err))
+			return
+		}
+		err := statsutil.CallWithSCtx(sa.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+			_, _, err := statsutil.ExecRows(sctx, "delete from mysql.tidb where variable_name = %?", missingGlobalStatsJobVarName)
+			return err
+		})
+		if err != nil {
+			statslogutil.StatsLogger().Warn("Failed to finish the job building the missing global stats", zap.Error(err))
+		}
+	})
+}
+
+// checkMissingGlobalStatsJob persists the current partition prune mode as the last seen one, and the job building the
+// missing global stats if the mode is switched to dynamic. It returns whether there is a pending job. The pending job
+// is dropped if the mode is switched back to static or the feature is disabled before it starts.
+func checkMissingGlobalStatsJob(sctx sessionctx.Context) (pending bool, err error) {
+	currentPruneMode := variable.PartitionPruneMode(sctx.GetSessionVars().PartitionPruneMode.Load())
+	rows, _, err := statsutil.ExecRows(sctx, "select variable_name, variable_value from mysql.tidb where variable_name in (%?, %?)",
+		lastSeenPruneModeVarName, missingGlobalStatsJobVarName)
+	if err != nil {
+		return false, err
+	}
+	var lastSeenPruneMode variable.PartitionPruneMode
+	for _, row := range rows {
+		switch row.GetString(0) {
+		case lastSeenPruneModeVarName:
+			lastSeenPruneMode = variable.PartitionPruneMode(row.GetString(1))
+		case missingGlobalStatsJobVarName:
+			pending = true
+		}
+	}
+	if currentPruneMode != lastSeenPruneMode {
+		if _, _, err = statsutil.ExecRows(sctx,
+			"insert into mysql.tidb (variable_name, variable_value, comment) values (%?, %?, %?) on duplicate key update variable_value = %?",
+			lastSeenPruneModeVarName, string(currentPruneMode), "The partition prune mode last seen by the auto analyze.", string(currentPruneMode)); err != nil {
+			return false, err
+		}
+	}
+	enabled := vardef.BuildGlobalStatsOnDynamicPruneMode.Load() && currentPruneMode == variable.Dynamic
+	switch {
+	case pending && !enabled:
+		_, _, err = statsutil.ExecRows(sctx, "delete from mysql.tidb where variable_name = %?", missingGlobalStatsJobVarName)
+		return false, err
+	// The switch is unknown if the mode has never been seen, e.g. just after upgrading.
+	case !pending && enabled && lastSeenPruneMode != "" && lastSeenPruneMode != variable.Dynamic:
+		_, _, err = statsutil.ExecRows(sctx,
+			"insert into mysql.tidb (variable_name, variable_value, comment) values (%?, %?, %?) on duplicate key update variable_value = %?",
+			missingGlobalStatsJobVarName, "pending", "The pending job building the missing global stats.", "pending")
+		return err == nil, err
+	}
+	return pending, nil
+}
+
 // Close closes the auto-analyze worker.
 func (sa *statsAnalyze) Close() {
 	sa.refresher.Close()
+	sa.missingGlobalStatsWg.Wait()
 }
 
 // CheckAutoAnalyzeWindow determine the time window for auto-analysis and verify if the current time falls within this range.
@@ -858,13 +956,14 @@ func finishAnalyzeJob(sctx sessionctx.Context, job *statistics.AnalyzeJob, analy
 		if len(failReason) > textMaxLength {
 			failReason = failReason[:textMaxLength]
 		}
+		failCategory := statistics.ClassifyAnalyzeFailure(analyzeErr)
 
 		if analyzeType == statistics.TableAnalysisJob {
-			sql = "UPDATE mysql.analyze_jobs SET processed_rows = processed_rows + %?, end_time = CONVERT_TZ(%?, '+00:00', @@TIME_ZONE), state = %?, fail_reason = %?, process_id = NULL WHERE id = %?"
-			args = []any{job.Progress.GetDeltaCount(), job.EndTime.UTC().Format(types.TimeFormat), statistics.AnalyzeFailed, failReason, *job.ID}
+			sql = "UPDATE mysql.analyze_jobs SET processed_rows = processed_rows + %?, end_time = CONVERT_TZ(%?, '+00:00', @@TIME_ZONE), state = %?, fail_reason = %?, fail_category = %?, process_id = NULL WHERE id = %?"
+			args = []any{job.Progress.GetDeltaCount(), job.EndTime.UTC().Format(types.TimeFormat), statistics.AnalyzeFailed, failReason, failCategory, *job.ID}
 		} else {
-			sql = "UPDATE mysql.analyze_jobs SET end_time = CONVERT_TZ(%?, '+00:00', @@TIME_ZONE), state = %?, fail_reason = %?, process_id = NULL WHERE id = %?"
-			args = []any{job.EndTime.UTC().Format(types.TimeFormat), statistics.AnalyzeFailed, failReason, *job.ID}
+			sql = "UPDATE mysql.analyze_jobs SET end_time = CONVERT_TZ(%?, '+00:00', @@TIME_ZONE), state = %?, fail_reason = %?, fail_category = %?, process_id = NULL WHERE id = %?"
+			args = []any{job.EndTime.UTC().Format(types.TimeFormat), statistics.AnalyzeFailed, failReason, failCategory, *job.ID}
 		}
 	} else {
 		if analyzeType == statistics.TableAnalysisJob {
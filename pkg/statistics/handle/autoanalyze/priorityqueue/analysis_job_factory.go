@@ -15,6 +15,9 @@
 package priorityqueue
 
 import (
+	"cmp"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/pingcap/tidb/pkg/meta/model"
@@ -86,6 +89,29 @@ func (f *AnalysisJobFactory) CreateNonPartitionedTableAnalysisJob(
 	)
 }
 
+// CreateDriftedTableAnalysisJob creates a job for a non-partitioned table whose data distribution has drifted from
+// its stats. Unlike CreateNonPartitionedTableAnalysisJob, the job is created even if the table isn't modified enough.
+func (f *AnalysisJobFactory) CreateDriftedTableAnalysisJob(
+	tblInfo *model.TableInfo,
+	tblStats *statistics.Table,
+) AnalysisJob {
+	if job := f.CreateNonPartitionedTableAnalysisJob(tblInfo, tblStats); job != nil || tblStats == nil || !tblStats.IsEligibleForAnalysis() {
+		return job
+	}
+
+	tableStatsVer := f.sctx.GetSessionVars().AnalyzeVersion
+	statistics.CheckAnalyzeVerOnTable(tblStats, &tableStatsVer)
+
+	return NewNonPartitionedTableAnalysisJob(
+		tblInfo.ID,
+		nil,
+		tableStatsVer,
+		0,
+		f.CalculateTableSize(tblStats),
+		f.GetTableLastAnalyzeDuration(tblStats),
+	)
+}
+
 // CreateStaticPartitionAnalysisJob creates a job for static partitions.
 func (f *AnalysisJobFactory) CreateStaticPartitionAnalysisJob(
 	globalTblInfo *model.TableInfo,
@@ -137,6 +163,14 @@ func (f *AnalysisJobFactory) CreateDynamicPartitionedTableAnalysisJob(
 	statistics.CheckAnalyzeVerOnTable(globalTblStats, &tableStatsVer)
 
 	avgChange, avgSize, minLastAnalyzeDuration, partitionIDs := f.CalculateIndicatorsForPartitions(globalTblStats, partitionStats)
+	if len(partitionIDs) > 0 {
+		// If the changes are concentrated in a few partitions, only these partitions need to be analyzed.
+		// But if the changes spread over the partitions make the whole table stale, the most changed partitions
+		// are also analyzed until the rest of the changes no longer make the global stats stale.
+		if _, changedPartitionIDs := f.CalculateGlobalChangePercentage(partitionStats); len(changedPartitionIDs) > 0 {
+			maps.Copy(partitionIDs, changedPartitionIDs)
+		}
+	}
 	partitionIndexes := f.CheckNewlyAddedIndexesNeedAnalyzeForPartitionedTable(globalTblInfo, partitionStats)
 
 	// No need to analyze.
@@ -230,12 +264,36 @@ func (*AnalysisJobFactory) CheckIndexesNeedAnalyze(tblInfo *model.TableInfo, tbl
 				continue
 			}
 			indexIDs[idx.ID] = struct{}{}
+			continue
+		}
+		if expressionIndexHiddenColumnNotAnalyzed(tblInfo, tblStats, idx) {
+			indexIDs[idx.ID] = struct{}{}
 		}
 	}
 
 	return indexIDs
 }
 
+// expressionIndexHiddenColumnNotAnalyzed checks whether the hidden column of the expression index has no analyzed
+// stats, e.g. the stats are built by an older version which skips the virtual columns. The predicates on the
+// expression are rewritten to the hidden column, so they can't be estimated by the column stats until it's analyzed.
+// It only works for the version 2 stats, since the version 1 stats never build the stats of the virtual columns.
+func expressionIndexHiddenColumnNotAnalyzed(tblInfo *model.TableInfo, tblStats *statistics.Table, idx *model.IndexInfo) bool {
+	if tblStats.StatsVer != statistics.Version2 || idx.State != model.StatePublic || idx.MVIndex || idx.VectorInfo != nil {
+		return false
+	}
+	for _, idxCol := range idx.Columns {
+		if idxCol.Offset >= len(tblInfo.Columns) {
+			continue
+		}
+		col := tblInfo.Columns[idxCol.Offset]
+		if col.Hidden && col.IsVirtualGenerated() && !tblStats.ColAndIdxExistenceMap.HasAnalyzed(col.ID, false) {
+			return true
+		}
+	}
+	return false
+}
+
 // CalculateIndicatorsForPartitions calculates the average change percentage,
 // average size and average last analyze duration for the partitions that meet the threshold.
 // Change percentage is the ratio of the number of modified rows to the total number of rows.
@@ -285,6 +343,69 @@ func (f *AnalysisJobFactory) CalculateIndicatorsForPartitions(
 	return avgChange, avgSize, avgLastAnalyzeDuration, partitionIDs
 }
 
+// CalculateGlobalChangePercentage calculates the change percentage of the whole partitioned table
+// from the partition-level deltas, i.e. the sum of the modified rows of the analyzed partitions divided by
+// the sum of their analyzed rows. If the change percentage meets the threshold, it also returns the partitions
+// which need to be analyzed to bring it back under the threshold: the modified partitions are picked in the
+// descending order of their own change ratio, so the least modified partitions are never re-analyzed.
+// If the change percentage doesn't meet the threshold, it returns 0 and nil.
+func (f *AnalysisJobFactory) CalculateGlobalChangePercentage(
+	partitionStats map[PartitionIDAndName]*statistics.Table,
+) (changePercentage float64, changedPartitionIDs map[int64]struct{}) {
+	// Auto analyze based on the change percentage is disabled.
+	if f.autoAnalyzeRatio == 0 {
+		return 0, nil
+	}
+
+	type partitionDelta struct {
+		id          int64
+		modifyCount float64
+		ratio       float64
+	}
+	totalModifyCount := 0.0
+	totalCount := 0.0
+	deltas := make([]partitionDelta, 0, len(partitionStats))
+	for pIDAndName, tblStats := range partitionStats {
+		if !tblStats.IsAnalyzed() {
+			continue
+		}
+		tblCnt := float64(tblStats.RealtimeCount)
+		if histCnt := tblStats.GetAnalyzeRowCount(); histCnt > 0 {
+			tblCnt = histCnt
+		}
+		totalCount += tblCnt
+		if tblStats.ModifyCount > 0 && tblCnt > 0 {
+			modifyCount := float64(tblStats.ModifyCount)
+			totalModifyCount += modifyCount
+			deltas = append(deltas, partitionDelta{id: pIDAndName.ID, modifyCount: modifyCount, ratio: modifyCount / tblCnt})
+		}
+	}
+	if totalCount == 0 {
+		return 0, nil
+	}
+	changePercentage = totalModifyCount / totalCount
+	if changePercentage <= f.autoAnalyzeRatio {
+		return 0, nil
+	}
+
+	slices.SortFunc(deltas, func(a, b partitionDelta) int {
+		if c := cmp.Compare(b.ratio, a.ratio); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.id, b.id)
+	})
+	changedPartitionIDs = make(map[int64]struct{}, len(deltas))
+	remainingModifyCount := totalModifyCount
+	for _, delta := range deltas {
+		if remainingModifyCount/totalCount <= f.autoAnalyzeRatio {
+			break
+		}
+		changedPartitionIDs[delta.id] = struct{}{}
+		remainingModifyCount -= delta.modifyCount
+	}
+	return changePercentage, changedPartitionIDs
+}
+
 // CheckNewlyAddedIndexesNeedAnalyzeForPartitionedTable checks if the indexes of the partitioned table need to be analyzed.
 // It returns a map from index name to the names of the partitions that need to be analyzed.
 // NOTE: This is only for newly added indexes.
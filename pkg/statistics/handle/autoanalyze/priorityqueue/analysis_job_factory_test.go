@@ -15,6 +15,7 @@
 package priorityqueue_test
 
 import (
+	"fmt"
 	"sort"
 	"testing"
 	"time"
@@ -122,6 +123,12 @@ func TestCheckIndexesNeedAnalyze(t *testing.T) {
 	analyzedMap := statistics.NewColAndIndexExistenceMap(1, 0)
 	analyzedMap.InsertCol(1, true)
 	analyzedMap.InsertIndex(1, false)
+	// The expression index is analyzed but its hidden column isn't.
+	expressionIndexAnalyzedMap := statistics.NewColAndIndexExistenceMap(2, 2)
+	expressionIndexAnalyzedMap.InsertCol(1, true)
+	expressionIndexAnalyzedMap.InsertCol(2, false)
+	expressionIndexAnalyzedMap.InsertIndex(1, true)
+	expressionIndexAnalyzedMap.InsertIndex(2, true)
 	tests := []struct {
 		name     string
 		tblInfo  *model.TableInfo
@@ -170,6 +177,39 @@ func TestCheckIndexesNeedAnalyze(t *testing.T) {
 			},
 			want: map[int64]struct{}{1: {}},
 		},
+		{
+			name: "Test hidden column of expression index not analyzed",
+			tblInfo: &model.TableInfo{
+				Columns: []*model.ColumnInfo{
+					{ID: 1, Offset: 0},
+					{ID: 2, Offset: 1, Hidden: true, GeneratedExprString: "lower(`a`)"},
+				},
+				Indices: []*model.IndexInfo{
+					{
+						ID:      1,
+						Name:    ast.NewCIStr("index1"),
+						State:   model.StatePublic,
+						Columns: []*model.IndexColumn{{Offset: 0}},
+					},
+					{
+						ID:      2,
+						Name:    ast.NewCIStr("expression_index1"),
+						State:   model.StatePublic,
+						Columns: []*model.IndexColumn{{Offset: 1}},
+					},
+				},
+			},
+			tblStats: &statistics.Table{
+				HistColl: func() statistics.HistColl {
+					coll := statistics.NewHistCollWithColsAndIdxs(0, 0, 0, nil, nil)
+					coll.StatsVer = statistics.Version2
+					return *coll
+				}(),
+				ColAndIdxExistenceMap: expressionIndexAnalyzedMap,
+				LastAnalyzeVersion:    1,
+			},
+			want: map[int64]struct{}{2: {}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -387,6 +427,68 @@ func TestCalculateIndicatorsForPartitions(t *testing.T) {
 	}
 }
 
+func TestCalculateGlobalChangePercentage(t *testing.T) {
+	analyzedMap := statistics.NewColAndIndexExistenceMap(1, 0)
+	analyzedMap.InsertCol(1, true)
+	newPartitionStats := func(modifyCounts ...int64) map[priorityqueue.PartitionIDAndName]*statistics.Table {
+		partitionStats := make(map[priorityqueue.PartitionIDAndName]*statistics.Table, len(modifyCounts))
+		for i, modifyCount := range modifyCounts {
+			partitionStats[priorityqueue.NewPartitionIDAndName(fmt.Sprintf("p%d", i), int64(i+1))] = &statistics.Table{
+				HistColl: *statistics.NewHistCollWithColsAndIdxs(0, 1000, modifyCount, map[int64]*statistics.Column{
+					1: {StatsVer: 2},
+				}, nil),
+				ColAndIdxExistenceMap: analyzedMap,
+				LastAnalyzeVersion:    1,
+			}
+		}
+		return partitionStats
+	}
+	tests := []struct {
+		name                  string
+		partitionStats        map[priorityqueue.PartitionIDAndName]*statistics.Table
+		autoAnalyzeRatio      float64
+		wantChangePercentage  float64
+		wantChangedPartitions map[int64]struct{}
+	}{
+		{
+			name:                  "Changes spread over the partitions",
+			partitionStats:        newPartitionStats(1500, 600, 0),
+			autoAnalyzeRatio:      0.5,
+			wantChangePercentage:  0.7,
+			wantChangedPartitions: map[int64]struct{}{1: {}},
+		},
+		{
+			name:                  "Only the most changed partitions are analyzed",
+			partitionStats:        newPartitionStats(800, 700, 600, 100),
+			autoAnalyzeRatio:      0.2,
+			wantChangePercentage:  0.55,
+			wantChangedPartitions: map[int64]struct{}{1: {}, 2: {}},
+		},
+		{
+			name:                  "Changes concentrated in one partition",
+			partitionStats:        newPartitionStats(1200, 100, 0),
+			autoAnalyzeRatio:      0.5,
+			wantChangePercentage:  0,
+			wantChangedPartitions: nil,
+		},
+		{
+			name:                  "Auto analyze ratio is disabled",
+			partitionStats:        newPartitionStats(3000, 3000, 3000),
+			autoAnalyzeRatio:      0,
+			wantChangePercentage:  0,
+			wantChangedPartitions: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := priorityqueue.NewAnalysisJobFactory(nil, tt.autoAnalyzeRatio, 0)
+			gotChangePercentage, gotChangedPartitions := factory.CalculateGlobalChangePercentage(tt.partitionStats)
+			require.InDelta(t, tt.wantChangePercentage, gotChangePercentage, 0.0001)
+			require.Equal(t, tt.wantChangedPartitions, gotChangedPartitions)
+		})
+	}
+}
+
 func TestCheckNewlyAddedIndexesNeedAnalyzeForPartitionedTable(t *testing.T) {
 	tblInfo := model.TableInfo{
 		Indices: []*model.IndexInfo{
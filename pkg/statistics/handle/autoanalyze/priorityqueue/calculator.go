@@ -21,6 +21,12 @@ const (
 	EventNone = 0.0
 	// EventNewIndex represents a special event for newly added indexes.
 	EventNewIndex = 2.0
+	// EventNewPartition represents a special event for newly added partitions.
+	// See tidb_enable_new_partition_stats_seeding for more details.
+	EventNewPartition = 1.0
+	// EventStatsDrift represents a special event for the tables whose data distributions have drifted from their stats.
+	// See tidb_stats_drift_check_interval for more details.
+	EventStatsDrift = 1.0
 )
 
 // TODO: make these configurable.
@@ -0,0 +1,179 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+)
+
+// The decisions of the auto analyze evaluation recorded in mysql.auto_analyze_decisions.
+const (
+	DecisionTriggered = "triggered"
+	DecisionSkipped   = "skipped"
+)
+
+// The reasons of the skipped decisions.
+const (
+	// SkipReasonTimeWindow means the table is skipped because now is outside the auto analyze time window.
+	SkipReasonTimeWindow = "time_window"
+	// SkipReasonChangeRatio means the change ratio of the table doesn't exceed the auto analyze ratio.
+	SkipReasonChangeRatio = "change_ratio"
+	// SkipReasonLocked means the stats of the table are locked.
+	SkipReasonLocked = "locked"
+	// SkipReasonFailureBackoff means the table is skipped because the last analysis failed recently.
+	SkipReasonFailureBackoff = "failure_backoff"
+	// SkipReasonRunning means the table is being analyzed.
+	SkipReasonRunning = "running"
+	// SkipReasonNotExist means the table or the partition doesn't exist anymore.
+	SkipReasonNotExist = "not_exist"
+	// SkipReasonError means the evaluation meets an error.
+	SkipReasonError = "error"
+)
+
+const (
+	// MaxAutoAnalyzeDecisionsPerTable is the number of the latest decisions kept for each table in
+	// mysql.auto_analyze_decisions, the older ones are rotated out.
+	MaxAutoAnalyzeDecisionsPerTable = 10
+	// maxPendingDecisions is the max number of the decisions buffered before being flushed, the new decisions are
+	// dropped once the limit is reached.
+	maxPendingDecisions = 4096
+
+	insertDecisionSQL      = "INSERT INTO mysql.auto_analyze_decisions (table_id, table_schema, table_name, partition_name, decision, reason, detail) VALUES (%?, %?, %?, %?, %?, %?, %?)"
+	selectRotateDecisionID = "SELECT id FROM mysql.auto_analyze_decisions WHERE table_id = %? ORDER BY id DESC LIMIT 1 OFFSET %?"
+	deleteRotatedDecisions = "DELETE FROM mysql.auto_analyze_decisions WHERE table_id = %? AND id <= %?"
+)
+
+// decision is a decision of the auto analyze evaluation on a table or a partition.
+type decision struct {
+	decision string
+	reason   string
+	detail   string
+	tableID  int64
+}
+
+// DecisionLog buffers the decisions of the auto analyze evaluation and flushes them to
+// mysql.auto_analyze_decisions, so that the users can see why a table is or isn't analyzed automatically.
+// The consecutive skipped decisions of a table with the same reason are only recorded once.
+// Note: This struct is thread-safe.
+type DecisionLog struct {
+	pending []decision
+	// lastDecisions is the last recorded decision and reason of each table.
+	lastDecisions map[int64]string
+	mu            sync.Mutex
+}
+
+// NewDecisionLog creates a new DecisionLog.
+func NewDecisionLog() *DecisionLog {
+	return &DecisionLog{lastDecisions: make(map[int64]string)}
+}
+
+// Record records a decision of the table or the partition.
+func (l *DecisionLog) Record(tableID int64, decisionType, reason, detail string) {
+	key := decisionType + "/" + reason
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if decisionType == DecisionSkipped && l.lastDecisions[tableID] == key {
+		return
+	}
+	if len(l.pending) >= maxPendingDecisions {
+		return
+	}
+	l.lastDecisions[tableID] = key
+	l.pending = append(l.pending, decision{tableID: tableID, decision: decisionType, reason: reason, detail: detail})
+}
+
+// Reset drops the buffered decisions and forgets the last decisions of the tables.
+func (l *DecisionLog) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pending = nil
+	clear(l.lastDecisions)
+}
+
+// Flush writes the buffered decisions to mysql.auto_analyze_decisions and rotates out the old decisions of the
+// tables beyond MaxAutoAnalyzeDecisionsPerTable.
+func (l *DecisionLog) Flush(sctx sessionctx.Context) error {
+	l.mu.Lock()
+	pending := l.pending
+	l.pending = nil
+	l.mu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+	tableIDs := make([]int64, 0, len(pending))
+	flushed := make(map[int64]struct{}, len(pending))
+	for _, d := range pending {
+		schema, table, partition := decisionTableNames(is, d.tableID)
+		if _, _, err := util.ExecRows(sctx, insertDecisionSQL,
+			d.tableID, schema, table, partition, d.decision, d.reason, d.detail,
+		); err != nil {
+			return errors.Trace(err)
+		}
+		if _, ok := flushed[d.tableID]; !ok {
+			flushed[d.tableID] = struct{}{}
+			tableIDs = append(tableIDs, d.tableID)
+		}
+	}
+	for _, tableID := range tableIDs {
+		rows, _, err := util.ExecRows(sctx, selectRotateDecisionID, tableID, MaxAutoAnalyzeDecisionsPerTable)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		if _, _, err := util.ExecRows(sctx, deleteRotatedDecisions, tableID, rows[0].GetUint64(0)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// decisionTableNames returns the schema, table and partition names of the table or the partition. The names are
+// empty if the table is dropped.
+func decisionTableNames(is infoschema.InfoSchema, id int64) (schema, table, partition string) {
+	if tbl, ok := is.TableByID(util.StatsCtx, id); ok {
+		if db, ok := is.SchemaByID(tbl.Meta().DBID); ok {
+			schema = db.Name.O
+		}
+		return schema, tbl.Meta().Name.O, ""
+	}
+	if tbl, db, def := is.FindTableByPartitionID(id); tbl != nil {
+		return db.Name.O, tbl.Meta().Name.O, def.Name.O
+	}
+	return "", "", ""
+}
+
+// ValidationFailureReason returns the reason of the skipped decision for the failure reason returned by
+// AnalysisJob.ValidateAndPrepare.
+func ValidationFailureReason(failReason string) string {
+	switch {
+	case failReason == schemaNotExist || failReason == tableNotExist ||
+		failReason == notPartitionedTable || failReason == partitionNotExist:
+		return SkipReasonNotExist
+	case strings.HasPrefix(failReason, "fail to get"):
+		return SkipReasonError
+	default:
+		return SkipReasonFailureBackoff
+	}
+}
@@ -112,7 +112,7 @@ func (j *DynamicPartitionedTableAnalysisJob) Analyze(
 		}
 	}()
 
-	return statsutil.CallWithSCtx(statsHandle.SPool(), func(sctx sessionctx.Context) error {
+	return statsutil.CallWithSCtxAndLabels(statsutil.StatsCtx, statsHandle.SPool(), statsutil.StatsOperationAnalyze, j.GlobalTableID, func(sctx sessionctx.Context) error {
 		switch j.getAnalyzeType() {
 		case analyzeDynamicPartition:
 			success = j.analyzePartitions(sctx, statsHandle, sysProcTracker)
@@ -97,7 +97,7 @@ func (j *NonPartitionedTableAnalysisJob) Analyze(
 		}
 	}()
 
-	return statsutil.CallWithSCtx(statsHandle.SPool(), func(sctx sessionctx.Context) error {
+	return statsutil.CallWithSCtxAndLabels(statsutil.StatsCtx, statsHandle.SPool(), statsutil.StatsOperationAnalyze, j.TableID, func(sctx sessionctx.Context) error {
 		switch j.getAnalyzeType() {
 		case analyzeTable:
 			success = j.analyzeTable(sctx, statsHandle, sysProcTracker)
@@ -16,6 +16,7 @@ package priorityqueue
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"sync"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/handle/autoanalyze/exec"
+	"github.com/pingcap/tidb/pkg/statistics/handle/drift"
 	"github.com/pingcap/tidb/pkg/statistics/handle/lockstats"
 	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
 	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
@@ -44,6 +46,7 @@ const (
 	lastAnalysisDurationRefreshInterval = time.Minute * 10
 	dmlChangesFetchInterval             = time.Minute * 2
 	mustRetryJobRequeueInterval         = time.Minute * 5
+	driftedTablesRequeueInterval        = time.Minute * 5
 )
 
 // If the process takes longer than this threshold, we will log it as a slow log.
@@ -84,6 +87,8 @@ type AnalysisPriorityQueue struct {
 	ctx         context.Context
 	statsHandle statstypes.StatsHandle
 	calculator  *PriorityCalculator
+	// decisions records the decisions of the auto analyze evaluation.
+	decisions *DecisionLog
 
 	wg util.WaitGroupWrapper
 
@@ -106,6 +111,12 @@ type AnalysisPriorityQueue struct {
 		//    particularly for tables with new indexes created during this process.
 		// We will requeue the must retry jobs periodically.
 		mustRetryJobs map[int64]struct{}
+		// newlyAddedPartitions is a map to store the IDs of the newly added partitions which are not analyzed yet.
+		// The jobs that analyze these partitions get an elevated priority.
+		newlyAddedPartitions map[int64]struct{}
+		// driftedTables is a map to store the IDs of the tables whose data distributions have drifted from their stats
+		// and are not analyzed yet. The jobs that analyze these tables get an elevated priority.
+		driftedTables map[int64]struct{}
 		// initialized is a flag to check if the queue is initialized.
 		initialized bool
 	}
@@ -116,11 +127,17 @@ func NewAnalysisPriorityQueue(handle statstypes.StatsHandle) *AnalysisPriorityQu
 	queue := &AnalysisPriorityQueue{
 		statsHandle: handle,
 		calculator:  NewPriorityCalculator(),
+		decisions:   NewDecisionLog(),
 	}
 
 	return queue
 }
 
+// Decisions returns the log of the decisions of the auto analyze evaluation.
+func (pq *AnalysisPriorityQueue) Decisions() *DecisionLog {
+	return pq.decisions
+}
+
 // IsInitialized checks if the priority queue is initialized.
 func (pq *AnalysisPriorityQueue) IsInitialized() bool {
 	pq.syncFields.mu.RLock()
@@ -157,6 +174,8 @@ func (pq *AnalysisPriorityQueue) Initialize() error {
 	pq.syncFields.cancel = cancel
 	pq.syncFields.runningJobs = make(map[int64]struct{})
 	pq.syncFields.mustRetryJobs = make(map[int64]struct{})
+	pq.syncFields.newlyAddedPartitions = make(map[int64]struct{})
+	pq.syncFields.driftedTables = make(map[int64]struct{})
 	pq.syncFields.initialized = true
 	pq.syncFields.mu.Unlock()
 
@@ -310,6 +329,8 @@ func (pq *AnalysisPriorityQueue) run() {
 	defer timeRefreshInterval.Stop()
 	mustRetryJobRequeueInterval := time.NewTicker(mustRetryJobRequeueInterval)
 	defer mustRetryJobRequeueInterval.Stop()
+	driftedTablesRequeueInterval := time.NewTicker(driftedTablesRequeueInterval)
+	defer driftedTablesRequeueInterval.Stop()
 
 	for {
 		select {
@@ -325,6 +346,9 @@ func (pq *AnalysisPriorityQueue) run() {
 		case <-mustRetryJobRequeueInterval.C:
 			queueSamplerLogger().Info("Start to requeue must retry jobs")
 			pq.RequeueMustRetryJobs()
+		case <-driftedTablesRequeueInterval.C:
+			queueSamplerLogger().Info("Start to requeue drifted tables")
+			pq.RequeueDriftedTables()
 		}
 	}
 }
@@ -413,6 +437,9 @@ func (pq *AnalysisPriorityQueue) processTableStats(
 	job, ok, _ := pq.syncFields.inner.getByKey(stats.PhysicalID)
 	if !ok {
 		job = pq.tryCreateJob(is, stats, pruneMode, jobFactory, lockedTables)
+		if job == nil {
+			pq.recordSkippedDecision(stats, autoAnalyzeRatio, lockedTables)
+		}
 	} else {
 		// Skip analysis if the table is locked.
 		// Dynamic partitioned tables are managed in the tryCreateJob branch.
@@ -420,6 +447,7 @@ func (pq *AnalysisPriorityQueue) processTableStats(
 		// For static partitioned tables, skip either the locked partition or the whole table if all partitions are locked.
 		// For dynamic partitioned tables, if the parent table is locked, we skip the whole table here as well.
 		if _, ok := lockedTables[stats.PhysicalID]; ok {
+			pq.decisions.Record(stats.PhysicalID, DecisionSkipped, SkipReasonLocked, "the stats are locked")
 			// Clean up the job if the table is locked.
 			err := pq.syncFields.inner.delete(job)
 			if err != nil {
@@ -431,10 +459,40 @@ func (pq *AnalysisPriorityQueue) processTableStats(
 			}
 			return nil
 		}
-		job = pq.tryUpdateJob(is, stats, job, jobFactory)
+		job = pq.tryUpdateJob(is, stats, job, jobFactory, lockedTables)
 	}
 	return pq.pushWithoutLock(job)
 }
+
+// recordSkippedDecision records why no job is created for the changed table or partition.
+func (pq *AnalysisPriorityQueue) recordSkippedDecision(
+	stats *statistics.Table,
+	autoAnalyzeRatio float64,
+	lockedTables map[int64]struct{},
+) {
+	if _, ok := lockedTables[stats.PhysicalID]; ok {
+		pq.decisions.Record(stats.PhysicalID, DecisionSkipped, SkipReasonLocked, "the stats are locked")
+		return
+	}
+	if !stats.IsAnalyzed() {
+		return
+	}
+	tblCnt := float64(stats.RealtimeCount)
+	if histCnt := stats.GetAnalyzeRowCount(); histCnt > 0 {
+		tblCnt = histCnt
+	}
+	var changeRatio float64
+	if tblCnt > 0 {
+		changeRatio = float64(stats.ModifyCount) / tblCnt
+	}
+	if autoAnalyzeRatio > 0 && changeRatio > autoAnalyzeRatio {
+		// The job isn't created for other reasons, e.g. the partition stats of the dynamic partitioned table.
+		return
+	}
+	pq.decisions.Record(stats.PhysicalID, DecisionSkipped, SkipReasonChangeRatio,
+		fmt.Sprintf("the change ratio %.4f doesn't exceed the auto analyze ratio %.4f", changeRatio, autoAnalyzeRatio))
+}
+
 func (pq *AnalysisPriorityQueue) tryCreateJob(
 	is infoschema.InfoSchema,
 	stats *statistics.Table,
@@ -513,12 +571,7 @@ func (pq *AnalysisPriorityQueue) tryCreateJob(
 			//
 			// This behavior is acceptable, as lock statuses will be validated before running the analysis.
 			// So let keep it simple and ignore this edge case here.
-			filteredPartitionDefs := make([]model.PartitionDefinition, 0, len(partitionDefs))
-			for _, def := range partitionDefs {
-				if _, ok := lockedTables[def.ID]; !ok {
-					filteredPartitionDefs = append(filteredPartitionDefs, def)
-				}
-			}
+			filteredPartitionDefs := filterLockedPartitions(partitionDefs, lockedTables)
 			partitionStats := GetPartitionStats(pq.statsHandle, tableMeta, filteredPartitionDefs)
 			job = jobFactory.CreateDynamicPartitionedTableAnalysisJob(
 				tableMeta,
@@ -530,11 +583,27 @@ func (pq *AnalysisPriorityQueue) tryCreateJob(
 	}
 	return job
 }
+
+// filterLockedPartitions returns the partitions whose stats are not locked.
+func filterLockedPartitions(
+	partitionDefs []model.PartitionDefinition,
+	lockedTables map[int64]struct{},
+) []model.PartitionDefinition {
+	filteredPartitionDefs := make([]model.PartitionDefinition, 0, len(partitionDefs))
+	for _, def := range partitionDefs {
+		if _, ok := lockedTables[def.ID]; !ok {
+			filteredPartitionDefs = append(filteredPartitionDefs, def)
+		}
+	}
+	return filteredPartitionDefs
+}
+
 func (pq *AnalysisPriorityQueue) tryUpdateJob(
 	is infoschema.InfoSchema,
 	stats *statistics.Table,
 	oldJob AnalysisJob,
 	jobFactory *AnalysisJobFactory,
+	lockedTables map[int64]struct{},
 ) AnalysisJob {
 	if stats == nil {
 		return nil
@@ -556,7 +625,8 @@ func (pq *AnalysisPriorityQueue) tryUpdateJob(
 		}
 		tableMeta := tableInfo.Meta()
 		partitionedTable := tableMeta.GetPartitionInfo()
-		partitionDefs := partitionedTable.Definitions
+		// Only analyze the partitions that have not been locked, same as tryCreateJob.
+		partitionDefs := filterLockedPartitions(partitionedTable.Definitions, lockedTables)
 		partitionStats := GetPartitionStats(pq.statsHandle, tableMeta, partitionDefs)
 		return jobFactory.CreateDynamicPartitionedTableAnalysisJob(
 			tableMeta,
@@ -616,6 +686,59 @@ func (pq *AnalysisPriorityQueue) RequeueMustRetryJobs() {
 	}
 }
 
+// RequeueDriftedTables pushes the jobs of the tables whose data distributions have drifted from their stats since
+// they were analyzed, see tidb_stats_drift_check_interval. The jobs are created even if the tables aren't modified
+// enough, and get an elevated priority.
+// Note: This function is thread-safe.
+func (pq *AnalysisPriorityQueue) RequeueDriftedTables() {
+	pq.syncFields.mu.Lock()
+	defer pq.syncFields.mu.Unlock()
+
+	if err := statsutil.CallWithSCtx(pq.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+		driftedTables, err := drift.DriftedTables(sctx)
+		if err != nil || len(driftedTables) == 0 {
+			return err
+		}
+		lockedTables, err := lockstats.QueryLockedTables(statsutil.StatsCtx, sctx)
+		if err != nil {
+			return err
+		}
+		parameters := exec.GetAutoAnalyzeParameters(sctx)
+		autoAnalyzeRatio := exec.ParseAutoAnalyzeRatio(parameters[vardef.TiDBAutoAnalyzeRatio])
+		currentTs, err := statsutil.GetStartTS(sctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		jobFactory := NewAnalysisJobFactory(sctx, autoAnalyzeRatio, currentTs)
+		is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+		for tableID, statsVersion := range driftedTables {
+			if _, ok := lockedTables[tableID]; ok {
+				continue
+			}
+			tblInfo, ok := pq.statsHandle.TableInfoByID(is, tableID)
+			if !ok {
+				continue
+			}
+			stats := pq.statsHandle.GetTableStatsForAutoAnalyze(tblInfo.Meta())
+			// The table is analyzed after the drift is found.
+			if stats == nil || stats.LastAnalyzeVersion > statsVersion {
+				continue
+			}
+			pq.syncFields.driftedTables[tableID] = struct{}{}
+			job, ok, _ := pq.syncFields.inner.getByKey(tableID)
+			if !ok {
+				job = jobFactory.CreateDriftedTableAnalysisJob(tblInfo.Meta(), stats)
+			}
+			if err := pq.pushWithoutLock(job); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, statsutil.FlagWrapTxn); err != nil {
+		statslogutil.StatsLogger().Error("Failed to requeue drifted tables", zap.Error(err))
+	}
+}
+
 // RefreshLastAnalysisDuration refreshes the last analysis duration of all jobs in the priority queue.
 // Note: This function is thread-safe.
 func (pq *AnalysisPriorityQueue) RefreshLastAnalysisDuration() {
@@ -726,6 +849,12 @@ func (pq *AnalysisPriorityQueue) pushWithoutLock(job AnalysisJob) error {
 	// We apply a penalty to larger tables, which can potentially result in a negative weight.
 	// To prevent this, we filter out any negative weights. Under normal circumstances, table sizes should not be negative.
 	weight := pq.calculator.CalculateWeight(job)
+	if pq.hasNewlyAddedPartitions(job) {
+		weight += EventNewPartition
+	}
+	if _, ok := pq.syncFields.driftedTables[job.GetTableID()]; ok {
+		weight += EventStatsDrift
+	}
 	if weight <= 0 {
 		statslogutil.SingletonStatsSamplerLogger().Warn(
 			"Table gets a negative weight",
@@ -737,6 +866,35 @@ func (pq *AnalysisPriorityQueue) pushWithoutLock(job AnalysisJob) error {
 	return pq.syncFields.inner.addOrUpdate(job)
 }
 
+// hasNewlyAddedPartitions checks whether the job analyzes any of the newly added partitions.
+func (pq *AnalysisPriorityQueue) hasNewlyAddedPartitions(job AnalysisJob) bool {
+	if len(pq.syncFields.newlyAddedPartitions) == 0 {
+		return false
+	}
+	for _, partitionID := range getPartitionIDsOfJob(job) {
+		if _, ok := pq.syncFields.newlyAddedPartitions[partitionID]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getPartitionIDsOfJob returns the IDs of the partitions analyzed by the job.
+func getPartitionIDsOfJob(job AnalysisJob) []int64 {
+	switch j := job.(type) {
+	case *StaticPartitionedTableAnalysisJob:
+		return []int64{j.StaticPartitionID}
+	case *DynamicPartitionedTableAnalysisJob:
+		partitionIDs := make([]int64, 0, len(j.PartitionIDs))
+		for partitionID := range j.PartitionIDs {
+			partitionIDs = append(partitionIDs, partitionID)
+		}
+		return partitionIDs
+	default:
+		return nil
+	}
+}
+
 // Pop pops a job from the priority queue and marks it as running.
 // Note: This function is thread-safe.
 func (pq *AnalysisPriorityQueue) Pop() (AnalysisJob, error) {
@@ -762,6 +920,10 @@ func (pq *AnalysisPriorityQueue) Pop() (AnalysisJob, error) {
 			return
 		}
 		delete(pq.syncFields.runningJobs, j.GetTableID())
+		for _, partitionID := range getPartitionIDsOfJob(j) {
+			delete(pq.syncFields.newlyAddedPartitions, partitionID)
+		}
+		delete(pq.syncFields.driftedTables, j.GetTableID())
 	})
 	job.RegisterFailureHook(func(j AnalysisJob, needRetry bool) {
 		pq.syncFields.mu.Lock()
@@ -871,6 +1033,8 @@ func (pq *AnalysisPriorityQueue) Close() {
 	pq.syncFields.inner = nil
 	pq.syncFields.runningJobs = nil
 	pq.syncFields.mustRetryJobs = nil
+	pq.syncFields.newlyAddedPartitions = nil
+	pq.syncFields.driftedTables = nil
 	pq.syncFields.lastDMLUpdateFetchTimestamp = 0
 	pq.syncFields.cancel = nil
 }
@@ -56,6 +56,8 @@ func (pq *AnalysisPriorityQueue) HandleDDLEvent(_ context.Context, sctx sessionc
 	switch event.GetType() {
 	case model.ActionAddIndex:
 		err = pq.handleAddIndexEvent(sctx, event)
+	case model.ActionAddTablePartition:
+		err = pq.handleAddTablePartitionEvent(sctx, event)
 	case model.ActionTruncateTable:
 		err = pq.handleTruncateTableEvent(sctx, event)
 	case model.ActionDropTable:
@@ -83,6 +85,9 @@ func (pq *AnalysisPriorityQueue) HandleDDLEvent(_ context.Context, sctx sessionc
 
 // getAndDeleteJob tries to get a job from the priority queue and delete it if it exists.
 func (pq *AnalysisPriorityQueue) getAndDeleteJob(tableID int64) error {
+	// The table or partition is gone, so it no longer needs the elevated priority.
+	delete(pq.syncFields.newlyAddedPartitions, tableID)
+	delete(pq.syncFields.driftedTables, tableID)
 	job, ok, err := pq.syncFields.inner.getByKey(tableID)
 	if err != nil {
 		statslogutil.StatsLogger().Error(
@@ -201,6 +206,25 @@ func (pq *AnalysisPriorityQueue) handleAddIndexEvent(
 	return pq.pushWithoutLock(job)
 }
 
+func (pq *AnalysisPriorityQueue) handleAddTablePartitionEvent(
+	sctx sessionctx.Context,
+	event *notifier.SchemaChangeEvent,
+) error {
+	if !vardef.EnableNewPartitionStatsSeeding.Load() {
+		return nil
+	}
+	globalTableInfo, addedPartInfo := event.GetAddPartitionInfo()
+	// Remember the new partitions so they are analyzed at an elevated priority.
+	// They are usually empty when they are created, so the job is only created
+	// once they have enough rows, which is handled by the DML changes.
+	for _, def := range addedPartInfo.Definitions {
+		pq.syncFields.newlyAddedPartitions[def.ID] = struct{}{}
+	}
+
+	// Try to recreate the job for the partitioned table in case the new partitions are already eligible.
+	return pq.recreateAndPushJobForTable(sctx, globalTableInfo)
+}
+
 func (pq *AnalysisPriorityQueue) handleTruncateTableEvent(
 	_ sessionctx.Context,
 	event *notifier.SchemaChangeEvent,
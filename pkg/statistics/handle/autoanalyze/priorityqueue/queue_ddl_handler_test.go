@@ -511,6 +511,136 @@ func TestDropTablePartition(t *testing.T) {
 	require.True(t, isEmpty)
 }
 
+func TestAddTablePartition(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("set global tidb_enable_new_partition_stats_seeding = on")
+	defer testKit.MustExec("set global tidb_enable_new_partition_stats_seeding = off")
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (c1 int, c2 int, index idx(c1, c2)) partition by range (c1) (partition p0 values less than (10), partition p1 values less than (20))")
+	h := do.StatsHandle()
+	// Analyze table.
+	testKit.MustExec("insert into t values (1,2),(11,2)")
+	testKit.MustExec("analyze table t")
+	require.NoError(t, h.Update(context.Background(), do.InfoSchema()))
+
+	statistics.AutoAnalyzeMinCnt = 0
+	defer func() {
+		statistics.AutoAnalyzeMinCnt = 1000
+	}()
+
+	pq := priorityqueue.NewAnalysisPriorityQueue(h)
+	defer pq.Close()
+	require.NoError(t, pq.Initialize())
+	isEmpty, err := pq.IsEmpty()
+	require.NoError(t, err)
+	require.True(t, isEmpty)
+
+	// Add table partition.
+	testKit.MustExec("alter table t add partition (partition p2 values less than (30))")
+
+	// Find the add table partition event.
+	addTablePartitionEvent := findEvent(h.DDLEventCh(), model.ActionAddTablePartition)
+
+	// Handle the add table partition event.
+	err = statstestutil.HandleDDLEventWithTxn(h, addTablePartitionEvent)
+	require.NoError(t, err)
+	// Load the seeded stats of the new partition.
+	ctx := context.Background()
+	require.NoError(t, h.Update(ctx, do.InfoSchema()))
+
+	require.NoError(t, statsutil.CallWithSCtx(
+		h.SPool(),
+		func(sctx sessionctx.Context) error {
+			// Handle the add table partition event in priority queue.
+			require.NoError(t, pq.HandleDDLEvent(ctx, sctx, addTablePartitionEvent))
+			return nil
+		}, statsutil.FlagWrapTxn),
+	)
+	// The job of the new partition gets an elevated priority.
+	tbl, err := do.InfoSchema().TableByName(ctx, ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	job, err := pq.Peek()
+	require.NoError(t, err)
+	require.Equal(t, tbl.Meta().ID, job.GetTableID())
+	calculator := priorityqueue.NewPriorityCalculator()
+	require.Equal(t, calculator.CalculateWeight(job)+priorityqueue.EventNewPartition, job.GetWeight())
+
+	// Insert some data into the new partition.
+	testKit.MustExec("insert into t values (21,2),(22,2)")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.NoError(t, h.Update(ctx, do.InfoSchema()))
+	pq.ProcessDMLChanges()
+
+	// The elevated priority is kept until the new partition is analyzed.
+	job, err = pq.Peek()
+	require.NoError(t, err)
+	require.Equal(t, tbl.Meta().ID, job.GetTableID())
+	require.Equal(t, calculator.CalculateWeight(job)+priorityqueue.EventNewPartition, job.GetWeight())
+}
+
+func TestAddTablePartitionWithSeededStats(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("set global tidb_enable_new_partition_stats_seeding = on")
+	defer testKit.MustExec("set global tidb_enable_new_partition_stats_seeding = off")
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (c1 int, c2 int, index idx(c1, c2)) partition by range (c1) (partition p0 values less than (10), partition p1 values less than (20))")
+	h := do.StatsHandle()
+	testKit.MustExec("insert into t values (1,2),(11,2),(12,3)")
+	testKit.MustExec("analyze table t")
+	require.NoError(t, h.Update(context.Background(), do.InfoSchema()))
+
+	statistics.AutoAnalyzeMinCnt = 0
+	defer func() {
+		statistics.AutoAnalyzeMinCnt = 1000
+	}()
+
+	pq := priorityqueue.NewAnalysisPriorityQueue(h)
+	defer pq.Close()
+	require.NoError(t, pq.Initialize())
+	isEmpty, err := pq.IsEmpty()
+	require.NoError(t, err)
+	require.True(t, isEmpty)
+
+	testKit.MustExec("alter table t add partition (partition p2 values less than (30))")
+	addTablePartitionEvent := findEvent(h.DDLEventCh(), model.ActionAddTablePartition)
+	// The new partition is seeded with the stats of p1, which are loaded before the queue handles the event.
+	require.NoError(t, statstestutil.HandleDDLEventWithTxn(h, addTablePartitionEvent))
+	ctx := context.Background()
+	require.NoError(t, h.Update(ctx, do.InfoSchema()))
+	tbl, err := do.InfoSchema().TableByName(ctx, ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	p2ID := tbl.Meta().Partition.Definitions[2].ID
+	p2Stats := h.GetPartitionStatsForAutoAnalyze(tbl.Meta(), p2ID)
+	require.False(t, p2Stats.IsAnalyzed())
+	require.Equal(t, int64(0), p2Stats.RealtimeCount)
+
+	require.NoError(t, statsutil.CallWithSCtx(
+		h.SPool(),
+		func(sctx sessionctx.Context) error {
+			require.NoError(t, pq.HandleDDLEvent(ctx, sctx, addTablePartitionEvent))
+			return nil
+		}, statsutil.FlagWrapTxn),
+	)
+	// The seeded partition is still analyzed by a job at an elevated priority.
+	job, err := pq.Peek()
+	require.NoError(t, err)
+	require.Equal(t, tbl.Meta().ID, job.GetTableID())
+	require.Contains(t, job.(*priorityqueue.DynamicPartitionedTableAnalysisJob).PartitionIDs, p2ID)
+	calculator := priorityqueue.NewPriorityCalculator()
+	require.Equal(t, calculator.CalculateWeight(job)+priorityqueue.EventNewPartition, job.GetWeight())
+
+	// The seeded partition is persisted as unanalyzed, so a new queue, e.g. the one of a new owner, still analyzes it.
+	newPQ := priorityqueue.NewAnalysisPriorityQueue(h)
+	defer newPQ.Close()
+	require.NoError(t, newPQ.Initialize())
+	job, err = newPQ.Peek()
+	require.NoError(t, err)
+	require.Equal(t, tbl.Meta().ID, job.GetTableID())
+	require.Contains(t, job.(*priorityqueue.DynamicPartitionedTableAnalysisJob).PartitionIDs, p2ID)
+}
+
 func TestExchangeTablePartition(t *testing.T) {
 	store, do := testkit.CreateMockStoreAndDomain(t)
 	testKit := testkit.NewTestKit(t, store)
@@ -420,6 +420,53 @@ func TestRequeueMustRetryJobs(t *testing.T) {
 	require.Equal(t, 1, l)
 }
 
+func TestRequeueDriftedTables(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	handle := dom.StatsHandle()
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	statstestutil.HandleNextDDLEventWithTxn(handle)
+	statistics.AutoAnalyzeMinCnt = 0
+	defer func() {
+		statistics.AutoAnalyzeMinCnt = 1000
+	}()
+	tk.MustExec("insert into t values (1), (2), (3)")
+	tk.MustExec("analyze table t")
+	require.NoError(t, handle.DumpStatsDeltaToKV(true))
+	require.NoError(t, handle.Update(context.Background(), dom.InfoSchema()))
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tblInfo := tbl.Meta()
+	statsVersion := handle.GetTableStats(tblInfo).LastAnalyzeVersion
+
+	pq := priorityqueue.NewAnalysisPriorityQueue(handle)
+	defer pq.Close()
+	require.NoError(t, pq.Initialize())
+	l, err := pq.Len()
+	require.NoError(t, err)
+	require.Equal(t, 0, l)
+
+	// The drift found before the last analyze is ignored.
+	tk.MustExec("insert into mysql.stats_drift (table_id, hist_id, stats_version, sample_rows, distance, drifted) values (?, 1, ?, 100, 0.9, 1)",
+		tblInfo.ID, statsVersion-1)
+	pq.RequeueDriftedTables()
+	l, err = pq.Len()
+	require.NoError(t, err)
+	require.Equal(t, 0, l)
+
+	// The table is analyzed for the drift though it isn't modified.
+	tk.MustExec("update mysql.stats_drift set stats_version = ? where table_id = ?", statsVersion, tblInfo.ID)
+	pq.RequeueDriftedTables()
+	l, err = pq.Len()
+	require.NoError(t, err)
+	require.Equal(t, 1, l)
+	job, err := pq.Peek()
+	require.NoError(t, err)
+	require.Equal(t, tblInfo.ID, job.GetTableID())
+	require.Zero(t, job.GetIndicators().ChangePercentage)
+}
+
 func TestProcessDMLChangesWithLockedTables(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
 	handle := dom.StatsHandle()
@@ -542,6 +589,65 @@ func TestProcessDMLChangesWithLockedPartitionsAndDynamicPruneMode(t *testing.T)
 	require.Equal(t, tableID, job.GetTableID())
 }
 
+func TestUpdateDynamicPartitionedJobSkipsLockedPartitions(t *testing.T) {
+	ctx := context.Background()
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	handle := dom.StatsHandle()
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec("create table t1 (a int) partition by range (a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30))")
+	statstestutil.HandleNextDDLEventWithTxn(handle)
+	tk.MustExec("insert into t1 values (1), (11)")
+	require.NoError(t, handle.DumpStatsDeltaToKV(true))
+	require.NoError(t, handle.Update(ctx, dom.InfoSchema()))
+	tk.MustExec("analyze table t1")
+	statistics.AutoAnalyzeMinCnt = 2
+	defer func() {
+		statistics.AutoAnalyzeMinCnt = 1000
+	}()
+
+	// Insert more rows into partition p0 and p1, then lock partition p0.
+	tk.MustExec("insert into t1 values (2), (3), (12), (13)")
+	require.NoError(t, handle.DumpStatsDeltaToKV(true))
+	tk.MustExec("lock stats t1 partition p0")
+	require.NoError(t, handle.Update(ctx, dom.InfoSchema()))
+
+	pq := priorityqueue.NewAnalysisPriorityQueue(handle)
+	defer pq.Close()
+	require.NoError(t, pq.Initialize())
+
+	schema := ast.NewCIStr("test")
+	tbl, err := dom.InfoSchema().TableByName(ctx, schema, ast.NewCIStr("t1"))
+	require.NoError(t, err)
+	pi := tbl.Meta().GetPartitionInfo()
+
+	// Check current jobs, the locked partition is not analyzed.
+	job, err := pq.Peek()
+	require.NoError(t, err)
+	require.Equal(t, tbl.Meta().ID, job.GetTableID())
+	partitionIDs := job.(*priorityqueue.DynamicPartitionedTableAnalysisJob).PartitionIDs
+	require.Len(t, partitionIDs, 1)
+	require.Contains(t, partitionIDs, pi.Definitions[1].ID)
+
+	// Insert a row into p2, which is too small to be analyzed.
+	// So only the global stats are changed and the existing job of the table is updated.
+	tk.MustExec("insert into t1 values (21)")
+	require.NoError(t, handle.DumpStatsDeltaToKV(true))
+	require.NoError(t, handle.Update(ctx, dom.InfoSchema()))
+
+	// Process the DML changes.
+	pq.ProcessDMLChanges()
+
+	// The locked partition is still not analyzed.
+	job, err = pq.Peek()
+	require.NoError(t, err)
+	require.Equal(t, tbl.Meta().ID, job.GetTableID())
+	partitionIDs = job.(*priorityqueue.DynamicPartitionedTableAnalysisJob).PartitionIDs
+	require.Len(t, partitionIDs, 1)
+	require.Contains(t, partitionIDs, pi.Definitions[1].ID)
+}
+
 func TestProcessDMLChangesWithLockedPartitionsAndStaticPruneMode(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
 	handle := dom.StatsHandle()
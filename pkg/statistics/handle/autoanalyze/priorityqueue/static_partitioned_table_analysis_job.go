@@ -102,7 +102,7 @@ func (j *StaticPartitionedTableAnalysisJob) Analyze(
 		}
 	}()
 
-	return statsutil.CallWithSCtx(statsHandle.SPool(), func(sctx sessionctx.Context) error {
+	return statsutil.CallWithSCtxAndLabels(statsutil.StatsCtx, statsHandle.SPool(), statsutil.StatsOperationAnalyze, j.GlobalTableID, func(sctx sessionctx.Context) error {
 		switch j.getAnalyzeType() {
 		case analyzeStaticPartition:
 			success = j.analyzeStaticPartition(sctx, statsHandle, sysProcTracker)
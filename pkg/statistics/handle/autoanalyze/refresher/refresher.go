@@ -16,6 +16,7 @@ package refresher
 
 import (
 	stderrors "errors"
+	"fmt"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -86,6 +87,12 @@ func (r *Refresher) UpdateConcurrency() {
 // Note: Make sure the session has the latest variable values.
 // Usually, this is done by the caller through `util.CallWithSCtx`.
 func (r *Refresher) AnalyzeHighestPriorityTables(sctx sessionctx.Context) bool {
+	decisions := r.jobs.Decisions()
+	defer func() {
+		if err := decisions.Flush(sctx); err != nil {
+			statslogutil.StatsLogger().Warn("Failed to flush the auto analyze decisions", zap.Error(err))
+		}
+	}()
 	parameters := exec.GetAutoAnalyzeParameters(sctx)
 	err := r.setAutoAnalysisTimeWindow(parameters)
 	if err != nil {
@@ -93,6 +100,12 @@ func (r *Refresher) AnalyzeHighestPriorityTables(sctx sessionctx.Context) bool {
 		return false
 	}
 	if !r.isWithinTimeWindow() {
+		// Only the most prior table is recorded, the others are still waiting for it.
+		if job, err := r.jobs.Peek(); err == nil {
+			decisions.Record(job.GetTableID(), priorityqueue.DecisionSkipped, priorityqueue.SkipReasonTimeWindow,
+				fmt.Sprintf("now is outside the auto analyze time window [%s, %s]",
+					parameters[vardef.TiDBAutoAnalyzeStartTime], parameters[vardef.TiDBAutoAnalyzeEndTime]))
+		}
 		return false
 	}
 	currentAutoAnalyzeRatio := exec.ParseAutoAnalyzeRatio(parameters[vardef.TiDBAutoAnalyzeRatio])
@@ -143,6 +156,8 @@ func (r *Refresher) AnalyzeHighestPriorityTables(sctx sessionctx.Context) bool {
 
 		if _, isRunning := currentRunningJobs[job.GetTableID()]; isRunning {
 			statslogutil.StatsLogger().Debug("Job already running, skipping", zap.Int64("tableID", job.GetTableID()))
+			decisions.Record(job.GetTableID(), priorityqueue.DecisionSkipped, priorityqueue.SkipReasonRunning,
+				"the table is being analyzed")
 			continue
 		}
 		if valid, failReason := job.ValidateAndPrepare(sctx); !valid {
@@ -151,10 +166,13 @@ func (r *Refresher) AnalyzeHighestPriorityTables(sctx sessionctx.Context) bool {
 				zap.String("reason", failReason),
 				zap.Stringer("job", job),
 			)
+			decisions.Record(job.GetTableID(), priorityqueue.DecisionSkipped,
+				priorityqueue.ValidationFailureReason(failReason), failReason)
 			continue
 		}
 
 		statslogutil.StatsLogger().Info("Auto analyze triggered", zap.Stringer("job", job))
+		decisions.Record(job.GetTableID(), priorityqueue.DecisionTriggered, "", job.String())
 
 		submitted := r.worker.SubmitJob(job)
 		intest.Assert(submitted, "Failed to submit job unexpectedly. "+
@@ -265,4 +283,5 @@ func (r *Refresher) OnRetireOwner() {
 	// Theoretically we should stop the worker here, but stopping analysis jobs can be time-consuming.
 	// To avoid blocking etcd leader re-election, we only close the priority queue.
 	r.jobs.Close()
+	r.jobs.Decisions().Reset()
 }
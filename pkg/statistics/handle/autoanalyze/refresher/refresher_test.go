@@ -16,7 +16,9 @@ package refresher_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/sessionctx"
@@ -479,6 +481,67 @@ func TestAnalyzeHighestPriorityTablesWithFailedAnalysis(t *testing.T) {
 	require.Equal(t, int64(0), tblStats2.ModifyCount)
 }
 
+func TestRecordAutoAnalyzeDecisions(t *testing.T) {
+	statistics.AutoAnalyzeMinCnt = 0
+	defer func() {
+		statistics.AutoAnalyzeMinCnt = 1000
+	}()
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	handle := dom.StatsHandle()
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_enable_auto_analyze=true")
+	tk.MustExec("set global tidb_auto_analyze_concurrency=2")
+	tk.MustExec("create table t1 (a int, b int, index idx(a)) partition by range (a) (partition p0 values less than (2), partition p1 values less than (4))")
+	testutil.HandleNextDDLEventWithTxn(handle)
+	tk.MustExec("create table t2 (a int, b int, index idx(a)) partition by range (a) (partition p0 values less than (2), partition p1 values less than (4))")
+	testutil.HandleNextDDLEventWithTxn(handle)
+	tk.MustExec("analyze table t2")
+	require.NoError(t, handle.DumpStatsDeltaToKV(true))
+	require.NoError(t, handle.Update(context.Background(), dom.InfoSchema()))
+	tk.MustExec("insert into t1 values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("insert into t2 values (1, 1), (2, 2), (3, 3)")
+	// Add a failed job to t1.
+	startTime := tk.MustQuery("select now() - interval 2 second").Rows()[0][0].(string)
+	insertFailedJobForPartitionWithStartTime(tk, "test", "t1", "p0", startTime)
+	require.NoError(t, handle.DumpStatsDeltaToKV(true))
+	require.NoError(t, handle.Update(context.Background(), dom.InfoSchema()))
+	sysProcTracker := dom.SysProcTracker()
+	r := refresher.NewRefresher(handle, sysProcTracker, dom.DDLNotifier())
+	defer r.Close()
+
+	require.NoError(t, util.CallWithSCtx(handle.SPool(), func(sctx sessionctx.Context) error {
+		require.True(t, r.AnalyzeHighestPriorityTables(sctx))
+		return nil
+	}))
+	r.WaitAutoAnalyzeFinishedForTest()
+	tk.MustQuery("select table_name, decision, reason from mysql.auto_analyze_decisions order by table_name").Check(testkit.Rows(
+		"t1 skipped failure_backoff",
+		"t2 triggered ",
+	))
+
+	// Skip the tables outside the time window.
+	tk.MustExec("insert into t2 values (1, 1), (2, 2), (3, 3)")
+	require.NoError(t, handle.DumpStatsDeltaToKV(true))
+	require.NoError(t, handle.Update(context.Background(), dom.InfoSchema()))
+	now := time.Now().UTC()
+	tk.MustExec(fmt.Sprintf("set global tidb_auto_analyze_start_time = '%s'", now.Add(2*time.Hour).Format("15:04 -0700")))
+	tk.MustExec(fmt.Sprintf("set global tidb_auto_analyze_end_time = '%s'", now.Add(3*time.Hour).Format("15:04 -0700")))
+	defer func() {
+		tk.MustExec("set global tidb_auto_analyze_start_time = default")
+		tk.MustExec("set global tidb_auto_analyze_end_time = default")
+	}()
+	r.ProcessDMLChangesForTest()
+	for range 2 {
+		require.NoError(t, util.CallWithSCtx(handle.SPool(), func(sctx sessionctx.Context) error {
+			require.False(t, r.AnalyzeHighestPriorityTables(sctx))
+			return nil
+		}))
+	}
+	// The consecutive skipped decisions with the same reason are recorded once.
+	tk.MustQuery("select count(*) from mysql.auto_analyze_decisions where reason = 'time_window'").Check(testkit.Rows("1"))
+}
+
 func insertFailedJobForPartitionWithStartTime(
 	tk *testkit.TestKit,
 	dbName string,
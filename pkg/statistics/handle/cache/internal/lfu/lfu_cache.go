@@ -35,9 +35,11 @@ type LFU struct {
 	// This is a secondary cache layer used to store all tables,
 	// including those that have been evicted from the primary cache.
 	resultKeySet *keySetShard
-	cost         atomic.Int64
-	closed       atomic.Bool
-	closeOnce    sync.Once
+	// onEvicted is called with the table ID after the table is evicted.
+	onEvicted func(tblID int64)
+	cost      atomic.Int64
+	closed    atomic.Bool
+	closeOnce sync.Once
 }
 
 // NewLFU creates a new LFU cache.
@@ -98,6 +100,13 @@ func (s *LFU) Get(tid int64) (*statistics.Table, bool) {
 
 // Put implements statsCacheInner
 func (s *LFU) Put(tblID int64, tbl *statistics.Table) bool {
+	if tbl.Pinned {
+		// The pinned table is only kept in the key set so that it's never evicted, and its memory usage is
+		// not counted in the cost of the cache. Delete the unpinned one from the cache if it exists.
+		s.resultKeySet.AddKeyValue(tblID, tbl)
+		s.cache.Del(tblID)
+		return true
+	}
 	cost := tbl.MemoryUsage().TotalTrackingMemUsage()
 	s.resultKeySet.AddKeyValue(tblID, tbl)
 	s.addCost(cost)
@@ -144,6 +153,15 @@ func (s *LFU) onEvict(item *ristretto.Item) {
 	}()
 	s.dropMemory(item)
 	metrics.EvictCounter.Inc()
+	if s.onEvicted != nil && item.Value != nil && !s.closed.Load() {
+		s.onEvicted(int64(item.Key))
+	}
+}
+
+// SetEvictCallback sets the function called with the table ID after the table is evicted. It should be called
+// before the cache is used.
+func (s *LFU) SetEvictCallback(f func(tblID int64)) {
+	s.onEvicted = f
 }
 
 func (s *LFU) dropMemory(item *ristretto.Item) {
@@ -92,6 +92,30 @@ func TestLFUPutTooBig(t *testing.T) {
 	require.Equal(t, uint64(lfu.Cost()), lfu.metrics().CostAdded()-lfu.metrics().CostEvicted())
 }
 
+func TestLFUPinnedTable(t *testing.T) {
+	lfu, err := NewLFU(1)
+	require.NoError(t, err)
+	mockTable := testutil.NewMockStatisticsTable(1, 1, true, false, false)
+	lfu.Put(int64(1), mockTable)
+	lfu.wait()
+	// The unpinned table is evicted since it's too big for the cache.
+	tbl, ok := lfu.Get(int64(1))
+	require.True(t, ok)
+	require.False(t, tbl.GetCol(1).IsFullLoad())
+
+	// The pinned table is never evicted, and it's not counted in the cost.
+	pinnedTable := testutil.NewMockStatisticsTable(1, 1, true, false, false)
+	pinnedTable.Pinned = true
+	lfu.Put(int64(1), pinnedTable)
+	lfu.wait()
+	tbl, ok = lfu.Get(int64(1))
+	require.True(t, ok)
+	require.True(t, tbl.GetCol(1).IsFullLoad())
+	require.True(t, tbl.GetIdx(1).IsFullLoad())
+	require.Equal(t, int64(0), lfu.Cost())
+	require.Equal(t, 1, lfu.Len())
+}
+
 func TestCacheLen(t *testing.T) {
 	capacity := int64(12)
 	lfu, err := NewLFU(capacity)
@@ -15,6 +15,7 @@
 package cache
 
 import (
+	"cmp"
 	"context"
 	"slices"
 	"strconv"
@@ -43,6 +44,8 @@ type StatsCacheImpl struct {
 	atomic.Pointer[StatsCache]
 
 	statsHandle types.StatsHandle
+
+	lastUpdatedTables atomic.Int64
 }
 
 // NewStatsCacheImpl creates a new StatsCache.
@@ -126,7 +129,7 @@ func (s *StatsCacheImpl) Update(ctx context.Context, is infoschema.InfoSchema, t
 		rows                      []chunk.Row
 		err                       error
 	)
-	if err := util.CallWithSCtx(s.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+	if err := util.CallWithSCtxAndLabels(util.StatsCtx, s.statsHandle.SPool(), util.StatsOperationCacheRefresh, 0, func(sctx sessionctx.Context) error {
 		query := "SELECT version, table_id, modify_count, count, snapshot from mysql.stats_meta where version > %? "
 		args := []any{lastVersion}
 
@@ -162,6 +165,7 @@ func (s *StatsCacheImpl) Update(ctx context.Context, is infoschema.InfoSchema, t
 		})
 	})
 
+	var updatedTables int64
 	for _, row := range rows {
 		version := row.GetUint64(0)
 		physicalID := row.GetInt64(1)
@@ -214,6 +218,10 @@ func (s *StatsCacheImpl) Update(ctx context.Context, is infoschema.InfoSchema, t
 		tbl.RealtimeCount = count
 		tbl.ModifyCount = modifyCount
 		tbl.TblInfoUpdateTS = tableInfo.UpdateTS
+		// The auto IDs are allocated for the whole table, so the progress can't be attributed to the partitions.
+		if tableInfo.GetPartitionInfo() == nil {
+			tbl.AutoIDBase = util.AutoIDBase(table)
+		}
 		// It only occurs in the following situations:
 		// 1. The table has already been analyzed,
 		//	but because the predicate columns feature is turned on, and it doesn't have any columns or indexes analyzed,
@@ -225,11 +233,21 @@ func (s *StatsCacheImpl) Update(ctx context.Context, is infoschema.InfoSchema, t
 			tbl.LastAnalyzeVersion = snapshot
 		}
 		tblToUpdateOrDelete.addToUpdate(tbl)
+		updatedTables++
 	}
 	tblToUpdateOrDelete.flush()
+	if !skipMoveForwardStatsCache {
+		s.lastUpdatedTables.Store(updatedTables)
+	}
 	return nil
 }
 
+// LastUpdatedTables returns the number of the tables and partitions whose stats are refreshed by the last Update of
+// all the tables.
+func (s *StatsCacheImpl) LastUpdatedTables() int64 {
+	return s.lastUpdatedTables.Load()
+}
+
 // GetNextCheckVersionWithOffset gets the last version with offset.
 func (s *StatsCacheImpl) GetNextCheckVersionWithOffset() uint64 {
 	// Get the greatest version of the stats meta table.
@@ -262,6 +280,7 @@ func (s *StatsCacheImpl) replace(newCache *StatsCache) {
 		old.Close()
 	}
 	metrics.CostGauge.Set(float64(newCache.Cost()))
+	s.trackMemory()
 }
 
 // UpdateStatsCache updates the cache with the new cache.
@@ -273,6 +292,7 @@ func (s *StatsCacheImpl) UpdateStatsCache(cacheUpdate types.CacheUpdate) {
 		newCache := s.Load().CopyAndUpdate(cacheUpdate.Updated, cacheUpdate.Deleted)
 		s.replace(newCache)
 	}
+	s.trackMemory()
 }
 
 // Close closes this cache.
@@ -307,6 +327,7 @@ func (s *StatsCacheImpl) Get(tableID int64) (*statistics.Table, bool) {
 // Put puts this table stats into the cache.
 func (s *StatsCacheImpl) Put(id int64, t *statistics.Table) {
 	s.Load().put(id, t)
+	s.trackMemory()
 }
 
 // TriggerEvict triggers the cache to evict some items.
@@ -314,6 +335,53 @@ func (s *StatsCacheImpl) TriggerEvict() {
 	s.Load().TriggerEvict()
 }
 
+// EvictTableStats evicts the stats of the table or the partition, or only the given columns of it, from the cache
+// without touching the storage.
+func (s *StatsCacheImpl) EvictTableStats(tableID int64, colIDs []int64) bool {
+	return s.Load().Evict(tableID, colIDs)
+}
+
+// Shrink evicts the stats of the largest tables from the cache to release about half of its memory, and returns the
+// bytes released. Only the histograms, TopN and sketches are dropped, so the tables are still in the cache with their
+// meta, and the evicted items are loaded again by the sync load on demand.
+func (s *StatsCacheImpl) Shrink() int64 {
+	type tableUsage struct {
+		id    int64
+		usage int64
+	}
+	tables := s.Values()
+	usages := make([]tableUsage, 0, len(tables))
+	for _, tbl := range tables {
+		usages = append(usages, tableUsage{id: tbl.PhysicalID, usage: tbl.MemoryUsage().TotalMemUsage})
+	}
+	slices.SortFunc(usages, func(a, b tableUsage) int {
+		return cmp.Compare(b.usage, a.usage)
+	})
+	target := s.MemConsumed() / 2
+	var released int64
+	for _, u := range usages {
+		if released >= target {
+			break
+		}
+		if s.EvictTableStats(u.id, nil) {
+			released += u.usage
+		}
+	}
+	s.trackMemory()
+	return released
+}
+
+// trackMemory reports the memory usage of the cache to util.StatsCacheMemTracker.
+func (s *StatsCacheImpl) trackMemory() {
+	util.StatsCacheMemTracker.ReplaceBytesUsed(s.MemConsumed())
+}
+
+// GetTableCacheUsage returns the load time and the eviction count of the stats of the table or the partition in the
+// cache.
+func (s *StatsCacheImpl) GetTableCacheUsage(tableID int64) (types.TableCacheUsage, bool) {
+	return s.Load().TableCacheUsage(tableID)
+}
+
 // MaxTableStatsVersion returns the version of the current cache, which is defined as
 // the max table stats version the cache has in its lifecycle.
 func (s *StatsCacheImpl) MaxTableStatsVersion() uint64 {
@@ -17,7 +17,9 @@ package cache
 import (
 	"testing"
 
+	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/handle/cache/internal/testutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -69,3 +71,52 @@ func TestCacheOfBatchUpdate(t *testing.T) {
 	require.Len(t, markedAsDeleted, 4)
 	require.Equal(t, int64(9), markedAsDeleted[3])
 }
+
+func TestUsageTracker(t *testing.T) {
+	tracker := newUsageTracker()
+	tbl := testutil.NewMockStatisticsTable(2, 1, true, true, true)
+	tbl.PhysicalID = 1
+	col1 := model.TableItemID{TableID: 1, ID: 1}
+	idx1 := model.TableItemID{TableID: 1, ID: 1, IsIndex: true}
+
+	tracker.recordLoad(tbl)
+	usage, ok := tracker.get(1)
+	require.True(t, ok)
+	require.False(t, usage.LoadTime.IsZero())
+	require.Len(t, usage.ItemLoadTimes, 3)
+	require.Zero(t, usage.EvictCount)
+	loadTime := usage.ItemLoadTimes[col1]
+
+	// The load time of the items isn't refreshed if their stats are unchanged.
+	tracker.recordLoad(tbl)
+	usage, ok = tracker.get(1)
+	require.True(t, ok)
+	require.Equal(t, loadTime, usage.ItemLoadTimes[col1])
+
+	tracker.recordEviction(1)
+	usage, ok = tracker.get(1)
+	require.True(t, ok)
+	require.Equal(t, int64(1), usage.EvictCount)
+	require.Equal(t, int64(1), usage.ItemEvictCounts[col1])
+	require.Equal(t, int64(1), usage.ItemEvictCounts[idx1])
+
+	// The evicted table is put back without the items, and then the items are loaded again.
+	evicted := tbl.Copy()
+	evicted.DropEvicted()
+	tracker.recordLoad(evicted)
+	tracker.recordEviction(1)
+	usage, ok = tracker.get(1)
+	require.True(t, ok)
+	require.Equal(t, int64(2), usage.EvictCount)
+	require.Equal(t, int64(1), usage.ItemEvictCounts[col1])
+	tracker.recordLoad(tbl)
+	tracker.recordEviction(1)
+	usage, ok = tracker.get(1)
+	require.True(t, ok)
+	require.Equal(t, int64(3), usage.EvictCount)
+	require.Equal(t, int64(2), usage.ItemEvictCounts[col1])
+
+	tracker.remove(1)
+	_, ok = tracker.get(1)
+	require.False(t, ok)
+}
@@ -19,12 +19,14 @@ import (
 	"time"
 
 	"github.com/pingcap/tidb/pkg/config"
+	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/handle/cache/internal"
 	"github.com/pingcap/tidb/pkg/statistics/handle/cache/internal/lfu"
 	"github.com/pingcap/tidb/pkg/statistics/handle/cache/internal/mapcache"
 	"github.com/pingcap/tidb/pkg/statistics/handle/cache/internal/metrics"
+	"github.com/pingcap/tidb/pkg/statistics/handle/types"
 	"github.com/pingcap/tidb/pkg/util/intest"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"go.uber.org/zap"
@@ -39,13 +41,17 @@ func NewStatsCache() (*StatsCache, error) {
 		if err != nil {
 			return nil, err
 		}
+		usage := newUsageTracker()
+		stats.SetEvictCallback(usage.recordEviction)
 		return &StatsCache{
-			c: stats,
+			c:     stats,
+			usage: usage,
 		}, nil
 	}
 
 	return &StatsCache{
-		c: mapcache.NewMapCache(),
+		c:     mapcache.NewMapCache(),
+		usage: newUsageTracker(),
 	}, nil
 }
 
@@ -53,6 +59,9 @@ func NewStatsCache() (*StatsCache, error) {
 // TODO: hide this structure or merge it into StatsCacheImpl.
 type StatsCache struct {
 	c internal.StatsCacheInner
+	// usage tracks the load time and the eviction count of the tables in the cache, it's shared by the copies of
+	// the cache.
+	usage *usageTracker
 	// the max table stats version the cache has in its lifecycle.
 	maxTblStatsVer atomic.Uint64
 }
@@ -86,6 +95,7 @@ func (sc *StatsCache) putCache(id int64, t *statistics.Table) bool {
 	intest.Assert(t.ColAndIdxExistenceMap != nil, "ColAndIdxExistenceMap should not be nil")
 	ok := sc.c.Put(id, t)
 	if ok {
+		sc.usage.recordLoad(t)
 		return ok
 	}
 	// TODO(hawkingrei): If necessary, add asynchronous retries
@@ -145,14 +155,16 @@ func (sc *StatsCache) Version() uint64 {
 
 // CopyAndUpdate copies a new cache and updates the new statistics table cache. It is only used in the COW mode.
 func (sc *StatsCache) CopyAndUpdate(tables []*statistics.Table, deletedIDs []int64) *StatsCache {
-	newCache := &StatsCache{c: sc.c.Copy()}
+	newCache := &StatsCache{c: sc.c.Copy(), usage: sc.usage}
 	newCache.maxTblStatsVer.Store(sc.maxTblStatsVer.Load())
 	for _, tbl := range tables {
 		id := tbl.PhysicalID
 		newCache.c.Put(id, tbl)
+		newCache.usage.recordLoad(tbl)
 	}
 	for _, id := range deletedIDs {
 		newCache.c.Del(id)
+		newCache.usage.remove(id)
 	}
 
 	// update the maxTblStatsVer
@@ -170,10 +182,12 @@ func (sc *StatsCache) Update(tables []*statistics.Table, deletedIDs []int64, ski
 		id := tbl.PhysicalID
 		metrics.UpdateCounter.Inc()
 		sc.c.Put(id, tbl)
+		sc.usage.recordLoad(tbl)
 	}
 	for _, id := range deletedIDs {
 		metrics.DelCounter.Inc()
 		sc.c.Del(id)
+		sc.usage.remove(id)
 	}
 
 	if !skipMoveForwardStatsCache {
@@ -186,6 +200,46 @@ func (sc *StatsCache) Update(tables []*statistics.Table, deletedIDs []int64, ski
 	}
 }
 
+// TableCacheUsage returns the load time and the eviction count of the table in the cache.
+func (sc *StatsCache) TableCacheUsage(id int64) (types.TableCacheUsage, bool) {
+	return sc.usage.get(id)
+}
+
+// Evict drops the histograms, the TopN and the CM sketches of the table, or only the given columns of it, from
+// the cache. The stats in the storage are untouched, they will be loaded again when they are needed.
+func (sc *StatsCache) Evict(id int64, colIDs []int64) bool {
+	tbl, ok := sc.c.Get(id)
+	if !ok {
+		return false
+	}
+	tbl = tbl.Copy()
+	if len(colIDs) == 0 {
+		tbl.DropEvicted()
+		if !sc.c.Put(id, tbl) {
+			return false
+		}
+		sc.usage.recordEviction(id)
+		return true
+	}
+	evicted := make([]model.TableItemID, 0, len(colIDs))
+	for _, colID := range colIDs {
+		col := tbl.GetCol(colID)
+		if col == nil || !col.IsStatsInitialized() || col.IsAllEvicted() {
+			continue
+		}
+		col.DropUnnecessaryData()
+		evicted = append(evicted, model.TableItemID{TableID: id, ID: colID})
+	}
+	if len(evicted) == 0 {
+		return false
+	}
+	if !sc.c.Put(id, tbl) {
+		return false
+	}
+	sc.usage.recordItemsEviction(id, evicted)
+	return true
+}
+
 // TriggerEvict triggers the cache to evict some items.
 func (sc *StatsCache) TriggerEvict() {
 	sc.c.TriggerEvict()
@@ -0,0 +1,152 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/handle/types"
+)
+
+type itemUsage struct {
+	loadTime   time.Time
+	version    uint64
+	evictCount int64
+	loaded     bool
+}
+
+type tableUsage struct {
+	loadTime   time.Time
+	items      map[model.TableItemID]*itemUsage
+	evictCount int64
+}
+
+// usageTracker tracks the load time and the eviction count of the tables and their columns and indexes in the
+// stats cache.
+// Note: This struct is thread-safe.
+type usageTracker struct {
+	tables map[int64]*tableUsage
+	mu     sync.Mutex
+}
+
+func newUsageTracker() *usageTracker {
+	return &usageTracker{tables: make(map[int64]*tableUsage)}
+}
+
+// recordLoad records that the table is put into the cache. The load time of a column or an index is only refreshed
+// when it becomes fully loaded or its stats are updated.
+func (u *usageTracker) recordLoad(tbl *statistics.Table) {
+	now := time.Now()
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	usage, ok := u.tables[tbl.PhysicalID]
+	if !ok {
+		usage = &tableUsage{}
+		u.tables[tbl.PhysicalID] = usage
+	}
+	usage.loadTime = now
+	// Rebuild the items so that the dropped columns and indexes are forgotten.
+	items := make(map[model.TableItemID]*itemUsage, tbl.ColNum()+tbl.IdxNum())
+	record := func(id model.TableItemID, loaded bool, version uint64) {
+		item, ok := usage.items[id]
+		if !ok {
+			item = &itemUsage{}
+		}
+		if loaded && (!item.loaded || item.version != version) {
+			item.loadTime = now
+			item.version = version
+		}
+		item.loaded = loaded
+		items[id] = item
+	}
+	tbl.ForEachColumnImmutable(func(id int64, col *statistics.Column) bool {
+		record(model.TableItemID{TableID: tbl.PhysicalID, ID: id}, col.IsFullLoad(), col.LastUpdateVersion)
+		return false
+	})
+	tbl.ForEachIndexImmutable(func(id int64, idx *statistics.Index) bool {
+		record(model.TableItemID{TableID: tbl.PhysicalID, ID: id, IsIndex: true}, idx.IsFullLoad(), idx.LastUpdateVersion)
+		return false
+	})
+	usage.items = items
+}
+
+// recordEviction records that the table is evicted from the cache, the loaded columns and indexes are evicted
+// along with it.
+func (u *usageTracker) recordEviction(tableID int64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	usage, ok := u.tables[tableID]
+	if !ok {
+		return
+	}
+	usage.evictCount++
+	for _, item := range usage.items {
+		if item.loaded {
+			item.evictCount++
+			item.loaded = false
+		}
+	}
+}
+
+// recordItemsEviction records that the given columns or indexes of the table are evicted from the cache.
+func (u *usageTracker) recordItemsEviction(tableID int64, ids []model.TableItemID) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	usage, ok := u.tables[tableID]
+	if !ok {
+		return
+	}
+	for _, id := range ids {
+		if item, ok := usage.items[id]; ok && item.loaded {
+			item.evictCount++
+			item.loaded = false
+		}
+	}
+}
+
+// remove forgets the table deleted from the cache.
+func (u *usageTracker) remove(tableID int64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	delete(u.tables, tableID)
+}
+
+// get returns the usage of the table.
+func (u *usageTracker) get(tableID int64) (types.TableCacheUsage, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	usage, ok := u.tables[tableID]
+	if !ok {
+		return types.TableCacheUsage{}, false
+	}
+	result := types.TableCacheUsage{
+		LoadTime:        usage.loadTime,
+		ItemLoadTimes:   make(map[model.TableItemID]time.Time, len(usage.items)),
+		ItemEvictCounts: make(map[model.TableItemID]int64, len(usage.items)),
+		EvictCount:      usage.evictCount,
+	}
+	for id, item := range usage.items {
+		if !item.loadTime.IsZero() {
+			result.ItemLoadTimes[id] = item.loadTime
+		}
+		if item.evictCount > 0 {
+			result.ItemEvictCounts[id] = item.evictCount
+		}
+	}
+	return result, true
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package changeevent records the changes of the stats in mysql.stats_change_events when
+// tidb_enable_stats_change_events is on. The table is an append-only event stream ordered by the stats version, so
+// TiCDC can replicate it, and the downstream TiDB replicas or the external optimizer services can tail it to know
+// which tables' stats to synchronize from the upstream cluster.
+package changeevent
+
+import (
+	"slices"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+)
+
+const (
+	// EventUpdate means the stats of the table are updated, e.g. by analyze, import or load stats.
+	EventUpdate = "update"
+	// EventDrop means the stats of the table are dropped.
+	EventDrop = "drop"
+	// EventLock means the stats of the table, or of some columns of it, are locked.
+	EventLock = "lock"
+	// EventUnlock means the stats of the table, or of some columns of it, are unlocked.
+	EventUnlock = "unlock"
+
+	// SourceDropStats is the source of the events of DROP STATS.
+	SourceDropStats = "drop stats"
+	// SourceGC is the source of the events of the stats removed by the GC of the dropped tables.
+	SourceGC = "gc"
+
+	insertEventSQL = "INSERT INTO mysql.stats_change_events (table_id, event, source, version) VALUES (%?, %?, %?, %?)"
+)
+
+// Enabled returns whether the stats change events are recorded.
+func Enabled() bool {
+	return vardef.EnableStatsChangeEvents.Load()
+}
+
+// Record records the event of the given tables with the version of the stats change. The tables are recorded in
+// ascending order of the ids and only once, so the events of a change are stable. It does nothing if the stats change
+// events are disabled.
+func Record(sctx sessionctx.Context, event, source string, version uint64, tableIDs ...int64) error {
+	if !Enabled() || version == 0 || len(tableIDs) == 0 {
+		return nil
+	}
+	ids := slices.Clone(tableIDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+	for _, id := range ids {
+		if _, err := util.Exec(sctx, insertEventSQL, id, event, source, version); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
@@ -45,7 +45,7 @@ func NewDDLHandler(
 		ddlEventCh:   make(chan *notifier.SchemaChangeEvent, 1000),
 		statsWriter:  statsWriter,
 		statsHandler: statsHandler,
-		sub:          newSubscriber(statsHandler),
+		sub:          newSubscriber(),
 	}
 }
 
@@ -64,6 +64,11 @@ func (h *ddlHandlerImpl) HandleDDLEvent(ctx context.Context, sctx sessionctx.Con
 	return err
 }
 
+// RegisterDDLEventHandlers registers the handlers of the ddl events.
+func (h *ddlHandlerImpl) RegisterDDLEventHandlers(handlers *types.DDLEventHandlers) {
+	h.sub.registry.register(handlers)
+}
+
 // DDLEventCh returns ddl events channel in handle.
 func (h *ddlHandlerImpl) DDLEventCh() chan *notifier.SchemaChangeEvent {
 	return h.ddlEventCh
@@ -18,20 +18,26 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb/pkg/ddl/notifier"
+	ddlutil "github.com/pingcap/tidb/pkg/ddl/util"
+	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/planner/cardinality"
+	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/statistics/handle/ddl"
 	statstestutil "github.com/pingcap/tidb/pkg/statistics/handle/ddl/testutil"
 	"github.com/pingcap/tidb/pkg/statistics/handle/storage"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
 	statsutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/mock"
 	"github.com/stretchr/testify/require"
+	tikvutil "github.com/tikv/client-go/v2/util"
 )
 
 func TestDDLAfterLoad(t *testing.T) {
@@ -210,6 +216,192 @@ func TestTruncateTable(t *testing.T) {
 	require.NotEqual(t, version, rows[0][0].(string))
 }
 
+func TestCarryForwardStatsForTruncateTable(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, b int, index ia(a))")
+	h := do.StatsHandle()
+	testKit.MustExec("insert into t values (1, 1), (1, 2), (1, 3), (1, 4), (2, 5), (3, 6), (4, 7), (5, 8), (6, 9), (7, 10)")
+	testKit.MustExec("analyze table t all columns with 1 topn, 2 buckets")
+
+	getTableID := func() int64 {
+		tbl, err := do.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+		require.NoError(t, err)
+		return tbl.Meta().ID
+	}
+	oldTableID := getTableID()
+
+	// The stats of the truncated table are scaled by the refill factor.
+	testKit.MustExec("set @@session.tidb_truncate_table_stats_refill_factor = 0.5")
+	testKit.MustExec("truncate table t")
+	truncateTableEvent := findEvent(h.DDLEventCh(), model.ActionTruncateTable)
+	require.Equal(t, 0.5, truncateTableEvent.GetTruncateTableStatsRefillFactor())
+	require.NoError(t, statstestutil.HandleDDLEventWithTxn(h, truncateTableEvent))
+	newTableID := getTableID()
+	testKit.MustQuery("select count, modify_count from mysql.stats_meta where table_id = ?", newTableID).Check(testkit.Rows("5 0"))
+	testKit.MustQuery("select is_index, hist_id, stats_ver from mysql.stats_histograms where table_id = ? order by is_index, hist_id", newTableID).Check(
+		testKit.MustQuery("select is_index, hist_id, stats_ver from mysql.stats_histograms where table_id = ? order by is_index, hist_id", oldTableID).Rows())
+	testKit.MustQuery("select count from mysql.stats_top_n where table_id = ? and is_index = 0 and hist_id = 1", newTableID).Check(testkit.Rows("2"))
+	require.NoError(t, h.Update(context.Background(), do.InfoSchema()))
+	testKit.MustQuery("explain format = 'brief' select * from t where a = 1").CheckAt([]int{1}, testkit.Rows("2.00", "2.00", "5.00"))
+
+	// The stats are not kept without the refill factor.
+	testKit.MustExec("set @@session.tidb_truncate_table_stats_refill_factor = default")
+	testKit.MustExec("truncate table t")
+	truncateTableEvent = findEvent(h.DDLEventCh(), model.ActionTruncateTable)
+	require.NoError(t, statstestutil.HandleDDLEventWithTxn(h, truncateTableEvent))
+	newTableID = getTableID()
+	testKit.MustQuery("select count from mysql.stats_meta where table_id = ?", newTableID).Check(testkit.Rows("0"))
+	testKit.MustQuery("select count(*) from mysql.stats_histograms where table_id = ? and stats_ver > 0", newTableID).Check(testkit.Rows("0"))
+}
+
+func TestRenameTableAcrossDatabases(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("create database test2")
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t1 (a int, index ia(a))")
+	testKit.MustExec("create table t2 (a int)")
+	h := do.StatsHandle()
+	testKit.MustExec("insert into t1 values (1), (2), (3)")
+	testKit.MustExec("insert into t2 values (1)")
+	testKit.MustExec("analyze table t1, t2")
+	testKit.MustQuery("select table_schema, table_name from mysql.analyze_jobs group by table_schema, table_name order by table_name").Check(
+		testkit.Rows("test t1", "test t2"))
+
+	getTableID := func(db, tbl string) int64 {
+		tblInfo, err := do.InfoSchema().TableByName(context.Background(), ast.NewCIStr(db), ast.NewCIStr(tbl))
+		require.NoError(t, err)
+		return tblInfo.Meta().ID
+	}
+	tableID := getTableID("test", "t1")
+
+	// The analyze jobs are moved along with the renamed table.
+	testKit.MustExec("rename table test.t1 to test2.T3")
+	renameTableEvent := findEvent(h.DDLEventCh(), model.ActionRenameTable)
+	tblInfo, oldSchemaName, newSchemaName, oldTableName := renameTableEvent.GetRenameTableInfo()
+	require.Equal(t, tableID, tblInfo.ID)
+	require.Equal(t, "test", oldSchemaName)
+	require.Equal(t, "test2", newSchemaName)
+	require.Equal(t, "t1", oldTableName)
+	require.NoError(t, statstestutil.HandleDDLEventWithTxn(h, renameTableEvent))
+	testKit.MustQuery("select table_schema, table_name from mysql.analyze_jobs group by table_schema, table_name order by table_name").Check(
+		testkit.Rows("test2 T3", "test t2"))
+
+	// Each table of RENAME TABLES has its own event.
+	testKit.MustExec("rename table test2.T3 to test.t1, test.t2 to test2.t4")
+	for range 2 {
+		renameTableEvent = findEvent(h.DDLEventCh(), model.ActionRenameTable)
+		require.NoError(t, statstestutil.HandleDDLEventWithTxn(h, renameTableEvent))
+	}
+	testKit.MustQuery("select table_schema, table_name from mysql.analyze_jobs group by table_schema, table_name order by table_name").Check(
+		testkit.Rows("test t1", "test2 t4"))
+
+	// The stats are still keyed by the same table ID.
+	require.Equal(t, tableID, getTableID("test", "t1"))
+	require.NoError(t, h.Update(context.Background(), do.InfoSchema()))
+	testKit.MustQuery("explain format = 'brief' select * from test.t1 where a = 1").CheckAt([]int{1}, testkit.Rows("1.00", "1.00"))
+}
+
+func TestRestoreStatsForRecoverTable(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	// Disable the emulator GC, otherwise the data of the dropped table is deleted at once.
+	originGC := ddlutil.IsEmulatorGCEnable()
+	ddlutil.EmulatorGCDisable()
+	defer func() {
+		if originGC {
+			ddlutil.EmulatorGCEnable()
+		}
+	}()
+	testKit.MustExec(fmt.Sprintf(`insert high_priority into mysql.tidb values ('tikv_gc_safe_point', '%[1]s', '')
+		on duplicate key update variable_value = '%[1]s'`, time.Now().Add(-48*time.Hour).Format(tikvutil.GCTimeFormat)))
+	testKit.MustExec("set global tidb_enable_historical_stats = 1")
+	defer testKit.MustExec("set global tidb_enable_historical_stats = default")
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, index ia(a))")
+	h := do.StatsHandle()
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec("insert into t values (1), (1), (2), (3)")
+	testKit.MustExec("analyze table t")
+	tbl, err := do.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tableID := tbl.Meta().ID
+	require.NoError(t, do.GetHistoricalStatsWorker().DumpHistoricalStats(tableID, h))
+
+	recoverTable := func() {
+		testKit.MustExec("flashback table t")
+		recoverTableEvent := findEvent(h.DDLEventCh(), model.ActionRecoverTable)
+		recoveredTableInfo, _ := recoverTableEvent.GetRecoverTableInfo()
+		require.Equal(t, tableID, recoveredTableInfo.ID)
+		require.NoError(t, statstestutil.HandleDDLEventWithTxn(h, recoverTableEvent))
+		require.NoError(t, h.Update(context.Background(), do.InfoSchema()))
+		testKit.MustQuery("select count from mysql.stats_meta where table_id = ?", tableID).Check(testkit.Rows("4"))
+		testKit.MustQuery("explain format = 'brief' select * from t where a = 1").CheckAt([]int{1}, testkit.Rows("2.00", "2.00"))
+	}
+
+	// The stats which have not been removed by GC are kept.
+	testKit.MustExec("drop table t")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	recoverTable()
+
+	// The stats removed by GC are restored from the historical stats.
+	testKit.MustExec("drop table t")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	require.NoError(t, h.GCStats(do.InfoSchema(), 0))
+	testKit.MustQuery("select count(*) from mysql.stats_histograms where table_id = ?", tableID).Check(testkit.Rows("0"))
+	recoverTable()
+}
+
+func TestCreateTableLikeWithStatistics(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, b int, index ia(a)) partition by range (b) (partition p0 values less than (6), partition p1 values less than (11))")
+	h := do.StatsHandle()
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	testKit.MustExec("insert into t values (1, 1), (1, 2), (1, 3), (1, 4), (2, 5), (3, 6), (4, 7), (5, 8), (6, 9), (7, 10)")
+	testKit.MustExec("analyze table t all columns")
+
+	getTableInfo := func(name string) *model.TableInfo {
+		tbl, err := do.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr(name))
+		require.NoError(t, err)
+		return tbl.Meta()
+	}
+	referInfo := getTableInfo("t")
+
+	// The stats of the table and all its partitions are copied to the new table.
+	testKit.MustExec("create table t2 like t with statistics")
+	createTableEvent := findEvent(h.DDLEventCh(), model.ActionCreateTable)
+	require.Equal(t, referInfo.ID, createTableEvent.GetCreateTableStatsReferTableID())
+	require.NoError(t, statstestutil.HandleDDLEventWithTxn(h, createTableEvent))
+	newInfo := getTableInfo("t2")
+	referIDs := []int64{referInfo.ID, referInfo.Partition.Definitions[0].ID, referInfo.Partition.Definitions[1].ID}
+	newIDs := []int64{newInfo.ID, newInfo.Partition.Definitions[0].ID, newInfo.Partition.Definitions[1].ID}
+	for i := range newIDs {
+		testKit.MustQuery("select count, modify_count from mysql.stats_meta where table_id = ?", newIDs[i]).Check(
+			testKit.MustQuery("select count, 0 from mysql.stats_meta where table_id = ?", referIDs[i]).Rows())
+		testKit.MustQuery("select is_index, hist_id, distinct_count, stats_ver from mysql.stats_histograms where table_id = ? order by is_index, hist_id", newIDs[i]).Check(
+			testKit.MustQuery("select is_index, hist_id, distinct_count, stats_ver from mysql.stats_histograms where table_id = ? order by is_index, hist_id", referIDs[i]).Rows())
+	}
+	require.NoError(t, h.Update(context.Background(), do.InfoSchema()))
+	testKit.MustQuery("explain format = 'brief' select * from t2 where a = 1").CheckAt([]int{1}, testkit.Rows("4.00", "4.00", "10.00"))
+
+	// The stats are not copied without WITH STATISTICS.
+	testKit.MustExec("create table t3 like t")
+	createTableEvent = findEvent(h.DDLEventCh(), model.ActionCreateTable)
+	require.Zero(t, createTableEvent.GetCreateTableStatsReferTableID())
+	require.NoError(t, statstestutil.HandleDDLEventWithTxn(h, createTableEvent))
+	testKit.MustQuery("select count(*) from mysql.stats_histograms where table_id = ? and stats_ver > 0", getTableInfo("t3").ID).Check(testkit.Rows("0"))
+
+	// Temporary tables have no stats.
+	testKit.MustExec("create table t4 (a int)")
+	testKit.MustGetErrCode("create temporary table t5 like t4 with statistics", errno.ErrOptOnTemporaryTable)
+	testKit.MustGetErrCode("create global temporary table t5 like t4 with statistics on commit delete rows", errno.ErrOptOnTemporaryTable)
+}
+
 func TestTruncateAPartitionedTable(t *testing.T) {
 	store, do := testkit.CreateMockStoreAndDomain(t)
 	testKit := testkit.NewTestKit(t, store)
@@ -393,6 +585,166 @@ func TestDDLHistogram(t *testing.T) {
 	rs.Check(testkit.Rows("2"))
 }
 
+func TestSeedStatsForAddedColumn(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	h := do.StatsHandle()
+
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, b int)")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 7), (8, 8)")
+	testKit.MustExec("analyze table t all columns")
+
+	testKit.MustExec("alter table t add column c int default 5")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec("alter table t add column d varchar(10) collate utf8mb4_general_ci default 'Abc'")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	is := do.InfoSchema()
+	require.Nil(t, h.Update(context.Background(), is))
+	tbl, err := is.TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tableInfo := tbl.Meta()
+
+	// The default values are saved as the only TopN of the new columns.
+	testKit.MustQuery(fmt.Sprintf("select hist_id, distinct_count, stats_ver from mysql.stats_histograms where table_id = %d and hist_id > 2 order by hist_id", tableInfo.ID)).Check(
+		testkit.Rows("3 1 2", "4 1 2"),
+	)
+	testKit.MustQuery(fmt.Sprintf("select hist_id, count from mysql.stats_top_n where table_id = %d and hist_id > 2 order by hist_id", tableInfo.ID)).Check(
+		testkit.Rows("3 8", "4 8"),
+	)
+	testKit.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_buckets where table_id = %d and hist_id > 2", tableInfo.ID)).Check(
+		testkit.Rows("0"),
+	)
+	statsTbl := h.GetTableStats(tableInfo)
+	require.False(t, statsTbl.Pseudo)
+	require.True(t, statsTbl.GetCol(tableInfo.Columns[2].ID).IsAnalyzed())
+
+	// The predicates on the new columns are estimated by the TopN.
+	testKit.MustQuery("explain format = 'brief' select * from t where c = 5").CheckAt([]int{1}, testkit.Rows("8.00", "8.00", "8.00"))
+	testKit.MustQuery("explain format = 'brief' select * from t where d = 'abc'").CheckAt([]int{1}, testkit.Rows("8.00", "8.00", "8.00"))
+
+	// The stats of the table which is not analyzed with stats version 2 are not changed.
+	testKit.MustExec("create table t1 (a int)")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec("insert into t1 values (1), (2)")
+	testKit.MustExec("set @@tidb_analyze_version = 1")
+	testKit.MustExec("analyze table t1")
+	testKit.MustExec("alter table t1 add column c int default 5")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	tbl, err = do.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t1"))
+	require.NoError(t, err)
+	testKit.MustQuery(fmt.Sprintf("select stats_ver from mysql.stats_histograms where table_id = %d and hist_id = 2", tbl.Meta().ID)).Check(
+		testkit.Rows("0"),
+	)
+	testKit.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_buckets where table_id = %d and hist_id = 2", tbl.Meta().ID)).Check(
+		testkit.Rows("1"),
+	)
+}
+
+func TestTransformStatsForModifyColumn(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	h := do.StatsHandle()
+
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int unsigned, b float, c date, d int)")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec(`insert into t values
+		(1, 1.5, '2024-01-01', 1), (1, 1.5, '2024-01-01', 1), (1, 1.5, '2024-01-01', 1), (2, 2.5, '2024-01-02', 2),
+		(3, 3.5, '2024-01-03', 3), (4, 4.5, '2024-01-04', 4), (5, 5.5, '2024-01-05', 5), (6, 6.5, '2024-01-06', 6)`)
+	testKit.MustExec("analyze table t all columns with 1 topn, 2 buckets")
+
+	getColumnID := func(name string) int64 {
+		tbl, err := do.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+		require.NoError(t, err)
+		return model.FindColumnInfo(tbl.Meta().Columns, name).ID
+	}
+	checkStats := func(colID int64, topN, statsVer string) {
+		testKit.MustQuery("select count(*) from mysql.stats_top_n where table_id = (select tidb_table_id from information_schema.tables where table_name = 't') and is_index = 0 and hist_id = ?", colID).Check(testkit.Rows(topN))
+		testKit.MustQuery("select stats_ver from mysql.stats_histograms where table_id = (select tidb_table_id from information_schema.tables where table_name = 't') and is_index = 0 and hist_id = ?", colID).Check(testkit.Rows(statsVer))
+	}
+	for _, ddl := range []string{
+		"alter table t modify column a bigint",
+		"alter table t modify column b double",
+		"alter table t modify column c datetime",
+	} {
+		testKit.MustExec(ddl)
+		require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	}
+	require.NoError(t, h.Update(context.Background(), do.InfoSchema()))
+	for _, col := range []string{"a", "b", "c"} {
+		checkStats(getColumnID(col), "1", "2")
+	}
+	// The transformed TopN and histogram are used to estimate the predicates on the new columns.
+	for _, cond := range []string{"a = 1", "b = 1.5", "c = '2024-01-01 00:00:00'"} {
+		testKit.MustQuery("explain format = 'brief' select * from t where "+cond).CheckAt([]int{1}, testkit.Rows("3.00", "3.00", "8.00"))
+	}
+	for _, cond := range []string{"a > 1", "b > 1.5", "c > '2024-01-01'"} {
+		testKit.MustQuery("explain format = 'brief' select * from t where "+cond).CheckAt([]int{1}, testkit.Rows("5.00", "5.00", "8.00"))
+	}
+
+	// The stats are not transformed for the lossy type change.
+	testKit.MustExec("alter table t modify column d varchar(10)")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	checkStats(getColumnID("d"), "0", "0")
+}
+
+func TestRetainDroppedIndexStats(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	h := do.StatsHandle()
+
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, b int, index ia(a), index iab(a, b))")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec("insert into t values (1, 1), (1, 2), (2, 3), (3, 4), (4, 5)")
+	testKit.MustExec("analyze table t")
+
+	getIndexID := func(name string) int64 {
+		tbl, err := do.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+		require.NoError(t, err)
+		return tbl.Meta().FindIndexByName(name).ID
+	}
+	countIndexStats := func(idxID int64) string {
+		rows := testKit.MustQuery("select count(*) from mysql.stats_histograms where is_index = 1 and hist_id = ?", idxID).Rows()
+		return rows[0][0].(string)
+	}
+
+	iaID, iabID := getIndexID("ia"), getIndexID("iab")
+	testKit.MustExec("alter table t drop index ia")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustQuery("select index_id, index_name from mysql.stats_index_tombstone").Check(testkit.Rows(fmt.Sprintf("%d ia", iaID)))
+	// The stats of the tombstoned index are not deleted by GC.
+	require.NoError(t, h.GCStats(do.InfoSchema(), 0))
+	require.Equal(t, "1", countIndexStats(iaID))
+
+	// The stats are restored for the index with the same columns.
+	testKit.MustExec("alter table t add index ia2(a)")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	ia2ID := getIndexID("ia2")
+	require.Equal(t, "0", countIndexStats(iaID))
+	require.Equal(t, "1", countIndexStats(ia2ID))
+	testKit.MustQuery("select count(*) from mysql.stats_index_tombstone").Check(testkit.Rows("0"))
+	require.NoError(t, h.Update(context.Background(), do.InfoSchema()))
+	testKit.MustQuery("show stats_histograms where table_name = 't' and column_name = 'ia2'").CheckAt([]int{6, 7}, testkit.Rows("4 0"))
+
+	// The index with the different columns doesn't restore the stats.
+	testKit.MustExec("alter table t drop index iab")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec("alter table t add index iba(b, a)")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	require.Equal(t, "0", countIndexStats(getIndexID("iba")))
+	require.Equal(t, "1", countIndexStats(iabID))
+
+	// The stats are deleted by GC after the retention period.
+	testKit.MustExec("set @@global.tidb_dropped_index_stats_retention = '0s'")
+	defer testKit.MustExec("set @@global.tidb_dropped_index_stats_retention = default")
+	require.NoError(t, h.GCStats(do.InfoSchema(), 0))
+	require.Equal(t, "0", countIndexStats(iabID))
+	testKit.MustQuery("select count(*) from mysql.stats_index_tombstone").Check(testkit.Rows("0"))
+}
+
 func TestDDLPartition(t *testing.T) {
 	store, do := testkit.CreateMockStoreAndDomain(t)
 	testKit := testkit.NewTestKit(t, store)
@@ -461,6 +813,63 @@ PARTITION BY RANGE ( a ) (
 	}
 }
 
+func TestSeedStatsForAddedPartition(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	h := do.StatsHandle()
+	testKit.MustExec("set global tidb_enable_new_partition_stats_seeding = on")
+	defer testKit.MustExec("set global tidb_enable_new_partition_stats_seeding = off")
+	testKit.MustExec("use test")
+	testKit.MustExec(`create table t (a int, b int, index idx(b))
+partition by range (a) (
+	partition p0 values less than (10),
+	partition p1 values less than (20)
+)`)
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec("insert into t values (1,1),(11,1),(12,2),(13,3),(14,4)")
+	testKit.MustExec("analyze table t")
+
+	testKit.MustExec("alter table t add partition (partition p2 values less than (30))")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	is := do.InfoSchema()
+	require.Nil(t, h.Update(context.Background(), is))
+	tbl, err := is.TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tableInfo := tbl.Meta()
+	pi := tableInfo.GetPartitionInfo()
+	p1Stats := h.GetPartitionStats(tableInfo, pi.Definitions[1].ID)
+	p2Stats := h.GetPartitionStats(tableInfo, pi.Definitions[2].ID)
+	require.False(t, p2Stats.Pseudo)
+	// The new partition is seeded with the data distribution of p1, but it is still empty and not analyzed.
+	require.False(t, p2Stats.IsAnalyzed())
+	require.Equal(t, int64(0), p2Stats.RealtimeCount)
+	require.True(t, p2Stats.GetCol(tableInfo.Columns[1].ID).IsStatsInitialized())
+	require.Equal(t, p1Stats.GetCol(tableInfo.Columns[1].ID).NDV, p2Stats.GetCol(tableInfo.Columns[1].ID).NDV)
+	require.True(t, p2Stats.GetIdx(tableInfo.Indices[0].ID).IsStatsInitialized())
+	// The seed is saved in the storage as not analyzed, so it is taken the same by the other instances.
+	testKit.MustQuery(fmt.Sprintf("select count, snapshot from mysql.stats_meta where table_id = %d", pi.Definitions[2].ID)).Check(
+		testkit.Rows("0 0"))
+	testKit.MustQuery(fmt.Sprintf("select count(*) > 0, max(version) from mysql.stats_histograms where table_id = %d", pi.Definitions[2].ID)).Check(
+		testkit.Rows("1 0"))
+	testKit.MustQuery(fmt.Sprintf("select count(*) > 0 from mysql.stats_top_n where table_id = %d", pi.Definitions[2].ID)).Check(
+		testkit.Rows("1"))
+
+	// The DML changes are applied on top of the seed.
+	testKit.MustExec("insert into t values (21,1),(22,2)")
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.Nil(t, h.Update(context.Background(), is))
+	p2Stats = h.GetPartitionStats(tableInfo, pi.Definitions[2].ID)
+	require.Equal(t, int64(2), p2Stats.RealtimeCount)
+	require.False(t, p2Stats.IsAnalyzed())
+	require.True(t, p2Stats.GetCol(tableInfo.Columns[1].ID).IsStatsInitialized())
+
+	// Analyzing the new partition replaces the seed.
+	testKit.MustExec("analyze table t partition p2")
+	p2Stats = h.GetPartitionStats(tableInfo, pi.Definitions[2].ID)
+	require.Equal(t, int64(2), p2Stats.RealtimeCount)
+	require.Equal(t, int64(2), p2Stats.GetCol(tableInfo.Columns[1].ID).NDV)
+}
+
 func TestReorgPartitions(t *testing.T) {
 	store, do := testkit.CreateMockStoreAndDomain(t)
 	testKit := testkit.NewTestKit(t, store)
@@ -1421,3 +1830,103 @@ func TestDumpStatsDeltaBeforeHandleAddColumnEvent(t *testing.T) {
 	err := statstestutil.HandleDDLEventWithTxn(do.StatsHandle(), event)
 	require.NoError(t, err)
 }
+
+func TestRegisterDDLEventHandlers(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, b int)")
+	h := do.StatsHandle()
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+
+	var calls []string
+	h.RegisterDDLEventHandlers(&statstypes.DDLEventHandlers{
+		Name: "test",
+		OnAddColumn: func(_ context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, addedCols []*model.ColumnInfo) error {
+			// The stats of the added column are inserted before the registered handlers are called.
+			rows, _, err := statsutil.ExecRows(sctx, "select count(*) from mysql.stats_histograms where table_id = %? and hist_id = %?", tblInfo.ID, addedCols[0].ID)
+			require.NoError(t, err)
+			require.Equal(t, int64(1), rows[0].GetInt64(0))
+			calls = append(calls, "add column "+addedCols[0].Name.L)
+			return nil
+		},
+		OnAddIndex: func(_ context.Context, _ sessionctx.Context, _ *model.TableInfo, addedIdxs []*model.IndexInfo) error {
+			calls = append(calls, "add index "+addedIdxs[0].Name.L)
+			return nil
+		},
+		OnRenameTable: func(_ context.Context, _ sessionctx.Context, tblInfo *model.TableInfo, _, _, oldTableName string) error {
+			calls = append(calls, "rename table "+oldTableName+" to "+tblInfo.Name.L)
+			return nil
+		},
+		OnDropTable: func(_ context.Context, _ sessionctx.Context, droppedTblInfo *model.TableInfo) error {
+			calls = append(calls, "drop table "+droppedTblInfo.Name.L)
+			return nil
+		},
+	})
+
+	testKit.MustExec("alter table t add column c int default 1")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	require.Equal(t, []string{"add column c"}, calls)
+	// The handlers without the callback of the event are skipped.
+	testKit.MustExec("alter table t modify column b varchar(10)")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	require.Equal(t, []string{"add column c"}, calls)
+	testKit.MustExec("alter table t add index ia(a)")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	require.Equal(t, []string{"add column c", "add index ia"}, calls)
+	testKit.MustExec("rename table t to t1")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec("drop table t1")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	require.Equal(t, []string{"add column c", "add index ia", "rename table t to t1", "drop table t1"}, calls)
+}
+
+func TestRegisteredDDLEventHandlersOfStatsSubsystems(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	testKit.MustExec("set @@global.tidb_enable_historical_stats = 1")
+	defer testKit.MustExec("set @@global.tidb_enable_historical_stats = default")
+	testKit.MustExec("create table t (a int, b int, c int)")
+	h := do.StatsHandle()
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustExec("insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3)")
+	testKit.MustExec("analyze table t columns a, b with 1 topn, 2 buckets")
+	require.NoError(t, h.Update(context.Background(), do.InfoSchema()))
+	testKit.MustQuery("select * from t where a > 1 and b > 1").Check(testkit.Rows("2 2 2", "3 3 3"))
+	require.NoError(t, h.DumpColStatsUsageToKV())
+
+	getTableInfo := func() *model.TableInfo {
+		tbl, err := do.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+		require.NoError(t, err)
+		return tbl.Meta()
+	}
+	tblInfo := getTableInfo()
+	colA, colB := model.FindColumnInfo(tblInfo.Columns, "a").ID, model.FindColumnInfo(tblInfo.Columns, "b").ID
+	testKit.MustQuery("select column_ids from mysql.analyze_options where table_id = ?", tblInfo.ID).Check(testkit.Rows(fmt.Sprintf("%d,%d", colA, colB)))
+
+	// The historical stats meta is recorded for the stats meta updated by the ddl event.
+	testKit.MustExec("alter table t add column d int default 1")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	testKit.MustQuery("select count(*) from mysql.stats_meta_history where table_id = ? and source = 'schema change'", tblInfo.ID).Check(testkit.Rows("1"))
+
+	// The predicate columns and the analyze options follow the column rebuilt by MODIFY COLUMN.
+	testKit.MustExec("alter table t modify column b varchar(10)")
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	tblInfo = getTableInfo()
+	newColB := model.FindColumnInfo(tblInfo.Columns, "b").ID
+	require.NotEqual(t, colB, newColB)
+	testKit.MustQuery("select column_id from mysql.column_stats_usage where table_id = ? and last_used_at is not null order by column_id", tblInfo.ID).Check(
+		testkit.Rows(fmt.Sprint(colA), fmt.Sprint(newColB)))
+	testKit.MustQuery("select column_ids from mysql.analyze_options where table_id = ?", tblInfo.ID).Check(testkit.Rows(fmt.Sprintf("%d,%d", colA, newColB)))
+
+	// The predicate columns and the analyze options are carried forward to the truncated table.
+	testKit.MustExec("truncate table t")
+	require.NoError(t, statstestutil.HandleDDLEventWithTxn(h, findEvent(h.DDLEventCh(), model.ActionTruncateTable)))
+	newTblInfo := getTableInfo()
+	require.NotEqual(t, tblInfo.ID, newTblInfo.ID)
+	testKit.MustQuery("select column_id from mysql.column_stats_usage where table_id = ? and last_used_at is not null order by column_id", newTblInfo.ID).Check(
+		testkit.Rows(fmt.Sprint(colA), fmt.Sprint(newColB)))
+	testKit.MustQuery("select topn, column_choice, column_ids from mysql.analyze_options where table_id = ?", newTblInfo.ID).Check(
+		testkit.Rows(fmt.Sprintf("1 LIST %d,%d", colA, newColB)))
+}
@@ -0,0 +1,226 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/logutil"
+	"github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"go.uber.org/zap"
+)
+
+// handlerRegistry keeps the typed handlers of the ddl events. The built-in handlers maintaining the stats are
+// registered when the subscriber is created, the other subsystems register theirs later.
+type handlerRegistry struct {
+	mu       sync.RWMutex
+	handlers []*types.DDLEventHandlers
+}
+
+func (r *handlerRegistry) register(handlers *types.DDLEventHandlers) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers = append(r.handlers, handlers)
+}
+
+// forEach calls fn with the registered handlers in the registration order, and stops at the first error.
+func (r *handlerRegistry) forEach(action model.ActionType, fn func(handlers *types.DDLEventHandlers) error) error {
+	r.mu.RLock()
+	handlersList := r.handlers
+	r.mu.RUnlock()
+	for _, handlers := range handlersList {
+		if err := fn(handlers); err != nil {
+			logutil.StatsLogger().Error("Failed to handle the ddl event",
+				zap.String("handlers", handlers.Name),
+				zap.Stringer("type", action),
+				zap.Error(err),
+			)
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (r *handlerRegistry) onCreateTable(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	referTableID int64,
+) error {
+	return r.forEach(model.ActionCreateTable, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnCreateTable == nil {
+			return nil
+		}
+		return handlers.OnCreateTable(ctx, sctx, tblInfo, referTableID)
+	})
+}
+
+func (r *handlerRegistry) onTruncateTable(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	newTblInfo, droppedTblInfo *model.TableInfo,
+	refillFactor float64,
+) error {
+	return r.forEach(model.ActionTruncateTable, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnTruncateTable == nil {
+			return nil
+		}
+		return handlers.OnTruncateTable(ctx, sctx, newTblInfo, droppedTblInfo, refillFactor)
+	})
+}
+
+func (r *handlerRegistry) onDropTable(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	droppedTblInfo *model.TableInfo,
+) error {
+	return r.forEach(model.ActionDropTable, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnDropTable == nil {
+			return nil
+		}
+		return handlers.OnDropTable(ctx, sctx, droppedTblInfo)
+	})
+}
+
+func (r *handlerRegistry) onRecoverTable(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	recoveredTblInfo *model.TableInfo,
+	snapshotTS uint64,
+) error {
+	return r.forEach(model.ActionRecoverTable, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnRecoverTable == nil {
+			return nil
+		}
+		return handlers.OnRecoverTable(ctx, sctx, recoveredTblInfo, snapshotTS)
+	})
+}
+
+func (r *handlerRegistry) onRenameTable(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	oldSchemaName, newSchemaName, oldTableName string,
+) error {
+	return r.forEach(model.ActionRenameTable, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnRenameTable == nil {
+			return nil
+		}
+		return handlers.OnRenameTable(ctx, sctx, tblInfo, oldSchemaName, newSchemaName, oldTableName)
+	})
+}
+
+func (r *handlerRegistry) onAddColumn(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	addedCols []*model.ColumnInfo,
+) error {
+	return r.forEach(model.ActionAddColumn, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnAddColumn == nil {
+			return nil
+		}
+		return handlers.OnAddColumn(ctx, sctx, tblInfo, addedCols)
+	})
+}
+
+func (r *handlerRegistry) onModifyColumn(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	modifiedCols, oldCols []*model.ColumnInfo,
+) error {
+	return r.forEach(model.ActionModifyColumn, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnModifyColumn == nil {
+			return nil
+		}
+		return handlers.OnModifyColumn(ctx, sctx, tblInfo, modifiedCols, oldCols)
+	})
+}
+
+func (r *handlerRegistry) onAddIndex(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	addedIdxs []*model.IndexInfo,
+) error {
+	return r.forEach(model.ActionAddIndex, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnAddIndex == nil {
+			return nil
+		}
+		return handlers.OnAddIndex(ctx, sctx, tblInfo, addedIdxs)
+	})
+}
+
+func (r *handlerRegistry) onDropIndex(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	droppedIdxs []*model.IndexInfo,
+) error {
+	return r.forEach(model.ActionDropIndex, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnDropIndex == nil {
+			return nil
+		}
+		return handlers.OnDropIndex(ctx, sctx, tblInfo, droppedIdxs)
+	})
+}
+
+func (r *handlerRegistry) onAddPartition(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	globalTblInfo *model.TableInfo,
+	addedPartInfo *model.PartitionInfo,
+) error {
+	return r.forEach(model.ActionAddTablePartition, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnAddPartition == nil {
+			return nil
+		}
+		return handlers.OnAddPartition(ctx, sctx, globalTblInfo, addedPartInfo)
+	})
+}
+
+func (r *handlerRegistry) onDropPartition(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	globalTblInfo *model.TableInfo,
+	droppedPartInfo *model.PartitionInfo,
+) error {
+	return r.forEach(model.ActionDropTablePartition, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnDropPartition == nil {
+			return nil
+		}
+		return handlers.OnDropPartition(ctx, sctx, globalTblInfo, droppedPartInfo)
+	})
+}
+
+func (r *handlerRegistry) onExchangePartition(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	globalTblInfo *model.TableInfo,
+	partInfo *model.PartitionInfo,
+	nonPartTblInfo *model.TableInfo,
+) error {
+	return r.forEach(model.ActionExchangeTablePartition, func(handlers *types.DDLEventHandlers) error {
+		if handlers.OnExchangePartition == nil {
+			return nil
+		}
+		return handlers.OnExchangePartition(ctx, sctx, globalTblInfo, partInfo, nonPartTblInfo)
+	})
+}
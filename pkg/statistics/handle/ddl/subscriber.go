@@ -24,7 +24,6 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
-	"github.com/pingcap/tidb/pkg/statistics/handle/history"
 	"github.com/pingcap/tidb/pkg/statistics/handle/lockstats"
 	"github.com/pingcap/tidb/pkg/statistics/handle/logutil"
 	"github.com/pingcap/tidb/pkg/statistics/handle/storage"
@@ -35,17 +34,35 @@ import (
 )
 
 type subscriber struct {
-	statsCache types.StatsCache
+	registry *handlerRegistry
 }
 
 // newSubscriber creates a new subscriber.
-func newSubscriber(
-	statsCache types.StatsCache,
-) *subscriber {
-	h := subscriber{statsCache: statsCache}
+func newSubscriber() *subscriber {
+	h := subscriber{registry: &handlerRegistry{}}
+	h.registry.register(h.statsHandlers())
 	return &h
 }
 
+// statsHandlers returns the built-in handlers which maintain the stats for the ddl events.
+func (h subscriber) statsHandlers() *types.DDLEventHandlers {
+	return &types.DDLEventHandlers{
+		Name:                "stats",
+		OnCreateTable:       h.onCreateTable,
+		OnTruncateTable:     h.onTruncateTable,
+		OnDropTable:         h.onDropTable,
+		OnRecoverTable:      h.onRecoverTable,
+		OnRenameTable:       h.onRenameTable,
+		OnAddColumn:         h.onAddColumn,
+		OnModifyColumn:      h.onModifyColumn,
+		OnAddIndex:          h.onAddIndex,
+		OnDropIndex:         h.onDropIndex,
+		OnAddPartition:      h.onAddPartition,
+		OnDropPartition:     h.onDropPartition,
+		OnExchangePartition: h.onExchangePartition,
+	}
+}
+
 func (h subscriber) handle(
 	ctx context.Context,
 	sctx sessionctx.Context,
@@ -54,81 +71,24 @@ func (h subscriber) handle(
 	switch change.GetType() {
 	case model.ActionCreateTable:
 		info := change.GetCreateTableInfo()
-		ids, err := getPhysicalIDs(sctx, info)
-		if err != nil {
-			return err
-		}
-		for _, id := range ids {
-			err = h.insertStats4PhysicalID(ctx, sctx, info, id)
-			if err != nil {
-				return errors.Trace(err)
-			}
-		}
+		return errors.Trace(h.registry.onCreateTable(ctx, sctx, info, change.GetCreateTableStatsReferTableID()))
 	case model.ActionTruncateTable:
 		newTableInfo, droppedTableInfo := change.GetTruncateTableInfo()
-		ids, err := getPhysicalIDs(sctx, newTableInfo)
-		if err != nil {
-			return err
-		}
-		for _, id := range ids {
-			err = h.insertStats4PhysicalID(ctx, sctx, newTableInfo, id)
-			if err != nil {
-				return errors.Trace(err)
-			}
-		}
-
-		// Remove the old table stats.
-		droppedIDs, err2 := getPhysicalIDs(sctx, droppedTableInfo)
-		if err2 != nil {
-			return err2
-		}
-		for _, id := range droppedIDs {
-			err2 = h.delayedDeleteStats4PhysicalID(ctx, sctx, id)
-			if err2 != nil {
-				return errors.Trace(err2)
-			}
-		}
+		return errors.Trace(h.registry.onTruncateTable(ctx, sctx, newTableInfo, droppedTableInfo, change.GetTruncateTableStatsRefillFactor()))
 	case model.ActionDropTable:
-		droppedTableInfo := change.GetDropTableInfo()
-		ids, err := getPhysicalIDs(sctx, droppedTableInfo)
-		if err != nil {
-			return err
-		}
-		for _, id := range ids {
-			err = h.delayedDeleteStats4PhysicalID(ctx, sctx, id)
-			if err != nil {
-				return errors.Trace(err)
-			}
-		}
+		return errors.Trace(h.registry.onDropTable(ctx, sctx, change.GetDropTableInfo()))
+	case model.ActionRecoverTable:
+		recoveredTableInfo, snapshotTS := change.GetRecoverTableInfo()
+		return errors.Trace(h.registry.onRecoverTable(ctx, sctx, recoveredTableInfo, snapshotTS))
 	case model.ActionAddColumn:
 		newTableInfo, newColumnInfo := change.GetAddColumnInfo()
-		ids, err := getPhysicalIDs(sctx, newTableInfo)
-		if err != nil {
-			return errors.Trace(err)
-		}
-		for _, id := range ids {
-			if err = h.insertStats4Col(ctx, sctx, id, newColumnInfo); err != nil {
-				return errors.Trace(err)
-			}
-		}
+		return errors.Trace(h.registry.onAddColumn(ctx, sctx, newTableInfo, newColumnInfo))
 	case model.ActionModifyColumn:
-		newTableInfo, modifiedColumnInfo := change.GetModifyColumnInfo()
-		ids, err := getPhysicalIDs(sctx, newTableInfo)
-		if err != nil {
-			return errors.Trace(err)
-		}
-		for _, id := range ids {
-			if err = h.insertStats4Col(ctx, sctx, id, modifiedColumnInfo); err != nil {
-				return errors.Trace(err)
-			}
-		}
+		newTableInfo, modifiedColumnInfo, oldColumnInfo := change.GetModifyColumnInfo()
+		return errors.Trace(h.registry.onModifyColumn(ctx, sctx, newTableInfo, modifiedColumnInfo, oldColumnInfo))
 	case model.ActionAddTablePartition:
 		globalTableInfo, addedPartitionInfo := change.GetAddPartitionInfo()
-		for _, def := range addedPartitionInfo.Definitions {
-			if err := h.insertStats4PhysicalID(ctx, sctx, globalTableInfo, def.ID); err != nil {
-				return errors.Trace(err)
-			}
-		}
+		return errors.Trace(h.registry.onAddPartition(ctx, sctx, globalTableInfo, addedPartitionInfo))
 	case model.ActionTruncateTablePartition:
 		globalTableInfo, addedPartInfo, droppedPartInfo := change.GetTruncatePartitionInfo()
 		// First, add the new stats meta record for the new partitions.
@@ -158,23 +118,7 @@ func (h subscriber) handle(
 		return nil
 	case model.ActionDropTablePartition:
 		globalTableInfo, droppedPartitionInfo := change.GetDropPartitionInfo()
-		if err := updateGlobalTableStats4DropPartition(
-			ctx,
-			sctx,
-			globalTableInfo,
-			droppedPartitionInfo,
-		); err != nil {
-			return errors.Trace(err)
-		}
-
-		// Reset the partition stats.
-		for _, def := range droppedPartitionInfo.Definitions {
-			if err := h.delayedDeleteStats4PhysicalID(ctx, sctx, def.ID); err != nil {
-				return errors.Trace(err)
-			}
-		}
-
-		return nil
+		return errors.Trace(h.registry.onDropPartition(ctx, sctx, globalTableInfo, droppedPartitionInfo))
 	// EXCHANGE PARTITION EVENT NOTES:
 	//  1. When a partition is exchanged with a system table, we need to adjust the global statistics
 	//     based on the count delta and modify count delta. However, due to the involvement of the system table,
@@ -187,13 +131,7 @@ func (h subscriber) handle(
 	// So we decided to completely ignore the system table event.
 	case model.ActionExchangeTablePartition:
 		globalTableInfo, originalPartInfo, originalTableInfo := change.GetExchangePartitionInfo()
-		return errors.Trace(updateGlobalTableStats4ExchangePartition(
-			ctx,
-			sctx,
-			globalTableInfo,
-			originalPartInfo,
-			originalTableInfo,
-		))
+		return errors.Trace(h.registry.onExchangePartition(ctx, sctx, globalTableInfo, originalPartInfo, originalTableInfo))
 	case model.ActionReorganizePartition:
 		globalTableInfo, addedPartInfo, droppedPartitionInfo := change.GetReorganizePartitionInfo()
 		// Avoid updating global stats as the data remains unchanged.
@@ -245,7 +183,14 @@ func (h subscriber) handle(
 	case model.ActionFlashbackCluster:
 		return errors.Trace(storage.UpdateStatsVersion(ctx, sctx))
 	case model.ActionAddIndex:
-		// No need to update the stats meta for the adding index event.
+		tblInfo, idxInfos := change.GetAddIndexInfo()
+		return errors.Trace(h.registry.onAddIndex(ctx, sctx, tblInfo, idxInfos))
+	case model.ActionDropIndex:
+		tblInfo, idxInfos := change.GetDropIndexInfo()
+		return errors.Trace(h.registry.onDropIndex(ctx, sctx, tblInfo, idxInfos))
+	case model.ActionRenameTable:
+		tblInfo, oldSchemaName, newSchemaName, oldTableName := change.GetRenameTableInfo()
+		return errors.Trace(h.registry.onRenameTable(ctx, sctx, tblInfo, oldSchemaName, newSchemaName, oldTableName))
 	case model.ActionDropSchema:
 		miniDBInfo := change.GetDropSchemaInfo()
 		intest.Assert(miniDBInfo != nil)
@@ -267,73 +212,446 @@ func (h subscriber) handle(
 	return nil
 }
 
-func (h subscriber) insertStats4PhysicalID(
+func (h subscriber) onCreateTable(
 	ctx context.Context,
 	sctx sessionctx.Context,
-	info *model.TableInfo,
-	id int64,
+	tblInfo *model.TableInfo,
+	referTableID int64,
 ) error {
-	startTS, err := storage.InsertTableStats2KV(ctx, sctx, info, id)
+	ids, err := getPhysicalIDs(sctx, tblInfo)
+	if err != nil {
+		return err
+	}
+	var referIDs map[int64]int64
+	if referTableID != 0 {
+		is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+		// The refer table may have been dropped, its stats are not copied then.
+		if referTableInfo, ok := is.TableInfoByID(referTableID); ok {
+			referIDs = util.MapPhysicalIDs(tblInfo, referTableInfo)
+		}
+	}
+	for _, id := range ids {
+		if referID, ok := referIDs[id]; ok {
+			copied, err := h.carryForwardStats4PhysicalID(ctx, sctx, referID, id, 1)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if copied {
+				continue
+			}
+		}
+		err = h.insertStats4PhysicalID(ctx, sctx, tblInfo, id)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (h subscriber) onTruncateTable(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	newTableInfo, droppedTableInfo *model.TableInfo,
+	refillFactor float64,
+) error {
+	ids, err := getPhysicalIDs(sctx, newTableInfo)
+	if err != nil {
+		return err
+	}
+	oldIDs := util.MapPhysicalIDs(newTableInfo, droppedTableInfo)
+	for _, id := range ids {
+		if refillFactor > 0 {
+			carried, err := h.carryForwardStats4PhysicalID(ctx, sctx, oldIDs[id], id, refillFactor)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if carried {
+				continue
+			}
+		}
+		err = h.insertStats4PhysicalID(ctx, sctx, newTableInfo, id)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	// Remove the old table stats.
+	return errors.Trace(h.onDropTable(ctx, sctx, droppedTableInfo))
+}
+
+func (h subscriber) onDropTable(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	droppedTableInfo *model.TableInfo,
+) error {
+	ids, err := getPhysicalIDs(sctx, droppedTableInfo)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		err = h.delayedDeleteStats4PhysicalID(ctx, sctx, id)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (h subscriber) onRecoverTable(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	recoveredTableInfo *model.TableInfo,
+	snapshotTS uint64,
+) error {
+	ids, err := getPhysicalIDs(sctx, recoveredTableInfo)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err = h.restoreStats4RecoveredPhysicalID(ctx, sctx, recoveredTableInfo, id, snapshotTS); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (subscriber) onRenameTable(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	oldSchemaName, newSchemaName, oldTableName string,
+) error {
+	// The stats, analyze options and predicate columns are keyed by the physical IDs,
+	// which are not changed by renaming, only the analyze jobs are keyed by the names.
+	return errors.Trace(storage.RenameAnalyzeJobsTable(
+		ctx,
+		sctx,
+		oldSchemaName,
+		oldTableName,
+		newSchemaName,
+		tblInfo.Name.O,
+	))
+}
+
+func (h subscriber) onAddColumn(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	addedCols []*model.ColumnInfo,
+) error {
+	ids, err := getPhysicalIDs(sctx, tblInfo)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	return errors.Trace(h.recordHistoricalStatsMeta(ctx, sctx, id, startTS))
+	for _, id := range ids {
+		if err = h.insertStats4AddedCol(ctx, sctx, id, addedCols); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
 }
 
-func (h subscriber) recordHistoricalStatsMeta(
+func (h subscriber) onModifyColumn(
 	ctx context.Context,
 	sctx sessionctx.Context,
-	id int64,
-	startTS uint64,
+	tblInfo *model.TableInfo,
+	modifiedCols, oldCols []*model.ColumnInfo,
 ) error {
-	if startTS == 0 {
-		return nil
+	ids, err := getPhysicalIDs(sctx, tblInfo)
+	if err != nil {
+		return errors.Trace(err)
 	}
-	enableHistoricalStats, err2 := getEnableHistoricalStats(sctx)
-	if err2 != nil {
-		return err2
+	for _, id := range ids {
+		if err = h.insertStats4ModifiedCol(ctx, sctx, id, modifiedCols, oldCols); err != nil {
+			return errors.Trace(err)
+		}
 	}
-	if !enableHistoricalStats {
-		return nil
+	return nil
+}
+
+func (h subscriber) onAddIndex(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	addedIdxs []*model.IndexInfo,
+) error {
+	for _, idxInfo := range addedIdxs {
+		if err := h.restoreStats4AddedIndex(ctx, sctx, tblInfo, idxInfo); err != nil {
+			return errors.Trace(err)
+		}
 	}
+	return nil
+}
 
-	tbl, ok := h.statsCache.Get(id)
-	if !ok || !tbl.IsInitialized() {
+func (subscriber) onDropIndex(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	droppedIdxs []*model.IndexInfo,
+) error {
+	if vardef.DroppedIndexStatsRetention.Load() <= 0 {
 		return nil
 	}
+	for _, idxInfo := range droppedIdxs {
+		if err := storage.TombstoneIndexStats(ctx, sctx, tblInfo, idxInfo); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (h subscriber) onAddPartition(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	globalTblInfo *model.TableInfo,
+	addedPartInfo *model.PartitionInfo,
+) error {
+	for _, def := range addedPartInfo.Definitions {
+		if err := h.insertStats4PhysicalID(ctx, sctx, globalTblInfo, def.ID); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if vardef.EnableNewPartitionStatsSeeding.Load() {
+		return errors.Trace(h.seedStats4AddedPartitions(ctx, sctx, globalTblInfo, addedPartInfo))
+	}
+	return nil
+}
 
-	return history.RecordHistoricalStatsMeta(
+func (h subscriber) onDropPartition(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	globalTblInfo *model.TableInfo,
+	droppedPartInfo *model.PartitionInfo,
+) error {
+	if err := updateGlobalTableStats4DropPartition(
 		ctx,
 		sctx,
-		startTS,
-		util.StatsMetaHistorySourceSchemaChange,
-		id,
+		globalTblInfo,
+		droppedPartInfo,
+	); err != nil {
+		return errors.Trace(err)
+	}
+
+	// Reset the partition stats.
+	for _, def := range droppedPartInfo.Definitions {
+		if err := h.delayedDeleteStats4PhysicalID(ctx, sctx, def.ID); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (subscriber) onExchangePartition(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	globalTblInfo *model.TableInfo,
+	partInfo *model.PartitionInfo,
+	nonPartTblInfo *model.TableInfo,
+) error {
+	return errors.Trace(updateGlobalTableStats4ExchangePartition(
+		ctx,
+		sctx,
+		globalTblInfo,
+		partInfo,
+		nonPartTblInfo,
+	))
+}
+
+func (subscriber) insertStats4PhysicalID(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	info *model.TableInfo,
+	id int64,
+) error {
+	_, err := storage.InsertTableStats2KV(ctx, sctx, info, id)
+	return errors.Trace(err)
+}
+
+// carryForwardStats4PhysicalID keeps the stats of the truncated or the refer table or partition as the initial
+// stats of the new one, it returns false if the old one has not been analyzed.
+func (subscriber) carryForwardStats4PhysicalID(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	oldID, newID int64,
+	refillFactor float64,
+) (bool, error) {
+	_, ok, err := storage.CarryForwardStats2KV(ctx, sctx, oldID, newID, refillFactor)
+	if err != nil || !ok {
+		return false, errors.Trace(err)
+	}
+	logutil.StatsLogger().Info("carry forward the stats to the new table",
+		zap.Int64("oldID", oldID),
+		zap.Int64("newID", newID),
+		zap.Float64("refillFactor", refillFactor),
 	)
+	return true, nil
 }
 
-func (h subscriber) delayedDeleteStats4PhysicalID(
+// restoreStats4RecoveredPhysicalID restores the stats of the recovered table or partition, the empty stats are
+// inserted if there is nothing to restore.
+func (h subscriber) restoreStats4RecoveredPhysicalID(
 	ctx context.Context,
 	sctx sessionctx.Context,
+	info *model.TableInfo,
 	id int64,
+	snapshotTS uint64,
+) error {
+	_, ok, err := storage.RestoreRecoveredTableStats2KV(ctx, sctx, info, id, snapshotTS)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		return h.insertStats4PhysicalID(ctx, sctx, info, id)
+	}
+	logutil.StatsLogger().Info("restore the stats of the recovered table",
+		zap.Int64("tableID", info.ID),
+		zap.Int64("physicalID", id),
+		zap.Uint64("snapshotTS", snapshotTS),
+	)
+	return nil
+}
+
+// seedStats4AddedPartitions seeds the newly added partitions with the data distribution of the most recent sibling
+// partition. Without it, the newest partition, which is usually the hottest one, has to use pseudo stats until it is
+// analyzed. The seeds are saved to the storage as not analyzed with the real row count of the partitions, so all the
+// TiDB instances load them but never take the partitions as analyzed, and they are replaced once the partitions are
+// analyzed, which are prioritized by the auto-analyze queue.
+func (subscriber) seedStats4AddedPartitions(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	globalTableInfo *model.TableInfo,
+	addedPartInfo *model.PartitionInfo,
 ) error {
-	startTS, err2 := storage.UpdateStatsMetaVersionForGC(ctx, sctx, id)
-	if err2 != nil {
-		return errors.Trace(err2)
+	siblingID := findRecentSiblingPartition(globalTableInfo, addedPartInfo)
+	if siblingID == 0 {
+		return nil
+	}
+	seeded := 0
+	for _, def := range addedPartInfo.Definitions {
+		_, ok, err := storage.SeedStats2KV(ctx, sctx, siblingID, def.ID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !ok {
+			// The sibling partition has not been analyzed, so there is nothing to seed.
+			return nil
+		}
+		seeded++
+	}
+	logutil.StatsLogger().Info("Seeded the stats of the newly added partitions",
+		zap.Int64("tableID", globalTableInfo.ID),
+		zap.Int64("siblingPartitionID", siblingID),
+		zap.Int("partitionCount", seeded),
+	)
+	return nil
+}
+
+// findRecentSiblingPartition returns the ID of the last existing partition before the newly added ones.
+// It returns 0 if there is no such partition.
+func findRecentSiblingPartition(
+	globalTableInfo *model.TableInfo,
+	addedPartInfo *model.PartitionInfo,
+) int64 {
+	pi := globalTableInfo.GetPartitionInfo()
+	if pi == nil || addedPartInfo == nil {
+		return 0
+	}
+	added := make(map[int64]struct{}, len(addedPartInfo.Definitions))
+	for _, def := range addedPartInfo.Definitions {
+		added[def.ID] = struct{}{}
+	}
+	var siblingID int64
+	for _, def := range pi.Definitions {
+		if _, ok := added[def.ID]; ok {
+			break
+		}
+		siblingID = def.ID
 	}
-	return errors.Trace(h.recordHistoricalStatsMeta(ctx, sctx, id, startTS))
+	return siblingID
 }
 
-func (h subscriber) insertStats4Col(
+func (subscriber) delayedDeleteStats4PhysicalID(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	id int64,
+) error {
+	_, err := storage.UpdateStatsMetaVersionForGC(ctx, sctx, id)
+	return errors.Trace(err)
+}
+
+func (subscriber) insertStats4Col(
 	ctx context.Context,
 	sctx sessionctx.Context,
 	physicalID int64,
 	colInfos []*model.ColumnInfo,
 ) error {
-	startTS, err := storage.InsertColStats2KV(ctx, sctx, physicalID, colInfos)
-	if err != nil {
+	_, err := storage.InsertColStats2KV(ctx, sctx, physicalID, colInfos)
+	return errors.Trace(err)
+}
+
+// insertStats4AddedCol seeds the stats of the newly added columns from their default values.
+func (subscriber) insertStats4AddedCol(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	physicalID int64,
+	colInfos []*model.ColumnInfo,
+) error {
+	_, err := storage.InsertAddedColStats2KV(ctx, sctx, physicalID, colInfos)
+	return errors.Trace(err)
+}
+
+// insertStats4ModifiedCol keeps the stats of the columns whose type is changed losslessly by transforming
+// them to the new type, the stats of other columns are generated according to the default value.
+func (h subscriber) insertStats4ModifiedCol(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	physicalID int64,
+	colInfos []*model.ColumnInfo,
+	oldColInfos []*model.ColumnInfo,
+) error {
+	if len(oldColInfos) != len(colInfos) {
+		return h.insertStats4Col(ctx, sctx, physicalID, colInfos)
+	}
+	remained := make([]*model.ColumnInfo, 0, len(colInfos))
+	for i, colInfo := range colInfos {
+		_, ok, err := storage.TransformColStats2KV(ctx, sctx, physicalID, oldColInfos[i], colInfo)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !ok {
+			remained = append(remained, colInfo)
+			continue
+		}
+		logutil.StatsLogger().Info("transform the column stats for the lossless type change",
+			zap.Int64("physicalID", physicalID),
+			zap.Int64("oldColumnID", oldColInfos[i].ID),
+			zap.Int64("newColumnID", colInfo.ID),
+		)
+	}
+	if len(remained) == 0 {
+		return nil
+	}
+	return h.insertStats4Col(ctx, sctx, physicalID, remained)
+}
+
+// restoreStats4AddedIndex restores the retained stats of the dropped index which has the same columns
+// as the added index, so the added index doesn't have to wait for the next analyze to get the stats.
+func (subscriber) restoreStats4AddedIndex(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	idxInfo *model.IndexInfo,
+) error {
+	_, ok, err := storage.RestoreIndexStatsFromTombstone(ctx, sctx, tblInfo, idxInfo, vardef.DroppedIndexStatsRetention.Load())
+	if err != nil || !ok {
 		return errors.Trace(err)
 	}
-	return errors.Trace(h.recordHistoricalStatsMeta(ctx, sctx, physicalID, startTS))
+	logutil.StatsLogger().Info("restore the stats of the dropped index for the added index",
+		zap.Int64("tableID", tblInfo.ID),
+		zap.Int64("indexID", idxInfo.ID),
+		zap.String("indexName", idxInfo.Name.O),
+	)
+	return nil
 }
 
 func getPhysicalIDs(
@@ -367,15 +685,6 @@ func getCurrentPruneMode(
 	return variable.PartitionPruneMode(pruneMode), errors.Trace(err)
 }
 
-func getEnableHistoricalStats(
-	sctx sessionctx.Context,
-) (bool, error) {
-	val, err := sctx.GetSessionVars().
-		GlobalVarsAccessor.
-		GetGlobalSysVar(vardef.TiDBEnableHistoricalStats)
-	return variable.TiDBOptOn(val), errors.Trace(err)
-}
-
 func updateGlobalTableStats4DropPartition(
 	ctx context.Context,
 	sctx sessionctx.Context,
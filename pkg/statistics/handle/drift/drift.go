@@ -0,0 +1,191 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drift
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/handle/storage"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+const (
+	// maxTablesPerRound is the max number of the tables checked in each round, the tables checked least recently
+	// are checked first.
+	maxTablesPerRound = 10
+	// sampleRows is the number of the rows sampled from each table.
+	sampleRows = 1000
+
+	selectLastCheckTimesSQL = "SELECT table_id, MAX(check_time) FROM mysql.stats_drift GROUP BY table_id"
+	selectDriftedTablesSQL  = "SELECT table_id, MAX(stats_version) FROM mysql.stats_drift WHERE drifted = 1 GROUP BY table_id"
+	deleteTableDriftSQL     = "DELETE FROM mysql.stats_drift WHERE table_id = %?"
+	insertDriftSQL          = `INSERT INTO mysql.stats_drift (table_id, hist_id, stats_version, sample_rows, distance, drifted)
+		VALUES (%?, %?, %?, %?, %?, %?)`
+)
+
+// ColumnDrift is the drift of the data distribution of a column from its stats.
+type ColumnDrift struct {
+	TableID  int64
+	ColumnID int64
+	// StatsVersion is the version of the stats checked, i.e. the last analyze version of the table.
+	StatsVersion uint64
+	SampleRows   int
+	// Distance is the Kolmogorov-Smirnov distance between the sampled and the analyzed distributions, see
+	// statistics.ColumnDrift.
+	Distance float64
+	Drifted  bool
+}
+
+// hotTable is a table whose column stats are loaded in the stats cache, i.e. used by the queries recently.
+type hotTable struct {
+	statsTbl    *statistics.Table
+	tblInfo     *model.TableInfo
+	dbName      string
+	lastChecked time.Time
+}
+
+// Check samples a tiny number of rows from each of the hot tables and checks whether the distributions of their
+// columns have drifted from the stats. The hot tables are the analyzed non-partitioned tables whose version 2 column
+// stats are fully loaded in the stats cache, which happens when the columns are used by the queries. Only the loaded
+// columns are checked, so no stats are loaded for the check.
+func Check(sctx sessionctx.Context, h statstypes.StatsHandle) ([]ColumnDrift, error) {
+	lastChecked, err := lastCheckTimes(sctx)
+	if err != nil {
+		return nil, err
+	}
+	is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+	var tables []hotTable
+	for _, statsTbl := range h.Values() {
+		if statsTbl.Pseudo || !statsTbl.IsAnalyzed() || !hasLoadedColumns(statsTbl) {
+			continue
+		}
+		tbl, ok := h.TableInfoByID(is, statsTbl.PhysicalID)
+		if !ok || tbl.Meta().ID != statsTbl.PhysicalID || tbl.Meta().GetPartitionInfo() != nil {
+			continue
+		}
+		db, ok := is.SchemaByID(tbl.Meta().DBID)
+		if !ok {
+			continue
+		}
+		tables = append(tables, hotTable{
+			statsTbl:    statsTbl,
+			tblInfo:     tbl.Meta(),
+			dbName:      db.Name.O,
+			lastChecked: lastChecked[statsTbl.PhysicalID],
+		})
+	}
+	slices.SortFunc(tables, func(a, b hotTable) int {
+		return a.lastChecked.Compare(b.lastChecked)
+	})
+	tables = tables[:min(len(tables), maxTablesPerRound)]
+	loc := sctx.GetSessionVars().StmtCtx.TimeZone()
+	var drifts []ColumnDrift
+	for _, tbl := range tables {
+		sample, err := storage.SampleTableRows(sctx, tbl.dbName, tbl.tblInfo, sampleRows)
+		if err != nil {
+			return nil, err
+		}
+		if sample == nil {
+			continue
+		}
+		for offset, colID := range sample.ColIDs {
+			values := make([]types.Datum, 0, len(sample.Rows))
+			for _, row := range sample.Rows {
+				values = append(values, row[offset])
+			}
+			distance, rows, ok := statistics.ColumnDrift(loc, tbl.statsTbl.GetCol(colID), values)
+			if !ok {
+				continue
+			}
+			drifts = append(drifts, ColumnDrift{
+				TableID:      tbl.statsTbl.PhysicalID,
+				ColumnID:     colID,
+				StatsVersion: tbl.statsTbl.LastAnalyzeVersion,
+				SampleRows:   rows,
+				Distance:     distance,
+				Drifted:      distance > statistics.DriftThreshold,
+			})
+		}
+	}
+	slices.SortFunc(drifts, func(a, b ColumnDrift) int {
+		return cmp.Or(cmp.Compare(a.TableID, b.TableID), cmp.Compare(a.ColumnID, b.ColumnID))
+	})
+	return drifts, nil
+}
+
+// hasLoadedColumns checks whether any version 2 column stats of the table are fully loaded.
+func hasLoadedColumns(statsTbl *statistics.Table) (loaded bool) {
+	statsTbl.ForEachColumnImmutable(func(_ int64, col *statistics.Column) bool {
+		loaded = col.StatsVer == statistics.Version2 && col.IsFullLoad()
+		return loaded
+	})
+	return loaded
+}
+
+// lastCheckTimes returns the last time each table is checked.
+func lastCheckTimes(sctx sessionctx.Context) (map[int64]time.Time, error) {
+	rows, _, err := util.ExecRows(sctx, selectLastCheckTimesSQL)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	res := make(map[int64]time.Time, len(rows))
+	for _, row := range rows {
+		checkTime, err := row.GetTime(1).GoTime(time.Local)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		res[row.GetInt64(0)] = checkTime
+	}
+	return res, nil
+}
+
+// Save replaces the drifts of the checked tables in mysql.stats_drift.
+func Save(sctx sessionctx.Context, drifts []ColumnDrift) error {
+	for i, drift := range drifts {
+		if i == 0 || drifts[i-1].TableID != drift.TableID {
+			if _, err := util.Exec(sctx, deleteTableDriftSQL, drift.TableID); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		if _, err := util.Exec(sctx, insertDriftSQL, drift.TableID, drift.ColumnID, drift.StatsVersion,
+			drift.SampleRows, drift.Distance, drift.Drifted); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// DriftedTables returns the tables found drifted from their stats, with the max version of the stats checked. The
+// tables analyzed after the check, i.e. whose last analyze version is larger, are no longer drifted.
+func DriftedTables(sctx sessionctx.Context) (map[int64]uint64, error) {
+	rows, _, err := util.ExecRows(sctx, selectDriftedTablesSQL)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	res := make(map[int64]uint64, len(rows))
+	for _, row := range rows {
+		res[row.GetInt64(0)] = row.GetUint64(1)
+	}
+	return res, nil
+}
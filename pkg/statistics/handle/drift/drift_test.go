@@ -0,0 +1,91 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drift_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/drift"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckStatsDrift(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	values := make([]string, 0, 200)
+	for i := range 200 {
+		values = append(values, fmt.Sprintf("(%d, %d)", i, i%10))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ", "))
+	tk.MustExec("analyze table t all columns")
+	// The values of a are shifted out of the range of its stats, while b is unchanged.
+	tk.MustExec("update t set a = a + 10000")
+	h := dom.StatsHandle()
+	is := dom.InfoSchema()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	h.Clear()
+	require.NoError(t, h.Update(context.Background(), is))
+	tbl, err := is.TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tblInfo := tbl.Meta()
+
+	// The stats of the columns not used by the queries aren't loaded, so the table isn't checked.
+	var drifts []drift.ColumnDrift
+	check := func() {
+		err := util.CallWithSCtx(h.SPool(), func(sctx sessionctx.Context) error {
+			var err error
+			drifts, err = drift.Check(sctx, h)
+			return err
+		})
+		require.NoError(t, err)
+	}
+	check()
+	require.Empty(t, drifts)
+
+	tk.MustExec("explain select * from t where a > 1 and b > 1")
+	require.NoError(t, h.LoadNeededHistograms(is))
+	check()
+	require.Len(t, drifts, 2)
+	require.Equal(t, tblInfo.Columns[0].ID, drifts[0].ColumnID)
+	require.True(t, drifts[0].Drifted)
+	require.InDelta(t, 1.0, drifts[0].Distance, 1e-9)
+	require.Equal(t, 200, drifts[0].SampleRows)
+	require.Equal(t, tblInfo.Columns[1].ID, drifts[1].ColumnID)
+	require.False(t, drifts[1].Drifted)
+
+	var drifted map[int64]uint64
+	err = util.CallWithSCtx(h.SPool(), func(sctx sessionctx.Context) error {
+		if err := drift.Save(sctx, drifts); err != nil {
+			return err
+		}
+		drifted, err = drift.DriftedTables(sctx)
+		return err
+	}, util.FlagWrapTxn)
+	require.NoError(t, err)
+	require.Equal(t, map[int64]uint64{tblInfo.ID: drifts[0].StatsVersion}, drifted)
+	tk.MustQuery("select hist_id, sample_rows, drifted from mysql.stats_drift order by hist_id").Check(testkit.Rows(
+		fmt.Sprintf("%d 200 1", tblInfo.Columns[0].ID),
+		fmt.Sprintf("%d 200 0", tblInfo.Columns[1].ID),
+	))
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package globalstats
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/domain/infosync"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
+	"github.com/pingcap/tidb/pkg/statistics/handle/storage"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/types"
+	pkgutil "github.com/pingcap/tidb/pkg/util"
+	"go.uber.org/zap"
+)
+
+// BuildMissingGlobalStats builds the global stats for all the partitioned tables which only have partition-level stats.
+// It is used when the partition prune mode is switched from static to dynamic, so these tables don't need
+// to fall back to the pseudo global stats until they are analyzed again.
+// Each table is tracked as an analyze job, so the progress can be checked by `SHOW ANALYZE STATUS`.
+func (sg *statsGlobalImpl) BuildMissingGlobalStats(is infoschema.InfoSchema) error {
+	if !sg.buildingMissingGlobalStats.CompareAndSwap(false, true) {
+		statslogutil.StatsLogger().Info("the missing global stats are being built, skip it")
+		return nil
+	}
+	defer sg.buildingMissingGlobalStats.Store(false)
+
+	var tables []missingGlobalStatsTable
+	err := util.CallWithSCtx(sg.statsHandler.SPool(), func(sctx sessionctx.Context) (err error) {
+		tables, err = findTablesWithMissingGlobalStats(sctx, is)
+		return err
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	statslogutil.StatsLogger().Info("start building the missing global stats", zap.Int("tables", len(tables)))
+
+	instance := ""
+	serverInfo, err := infosync.GetServerInfo()
+	if err == nil {
+		instance = net.JoinHostPort(serverInfo.IP, strconv.Itoa(int(serverInfo.Port)))
+	}
+	failed := 0
+	for i, tbl := range tables {
+		err := sg.buildGlobalStats(is, tbl, instance)
+		if err != nil {
+			failed++
+			statslogutil.StatsLogger().Warn("build the missing global stats failed",
+				zap.String("db", tbl.dbName),
+				zap.String("table", tbl.tableInfo.Name.O),
+				zap.Error(err))
+		}
+		statslogutil.StatsLogger().Info("building the missing global stats",
+			zap.String("db", tbl.dbName),
+			zap.String("table", tbl.tableInfo.Name.O),
+			zap.Int("done", i+1),
+			zap.Int("total", len(tables)))
+	}
+	statslogutil.StatsLogger().Info("finish building the missing global stats",
+		zap.Int("tables", len(tables)), zap.Int("failed", failed))
+	return nil
+}
+
+type missingGlobalStatsTable struct {
+	tableInfo *model.TableInfo
+	dbName    string
+	// colIDs and idxIDs are the IDs of the columns and indexes which have partition-level stats.
+	colIDs []int64
+	idxIDs []int64
+}
+
+// findTablesWithMissingGlobalStats finds the partitioned tables which have partition-level stats but no global stats.
+func findTablesWithMissingGlobalStats(sctx sessionctx.Context, is infoschema.InfoSchema) ([]missingGlobalStatsTable, error) {
+	var tables []missingGlobalStatsTable
+	for _, dbName := range is.AllSchemaNames() {
+		if pkgutil.IsMemOrSysDB(dbName.L) {
+			continue
+		}
+		tblInfos, err := is.SchemaTableInfos(context.Background(), dbName)
+		if err != nil {
+			return nil, err
+		}
+		for _, tblInfo := range tblInfos {
+			if tblInfo.GetPartitionInfo() == nil {
+				continue
+			}
+			err := storage.CheckSkipPartition(sctx, tblInfo.ID, 0)
+			if err == nil {
+				// The global stats already exist.
+				continue
+			}
+			if !types.ErrPartitionStatsMissing.Equal(err) {
+				return nil, err
+			}
+			analyzedColumns, err := analyzedHistIDsOfPartitions(sctx, tblInfo, 0)
+			if err != nil {
+				return nil, err
+			}
+			analyzedIndexes, err := analyzedHistIDsOfPartitions(sctx, tblInfo, 1)
+			if err != nil {
+				return nil, err
+			}
+			tbl := missingGlobalStatsTable{tableInfo: tblInfo, dbName: dbName.O}
+			for _, col := range tblInfo.Columns {
+				if _, ok := analyzedColumns[col.ID]; ok && !col.IsVirtualGenerated() {
+					tbl.colIDs = append(tbl.colIDs, col.ID)
+				}
+			}
+			for _, idx := range tblInfo.Indices {
+				// The stats of the global index are collected on the table directly, no need to merge.
+				if _, ok := analyzedIndexes[idx.ID]; ok && idx.State == model.StatePublic && !idx.Global {
+					tbl.idxIDs = append(tbl.idxIDs, idx.ID)
+				}
+			}
+			// There is nothing to merge if none of the partitions is analyzed.
+			if len(tbl.colIDs) > 0 {
+				tables = append(tables, tbl)
+			}
+		}
+	}
+	return tables, nil
+}
+
+// analyzedHistIDsOfPartitions returns the IDs of the columns or indexes which are analyzed in some partitions.
+func analyzedHistIDsOfPartitions(sctx sessionctx.Context, tblInfo *model.TableInfo, isIndex int) (map[int64]struct{}, error) {
+	definitions := tblInfo.GetPartitionInfo().Definitions
+	partitionIDs := make([]int64, 0, len(definitions))
+	for _, def := range definitions {
+		partitionIDs = append(partitionIDs, def.ID)
+	}
+	partitionVersions, err := storage.HistVersionsFromStorage(sctx, partitionIDs, isIndex)
+	if err != nil {
+		return nil, err
+	}
+	histIDs := make(map[int64]struct{})
+	for _, versions := range partitionVersions {
+		for histID := range versions {
+			histIDs[histID] = struct{}{}
+		}
+	}
+	return histIDs, nil
+}
+
+func (sg *statsGlobalImpl) buildGlobalStats(is infoschema.InfoSchema, tbl missingGlobalStatsTable, instance string) error {
+	job := &statistics.AnalyzeJob{
+		DBName:    tbl.dbName,
+		TableName: tbl.tableInfo.Name.O,
+		JobInfo:   "merge global stats for dynamic prune mode",
+	}
+	return util.CallWithSCtxAndLabels(util.StatsCtx, sg.statsHandler.SPool(), util.StatsOperationMergeGlobalStats, tbl.tableInfo.ID, func(sctx sessionctx.Context) error {
+		if err := sg.statsHandler.InsertAnalyzeJob(job, instance, sctx.GetSessionVars().ConnectionID); err != nil {
+			return err
+		}
+		sg.statsHandler.StartAnalyzeJob(job)
+		opts := analyzeOptionDefault
+		statsVersion := sctx.GetSessionVars().AnalyzeVersion
+		if statsVersion == statistics.Version2 {
+			opts = analyzeOptionDefaultV2
+		}
+		infos := []*statstypes.GlobalStatsInfo{{IsIndex: 0, HistIDs: tbl.colIDs, StatsVersion: statsVersion}}
+		for _, idxID := range tbl.idxIDs {
+			infos = append(infos, &statstypes.GlobalStatsInfo{IsIndex: 1, HistIDs: []int64{idxID}, StatsVersion: statsVersion})
+		}
+		var mergeErr error
+		for _, info := range infos {
+			mergeErr = sg.MergePartitionStats2GlobalStatsByTableID(sctx, opts, is, info, tbl.tableInfo.ID)
+			if mergeErr != nil {
+				break
+			}
+		}
+		sg.statsHandler.FinishAnalyzeJob(job, mergeErr, statistics.GlobalStatsMergeJob)
+		return mergeErr
+	})
+}
@@ -15,19 +15,23 @@
 package globalstats
 
 import (
+	"context"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/statistics"
 	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
 	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
 	"github.com/pingcap/tidb/pkg/statistics/handle/util"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/tiancaiamao/gp"
 	"go.uber.org/zap"
 )
@@ -40,11 +44,18 @@ const (
 // statsGlobalImpl implements util.StatsGlobal
 type statsGlobalImpl struct {
 	statsHandler statstypes.StatsHandle
+	// buildingMissingGlobalStats indicates whether BuildMissingGlobalStats is running.
+	buildingMissingGlobalStats atomic.Bool
+	// partialResults keeps the global hists merged by the failed async merges.
+	partialResults *partialMergeResultCache
 }
 
 // NewStatsGlobal creates a new StatsGlobal.
 func NewStatsGlobal(statsHandler statstypes.StatsHandle) statstypes.StatsGlobal {
-	return &statsGlobalImpl{statsHandler: statsHandler}
+	return &statsGlobalImpl{
+		statsHandler:   statsHandler,
+		partialResults: newPartialMergeResultCache(),
+	}
 }
 
 // MergePartitionStats2GlobalStatsByTableID merge the partition-level stats to global-level stats based on the tableID.
@@ -53,7 +64,7 @@ func (sg *statsGlobalImpl) MergePartitionStats2GlobalStatsByTableID(sc sessionct
 	info *statstypes.GlobalStatsInfo,
 	physicalID int64,
 ) (err error) {
-	globalStats, err := MergePartitionStats2GlobalStatsByTableID(sc, sg.statsHandler, opts, is, physicalID, info.IsIndex == 1, info.HistIDs)
+	globalStats, err := sg.mergePartitionStats2GlobalStatsByTableID(sc, opts, is, physicalID, info.IsIndex == 1, info.HistIDs)
 	if err != nil {
 		if types.ErrPartitionStatsMissing.Equal(err) || types.ErrPartitionColumnStatsMissing.Equal(err) {
 			// When we find some partition-level stats are missing, we need to report warning.
@@ -64,6 +75,34 @@ func (sg *statsGlobalImpl) MergePartitionStats2GlobalStatsByTableID(sc sessionct
 	return WriteGlobalStatsToStorage(sg.statsHandler, globalStats, info, physicalID)
 }
 
+// DDLEventHandlers implements StatsGlobal.DDLEventHandlers.
+func (sg *statsGlobalImpl) DDLEventHandlers() *statstypes.DDLEventHandlers {
+	// The partial merge results of the table can't be reused once its partitions or columns are changed,
+	// and they are useless once the table is dropped.
+	removeTable := func(tblInfo *model.TableInfo) error {
+		sg.partialResults.removeTableAll(tblInfo.ID)
+		return nil
+	}
+	return &statstypes.DDLEventHandlers{
+		Name: "global stats",
+		OnTruncateTable: func(_ context.Context, _ sessionctx.Context, _, droppedTblInfo *model.TableInfo, _ float64) error {
+			return removeTable(droppedTblInfo)
+		},
+		OnDropTable: func(_ context.Context, _ sessionctx.Context, droppedTblInfo *model.TableInfo) error {
+			return removeTable(droppedTblInfo)
+		},
+		OnModifyColumn: func(_ context.Context, _ sessionctx.Context, tblInfo *model.TableInfo, _, _ []*model.ColumnInfo) error {
+			return removeTable(tblInfo)
+		},
+		OnDropPartition: func(_ context.Context, _ sessionctx.Context, globalTblInfo *model.TableInfo, _ *model.PartitionInfo) error {
+			return removeTable(globalTblInfo)
+		},
+		OnExchangePartition: func(_ context.Context, _ sessionctx.Context, globalTblInfo *model.TableInfo, _ *model.PartitionInfo, _ *model.TableInfo) error {
+			return removeTable(globalTblInfo)
+		},
+	}
+}
+
 // GlobalStats is used to store the statistics contained in the global-level stats
 // which is generated by the merge of partition-level stats.
 // It will both store the column stats and index stats.
@@ -92,22 +131,22 @@ func newGlobalStats(histCount int) *GlobalStats {
 	return globalStats
 }
 
-// MergePartitionStats2GlobalStats merge the partition-level stats to global-level stats based on the tableInfo.
-func MergePartitionStats2GlobalStats(
+// mergePartitionStats2GlobalStats merge the partition-level stats to global-level stats based on the tableInfo.
+func (sg *statsGlobalImpl) mergePartitionStats2GlobalStats(
 	sc sessionctx.Context,
-	statsHandle statstypes.StatsHandle,
 	opts map[ast.AnalyzeOptionType]uint64,
 	is infoschema.InfoSchema,
 	globalTableInfo *model.TableInfo,
 	isIndex bool,
 	histIDs []int64,
 ) (globalStats *GlobalStats, err error) {
+	statsHandle := sg.statsHandler
 	if sc.GetSessionVars().EnableAsyncMergeGlobalStats {
 		statslogutil.SingletonStatsSamplerLogger().Info("use async merge global stats",
 			zap.Int64("tableID", globalTableInfo.ID),
 			zap.String("table", globalTableInfo.Name.L),
 		)
-		worker, err := NewAsyncMergePartitionStats2GlobalStats(statsHandle, globalTableInfo, histIDs, is)
+		worker, err := NewAsyncMergePartitionStats2GlobalStats(statsHandle, globalTableInfo, histIDs, is, sg.partialResults)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -115,19 +154,26 @@ func MergePartitionStats2GlobalStats(
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
-		return worker.Result(), nil
+		globalStats = worker.Result()
+	} else {
+		statslogutil.SingletonStatsSamplerLogger().Info("use blocking merge global stats",
+			zap.Int64("tableID", globalTableInfo.ID),
+			zap.String("table", globalTableInfo.Name.L),
+		)
+		globalStats, err = blockingMergePartitionStats2GlobalStats(sc, statsHandle.GPool(), opts, is, globalTableInfo, isIndex, histIDs, nil, statsHandle)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if vardef.EnableTiFlashGlobalStats.Load() {
+		refineGlobalStatsByTiFlash(statsHandle, is, globalTableInfo, isIndex, globalStats)
 	}
-	statslogutil.SingletonStatsSamplerLogger().Info("use blocking merge global stats",
-		zap.Int64("tableID", globalTableInfo.ID),
-		zap.String("table", globalTableInfo.Name.L),
-	)
-	return blockingMergePartitionStats2GlobalStats(sc, statsHandle.GPool(), opts, is, globalTableInfo, isIndex, histIDs, nil, statsHandle)
+	return globalStats, nil
 }
 
-// MergePartitionStats2GlobalStatsByTableID merge the partition-level stats to global-level stats based on the tableID.
-func MergePartitionStats2GlobalStatsByTableID(
+// mergePartitionStats2GlobalStatsByTableID merge the partition-level stats to global-level stats based on the tableID.
+func (sg *statsGlobalImpl) mergePartitionStats2GlobalStatsByTableID(
 	sc sessionctx.Context,
-	statsHandle statstypes.StatsHandle,
 	opts map[ast.AnalyzeOptionType]uint64,
 	is infoschema.InfoSchema,
 	tableID int64,
@@ -135,14 +181,14 @@ func MergePartitionStats2GlobalStatsByTableID(
 	histIDs []int64,
 ) (globalStats *GlobalStats, err error) {
 	// Get the partition table IDs.
-	globalTable, ok := statsHandle.TableInfoByID(is, tableID)
+	globalTable, ok := sg.statsHandler.TableInfoByID(is, tableID)
 	if !ok {
 		err = errors.Errorf("unknown physical ID %d in stats meta table, maybe it has been dropped", tableID)
 		return
 	}
 
 	globalTableInfo := globalTable.Meta()
-	globalStats, err = MergePartitionStats2GlobalStats(sc, statsHandle, opts, is, globalTableInfo, isIndex, histIDs)
+	globalStats, err = sg.mergePartitionStats2GlobalStats(sc, opts, is, globalTableInfo, isIndex, histIDs)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -171,6 +217,13 @@ var analyzeOptionDefault = map[ast.AnalyzeOptionType]uint64{
 	ast.AnalyzeOptNumTopN:    20,
 }
 
+// analyzeOptionDefaultV2 is the same as analyzeOptionDefault but for the analyze version 2.
+// These values originally came from the analyzeOptionDefaultV2 structure in the planner/core/planbuilder.go file.
+var analyzeOptionDefaultV2 = map[ast.AnalyzeOptionType]uint64{
+	ast.AnalyzeOptNumBuckets: 256,
+	ast.AnalyzeOptNumTopN:    100,
+}
+
 // blockingMergePartitionStats2GlobalStats merge the partition-level stats to global-level stats based on the tableInfo.
 // It is the old algorithm to merge partition-level stats to global-level stats. It will happen the OOM. because it will load all the partition-level stats into memory.
 func blockingMergePartitionStats2GlobalStats(
@@ -219,6 +272,12 @@ func blockingMergePartitionStats2GlobalStats(
 		allFms[i] = make([]*statistics.FMSketch, 0, partitionNum)
 	}
 
+	// The partition stats are all held in memory until the merge finishes, so they're tracked by
+	// util.GlobalStatsMergeMemTracker, which is released after the merge.
+	memTracker := memory.NewTracker(memory.LabelForGlobalStatsMerge, -1)
+	memTracker.AttachTo(util.GlobalStatsMergeMemTracker)
+	defer memTracker.Detach()
+
 	skipMissingPartitionStats := sc.GetSessionVars().SkipMissingPartitionStats
 	for _, def := range globalTableInfo.Partition.Definitions {
 		partitionID := def.ID
@@ -251,6 +310,7 @@ func blockingMergePartitionStats2GlobalStats(
 				allPartitionStats[partitionID] = partitionStats
 			}
 		}
+		memTracker.Consume(partitionStats.MemoryUsage().TotalMemUsage)
 
 		for i := 0; i < globalStats.Num; i++ {
 			// GetStatsInfo will return the copy of the statsInfo, so we don't need to worry about the data race.
@@ -371,6 +431,14 @@ func blockingMergePartitionStats2GlobalStats(
 
 // WriteGlobalStatsToStorage is to write global stats to storage
 func WriteGlobalStatsToStorage(statsHandle statstypes.StatsHandle, globalStats *GlobalStats, info *statstypes.GlobalStatsInfo, gid int64) (err error) {
+	var lockedColumns map[int64]struct{}
+	if info.IsIndex == 0 {
+		// The global-level stats of the locked columns are kept.
+		lockedColumns, err = statsHandle.GetLockedColumns(gid)
+		if err != nil {
+			return err
+		}
+	}
 	// Dump global-level stats to kv.
 	for i := 0; i < globalStats.Num; i++ {
 		hg, cms, topN := globalStats.Hg[i], globalStats.Cms[i], globalStats.TopN[i]
@@ -378,6 +446,9 @@ func WriteGlobalStatsToStorage(statsHandle statstypes.StatsHandle, globalStats *
 			// All partitions have no stats so global stats are not created.
 			continue
 		}
+		if _, locked := lockedColumns[hg.ID]; locked {
+			continue
+		}
 		// fms for global stats doesn't need to dump to kv.
 		err = statsHandle.SaveStatsToStorage(gid,
 			globalStats.Count,
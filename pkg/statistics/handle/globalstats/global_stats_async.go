@@ -83,6 +83,14 @@ type AsyncMergePartitionStats2GlobalStats struct {
 	tableInfo           map[int64]*model.TableInfo
 	// key is partition id and histID
 	skipPartition map[skipItem]struct{}
+	// partitionHistVersions is the versions of the partition hists. The key is partition id and histID.
+	partitionHistVersions map[int64]map[int64]uint64
+	// resumed marks the global hists restored from the partial results of a previous failed merge.
+	resumed []bool
+	// merged marks the global hists whose merge has finished.
+	merged []bool
+	// partialResults keeps the global hists merged by the failed merges, it belongs to the stats handle.
+	partialResults *partialMergeResultCache
 	// ioWorker meet error, it will close this channel to notify cpuWorker.
 	ioWorkerExitWhenErrChan chan struct{}
 	// cpuWorker exit, it will close this channel to notify ioWorker.
@@ -100,7 +108,8 @@ func NewAsyncMergePartitionStats2GlobalStats(
 	statsHandle statstypes.StatsHandle,
 	globalTableInfo *model.TableInfo,
 	histIDs []int64,
-	is infoschema.InfoSchema) (*AsyncMergePartitionStats2GlobalStats, error) {
+	is infoschema.InfoSchema,
+	partialResults *partialMergeResultCache) (*AsyncMergePartitionStats2GlobalStats, error) {
 	partitionNum := len(globalTableInfo.Partition.Definitions)
 	return &AsyncMergePartitionStats2GlobalStats{
 		statsHandle:             statsHandle,
@@ -118,6 +127,7 @@ func NewAsyncMergePartitionStats2GlobalStats(
 		histIDs:                 histIDs,
 		is:                      is,
 		partitionNum:            partitionNum,
+		partialResults:          partialResults,
 	}, nil
 }
 
@@ -133,7 +143,9 @@ func (a *AsyncMergePartitionStats2GlobalStats) prepare(sctx sessionctx.Context,
 	}
 	a.globalStats = newGlobalStats(len(a.histIDs))
 	a.globalStats.Num = len(a.histIDs)
-	a.globalStatsNDV = make([]int64, 0, a.globalStats.Num)
+	a.globalStatsNDV = make([]int64, a.globalStats.Num)
+	a.resumed = make([]bool, a.globalStats.Num)
+	a.merged = make([]bool, a.globalStats.Num)
 	// get all partition stats
 	for _, def := range a.globalTableInfo.Partition.Definitions {
 		partitionID := def.ID
@@ -182,7 +194,8 @@ func (a *AsyncMergePartitionStats2GlobalStats) prepare(sctx sessionctx.Context,
 			}
 		}
 	}
-	return nil
+	a.partitionHistVersions, err = storage.HistVersionsFromStorage(sctx, a.partitionIDs, toSQLIndex(isIndex))
+	return err
 }
 
 func (a *AsyncMergePartitionStats2GlobalStats) dealWithSkipPartition(partitionID int64, isIndex bool, idx int, err error) error {
@@ -275,12 +288,15 @@ func (a *AsyncMergePartitionStats2GlobalStats) cpuWorker(stmtCtx *stmtctx.Statem
 		return nil
 	default:
 		for i := 0; i < a.globalStats.Num; i++ {
+			if a.resumed[i] {
+				continue
+			}
 			// Update the global NDV.
 			globalStatsNDV := a.globalStats.Fms[i].NDV()
 			if globalStatsNDV > a.globalStats.Count {
 				globalStatsNDV = a.globalStats.Count
 			}
-			a.globalStatsNDV = append(a.globalStatsNDV, globalStatsNDV)
+			a.globalStatsNDV[i] = globalStatsNDV
 			a.globalStats.Fms[i].DestroyAndPutToPool()
 		}
 	}
@@ -318,35 +334,58 @@ func (a *AsyncMergePartitionStats2GlobalStats) MergePartitionStats2GlobalStats(
 	tz := sctx.GetSessionVars().StmtCtx.TimeZone()
 	analyzeVersion := sctx.GetSessionVars().AnalyzeVersion
 	stmtCtx := sctx.GetSessionVars().StmtCtx
+	// The merge runs in the ANALYZE statement, so it keeps the labels of the statement rather than the stats ones.
 	return util.CallWithSCtx(a.statsHandle.SPool(),
 		func(sctx sessionctx.Context) error {
 			err := a.prepare(sctx, isIndex)
 			if err != nil {
 				return err
 			}
-			ctx := context.Background()
-			metawg, _ := errgroup.WithContext(ctx)
-			mergeWg, _ := errgroup.WithContext(ctx)
-			metawg.Go(func() error {
-				return a.ioWorker(sctx, isIndex)
-			})
-			mergeWg.Go(func() error {
-				return a.cpuWorker(stmtCtx, sctx, opts, isIndex, tz, analyzeVersion)
-			})
-			err = metawg.Wait()
+			a.resumeFromPartialResults(isIndex, opts, analyzeVersion)
+			err = a.merge(stmtCtx, sctx, opts, isIndex, tz, analyzeVersion)
 			if err != nil {
-				if err1 := mergeWg.Wait(); err1 != nil {
-					err = stderrors.Join(err, err1)
-				}
+				// Keep the hists merged so far, so the retry doesn't need to merge them again.
+				a.savePartialResults(isIndex, opts, analyzeVersion)
 				return err
 			}
-			return mergeWg.Wait()
+			a.partialResults.removeTable(a.globalTableInfo.ID, isIndex)
+			return nil
 		},
 	)
 }
 
+func (a *AsyncMergePartitionStats2GlobalStats) merge(
+	stmtCtx *stmtctx.StatementContext,
+	sctx sessionctx.Context,
+	opts map[ast.AnalyzeOptionType]uint64,
+	isIndex bool,
+	tz *time.Location,
+	analyzeVersion int,
+) error {
+	ctx := context.Background()
+	metawg, _ := errgroup.WithContext(ctx)
+	mergeWg, _ := errgroup.WithContext(ctx)
+	metawg.Go(func() error {
+		return a.ioWorker(sctx, isIndex)
+	})
+	mergeWg.Go(func() error {
+		return a.cpuWorker(stmtCtx, sctx, opts, isIndex, tz, analyzeVersion)
+	})
+	err := metawg.Wait()
+	if err != nil {
+		if err1 := mergeWg.Wait(); err1 != nil {
+			err = stderrors.Join(err, err1)
+		}
+		return err
+	}
+	return mergeWg.Wait()
+}
+
 func (a *AsyncMergePartitionStats2GlobalStats) loadFmsketch(sctx sessionctx.Context, isIndex bool) error {
 	for i := 0; i < a.globalStats.Num; i++ {
+		if a.resumed[i] {
+			continue
+		}
 		// load fmsketch from tikv
 		for _, partitionID := range a.partitionIDs {
 			_, ok := a.skipPartition[skipItem{
@@ -376,6 +415,9 @@ func (a *AsyncMergePartitionStats2GlobalStats) loadFmsketch(sctx sessionctx.Cont
 func (a *AsyncMergePartitionStats2GlobalStats) loadCMsketch(sctx sessionctx.Context, isIndex bool) error {
 	failpoint.Inject("PanicInIOWorker", nil)
 	for i := 0; i < a.globalStats.Num; i++ {
+		if a.resumed[i] {
+			continue
+		}
 		for _, partitionID := range a.partitionIDs {
 			_, ok := a.skipPartition[skipItem{
 				histID:      a.histIDs[i],
@@ -409,6 +451,9 @@ func (a *AsyncMergePartitionStats2GlobalStats) loadHistogramAndTopN(sctx session
 		}
 	})
 	for i := 0; i < a.globalStats.Num; i++ {
+		if a.resumed[i] {
+			continue
+		}
 		hists := make([]*statistics.Histogram, 0, a.partitionNum)
 		topn := make([]*statistics.TopN, 0, a.partitionNum)
 		for _, partitionID := range a.partitionIDs {
@@ -528,6 +573,12 @@ func (a *AsyncMergePartitionStats2GlobalStats) dealHistogramAndTopN(stmtCtx *stm
 				(*globalHg).Buckets[j].NDV = 0
 			}
 			(*globalHg).NDV = a.globalStatsNDV[item.idx]
+			a.merged[item.idx] = true
+			failpoint.Inject("dealHistogramAndTopNErrAfterMerge", func(val failpoint.Value) {
+				if val, _ := val.(bool); val {
+					failpoint.Return(errors.New("dealHistogramAndTopNErrAfterMerge returned error"))
+				}
+			})
 		case <-a.ioWorkerExitWhenErrChan:
 			return nil
 		}
@@ -17,6 +17,7 @@ package globalstats_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,6 +25,8 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/session"
 	statstestutil "github.com/pingcap/tidb/pkg/statistics/handle/ddl/testutil"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"github.com/pingcap/tidb/pkg/store/mockstore"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/stretchr/testify/require"
 )
@@ -136,6 +139,164 @@ func TestGlobalStatsErrorSametime(t *testing.T) {
 	simpleTest(t)
 }
 
+func TestResumeAsyncMergeFromPartialResults(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@global.tidb_enable_async_merge_global_stats = 1")
+	tk.MustExec("set @@tidb_analyze_version = 2")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec("create table t (a int, b int, c int) partition by hash(a) partitions 3")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3), (4, 4, 4), (5, 5, 5), (6, 6, 6)")
+
+	// Every merge fails right after one column is merged.
+	fpName := "github.com/pingcap/tidb/pkg/statistics/handle/globalstats/dealHistogramAndTopNErrAfterMerge"
+	require.NoError(t, failpoint.Enable(fpName, `return(true)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable(fpName))
+	}()
+	tk.MustExec("analyze table t all columns with 1 topn, 2 buckets")
+	require.Len(t, tk.MustQuery("show stats_histograms where partition_name = 'global'").Rows(), 0)
+
+	is := dom.InfoSchema()
+	tbl, err := is.TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tableInfo := tbl.Meta()
+	opts := map[ast.AnalyzeOptionType]uint64{
+		ast.AnalyzeOptNumTopN:    1,
+		ast.AnalyzeOptNumBuckets: 2,
+	}
+	info := &statstypes.GlobalStatsInfo{IsIndex: 0, StatsVersion: 2}
+	merge := func() error {
+		return dom.StatsHandle().MergePartitionStats2GlobalStatsByTableID(tk.Session(), opts, is, info, tableInfo.ID)
+	}
+	// The retry resumes from the columns merged by the previous merges, so each retry merges one more column.
+	require.Error(t, merge())
+	// Once the partition stats are changed, the merged columns can't be reused.
+	tk.MustExec("update mysql.stats_histograms set version = version + 1 where table_id = ?", tableInfo.Partition.Definitions[0].ID)
+	for range 3 {
+		require.Error(t, merge())
+	}
+	require.NoError(t, merge())
+	tk.MustQuery("select count(*) from mysql.stats_histograms where table_id = ?", tableInfo.ID).Check(testkit.Rows("3"))
+}
+
+func TestBuildMissingGlobalStatsOnDynamicPruneMode(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@global.tidb_partition_prune_mode = 'static'")
+	tk.MustExec("set @@session.tidb_partition_prune_mode = 'static'")
+	tk.MustExec("create table t (a int, b int, key(a)) partition by hash(a) partitions 3")
+	tk.MustExec("create table t2 (a int) partition by hash(a) partitions 3")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6)")
+	tk.MustExec("analyze table t all columns")
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tableID := tbl.Meta().ID
+	tk.MustQuery("select count(*) from mysql.stats_histograms where table_id = ?", tableID).Check(testkit.Rows("0"))
+
+	h := dom.StatsHandle()
+	// The auto-analyze worker sees the static prune mode first.
+	h.HandleAutoAnalyze()
+	// The global stats are not built if it's disabled.
+	tk.MustExec("set @@global.tidb_partition_prune_mode = 'dynamic'")
+	h.HandleAutoAnalyze()
+	tk.MustQuery("select count(*) from mysql.stats_histograms where table_id = ?", tableID).Check(testkit.Rows("0"))
+
+	tk.MustExec("set @@global.tidb_partition_prune_mode = 'static'")
+	h.HandleAutoAnalyze()
+	tk.MustExec("set @@global.tidb_build_global_stats_on_dynamic_prune_mode = 1")
+	defer tk.MustExec("set @@global.tidb_build_global_stats_on_dynamic_prune_mode = default")
+	// The note is only shown when the auto-analyze is enabled, which builds the global stats.
+	hasNote := func() bool {
+		for _, row := range tk.MustQuery("show warnings").Rows() {
+			if row[0] == "Note" && strings.Contains(row[2].(string), "missing global stats") {
+				return true
+			}
+		}
+		return false
+	}
+	tk.MustExec("set @@global.tidb_enable_auto_analyze = 0")
+	defer tk.MustExec("set @@global.tidb_enable_auto_analyze = default")
+	tk.MustExec("set @@global.tidb_partition_prune_mode = 'dynamic'")
+	require.False(t, hasNote())
+	tk.MustExec("set @@global.tidb_partition_prune_mode = 'static'")
+	tk.MustExec("set @@global.tidb_enable_auto_analyze = 1")
+	tk.MustExec("set @@global.tidb_partition_prune_mode = 'dynamic'")
+	require.True(t, hasNote())
+	// Switching the mode doesn't build the global stats, the auto-analyze worker persists the job and runs it in the
+	// background.
+	tk.MustQuery("select count(*) from mysql.stats_histograms where table_id = ?", tableID).Check(testkit.Rows("0"))
+	h.HandleAutoAnalyze()
+	require.Eventually(t, func() bool {
+		rows := tk.MustQuery("show analyze status where job_info = 'merge global stats for dynamic prune mode'").Rows()
+		return len(rows) == 1 && rows[0][7] == "finished"
+	}, 10*time.Second, 100*time.Millisecond)
+	// Two columns and one index. The table t2 is skipped because none of its partitions is analyzed.
+	tk.MustQuery("select is_index, count(*) from mysql.stats_histograms where table_id = ? group by is_index order by is_index", tableID).Check(testkit.Rows("0 2", "1 1"))
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery("select * from mysql.tidb where variable_name = 'tidb_stats_missing_global_stats_job'").Rows()) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+	tk.MustQuery("select variable_value from mysql.tidb where variable_name = 'tidb_stats_last_seen_partition_prune_mode'").Check(testkit.Rows("dynamic"))
+
+	// The pending job left by the old owner is resumed.
+	tk.MustExec("delete from mysql.stats_histograms where table_id = ?", tableID)
+	tk.MustExec("insert into mysql.tidb values ('tidb_stats_missing_global_stats_job', 'pending', '')")
+	h.HandleAutoAnalyze()
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery("select * from mysql.tidb where variable_name = 'tidb_stats_missing_global_stats_job'").Rows()) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+	tk.MustQuery("select is_index, count(*) from mysql.stats_histograms where table_id = ? group by is_index order by is_index", tableID).Check(testkit.Rows("0 2", "1 1"))
+}
+
+func TestRefineGlobalStatsByTiFlash(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t, mockstore.WithMockTiFlash(2))
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec("create table t (a int, b int) partition by range (a) (partition p0 values less than (20000), partition p1 values less than (40000))")
+	// The NDV of the column a is too large to be recomputed by TiFlash.
+	values := make([]string, 0, 12000)
+	for i := range 12000 {
+		values = append(values, fmt.Sprintf("(%d, %d)", i, i%2))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ", "))
+	tk.MustExec("insert into t values (20000, 2), (20001, 2)")
+	tk.MustExec("analyze table t all columns")
+	// Set the TiFlash replicas by the DDLs rather than modifying the table info shared by the info schema.
+	tk.MustExec("alter table t set tiflash replica 1")
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tblInfo := tbl.Meta()
+	defs := tblInfo.GetPartitionInfo().Definitions
+	for _, def := range defs {
+		require.NoError(t, dom.DDLExecutor().UpdateTableReplicaInfo(tk.Session(), def.ID, true))
+	}
+	colB := tblInfo.Columns[1].ID
+	// The new rows of p1 are not counted in its stats, so they are missing in the merged global stats.
+	tk.MustExec("insert into t values (12000, 3), (20002, 4)")
+	tk.MustExec("analyze table t partition p0 all columns")
+	tk.MustQuery("select count from mysql.stats_meta where table_id = ?", tblInfo.ID).Check(testkit.Rows("12003"))
+	tk.MustQuery("select distinct_count from mysql.stats_histograms where table_id = ? and is_index = 0 and hist_id = ?", tblInfo.ID, colB).Check(testkit.Rows("4"))
+
+	tk.MustExec("set @@global.tidb_enable_tiflash_global_stats = 1")
+	defer tk.MustExec("set @@global.tidb_enable_tiflash_global_stats = default")
+	tk.MustExec("analyze table t partition p0 all columns")
+	tk.MustQuery("select count from mysql.stats_meta where table_id = ?", tblInfo.ID).Check(testkit.Rows("12004"))
+	tk.MustQuery("select distinct_count from mysql.stats_histograms where table_id = ? and is_index = 0 and hist_id = ?", tblInfo.ID, colB).Check(testkit.Rows("5"))
+	// The table isn't scanned again within the interval, and the result of the last scan is reused.
+	tk.MustExec("insert into t values (20003, 5)")
+	tk.MustExec("analyze table t partition p0 all columns")
+	tk.MustQuery("select count from mysql.stats_meta where table_id = ?", tblInfo.ID).Check(testkit.Rows("12004"))
+	tk.MustQuery("select distinct_count from mysql.stats_histograms where table_id = ? and is_index = 0 and hist_id = ?", tblInfo.ID, colB).Check(testkit.Rows("5"))
+
+	// The merged global stats are used if some partitions don't have TiFlash replicas.
+	require.NoError(t, dom.DDLExecutor().UpdateTableReplicaInfo(tk.Session(), defs[1].ID, false))
+	tk.MustExec("analyze table t partition p0 all columns")
+	tk.MustQuery("select count from mysql.stats_meta where table_id = ?", tblInfo.ID).Check(testkit.Rows("12003"))
+}
+
 func TestBuildGlobalLevelStats(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	testKit := testkit.NewTestKit(t, store)
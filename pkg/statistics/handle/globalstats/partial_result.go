@@ -0,0 +1,204 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package globalstats
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/statistics"
+	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxPartialMergeResults is the max number of the merged hists kept in the partial result cache.
+	maxPartialMergeResults = 1024
+	// partialMergeResultTTL is how long a merged hist is kept in the partial result cache.
+	partialMergeResultTTL = 30 * time.Minute
+)
+
+type partialMergeKey struct {
+	tableID int64
+	histID  int64
+	isIndex bool
+}
+
+// partialMergeResult is the global-level stats of one column or index which has been merged
+// by a failed async merge. It is only valid when the partition stats it was built from are not changed.
+type partialMergeResult struct {
+	createTime time.Time
+	hg         *statistics.Histogram
+	cms        *statistics.CMSketch
+	topN       *statistics.TopN
+	// partitionVersions is the versions of the partition hists used by the merge. The key is partition ID.
+	partitionVersions map[int64]uint64
+	ndv               int64
+	numBuckets        uint64
+	numTopN           uint64
+	analyzeVersion    int
+}
+
+func (r *partialMergeResult) match(partitionVersions map[int64]uint64, numBuckets, numTopN uint64, analyzeVersion int) bool {
+	return r.numBuckets == numBuckets && r.numTopN == numTopN && r.analyzeVersion == analyzeVersion &&
+		maps.Equal(r.partitionVersions, partitionVersions)
+}
+
+// partialMergeResultCache keeps the merged hists of the failed async merges, so the retry of the merge
+// can resume from where it stopped instead of merging all the hists again. It belongs to the stats handle.
+// The results built from the outdated partition stats, e.g. the partitions are analyzed again, are removed
+// when they are found mismatched, and the results of the tables changed by DDL are removed by the DDL handlers.
+type partialMergeResultCache struct {
+	results map[partialMergeKey]*partialMergeResult
+	mu      sync.Mutex
+}
+
+func newPartialMergeResultCache() *partialMergeResultCache {
+	return &partialMergeResultCache{
+		results: make(map[partialMergeKey]*partialMergeResult),
+	}
+}
+
+func (c *partialMergeResultCache) put(key partialMergeKey, result *partialMergeResult) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.results[key]; !ok && len(c.results) >= maxPartialMergeResults {
+		for k, r := range c.results {
+			if time.Since(r.createTime) > partialMergeResultTTL {
+				delete(c.results, k)
+			}
+		}
+		if len(c.results) >= maxPartialMergeResults {
+			return false
+		}
+	}
+	c.results[key] = result
+	return true
+}
+
+func (c *partialMergeResultCache) get(key partialMergeKey) (*partialMergeResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result, ok := c.results[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Since(result.createTime) > partialMergeResultTTL {
+		delete(c.results, key)
+		return nil, false
+	}
+	return result, true
+}
+
+func (c *partialMergeResultCache) remove(key partialMergeKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.results, key)
+}
+
+// removeTable removes all the merged column or index hists of the table.
+func (c *partialMergeResultCache) removeTable(tableID int64, isIndex bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.results {
+		if k.tableID == tableID && k.isIndex == isIndex {
+			delete(c.results, k)
+		}
+	}
+}
+
+// removeTableAll removes all the merged hists of the table, both the columns and the indexes.
+func (c *partialMergeResultCache) removeTableAll(tableID int64) {
+	c.removeTable(tableID, false)
+	c.removeTable(tableID, true)
+}
+
+// partitionVersionsOfHist returns the versions of the partition hists which are merged into the idx-th global hist.
+func (a *AsyncMergePartitionStats2GlobalStats) partitionVersionsOfHist(idx int) map[int64]uint64 {
+	histID := a.histIDs[idx]
+	versions := make(map[int64]uint64, len(a.partitionIDs))
+	for _, partitionID := range a.partitionIDs {
+		if _, ok := a.skipPartition[skipItem{histID: histID, partitionID: partitionID}]; ok {
+			continue
+		}
+		versions[partitionID] = a.partitionHistVersions[partitionID][histID]
+	}
+	return versions
+}
+
+// resumeFromPartialResults restores the global hists merged by a previous failed merge,
+// as long as the partition stats they were built from are not changed.
+func (a *AsyncMergePartitionStats2GlobalStats) resumeFromPartialResults(isIndex bool, opts map[ast.AnalyzeOptionType]uint64, analyzeVersion int) {
+	resumed := 0
+	for idx, histID := range a.histIDs {
+		key := partialMergeKey{tableID: a.globalTableInfo.ID, histID: histID, isIndex: isIndex}
+		result, ok := a.partialResults.get(key)
+		if !ok {
+			continue
+		}
+		if !result.match(a.partitionVersionsOfHist(idx), opts[ast.AnalyzeOptNumBuckets], opts[ast.AnalyzeOptNumTopN], analyzeVersion) {
+			// The partition stats or the options are changed, so the result can never be used again.
+			a.partialResults.remove(key)
+			continue
+		}
+		a.globalStats.Hg[idx] = result.hg.Copy()
+		a.globalStats.Cms[idx] = result.cms.Copy()
+		a.globalStats.TopN[idx] = result.topN.Copy()
+		a.globalStatsNDV[idx] = result.ndv
+		a.resumed[idx] = true
+		resumed++
+	}
+	if resumed > 0 {
+		statslogutil.StatsLogger().Info("resume merging global stats from the partial results",
+			zap.Int64("tableID", a.globalTableInfo.ID),
+			zap.Bool("isIndex", isIndex),
+			zap.Int("resumed", resumed),
+			zap.Int("total", len(a.histIDs)))
+	}
+}
+
+// savePartialResults keeps the global hists which have been merged before the merge fails.
+func (a *AsyncMergePartitionStats2GlobalStats) savePartialResults(isIndex bool, opts map[ast.AnalyzeOptionType]uint64, analyzeVersion int) {
+	saved := 0
+	for idx, histID := range a.histIDs {
+		if !a.merged[idx] || a.globalStats.Hg[idx] == nil {
+			continue
+		}
+		ok := a.partialResults.put(partialMergeKey{tableID: a.globalTableInfo.ID, histID: histID, isIndex: isIndex}, &partialMergeResult{
+			createTime:        time.Now(),
+			hg:                a.globalStats.Hg[idx],
+			cms:               a.globalStats.Cms[idx],
+			topN:              a.globalStats.TopN[idx],
+			partitionVersions: a.partitionVersionsOfHist(idx),
+			ndv:               a.globalStatsNDV[idx],
+			numBuckets:        opts[ast.AnalyzeOptNumBuckets],
+			numTopN:           opts[ast.AnalyzeOptNumTopN],
+			analyzeVersion:    analyzeVersion,
+		})
+		if !ok {
+			break
+		}
+		saved++
+	}
+	if saved > 0 {
+		statslogutil.StatsLogger().Info("save the partial results of the failed global stats merge",
+			zap.Int64("tableID", a.globalTableInfo.ID),
+			zap.Bool("isIndex", isIndex),
+			zap.Int("saved", saved),
+			zap.Int("total", len(a.histIDs)))
+	}
+}
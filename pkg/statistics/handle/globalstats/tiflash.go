@@ -0,0 +1,220 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package globalstats
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/types"
+	"go.uber.org/zap"
+)
+
+// maxExactNDVByTiFlash is the max merged NDV of a column whose NDV is recomputed by TiFlash.
+// The NDV merged from the FMSketches is an estimation, which is inaccurate for the low-cardinality columns.
+const maxExactNDVByTiFlash = 10000
+
+// tiflashScanInterval is the min interval between the TiFlash aggregate queries of a table. The merges of the columns
+// and the indexes of a table in one analyze, as well as the frequent merges triggered by the auto analyze of the
+// partitions, reuse the result of the last query within the interval rather than scanning the whole table again.
+var tiflashScanInterval = 10 * time.Minute
+
+// tiflashScanResult is the result of the TiFlash aggregate query of a table.
+type tiflashScanResult struct {
+	scanTime time.Time
+	// ndvs is the exact NDVs of the low-cardinality columns, keyed by the column ID.
+	ndvs  map[int64]int64
+	count int64
+}
+
+// tiflashScanResults caches the last result of the TiFlash aggregate query of each table, keyed by the table ID.
+var tiflashScanResults = struct {
+	results map[int64]*tiflashScanResult
+	sync.Mutex
+}{results: make(map[int64]*tiflashScanResult)}
+
+// getTiFlashScanResult returns the cached result of the table if it's scanned within the interval and contains the
+// NDVs of all the columns.
+func getTiFlashScanResult(tableID int64, colIDs []int64) *tiflashScanResult {
+	tiflashScanResults.Lock()
+	defer tiflashScanResults.Unlock()
+	result, ok := tiflashScanResults.results[tableID]
+	if !ok || time.Since(result.scanTime) >= tiflashScanInterval {
+		return nil
+	}
+	for _, id := range colIDs {
+		if _, ok := result.ndvs[id]; !ok {
+			return nil
+		}
+	}
+	return result
+}
+
+// putTiFlashScanResult caches the result of the table and removes the expired results of the other tables.
+func putTiFlashScanResult(tableID int64, result *tiflashScanResult) {
+	tiflashScanResults.Lock()
+	defer tiflashScanResults.Unlock()
+	for id, r := range tiflashScanResults.results {
+		if time.Since(r.scanTime) >= tiflashScanInterval {
+			delete(tiflashScanResults.results, id)
+		}
+	}
+	tiflashScanResults.results[tableID] = result
+}
+
+// allPartitionsHaveTiFlashReplica checks whether the TiFlash replicas of all the partitions are available.
+func allPartitionsHaveTiFlashReplica(tblInfo *model.TableInfo) bool {
+	replica := tblInfo.TiFlashReplica
+	if replica == nil || replica.Count == 0 || !replica.Available {
+		return false
+	}
+	for _, def := range tblInfo.GetPartitionInfo().Definitions {
+		if !replica.IsPartitionAvailable(def.ID) {
+			return false
+		}
+	}
+	return true
+}
+
+// exactNDVByTiFlashSupported checks whether the exact NDV of the column can be recomputed by TiFlash.
+func exactNDVByTiFlashSupported(col *model.ColumnInfo) bool {
+	tp := col.GetType()
+	return !col.IsVirtualGenerated() && !types.IsTypeBlob(tp) && tp != mysql.TypeJSON && tp != mysql.TypeTiDBVectorFloat32
+}
+
+// scaleGlobalStats scales the histograms and the TopNs of the global stats to the row count, so they stay consistent
+// with the row count after it's replaced by the exact one.
+func scaleGlobalStats(globalStats *GlobalStats, count int64) {
+	if globalStats.Count <= 0 || count == globalStats.Count {
+		globalStats.Count = count
+		return
+	}
+	factor := float64(count) / float64(globalStats.Count)
+	for i, hg := range globalStats.Hg {
+		if hg != nil {
+			hg.NullCount = int64(float64(hg.NullCount) * factor)
+			for j := range hg.Buckets {
+				hg.Buckets[j].Count = int64(float64(hg.Buckets[j].Count) * factor)
+				hg.Buckets[j].Repeat = int64(float64(hg.Buckets[j].Repeat) * factor)
+			}
+		}
+		if topN := globalStats.TopN[i]; topN != nil {
+			topN.Scale(factor)
+		}
+	}
+	globalStats.Count = count
+}
+
+// refineGlobalStatsByTiFlash recomputes the row count and the NDVs of the low-cardinality columns of the merged
+// global stats with a TiFlash aggregate query, which are exact rather than merged from the partition-level stats.
+// The histograms and the TopNs are scaled to the exact row count. It only works when all the partitions have TiFlash
+// replicas, and the table is scanned at most once per tiflashScanInterval. If the query fails, the merged global
+// stats are kept.
+func refineGlobalStatsByTiFlash(
+	statsHandle statstypes.StatsHandle,
+	is infoschema.InfoSchema,
+	globalTableInfo *model.TableInfo,
+	isIndex bool,
+	globalStats *GlobalStats,
+) {
+	if globalTableInfo.GetPartitionInfo() == nil || !allPartitionsHaveTiFlashReplica(globalTableInfo) {
+		return
+	}
+	db, ok := is.SchemaByID(globalTableInfo.DBID)
+	if !ok {
+		return
+	}
+
+	var sql strings.Builder
+	sql.WriteString("select /*+ read_from_storage(tiflash[%n]) */ count(*)")
+	args := []any{globalTableInfo.Name.O}
+	// The positions and the column IDs of the global hists whose NDVs are recomputed.
+	var (
+		ndvHists []int
+		colIDs   []int64
+	)
+	if !isIndex {
+		for i, hg := range globalStats.Hg {
+			if hg == nil || hg.NDV > maxExactNDVByTiFlash {
+				continue
+			}
+			col := globalTableInfo.FindColumnByID(hg.ID)
+			if col == nil || !exactNDVByTiFlashSupported(col) {
+				continue
+			}
+			sql.WriteString(", count(distinct %n)")
+			args = append(args, col.Name.O)
+			colIDs = append(colIDs, col.ID)
+			ndvHists = append(ndvHists, i)
+		}
+	}
+	sql.WriteString(" from %n.%n")
+	args = append(args, db.Name.O, globalTableInfo.Name.O)
+
+	result := getTiFlashScanResult(globalTableInfo.ID, colIDs)
+	if result == nil {
+		err := util.CallWithSCtx(statsHandle.SPool(), func(sctx sessionctx.Context) error {
+			rows, _, err := util.ExecRows(sctx, sql.String(), args...)
+			if err != nil {
+				return err
+			}
+			if len(rows) != 1 {
+				return errors.Errorf("unexpected result of the TiFlash aggregate query, rows: %d", len(rows))
+			}
+			result = &tiflashScanResult{
+				scanTime: time.Now(),
+				ndvs:     make(map[int64]int64, len(colIDs)),
+				count:    rows[0].GetInt64(0),
+			}
+			for i, id := range colIDs {
+				result.ndvs[id] = rows[0].GetInt64(i + 1)
+			}
+			return nil
+		})
+		if err != nil {
+			statslogutil.StatsLogger().Warn("refine the global stats by TiFlash failed, use the merged global stats",
+				zap.Int64("tableID", globalTableInfo.ID),
+				zap.String("table", globalTableInfo.Name.O),
+				zap.Error(err))
+			return
+		}
+		putTiFlashScanResult(globalTableInfo.ID, result)
+	}
+	scaleGlobalStats(globalStats, result.count)
+	for i, idx := range ndvHists {
+		globalStats.Hg[idx].NDV = result.ndvs[colIDs[i]]
+	}
+	// The NDVs of the other hists can't exceed the exact row count.
+	for _, hg := range globalStats.Hg {
+		if hg != nil && hg.NDV > globalStats.Count {
+			hg.NDV = globalStats.Count
+		}
+	}
+	statslogutil.StatsLogger().Info("refine the global stats by TiFlash",
+		zap.Int64("tableID", globalTableInfo.ID),
+		zap.String("table", globalTableInfo.Name.O),
+		zap.Bool("isIndex", isIndex),
+		zap.Int64("count", globalStats.Count),
+		zap.Int("exactNDVs", len(ndvHists)))
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package globalstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScaleGlobalStats(t *testing.T) {
+	hg := statistics.NewHistogram(1, 2, 10, 0, types.NewFieldType(mysql.TypeLong), 2, 0)
+	lower, upper := types.NewIntDatum(1), types.NewIntDatum(2)
+	hg.AppendBucket(&lower, &lower, 20, 10)
+	hg.AppendBucket(&upper, &upper, 50, 30)
+	topN := statistics.NewTopN(1)
+	topN.AppendTopN([]byte("a"), 40)
+	globalStats := newGlobalStats(1)
+	globalStats.Hg[0], globalStats.TopN[0], globalStats.Count = hg, topN, 100
+
+	scaleGlobalStats(globalStats, 200)
+	require.Equal(t, int64(200), globalStats.Count)
+	require.Equal(t, int64(20), hg.NullCount)
+	require.Equal(t, []statistics.Bucket{{Count: 40, Repeat: 20}, {Count: 100, Repeat: 60}}, hg.Buckets)
+	require.Equal(t, uint64(80), topN.TopN[0].Count)
+}
+
+func TestTiFlashScanResultCache(t *testing.T) {
+	putTiFlashScanResult(1, &tiflashScanResult{scanTime: time.Now(), ndvs: map[int64]int64{1: 10}, count: 100})
+	require.Equal(t, int64(100), getTiFlashScanResult(1, nil).count)
+	require.Equal(t, int64(100), getTiFlashScanResult(1, []int64{1}).count)
+	// The NDVs of the column 2 aren't cached.
+	require.Nil(t, getTiFlashScanResult(1, []int64{1, 2}))
+	require.Nil(t, getTiFlashScanResult(2, nil))
+
+	// The expired results are not reused and removed.
+	putTiFlashScanResult(2, &tiflashScanResult{scanTime: time.Now().Add(-tiflashScanInterval), count: 100})
+	require.Nil(t, getTiFlashScanResult(2, nil))
+	putTiFlashScanResult(3, &tiflashScanResult{scanTime: time.Now()})
+	tiflashScanResults.Lock()
+	require.Len(t, tiflashScanResults.results, 2)
+	tiflashScanResults.Unlock()
+}
@@ -15,13 +15,19 @@
 package handle
 
 import (
+	"encoding/binary"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pingcap/tidb/pkg/ddl/notifier"
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/metrics"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/sysproctrack"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/handle/autoanalyze"
 	"github.com/pingcap/tidb/pkg/statistics/handle/cache"
@@ -37,7 +43,11 @@ import (
 	"github.com/pingcap/tidb/pkg/statistics/handle/util"
 	pkgutil "github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/intest"
+	"github.com/pingcap/tidb/pkg/util/kvcache"
+	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -96,8 +106,40 @@ type Handle struct {
 
 	InitStatsDone chan struct{}
 
+	// cardinalityFeedback collects the runtime cardinality feedback of the statements.
+	cardinalityFeedback *statistics.CardinalityFeedback
+
+	// dynamicSampleGroup merges the concurrent dynamic sampling of the same table.
+	dynamicSampleGroup singleflight.Group
+
+	// regionRowCounts caches the row counts of the physical tables estimated by their regions, see GetRegionRowCount.
+	regionRowCounts   *kvcache.SimpleLRUCache
+	regionRowCountsMu sync.Mutex
+	// regionRowCountGroup merges the concurrent refreshing of the region row count of the same table.
+	regionRowCountGroup singleflight.Group
+	// regionRowCountLimiter limits the rate of refreshing the region row counts, each of which requests the PD.
+	regionRowCountLimiter *rate.Limiter
+
+	// snapshotStats caches the stats of the physical tables restored from the historical stats, see GetStatsAtSnapshot.
+	snapshotStats   *kvcache.SimpleLRUCache
+	snapshotStatsMu sync.Mutex
+
+	// inListSelCache caches the selectivities of the constants in the IN lists probed against the stats.
+	inListSelCache *statistics.InListSelCache
+
+	// pseudoStatsUsage counts the pseudo stats usages of the tables by the optimizer in the last hour.
+	pseudoStatsUsage *statistics.PseudoStatsUsage
+
+	// lastSyncLoads and lastSyncLoadTimeouts are the sync load counts at the last UpdateStatsAlertMetrics, from
+	// which the timeout ratio since then is derived.
+	lastSyncLoads        int64
+	lastSyncLoadTimeouts int64
+
 	// StatsCache ...
 	types.StatsCache
+
+	// statsStorage is the backend persisting the stats, which can be replaced concurrently by SetStatsStorage.
+	statsStorage atomic.Pointer[types.StatsStorage]
 }
 
 // Clear the statsCache, only for test.
@@ -107,6 +149,15 @@ func (h *Handle) Clear() {
 		<-h.DDLEventCh()
 	}
 	h.ResetSessionStatsList()
+	h.cardinalityFeedback.Reset()
+	h.regionRowCountsMu.Lock()
+	h.regionRowCounts.DeleteAll()
+	h.regionRowCountsMu.Unlock()
+	h.snapshotStatsMu.Lock()
+	h.snapshotStats.DeleteAll()
+	h.snapshotStatsMu.Unlock()
+	h.inListSelCache.Clear()
+	h.pseudoStatsUsage.Reset()
 }
 
 // NewHandle creates a Handle for update stats.
@@ -122,10 +173,18 @@ func NewHandle(
 	releaseAutoAnalyzeProcID func(uint64),
 ) (*Handle, error) {
 	handle := &Handle{
-		InitStatsDone:   make(chan struct{}),
-		TableInfoGetter: util.NewTableInfoGetter(),
-		StatsLock:       lockstats.NewStatsLock(pool),
+		InitStatsDone:       make(chan struct{}),
+		TableInfoGetter:     util.NewTableInfoGetter(),
+		StatsLock:           lockstats.NewStatsLock(pool),
+		cardinalityFeedback: statistics.NewCardinalityFeedback(),
+		snapshotStats:       kvcache.NewSimpleLRUCache(snapshotStatsCacheCapacity, 0, 0),
+		inListSelCache:      statistics.NewInListSelCache(),
+		pseudoStatsUsage:    statistics.NewPseudoStatsUsage(),
+
+		regionRowCounts:       kvcache.NewSimpleLRUCache(regionRowCountCacheCapacity, 0, 0),
+		regionRowCountLimiter: rate.NewLimiter(regionRowCountRefreshRate, regionRowCountRefreshBurst),
 	}
+	handle.SetStatsStorage(storage.NewSystemTableStorage(handle))
 	handle.StatsGC = storage.NewStatsGC(handle)
 	handle.StatsReadWriter = storage.NewStatsReadWriter(handle)
 
@@ -138,6 +197,9 @@ func NewHandle(
 	handle.AutoAnalyzeProcIDGenerator = util.NewGenerator(autoAnalyzeProcIDGetter, releaseAutoAnalyzeProcID)
 	handle.LeaseGetter = util.NewLeaseGetter(lease)
 	handle.StatsCache = statsCache
+	util.RegisterStatsMemoryConsumer(util.MemoryConsumerStatsCache, util.StatsCacheMemTracker, statsCache.Shrink)
+	util.RegisterStatsMemoryConsumer(util.MemoryConsumerGlobalStatsMerge, util.GlobalStatsMergeMemTracker, nil)
+	util.RegisterStatsMemoryConsumer(util.MemoryConsumerStatsDelta, util.StatsDeltaMemTracker, nil)
 	handle.StatsHistory = history.NewStatsHistory(handle)
 	handle.StatsUsage = usage.NewStatsUsageImpl(handle)
 	handle.StatsAnalyze = autoanalyze.NewStatsAnalyze(handle, tracker, ddlNotifier)
@@ -147,6 +209,10 @@ func NewHandle(
 		handle.StatsReadWriter,
 		handle,
 	)
+	handle.DDL.RegisterDDLEventHandlers(history.DDLEventHandlers(handle.StatsCache))
+	handle.DDL.RegisterDDLEventHandlers(usage.PredicateColumnsDDLEventHandlers())
+	handle.DDL.RegisterDDLEventHandlers(storage.AnalyzeOptionsDDLEventHandlers())
+	handle.DDL.RegisterDDLEventHandlers(handle.StatsGlobal.DDLEventHandlers())
 	if ddlNotifier != nil {
 		// In test environments, we use a channel-based approach to handle DDL events.
 		// This maintains compatibility with existing test cases that expect events to be delivered through channels.
@@ -158,6 +224,16 @@ func NewHandle(
 	return handle, nil
 }
 
+// StatsStorage returns the backend persisting the stats.
+func (h *Handle) StatsStorage() types.StatsStorage {
+	return *h.statsStorage.Load()
+}
+
+// SetStatsStorage replaces the backend persisting the stats. The calls in progress keep using the old backend.
+func (h *Handle) SetStatsStorage(backend types.StatsStorage) {
+	h.statsStorage.Store(&backend)
+}
+
 // GetTableStats retrieves the statistics table from cache, and the cache will be updated by a goroutine.
 // TODO: remove GetTableStats later on.
 func (h *Handle) GetTableStats(tblInfo *model.TableInfo) *statistics.Table {
@@ -204,6 +280,299 @@ func (h *Handle) getPartitionStats(tblInfo *model.TableInfo, pid int64, returnPs
 	return tbl
 }
 
+// GetPartitionCardinality returns the row count summary of all the partitions of the given table, partColID is the
+// ID of the column the partitions are decided by, or 0 if they're decided by multiple columns.
+// It's built from the partition-level stats in the cache, and returns nil if the table is not partitioned.
+func (h *Handle) GetPartitionCardinality(tblInfo *model.TableInfo, partColID int64) *statistics.PartitionCardinality {
+	pi := tblInfo.GetPartitionInfo()
+	if h == nil || pi == nil {
+		return nil
+	}
+	pids := make([]int64, 0, len(pi.Definitions))
+	for _, def := range pi.Definitions {
+		pids = append(pids, def.ID)
+	}
+	return statistics.NewPartitionCardinality(pids, partColID, func(pid int64) *statistics.Table {
+		tbl, ok := h.Get(pid)
+		if !ok {
+			return nil
+		}
+		return tbl
+	})
+}
+
+// RecordCardinalityFeedback records the estimated and the actual row counts of a table reader of the statement.
+// It does nothing if tidb_enable_cardinality_feedback is off.
+func (h *Handle) RecordCardinalityFeedback(sqlDigest string, tableID int64, estRows, actRows float64) {
+	if h == nil || !vardef.EnableCardinalityFeedback.Load() {
+		return
+	}
+	key := statistics.CardinalityFeedbackKey{SQLDigest: sqlDigest, TableID: tableID}
+	if h.cardinalityFeedback.Record(key, estRows, actRows) {
+		metrics.CardinalityFeedbackCounter.WithLabelValues("evict").Inc()
+	}
+	metrics.CardinalityFeedbackCounter.WithLabelValues("record").Inc()
+}
+
+// GetCardinalityFeedbackFactor returns the factor to correct the estimated row count of the table in the statement.
+// The ok is false if tidb_enable_cardinality_feedback is off or the collected feedback is not stable enough.
+func (h *Handle) GetCardinalityFeedbackFactor(sqlDigest string, tableID int64) (factor float64, ok bool) {
+	if h == nil || !vardef.EnableCardinalityFeedback.Load() {
+		return 1, false
+	}
+	key := statistics.CardinalityFeedbackKey{SQLDigest: sqlDigest, TableID: tableID}
+	factor, ok = h.cardinalityFeedback.CorrectionFactor(key, vardef.CardinalityFeedbackMinSamples.Load())
+	if ok {
+		metrics.CardinalityFeedbackCounter.WithLabelValues("apply").Inc()
+	}
+	return factor, ok
+}
+
+// InListSelCache returns the cache of the selectivities of the constants in the IN lists probed against the stats.
+func (h *Handle) InListSelCache() *statistics.InListSelCache {
+	return h.inListSelCache
+}
+
+// RecordPseudoStatsUsage records that the optimizer uses the pseudo stats of the table.
+func (h *Handle) RecordPseudoStatsUsage(tableID int64) {
+	if h == nil {
+		return
+	}
+	h.pseudoStatsUsage.Record(tableID, time.Now())
+}
+
+// GetPseudoStatsUsage returns how many times the optimizer used the pseudo stats of the table in the last hour.
+func (h *Handle) GetPseudoStatsUsage(tableID int64) int64 {
+	if h == nil {
+		return 0
+	}
+	return h.pseudoStatsUsage.Count(tableID, time.Now())
+}
+
+// GetDynamicSample returns the dynamic sample of the table which has no usable stats. The sample cached with the
+// stats of the table is returned if it has enough rows and the stats meta of the table is not changed since it's
+// taken, otherwise at most maxRows rows are sampled from the table and the sample is cached in the stats cache,
+// unless the stats of the table are changed during the sampling.
+func (h *Handle) GetDynamicSample(dbName string, tblInfo *model.TableInfo, maxRows int) (*statistics.DynamicSample, error) {
+	tbl, ok := h.Get(tblInfo.ID)
+	if ok && tbl.DynamicSample != nil && !tbl.DynamicSample.IsOutdated(tbl.Version, tbl.ModifyCount) &&
+		(tbl.DynamicSample.Complete || tbl.DynamicSample.RowCount() >= maxRows) {
+		metrics.DynamicSamplingCounter.WithLabelValues("hit").Inc()
+		return tbl.DynamicSample, nil
+	}
+	res, err, _ := h.dynamicSampleGroup.Do(strconv.FormatInt(tblInfo.ID, 10), func() (any, error) {
+		var sample *statistics.DynamicSample
+		err := util.CallWithSCtx(h.SPool(), func(sctx sessionctx.Context) (err error) {
+			sample, err = storage.SampleTableRows(sctx, dbName, tblInfo, maxRows)
+			return err
+		})
+		if err != nil {
+			metrics.DynamicSamplingCounter.WithLabelValues("error").Inc()
+			return nil, err
+		}
+		if sample == nil {
+			return sample, nil
+		}
+		metrics.DynamicSamplingCounter.WithLabelValues("sample").Inc()
+		if ok {
+			sample.Version, sample.ModifyCount = tbl.Version, tbl.ModifyCount
+		}
+		if latest, ok := h.Get(tblInfo.ID); ok && latest == tbl {
+			latest = latest.ShallowCopy()
+			latest.DynamicSample = sample
+			h.UpdateStatsCache(types.CacheUpdate{
+				Updated: []*statistics.Table{latest},
+				Options: types.UpdateOptions{SkipMoveForward: true},
+			})
+		}
+		return sample, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*statistics.DynamicSample), nil
+}
+
+// regionRowCount is the row count of a physical table estimated by its regions.
+type regionRowCount struct {
+	count      int64
+	updateTime time.Time
+}
+
+const (
+	// regionRowCountTTL is the duration after which the cached region row count is refreshed.
+	regionRowCountTTL = time.Minute
+	// regionRowCountCacheCapacity is the max number of the region row counts cached in the handle.
+	regionRowCountCacheCapacity = 10000
+	// regionRowCountRefreshRate is the max number of the region row counts refreshed per second.
+	regionRowCountRefreshRate = 5
+	// regionRowCountRefreshBurst is the max number of the region row counts refreshed at once.
+	regionRowCountRefreshBurst = 10
+)
+
+type regionRowCountKey int64
+
+// Hash implements kvcache.Key.
+func (k regionRowCountKey) Hash() []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(k))
+	return buf
+}
+
+// GetRegionRowCount returns the row count of the physical table estimated by the approximate keys of its regions,
+// which is used as the row count of the pseudo stats of the tables never analyzed. The estimation is refreshed in
+// the background if it's missing or expired, so the optimization is never blocked by it, and ok is false until the
+// first estimation is done. The refreshing is rate limited since each one requests the regions from the PD.
+func (h *Handle) GetRegionRowCount(physicalID int64) (count int64, ok bool) {
+	if h == nil {
+		return 0, false
+	}
+	key := regionRowCountKey(physicalID)
+	h.regionRowCountsMu.Lock()
+	cached, ok := h.regionRowCounts.Get(key)
+	h.regionRowCountsMu.Unlock()
+	if !ok || time.Since(cached.(*regionRowCount).updateTime) > regionRowCountTTL {
+		h.regionRowCountGroup.DoChan(strconv.FormatInt(physicalID, 10), func() (any, error) {
+			if !h.regionRowCountLimiter.Allow() {
+				return nil, nil
+			}
+			var count int64
+			err := util.CallWithSCtx(h.SPool(), func(sctx sessionctx.Context) (err error) {
+				count, err = storage.EstimateRowCountByRegions(sctx, physicalID)
+				return err
+			})
+			if err != nil {
+				statslogutil.StatsLogger().Warn("failed to estimate the row count by the regions",
+					zap.Int64("physicalID", physicalID), zap.Error(err))
+			}
+			h.regionRowCountsMu.Lock()
+			h.regionRowCounts.Put(key, &regionRowCount{count: count, updateTime: time.Now()})
+			h.regionRowCountsMu.Unlock()
+			return nil, err
+		})
+	}
+	if !ok {
+		return 0, false
+	}
+	count = cached.(*regionRowCount).count
+	return count, count > 0
+}
+
+// snapshotStatsCacheCapacity is the max number of the stats restored from the historical stats cached in the handle.
+const snapshotStatsCacheCapacity = 64
+
+type snapshotStatsKey struct {
+	physicalID int64
+	snapshot   uint64
+}
+
+// Hash implements kvcache.Key.
+func (k snapshotStatsKey) Hash() []byte {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf, uint64(k.physicalID))
+	binary.BigEndian.PutUint64(buf[8:], k.snapshot)
+	return buf
+}
+
+// GetStatsAtSnapshot returns the stats of the physical table which existed at the snapshot, restored from the
+// historical stats in mysql.stats_history and mysql.stats_meta_history. It returns nil if there are no historical stats
+// of the table at the snapshot, e.g. tidb_enable_historical_stats was off or they are already GCed.
+// The restored stats are cached if the snapshot is in the past, since the historical stats at it never change then.
+func (h *Handle) GetStatsAtSnapshot(tblInfo *model.TableInfo, physicalID int64, snapshot uint64) (*statistics.Table, error) {
+	key := snapshotStatsKey{physicalID: physicalID, snapshot: snapshot}
+	h.snapshotStatsMu.Lock()
+	cached, ok := h.snapshotStats.Get(key)
+	h.snapshotStatsMu.Unlock()
+	if ok {
+		return cached.(*statistics.Table), nil
+	}
+	jsonTbl, exist, err := h.StatsStorage().History(physicalID, snapshot)
+	if err != nil || !exist {
+		return nil, err
+	}
+	tbl, err := storage.TableStatsFromJSON(tblInfo, physicalID, jsonTbl)
+	if err != nil {
+		return nil, err
+	}
+	tbl.Version = jsonTbl.Version
+	tbl.ForEachColumnImmutable(func(_ int64, col *statistics.Column) bool {
+		tbl.LastAnalyzeVersion = max(tbl.LastAnalyzeVersion, col.LastUpdateVersion)
+		return false
+	})
+	tbl.ForEachIndexImmutable(func(_ int64, idx *statistics.Index) bool {
+		tbl.LastAnalyzeVersion = max(tbl.LastAnalyzeVersion, idx.LastUpdateVersion)
+		return false
+	})
+	tbl.ColAndIdxExistenceMap.SetChecked()
+	if oracle.GetTimeFromTS(snapshot).Before(time.Now()) {
+		h.snapshotStatsMu.Lock()
+		h.snapshotStats.Put(key, tbl)
+		h.snapshotStatsMu.Unlock()
+	}
+	return tbl, nil
+}
+
+// costCalibrationInterval is the interval to refit the calibration profile of the cost model.
+const costCalibrationInterval = time.Hour
+
+// CostCalibrator fits and applies the calibration profile of the cost model. It's set by the planner, so the stats
+// handle doesn't depend on the cost model.
+var CostCalibrator types.CostCalibrator
+
+// CalibrateCostModel fits the calibration profile of the cost model from the statement summary of the cluster, and
+// saves it to the storage. The profile is kept if the samples are not enough to fit a new one.
+func (h *Handle) CalibrateCostModel() error {
+	if CostCalibrator == nil {
+		return nil
+	}
+	return util.CallWithSCtx(h.SPool(), func(sctx sessionctx.Context) error {
+		samples, err := storage.CollectCostCalibrationSamples(sctx)
+		if err != nil {
+			return err
+		}
+		profile, err := CostCalibrator.Fit(samples)
+		if err != nil {
+			return err
+		}
+		if profile == nil {
+			statslogutil.StatsLogger().Info("the samples are not enough to calibrate the cost model",
+				zap.Int("samples", len(samples)))
+			return nil
+		}
+		if err := storage.SaveCostCalibrationProfile(sctx, profile); err != nil {
+			return err
+		}
+		if err := CostCalibrator.Apply(profile); err != nil {
+			return err
+		}
+		statslogutil.StatsLogger().Info("the cost model is calibrated",
+			zap.ByteString("profile", profile), zap.Int("samples", len(samples)))
+		return nil
+	})
+}
+
+// UpdateCostCalibration refits the calibration profile of the cost model on the stats owner once it's older than
+// costCalibrationInterval, and loads the profile saved by the owner on the other instances. It does nothing if
+// tidb_enable_cost_calibration is off.
+func (h *Handle) UpdateCostCalibration(isOwner bool) error {
+	if !vardef.EnableCostCalibration.Load() || CostCalibrator == nil {
+		return nil
+	}
+	if isOwner {
+		if time.Since(CostCalibrator.FittedAt()) > costCalibrationInterval {
+			return h.CalibrateCostModel()
+		}
+		return nil
+	}
+	return util.CallWithSCtx(h.SPool(), func(sctx sessionctx.Context) error {
+		profile, err := storage.LoadCostCalibrationProfile(sctx)
+		if err != nil || profile == nil {
+			return err
+		}
+		return CostCalibrator.Apply(profile)
+	})
+}
+
 // GetPartitionStatsByID retrieves the partition stats from cache by partition ID.
 func (h *Handle) GetPartitionStatsByID(is infoschema.InfoSchema, pid int64) *statistics.Table {
 	return h.getPartitionStatsByID(is, pid)
@@ -135,7 +135,38 @@ func TestAnalyzeVirtualCol(t *testing.T) {
 	analyzehelper.TriggerPredicateColumnsCollection(t, tk, store, "t", "a", "b", "c")
 	tk.MustExec("set @@tidb_analyze_version = 2")
 	tk.MustExec("analyze table t")
-	require.Len(t, tk.MustQuery("show stats_histograms where table_name ='t'").Rows(), 3)
+	require.Len(t, tk.MustQuery("show stats_histograms where table_name ='t'").Rows(), 4)
+}
+
+func TestAnalyzeVirtualColInPredicate(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_analyze_version = 2")
+	tk.MustExec("create table t(a int, b int, c int generated always as (a + b) virtual, d int generated always as (c * 2) virtual)")
+	for i := 0; i < 20; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t(a, b) values(%d, %d)", i%5, i%2))
+	}
+	tk.MustExec("insert into t(a, b) values(NULL, 1), (NULL, 2)")
+	// Only the virtual column d is used in the predicates.
+	analyzehelper.TriggerPredicateColumnsCollection(t, tk, store, "t", "d")
+	tk.MustExec("analyze table t predicate columns")
+
+	// The virtual column c and the columns making up d are analyzed too so that d can be evaluated from the samples.
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tblInfo := tbl.Meta()
+	tk.MustQuery(fmt.Sprintf("select hist_id, distinct_count, null_count from mysql.stats_histograms where table_id = %d and is_index = 0 order by hist_id", tblInfo.ID)).Check(testkit.Rows(
+		fmt.Sprintf("%d 5 2", tblInfo.Columns[0].ID),
+		fmt.Sprintf("%d 3 0", tblInfo.Columns[1].ID),
+		fmt.Sprintf("%d 6 2", tblInfo.Columns[2].ID),
+		fmt.Sprintf("%d 6 2", tblInfo.Columns[3].ID),
+	))
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_top_n where table_id = %d and is_index = 0 and hist_id = %d", tblInfo.ID, tblInfo.Columns[3].ID)).Check(testkit.Rows("6"))
+	// The estimation on d uses its stats instead of the pseudo selectivity.
+	rows := tk.MustQuery("explain format = 'brief' select * from t where d = 4").Rows()
+	require.Equal(t, "Selection", rows[0][0])
+	require.Equal(t, "4.00", rows[0][1])
 }
 
 func TestAnalyzeGlobalStatsWithOpts1(t *testing.T) {
@@ -25,8 +25,11 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/metrics"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/planner/cardinality"
+	"github.com/pingcap/tidb/pkg/planner/core/cost"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/handle"
@@ -38,6 +41,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/mock"
 	"github.com/pingcap/tidb/pkg/util/ranger"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"github.com/tikv/client-go/v2/oracle"
 )
@@ -1513,3 +1517,101 @@ func TestStatsCacheUpdateTimeout(t *testing.T) {
 	require.Equal(t, 6, int(globalStats2.RealtimeCount))
 	require.Equal(t, 2, int(globalStats2.ModifyCount))
 }
+
+func TestCalibrateCostModel(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	// The statement summary only records the statements of the authenticated users.
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	defer cost.SetCalibrationProfile(nil)
+	fpName := "github.com/pingcap/tidb/pkg/statistics/handle/storage/mockLocalStmtSummary"
+	require.NoError(t, failpoint.Enable(fpName, "return"))
+	defer func() {
+		require.NoError(t, failpoint.Disable(fpName))
+	}()
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index ia(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	h := dom.StatsHandle()
+
+	// The profile is not fitted if the samples are not enough.
+	require.NoError(t, h.CalibrateCostModel())
+	require.Nil(t, cost.GetCalibrationProfile())
+	tk.MustQuery("select * from mysql.tidb where variable_name = 'tidb_cost_calibration_profile'").Check(testkit.Rows())
+
+	for i := range cost.MinCalibrationSamples {
+		tk.MustQuery("select * from t where b > 0" + strings.Repeat(" and a > 0", i))
+	}
+	require.NoError(t, h.CalibrateCostModel())
+	profile := cost.GetCalibrationProfile()
+	require.NotNil(t, profile)
+	require.GreaterOrEqual(t, profile.Samples, cost.MinCalibrationSamples)
+	require.True(t, profile.ScanNsPerRow > 0 || profile.LookupNsPerTask > 0 || profile.NetNsPerRow > 0)
+
+	// The saved profile is loaded by the instances which are not the stats owner, only if the calibration is enabled.
+	cost.SetCalibrationProfile(nil)
+	require.NoError(t, h.UpdateCostCalibration(false))
+	require.Nil(t, cost.GetCalibrationProfile())
+	tk.MustExec("set global tidb_enable_cost_calibration = on")
+	defer tk.MustExec("set global tidb_enable_cost_calibration = default")
+	require.NoError(t, h.UpdateCostCalibration(false))
+	loaded := cost.GetCalibrationProfile()
+	require.NotNil(t, loaded)
+	require.Equal(t, profile.LookupNsPerTask, loaded.LookupNsPerTask)
+	require.Equal(t, profile.Samples, loaded.Samples)
+}
+
+func TestStatsAlertMetrics(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("create table pt (a int, b int) partition by hash(a) partitions 2")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4)")
+	tk.MustExec("insert into pt values (1, 1), (2, 2), (3, 3), (4, 4)")
+	tk.MustExec("analyze table t, pt all columns")
+	tk.MustExec("insert into t values (5, 5), (6, 6), (7, 7), (8, 8)")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+
+	// The unhealthy table isn't counted until it's used by the queries.
+	require.NoError(t, h.UpdateStatsAlertMetrics(dom.InfoSchema(), true))
+	require.Equal(t, 0.0, promtestutil.ToFloat64(metrics.UnhealthyHotTablesGauge))
+	require.Equal(t, 0.0, promtestutil.ToFloat64(metrics.OldestFailedAutoAnalyzeGauge))
+	require.Equal(t, 0.0, promtestutil.ToFloat64(metrics.SyncLoadTimeoutRatioGauge))
+	require.Equal(t, 0.0, promtestutil.ToFloat64(metrics.GlobalStatsMergeBacklogGauge))
+
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tk.MustExec("insert into mysql.column_stats_usage (table_id, column_id, last_used_at) values (%?, 1, now())", tbl.Meta().ID)
+	tk.MustExec(`insert into mysql.analyze_jobs (table_schema, table_name, job_info, start_time, end_time, state, instance) values
+		('test', 't', 'auto analyze table all columns', now() - interval 2 hour, now() - interval 2 hour, 'failed', '127.0.0.1:4000')`)
+	// The partition analyzed in the static prune mode isn't merged into the global stats.
+	tk.MustExec("set @@tidb_partition_prune_mode = 'static'")
+	tk.MustExec("analyze table pt partition p0 all columns")
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	require.NoError(t, h.UpdateStatsAlertMetrics(dom.InfoSchema(), true))
+	require.Equal(t, 1.0, promtestutil.ToFloat64(metrics.UnhealthyHotTablesGauge))
+	require.GreaterOrEqual(t, promtestutil.ToFloat64(metrics.OldestFailedAutoAnalyzeGauge), 2*time.Hour.Seconds())
+	require.Equal(t, 1.0, promtestutil.ToFloat64(metrics.GlobalStatsMergeBacklogGauge))
+
+	// The threshold excludes the table.
+	tk.MustExec("set global tidb_stats_alert_healthy_threshold = 0")
+	defer tk.MustExec("set global tidb_stats_alert_healthy_threshold = default")
+	require.NoError(t, h.UpdateStatsAlertMetrics(dom.InfoSchema(), true))
+	require.Equal(t, 0.0, promtestutil.ToFloat64(metrics.UnhealthyHotTablesGauge))
+
+	// The failure followed by a success isn't counted.
+	tk.MustExec(`insert into mysql.analyze_jobs (table_schema, table_name, job_info, start_time, end_time, state, instance) values
+		('test', 't', 'auto analyze table all columns', now() - interval 1 hour, now() - interval 1 hour, 'finished', '127.0.0.1:4000')`)
+	require.NoError(t, h.UpdateStatsAlertMetrics(dom.InfoSchema(), true))
+	require.Equal(t, 0.0, promtestutil.ToFloat64(metrics.OldestFailedAutoAnalyzeGauge))
+
+	// Only the stats owner reports the metrics read from the system tables.
+	tk.MustExec(`insert into mysql.analyze_jobs (table_schema, table_name, job_info, start_time, end_time, state, instance) values
+		('test', 't', 'auto analyze table all columns', now(), now(), 'failed', '127.0.0.1:4000')`)
+	require.NoError(t, h.UpdateStatsAlertMetrics(dom.InfoSchema(), false))
+	require.Equal(t, 0.0, promtestutil.ToFloat64(metrics.OldestFailedAutoAnalyzeGauge))
+	require.Equal(t, 1.0, promtestutil.ToFloat64(metrics.GlobalStatsMergeBacklogGauge))
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/stretchr/testify/require"
@@ -365,6 +366,134 @@ func TestDeltaInLockInfoCanBeNegative(t *testing.T) {
 	require.Equal(t, "4", rows[0][1])
 }
 
+func TestLockTableStatsWithExpiration(t *testing.T) {
+	_, dom, tk, tbl := setupTestEnvironmentWithPartitionedTableT(t)
+
+	h := dom.StatsHandle()
+	tk.MustGetErrMsg("lock stats t until timestamp '2020-01-01 00:00:00'", "Lock Stats: the expiration should be in the future")
+
+	// The lock is kept before it expires.
+	tk.MustExec("lock stats t for interval 7 day")
+	require.NoError(t, h.RemoveExpiredLockedTables(dom.InfoSchema()))
+	tk.MustQuery(selectTableLockSQL).Check(testkit.Rows("3"))
+	tk.MustExec("unlock stats t")
+
+	tk.MustExec("lock stats t until timestamp now() + interval 1 second")
+	tk.MustQuery(selectTableLockSQL).Check(testkit.Rows("3"))
+	tk.MustExec("insert into t(a, b) values(1,'a')")
+	tk.MustExec("insert into t(a, b) values(2,'b')")
+	require.Nil(t, h.DumpStatsDeltaToKV(true))
+	require.Eventually(t, func() bool {
+		require.NoError(t, h.RemoveExpiredLockedTables(dom.InfoSchema()))
+		return tk.MustQuery(selectTableLockSQL).Rows()[0][0] == "0"
+	}, 5*time.Second, 100*time.Millisecond)
+	// The delta collected during the lock is applied after the lock expires.
+	rows := tk.MustQuery(fmt.Sprint("select count, modify_count from mysql.stats_meta where table_id = ", tbl.ID)).Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "2", rows[0][0])
+	require.Equal(t, "2", rows[0][1])
+}
+
+func TestLockColumnStats(t *testing.T) {
+	_, _, tk, tbl := setupTestEnvironmentWithTableT(t)
+
+	selectColumnLockSQL := fmt.Sprintf("select hist_id from mysql.stats_column_locked where table_id = %d", tbl.ID)
+	selectColumnStatsSQL := fmt.Sprintf("select hist_id, distinct_count, version from mysql.stats_histograms where table_id = %d and is_index = 0 order by hist_id", tbl.ID)
+	tk.MustGetErrMsg("lock stats t columns (c)", "[schema:1054]Unknown column 'c' in 't'")
+
+	tk.MustExec("lock stats t columns (a)")
+	tk.MustQuery(selectColumnLockSQL).Check(testkit.Rows("1"))
+	tk.MustExec("lock stats t columns (a, b)")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1105 skip locking locked columns of table test.t: a, other columns locked successfully",
+	))
+	tk.MustExec("unlock stats t columns (b)")
+	tk.MustQuery(selectColumnLockSQL).Check(testkit.Rows("1"))
+
+	// The stats of the locked column are kept after analyze.
+	lockedRows := tk.MustQuery(selectColumnStatsSQL).Rows()
+	require.Len(t, lockedRows, 2)
+	tk.MustExec("insert into t(a, b) values(1,'a'), (2,'b'), (3,'c')")
+	tk.MustExec("analyze table test.t")
+	rows := tk.MustQuery(selectColumnStatsSQL).Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, lockedRows[0], rows[0])
+	require.Equal(t, "3", rows[1][1])
+
+	tk.MustExec("unlock stats t columns (a)")
+	tk.MustQuery(selectColumnLockSQL).Check(testkit.Rows())
+	tk.MustExec("unlock stats t columns (a)")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1105 skip unlocking unlocked column of table test.t: a",
+	))
+	tk.MustExec("analyze table test.t")
+	tk.MustQuery(selectColumnStatsSQL).CheckAt([]int{0, 1}, testkit.Rows("1 3", "2 3"))
+
+	// Locking the columns of a locked table is skipped.
+	tk.MustExec("lock stats t")
+	tk.MustExec("lock stats t columns (a)")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1105 skip locking columns of locked table: test.t",
+	))
+	tk.MustQuery(selectColumnLockSQL).Check(testkit.Rows())
+}
+
+func TestLockStatsHistory(t *testing.T) {
+	_, _, tk, _ := setupTestEnvironmentWithPartitionedTableT(t)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	selectHistorySQL := "select scope, name, action, user, reason from mysql.stats_lock_history order by id"
+
+	tk.MustExec("lock stats t partition p0 comment 'imported stats'")
+	tk.MustExec("lock stats t comment 'keep the plan stable'")
+	tk.MustExec("lock stats t columns (a)")
+	tk.MustQuery(selectHistorySQL).Check(testkit.Rows(
+		"partition test.t partition (p0) lock root@% imported stats",
+		"table test.t lock root@% keep the plan stable",
+		"partition test.t partition (p1) lock root@% keep the plan stable",
+		// Locking the columns of a locked table is skipped, so it's not recorded.
+	))
+
+	// The lock time and age are shown, the partitions locked along with the table share its lock time.
+	rows := tk.MustQuery("show stats_locked").Rows()
+	require.Len(t, rows, 3)
+	for _, row := range rows {
+		require.Len(t, row, 6)
+		require.NotEqual(t, "<nil>", row[4])
+		require.NotEqual(t, "<nil>", row[5])
+	}
+	require.Equal(t, rows[0][4], rows[2][4])
+
+	tk.MustExec("delete from mysql.stats_lock_history")
+	tk.MustExec("unlock stats t")
+	tk.MustExec("unlock stats t")
+	tk.MustExec("lock stats t columns (a, b) comment 'hand-tuned histogram'")
+	tk.MustExec("unlock stats t columns (b)")
+	tk.MustQuery(selectHistorySQL).Check(testkit.Rows(
+		"table test.t unlock root@% <nil>",
+		"partition test.t partition (p0) unlock root@% <nil>",
+		"partition test.t partition (p1) unlock root@% <nil>",
+		"column test.t column (a) lock root@% hand-tuned histogram",
+		"column test.t column (b) lock root@% hand-tuned histogram",
+		"column test.t column (b) unlock root@% <nil>",
+	))
+}
+
+func TestGCOutdatedLockStatsHistory(t *testing.T) {
+	_, dom, tk, _ := setupTestEnvironmentWithPartitionedTableT(t)
+	selectHistorySQL := "select scope, name, action from mysql.stats_lock_history order by id"
+
+	tk.MustExec("lock stats t partition p0")
+	tk.MustExec("lock stats t partition p1")
+	tk.MustExec("unlock stats t partition p0")
+	tk.MustExec("update mysql.stats_lock_history set create_time = NOW() - INTERVAL 8 DAY")
+
+	// The outdated records are removed, except the ones of the partitions which are still locked.
+	require.Nil(t, dom.StatsHandle().GCStats(dom.InfoSchema(), time.Duration(0)))
+	tk.MustQuery(selectHistorySQL).Check(testkit.Rows(
+		"partition test.t partition (p1) lock",
+	))
+}
+
 func setupTestEnvironmentWithTableT(t *testing.T) (kv.Storage, *domain.Domain, *testkit.TestKit, *model.TableInfo) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
 	tk := testkit.NewTestKit(t, store)
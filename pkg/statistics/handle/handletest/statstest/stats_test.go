@@ -17,16 +17,20 @@ package statstest
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/config"
+	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/statistics"
 	statstestutil "github.com/pingcap/tidb/pkg/statistics/handle/ddl/testutil"
 	"github.com/pingcap/tidb/pkg/statistics/handle/internal"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/testkit/analyzehelper"
 	"github.com/stretchr/testify/require"
@@ -465,3 +469,169 @@ func TestDumpStatsDeltaInBatch(t *testing.T) {
 		"The version of two tables should be the same because they are dumped in the same transaction.",
 	)
 }
+
+func TestDumpStatsDeltaInShards(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	for i := 0; i < 10; i++ {
+		testKit.MustExec(fmt.Sprintf("create table t%d (c1 int, c2 int)", i))
+		testKit.MustExec(fmt.Sprintf("insert into t%d values %s", i, strings.Repeat("(1, 1),", i)+"(1, 1)"))
+	}
+	testKit.MustExec("create table tp (c1 int) partition by hash(c1) partitions 4")
+	testKit.MustExec("insert into tp values (1), (2), (3), (4), (5), (6), (7), (8)")
+	testKit.MustExec("delete from t9 limit 5")
+
+	// The deltas are dumped by the workers of the shards in many batches.
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/statistics/handle/usage/mockDumpDeltaBatchSize", "return(2)"))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/statistics/handle/usage/mockDumpDeltaBatchSize"))
+	}()
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	for i := 0; i < 9; i++ {
+		testKit.MustQuery(fmt.Sprintf(`select modify_count, count from mysql.stats_meta where table_id = (
+			select tidb_table_id from information_schema.tables where table_schema = 'test' and table_name = 't%d')`, i)).
+			Check(testkit.Rows(fmt.Sprintf("%d %d", i+1, i+1)))
+	}
+	testKit.MustQuery(`select modify_count, count from mysql.stats_meta where table_id = (
+		select tidb_table_id from information_schema.tables where table_schema = 'test' and table_name = 't9')`).
+		Check(testkit.Rows("15 5"))
+	// The global stats of the partitioned table are updated by the deltas of all the partitions.
+	testKit.MustQuery(`select modify_count, count from mysql.stats_meta where table_id = (
+		select tidb_table_id from information_schema.tables where table_schema = 'test' and table_name = 'tp')`).
+		Check(testkit.Rows("8 8"))
+	testKit.MustQuery(`select sum(modify_count), sum(count) from mysql.stats_meta where table_id in (
+		select tidb_partition_id from information_schema.partitions where table_schema = 'test' and table_name = 'tp')`).
+		Check(testkit.Rows("8 8"))
+}
+
+func TestStatsCacheOfCachedTable(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, b int, index idx(a))")
+	testKit.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	testKit.MustExec("alter table t cache")
+	// Read the cached table to load the table cache.
+	require.Eventually(t, func() bool {
+		testKit.MustQuery("select * from t").Check(testkit.Rows("1 1", "2 2", "3 3"))
+		return testKit.Session().GetSessionVars().StmtCtx.ReadFromTableCache
+	}, 10*time.Second, 100*time.Millisecond)
+
+	testKit.MustExec("analyze table t")
+	h := dom.StatsHandle()
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	statsTbl := h.GetTableStats(tbl.Meta())
+	require.False(t, statsTbl.Pseudo)
+	require.True(t, statsTbl.Pinned)
+	require.Equal(t, int64(3), statsTbl.RealtimeCount)
+
+	// The stats are unpinned after the table is not cached.
+	testKit.MustExec("alter table t nocache")
+	tbl, err = dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	h.Clear()
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	statsTbl = h.GetTableStats(tbl.Meta())
+	require.False(t, statsTbl.Pseudo)
+	require.False(t, statsTbl.Pinned)
+}
+
+func TestExtrapolateRowCountByAutoID(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int primary key auto_increment, b int)")
+	testKit.MustExec("create table t2 (a bigint primary key auto_random, b int)")
+	testKit.MustExec("create table t3 (a int, b int)")
+	h := dom.StatsHandle()
+	for _, tblName := range []string{"t", "t2", "t3"} {
+		for range 10 {
+			testKit.MustExec(fmt.Sprintf("insert into %s (b) values (1)", tblName))
+		}
+	}
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+
+	// The inserted rows whose stats delta is not dumped are invisible to the stats.
+	for _, tblName := range []string{"t", "t2", "t3"} {
+		testKit.MustExec(fmt.Sprintf("insert into %s (b) values (1), (2), (3), (4), (5)", tblName))
+		testKit.MustQuery(fmt.Sprintf("explain format = 'brief' select * from %s", tblName)).CheckAt([]int{1}, [][]any{{"10.00"}, {"10.00"}})
+	}
+	testKit.MustExec("set @@tidb_opt_extrapolate_row_count_by_auto_id = on")
+	for _, tblName := range []string{"t", "t2", "t3"} {
+		testKit.MustQuery(fmt.Sprintf("explain format = 'brief' select * from %s", tblName)).CheckAt([]int{1}, [][]any{{"15.00"}, {"15.00"}})
+	}
+
+	// The IDs allocated before the stats meta is reloaded are not counted again.
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	testKit.MustExec("set @@auto_increment_increment = 2")
+	testKit.MustExec("insert into t (b) values (1), (2)")
+	testKit.MustQuery("explain format = 'brief' select * from t").CheckAt([]int{1}, [][]any{{"17.00"}, {"17.00"}})
+}
+
+func TestInjectStats(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, b int)")
+	testKit.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 7), (8, 8), (9, 9), (10, 10)")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	testKit.MustQuery("explain format = 'brief' select * from t").CheckAt([]int{1}, [][]any{{"10.00"}, {"10.00"}})
+
+	testKit.MustExec(`set @@tidb_inject_stats = '{"Test.T": {"row_count": 1000}}'`)
+	testKit.MustQuery("explain format = 'brief' select * from t").CheckAt([]int{1}, [][]any{{"1000.00"}, {"1000.00"}})
+	// The injected stats only affect the session, and the real stats are untouched.
+	testKit2 := testkit.NewTestKit(t, store)
+	testKit2.MustExec("use test")
+	testKit2.MustQuery("explain format = 'brief' select * from t").CheckAt([]int{1}, [][]any{{"10.00"}, {"10.00"}})
+	statsTbl := h.GetTableStats(dom.MustGetTableInfo(t, "test", "t"))
+	require.Equal(t, int64(10), statsTbl.RealtimeCount)
+
+	testKit.MustContainErrMsg(`set @@tidb_inject_stats = '{"t": {"row_count": 1000}}'`, "isn't qualified by the database")
+	testKit.MustContainErrMsg(`set @@tidb_inject_stats = '{"test.t": {"row_count": -1}}'`, "negative count")
+	testKit.MustContainErrMsg(`set @@tidb_inject_stats = 'x'`, "invalid injected stats")
+	testKit.MustExec("set @@tidb_inject_stats = ''")
+	testKit.MustQuery("explain format = 'brief' select * from t").CheckAt([]int{1}, [][]any{{"10.00"}, {"10.00"}})
+}
+
+// countingStatsStorage counts the stats saved and loaded through the default backend.
+type countingStatsStorage struct {
+	statstypes.StatsStorage
+	saves, loads atomic.Int64
+}
+
+func (s *countingStatsStorage) Save(results *statistics.AnalyzeResults, analyzeSnapshot bool, source string) (uint64, error) {
+	s.saves.Add(1)
+	return s.StatsStorage.Save(results, analyzeSnapshot, source)
+}
+
+func (s *countingStatsStorage) Load(tableInfo *model.TableInfo, physicalID int64, loadAll bool, snapshot uint64, table *statistics.Table) (*statistics.Table, error) {
+	s.loads.Add(1)
+	return s.StatsStorage.Load(tableInfo, physicalID, loadAll, snapshot, table)
+}
+
+func TestStatsStorageBackend(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, b int, index idx(a))")
+	testKit.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	h := dom.StatsHandle()
+	backend := &countingStatsStorage{StatsStorage: h.StatsStorage()}
+	h.SetStatsStorage(backend)
+	defer h.SetStatsStorage(backend.StatsStorage)
+
+	testKit.MustExec("analyze table t")
+	require.Positive(t, backend.saves.Load())
+	tableInfo := dom.MustGetTableInfo(t, "test", "t")
+	statsTbl, err := h.TableStatsFromStorage(tableInfo, tableInfo.ID, true, 0)
+	require.NoError(t, err)
+	require.Positive(t, backend.loads.Load())
+	require.Equal(t, int64(3), statsTbl.RealtimeCount)
+}
@@ -0,0 +1,355 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthreport
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+const (
+	// topN is the max number of the tables listed in each section of the report.
+	topN = 10
+	// MaxStatsHealthReports is the number of the latest reports kept in mysql.stats_health_reports, the older ones
+	// are rotated out.
+	MaxStatsHealthReports = 100
+
+	selectLastReportSQL  = "SELECT report_time, stats_storage_bytes FROM mysql.stats_health_reports ORDER BY id DESC LIMIT 1"
+	selectStaleTablesSQL = `SELECT table_schema, table_name, healthy, last_analyze_time
+		FROM information_schema.stats_health_summary
+		WHERE healthy < 100
+		ORDER BY healthy, table_schema, table_name LIMIT %?`
+	selectFailedAnalyzesSQL = `SELECT table_schema, table_name, partition_name, IFNULL(fail_category, ''), COUNT(*), MAX(end_time)
+		FROM mysql.analyze_jobs
+		WHERE state = 'failed' AND end_time >= %?
+		GROUP BY table_schema, table_name, partition_name, fail_category
+		ORDER BY COUNT(*) DESC, table_schema, table_name, partition_name LIMIT %?`
+	selectPseudoStatsHotSpotsSQL = `SELECT table_schema, table_name, pseudo_stats_usage_last_hour
+		FROM information_schema.stats_health_summary
+		WHERE pseudo_stats_usage_last_hour > 0
+		ORDER BY pseudo_stats_usage_last_hour DESC, table_schema, table_name LIMIT %?`
+	// maxStatsStorageTablesPerScan is the max number of the tables whose stats storage is scanned by each SQL.
+	maxStatsStorageTablesPerScan = 1024
+
+	selectStatsMetaVersionsSQL = "SELECT table_id, version FROM mysql.stats_meta"
+	selectStatsStorageSQL      = `SELECT table_id, SUM(size) FROM (
+			SELECT table_id, LENGTH(lower_bound) + LENGTH(upper_bound) AS size FROM mysql.stats_buckets WHERE table_id IN (%?)
+			UNION ALL SELECT table_id, LENGTH(value) FROM mysql.stats_top_n WHERE table_id IN (%?)
+			UNION ALL SELECT table_id, IFNULL(LENGTH(cm_sketch), 0) FROM mysql.stats_histograms WHERE table_id IN (%?)
+		) t GROUP BY table_id`
+	insertReportSQL = `INSERT INTO mysql.stats_health_reports
+		(stale_tables, failed_analyzes, pseudo_stats_hot_spots, stats_storage_bytes, stats_storage_growth_bytes, report)
+		VALUES (%?, %?, %?, %?, %?, %?)`
+	selectRotateReportID = "SELECT id FROM mysql.stats_health_reports ORDER BY id DESC LIMIT 1 OFFSET %?"
+	deleteRotatedReports = "DELETE FROM mysql.stats_health_reports WHERE id <= %?"
+)
+
+// Report is the stats health report of the cluster.
+type Report struct {
+	ReportTime time.Time `json:"report_time"`
+	// Since is the time of the previous report, the failed analyzes are counted since then.
+	Since               time.Time            `json:"since"`
+	StaleTables         []StaleTable         `json:"stale_tables"`
+	FailedAnalyzes      []FailedAnalyze      `json:"failed_analyzes"`
+	PseudoStatsHotSpots []PseudoStatsHotSpot `json:"pseudo_stats_hot_spots"`
+	LargestStats        []StatsStorage       `json:"largest_stats"`
+	// StatsStorageBytes is the size of the buckets, the TopN and the CM sketches of all the tables.
+	StatsStorageBytes int64 `json:"stats_storage_bytes"`
+	// StatsStorageGrowthBytes is the growth of StatsStorageBytes since the previous report.
+	StatsStorageGrowthBytes int64 `json:"stats_storage_growth_bytes"`
+}
+
+// StaleTable is a table whose stats are stale.
+type StaleTable struct {
+	TableSchema     string `json:"table_schema"`
+	TableName       string `json:"table_name"`
+	LastAnalyzeTime string `json:"last_analyze_time,omitempty"`
+	Healthy         int64  `json:"healthy"`
+}
+
+// FailedAnalyze is the failures of the analyze of a table or a partition in a fail category.
+type FailedAnalyze struct {
+	TableSchema   string `json:"table_schema"`
+	TableName     string `json:"table_name"`
+	PartitionName string `json:"partition_name,omitempty"`
+	FailCategory  string `json:"fail_category,omitempty"`
+	LastFailTime  string `json:"last_fail_time,omitempty"`
+	Failures      int64  `json:"failures"`
+}
+
+// PseudoStatsHotSpot is a table whose pseudo stats are used by the optimizer of the instance generating the report.
+type PseudoStatsHotSpot struct {
+	TableSchema   string `json:"table_schema"`
+	TableName     string `json:"table_name"`
+	UsageLastHour int64  `json:"usage_last_hour"`
+}
+
+// StatsStorage is the size of the stats of a table or a partition.
+type StatsStorage struct {
+	TableSchema   string `json:"table_schema"`
+	TableName     string `json:"table_name"`
+	PartitionName string `json:"partition_name,omitempty"`
+	Bytes         int64  `json:"bytes"`
+}
+
+// StatsStorageCache caches the stats storage size of each table or partition with the version of its stats_meta when
+// the size is taken. The version is updated whenever the buckets, the TopN or the CM sketches of the table are changed,
+// so only the stats of the tables whose version is changed since the previous report are scanned. It's kept by the
+// worker generating the reports.
+type StatsStorageCache struct {
+	tables map[int64]tableStatsStorage
+}
+
+type tableStatsStorage struct {
+	version uint64
+	bytes   int64
+}
+
+// NewStatsStorageCache creates an empty StatsStorageCache, with which the stats of all the tables are scanned by the
+// first report.
+func NewStatsStorageCache() *StatsStorageCache {
+	return &StatsStorageCache{tables: make(map[int64]tableStatsStorage)}
+}
+
+// update scans the stats storage of the tables changed since the last update, and removes the tables whose stats are
+// deleted.
+func (c *StatsStorageCache) update(sctx sessionctx.Context) error {
+	rows, _, err := util.ExecRows(sctx, selectStatsMetaVersionsSQL)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	versions := make(map[int64]uint64, len(rows))
+	var changed []int64
+	for _, row := range rows {
+		tableID, version := row.GetInt64(0), row.GetUint64(1)
+		versions[tableID] = version
+		if cached, ok := c.tables[tableID]; !ok || cached.version != version {
+			changed = append(changed, tableID)
+		}
+	}
+	for tableID := range c.tables {
+		if _, ok := versions[tableID]; !ok {
+			delete(c.tables, tableID)
+		}
+	}
+	for len(changed) > 0 {
+		batch := changed[:min(len(changed), maxStatsStorageTablesPerScan)]
+		changed = changed[len(batch):]
+		// The SQL executor only accepts the string arrays for the IN clauses.
+		ids := make([]string, 0, len(batch))
+		for _, tableID := range batch {
+			ids = append(ids, strconv.FormatInt(tableID, 10))
+		}
+		sizes := make(map[int64]int64, len(batch))
+		rows, _, err := util.ExecRows(sctx, selectStatsStorageSQL, ids, ids, ids)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, row := range rows {
+			bytes, err := row.GetMyDecimal(1).ToInt()
+			if err != nil {
+				continue
+			}
+			sizes[row.GetInt64(0)] = bytes
+		}
+		// The tables without any buckets, TopN or CM sketches are cached with 0 bytes, so that they aren't scanned again.
+		for _, tableID := range batch {
+			c.tables[tableID] = tableStatsStorage{version: versions[tableID], bytes: sizes[tableID]}
+		}
+	}
+	return nil
+}
+
+// lastReport returns the time and the stats storage size of the latest report. The time is zero if there is no
+// report yet.
+func lastReport(sctx sessionctx.Context) (time.Time, int64, error) {
+	rows, _, err := util.ExecRows(sctx, selectLastReportSQL)
+	if err != nil || len(rows) == 0 {
+		return time.Time{}, 0, errors.Trace(err)
+	}
+	reportTime, err := rows[0].GetTime(0).GoTime(time.Local)
+	if err != nil {
+		return time.Time{}, 0, errors.Trace(err)
+	}
+	return reportTime, rows[0].GetInt64(1), nil
+}
+
+// IsDue returns whether the last report is older than the interval.
+func IsDue(sctx sessionctx.Context, interval time.Duration) (bool, error) {
+	last, _, err := lastReport(sctx)
+	if err != nil {
+		return false, err
+	}
+	return time.Since(last) >= interval, nil
+}
+
+// Generate generates the stats health report. The failed analyzes are counted since the previous report, or within
+// the interval if there is no report yet. The stats storage sizes are taken by the cache, which scans the stats of all
+// the tables if it's nil.
+func Generate(sctx sessionctx.Context, interval time.Duration, cache *StatsStorageCache) (*Report, error) {
+	now := time.Now()
+	since, lastStorageBytes, err := lastReport(sctx)
+	if err != nil {
+		return nil, err
+	}
+	if since.IsZero() {
+		since = now.Add(-interval)
+	}
+	report := &Report{ReportTime: now, Since: since}
+
+	rows, _, err := util.ExecRows(sctx, selectStaleTablesSQL, topN)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, row := range rows {
+		stale := StaleTable{TableSchema: row.GetString(0), TableName: row.GetString(1)}
+		if !row.IsNull(2) {
+			stale.Healthy = row.GetInt64(2)
+		}
+		if !row.IsNull(3) {
+			stale.LastAnalyzeTime = row.GetTime(3).String()
+		}
+		report.StaleTables = append(report.StaleTables, stale)
+	}
+
+	rows, _, err = util.ExecRows(sctx, selectFailedAnalyzesSQL, since.Format(types.TimeFormat), topN)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, row := range rows {
+		failed := FailedAnalyze{
+			TableSchema:   row.GetString(0),
+			TableName:     row.GetString(1),
+			PartitionName: row.GetString(2),
+			FailCategory:  row.GetString(3),
+			Failures:      row.GetInt64(4),
+		}
+		if !row.IsNull(5) {
+			failed.LastFailTime = row.GetTime(5).String()
+		}
+		report.FailedAnalyzes = append(report.FailedAnalyzes, failed)
+	}
+
+	rows, _, err = util.ExecRows(sctx, selectPseudoStatsHotSpotsSQL, topN)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, row := range rows {
+		report.PseudoStatsHotSpots = append(report.PseudoStatsHotSpots, PseudoStatsHotSpot{
+			TableSchema:   row.GetString(0),
+			TableName:     row.GetString(1),
+			UsageLastHour: row.GetInt64(2),
+		})
+	}
+
+	if cache == nil {
+		cache = NewStatsStorageCache()
+	}
+	if err := cache.update(sctx); err != nil {
+		return nil, err
+	}
+	tableIDs := make([]int64, 0, len(cache.tables))
+	for tableID, storage := range cache.tables {
+		report.StatsStorageBytes += storage.bytes
+		if storage.bytes > 0 {
+			tableIDs = append(tableIDs, tableID)
+		}
+	}
+	slices.SortFunc(tableIDs, func(a, b int64) int {
+		return cmp.Or(cmp.Compare(cache.tables[b].bytes, cache.tables[a].bytes), cmp.Compare(a, b))
+	})
+	is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+	for _, tableID := range tableIDs {
+		if len(report.LargestStats) >= topN {
+			break
+		}
+		schema, table, partition, ok := tableNames(is, tableID)
+		if !ok {
+			// The stats of the dropped tables are removed by the stats GC.
+			continue
+		}
+		report.LargestStats = append(report.LargestStats, StatsStorage{
+			TableSchema:   schema,
+			TableName:     table,
+			PartitionName: partition,
+			Bytes:         cache.tables[tableID].bytes,
+		})
+	}
+	if lastStorageBytes > 0 {
+		report.StatsStorageGrowthBytes = report.StatsStorageBytes - lastStorageBytes
+	}
+	return report, nil
+}
+
+// Save saves the report into mysql.stats_health_reports and rotates out the reports beyond MaxStatsHealthReports.
+func Save(sctx sessionctx.Context, report *Report) error {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, _, err := util.ExecRows(sctx, insertReportSQL,
+		len(report.StaleTables), len(report.FailedAnalyzes), len(report.PseudoStatsHotSpots),
+		report.StatsStorageBytes, report.StatsStorageGrowthBytes, string(data),
+	); err != nil {
+		return errors.Trace(err)
+	}
+	rows, _, err := util.ExecRows(sctx, selectRotateReportID, MaxStatsHealthReports)
+	if err != nil || len(rows) == 0 {
+		return errors.Trace(err)
+	}
+	_, _, err = util.ExecRows(sctx, deleteRotatedReports, rows[0].GetUint64(0))
+	return errors.Trace(err)
+}
+
+// WriteToExternalStorage writes the report as a JSON file named by the report time to the external storage.
+func WriteToExternalStorage(ctx context.Context, uri string, report *Report) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	store, err := storage.NewFromURL(ctx, uri)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer store.Close()
+	name := fmt.Sprintf("stats_health_report_%s.json", report.ReportTime.UTC().Format("20060102150405"))
+	return errors.Trace(store.WriteFile(ctx, name, data))
+}
+
+// tableNames returns the schema, table and partition names of the table or the partition.
+func tableNames(is infoschema.InfoSchema, id int64) (schema, table, partition string, ok bool) {
+	if tbl, ok := is.TableByID(util.StatsCtx, id); ok {
+		if db, ok := is.SchemaByID(tbl.Meta().DBID); ok {
+			schema = db.Name.O
+		}
+		return schema, tbl.Meta().Name.O, "", true
+	}
+	if tbl, db, def := is.FindTableByPartitionID(id); tbl != nil {
+		return db.Name.O, tbl.Meta().Name.O, def.Name.O, true
+	}
+	return "", "", "", false
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthreport_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/healthreport"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsHealthReport(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4)")
+	tk.MustExec("analyze table t all columns")
+	tk.MustExec("insert into t values (5, 5), (6, 6)")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	tk.MustExec(`insert into mysql.analyze_jobs (table_schema, table_name, job_info, end_time, state, fail_reason, fail_category, instance) values
+		('test', 't', 'auto analyze table', now(), 'failed', 'killed', 'killed', '127.0.0.1:4000'),
+		('test', 't', 'auto analyze table', now(), 'failed', 'killed', 'killed', '127.0.0.1:4000')`)
+
+	var report *healthreport.Report
+	cache := healthreport.NewStatsStorageCache()
+	err := util.CallWithSCtx(h.SPool(), func(sctx sessionctx.Context) error {
+		due, err := healthreport.IsDue(sctx, time.Hour)
+		require.NoError(t, err)
+		require.True(t, due)
+		report, err = healthreport.Generate(sctx, time.Hour, cache)
+		if err != nil {
+			return err
+		}
+		return healthreport.Save(sctx, report)
+	})
+	require.NoError(t, err)
+	require.Len(t, report.StaleTables, 1)
+	require.Equal(t, "t", report.StaleTables[0].TableName)
+	require.Equal(t, int64(50), report.StaleTables[0].Healthy)
+	require.Len(t, report.FailedAnalyzes, 1)
+	require.Equal(t, "killed", report.FailedAnalyzes[0].FailCategory)
+	require.Equal(t, int64(2), report.FailedAnalyzes[0].Failures)
+	require.Positive(t, report.StatsStorageBytes)
+	require.Equal(t, "t", report.LargestStats[0].TableName)
+	tk.MustQuery("select stale_tables, failed_analyzes, stats_storage_growth_bytes, json_extract(report, '$.failed_analyzes[0].failures') from mysql.stats_health_reports").
+		Check(testkit.Rows("1 1 0 2"))
+
+	// The next report isn't due until the interval passes, and the failures are counted since the previous report.
+	err = util.CallWithSCtx(h.SPool(), func(sctx sessionctx.Context) error {
+		due, err := healthreport.IsDue(sctx, time.Hour)
+		require.NoError(t, err)
+		require.False(t, due)
+		tk.MustExec("update mysql.stats_health_reports set report_time = now() + interval 1 second")
+		report, err = healthreport.Generate(sctx, time.Hour, cache)
+		return err
+	})
+	require.NoError(t, err)
+	require.Empty(t, report.FailedAnalyzes)
+	storageBytes := report.StatsStorageBytes
+
+	// Only the stats of the tables whose version is changed are scanned again.
+	tk.MustExec("update mysql.stats_buckets set upper_bound = concat(upper_bound, 'xxxxxxxxxx')")
+	generate := func(cache *healthreport.StatsStorageCache) {
+		err = util.CallWithSCtx(h.SPool(), func(sctx sessionctx.Context) error {
+			report, err = healthreport.Generate(sctx, time.Hour, cache)
+			return err
+		})
+		require.NoError(t, err)
+	}
+	generate(cache)
+	require.Equal(t, storageBytes, report.StatsStorageBytes)
+	generate(nil)
+	require.Greater(t, report.StatsStorageBytes, storageBytes)
+	storageBytes = report.StatsStorageBytes
+	tk.MustExec("update mysql.stats_meta set version = version + 1")
+	generate(cache)
+	require.Equal(t, storageBytes, report.StatsStorageBytes)
+
+	dir := t.TempDir()
+	require.NoError(t, healthreport.WriteToExternalStorage(context.Background(), dir, report))
+	files, err := filepath.Glob(filepath.Join(dir, "stats_health_report_*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"stale_tables"`)
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/statistics/handle/types"
+	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
+)
+
+// DDLEventHandlers returns the handlers of the ddl events which record the historical stats meta of the tables and
+// partitions whose stats meta is updated by the ddl events. They must be registered after the built-in handlers
+// maintaining the stats.
+func DDLEventHandlers(statsCache types.StatsCache) *types.DDLEventHandlers {
+	return &types.DDLEventHandlers{
+		Name: "historical stats",
+		OnCreateTable: func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, _ int64) error {
+			return recordUpdatedStatsMeta(ctx, sctx, statsCache, handleutil.PhysicalIDs(tblInfo)...)
+		},
+		OnTruncateTable: func(ctx context.Context, sctx sessionctx.Context, newTblInfo, droppedTblInfo *model.TableInfo, _ float64) error {
+			return recordUpdatedStatsMeta(ctx, sctx, statsCache, append(handleutil.PhysicalIDs(newTblInfo), handleutil.PhysicalIDs(droppedTblInfo)...)...)
+		},
+		OnDropTable: func(ctx context.Context, sctx sessionctx.Context, droppedTblInfo *model.TableInfo) error {
+			return recordUpdatedStatsMeta(ctx, sctx, statsCache, handleutil.PhysicalIDs(droppedTblInfo)...)
+		},
+		OnRecoverTable: func(ctx context.Context, sctx sessionctx.Context, recoveredTblInfo *model.TableInfo, _ uint64) error {
+			return recordUpdatedStatsMeta(ctx, sctx, statsCache, handleutil.PhysicalIDs(recoveredTblInfo)...)
+		},
+		OnAddColumn: func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, _ []*model.ColumnInfo) error {
+			return recordUpdatedStatsMeta(ctx, sctx, statsCache, handleutil.PhysicalIDs(tblInfo)...)
+		},
+		OnModifyColumn: func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, _, _ []*model.ColumnInfo) error {
+			return recordUpdatedStatsMeta(ctx, sctx, statsCache, handleutil.PhysicalIDs(tblInfo)...)
+		},
+		OnAddIndex: func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, _ []*model.IndexInfo) error {
+			return recordUpdatedStatsMeta(ctx, sctx, statsCache, tblInfo.ID)
+		},
+		OnAddPartition: func(ctx context.Context, sctx sessionctx.Context, _ *model.TableInfo, addedPartInfo *model.PartitionInfo) error {
+			return recordUpdatedStatsMeta(ctx, sctx, statsCache, partitionIDs(addedPartInfo)...)
+		},
+		OnDropPartition: func(ctx context.Context, sctx sessionctx.Context, globalTblInfo *model.TableInfo, droppedPartInfo *model.PartitionInfo) error {
+			return recordUpdatedStatsMeta(ctx, sctx, statsCache, append(partitionIDs(droppedPartInfo), globalTblInfo.ID)...)
+		},
+		OnExchangePartition: func(ctx context.Context, sctx sessionctx.Context, globalTblInfo *model.TableInfo, partInfo *model.PartitionInfo, nonPartTblInfo *model.TableInfo) error {
+			return recordUpdatedStatsMeta(ctx, sctx, statsCache, append(partitionIDs(partInfo), globalTblInfo.ID, nonPartTblInfo.ID)...)
+		},
+	}
+}
+
+// recordUpdatedStatsMeta records the historical stats meta of the given tables whose stats meta is updated in the
+// transaction handling the ddl event, that is, whose version is the start ts of the transaction.
+func recordUpdatedStatsMeta(ctx context.Context, sctx sessionctx.Context, statsCache types.StatsCache, tableIDs ...int64) error {
+	val, err := sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBEnableHistoricalStats)
+	if err != nil || !variable.TiDBOptOn(val) {
+		return errors.Trace(err)
+	}
+	startTS, err := handleutil.GetStartTS(sctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	stringIDs := make([]string, 0, len(tableIDs))
+	for _, tableID := range tableIDs {
+		tbl, ok := statsCache.Get(tableID)
+		if !ok || !tbl.IsInitialized() {
+			continue
+		}
+		stringIDs = append(stringIDs, strconv.FormatInt(tableID, 10))
+	}
+	if len(stringIDs) == 0 {
+		return nil
+	}
+	rows, _, err := handleutil.ExecRowsWithCtx(ctx, sctx,
+		"SELECT table_id FROM mysql.stats_meta WHERE table_id IN (%?) AND version = %?", stringIDs, startTS)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, row := range rows {
+		if err := RecordHistoricalStatsMeta(ctx, sctx, startTS, handleutil.StatsMetaHistorySourceSchemaChange, row.GetInt64(0)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// partitionIDs returns the IDs of the partitions.
+func partitionIDs(pi *model.PartitionInfo) []int64 {
+	if pi == nil {
+		return nil
+	}
+	ids := make([]int64, 0, len(pi.Definitions))
+	for _, def := range pi.Definitions {
+		ids = append(ids, def.ID)
+	}
+	return ids
+}
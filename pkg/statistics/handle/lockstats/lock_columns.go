@@ -0,0 +1,244 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockstats
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/logutil"
+	"github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"go.uber.org/zap"
+)
+
+const (
+	insertColumnSQL        = "INSERT INTO mysql.stats_column_locked (table_id, hist_id, version) VALUES (%?, %?, %?) ON DUPLICATE KEY UPDATE table_id = %?"
+	deleteColumnSQL        = "DELETE FROM mysql.stats_column_locked WHERE table_id = %? AND hist_id = %?"
+	selectLockedColumnsSQL = "SELECT hist_id FROM mysql.stats_column_locked WHERE table_id = %?"
+	// DeleteLockedColumnsSQL is used to delete all the locked columns of a table.
+	DeleteLockedColumnsSQL = "DELETE FROM mysql.stats_column_locked WHERE table_id = %?"
+)
+
+// LockColumns add locked columns id to store.
+// - tid: table id of which the columns will be locked.
+// - tableName: table name of which the columns will be locked.
+// - colIDNames: column ids of which will be locked.
+// - audit: the audit information recorded in the lock history.
+// Return the message of skipped columns and error.
+func (sl *statsLockImpl) LockColumns(
+	tid int64,
+	tableName string,
+	colIDNames map[int64]string,
+	audit *types.StatsLockAudit,
+) (skipped string, err error) {
+	err = util.CallWithSCtx(sl.pool, func(sctx sessionctx.Context) error {
+		return withColumnLockHistory(sctx, lockHistoryActionLock, tid, tableName, colIDNames, audit, func() error {
+			skipped, err = AddLockedColumns(sctx, tid, tableName, colIDNames)
+			return err
+		})
+	}, util.FlagWrapTxn)
+	return
+}
+
+// RemoveLockedColumns remove columns from column locked records.
+// - tid: table id of which the columns will be unlocked.
+// - tableName: table name of which the columns will be unlocked.
+// - colIDNames: column ids of which will be unlocked.
+// - audit: the audit information recorded in the lock history.
+// Return the message of skipped columns and error.
+func (sl *statsLockImpl) RemoveLockedColumns(
+	tid int64,
+	tableName string,
+	colIDNames map[int64]string,
+	audit *types.StatsLockAudit,
+) (skipped string, err error) {
+	err = util.CallWithSCtx(sl.pool, func(sctx sessionctx.Context) error {
+		return withColumnLockHistory(sctx, lockHistoryActionUnlock, tid, tableName, colIDNames, audit, func() error {
+			skipped, err = RemoveLockedColumns(sctx, tid, tableName, colIDNames)
+			return err
+		})
+	}, util.FlagWrapTxn)
+	return
+}
+
+// withColumnLockHistory runs the lock or unlock operation of the columns and records the columns
+// whose lock status is changed by it in the lock history.
+func withColumnLockHistory(
+	sctx sessionctx.Context,
+	action string,
+	tid int64,
+	tableName string,
+	colIDNames map[int64]string,
+	audit *types.StatsLockAudit,
+	op func() error,
+) error {
+	before, err := QueryLockedColumns(sctx, tid)
+	if err != nil {
+		return err
+	}
+	if err := op(); err != nil {
+		return err
+	}
+	after, err := QueryLockedColumns(sctx, tid)
+	if err != nil {
+		return err
+	}
+	records := columnLockHistoryRecords(tid, tableName, colIDNames, before, after)
+	return recordLockHistory(sctx, action, records, audit)
+}
+
+// GetLockedColumns returns the locked columns of the given table.
+func (sl *statsLockImpl) GetLockedColumns(tableID int64) (columns map[int64]struct{}, err error) {
+	err = util.CallWithSCtx(sl.pool, func(sctx sessionctx.Context) error {
+		columns, err = QueryLockedColumns(sctx, tableID)
+		return err
+	})
+	return
+}
+
+// QueryLockedColumns loads the locked columns of the table from mysql.stats_column_locked.
+// Return it as a map for fast query.
+func QueryLockedColumns(sctx sessionctx.Context, tableID int64) (map[int64]struct{}, error) {
+	rows, _, err := util.ExecRows(sctx, selectLockedColumnsSQL, tableID)
+	if err != nil {
+		return nil, err
+	}
+	columnLocked := make(map[int64]struct{}, len(rows))
+	for _, row := range rows {
+		columnLocked[row.GetInt64(0)] = struct{}{}
+	}
+	return columnLocked, nil
+}
+
+// AddLockedColumns add locked columns id to store.
+// If the whole table is locked, then skip all columns of the table.
+// - tid: table id of which the columns will be locked.
+// - tableName: table name of which the columns will be locked.
+// - colIDNames: column ids of which will be locked.
+// Return the message of skipped columns and error.
+func AddLockedColumns(
+	sctx sessionctx.Context,
+	tid int64,
+	tableName string,
+	colIDNames map[int64]string,
+) (string, error) {
+	colIDs := make([]int64, 0, len(colIDNames))
+	colNames := make([]string, 0, len(colIDNames))
+	for colID, colName := range colIDNames {
+		colIDs = append(colIDs, colID)
+		colNames = append(colNames, colName)
+	}
+	logutil.StatsLogger().Info("lock columns",
+		zap.Int64("tableID", tid),
+		zap.String("tableName", tableName),
+		zap.Int64s("columnIDs", colIDs),
+		zap.Strings("columnNames", colNames),
+	)
+
+	// It is not necessary to lock columns if whole table is locked.
+	lockedTables, err := QueryLockedTables(util.StatsCtx, sctx)
+	if err != nil {
+		return "", err
+	}
+	if _, locked := lockedTables[tid]; locked {
+		return "skip locking columns of locked table: " + tableName, nil
+	}
+
+	lockedColumns, err := QueryLockedColumns(sctx, tid)
+	if err != nil {
+		return "", err
+	}
+	version, err := util.GetStartTS(sctx)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	skippedColumns := make([]string, 0, len(colIDs))
+	for _, colID := range colIDs {
+		if _, ok := lockedColumns[colID]; ok {
+			skippedColumns = append(skippedColumns, colIDNames[colID])
+			continue
+		}
+		if _, _, err := util.ExecRows(sctx, insertColumnSQL, tid, colID, version, tid); err != nil {
+			logutil.StatsLogger().Error("error occurred when insert mysql.stats_column_locked", zap.Error(err))
+			return "", err
+		}
+	}
+
+	return generateStableSkippedColumnsMessage(len(colIDs), tableName, skippedColumns, lockAction, lockedStatus), nil
+}
+
+// RemoveLockedColumns remove columns from column locked records.
+// - tid: table id of which the columns will be unlocked.
+// - tableName: table name of which the columns will be unlocked.
+// - colIDNames: column ids of which will be unlocked.
+// Return the message of skipped columns and error.
+func RemoveLockedColumns(
+	sctx sessionctx.Context,
+	tid int64,
+	tableName string,
+	colIDNames map[int64]string,
+) (string, error) {
+	colIDs := make([]int64, 0, len(colIDNames))
+	colNames := make([]string, 0, len(colIDNames))
+	for colID, colName := range colIDNames {
+		colIDs = append(colIDs, colID)
+		colNames = append(colNames, colName)
+	}
+	logutil.StatsLogger().Info("unlock columns",
+		zap.Int64("tableID", tid),
+		zap.String("tableName", tableName),
+		zap.Int64s("columnIDs", colIDs),
+		zap.Strings("columnNames", colNames),
+	)
+
+	lockedColumns, err := QueryLockedColumns(sctx, tid)
+	if err != nil {
+		return "", err
+	}
+	skippedColumns := make([]string, 0, len(colIDs))
+	for _, colID := range colIDs {
+		if _, ok := lockedColumns[colID]; !ok {
+			skippedColumns = append(skippedColumns, colIDNames[colID])
+			continue
+		}
+		if _, _, err := util.ExecRows(sctx, deleteColumnSQL, tid, colID); err != nil {
+			logutil.StatsLogger().Error("error occurred when delete mysql.stats_column_locked", zap.Error(err))
+			return "", err
+		}
+	}
+
+	return generateStableSkippedColumnsMessage(len(colIDs), tableName, skippedColumns, unlockAction, unlockedStatus), nil
+}
+
+// generateStableSkippedColumnsMessage generates stable skipped columns message.
+func generateStableSkippedColumnsMessage(columnCount int, tableName string, skippedNames []string, action, status string) string {
+	if len(skippedNames) == 0 {
+		return ""
+	}
+	// Sort to stabilize the output.
+	slices.Sort(skippedNames)
+	columns := strings.Join(skippedNames, ", ")
+	if columnCount > 1 {
+		if columnCount > len(skippedNames) {
+			return fmt.Sprintf("skip %s %s columns of table %s: %s, other columns %s successfully", action, status, tableName, columns, status)
+		}
+		return fmt.Sprintf("skip %s %s columns of table %s: %s", action, status, tableName, columns)
+	}
+	return fmt.Sprintf("skip %s %s column of table %s: %s", action, status, tableName, columns)
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockstats
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/changeevent"
+	"github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+)
+
+const (
+	lockHistoryActionLock   = "lock"
+	lockHistoryActionUnlock = "unlock"
+
+	lockHistoryScopeTable     = "table"
+	lockHistoryScopePartition = "partition"
+	lockHistoryScopeColumn    = "column"
+
+	insertLockHistorySQL = "INSERT INTO mysql.stats_lock_history (table_id, scope, name, action, user, reason, version) VALUES (%?, %?, %?, %?, %?, %?, %?)"
+	selectLockVersionSQL = "SELECT table_id, MAX(version) FROM mysql.stats_lock_history WHERE table_id IN (%?) AND action = 'lock' AND scope != 'column' GROUP BY table_id"
+
+	// expiredLockReason is the reason recorded when the lock is removed because it has expired.
+	expiredLockReason = "lock expired"
+)
+
+// lockHistoryRecord is a row of mysql.stats_lock_history.
+type lockHistoryRecord struct {
+	scope   string
+	name    string
+	tableID int64
+}
+
+// recordLockHistory writes the records of the lock or unlock operation to mysql.stats_lock_history.
+// All the records share the same version, which is the start TS of the transaction.
+func recordLockHistory(
+	sctx sessionctx.Context,
+	action string,
+	records []lockHistoryRecord,
+	audit *types.StatsLockAudit,
+) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if audit == nil {
+		audit = &types.StatsLockAudit{}
+	}
+	var reason any
+	if audit.Reason != "" {
+		reason = audit.Reason
+	}
+	version, err := util.GetStartTS(sctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// Sort to make the ids of the records stable.
+	slices.SortFunc(records, func(a, b lockHistoryRecord) int {
+		if c := cmp.Compare(a.tableID, b.tableID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.name, b.name)
+	})
+	for _, record := range records {
+		if _, _, err := util.ExecRows(sctx, insertLockHistorySQL,
+			record.tableID, record.scope, record.name, action, audit.User, reason, version,
+		); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	event := changeevent.EventLock
+	if action == lockHistoryActionUnlock {
+		event = changeevent.EventUnlock
+	}
+	// The scope is recorded as the source of the event, and the columns of a table share one event.
+	for i, record := range records {
+		if i > 0 && records[i-1].tableID == record.tableID && records[i-1].scope == record.scope {
+			continue
+		}
+		if err := changeevent.Record(sctx, event, record.scope, version, record.tableID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isLockChanged returns whether the lock status of the table or partition is changed by the operation.
+func isLockChanged(before, after map[int64]struct{}, id int64) bool {
+	_, lockedBefore := before[id]
+	_, lockedAfter := after[id]
+	return lockedBefore != lockedAfter
+}
+
+// tableLockHistoryRecords returns the history records of the tables and partitions whose lock status is changed.
+func tableLockHistoryRecords(
+	tables map[int64]*types.StatsLockTable,
+	before, after map[int64]struct{},
+) []lockHistoryRecord {
+	records := make([]lockHistoryRecord, 0, len(tables))
+	for tid, table := range tables {
+		if isLockChanged(before, after, tid) {
+			records = append(records, lockHistoryRecord{tableID: tid, scope: lockHistoryScopeTable, name: table.FullName})
+		}
+		for pid, name := range table.PartitionInfo {
+			if isLockChanged(before, after, pid) {
+				records = append(records, lockHistoryRecord{tableID: pid, scope: lockHistoryScopePartition, name: name})
+			}
+		}
+	}
+	return records
+}
+
+// partitionLockHistoryRecords returns the history records of the partitions whose lock status is changed.
+func partitionLockHistoryRecords(
+	tableName string,
+	pidNames map[int64]string,
+	before, after map[int64]struct{},
+) []lockHistoryRecord {
+	records := make([]lockHistoryRecord, 0, len(pidNames))
+	for pid, name := range pidNames {
+		if isLockChanged(before, after, pid) {
+			records = append(records, lockHistoryRecord{
+				tableID: pid,
+				scope:   lockHistoryScopePartition,
+				name:    fmt.Sprintf("%s partition (%s)", tableName, name),
+			})
+		}
+	}
+	return records
+}
+
+// columnLockHistoryRecords returns the history records of the columns whose lock status is changed.
+func columnLockHistoryRecords(
+	tid int64,
+	tableName string,
+	colIDNames map[int64]string,
+	before, after map[int64]struct{},
+) []lockHistoryRecord {
+	records := make([]lockHistoryRecord, 0, len(colIDNames))
+	for colID, name := range colIDNames {
+		if isLockChanged(before, after, colID) {
+			records = append(records, lockHistoryRecord{
+				tableID: tid,
+				scope:   lockHistoryScopeColumn,
+				name:    fmt.Sprintf("%s column (%s)", tableName, name),
+			})
+		}
+	}
+	return records
+}
+
+// expiredLockHistoryRecords returns the history records of the tables and partitions whose locks have expired.
+// The names are resolved from the info schema, the dropped tables are not recorded.
+func expiredLockHistoryRecords(
+	is infoschema.InfoSchema,
+	before, after map[int64]struct{},
+) []lockHistoryRecord {
+	records := make([]lockHistoryRecord, 0, len(before))
+	for id := range before {
+		if !isLockChanged(before, after, id) {
+			continue
+		}
+		if tbl, ok := is.TableByID(util.StatsCtx, id); ok {
+			if db, ok := is.SchemaByID(tbl.Meta().DBID); ok {
+				records = append(records, lockHistoryRecord{
+					tableID: id,
+					scope:   lockHistoryScopeTable,
+					name:    fmt.Sprintf("%s.%s", db.Name.L, tbl.Meta().Name.L),
+				})
+			}
+			continue
+		}
+		if tbl, db, def := is.FindTableByPartitionID(id); tbl != nil {
+			records = append(records, lockHistoryRecord{
+				tableID: id,
+				scope:   lockHistoryScopePartition,
+				name:    fmt.Sprintf("%s.%s partition (%s)", db.Name.L, tbl.Meta().Name.L, def.Name.L),
+			})
+		}
+	}
+	return records
+}
+
+// QueryTablesLockVersion loads the version of the latest lock operation of the given tables and partitions
+// from mysql.stats_lock_history.
+func QueryTablesLockVersion(sctx sessionctx.Context, tableIDs ...int64) (map[int64]uint64, error) {
+	if len(tableIDs) == 0 {
+		return map[int64]uint64{}, nil
+	}
+	// sqlescape doesn't support the int64 slice, so convert the ids to strings.
+	ids := make([]string, 0, len(tableIDs))
+	for _, tid := range tableIDs {
+		ids = append(ids, strconv.FormatInt(tid, 10))
+	}
+	rows, _, err := util.ExecRows(sctx, selectLockVersionSQL, ids)
+	if err != nil {
+		return nil, err
+	}
+	versions := make(map[int64]uint64, len(rows))
+	for _, row := range rows {
+		versions[row.GetInt64(0)] = row.GetUint64(1)
+	}
+	return versions, nil
+}
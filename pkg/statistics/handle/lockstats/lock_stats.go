@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/statistics/handle/logutil"
 	"github.com/pingcap/tidb/pkg/statistics/handle/types"
@@ -35,7 +36,7 @@ const (
 	lockedStatus   = "locked"
 	unlockedStatus = "unlocked"
 
-	insertSQL            = "INSERT INTO mysql.stats_table_locked (table_id) VALUES (%?) ON DUPLICATE KEY UPDATE table_id = %?"
+	insertSQL            = "INSERT INTO mysql.stats_table_locked (table_id, expire_version) VALUES (%?, %?) ON DUPLICATE KEY UPDATE expire_version = VALUES(expire_version)"
 	updateMetaVersionSQL = "UPDATE mysql.stats_meta SET version = %? WHERE table_id = %?"
 )
 
@@ -51,11 +52,21 @@ func NewStatsLock(pool pkgutil.DestroyableSessionPool) types.StatsLock {
 
 // LockTables add locked tables id to store.
 // - tables: tables that will be locked.
+// - expireVersion: the TSO after which the tables are unlocked automatically, 0 means never.
+// - audit: the audit information recorded in the lock history.
 // Return the message of skipped tables and error.
-func (sl *statsLockImpl) LockTables(tables map[int64]*types.StatsLockTable) (skipped string, err error) {
+func (sl *statsLockImpl) LockTables(
+	tables map[int64]*types.StatsLockTable,
+	expireVersion uint64,
+	audit *types.StatsLockAudit,
+) (skipped string, err error) {
 	err = util.CallWithSCtx(sl.pool, func(sctx sessionctx.Context) error {
-		skipped, err = AddLockedTables(sctx, tables)
-		return err
+		return withTableLockHistory(sctx, lockHistoryActionLock, audit, func() error {
+			skipped, err = AddLockedTables(sctx, tables, expireVersion)
+			return err
+		}, func(before, after map[int64]struct{}) []lockHistoryRecord {
+			return tableLockHistoryRecords(tables, before, after)
+		})
 	}, util.FlagWrapTxn)
 	return
 }
@@ -65,27 +76,43 @@ func (sl *statsLockImpl) LockTables(tables map[int64]*types.StatsLockTable) (ski
 // - tid: table id of which will be locked.
 // - tableName: table name of which will be locked.
 // - pidNames: partition ids of which will be locked.
+// - expireVersion: the TSO after which the partitions are unlocked automatically, 0 means never.
+// - audit: the audit information recorded in the lock history.
 // Return the message of skipped tables and error.
 // Note: If the whole table is locked, then skip all partitions of the table.
 func (sl *statsLockImpl) LockPartitions(
 	tid int64,
 	tableName string,
 	pidNames map[int64]string,
+	expireVersion uint64,
+	audit *types.StatsLockAudit,
 ) (skipped string, err error) {
 	err = util.CallWithSCtx(sl.pool, func(sctx sessionctx.Context) error {
-		skipped, err = AddLockedPartitions(sctx, tid, tableName, pidNames)
-		return err
+		return withTableLockHistory(sctx, lockHistoryActionLock, audit, func() error {
+			skipped, err = AddLockedPartitions(sctx, tid, tableName, pidNames, expireVersion)
+			return err
+		}, func(before, after map[int64]struct{}) []lockHistoryRecord {
+			return partitionLockHistoryRecords(tableName, pidNames, before, after)
+		})
 	}, util.FlagWrapTxn)
 	return
 }
 
 // RemoveLockedTables remove tables from table locked records.
 // - tables: tables of which will be unlocked.
+// - audit: the audit information recorded in the lock history.
 // Return the message of skipped tables and error.
-func (sl *statsLockImpl) RemoveLockedTables(tables map[int64]*types.StatsLockTable) (skipped string, err error) {
+func (sl *statsLockImpl) RemoveLockedTables(
+	tables map[int64]*types.StatsLockTable,
+	audit *types.StatsLockAudit,
+) (skipped string, err error) {
 	err = util.CallWithSCtx(sl.pool, func(sctx sessionctx.Context) error {
-		skipped, err = RemoveLockedTables(sctx, tables)
-		return err
+		return withTableLockHistory(sctx, lockHistoryActionUnlock, audit, func() error {
+			skipped, err = RemoveLockedTables(sctx, tables)
+			return err
+		}, func(before, after map[int64]struct{}) []lockHistoryRecord {
+			return tableLockHistoryRecords(tables, before, after)
+		})
 	}, util.FlagWrapTxn)
 	return
 }
@@ -94,19 +121,60 @@ func (sl *statsLockImpl) RemoveLockedTables(tables map[int64]*types.StatsLockTab
 // - tid: table id of which will be unlocked.
 // - tableName: table name of which will be unlocked.
 // - pidNames: partition ids of which will be unlocked.
+// - audit: the audit information recorded in the lock history.
 // Note: If the whole table is locked, then skip all partitions of the table.
 func (sl *statsLockImpl) RemoveLockedPartitions(
 	tid int64,
 	tableName string,
 	pidNames map[int64]string,
+	audit *types.StatsLockAudit,
 ) (skipped string, err error) {
 	err = util.CallWithSCtx(sl.pool, func(sctx sessionctx.Context) error {
-		skipped, err = RemoveLockedPartitions(sctx, tid, tableName, pidNames)
-		return err
+		return withTableLockHistory(sctx, lockHistoryActionUnlock, audit, func() error {
+			skipped, err = RemoveLockedPartitions(sctx, tid, tableName, pidNames)
+			return err
+		}, func(before, after map[int64]struct{}) []lockHistoryRecord {
+			return partitionLockHistoryRecords(tableName, pidNames, before, after)
+		})
 	}, util.FlagWrapTxn)
 	return
 }
 
+// RemoveExpiredLockedTables removes the tables and partitions whose locks have expired from table locked records.
+func (sl *statsLockImpl) RemoveExpiredLockedTables(is infoschema.InfoSchema) error {
+	return util.CallWithSCtx(sl.pool, func(sctx sessionctx.Context) error {
+		audit := &types.StatsLockAudit{Reason: expiredLockReason}
+		return withTableLockHistory(sctx, lockHistoryActionUnlock, audit, func() error {
+			return RemoveExpiredLockedTables(sctx, is)
+		}, func(before, after map[int64]struct{}) []lockHistoryRecord {
+			return expiredLockHistoryRecords(is, before, after)
+		})
+	}, util.FlagWrapTxn)
+}
+
+// withTableLockHistory runs the lock or unlock operation and records the tables and partitions
+// whose lock status is changed by it in the lock history.
+func withTableLockHistory(
+	sctx sessionctx.Context,
+	action string,
+	audit *types.StatsLockAudit,
+	op func() error,
+	genRecords func(before, after map[int64]struct{}) []lockHistoryRecord,
+) error {
+	before, err := QueryLockedTables(util.StatsCtx, sctx)
+	if err != nil {
+		return err
+	}
+	if err := op(); err != nil {
+		return err
+	}
+	after, err := QueryLockedTables(util.StatsCtx, sctx)
+	if err != nil {
+		return err
+	}
+	return recordLockHistory(sctx, action, genRecords(before, after), audit)
+}
+
 // queryLockedTables query locked tables from store.
 func (sl *statsLockImpl) queryLockedTables() (tables map[int64]struct{}, err error) {
 	err = util.CallWithSCtx(sl.pool, func(sctx sessionctx.Context) error {
@@ -127,6 +195,16 @@ func (sl *statsLockImpl) GetLockedTables(tableIDs ...int64) (map[int64]struct{},
 	return GetLockedTables(tableLocked, tableIDs...), nil
 }
 
+// GetTablesLockVersion returns the version of the latest lock operation of the given tables and partitions
+// recorded in the lock history. The tables without any lock record are not in the result.
+func (sl *statsLockImpl) GetTablesLockVersion(tableIDs ...int64) (versions map[int64]uint64, err error) {
+	err = util.CallWithSCtx(sl.pool, func(sctx sessionctx.Context) error {
+		versions, err = QueryTablesLockVersion(sctx, tableIDs...)
+		return err
+	})
+	return
+}
+
 // GetTableLockedAndClearForTest for unit test only.
 func (sl *statsLockImpl) GetTableLockedAndClearForTest() (map[int64]struct{}, error) {
 	return sl.queryLockedTables()
@@ -140,10 +218,12 @@ var (
 // AddLockedTables add locked tables id to store.
 // - exec: sql executor.
 // - tables: tables that will be locked.
+// - expireVersion: the TSO after which the tables are unlocked automatically, 0 means never.
 // Return the message of skipped tables and error.
 func AddLockedTables(
 	sctx sessionctx.Context,
 	tables map[int64]*types.StatsLockTable,
+	expireVersion uint64,
 ) (string, error) {
 	// Load tables to check duplicate before insert.
 	lockedTables, err := QueryLockedTables(util.StatsCtx, sctx)
@@ -161,13 +241,14 @@ func AddLockedTables(
 	}
 	logutil.StatsLogger().Info("lock table",
 		zap.Any("tables", tables),
+		zap.Uint64("expireVersion", expireVersion),
 	)
 
 	// Lock tables and partitions.
 	lockedTablesAndPartitions := GetLockedTables(lockedTables, ids...)
 	for tid, table := range tables {
 		if _, ok := lockedTablesAndPartitions[tid]; !ok {
-			if err := insertIntoStatsTableLockedAndUpdateStatsVersion(sctx, tid); err != nil {
+			if err := insertIntoStatsTableLockedAndUpdateStatsVersion(sctx, tid, expireVersion); err != nil {
 				return "", err
 			}
 		} else {
@@ -176,7 +257,7 @@ func AddLockedTables(
 
 		for pid := range table.PartitionInfo {
 			if _, ok := lockedTablesAndPartitions[pid]; !ok {
-				if err := insertIntoStatsTableLockedAndUpdateStatsVersion(sctx, pid); err != nil {
+				if err := insertIntoStatsTableLockedAndUpdateStatsVersion(sctx, pid, expireVersion); err != nil {
 					return "", err
 				}
 			}
@@ -194,12 +275,14 @@ func AddLockedTables(
 // - tid: table id of which will be locked.
 // - tableName: table name of which will be locked.
 // - pidNames: partition ids of which will be locked.
+// - expireVersion: the TSO after which the partitions are unlocked automatically, 0 means never.
 // Return the message of skipped tables and error.
 func AddLockedPartitions(
 	sctx sessionctx.Context,
 	tid int64,
 	tableName string,
 	pidNames map[int64]string,
+	expireVersion uint64,
 ) (string, error) {
 	// Load tables to check duplicate before insert.
 	lockedTables, err := QueryLockedTables(util.StatsCtx, sctx)
@@ -218,6 +301,7 @@ func AddLockedPartitions(
 		zap.String("tableName", tableName),
 		zap.Int64s("partitionIDs", pids),
 		zap.Strings("partitionNames", pNames),
+		zap.Uint64("expireVersion", expireVersion),
 	)
 
 	// Check if whole table is locked.
@@ -233,7 +317,7 @@ func AddLockedPartitions(
 	lockedPartitions := GetLockedTables(lockedTables, pids...)
 	for _, pid := range pids {
 		if _, ok := lockedPartitions[pid]; !ok {
-			if err := insertIntoStatsTableLockedAndUpdateStatsVersion(sctx, pid); err != nil {
+			if err := insertIntoStatsTableLockedAndUpdateStatsVersion(sctx, pid, expireVersion); err != nil {
 				return "", err
 			}
 		} else {
@@ -291,8 +375,8 @@ func generateStableSkippedPartitionsMessage(ids []int64, tableName string, skipp
 	return ""
 }
 
-func insertIntoStatsTableLockedAndUpdateStatsVersion(sctx sessionctx.Context, tid int64) error {
-	_, _, err := util.ExecRows(sctx, insertSQL, tid, tid)
+func insertIntoStatsTableLockedAndUpdateStatsVersion(sctx sessionctx.Context, tid int64, expireVersion uint64) error {
+	_, _, err := util.ExecRows(sctx, insertSQL, tid, expireVersion)
 	if err != nil {
 		logutil.StatsLogger().Error("error occurred when insert mysql.stats_table_locked", zap.Error(err))
 		return err
@@ -160,7 +160,7 @@ func TestInsertIntoStatsTableLocked(t *testing.T) {
 		util.StatsCtx,
 		util.UseCurrentSessionOpt,
 		gomock.Eq(insertSQL),
-		gomock.Eq([]any{int64(1), int64(1)}),
+		gomock.Eq([]any{int64(1), uint64(0)}),
 	)
 	exec.EXPECT().ExecRestrictedSQL(
 		util.StatsCtx,
@@ -168,7 +168,7 @@ func TestInsertIntoStatsTableLocked(t *testing.T) {
 		gomock.Eq(updateMetaVersionSQL),
 		gomock.Any(),
 	)
-	err := insertIntoStatsTableLockedAndUpdateStatsVersion(wrapAsSCtx(exec), 1)
+	err := insertIntoStatsTableLockedAndUpdateStatsVersion(wrapAsSCtx(exec), 1, 0)
 	require.NoError(t, err)
 
 	// Error should be returned when ExecRestrictedSQL returns error.
@@ -179,7 +179,7 @@ func TestInsertIntoStatsTableLocked(t *testing.T) {
 		gomock.Any(),
 	).Return(nil, nil, errors.New("test error"))
 
-	err = insertIntoStatsTableLockedAndUpdateStatsVersion(wrapAsSCtx(exec), 1)
+	err = insertIntoStatsTableLockedAndUpdateStatsVersion(wrapAsSCtx(exec), 1, 0)
 	require.Equal(t, "test error", err.Error())
 }
 
@@ -202,7 +202,7 @@ func TestAddLockedTables(t *testing.T) {
 		gomock.All(&ctxMatcher{}),
 		util.UseCurrentSessionOpt,
 		insertSQL,
-		gomock.Eq([]any{int64(2), int64(2)}),
+		gomock.Eq([]any{int64(2), uint64(0)}),
 	)
 	exec.EXPECT().ExecRestrictedSQL(
 		util.StatsCtx,
@@ -214,7 +214,7 @@ func TestAddLockedTables(t *testing.T) {
 		gomock.All(&ctxMatcher{}),
 		util.UseCurrentSessionOpt,
 		insertSQL,
-		gomock.Eq([]any{int64(3), int64(3)}),
+		gomock.Eq([]any{int64(3), uint64(0)}),
 	)
 	exec.EXPECT().ExecRestrictedSQL(
 		util.StatsCtx,
@@ -226,7 +226,7 @@ func TestAddLockedTables(t *testing.T) {
 		gomock.All(&ctxMatcher{}),
 		util.UseCurrentSessionOpt,
 		insertSQL,
-		gomock.Eq([]any{int64(4), int64(4)}),
+		gomock.Eq([]any{int64(4), uint64(0)}),
 	)
 	exec.EXPECT().ExecRestrictedSQL(
 		util.StatsCtx,
@@ -252,6 +252,7 @@ func TestAddLockedTables(t *testing.T) {
 	msg, err := AddLockedTables(
 		wrapAsSCtx(exec),
 		tables,
+		0,
 	)
 	require.NoError(t, err)
 	require.Equal(t, "skip locking locked tables: test.t1, other tables locked successfully", msg)
@@ -273,7 +274,7 @@ func TestAddLockedPartitions(t *testing.T) {
 		gomock.All(&ctxMatcher{}),
 		util.UseCurrentSessionOpt,
 		insertSQL,
-		gomock.Eq([]any{int64(2), int64(2)}),
+		gomock.Eq([]any{int64(2), uint64(100)}),
 	)
 	exec.EXPECT().ExecRestrictedSQL(
 		util.StatsCtx,
@@ -285,7 +286,7 @@ func TestAddLockedPartitions(t *testing.T) {
 		gomock.All(&ctxMatcher{}),
 		util.UseCurrentSessionOpt,
 		insertSQL,
-		gomock.Eq([]any{int64(3), int64(3)}),
+		gomock.Eq([]any{int64(3), uint64(100)}),
 	)
 	exec.EXPECT().ExecRestrictedSQL(
 		util.StatsCtx,
@@ -302,6 +303,7 @@ func TestAddLockedPartitions(t *testing.T) {
 			2: "p1",
 			3: "p2",
 		},
+		100,
 	)
 	require.NoError(t, err)
 	require.Equal(t, "", msg)
@@ -330,6 +332,7 @@ func TestAddLockedPartitionsFailed(t *testing.T) {
 			2: "p1",
 			3: "p2",
 		},
+		100,
 	)
 	require.NoError(t, err)
 	require.Equal(t, "skip locking partitions of locked table: test.t1", msg)
@@ -17,6 +17,7 @@ package lockstats
 import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/statistics/handle/cache"
 	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
@@ -31,6 +32,8 @@ const (
 	updateDeltaSQL = "UPDATE mysql.stats_meta SET version = %?, count = IF(count + %? > 0, count + %?, 0), modify_count = modify_count + %? WHERE table_id = %?"
 	// DeleteLockSQL is used to delete the locked table record.
 	DeleteLockSQL = "DELETE FROM mysql.stats_table_locked WHERE table_id = %?"
+	// selectExpiredSQL is used to select the tables and partitions whose locks have expired.
+	selectExpiredSQL = "SELECT table_id FROM mysql.stats_table_locked WHERE expire_version > 0 AND expire_version <= %?"
 )
 
 // RemoveLockedTables remove tables from table locked records.
@@ -140,6 +143,59 @@ func RemoveLockedPartitions(
 	return msg, err
 }
 
+// RemoveExpiredLockedTables removes the tables and partitions whose locks have expired from table locked records.
+// The same as UNLOCK STATS, the stats delta collected during the lock is applied to the stats meta.
+// - exec: sql executor.
+// - is: info schema to find the tables of the partitions.
+func RemoveExpiredLockedTables(sctx sessionctx.Context, is infoschema.InfoSchema) error {
+	version, err := util.GetStartTS(sctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	rows, _, err := util.ExecRows(sctx, selectExpiredSQL, version)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// The tables must be unlocked before their partitions, the same as RemoveLockedTables.
+	partitions := make(map[int64]int64, len(rows))
+	tids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		id := row.GetInt64(0)
+		if _, ok := is.TableByID(util.StatsCtx, id); ok {
+			if err := updateStatsAndUnlockTable(sctx, id); err != nil {
+				return err
+			}
+			tids = append(tids, id)
+			continue
+		}
+		if tbl, _, _ := is.FindTableByPartitionID(id); tbl != nil {
+			partitions[id] = tbl.Meta().ID
+			continue
+		}
+		// The table has been dropped, just remove the record.
+		if _, _, err := util.ExecRows(sctx, DeleteLockSQL, id); err != nil {
+			return err
+		}
+	}
+	pids := make([]int64, 0, len(partitions))
+	for pid, tid := range partitions {
+		if err := updateStatsAndUnlockPartition(sctx, pid, tid); err != nil {
+			return err
+		}
+		pids = append(pids, pid)
+	}
+
+	statslogutil.StatsLogger().Info("unlock expired tables and partitions",
+		zap.Int64s("tableIDs", tids),
+		zap.Int64s("partitionIDs", pids),
+	)
+	return nil
+}
+
 func updateDelta(sctx sessionctx.Context, count, modifyCount int64, tid int64) error {
 	version, err := util.GetStartTS(sctx)
 	if err != nil {
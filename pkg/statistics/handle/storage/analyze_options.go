@@ -0,0 +1,114 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+)
+
+// AnalyzeOptionsDDLEventHandlers returns the handlers of the ddl events which keep the analyze options persisted in
+// mysql.analyze_options following the physical IDs and the column IDs of the tables, otherwise the auto analyze
+// falls back to the default options once the table is truncated or a listed column is rebuilt by MODIFY COLUMN.
+func AnalyzeOptionsDDLEventHandlers() *types.DDLEventHandlers {
+	return &types.DDLEventHandlers{
+		Name: "analyze options",
+		OnCreateTable: func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, referTableID int64) error {
+			if referTableID == 0 {
+				return nil
+			}
+			is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+			referTblInfo, ok := is.TableInfoByID(referTableID)
+			if !ok {
+				return nil
+			}
+			return copyAnalyzeOptions(ctx, sctx, util.MapPhysicalIDs(tblInfo, referTblInfo))
+		},
+		OnTruncateTable: func(ctx context.Context, sctx sessionctx.Context, newTblInfo, droppedTblInfo *model.TableInfo, _ float64) error {
+			return copyAnalyzeOptions(ctx, sctx, util.MapPhysicalIDs(newTblInfo, droppedTblInfo))
+		},
+		OnModifyColumn: func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, modifiedCols, oldCols []*model.ColumnInfo) error {
+			if len(modifiedCols) != len(oldCols) {
+				return nil
+			}
+			newColIDs := make(map[string]string, len(modifiedCols))
+			for i, colInfo := range modifiedCols {
+				if colInfo.ID != oldCols[i].ID {
+					newColIDs[strconv.FormatInt(oldCols[i].ID, 10)] = strconv.FormatInt(colInfo.ID, 10)
+				}
+			}
+			if len(newColIDs) == 0 {
+				return nil
+			}
+			for _, id := range util.PhysicalIDs(tblInfo) {
+				if err := changeAnalyzeOptionsColumnIDs(ctx, sctx, id, newColIDs); err != nil {
+					return errors.Trace(err)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// copyAnalyzeOptions copies the analyze options of the old tables to the new ones, ids maps the IDs of the new
+// tables to the ones of the old tables.
+func copyAnalyzeOptions(ctx context.Context, sctx sessionctx.Context, ids map[int64]int64) error {
+	for newID, oldID := range ids {
+		_, err := util.ExecWithCtx(
+			ctx, sctx,
+			"REPLACE INTO mysql.analyze_options (table_id, sample_num, sample_rate, buckets, topn, column_choice, column_ids) "+
+				"SELECT %?, sample_num, sample_rate, buckets, topn, column_choice, column_ids FROM mysql.analyze_options WHERE table_id = %?",
+			newID, oldID,
+		)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// changeAnalyzeOptionsColumnIDs replaces the column IDs listed in the analyze options of the table, newColIDs maps
+// the old column IDs to the new ones.
+func changeAnalyzeOptionsColumnIDs(ctx context.Context, sctx sessionctx.Context, tableID int64, newColIDs map[string]string) error {
+	rows, _, err := util.ExecRowsWithCtx(ctx, sctx, "SELECT column_ids FROM mysql.analyze_options WHERE table_id = %?", tableID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(rows) == 0 || rows[0].IsNull(0) || rows[0].GetString(0) == "" {
+		return nil
+	}
+	colIDs := strings.Split(rows[0].GetString(0), ",")
+	changed := false
+	for i, colID := range colIDs {
+		if newColID, ok := newColIDs[strings.TrimSpace(colID)]; ok {
+			colIDs[i] = newColID
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	_, err = util.ExecWithCtx(ctx, sctx, "UPDATE mysql.analyze_options SET column_ids = %? WHERE table_id = %?",
+		strings.Join(colIDs, ","), tableID)
+	return errors.Trace(err)
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/handle/changeevent"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	statsutil "github.com/pingcap/tidb/pkg/statistics/util"
+)
+
+// systemTableStorage is the default StatsStorage, which keeps the stats in the mysql.stats_* system tables through
+// the internal SQL.
+type systemTableStorage struct {
+	statsHandle statstypes.StatsHandle
+}
+
+// NewSystemTableStorage creates the StatsStorage keeping the stats in the system tables.
+func NewSystemTableStorage(statsHandle statstypes.StatsHandle) statstypes.StatsStorage {
+	return &systemTableStorage{statsHandle: statsHandle}
+}
+
+// Save implements the StatsStorage interface.
+func (s *systemTableStorage) Save(results *statistics.AnalyzeResults, analyzeSnapshot bool, source string) (statsVer uint64, err error) {
+	err = util.CallWithSCtx(s.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+		statsVer, err = SaveTableStatsToStorage(sctx, results, analyzeSnapshot)
+		if err != nil {
+			return err
+		}
+		return changeevent.Record(sctx, changeevent.EventUpdate, source, statsVer, results.TableID.GetStatisticsID())
+	}, util.FlagWrapTxn, util.FlagRetryOnConflict)
+	return
+}
+
+// Load implements the StatsStorage interface.
+func (s *systemTableStorage) Load(
+	tableInfo *model.TableInfo,
+	physicalID int64,
+	loadAll bool,
+	snapshot uint64,
+	table *statistics.Table,
+) (statsTbl *statistics.Table, err error) {
+	load := func(sctx sessionctx.Context) error {
+		statsTbl, err = TableStatsFromStorage(sctx, snapshot, tableInfo, physicalID, loadAll, s.statsHandle.Lease(), table)
+		return err
+	}
+	if snapshot == 0 {
+		err = util.CallWithSCtx(s.statsHandle.SPool(), load, util.FlagWrapTxn)
+		return
+	}
+	// The stats are read at the requested snapshot consistently without any lock.
+	ctx := util.WithReadOnlyTxnTS(util.StatsCtx, snapshot)
+	err = util.CallWithSCtxCtx(ctx, s.statsHandle.SPool(), load, util.FlagWrapReadOnlyTxn)
+	return
+}
+
+// History implements the StatsStorage interface.
+func (s *systemTableStorage) History(physicalID int64, snapshot uint64) (jsonTbl *statsutil.JSONTable, exist bool, err error) {
+	err = util.CallWithSCtx(s.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+		jsonTbl, exist, err = TableHistoricalStatsToJSON(sctx, physicalID, snapshot)
+		return err
+	}, util.FlagWrapReadOnlyTxn)
+	return
+}
+
+// GC implements the StatsStorage interface.
+func (s *systemTableStorage) GC(statsIDs []int64, soft bool) error {
+	return util.CallWithSCtx(s.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+		return DeleteTableStatsFromKV(sctx, statsIDs, soft)
+	}, util.FlagWrapTxn)
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+)
+
+const (
+	// costCalibrationVarName is the name of the calibration profile of the cost model in mysql.tidb.
+	costCalibrationVarName = "tidb_cost_calibration_profile"
+	// maxCalibrationSamples is the maximum number of samples collected from each source.
+	maxCalibrationSamples = 1000
+)
+
+// CollectCostCalibrationSamples collects the samples to fit the calibration profile of the cost model from the
+// statement summary of all the instances in the cluster:
+//   - The analyze statements, whose coprocessor process time is the time to scan the processed keys. The total
+//     duration of an analyze job is not used since it also includes building and saving the stats.
+//   - The select statements accessing the storage, excluding the parse and compile latency not covered by the cost
+//     model.
+func CollectCostCalibrationSamples(sctx sessionctx.Context) ([]types.CostCalibrationSample, error) {
+	stmtSummaryTable := "cluster_statements_summary"
+	failpoint.Inject("mockLocalStmtSummary", func() {
+		stmtSummaryTable = "statements_summary"
+	})
+	rows, _, err := util.ExecRows(sctx, `select exec_count, avg_processed_keys, avg_process_time
+		from information_schema.%n
+		where stmt_type = 'AnalyzeTable' and exec_count > 0 and avg_processed_keys > 0 and avg_process_time > 0
+		order by exec_count desc limit %?`, stmtSummaryTable, maxCalibrationSamples)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	samples := make([]types.CostCalibrationSample, 0, len(rows))
+	for _, row := range rows {
+		samples = append(samples, types.CostCalibrationSample{
+			ScanRows: float64(row.GetInt64(1)),
+			Latency:  float64(row.GetInt64(2)),
+			Weight:   float64(row.GetInt64(0)),
+		})
+	}
+	rows, _, err = util.ExecRows(sctx, `select exec_count, avg_processed_keys, sum_cop_task_num / exec_count, avg_result_rows,
+		avg_latency - avg_parse_latency - avg_compile_latency
+		from information_schema.%n
+		where stmt_type = 'Select' and exec_count > 0 and (avg_processed_keys > 0 or sum_cop_task_num > 0)
+			and avg_latency > avg_parse_latency + avg_compile_latency
+		order by exec_count desc limit %?`, stmtSummaryTable, maxCalibrationSamples)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, row := range rows {
+		copTasks, err := row.GetMyDecimal(2).ToFloat64()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		samples = append(samples, types.CostCalibrationSample{
+			ScanRows:    float64(row.GetInt64(1)),
+			LookupTasks: copTasks,
+			NetRows:     float64(row.GetInt64(3)),
+			Latency:     float64(row.GetInt64(4)),
+			Weight:      float64(row.GetInt64(0)),
+		})
+	}
+	return samples, nil
+}
+
+// SaveCostCalibrationProfile saves the encoded calibration profile of the cost model to mysql.tidb.
+func SaveCostCalibrationProfile(sctx sessionctx.Context, profile []byte) error {
+	_, _, err := util.ExecRows(sctx,
+		"insert into mysql.tidb (variable_name, variable_value, comment) values (%?, %?, %?) on duplicate key update variable_value = %?",
+		costCalibrationVarName, string(profile), "the calibration profile of the cost model", string(profile))
+	return errors.Trace(err)
+}
+
+// LoadCostCalibrationProfile loads the encoded calibration profile of the cost model from mysql.tidb, nil if none.
+func LoadCostCalibrationProfile(sctx sessionctx.Context) ([]byte, error) {
+	rows, _, err := util.ExecRows(sctx, "select variable_value from mysql.tidb where variable_name = %?", costCalibrationVarName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return rows[0].GetBytes(0), nil
+}
@@ -285,6 +285,61 @@ func TestLoadPredicateColumns(t *testing.T) {
 	require.NotEqual(t, "<nil>", rows[1][3])
 }
 
+func TestDumpAndLoadPredicateColumnsOnly(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int)")
+	tk.MustExec("insert into t values (1, 2, 3), (2, 3, 4), (3, 4, 5)")
+	tk.MustExec("select * from t where c = 1")
+	tk.MustExec("select * from t where a = 1")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpColStatsUsageToKV())
+	tk.MustExec("select * from t where c = 1")
+	require.NoError(t, h.DumpColStatsUsageToKV())
+
+	is := dom.InfoSchema()
+	table, err := is.TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	jsonTbl, err := h.DumpColumnStatsUsageToJSON("test", table.Meta())
+	require.NoError(t, err)
+	require.True(t, jsonTbl.PredicateColumnsOnly)
+	require.Empty(t, jsonTbl.Columns)
+	jsonTbl.Sort()
+	require.Len(t, jsonTbl.PredicateColumns, 2)
+	require.Equal(t, "a", jsonTbl.PredicateColumns[0].Name)
+	require.Equal(t, "c", jsonTbl.PredicateColumns[1].Name)
+	require.Greater(t, jsonTbl.PredicateColumns[1].UsageScore, jsonTbl.PredicateColumns[0].UsageScore)
+
+	// Apply the predicate columns to a table whose column IDs are different.
+	tk.MustExec("create database staging")
+	tk.MustExec("create table staging.t (c int, b int, a int, d int)")
+	tk.MustExec("insert into staging.t values (1, 2, 3, 4)")
+	data, err := json.Marshal(jsonTbl)
+	require.NoError(t, err)
+	loadedTbl := &statsutil.JSONTable{}
+	require.NoError(t, json.Unmarshal(data, loadedTbl))
+	loadedTbl.DatabaseName = "staging"
+	is = dom.InfoSchema()
+	require.NoError(t, h.LoadColumnStatsUsageFromJSON(is, loadedTbl))
+
+	stagingTbl, err := is.TableByName(context.Background(), ast.NewCIStr("staging"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tk.MustQuery(fmt.Sprintf("select column_id, last_used_at is not null, usage_score > 1 from mysql.column_stats_usage where table_id = %d order by column_id", stagingTbl.Meta().ID)).Check(
+		testkit.Rows("1 1 1", "3 1 0"),
+	)
+	// The statistics of the table are not loaded.
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_histograms where table_id = %d", stagingTbl.Meta().ID)).Check(testkit.Rows("0"))
+
+	// The predicate columns are used by ANALYZE on the staging table.
+	tk.MustExec("set global tidb_analyze_column_options='PREDICATE'")
+	defer tk.MustExec("set global tidb_analyze_column_options=default")
+	tk.MustExec("analyze table staging.t")
+	tk.MustQuery("select table_name, job_info from mysql.analyze_jobs order by id desc limit 1").Check(
+		testkit.Rows("t analyze table columns c, a with 256 buckets, 100 topn, 1 samplerate"),
+	)
+}
+
 func TestLoadPartitionStatsErrPanic(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
 	tk := testkit.NewTestKit(t, store)
@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/handle/cache"
+	"github.com/pingcap/tidb/pkg/statistics/handle/changeevent"
 	"github.com/pingcap/tidb/pkg/statistics/handle/lockstats"
 	"github.com/pingcap/tidb/pkg/statistics/handle/types"
 	"github.com/pingcap/tidb/pkg/statistics/handle/util"
@@ -51,11 +52,19 @@ func NewStatsGC(statsHandle types.StatsHandle) types.StatsGC {
 	}
 }
 
+// DeleteColumnAndIndexStatsFromKV resets the stats of the columns and indexes of the tables or partitions in one
+// transaction, the other stats of them are kept.
+func (gc *statsGCImpl) DeleteColumnAndIndexStatsFromKV(statsIDs, colIDs, idxIDs []int64) (err error) {
+	return util.CallWithSCtx(gc.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+		return DeleteColumnAndIndexStatsFromKV(sctx, statsIDs, colIDs, idxIDs)
+	}, util.FlagWrapTxn)
+}
+
 // GCStats will garbage collect the useless stats' info.
 // For dropped tables, we will first update their version
 // so that other tidb could know that table is deleted.
 func (gc *statsGCImpl) GCStats(is infoschema.InfoSchema, ddlLease time.Duration) (err error) {
-	return util.CallWithSCtx(gc.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+	return util.CallWithSCtxAndLabels(util.StatsCtx, gc.statsHandle.SPool(), util.StatsOperationGC, 0, func(sctx sessionctx.Context) error {
 		return GCStats(sctx, gc.statsHandle, is, ddlLease)
 	})
 }
@@ -69,9 +78,7 @@ func (gc *statsGCImpl) ClearOutdatedHistoryStats() error {
 // DeleteTableStatsFromKV deletes table statistics from kv.
 // A statsID refers to statistic of a table or a partition.
 func (gc *statsGCImpl) DeleteTableStatsFromKV(statsIDs []int64, soft bool) (err error) {
-	return util.CallWithSCtx(gc.statsHandle.SPool(), func(sctx sessionctx.Context) error {
-		return DeleteTableStatsFromKV(sctx, statsIDs, soft)
-	}, util.FlagWrapTxn)
+	return gc.statsHandle.StatsStorage().GC(statsIDs, soft)
 }
 
 // GCStats will garbage collect the useless stats' info.
@@ -113,16 +120,16 @@ func GCStats(
 	if err != nil {
 		return errors.Trace(err)
 	}
+	// The historical stats of the dropped tables are kept until they are outdated, so that the stats
+	// can be restored when the tables are recovered.
 	for _, row := range rows {
 		if err := gcTableStats(sctx, statsHandle, is, row.GetInt64(0)); err != nil {
 			return errors.Trace(err)
 		}
-		_, existed := is.TableByID(context.Background(), row.GetInt64(0))
-		if !existed {
-			if err := gcHistoryStatsFromKV(sctx, row.GetInt64(0)); err != nil {
-				return errors.Trace(err)
-			}
-		}
+	}
+
+	if err := gcExpiredIndexTombstones(sctx, is, vardef.DroppedIndexStatsRetention.Load()); err != nil {
+		return errors.Trace(err)
 	}
 
 	if err := ClearOutdatedHistoryStats(sctx); err != nil {
@@ -131,6 +138,24 @@ func GCStats(
 			zap.Error(err))
 	}
 
+	if err := gcOutdatedLockHistory(sctx, vardef.HistoricalStatsDuration.Load()); err != nil {
+		logutil.BgLogger().Warn("failed to gc outdated stats lock history",
+			zap.Duration("duration", vardef.HistoricalStatsDuration.Load()),
+			zap.Error(err))
+	}
+
+	if err := gcOutdatedEstimationErrors(sctx, vardef.HistoricalStatsDuration.Load()); err != nil {
+		logutil.BgLogger().Warn("failed to gc outdated estimation errors",
+			zap.Duration("duration", vardef.HistoricalStatsDuration.Load()),
+			zap.Error(err))
+	}
+
+	if err := gcOutdatedChangeEvents(sctx, vardef.StatsChangeEventsRetention.Load()); err != nil {
+		logutil.BgLogger().Warn("failed to gc outdated stats change events",
+			zap.Duration("duration", vardef.StatsChangeEventsRetention.Load()),
+			zap.Error(err))
+	}
+
 	return removeDeletedExtendedStats(sctx, gcVer)
 }
 
@@ -172,6 +197,9 @@ func DeleteTableStatsFromKV(sctx sessionctx.Context, statsIDs []int64, soft bool
 		if _, err = util.Exec(sctx, "delete from mysql.stats_fm_sketch where table_id = %?", statsID); err != nil {
 			return err
 		}
+		if _, err = util.Exec(sctx, "delete from mysql.stats_fk_fanout where table_id = %?", statsID); err != nil {
+			return err
+		}
 		if _, err = util.Exec(sctx, "delete from mysql.column_stats_usage where table_id = %?", statsID); err != nil {
 			return err
 		}
@@ -181,6 +209,67 @@ func DeleteTableStatsFromKV(sctx sessionctx.Context, statsIDs []int64, soft bool
 		if _, err = util.Exec(sctx, lockstats.DeleteLockSQL, statsID); err != nil {
 			return err
 		}
+		if _, err = util.Exec(sctx, lockstats.DeleteLockedColumnsSQL, statsID); err != nil {
+			return err
+		}
+		if _, err = util.Exec(sctx, "delete from mysql.stats_index_tombstone where table_id = %?", statsID); err != nil {
+			return err
+		}
+		if _, err = util.Exec(sctx, "delete from mysql.stats_estimation_errors where table_id = %?", statsID); err != nil {
+			return err
+		}
+		if _, err = util.Exec(sctx, "delete from mysql.auto_analyze_decisions where table_id = %?", statsID); err != nil {
+			return err
+		}
+		if _, err = util.Exec(sctx, "delete from mysql.stats_drift where table_id = %?", statsID); err != nil {
+			return err
+		}
+	}
+	source := changeevent.SourceGC
+	if soft {
+		source = changeevent.SourceDropStats
+	}
+	return changeevent.Record(sctx, changeevent.EventDrop, source, startTS, statsIDs...)
+}
+
+// DeleteColumnAndIndexStatsFromKV resets the stats of the columns and indexes of the tables or partitions, which is
+// triggered by DROP STATS ... COLUMNS (...), INDEXES (...). Like DeleteTableStatsFromKV in the soft mode, the meta
+// info of the histograms is reset instead of deleted, so that the stats cached by the other tidb are reloaded.
+func DeleteColumnAndIndexStatsFromKV(sctx sessionctx.Context, statsIDs, colIDs, idxIDs []int64) (err error) {
+	startTS, err := util.GetStartTS(sctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, statsID := range statsIDs {
+		if _, err = util.Exec(sctx, "update mysql.stats_meta set version = %? where table_id = %? ", startTS, statsID); err != nil {
+			return err
+		}
+		for isIndex, histIDs := range [][]int64{colIDs, idxIDs} {
+			for _, histID := range histIDs {
+				if _, err = util.Exec(sctx,
+					"update mysql.stats_histograms "+
+						"set distinct_count = 0, null_count = 0, tot_col_size = 0, modify_count = 0, version = %?,"+
+						"cm_sketch = null, stats_ver = 0, flag = 0, correlation = 0, last_analyze_pos = null "+
+						"where table_id = %? and hist_id = %? and is_index = %?",
+					startTS, statsID, histID, isIndex); err != nil {
+					return err
+				}
+				if _, err = util.Exec(sctx, "delete from mysql.stats_buckets where table_id = %? and hist_id = %? and is_index = %?", statsID, histID, isIndex); err != nil {
+					return err
+				}
+				if _, err = util.Exec(sctx, "delete from mysql.stats_top_n where table_id = %? and hist_id = %? and is_index = %?", statsID, histID, isIndex); err != nil {
+					return err
+				}
+				if _, err = util.Exec(sctx, "delete from mysql.stats_fm_sketch where table_id = %? and hist_id = %? and is_index = %?", statsID, histID, isIndex); err != nil {
+					return err
+				}
+				if isIndex == 0 {
+					if _, err = util.Exec(sctx, "delete from mysql.stats_drift where table_id = %? and hist_id = %?", statsID, histID); err != nil {
+						return err
+					}
+				}
+			}
+		}
 	}
 	return nil
 }
@@ -227,16 +316,70 @@ func ClearOutdatedHistoryStats(sctx sessionctx.Context) error {
 	return nil
 }
 
-// gcHistoryStatsFromKV delete history stats from kv.
-func gcHistoryStatsFromKV(sctx sessionctx.Context, physicalID int64) (err error) {
-	sql := "delete from mysql.stats_history where table_id = %?"
-	_, err = util.Exec(sctx, sql, physicalID)
+// gcOutdatedLockHistory deletes the records of mysql.stats_lock_history which are older than the retention.
+// The records of the tables and partitions which are still locked are kept, because the version of
+// the latest lock operation is read from them.
+func gcOutdatedLockHistory(sctx sessionctx.Context, retention time.Duration) error {
+	const batchSize = 1000
+	rows, _, err := util.ExecRows(sctx, "select count(*) from mysql.stats_lock_history use index (idx_create_time) where create_time <= NOW() - INTERVAL %? SECOND", retention.Seconds())
 	if err != nil {
 		return errors.Trace(err)
 	}
-	sql = "delete from mysql.stats_meta_history where table_id = %?"
-	_, err = util.Exec(sctx, sql, physicalID)
-	return err
+	count := rows[0].GetInt64(0)
+	if count == 0 {
+		return nil
+	}
+	for n := int64(0); n < forCount(count, batchSize); n++ {
+		_, err = util.Exec(sctx, "delete from mysql.stats_lock_history use index (idx_create_time) where create_time <= NOW() - INTERVAL %? SECOND and table_id not in (select table_id from mysql.stats_table_locked) limit %?", retention.Seconds(), batchSize)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	logutil.BgLogger().Info("clear outdated stats lock history", zap.Int64("count", count))
+	return nil
+}
+
+// gcOutdatedEstimationErrors removes the estimation errors of the operators which are not seen within the retention.
+func gcOutdatedEstimationErrors(sctx sessionctx.Context, retention time.Duration) error {
+	const batchSize = 1000
+	rows, _, err := util.ExecRows(sctx, "select count(*) from mysql.stats_estimation_errors use index (idx_last_seen_at) where last_seen_at <= NOW() - INTERVAL %? SECOND", retention.Seconds())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	count := rows[0].GetInt64(0)
+	if count == 0 {
+		return nil
+	}
+	for n := int64(0); n < forCount(count, batchSize); n++ {
+		_, err = util.Exec(sctx, "delete from mysql.stats_estimation_errors use index (idx_last_seen_at) where last_seen_at <= NOW() - INTERVAL %? SECOND limit %?", retention.Seconds(), batchSize)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	logutil.BgLogger().Info("clear outdated estimation errors", zap.Int64("count", count))
+	return nil
+}
+
+// gcOutdatedChangeEvents removes the stats change events recorded before the retention, the consumers are expected
+// to have synchronized them.
+func gcOutdatedChangeEvents(sctx sessionctx.Context, retention time.Duration) error {
+	const batchSize = 1000
+	rows, _, err := util.ExecRows(sctx, "select count(*) from mysql.stats_change_events use index (idx_create_time) where create_time <= NOW() - INTERVAL %? SECOND", retention.Seconds())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	count := rows[0].GetInt64(0)
+	if count == 0 {
+		return nil
+	}
+	for n := int64(0); n < forCount(count, batchSize); n++ {
+		_, err = util.Exec(sctx, "delete from mysql.stats_change_events use index (idx_create_time) where create_time <= NOW() - INTERVAL %? SECOND limit %?", retention.Seconds(), batchSize)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	logutil.BgLogger().Info("clear outdated stats change events", zap.Int64("count", count))
+	return nil
 }
 
 // deleteHistStatsFromKV deletes all records about a column or an index and updates version.
@@ -313,6 +456,7 @@ func gcTableStats(sctx sessionctx.Context,
 	}
 
 	tblInfo := tbl.Meta()
+	var tombstonedIndexIDs map[int64]struct{}
 	for _, row := range rows {
 		isIndex, histID := row.GetInt64(0), row.GetInt64(1)
 		find := false
@@ -323,6 +467,15 @@ func gcTableStats(sctx sessionctx.Context,
 					break
 				}
 			}
+			if !find {
+				// The stats of the dropped index are retained until the tombstone is expired.
+				if tombstonedIndexIDs == nil {
+					if tombstonedIndexIDs, err = loadTombstonedIndexIDs(sctx, tblInfo.ID); err != nil {
+						return errors.Trace(err)
+					}
+				}
+				_, find = tombstonedIndexIDs[histID]
+			}
 		} else {
 			for _, col := range tblInfo.Columns {
 				if col.ID == histID {
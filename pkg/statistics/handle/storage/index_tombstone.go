@@ -0,0 +1,237 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+)
+
+// The stats of a dropped index are kept in the stats tables with the dropped index ID, and the index is
+// recorded in mysql.stats_index_tombstone. GC skips the stats of the tombstoned indexes until the retention
+// period is over, so the stats can be restored when an index with the same columns is added again.
+
+// tombstoneIndexColumn is an element of the index_columns of mysql.stats_index_tombstone.
+type tombstoneIndexColumn struct {
+	ID     int64 `json:"id"`
+	Length int   `json:"length"`
+}
+
+// encodeTombstoneIndexColumns encodes the columns of the index, which is used to match the re-created index.
+// It returns false if any column of the index can't be found in the table, e.g. the hidden column of an
+// expression index which has been removed along with the index.
+func encodeTombstoneIndexColumns(tblInfo *model.TableInfo, idxInfo *model.IndexInfo) (string, bool, error) {
+	cols := make([]tombstoneIndexColumn, 0, len(idxInfo.Columns))
+	for _, idxCol := range idxInfo.Columns {
+		col := model.FindColumnInfo(tblInfo.Columns, idxCol.Name.L)
+		if col == nil || col.Hidden {
+			return "", false, nil
+		}
+		cols = append(cols, tombstoneIndexColumn{ID: col.ID, Length: idxCol.Length})
+	}
+	b, err := json.Marshal(cols)
+	if err != nil {
+		return "", false, errors.Trace(err)
+	}
+	return string(b), true, nil
+}
+
+// getStatsPhysicalIDs returns the IDs under which the stats of the table may be saved.
+func getStatsPhysicalIDs(tblInfo *model.TableInfo) []int64 {
+	ids := []int64{tblInfo.ID}
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		for _, def := range pi.Definitions {
+			ids = append(ids, def.ID)
+		}
+	}
+	return ids
+}
+
+// formatPhysicalIDs converts the IDs to strings since the SQL executor only accepts string arrays for IN clauses.
+func formatPhysicalIDs(ids []int64) []string {
+	strIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strIDs = append(strIDs, strconv.FormatInt(id, 10))
+	}
+	return strIDs
+}
+
+// TombstoneIndexStats records the dropped index in mysql.stats_index_tombstone, so that its stats are
+// retained for a period instead of being deleted by GC. The indexes without stats are not recorded.
+func TombstoneIndexStats(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	idxInfo *model.IndexInfo,
+) error {
+	idxCols, ok, err := encodeTombstoneIndexColumns(tblInfo, idxInfo)
+	if err != nil || !ok {
+		return err
+	}
+	rows, _, err := util.ExecRowsWithCtx(
+		ctx, sctx,
+		"select count(*) from mysql.stats_histograms where table_id in (%?) and is_index = 1 and hist_id = %?",
+		formatPhysicalIDs(getStatsPhysicalIDs(tblInfo)), idxInfo.ID,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if rows[0].GetInt64(0) == 0 {
+		return nil
+	}
+	startTS, err := util.GetStartTS(sctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = util.ExecWithCtx(
+		ctx, sctx,
+		`replace into mysql.stats_index_tombstone (table_id, index_id, index_name, index_columns, drop_version)
+		values (%?, %?, %?, %?, %?)`,
+		tblInfo.ID, idxInfo.ID, idxInfo.Name.O, idxCols, startTS,
+	)
+	return errors.Trace(err)
+}
+
+// RestoreIndexStatsFromTombstone moves the retained stats of the latest dropped index which has the same
+// columns as the added index to the added index. It returns false if there is no such dropped index.
+func RestoreIndexStatsFromTombstone(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	idxInfo *model.IndexInfo,
+	retention time.Duration,
+) (startTS uint64, ok bool, err error) {
+	if retention <= 0 {
+		return 0, false, nil
+	}
+	idxCols, ok, err := encodeTombstoneIndexColumns(tblInfo, idxInfo)
+	if err != nil || !ok {
+		return 0, false, err
+	}
+	startTS, err = util.GetStartTS(sctx)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	rows, _, err := util.ExecRowsWithCtx(
+		ctx, sctx,
+		`select index_id from mysql.stats_index_tombstone
+		where table_id = %? and index_columns = %? and drop_version >= %?
+		order by drop_version desc limit 1`,
+		tblInfo.ID, idxCols, tombstoneExpireVersion(startTS, retention),
+	)
+	if err != nil || len(rows) == 0 {
+		return 0, false, errors.Trace(err)
+	}
+	droppedIndexID := rows[0].GetInt64(0)
+	ids := formatPhysicalIDs(getStatsPhysicalIDs(tblInfo))
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		"update mysql.stats_meta set version = %? where table_id in (%?)",
+		startTS, ids,
+	); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		"update mysql.stats_histograms set hist_id = %?, version = %? where table_id in (%?) and is_index = 1 and hist_id = %?",
+		idxInfo.ID, startTS, ids, droppedIndexID,
+	); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	for _, table := range []string{"stats_top_n", "stats_buckets", "stats_fm_sketch"} {
+		if _, err = util.ExecWithCtx(
+			ctx, sctx,
+			"update %n.%n set hist_id = %? where table_id in (%?) and is_index = 1 and hist_id = %?",
+			"mysql", table, idxInfo.ID, ids, droppedIndexID,
+		); err != nil {
+			return 0, false, errors.Trace(err)
+		}
+	}
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		"delete from mysql.stats_index_tombstone where table_id = %? and index_id = %?",
+		tblInfo.ID, droppedIndexID,
+	); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	return startTS, true, nil
+}
+
+// tombstoneExpireVersion returns the version before which the tombstones are expired.
+func tombstoneExpireVersion(now uint64, retention time.Duration) uint64 {
+	offset := util.DurationToTS(retention)
+	if now < offset {
+		return 0
+	}
+	return now - offset
+}
+
+// loadTombstonedIndexIDs loads the IDs of the dropped indexes of the table whose stats are retained.
+func loadTombstonedIndexIDs(sctx sessionctx.Context, tableID int64) (map[int64]struct{}, error) {
+	rows, _, err := util.ExecRows(sctx, "select index_id from mysql.stats_index_tombstone where table_id = %?", tableID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ids := make(map[int64]struct{}, len(rows))
+	for _, row := range rows {
+		ids[row.GetInt64(0)] = struct{}{}
+	}
+	return ids, nil
+}
+
+// gcExpiredIndexTombstones deletes the retained stats of the dropped indexes whose retention period is over.
+func gcExpiredIndexTombstones(sctx sessionctx.Context, is infoschema.InfoSchema, retention time.Duration) error {
+	expireVersion := tombstoneExpireVersion(oracle.GoTimeToTS(time.Now()), retention)
+	rows, _, err := util.ExecRows(
+		sctx,
+		"select table_id, index_id from mysql.stats_index_tombstone where drop_version < %?",
+		expireVersion,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, row := range rows {
+		tableID, indexID := row.GetInt64(0), row.GetInt64(1)
+		err := util.WrapTxn(sctx, func(sctx sessionctx.Context) error {
+			// The stats of the dropped table are deleted by the GC of the table.
+			if tbl, ok := is.TableByID(context.Background(), tableID); ok {
+				for _, id := range getStatsPhysicalIDs(tbl.Meta()) {
+					if err := deleteHistStatsFromKV(sctx, id, indexID, 1); err != nil {
+						return errors.Trace(err)
+					}
+				}
+			}
+			_, err := util.Exec(sctx, "delete from mysql.stats_index_tombstone where table_id = %? and index_id = %?", tableID, indexID)
+			return errors.Trace(err)
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		logutil.BgLogger().Info("remove stats in GC due to expired dropped index",
+			zap.Int64("tableID", tableID), zap.Int64("indexID", indexID))
+	}
+	return nil
+}
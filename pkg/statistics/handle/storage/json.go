@@ -22,6 +22,7 @@ import (
 	"github.com/klauspost/compress/gzip"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/statistics"
@@ -65,6 +66,30 @@ func extendedStatsFromJSON(statsColl []*statsutil.JSONExtendedStats) *statistics
 			ScalarVals: js.ScalarVals,
 			StringVals: js.StringVals,
 		}
+		if item.Tp == ast.StatsTypeTrigram && item.StringVals != "" {
+			trigrams, err := statistics.DecodeTrigramStats(item.StringVals)
+			if err != nil {
+				logutil.BgLogger().Warn("decode trigram stats from json failed", zap.String("stats", js.StatsName), zap.Error(err))
+				continue
+			}
+			item.Trigrams = trigrams
+		}
+		if item.Tp == ast.StatsTypeExpression && item.StringVals != "" {
+			exprStats, err := statistics.DecodeExpressionStats(item.StringVals)
+			if err != nil {
+				logutil.BgLogger().Warn("decode expression stats from json failed", zap.String("stats", js.StatsName), zap.Error(err))
+				continue
+			}
+			item.ExprStats = exprStats
+		}
+		if item.Tp == ast.StatsTypeJoinSketch && item.StringVals != "" {
+			sketch, err := statistics.DecodeJoinSketch(item.StringVals)
+			if err != nil {
+				logutil.BgLogger().Warn("decode join sketch from json failed", zap.String("stats", js.StatsName), zap.Error(err))
+				continue
+			}
+			item.JoinSketch = sketch
+		}
 		stats.Stats[js.StatsName] = item
 	}
 	return stats
@@ -144,29 +169,41 @@ func GenJSONTableFromStats(
 	}
 	jsonTbl.ExtStats = dumpJSONExtendedStats(tbl.ExtendedStats)
 	if colStatsUsage != nil {
-		// nilIfNil checks if the provided *time.Time is nil and returns a nil or its string representation accordingly.
-		nilIfNil := func(t *types.Time) *string {
-			if t == nil {
-				return nil
-			}
-			s := t.String()
-			return &s
-		}
-		jsonColStatsUsage := make([]*statsutil.JSONPredicateColumn, 0, len(colStatsUsage))
-		for id, usage := range colStatsUsage {
-			jsonCol := &statsutil.JSONPredicateColumn{
-				ID:             id.ID,
-				LastUsedAt:     nilIfNil(usage.LastUsedAt),
-				LastAnalyzedAt: nilIfNil(usage.LastAnalyzedAt),
-			}
-			jsonColStatsUsage = append(jsonColStatsUsage, jsonCol)
-		}
-		jsonTbl.PredicateColumns = jsonColStatsUsage
+		jsonTbl.PredicateColumns = dumpJSONPredicateColumns(tableInfo, colStatsUsage)
 	}
 
 	return jsonTbl, nil
 }
 
+// dumpJSONPredicateColumns converts the column stats usage to JSONPredicateColumn.
+func dumpJSONPredicateColumns(
+	tableInfo *model.TableInfo,
+	colStatsUsage map[model.TableItemID]statstypes.ColStatsTimeInfo,
+) []*statsutil.JSONPredicateColumn {
+	// nilIfNil checks if the provided *time.Time is nil and returns a nil or its string representation accordingly.
+	nilIfNil := func(t *types.Time) *string {
+		if t == nil {
+			return nil
+		}
+		s := t.String()
+		return &s
+	}
+	jsonColStatsUsage := make([]*statsutil.JSONPredicateColumn, 0, len(colStatsUsage))
+	for id, usage := range colStatsUsage {
+		jsonCol := &statsutil.JSONPredicateColumn{
+			ID:             id.ID,
+			LastUsedAt:     nilIfNil(usage.LastUsedAt),
+			LastAnalyzedAt: nilIfNil(usage.LastAnalyzedAt),
+			UsageScore:     usage.UsageScore,
+		}
+		if col := tableInfo.FindColumnByID(id.ID); col != nil {
+			jsonCol.Name = col.Name.L
+		}
+		jsonColStatsUsage = append(jsonColStatsUsage, jsonCol)
+	}
+	return jsonColStatsUsage
+}
+
 // TableStatsFromJSON loads statistic from JSONTable and return the Table of statistic.
 func TableStatsFromJSON(tableInfo *model.TableInfo, physicalID int64, jsonTbl *statsutil.JSONTable) (*statistics.Table, error) {
 	newHistColl := *statistics.NewHistColl(physicalID, jsonTbl.Count, jsonTbl.ModifyCount, len(jsonTbl.Columns), len(jsonTbl.Indices))
@@ -16,12 +16,16 @@ package storage
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
+	"math/rand"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/kvproto/pkg/debugpb"
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/kv"
@@ -34,12 +38,17 @@ import (
 	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
 	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
 	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/codec"
 	"github.com/pingcap/tidb/pkg/util/intest"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tidb/pkg/util/sqlexec"
+	tikvstore "github.com/tikv/client-go/v2/kv"
+	"github.com/tikv/client-go/v2/tikv"
+	"github.com/tikv/client-go/v2/tikvrpc"
 	"go.uber.org/zap"
 )
 
@@ -83,6 +92,16 @@ func statsMetaCountAndModifyCount(
 	return count, modifyCount, false, nil
 }
 
+// LastAnalyzedHandle reads the max handle of the rows analyzed by the last ANALYZE INCREMENTAL TABLE from
+// mysql.stats_meta. ok is false if the table isn't analyzed incrementally last time.
+func LastAnalyzedHandle(sctx sessionctx.Context, tableID int64) (handle int64, ok bool, err error) {
+	rows, _, err := util.ExecRows(sctx, "select last_analyzed_handle from mysql.stats_meta where table_id = %?", tableID)
+	if err != nil || len(rows) == 0 || rows[0].IsNull(0) {
+		return 0, false, err
+	}
+	return rows[0].GetInt64(0), true, nil
+}
+
 // HistMetaFromStorageWithHighPriority reads the meta info of the histogram from the storage.
 func HistMetaFromStorageWithHighPriority(sctx sessionctx.Context, item *model.TableItemID, possibleColInfo *model.ColumnInfo) (*statistics.Histogram, int64, error) {
 	isIndex := 0
@@ -247,6 +266,29 @@ func CheckSkipColumnPartiion(sctx sessionctx.Context, tblID int64, isIndex int,
 	return nil
 }
 
+// HistVersionsFromStorage reads the versions of all the column or index histograms of the tables in one query.
+// The key of the returned map is the table ID, and the key of the inner map is the hist ID.
+func HistVersionsFromStorage(sctx sessionctx.Context, tblIDs []int64, isIndex int) (map[int64]map[int64]uint64, error) {
+	versions := make(map[int64]map[int64]uint64, len(tblIDs))
+	if len(tblIDs) == 0 {
+		return versions, nil
+	}
+	// Convert table IDs to strings since the SQL executor only accepts string arrays for IN clauses
+	tblStringIDs := make([]string, 0, len(tblIDs))
+	for _, tblID := range tblIDs {
+		tblStringIDs = append(tblStringIDs, strconv.FormatInt(tblID, 10))
+		versions[tblID] = make(map[int64]uint64)
+	}
+	rows, _, err := util.ExecRows(sctx, "select table_id, hist_id, version from mysql.stats_histograms where table_id in (%?) and is_index = %?", tblStringIDs, isIndex)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		versions[row.GetInt64(0)][row.GetInt64(1)] = row.GetUint64(2)
+	}
+	return versions, nil
+}
+
 // ExtendedStatsFromStorage reads extended stats from storage.
 func ExtendedStatsFromStorage(sctx sessionctx.Context, table *statistics.Table, tableID int64, loadAll bool) (*statistics.Table, error) {
 	failpoint.Inject("injectExtStatsLoadErr", func() {
@@ -291,6 +333,27 @@ func ExtendedStatsFromStorage(sctx sessionctx.Context, table *statistics.Table,
 			} else {
 				item.StringVals = statsStr
 			}
+			if item.Tp == ast.StatsTypeTrigram && statsStr != "" {
+				item.Trigrams, err = statistics.DecodeTrigramStats(statsStr)
+				if err != nil {
+					statslogutil.StatsLogger().Error("decode trigram stats failed", zap.Error(err))
+					return nil, err
+				}
+			}
+			if item.Tp == ast.StatsTypeExpression && statsStr != "" {
+				item.ExprStats, err = statistics.DecodeExpressionStats(statsStr)
+				if err != nil {
+					statslogutil.StatsLogger().Error("decode expression stats failed", zap.Error(err))
+					return nil, err
+				}
+			}
+			if item.Tp == ast.StatsTypeJoinSketch && statsStr != "" {
+				item.JoinSketch, err = statistics.DecodeJoinSketch(statsStr)
+				if err != nil {
+					statslogutil.StatsLogger().Error("decode join sketch failed", zap.Error(err))
+					return nil, err
+				}
+			}
 			table.ExtendedStats.Stats[name] = item
 		}
 	}
@@ -518,6 +581,7 @@ func TableStatsFromStorage(sctx sessionctx.Context, snapshot uint64, tableInfo *
 		table = table.Copy()
 	}
 	table.Pseudo = false
+	table.Pinned = tableInfo.TableCacheStatusType == model.TableCacheStatusEnable
 
 	realtimeCount, modidyCount, isNull, err := StatsMetaCountAndModifyCount(util.StatsCtx, sctx, tableID)
 	if err != nil || isNull {
@@ -547,6 +611,9 @@ func TableStatsFromStorage(sctx sessionctx.Context, snapshot uint64, tableInfo *
 			return nil, err
 		}
 	}
+	if err := derivePrefixGlobalIndexStats(sctx, table, tableInfo, tableID, rows, loadAll); err != nil {
+		return nil, err
+	}
 	// If DROP STATS executes, we need to reset the stats version to 0.
 	if table.StatsVer != statistics.Version0 {
 		allZero := true
@@ -569,9 +636,128 @@ func TableStatsFromStorage(sctx sessionctx.Context, snapshot uint64, tableInfo *
 		}
 	}
 	table.ColAndIdxExistenceMap.SetChecked()
+	if table.FKFanouts, err = fkFanoutsFromStorage(sctx, tableID); err != nil {
+		return nil, err
+	}
 	return ExtendedStatsFromStorage(sctx, table, tableID, loadAll)
 }
 
+// fkFanoutsFromStorage reads the fanouts of the foreign keys of the table from storage.
+func fkFanoutsFromStorage(sctx sessionctx.Context, tableID int64) (map[int64]*statistics.FKFanout, error) {
+	rows, _, err := util.ExecRows(sctx, "select fk_id, col_id, row_count, null_count, parent_keys, avg_fanout, p50_fanout, p90_fanout, p99_fanout, max_fanout, version from mysql.stats_fk_fanout where table_id = %?", tableID)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	fanouts := make(map[int64]*statistics.FKFanout, len(rows))
+	for _, row := range rows {
+		fanouts[row.GetInt64(0)] = &statistics.FKFanout{
+			FKID:       row.GetInt64(0),
+			ColID:      row.GetInt64(1),
+			RowCount:   row.GetFloat64(2),
+			NullCount:  row.GetFloat64(3),
+			ParentKeys: row.GetFloat64(4),
+			Avg:        row.GetFloat64(5),
+			P50:        row.GetFloat64(6),
+			P90:        row.GetFloat64(7),
+			P99:        row.GetFloat64(8),
+			Max:        row.GetFloat64(9),
+			Version:    row.GetUint64(10),
+		}
+	}
+	return fanouts, nil
+}
+
+// derivePrefixGlobalIndexStats derives the stats of the global indexes with prefix column which have no stats
+// from the stats of their base columns. The derived stats are kept until the base column is analyzed again.
+// They are derived from the base column stats in memory if possible, the base column stats are only read from
+// the storage when all the stats are required, otherwise the index stats are derived when the base column is
+// loaded, see statistics.DerivePrefixGlobalIndexStatsOnColumn.
+func derivePrefixGlobalIndexStats(sctx sessionctx.Context, table *statistics.Table, tableInfo *model.TableInfo, tableID int64, rows []chunk.Row, loadAll bool) error {
+	// The global indexes only have the global stats.
+	if tableID != tableInfo.ID {
+		return nil
+	}
+	idxInfos := statistics.PrefixGlobalIndexes(tableInfo)
+	if len(idxInfos) == 0 {
+		return nil
+	}
+	analyzedIdxs := make(map[int64]struct{})
+	colVersions := make(map[int64]uint64)
+	for _, row := range rows {
+		if row.GetInt64(1) > 0 {
+			analyzedIdxs[row.GetInt64(2)] = struct{}{}
+		} else {
+			colVersions[row.GetInt64(2)] = row.GetUint64(4)
+		}
+	}
+	for _, idxInfo := range idxInfos {
+		if _, ok := analyzedIdxs[idxInfo.ID]; ok {
+			continue
+		}
+		colInfo := statistics.PrefixIndexBaseColumn(tableInfo, idxInfo)
+		colVersion, ok := colVersions[colInfo.ID]
+		if !ok {
+			continue
+		}
+		if idx := table.GetIdx(idxInfo.ID); idx != nil && idx.LastUpdateVersion >= colVersion {
+			continue
+		}
+		var (
+			idx *statistics.Index
+			err error
+		)
+		if col := table.GetCol(colInfo.ID); col != nil && col.IsFullLoad() && col.LastUpdateVersion >= colVersion {
+			idx, err = statistics.DerivePrefixIndexStats(col, idxInfo)
+		} else if loadAll {
+			idx, err = derivePrefixIndexStats(sctx, tableInfo, tableID, idxInfo)
+		} else {
+			// The stale stats are removed, the index stats are derived again when the base column is loaded.
+			table.DelIdx(idxInfo.ID)
+			continue
+		}
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if idx == nil {
+			continue
+		}
+		table.SetIdx(idxInfo.ID, idx)
+		table.ColAndIdxExistenceMap.InsertIndex(idxInfo.ID, false)
+	}
+	return nil
+}
+
+// derivePrefixIndexStats derives the stats of the global index with prefix column from the stats of its base
+// column in the storage. It returns nil if the stats can't be derived.
+func derivePrefixIndexStats(sctx sessionctx.Context, tableInfo *model.TableInfo, tableID int64, idxInfo *model.IndexInfo) (*statistics.Index, error) {
+	colInfo := statistics.PrefixIndexBaseColumn(tableInfo, idxInfo)
+	if colInfo == nil {
+		return nil, nil
+	}
+	item := model.TableItemID{TableID: tableID, ID: colInfo.ID}
+	hgMeta, statsVer, err := HistMetaFromStorageWithHighPriority(sctx, &item, colInfo)
+	if hgMeta == nil || err != nil || statsVer != statistics.Version2 {
+		return nil, err
+	}
+	hg, err := HistogramFromStorageWithPriority(sctx, tableID, colInfo.ID, &colInfo.FieldType, hgMeta.NDV, 0, hgMeta.LastUpdateVersion, hgMeta.NullCount, hgMeta.TotColSize, hgMeta.Correlation, kv.PriorityHigh)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	_, topN, err := CMSketchAndTopNFromStorageWithHighPriority(sctx, tableID, 0, colInfo.ID, statsVer)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	col := &statistics.Column{
+		PhysicalID:        tableID,
+		Histogram:         *hg,
+		Info:              colInfo,
+		TopN:              topN,
+		StatsVer:          statsVer,
+		StatsLoadedStatus: statistics.NewStatsFullLoadStatus(),
+	}
+	return statistics.DerivePrefixIndexStats(col, idxInfo)
+}
+
 // LoadHistogram will load histogram from storage.
 func LoadHistogram(sctx sessionctx.Context, tableID int64, isIndex int, histID int64, tableInfo *model.TableInfo) (*statistics.Histogram, error) {
 	row, _, err := util.ExecRows(sctx, "select distinct_count, version, null_count, tot_col_size, stats_ver, flag, correlation from mysql.stats_histograms where table_id = %? and is_index = %? and hist_id = %?", tableID, isIndex, histID)
@@ -744,6 +930,12 @@ func loadNeededColumnHistograms(sctx sessionctx.Context, statsHandle statstypes.
 		}
 	}
 	statsTbl.SetCol(col.ID, colHist)
+	// The stats of the global indexes with prefix column are derived from the base column lazily.
+	if fullLoad && colHist.StatsAvailable() {
+		if err := statistics.DerivePrefixGlobalIndexStatsOnColumn(statsTbl, tblInfo, colHist); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	statsHandle.UpdateStatsCache(statstypes.CacheUpdate{
 		Updated: []*statistics.Table{statsTbl},
 	})
@@ -769,6 +961,11 @@ func loadNeededIndexHistograms(sctx sessionctx.Context, is infoschema.InfoSchema
 	}
 	hgMeta, statsVer, err := HistMetaFromStorageWithHighPriority(sctx, &idx, nil)
 	if hgMeta == nil || err != nil {
+		if err == nil {
+			// The stats of the global index with prefix column may be derived, they need to be derived again
+			// after being evicted.
+			err = loadDerivedPrefixIndexStats(sctx, is, statsHandle, idx)
+		}
 		asyncload.AsyncLoadHistogramNeededItems.Delete(idx)
 		return err
 	}
@@ -824,6 +1021,31 @@ func loadNeededIndexHistograms(sctx sessionctx.Context, is infoschema.InfoSchema
 	return nil
 }
 
+func loadDerivedPrefixIndexStats(sctx sessionctx.Context, is infoschema.InfoSchema, statsHandle statstypes.StatsHandle, idx model.TableItemID) error {
+	tbl, ok := statsHandle.TableInfoByID(is, idx.TableID)
+	if !ok || tbl.Meta().ID != idx.TableID {
+		return nil
+	}
+	idxInfo := tbl.Meta().FindIndexByID(idx.ID)
+	if idxInfo == nil {
+		return nil
+	}
+	idxHist, err := derivePrefixIndexStats(sctx, tbl.Meta(), idx.TableID, idxInfo)
+	if idxHist == nil || err != nil {
+		return err
+	}
+	statsTbl, ok := statsHandle.Get(idx.TableID)
+	if !ok {
+		return nil
+	}
+	statsTbl = statsTbl.Copy()
+	statsTbl.SetIdx(idx.ID, idxHist)
+	statsHandle.UpdateStatsCache(statstypes.CacheUpdate{
+		Updated: []*statistics.Table{statsTbl},
+	})
+	return nil
+}
+
 // StatsMetaByTableIDFromStorage gets the stats meta of a table from storage.
 func StatsMetaByTableIDFromStorage(sctx sessionctx.Context, tableID int64, snapshot uint64) (version uint64, modifyCount, count int64, err error) {
 	var rows []chunk.Row
@@ -843,3 +1065,251 @@ func StatsMetaByTableIDFromStorage(sctx sessionctx.Context, tableID int64, snaps
 	count = rows[0].GetInt64(2)
 	return
 }
+
+// dynamicSampleScanFactor bounds the rows scanned by the dynamic sampling of a table with few regions, which is
+// dynamicSampleScanFactor times of the max number of the sampled rows.
+const dynamicSampleScanFactor = 10
+
+// SampleTableRows samples at most maxRows rows of the public columns of the table. It's used to build the dynamic
+// sample of a table without usable stats, see statistics.DynamicSample, and to check the stats drift of a table.
+// The rows are sampled from the first rows of all the regions by TABLESAMPLE REGIONS() if the table has enough
+// regions. Otherwise, at most dynamicSampleScanFactor*maxRows rows are scanned and maxRows of them are picked
+// randomly, so the sample is random but may be biased to the beginning of a large table with few regions.
+func SampleTableRows(sctx sessionctx.Context, dbName string, tblInfo *model.TableInfo, maxRows int) (*statistics.DynamicSample, error) {
+	cols := make([]*model.ColumnInfo, 0, len(tblInfo.Columns))
+	for _, col := range tblInfo.Columns {
+		if col.State == model.StatePublic && !col.Hidden {
+			cols = append(cols, col)
+		}
+	}
+	if len(cols) == 0 || maxRows <= 0 {
+		return nil, nil
+	}
+	var sql strings.Builder
+	args := make([]any, 0, len(cols)+3)
+	sql.WriteString("select ")
+	for i, col := range cols {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		sql.WriteString("%n")
+		args = append(args, col.Name.O)
+	}
+	sql.WriteString(" from %n.%n")
+	args = append(args, dbName, tblInfo.Name.O)
+	rows, _, err := util.ExecRows(sctx, sql.String()+" tablesample regions()", args...)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	complete := false
+	if len(rows) < maxRows {
+		// Read one more row to tell whether all the rows of the table are read.
+		scanLimit := maxRows*dynamicSampleScanFactor + 1
+		rows, _, err = util.ExecRows(sctx, sql.String()+" limit %?", append(args, scanLimit)...)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		complete = len(rows) <= maxRows
+		rows = rows[:min(len(rows), scanLimit-1)]
+	}
+	if len(rows) > maxRows {
+		// Pick maxRows rows randomly by a partial Fisher-Yates shuffle.
+		rng := rand.New(rand.NewSource(time.Now().UnixNano())) // #nosec G404
+		for i := range maxRows {
+			j := i + rng.Intn(len(rows)-i)
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+		rows = rows[:maxRows]
+	}
+	sample := &statistics.DynamicSample{
+		ColIDs:   make([]int64, 0, len(cols)),
+		Rows:     make([][]types.Datum, 0, len(rows)),
+		Complete: complete,
+	}
+	fieldTypes := make([]*types.FieldType, 0, len(cols))
+	for _, col := range cols {
+		sample.ColIDs = append(sample.ColIDs, col.ID)
+		fieldTypes = append(fieldTypes, &col.FieldType)
+	}
+	for _, row := range rows {
+		sample.Rows = append(sample.Rows, row.GetDatumRow(fieldTypes))
+	}
+	return sample, nil
+}
+
+// EstimateRowCountByRegions estimates the row count of the physical table by the approximate keys of its record
+// regions. The only region of a small table may be shared with the other tables, so the approximate keys of it are
+// only used as an upper bound of statistics.PseudoRowCount. It returns 0 if there is no record region.
+func EstimateRowCountByRegions(sctx sessionctx.Context, physicalID int64) (int64, error) {
+	failpoint.Inject("mockRegionRowCount", func(val failpoint.Value) {
+		failpoint.Return(int64(val.(int)), nil)
+	})
+	rows, _, err := util.ExecRows(sctx, `select count(*), cast(ifnull(sum(approximate_keys), 0) as signed)
+		from information_schema.tikv_region_status where table_id = %? and is_index = 0`, physicalID)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	regionCount, keys := rows[0].GetInt64(0), rows[0].GetInt64(1)
+	if regionCount == 1 {
+		return min(keys, statistics.PseudoRowCount), nil
+	}
+	return keys, nil
+}
+
+// RegionPropertiesOfTable returns the properties of the record regions and the regions of each index of the physical
+// table reported by TiKV, which are used to build the quick stats.
+func RegionPropertiesOfTable(sctx sessionctx.Context, tblInfo *model.TableInfo, physicalID int64) (
+	records []statistics.RegionProperties, indexes map[int64][]statistics.RegionProperties, err error) {
+	failpoint.Inject("mockRegionProperties", func(val failpoint.Value) {
+		// Two record regions sharing the keys, 100 bytes per key.
+		keys := int64(val.(int))
+		r := statistics.RegionProperties{ApproximateKeys: keys / 2, ApproximateSize: keys / 2 * 100}
+		failpoint.Return([]statistics.RegionProperties{r, r}, nil, nil)
+	})
+	sql := `select is_index, ifnull(index_id, 0), start_key, end_key, ifnull(approximate_size, 0), ifnull(approximate_keys, 0)
+		from information_schema.tikv_region_status where table_id = %? and is_partition = 0`
+	args := []any{tblInfo.ID}
+	if physicalID != tblInfo.ID {
+		sql = `select is_index, ifnull(index_id, 0), start_key, end_key, ifnull(approximate_size, 0), ifnull(approximate_keys, 0)
+		from information_schema.tikv_region_status where table_id = %? and partition_id = %?`
+		args = append(args, physicalID)
+	}
+	rows, _, err := util.ExecRows(sctx, sql, args...)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	indexes = make(map[int64][]statistics.RegionProperties)
+	for _, row := range rows {
+		r := statistics.RegionProperties{
+			StartKey: decodeRegionKey(row.GetString(2)),
+			EndKey:   decodeRegionKey(row.GetString(3)),
+			// The approximate size is reported in MiB.
+			ApproximateSize: row.GetInt64(4) << 20,
+			ApproximateKeys: row.GetInt64(5),
+		}
+		if row.GetInt64(0) == 0 {
+			records = append(records, r)
+		} else {
+			indexes[row.GetInt64(1)] = append(indexes[row.GetInt64(1)], r)
+		}
+	}
+	return records, indexes, nil
+}
+
+// decodeRegionKey decodes the hex boundary key of a region reported by PD to the raw key. It returns nil for the
+// unbounded key.
+func decodeRegionKey(hexKey string) []byte {
+	if hexKey == "" {
+		return nil
+	}
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil
+	}
+	if _, raw, err := codec.DecodeBytes(key, nil); err == nil {
+		return raw
+	}
+	return key
+}
+
+// RegionKeys is the approximate keys of the record regions of a physical table reported by TiKV.
+type RegionKeys struct {
+	Regions int64
+	Keys    int64
+}
+
+// RegionKeysOfTables returns the approximate keys of the record regions of the physical tables, the tables without any
+// record region are absent.
+func RegionKeysOfTables(sctx sessionctx.Context, physicalIDs []int64) (map[int64]RegionKeys, error) {
+	if len(physicalIDs) == 0 {
+		return nil, nil
+	}
+	failpoint.Inject("mockRegionRowCount", func(val failpoint.Value) {
+		res := make(map[int64]RegionKeys, len(physicalIDs))
+		for _, id := range physicalIDs {
+			res[id] = RegionKeys{Regions: 2, Keys: int64(val.(int))}
+		}
+		failpoint.Return(res, nil)
+	})
+	// Convert table IDs to strings since the SQL executor only accepts string arrays for IN clauses
+	stringIDs := make([]string, 0, len(physicalIDs))
+	for _, id := range physicalIDs {
+		stringIDs = append(stringIDs, strconv.FormatInt(id, 10))
+	}
+	rows, _, err := util.ExecRows(sctx, `select table_id, count(*), cast(ifnull(sum(approximate_keys), 0) as signed)
+		from information_schema.tikv_region_status where table_id in (%?) and is_index = 0 group by table_id`, stringIDs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	res := make(map[int64]RegionKeys, len(rows))
+	for _, row := range rows {
+		res[row.GetInt64(0)] = RegionKeys{Regions: row.GetInt64(1), Keys: row.GetInt64(2)}
+	}
+	return res, nil
+}
+
+// MVCCRowsOfTable returns the rows of the record regions of a physical table counted by the MVCC properties reported
+// by TiKV, i.e. `mvcc.num_rows` minus `mvcc.num_deletes`. Unlike the approximate keys, they count neither the old
+// versions nor the tombstones of the rows. They're still approximate, since the properties are collected when the
+// SST files are flushed or compacted, and the regions at the boundaries may hold the rows of the other tables.
+// It returns false if the store doesn't report the MVCC properties.
+func MVCCRowsOfTable(ctx context.Context, sctx sessionctx.Context, physicalID int64) (int64, bool, error) {
+	failpoint.Inject("mockMVCCRowCount", func(val failpoint.Value) {
+		failpoint.Return(int64(val.(int)), true, nil)
+	})
+	store, ok := sctx.GetStore().(tikv.Storage)
+	if !ok {
+		return 0, false, nil
+	}
+	startKey := tablecodec.GenTableRecordPrefix(physicalID)
+	bo := tikv.NewBackofferWithVars(ctx, 10000, nil)
+	locations, err := store.GetRegionCache().LocateKeyRange(bo, startKey, startKey.PrefixNext())
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	var rows int64
+	for _, loc := range locations {
+		req := tikvrpc.NewRequest(tikvrpc.CmdDebugGetRegionProperties, &debugpb.GetRegionPropertiesRequest{
+			RegionId: loc.Region.GetID(),
+		})
+		rpcCtx, err := store.GetRegionCache().GetTiKVRPCContext(bo, loc.Region, tikvstore.ReplicaReadLeader, 0)
+		if err != nil {
+			return 0, false, errors.Trace(err)
+		}
+		// The region is changed, try again in the next round.
+		if rpcCtx == nil {
+			return 0, false, nil
+		}
+		resp, err := store.GetTiKVClient().SendRequest(ctx, rpcCtx.Addr, req, time.Minute)
+		if err != nil {
+			return 0, false, errors.Trace(err)
+		}
+		props, ok := resp.Resp.(*debugpb.GetRegionPropertiesResponse)
+		if !ok {
+			return 0, false, nil
+		}
+		found := false
+		for _, prop := range props.GetProps() {
+			if prop.Name != "mvcc.num_rows" && prop.Name != "mvcc.num_deletes" {
+				continue
+			}
+			n, err := strconv.ParseInt(prop.Value, 10, 64)
+			if err != nil {
+				return 0, false, errors.Trace(err)
+			}
+			if prop.Name == "mvcc.num_rows" {
+				found = true
+				rows += n
+			} else {
+				rows -= n
+			}
+		}
+		if !found {
+			return 0, false, nil
+		}
+	}
+	return rows, true, nil
+}
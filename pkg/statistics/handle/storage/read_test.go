@@ -18,9 +18,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/planner/cardinality"
 	"github.com/pingcap/tidb/pkg/statistics"
+	statstestutil "github.com/pingcap/tidb/pkg/statistics/handle/ddl/testutil"
+	"github.com/pingcap/tidb/pkg/statistics/handle/storage"
+	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/stretchr/testify/require"
@@ -100,3 +104,66 @@ func TestLoadStats(t *testing.T) {
 	require.Greater(t, float64(cms.TotalCount()+topN.TotalCount())+hg.TotalRowCount(), float64(0))
 	require.True(t, idx.IsFullLoad())
 }
+
+func TestDerivePrefixGlobalIndexStats(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	testKit.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	testKit.MustExec("create table t(a varchar(20), b int) partition by hash(b) partitions 2")
+	h := dom.StatsHandle()
+	require.NoError(t, statstestutil.HandleNextDDLEventWithTxn(h))
+	// The column stats are loaded lazily only if the lease is positive.
+	oriLease := h.Lease()
+	h.SetLease(1)
+	defer func() {
+		h.SetLease(oriLease)
+	}()
+	testKit.MustExec("insert into t values ('aa1', 1), ('aa2', 2), ('aa3', 3), ('ab1', 4), ('ab2', 5), ('ac1', 6), ('ad1', 7), ('ae1', 8), ('af1', 9), (null, 10)")
+	testKit.MustExec("analyze table t all columns with 1 topn, 3 buckets")
+	// The index added after analyze has no stats, its stats are derived from the column a.
+	testKit.MustExec("alter table t add index ia(a(2)) global")
+	h.Clear()
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+
+	tableInfo := dom.MustGetTableInfo(t, "test", "t")
+	idxInfo := tableInfo.FindIndexByName("ia")
+	testKit.MustQuery("select count(*) from mysql.stats_histograms where table_id = ? and is_index = 1", tableInfo.ID).Check(testkit.Rows("0"))
+	// The index stats are not derived until the stats of the column a are loaded.
+	stat := h.GetTableStats(tableInfo)
+	require.Nil(t, stat.GetIdx(idxInfo.ID))
+	_, err := cardinality.ColumnEqualRowCount(testKit.Session().GetPlanCtx(), stat, types.NewStringDatum("aa1"), tableInfo.Columns[0].ID)
+	require.NoError(t, err)
+	require.NoError(t, h.LoadNeededHistograms(dom.InfoSchema()))
+	idx := h.GetTableStats(tableInfo).GetIdx(idxInfo.ID)
+	require.NotNil(t, idx)
+	require.True(t, idx.IsFullLoad())
+	require.Equal(t, int64(1), idx.NullCount)
+	require.Equal(t, float64(9), float64(idx.TopN.TotalCount())+idx.Histogram.NotNullCount())
+	// The values with the same prefix are merged.
+	require.Less(t, idx.NDV, int64(9))
+	rows := testKit.MustQuery("explain format = 'brief' select * from t use index(ia) where a > 'ac'").Rows()
+	require.Equal(t, "├─IndexRangeScan(Build)", rows[1][0])
+	require.Equal(t, "4.00", rows[1][1])
+}
+
+func TestMVCCRowsOfTable(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5)")
+	tableInfo := dom.MustGetTableInfo(t, "test", "t")
+	// The mock store can't report the properties of the regions without the start or end key.
+	prefix := tablecodec.GenTableRecordPrefix(tableInfo.ID)
+	_, err := store.(kv.SplittableStore).SplitRegions(context.Background(), [][]byte{prefix, prefix.PrefixNext()}, false, nil)
+	require.NoError(t, err)
+	tk.MustQuery("split table t by (3)").Check(testkit.Rows("1 1"))
+	// The old versions and the tombstones of the rows aren't counted.
+	tk.MustExec("update t set b = b + 1")
+	tk.MustExec("delete from t where a > 3")
+	rows, ok, err := storage.MVCCRowsOfTable(context.Background(), tk.Session(), tableInfo.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(3), rows)
+}
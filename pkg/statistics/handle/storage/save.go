@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/pingcap/errors"
@@ -28,10 +29,13 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/handle/cache"
+	"github.com/pingcap/tidb/pkg/statistics/handle/lockstats"
 	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
 	"github.com/pingcap/tidb/pkg/statistics/handle/util"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/sqlescape"
 	"github.com/pingcap/tidb/pkg/util/sqlexec"
 	"go.uber.org/zap"
@@ -74,6 +78,21 @@ func saveTopNToStorage(sctx sessionctx.Context, tableID int64, isIndex int, hist
 	return nil
 }
 
+// bucketBoundToBlob converts the bucket bound to the blob saved in mysql.stats_buckets. The bounds of BIT columns are
+// saved as their binary literals rather than the decimal strings of their values, so they are read back to the same
+// values by converting the blobs to BIT.
+func bucketBoundToBlob(typeCtx types.Context, d *types.Datum, tp *types.FieldType) (types.Datum, error) {
+	if tp != nil && tp.GetType() == mysql.TypeBit {
+		switch d.Kind() {
+		case types.KindMysqlBit, types.KindBinaryLiteral:
+			return types.NewBytesDatum(d.GetBytes()), nil
+		case types.KindUint64, types.KindInt64:
+			return types.NewBytesDatum(types.NewBinaryLiteralFromUint(d.GetUint64(), -1)), nil
+		}
+	}
+	return d.ConvertTo(typeCtx, types.NewFieldType(mysql.TypeBlob))
+}
+
 func saveBucketsToStorage(sctx sessionctx.Context, tableID int64, isIndex int, hg *statistics.Histogram) (err error) {
 	if hg == nil {
 		return
@@ -93,12 +112,12 @@ func saveBucketsToStorage(sctx sessionctx.Context, tableID int64, isIndex int, h
 				count -= hg.Buckets[j-1].Count
 			}
 			var upperBound types.Datum
-			upperBound, err = hg.GetUpper(j).ConvertTo(sc.TypeCtx(), types.NewFieldType(mysql.TypeBlob))
+			upperBound, err = bucketBoundToBlob(sc.TypeCtx(), hg.GetUpper(j), hg.Tp)
 			if err != nil {
 				return
 			}
 			var lowerBound types.Datum
-			lowerBound, err = hg.GetLower(j).ConvertTo(sc.TypeCtx(), types.NewFieldType(mysql.TypeBlob))
+			lowerBound, err = bucketBoundToBlob(sc.TypeCtx(), hg.GetLower(j), hg.Tp)
 			if err != nil {
 				return
 			}
@@ -123,8 +142,13 @@ func saveBucketsToStorage(sctx sessionctx.Context, tableID int64, isIndex int, h
 // SaveTableStatsToStorage saves the stats of a table to storage.
 func SaveTableStatsToStorage(sctx sessionctx.Context,
 	results *statistics.AnalyzeResults, analyzeSnapshot bool) (statsVer uint64, err error) {
-	needDumpFMS := results.TableID.IsPartitionTable()
+	// The FMSketches of the incrementally analyzed tables are merged with the ones of the appended rows next time.
+	needDumpFMS := results.TableID.IsPartitionTable() || results.LastAnalyzedHandle != nil
 	tableID := results.TableID.GetStatisticsID()
+	var lastAnalyzedHandle any
+	if results.LastAnalyzedHandle != nil {
+		lastAnalyzedHandle = *results.LastAnalyzedHandle
+	}
 	ctx := util.StatsCtx
 	txn, err := sctx.Txn(true)
 	if err != nil {
@@ -172,11 +196,12 @@ func SaveTableStatsToStorage(sctx sessionctx.Context,
 			count = 0
 		}
 		if _, err = util.Exec(sctx,
-			"replace into mysql.stats_meta (version, table_id, count, snapshot) values (%?, %?, %?, %?)",
+			"replace into mysql.stats_meta (version, table_id, count, snapshot, last_analyzed_handle) values (%?, %?, %?, %?, %?)",
 			version,
 			tableID,
 			count,
 			snapShot,
+			lastAnalyzedHandle,
 		); err != nil {
 			return 0, err
 		}
@@ -225,11 +250,12 @@ func SaveTableStatsToStorage(sctx sessionctx.Context,
 				zap.Int64("count", cnt))
 		}
 		if _, err = util.Exec(sctx,
-			"update mysql.stats_meta set version=%?, modify_count=%?, count=%?, snapshot=%? where table_id=%?",
+			"update mysql.stats_meta set version=%?, modify_count=%?, count=%?, snapshot=%?, last_analyzed_handle=%? where table_id=%?",
 			version,
 			modifyCnt,
 			cnt,
 			results.Snapshot,
+			lastAnalyzedHandle,
 			tableID,
 		); err != nil {
 			return 0, err
@@ -237,6 +263,11 @@ func SaveTableStatsToStorage(sctx sessionctx.Context,
 		statsVer = version
 	}
 	cache.TableRowStatsCache.Invalidate(tableID)
+	// The stats of the locked columns are kept, they are locked on the logical table.
+	lockedColumns, err := lockstats.QueryLockedColumns(sctx, results.TableID.TableID)
+	if err != nil {
+		return 0, err
+	}
 	// 2. Save histograms.
 	for _, result := range results.Ars {
 		for i, hg := range result.Hist {
@@ -244,6 +275,12 @@ func SaveTableStatsToStorage(sctx sessionctx.Context,
 			if hg == nil {
 				continue
 			}
+			if _, locked := lockedColumns[hg.ID]; locked && result.IsIndex == 0 {
+				statslogutil.StatsLogger().Info("skip saving the stats of the locked column",
+					zap.Int64("tableID", tableID),
+					zap.Int64("columnID", hg.ID))
+				continue
+			}
 			var cms *statistics.CMSketch
 			if results.StatsVer != statistics.Version2 {
 				cms = result.Cms[i]
@@ -289,7 +326,11 @@ func SaveTableStatsToStorage(sctx sessionctx.Context,
 			}
 		}
 	}
-	// 3. Save extended statistics.
+	// 3. Save the fanouts of the foreign keys.
+	if err = saveFKFanoutsToStorage(sctx, tableID, results.FKFanouts, version); err != nil {
+		return 0, err
+	}
+	// 4. Save extended statistics.
 	extStats := results.ExtStats
 	if extStats == nil || len(extStats.Stats) == 0 {
 		return
@@ -305,7 +346,7 @@ func SaveTableStatsToStorage(sctx sessionctx.Context,
 		switch item.Tp {
 		case ast.StatsTypeCardinality, ast.StatsTypeCorrelation:
 			statsStr = fmt.Sprintf("%f", item.ScalarVals)
-		case ast.StatsTypeDependency:
+		case ast.StatsTypeDependency, ast.StatsTypeTrigram, ast.StatsTypeExpression, ast.StatsTypeJoinSketch:
 			statsStr = item.StringVals
 		}
 		if _, err = util.Exec(sctx, "replace into mysql.stats_extended values (%?, %?, %?, %?, %?, %?, %?)", name, item.Tp, tableID, strColIDs, statsStr, version, statistics.ExtendedStatsAnalyzed); err != nil {
@@ -315,6 +356,18 @@ func SaveTableStatsToStorage(sctx sessionctx.Context,
 	return
 }
 
+// saveFKFanoutsToStorage saves the fanouts of the foreign keys of the table. The fanouts of the foreign keys which
+// aren't analyzed this time are kept, and the ones of the dropped foreign keys are ignored by the planner.
+func saveFKFanoutsToStorage(sctx sessionctx.Context, tableID int64, fanouts []*statistics.FKFanout, version uint64) error {
+	for _, f := range fanouts {
+		if _, err := util.Exec(sctx, "replace into mysql.stats_fk_fanout (table_id, fk_id, col_id, row_count, null_count, parent_keys, avg_fanout, p50_fanout, p90_fanout, p99_fanout, max_fanout, version) values (%?, %?, %?, %?, %?, %?, %?, %?, %?, %?, %?, %?)",
+			tableID, f.FKID, f.ColID, f.RowCount, f.NullCount, f.ParentKeys, f.Avg, f.P50, f.P90, f.P99, f.Max, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SaveStatsToStorage saves the stats to storage.
 // If count is negative, both count and modify count would not be used and not be written to the table. Unless, corresponding
 // fields in the stats_meta table will be updated.
@@ -393,6 +446,64 @@ func SaveMetaToStorage(
 	return
 }
 
+// SaveQuickStatsToStorage saves the quick stats of the physical table. They only fill in the stats of the table which
+// has never been analyzed: both the count and the modify count in mysql.stats_meta are set to the approximate row
+// count, so the stats are regarded as outdated and auto analyze runs the full analyze later, the total sizes of the
+// columns are filled in, and the histograms of the indexes are replaced by the ones built from the regions. The full
+// stats of the analyzed table are kept, only its count is refreshed and the change of the count is added to its modify
+// count.
+func SaveQuickStatsToStorage(sctx sessionctx.Context, physicalID int64, qs *statistics.QuickStats) (statsVer uint64, err error) {
+	version, err := util.GetStartTS(sctx)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	rows, _, err := util.ExecRows(sctx, "select snapshot from mysql.stats_meta where table_id = %?", physicalID)
+	if err != nil {
+		return 0, err
+	}
+	defer cache.TableRowStatsCache.Invalidate(physicalID)
+	if len(rows) > 0 && rows[0].GetUint64(0) != 0 {
+		// The modify count is updated before the count, so it's computed from the old count.
+		if _, err = util.Exec(sctx, `update mysql.stats_meta set version = %?, modify_count = modify_count + abs(%? - cast(count as signed)),
+			count = %? where table_id = %?`, version, qs.RowCount, qs.RowCount, physicalID); err != nil {
+			return 0, err
+		}
+		return version, nil
+	}
+	if _, err = util.Exec(sctx, `insert into mysql.stats_meta (version, table_id, count, modify_count) values (%?, %?, %?, %?)
+		on duplicate key update version = values(version), count = values(count), modify_count = values(modify_count)`,
+		version, physicalID, qs.RowCount, qs.RowCount); err != nil {
+		return 0, err
+	}
+	for colID, size := range qs.TotColSizes {
+		if _, err = util.Exec(sctx, `insert into mysql.stats_histograms (table_id, is_index, hist_id, distinct_count, version, tot_col_size)
+			values (%?, 0, %?, 0, %?, %?) on duplicate key update version = values(version), tot_col_size = values(tot_col_size)`,
+			physicalID, colID, version, size); err != nil {
+			return 0, err
+		}
+	}
+	for _, hg := range qs.IndexHists {
+		// The seeded stats of the new partitions may have the TopN and the FM sketch.
+		if _, err = util.Exec(sctx, "delete from mysql.stats_top_n where table_id = %? and is_index = 1 and hist_id = %?", physicalID, hg.ID); err != nil {
+			return 0, err
+		}
+		if _, err = util.Exec(sctx, "delete from mysql.stats_fm_sketch where table_id = %? and is_index = 1 and hist_id = %?", physicalID, hg.ID); err != nil {
+			return 0, err
+		}
+		if _, err = util.Exec(sctx, "replace into mysql.stats_histograms (table_id, is_index, hist_id, distinct_count, version, null_count, tot_col_size, stats_ver, correlation) values (%?, 1, %?, %?, %?, 0, 0, %?, 0)",
+			physicalID, hg.ID, hg.NDV, version, statistics.Version2); err != nil {
+			return 0, err
+		}
+		if _, err = util.Exec(sctx, "delete from mysql.stats_buckets where table_id = %? and is_index = 1 and hist_id = %?", physicalID, hg.ID); err != nil {
+			return 0, err
+		}
+		if err = saveBucketsToStorage(sctx, physicalID, 1, hg); err != nil {
+			return 0, err
+		}
+	}
+	return version, nil
+}
+
 // InsertColStats2KV insert a record to stats_histograms with distinct_count 1
 // and insert a bucket to stats_buckets with default value. This operation also
 // updates version.
@@ -401,6 +512,28 @@ func InsertColStats2KV(
 	sctx sessionctx.Context,
 	physicalID int64,
 	colInfos []*model.ColumnInfo,
+) (uint64, error) {
+	return insertColStats2KV(ctx, sctx, physicalID, colInfos, false)
+}
+
+// InsertAddedColStats2KV is the same as InsertColStats2KV, but it is used for the newly added columns.
+// If the table has been analyzed with stats version 2, the default value is saved as the only TopN of
+// the column and the column is marked as analyzed, since all the existing rows have the default value.
+func InsertAddedColStats2KV(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	physicalID int64,
+	colInfos []*model.ColumnInfo,
+) (uint64, error) {
+	return insertColStats2KV(ctx, sctx, physicalID, colInfos, true)
+}
+
+func insertColStats2KV(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	physicalID int64,
+	colInfos []*model.ColumnInfo,
+	seedTopN bool,
 ) (uint64, error) {
 	startTS, err := util.GetStartTS(sctx)
 	if err != nil {
@@ -438,6 +571,13 @@ func InsertColStats2KV(
 		return 0, errors.Trace(err)
 	}
 	count := req.GetRow(0).GetInt64(0)
+	if seedTopN {
+		statsVer, err := getColumnStatsVer(sctx, physicalID)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		seedTopN = statsVer == statistics.Version2
+	}
 	for _, colInfo := range colInfos {
 		value := types.NewDatum(colInfo.GetOriginDefaultValue())
 		value, err = value.ConvertTo(sctx.GetSessionVars().StmtCtx.TypeCtx(), &colInfo.FieldType)
@@ -458,6 +598,13 @@ func InsertColStats2KV(
 			continue
 		}
 
+		if seedTopN {
+			if err := insertDefaultValueTopN(ctx, sctx, startTS, physicalID, colInfo, value, count); err != nil {
+				return 0, errors.Trace(err)
+			}
+			continue
+		}
+
 		// If this stats doest not exist, we insert histogram meta first, the distinct_count will always be one.
 		if _, err = util.ExecWithCtx(
 			ctx, sctx,
@@ -486,6 +633,373 @@ func InsertColStats2KV(
 	return startTS, nil
 }
 
+// TransformColStats2KV copies the stats of the old column to the new column which replaces it by a lossless
+// type change, the TopN and the bucket bounds are converted to the new type. Only the stats collected with
+// stats version 2 can be transformed, and it returns false if the type change is lossy or there are no such
+// stats for the old column. The FM sketch is not copied since it is built from the encoded values of the old type.
+func TransformColStats2KV(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	physicalID int64,
+	oldCol, newCol *model.ColumnInfo,
+) (startTS uint64, ok bool, err error) {
+	if !isLosslessColumnTypeChange(oldCol, newCol) {
+		return 0, false, nil
+	}
+	rows, _, err := util.ExecRows(
+		sctx,
+		`select distinct_count, null_count, tot_col_size, correlation from mysql.stats_histograms
+		where table_id = %? and is_index = 0 and hist_id = %? and stats_ver = %?`,
+		physicalID, oldCol.ID, statistics.Version2,
+	)
+	if err != nil || len(rows) == 0 {
+		return 0, false, errors.Trace(err)
+	}
+	startTS, err = util.GetStartTS(sctx)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		"update mysql.stats_meta set version = %? where table_id = %?",
+		startTS, physicalID,
+	); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		`insert into mysql.stats_histograms
+			(version, table_id, is_index, hist_id, distinct_count, null_count, tot_col_size, stats_ver, correlation)
+		values (%?, %?, 0, %?, %?, %?, %?, %?, %?)`,
+		startTS, physicalID, newCol.ID, rows[0].GetInt64(0), rows[0].GetInt64(1), rows[0].GetInt64(2),
+		statistics.Version2, rows[0].GetFloat64(3),
+	); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+
+	// The string values are saved as they are or by the collation keys, they don't need to be converted
+	// since the collation is not changed.
+	needConvert := !types.IsString(oldCol.GetType())
+	typeCtx := sctx.GetSessionVars().StmtCtx.TypeCtx()
+	loc := sctx.GetSessionVars().StmtCtx.TimeZone()
+	rows, _, err = util.ExecRows(
+		sctx,
+		"select value, count from mysql.stats_top_n where table_id = %? and is_index = 0 and hist_id = %?",
+		physicalID, oldCol.ID,
+	)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	for _, row := range rows {
+		value := row.GetBytes(0)
+		if needConvert {
+			vals, _, err := codec.DecodeRange(value, 1, []byte{oldCol.GetType()}, loc)
+			if err != nil {
+				return 0, false, errors.Trace(err)
+			}
+			d, err := vals[0].ConvertTo(typeCtx, &newCol.FieldType)
+			if err != nil {
+				return 0, false, errors.Trace(err)
+			}
+			if value, err = codec.EncodeKey(loc, nil, d); err != nil {
+				return 0, false, errors.Trace(err)
+			}
+		}
+		if _, err = util.ExecWithCtx(
+			ctx, sctx,
+			"insert into mysql.stats_top_n (table_id, is_index, hist_id, value, count) values (%?, 0, %?, %?, %?)",
+			physicalID, newCol.ID, value, row.GetUint64(1),
+		); err != nil {
+			return 0, false, errors.Trace(err)
+		}
+	}
+
+	rows, _, err = util.ExecRows(
+		sctx,
+		`select bucket_id, count, repeats, lower_bound, upper_bound, ndv from mysql.stats_buckets
+		where table_id = %? and is_index = 0 and hist_id = %?`,
+		physicalID, oldCol.ID,
+	)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	for _, row := range rows {
+		lower, upper := row.GetBytes(3), row.GetBytes(4)
+		if needConvert {
+			if lower, err = convertBucketBound(typeCtx, lower, newCol); err != nil {
+				return 0, false, errors.Trace(err)
+			}
+			if upper, err = convertBucketBound(typeCtx, upper, newCol); err != nil {
+				return 0, false, errors.Trace(err)
+			}
+		}
+		if _, err = util.ExecWithCtx(
+			ctx, sctx,
+			`insert into mysql.stats_buckets
+				(table_id, is_index, hist_id, bucket_id, count, repeats, lower_bound, upper_bound, ndv)
+			values (%?, 0, %?, %?, %?, %?, %?, %?, %?)`,
+			physicalID, newCol.ID, row.GetInt64(0), row.GetInt64(1), row.GetInt64(2), lower, upper, row.GetInt64(5),
+		); err != nil {
+			return 0, false, errors.Trace(err)
+		}
+	}
+	return startTS, true, nil
+}
+
+// isLosslessColumnTypeChange returns whether all the values of the old column can be converted
+// to the new type without changing their order, so the stats of the old column can be reused.
+func isLosslessColumnTypeChange(oldCol, newCol *model.ColumnInfo) bool {
+	oldTp, newTp := oldCol.GetType(), newCol.GetType()
+	switch {
+	case mysql.IsIntegerType(oldTp) && mysql.IsIntegerType(newTp):
+		oldUnsigned, newUnsigned := mysql.HasUnsignedFlag(oldCol.GetFlag()), mysql.HasUnsignedFlag(newCol.GetFlag())
+		if oldUnsigned {
+			if newUnsigned {
+				return types.IntegerUnsignedUpperBound(newTp) >= types.IntegerUnsignedUpperBound(oldTp)
+			}
+			return uint64(types.IntegerSignedUpperBound(newTp)) >= types.IntegerUnsignedUpperBound(oldTp)
+		}
+		return !newUnsigned && types.IntegerSignedUpperBound(newTp) >= types.IntegerSignedUpperBound(oldTp)
+	case oldTp == mysql.TypeFloat && newTp == mysql.TypeDouble:
+		return oldCol.GetDecimal() == types.UnspecifiedLength && newCol.GetDecimal() == types.UnspecifiedLength
+	case oldTp == mysql.TypeNewDecimal && newTp == mysql.TypeNewDecimal:
+		if mysql.HasUnsignedFlag(newCol.GetFlag()) && !mysql.HasUnsignedFlag(oldCol.GetFlag()) {
+			return false
+		}
+		return newCol.GetDecimal() == oldCol.GetDecimal() &&
+			newCol.GetFlen()-newCol.GetDecimal() >= oldCol.GetFlen()-oldCol.GetDecimal()
+	case oldTp == mysql.TypeDate && newTp == mysql.TypeDatetime:
+		return true
+	case (oldTp == mysql.TypeDatetime || oldTp == mysql.TypeDuration) && newTp == oldTp:
+		return newCol.GetDecimal() >= oldCol.GetDecimal()
+	case (types.IsTypeChar(oldTp) || types.IsTypeVarchar(oldTp)) && types.IsTypeVarchar(newTp):
+		return oldCol.GetCharset() == newCol.GetCharset() &&
+			oldCol.GetCollate() == newCol.GetCollate() &&
+			newCol.GetFlen() >= oldCol.GetFlen()
+	}
+	return false
+}
+
+// convertBucketBound converts the bucket bound saved as blob to the new type of the column.
+func convertBucketBound(typeCtx types.Context, bound []byte, newCol *model.ColumnInfo) ([]byte, error) {
+	d := types.NewBytesDatum(bound)
+	d, err := d.ConvertTo(typeCtx, &newCol.FieldType)
+	if err != nil {
+		return nil, err
+	}
+	d, err = d.ConvertTo(typeCtx, types.NewFieldType(mysql.TypeBlob))
+	if err != nil {
+		return nil, err
+	}
+	return d.GetBytes(), nil
+}
+
+// getColumnStatsVer returns the max stats version of the columns of the table, it is 0 if the table is not analyzed.
+func getColumnStatsVer(sctx sessionctx.Context, physicalID int64) (int64, error) {
+	rows, _, err := util.ExecRows(
+		sctx,
+		"select max(stats_ver) from mysql.stats_histograms where table_id = %? and is_index = 0",
+		physicalID,
+	)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if len(rows) == 0 || rows[0].IsNull(0) {
+		return 0, nil
+	}
+	return rows[0].GetInt64(0), nil
+}
+
+// insertDefaultValueTopN saves the default value as the only TopN of the newly added column.
+// The histogram has no bucket, the same as the one built by ANALYZE with stats version 2.
+func insertDefaultValueTopN(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	startTS uint64,
+	physicalID int64,
+	colInfo *model.ColumnInfo,
+	value types.Datum,
+	count int64,
+) error {
+	totColSize := int64(len(value.GetBytes())) * count
+	// The TopN of the string column is encoded by the collation key, the same as ANALYZE.
+	if value.Kind() == types.KindString {
+		value.SetBytes(collate.GetCollator(colInfo.GetCollate()).Key(value.GetString()))
+	}
+	encoded, err := codec.EncodeKey(sctx.GetSessionVars().StmtCtx.TimeZone(), nil, value)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		`insert into mysql.stats_histograms
+			(version, table_id, is_index, hist_id, distinct_count, tot_col_size, stats_ver)
+		values (%?, %?, 0, %?, 1, GREATEST(%?, 0), %?)`,
+		startTS, physicalID, colInfo.ID, totColSize, statistics.Version2,
+	); err != nil {
+		return errors.Trace(err)
+	}
+	_, err = util.ExecWithCtx(
+		ctx, sctx,
+		"insert into mysql.stats_top_n (table_id, is_index, hist_id, value, count) values (%?, 0, %?, %?, %?)",
+		physicalID, colInfo.ID, encoded, count,
+	)
+	return errors.Trace(err)
+}
+
+// CarryForwardStats2KV keeps the stats of the truncated table or partition as the initial stats of the new one.
+// The row count, the null count, the total column size and the counts of the TopN and buckets are scaled by the
+// refill factor, which is the expected ratio of the row count after refilling to the row count before truncating.
+// It returns false if the old table or partition has not been analyzed.
+func CarryForwardStats2KV(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	oldID, newID int64,
+	refillFactor float64,
+) (startTS uint64, ok bool, err error) {
+	return copyStats2KV(ctx, sctx, oldID, newID, refillFactor, true)
+}
+
+// SeedStats2KV seeds the newly added partition with the data distribution of its sibling partition. Unlike the
+// carried forward stats, the seeds are persisted as not analyzed: the row count is 0 and so is the version of the
+// histograms, from which the last analyze version is derived. So the seeded partition only takes the shape of the
+// histograms and TopN, which are scaled by its real row count, and all the TiDB instances, including a new stats owner,
+// still take it as unanalyzed until it is analyzed. It returns false if the sibling partition has not been analyzed.
+func SeedStats2KV(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	siblingID, newID int64,
+) (startTS uint64, ok bool, err error) {
+	return copyStats2KV(ctx, sctx, siblingID, newID, 1, false)
+}
+
+// copyStats2KV copies the stats of the old table or partition to the new one. If analyzed is false, the stats are
+// saved with the row count and the version of the histograms being 0, so they are not taken as analyzed.
+func copyStats2KV(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	oldID, newID int64,
+	refillFactor float64,
+	analyzed bool,
+) (startTS uint64, ok bool, err error) {
+	rows, _, err := util.ExecRowsWithCtx(
+		ctx, sctx,
+		`select m.count from mysql.stats_meta m where m.table_id = %? and exists
+			(select 1 from mysql.stats_histograms h where h.table_id = m.table_id and h.stats_ver > 0)`,
+		oldID,
+	)
+	if err != nil || len(rows) == 0 {
+		return 0, false, errors.Trace(err)
+	}
+	count := int64(math.Round(float64(rows[0].GetInt64(0)) * refillFactor))
+	startTS, err = util.GetStartTS(sctx)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	metaSQL := "replace into mysql.stats_meta (version, table_id, modify_count, count) values (%?, %?, 0, %?)"
+	histVersion := startTS
+	if !analyzed {
+		// Keep the real row count, the rows may have been inserted and dumped.
+		metaSQL = `insert into mysql.stats_meta (version, table_id, modify_count, count) values (%?, %?, 0, %?)
+			on duplicate key update version = values(version)`
+		count, histVersion = 0, 0
+	}
+	if _, err = util.ExecWithCtx(ctx, sctx, metaSQL, startTS, newID, count); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		`replace into mysql.stats_histograms
+			(table_id, is_index, hist_id, distinct_count, null_count, tot_col_size, modify_count, version,
+			cm_sketch, stats_ver, flag, correlation, last_analyze_pos)
+		select %?, is_index, hist_id, distinct_count, round(null_count * %?), round(tot_col_size * %?), 0, %?,
+			cm_sketch, stats_ver, flag, correlation, last_analyze_pos
+		from mysql.stats_histograms where table_id = %?`,
+		newID, refillFactor, refillFactor, histVersion, oldID,
+	); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		`insert into mysql.stats_top_n (table_id, is_index, hist_id, value, count)
+		select %?, is_index, hist_id, value, round(count * %?) from mysql.stats_top_n where table_id = %?`,
+		newID, refillFactor, oldID,
+	); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		`replace into mysql.stats_buckets (table_id, is_index, hist_id, bucket_id, count, repeats, upper_bound, lower_bound, ndv)
+		select %?, is_index, hist_id, bucket_id, round(count * %?), round(repeats * %?), upper_bound, lower_bound, ndv
+		from mysql.stats_buckets where table_id = %?`,
+		newID, refillFactor, refillFactor, oldID,
+	); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		`insert into mysql.stats_fm_sketch (table_id, is_index, hist_id, value)
+		select %?, is_index, hist_id, value from mysql.stats_fm_sketch where table_id = %?`,
+		newID, oldID,
+	); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	return startTS, true, nil
+}
+
+// RestoreRecoveredTableStats2KV restores the stats of the recovered table or partition. The stats which
+// have not been removed by GC are kept, otherwise the latest historical stats before the snapshot, which
+// is the time the table was dropped, are saved as the stats. It returns false if there is nothing to restore.
+func RestoreRecoveredTableStats2KV(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	physicalID int64,
+	snapshot uint64,
+) (startTS uint64, ok bool, err error) {
+	rows, _, err := util.ExecRowsWithCtx(
+		ctx, sctx,
+		`select count(*) from mysql.stats_meta m where m.table_id = %? and exists
+			(select 1 from mysql.stats_histograms h where h.table_id = m.table_id)`,
+		physicalID,
+	)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	if rows[0].GetInt64(0) > 0 {
+		// Update the version so that the stats are reloaded, since they may have been removed from the cache.
+		startTS, err = UpdateStatsMetaVersionForGC(ctx, sctx, physicalID)
+		return startTS, err == nil, errors.Trace(err)
+	}
+	jsonTbl, exist, err := TableHistoricalStatsToJSON(sctx, physicalID, snapshot)
+	if err != nil || !exist {
+		return 0, false, errors.Trace(err)
+	}
+	tbl, err := TableStatsFromJSON(tblInfo, physicalID, jsonTbl)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	tbl.ForEachColumnImmutable(func(_ int64, col *statistics.Column) bool {
+		_, err = SaveStatsToStorage(sctx, physicalID, -1, 0, 0, &col.Histogram, col.CMSketch, col.TopN, int(col.GetStatsVer()), false)
+		return err != nil
+	})
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	tbl.ForEachIndexImmutable(func(_ int64, idx *statistics.Index) bool {
+		_, err = SaveStatsToStorage(sctx, physicalID, -1, 0, 1, &idx.Histogram, idx.CMSketch, idx.TopN, int(idx.GetStatsVer()), false)
+		return err != nil
+	})
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	startTS, err = SaveMetaToStorage(sctx, physicalID, tbl.RealtimeCount, tbl.ModifyCount)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	return startTS, true, nil
+}
+
 // InsertTableStats2KV inserts a record standing for a new table to stats_meta
 // and inserts some records standing for the new columns and indices which belong
 // to this table.
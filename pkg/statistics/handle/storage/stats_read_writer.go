@@ -32,6 +32,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/handle/changeevent"
 	handle_metrics "github.com/pingcap/tidb/pkg/statistics/handle/metrics"
 	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
 	"github.com/pingcap/tidb/pkg/statistics/handle/usage/predicatecolumn"
@@ -79,6 +80,26 @@ func (s *statsReadWriter) UpdateStatsMetaVersionForGC(physicalID int64) (err err
 	}, util.FlagWrapTxn)
 }
 
+// AdjustStatsForTTL removes the values before the expire time from the stats of the TTL time column
+// after the TTL job has deleted the expired rows of the table or partition.
+func (s *statsReadWriter) AdjustStatsForTTL(tblInfo *model.TableInfo, physicalID int64, expireTime time.Time) (err error) {
+	statsVer := uint64(0)
+	defer func() {
+		if err == nil && statsVer != 0 {
+			s.statsHandler.RecordHistoricalStatsMeta(statsVer, util.StatsMetaHistorySourceTTL, false, physicalID)
+		}
+	}()
+
+	return util.CallWithSCtx(s.statsHandler.SPool(), func(sctx sessionctx.Context) error {
+		startTS, ok, err := AdjustStatsForTTL(util.StatsCtx, sctx, tblInfo, physicalID, expireTime)
+		if err != nil || !ok {
+			return errors.Trace(err)
+		}
+		statsVer = startTS
+		return nil
+	}, util.FlagWrapTxn)
+}
+
 // UpdateStatsVersion will set statistics version to the newest TS,
 // then tidb-server will reload automatic.
 func (s *statsReadWriter) UpdateStatsVersion() error {
@@ -89,14 +110,9 @@ func (s *statsReadWriter) UpdateStatsVersion() error {
 
 // SaveTableStatsToStorage saves the stats of a table to storage.
 func (s *statsReadWriter) SaveTableStatsToStorage(results *statistics.AnalyzeResults, analyzeSnapshot bool, source string) (err error) {
-	var statsVer uint64
-	err = util.CallWithSCtx(s.statsHandler.SPool(), func(sctx sessionctx.Context) error {
-		statsVer, err = SaveTableStatsToStorage(sctx, results, analyzeSnapshot)
-		return err
-	}, util.FlagWrapTxn)
+	statsVer, err := s.statsHandler.StatsStorage().Save(results, analyzeSnapshot, source)
 	if err == nil && statsVer != 0 {
-		tableID := results.TableID.GetStatisticsID()
-		s.statsHandler.RecordHistoricalStatsMeta(statsVer, source, true, tableID)
+		s.statsHandler.RecordHistoricalStatsMeta(statsVer, source, true, results.TableID.GetStatisticsID())
 	}
 	return err
 }
@@ -112,16 +128,11 @@ func (s *statsReadWriter) StatsMetaCountAndModifyCount(tableID int64) (count, mo
 
 // TableStatsFromStorage loads table stats info from storage.
 func (s *statsReadWriter) TableStatsFromStorage(tableInfo *model.TableInfo, physicalID int64, loadAll bool, snapshot uint64) (statsTbl *statistics.Table, err error) {
-	err = util.CallWithSCtx(s.statsHandler.SPool(), func(sctx sessionctx.Context) error {
-		var ok bool
-		statsTbl, ok = s.statsHandler.Get(physicalID)
-		if !ok {
-			statsTbl = nil
-		}
-		statsTbl, err = TableStatsFromStorage(sctx, snapshot, tableInfo, physicalID, loadAll, s.statsHandler.Lease(), statsTbl)
-		return err
-	}, util.FlagWrapTxn)
-	return
+	statsTbl, ok := s.statsHandler.Get(physicalID)
+	if !ok {
+		statsTbl = nil
+	}
+	return s.statsHandler.StatsStorage().Load(tableInfo, physicalID, loadAll, snapshot, statsTbl)
 }
 
 // SaveStatsToStorage saves the stats to storage.
@@ -143,7 +154,10 @@ func (s *statsReadWriter) SaveStatsToStorage(
 	err = util.CallWithSCtx(s.statsHandler.SPool(), func(sctx sessionctx.Context) error {
 		statsVer, err = SaveStatsToStorage(sctx, tableID,
 			count, modifyCount, isIndex, hg, cms, topN, statsVersion, updateAnalyzeTime)
-		return err
+		if err != nil {
+			return err
+		}
+		return changeevent.Record(sctx, changeevent.EventUpdate, source, statsVer, tableID)
 	}, util.FlagWrapTxn)
 	if err == nil && statsVer != 0 {
 		s.statsHandler.RecordHistoricalStatsMeta(statsVer, source, false, tableID)
@@ -164,11 +178,27 @@ func (s *statsReadWriter) SaveMetaToStorage(tableID, count, modifyCount int64, s
 	return
 }
 
+// SaveQuickStatsToStorage saves the quick stats of a physical table to storage.
+func (s *statsReadWriter) SaveQuickStatsToStorage(physicalID int64, qs *statistics.QuickStats, source string) (err error) {
+	var statsVer uint64
+	err = util.CallWithSCtx(s.statsHandler.SPool(), func(sctx sessionctx.Context) error {
+		statsVer, err = SaveQuickStatsToStorage(sctx, physicalID, qs)
+		if err != nil {
+			return err
+		}
+		return changeevent.Record(sctx, changeevent.EventUpdate, source, statsVer, physicalID)
+	}, util.FlagWrapTxn)
+	if err == nil && statsVer != 0 {
+		s.statsHandler.RecordHistoricalStatsMeta(statsVer, source, false, physicalID)
+	}
+	return
+}
+
 // InsertExtendedStats inserts a record into mysql.stats_extended and update version in mysql.stats_meta.
-func (s *statsReadWriter) InsertExtendedStats(statsName string, colIDs []int64, tp int, tableID int64, ifNotExists bool) (err error) {
+func (s *statsReadWriter) InsertExtendedStats(statsName string, colIDs []int64, tp int, exprStr string, tableID int64, ifNotExists bool) (err error) {
 	var statsVer uint64
 	err = util.CallWithSCtx(s.statsHandler.SPool(), func(sctx sessionctx.Context) error {
-		statsVer, err = InsertExtendedStats(sctx, s.statsHandler, statsName, colIDs, tp, tableID, ifNotExists)
+		statsVer, err = InsertExtendedStats(sctx, s.statsHandler, statsName, colIDs, tp, exprStr, tableID, ifNotExists)
 		return err
 	}, util.FlagWrapTxn)
 	if err == nil && statsVer != 0 {
@@ -220,7 +250,7 @@ func (s *statsReadWriter) LoadTablePartitionStats(tableInfo *model.TableInfo, pa
 
 // LoadNeededHistograms will load histograms for those needed columns/indices.
 func (s *statsReadWriter) LoadNeededHistograms(is infoschema.InfoSchema) (err error) {
-	err = util.CallWithSCtx(s.statsHandler.SPool(), func(sctx sessionctx.Context) error {
+	err = util.CallWithSCtxAndLabels(util.StatsCtx, s.statsHandler.SPool(), util.StatsOperationLoad, 0, func(sctx sessionctx.Context) error {
 		loadFMSketch := config.GetGlobalConfig().Performance.EnableLoadFMSketch
 		return LoadNeededHistograms(sctx, is, s.statsHandler, loadFMSketch)
 	}, util.FlagWrapTxn)
@@ -417,7 +447,7 @@ func (s *statsReadWriter) getTableHistoricalStatsToJSONWithFallback(
 	bool,
 	error,
 ) {
-	jt, exist, err := s.tableHistoricalStatsToJSON(physicalID, snapshot)
+	jt, exist, err := s.statsHandler.StatsStorage().History(physicalID, snapshot)
 	if err != nil {
 		return nil, false, err
 	}
@@ -429,17 +459,13 @@ func (s *statsReadWriter) getTableHistoricalStatsToJSONWithFallback(
 		}
 		return jt, fallback, err
 	}
+	// The historical stats are saved with the names at that time, use the current names
+	// since the table may have been renamed, even to another database, after that.
+	jt.DatabaseName = dbName
+	jt.TableName = tableInfo.Name.L
 	return jt, false, nil
 }
 
-func (s *statsReadWriter) tableHistoricalStatsToJSON(physicalID int64, snapshot uint64) (jt *statsutil.JSONTable, exist bool, err error) {
-	err = util.CallWithSCtx(s.statsHandler.SPool(), func(sctx sessionctx.Context) error {
-		jt, exist, err = TableHistoricalStatsToJSON(sctx, physicalID, snapshot)
-		return err
-	}, util.FlagWrapTxn)
-	return
-}
-
 // TableStatsToJSON dumps statistic to json.
 func (s *statsReadWriter) TableStatsToJSON(dbName string, tableInfo *model.TableInfo, physicalID int64, snapshot uint64) (*statsutil.JSONTable, error) {
 	tbl, err := s.TableStatsFromStorage(tableInfo, physicalID, true, snapshot)
@@ -613,7 +639,7 @@ func (s *statsReadWriter) loadStatsFromJSON(tableInfo *model.TableInfo, physical
 	if err != nil {
 		return errors.Trace(err)
 	}
-	err = s.SaveColumnStatsUsageToStorage(tbl.PhysicalID, jsonTbl.PredicateColumns)
+	err = s.SaveColumnStatsUsageToStorage(tableInfo, tbl.PhysicalID, jsonTbl.PredicateColumns)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -621,14 +647,27 @@ func (s *statsReadWriter) loadStatsFromJSON(tableInfo *model.TableInfo, physical
 }
 
 // SaveColumnStatsUsageToStorage saves column statistics usage information for a table into mysql.column_stats_usage.
-func (s *statsReadWriter) SaveColumnStatsUsageToStorage(physicalID int64, predicateColumns []*statsutil.JSONPredicateColumn) error {
+// The columns are matched by name if the name is dumped, the columns which don't exist in the table are skipped.
+func (s *statsReadWriter) SaveColumnStatsUsageToStorage(
+	tableInfo *model.TableInfo,
+	physicalID int64,
+	predicateColumns []*statsutil.JSONPredicateColumn,
+) error {
 	return util.CallWithSCtx(s.statsHandler.SPool(), func(sctx sessionctx.Context) error {
 		colStatsUsage := make(map[model.TableItemID]statstypes.ColStatsTimeInfo, len(predicateColumns))
 		for _, col := range predicateColumns {
 			if col == nil {
 				continue
 			}
-			itemID := model.TableItemID{TableID: physicalID, ID: col.ID}
+			colID := col.ID
+			if col.Name != "" {
+				colInfo := model.FindColumnInfo(tableInfo.Columns, col.Name)
+				if colInfo == nil {
+					continue
+				}
+				colID = colInfo.ID
+			}
+			itemID := model.TableItemID{TableID: physicalID, ID: colID}
 			lastUsedAt, err := parseTimeOrNil(col.LastUsedAt)
 			if err != nil {
 				return err
@@ -640,12 +679,83 @@ func (s *statsReadWriter) SaveColumnStatsUsageToStorage(physicalID int64, predic
 			colStatsUsage[itemID] = statstypes.ColStatsTimeInfo{
 				LastUsedAt:     lastUsedAt,
 				LastAnalyzedAt: lastAnalyzedAt,
+				UsageScore:     col.UsageScore,
 			}
 		}
 		return predicatecolumn.SaveColumnStatsUsageForTable(sctx, colStatsUsage)
 	}, util.FlagWrapTxn)
 }
 
+// DumpColumnStatsUsageToJSON dumps the predicate columns of the table and its partitions to json.
+// It is used to apply the predicate columns of a cluster to another one without the statistics.
+func (s *statsReadWriter) DumpColumnStatsUsageToJSON(dbName string, tableInfo *model.TableInfo) (*statsutil.JSONTable, error) {
+	jsonTbl := &statsutil.JSONTable{
+		DatabaseName:         dbName,
+		TableName:            tableInfo.Name.L,
+		PredicateColumnsOnly: true,
+	}
+	err := util.CallWithSCtx(s.statsHandler.SPool(), func(sctx sessionctx.Context) error {
+		// Note: Because we don't show this information in the session directly, so we can always use UTC here.
+		colStatsUsage, err := predicatecolumn.LoadColumnStatsUsageForTable(sctx, time.UTC, tableInfo.ID)
+		if err != nil {
+			return err
+		}
+		jsonTbl.PredicateColumns = dumpJSONPredicateColumns(tableInfo, colStatsUsage)
+		pi := tableInfo.GetPartitionInfo()
+		if pi == nil {
+			return nil
+		}
+		jsonTbl.Partitions = make(map[string]*statsutil.JSONTable, len(pi.Definitions))
+		for _, def := range pi.Definitions {
+			colStatsUsage, err := predicatecolumn.LoadColumnStatsUsageForTable(sctx, time.UTC, def.ID)
+			if err != nil {
+				return err
+			}
+			if len(colStatsUsage) == 0 {
+				continue
+			}
+			jsonTbl.Partitions[def.Name.L] = &statsutil.JSONTable{
+				DatabaseName:         dbName,
+				TableName:            tableInfo.Name.L,
+				PredicateColumns:     dumpJSONPredicateColumns(tableInfo, colStatsUsage),
+				PredicateColumnsOnly: true,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return jsonTbl, nil
+}
+
+// LoadColumnStatsUsageFromJSON loads the predicate columns dumped by DumpColumnStatsUsageToJSON,
+// and saves them to mysql.column_stats_usage. The statistics of the table are not changed.
+func (s *statsReadWriter) LoadColumnStatsUsageFromJSON(is infoschema.InfoSchema, jsonTbl *statsutil.JSONTable) error {
+	table, err := is.TableByName(context.Background(), ast.NewCIStr(jsonTbl.DatabaseName), ast.NewCIStr(jsonTbl.TableName))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tableInfo := table.Meta()
+	if err := s.SaveColumnStatsUsageToStorage(tableInfo, tableInfo.ID, jsonTbl.PredicateColumns); err != nil {
+		return errors.Trace(err)
+	}
+	pi := tableInfo.GetPartitionInfo()
+	if pi == nil {
+		return nil
+	}
+	for _, def := range pi.Definitions {
+		tbl := jsonTbl.Partitions[def.Name.L]
+		if tbl == nil {
+			continue
+		}
+		if err := s.SaveColumnStatsUsageToStorage(tableInfo, def.ID, tbl.PredicateColumns); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
 func parseTimeOrNil(timeStr *string) (*types.Time, error) {
 	if timeStr == nil {
 		return nil, nil
@@ -16,7 +16,10 @@ package storage_test
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/testkit"
@@ -81,3 +84,103 @@ func TestUpdateStatsMetaVersionForGC(t *testing.T) {
 	).Rows()
 	require.Equal(t, 1, len(rows))
 }
+
+func TestAdjustStatsForTTL(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	h := do.StatsHandle()
+	testKit.MustExec("use test")
+	testKit.MustExec("set @@tidb_analyze_version = 2")
+	testKit.MustExec("create table t (a int primary key, b datetime, index idx(b)) TTL = b + interval 1 day TTL_ENABLE = 'OFF'")
+	for i := 1; i <= 20; i++ {
+		testKit.MustExec(fmt.Sprintf("insert into t values (%d, '2024-01-%02d 00:00:00')", i, i))
+	}
+	testKit.MustExec("analyze table t with 4 buckets, 0 topn")
+	tbl, err := do.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tableInfo := tbl.Meta()
+	colID, idxID := tableInfo.Columns[1].ID, tableInfo.Indices[0].ID
+	testKit.MustQuery("select count(*), sum(count) from mysql.stats_buckets where table_id = ? and is_index = 0 and hist_id = ?",
+		tableInfo.ID, colID).Check(testkit.Rows("4 20"))
+
+	// The rows before 2024-01-09 are deleted by the TTL job.
+	testKit.MustExec("delete from t where b < '2024-01-09'")
+	expireTime := time.Date(2024, 1, 9, 0, 0, 0, 0, testKit.Session().GetSessionVars().Location())
+	require.NoError(t, h.AdjustStatsForTTL(tableInfo, tableInfo.ID, expireTime))
+
+	// The buckets are [01-01, 01-06], [01-07, 01-12], [01-13, 01-18] and [01-19, 01-20]. The first bucket is
+	// removed, and the second bucket is shifted to [01-09, 01-12] with 60% of its count and NDV.
+	testKit.MustQuery("select count(*), sum(count), min(lower_bound) from mysql.stats_buckets where table_id = ? and is_index = 0 and hist_id = ?",
+		tableInfo.ID, colID).Check(testkit.Rows("3 12 2024-01-09 00:00:00"))
+	testKit.MustQuery("select count(*), sum(count) from mysql.stats_buckets where table_id = ? and is_index = 1 and hist_id = ?",
+		tableInfo.ID, idxID).Check(testkit.Rows("3 12"))
+	testKit.MustQuery("select distinct_count from mysql.stats_histograms where table_id = ? and is_index = 0 and hist_id = ?",
+		tableInfo.ID, colID).Check(testkit.Rows("12"))
+	// The version is updated to reload the stats.
+	testKit.MustQuery("select count(*) from mysql.stats_meta m join mysql.stats_histograms h on m.table_id = h.table_id and m.version = h.version where m.table_id = ? and h.hist_id = ?",
+		tableInfo.ID, colID).Check(testkit.Rows("1"))
+
+	// The table without stats is skipped.
+	testKit.MustExec("create table t2 (a int primary key, b datetime) TTL = b + interval 1 day TTL_ENABLE = 'OFF'")
+	tbl, err = do.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t2"))
+	require.NoError(t, err)
+	require.NoError(t, h.AdjustStatsForTTL(tbl.Meta(), tbl.Meta().ID, expireTime))
+	testKit.MustQuery("select count(*) from mysql.stats_meta where table_id = ?", tbl.Meta().ID).Check(testkit.Rows("0"))
+}
+
+func TestStatsChangeEvents(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index idx(b))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tableID := tbl.Meta().ID
+	selectEventsSQL := "select table_id, event, source from mysql.stats_change_events order by id"
+
+	// Nothing is recorded by default.
+	tk.MustExec("analyze table t")
+	tk.MustQuery(selectEventsSQL).Check(testkit.Rows())
+
+	tk.MustExec("set global tidb_enable_stats_change_events = on")
+	defer tk.MustExec("set global tidb_enable_stats_change_events = default")
+	tk.MustExec("analyze table t")
+	tk.MustExec("lock stats t")
+	tk.MustExec("unlock stats t")
+	tk.MustExec("lock stats t columns (a, b)")
+	tk.MustExec("unlock stats t columns (a, b)")
+	tk.MustExec("drop stats t")
+	tk.MustQuery(selectEventsSQL).Check(testkit.Rows(
+		fmt.Sprintf("%d update analyze", tableID),
+		fmt.Sprintf("%d lock table", tableID),
+		fmt.Sprintf("%d unlock table", tableID),
+		fmt.Sprintf("%d lock column", tableID),
+		fmt.Sprintf("%d unlock column", tableID),
+		fmt.Sprintf("%d drop drop stats", tableID),
+	))
+	// The events are ordered by the version, so the consumers can tail them by the version.
+	rows := tk.MustQuery("select version from mysql.stats_change_events order by id").Rows()
+	var lastVersion uint64
+	for _, row := range rows {
+		version, err := strconv.ParseUint(row[0].(string), 10, 64)
+		require.NoError(t, err)
+		require.Less(t, lastVersion, version)
+		lastVersion = version
+	}
+
+	// The update event is committed with the stats, so it carries the version of them.
+	tk.MustExec("analyze table t")
+	tk.MustQuery("select e.version = m.version from mysql.stats_change_events e join mysql.stats_meta m on e.table_id = m.table_id where e.id = (select max(id) from mysql.stats_change_events)").Check(testkit.Rows("1"))
+
+	// The outdated events are removed by the GC according to tidb_stats_change_events_retention.
+	tk.MustExec("delete from mysql.stats_change_events")
+	tk.MustExec("analyze table t")
+	tk.MustExec("update mysql.stats_change_events set create_time = NOW() - INTERVAL 2 HOUR")
+	require.NoError(t, dom.StatsHandle().GCStats(dom.InfoSchema(), time.Duration(0)))
+	tk.MustQuery(selectEventsSQL).Check(testkit.Rows(fmt.Sprintf("%d update analyze", tableID)))
+	tk.MustExec("set global tidb_stats_change_events_retention = '1h'")
+	defer tk.MustExec("set global tidb_stats_change_events_retention = default")
+	require.NoError(t, dom.StatsHandle().GCStats(dom.InfoSchema(), time.Duration(0)))
+	tk.MustQuery(selectEventsSQL).Check(testkit.Rows())
+}
@@ -0,0 +1,290 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+)
+
+// The TTL job deletes the rows whose TTL time column is before the expire time. The count in stats_meta is
+// maintained by the stats collector attached to the TTL sessions, but the TopN and the histogram of the time
+// column still hold the deleted values until the next analyze, so the estimation on the time column keeps
+// counting the expired rows. The stats of the time column are adjusted by the functions in this file when the
+// TTL job finishes.
+
+// ttlHistAdjuster adjusts the stats of a histogram on the TTL time column, it's either the column stats or
+// the stats of an index which only consists of the time column.
+type ttlHistAdjuster struct {
+	typeCtx types.Context
+	loc     *time.Location
+	col     *model.ColumnInfo
+	isIndex int
+	histID  int64
+}
+
+// decodeKey decodes the time from the encoded key of the TopN value or the index bucket bound.
+func (a *ttlHistAdjuster) decodeKey(key []byte) (types.Time, error) {
+	_, d, err := codec.DecodeOne(key)
+	if err != nil {
+		return types.ZeroTime, errors.Trace(err)
+	}
+	var t types.Time
+	if err = t.FromPackedUint(d.GetUint64()); err != nil {
+		return types.ZeroTime, errors.Trace(err)
+	}
+	return t, nil
+}
+
+// decodeBound decodes the time from the bucket bound.
+func (a *ttlHistAdjuster) decodeBound(bound []byte) (types.Time, error) {
+	if a.isIndex == 1 {
+		return a.decodeKey(bound)
+	}
+	d := types.NewBytesDatum(bound)
+	d, err := d.ConvertTo(a.typeCtx, &a.col.FieldType)
+	if err != nil {
+		return types.ZeroTime, errors.Trace(err)
+	}
+	return d.GetMysqlTime(), nil
+}
+
+// encodeBound encodes the expire time as the bucket bound.
+func (a *ttlHistAdjuster) encodeBound(expire types.Datum) ([]byte, error) {
+	if a.isIndex == 1 {
+		key, err := codec.EncodeKey(a.loc, nil, expire)
+		return key, errors.Trace(err)
+	}
+	d, err := expire.ConvertTo(a.typeCtx, types.NewFieldType(mysql.TypeBlob))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return d.GetBytes(), nil
+}
+
+// adjust removes the values before the expire time from the TopN and the buckets of the histogram. The
+// bucket which crosses the expire time takes the expire time as its lower bound, and its count and NDV are
+// scaled down in proportion to the remaining time range.
+func (a *ttlHistAdjuster) adjust(ctx context.Context, sctx sessionctx.Context, physicalID int64, expire types.Datum, version uint64) error {
+	// The time in the encoded keys is always compared in UTC, while the time in the column bucket bounds
+	// is in the time zone of the session, get the expire time for both of them.
+	expireKey, err := codec.EncodeKey(a.loc, nil, expire)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	expireKeyTime, err := a.decodeKey(expireKey)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	expireTime := expire.GetMysqlTime()
+	if a.isIndex == 1 {
+		expireTime = expireKeyTime
+	}
+
+	rows, _, err := util.ExecRowsWithCtx(
+		ctx, sctx,
+		"select distinct_count from mysql.stats_histograms where table_id = %? and is_index = %? and hist_id = %?",
+		physicalID, a.isIndex, a.histID,
+	)
+	if err != nil || len(rows) == 0 {
+		return errors.Trace(err)
+	}
+	ndv := rows[0].GetInt64(0)
+
+	var removedNDV int64
+	rows, _, err = util.ExecRowsWithCtx(
+		ctx, sctx,
+		"select value from mysql.stats_top_n where table_id = %? and is_index = %? and hist_id = %?",
+		physicalID, a.isIndex, a.histID,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	topNNum := int64(len(rows))
+	for _, row := range rows {
+		value := row.GetBytes(0)
+		t, err := a.decodeKey(value)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if t.Compare(expireKeyTime) >= 0 {
+			continue
+		}
+		if _, err = util.ExecWithCtx(
+			ctx, sctx,
+			"delete from mysql.stats_top_n where table_id = %? and is_index = %? and hist_id = %? and value = %?",
+			physicalID, a.isIndex, a.histID, value,
+		); err != nil {
+			return errors.Trace(err)
+		}
+		removedNDV++
+	}
+
+	rows, _, err = util.ExecRowsWithCtx(
+		ctx, sctx,
+		`select bucket_id, count, repeats, lower_bound, upper_bound, ndv from mysql.stats_buckets
+		where table_id = %? and is_index = %? and hist_id = %? order by bucket_id`,
+		physicalID, a.isIndex, a.histID,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// The NDV of the buckets is not saved for all the stats versions, the removed NDV of such buckets is
+	// estimated by the removed rows instead.
+	var histRows, removedRowsWithoutNDV int64
+	for _, row := range rows {
+		histRows += row.GetInt64(1)
+	}
+	for _, row := range rows {
+		bucketID, count, repeats, bucketNDV := row.GetInt64(0), row.GetInt64(1), row.GetInt64(2), row.GetInt64(5)
+		lower, err := a.decodeBound(row.GetBytes(3))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		upper, err := a.decodeBound(row.GetBytes(4))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if upper.Compare(expireTime) < 0 {
+			if _, err = util.ExecWithCtx(
+				ctx, sctx,
+				"delete from mysql.stats_buckets where table_id = %? and is_index = %? and hist_id = %? and bucket_id = %?",
+				physicalID, a.isIndex, a.histID, bucketID,
+			); err != nil {
+				return errors.Trace(err)
+			}
+			if bucketNDV > 0 {
+				removedNDV += bucketNDV
+			} else {
+				removedRowsWithoutNDV += count
+			}
+			continue
+		}
+		// The buckets are ordered, so the rest of them are all after the expire time.
+		if lower.Compare(expireTime) >= 0 {
+			break
+		}
+		ratio := remainingRatio(lower, upper, expireTime)
+		newCount := max(int64(math.Round(float64(count)*ratio)), repeats)
+		newNDV := bucketNDV
+		if bucketNDV > 0 {
+			newNDV = max(int64(math.Round(float64(bucketNDV)*ratio)), 1)
+			removedNDV += bucketNDV - newNDV
+		} else {
+			removedRowsWithoutNDV += count - newCount
+		}
+		lowerBound, err := a.encodeBound(expire)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if _, err = util.ExecWithCtx(
+			ctx, sctx,
+			`update mysql.stats_buckets set lower_bound = %?, count = %?, ndv = %?
+			where table_id = %? and is_index = %? and hist_id = %? and bucket_id = %?`,
+			lowerBound, newCount, newNDV, physicalID, a.isIndex, a.histID, bucketID,
+		); err != nil {
+			return errors.Trace(err)
+		}
+		break
+	}
+	if removedRowsWithoutNDV > 0 && histRows > 0 && ndv > topNNum {
+		removedNDV += int64(math.Round(float64(ndv-topNNum) * float64(removedRowsWithoutNDV) / float64(histRows)))
+	}
+
+	_, err = util.ExecWithCtx(
+		ctx, sctx,
+		"update mysql.stats_histograms set distinct_count = %?, version = %? where table_id = %? and is_index = %? and hist_id = %?",
+		max(ndv-removedNDV, 0), version, physicalID, a.isIndex, a.histID,
+	)
+	return errors.Trace(err)
+}
+
+// remainingRatio returns the ratio of the time range [expire, upper] to the time range [lower, upper].
+func remainingRatio(lower, upper, expire types.Time) float64 {
+	lowerTime, err1 := lower.CoreTime().GoTime(time.UTC)
+	upperTime, err2 := upper.CoreTime().GoTime(time.UTC)
+	expireTime, err3 := expire.CoreTime().GoTime(time.UTC)
+	if err1 != nil || err2 != nil || err3 != nil || !upperTime.After(lowerTime) {
+		return 1
+	}
+	return float64(upperTime.Sub(expireTime)) / float64(upperTime.Sub(lowerTime))
+}
+
+// AdjustStatsForTTL removes the values before the expire time from the stats of the TTL time column and the
+// indexes which only consist of it, after the TTL job has deleted the expired rows of the table or partition.
+// It returns false if the TTL time column has no stats.
+func AdjustStatsForTTL(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	tblInfo *model.TableInfo,
+	physicalID int64,
+	expireTime time.Time,
+) (startTS uint64, ok bool, err error) {
+	if tblInfo.TTLInfo == nil {
+		return 0, false, nil
+	}
+	timeCol := model.FindColumnInfo(tblInfo.Columns, tblInfo.TTLInfo.ColumnName.L)
+	if timeCol == nil {
+		return 0, false, nil
+	}
+	rows, _, err := util.ExecRowsWithCtx(
+		ctx, sctx,
+		"select count(*) from mysql.stats_histograms where table_id = %? and is_index = 0 and hist_id = %?",
+		physicalID, timeCol.ID,
+	)
+	if err != nil || rows[0].GetInt64(0) == 0 {
+		return 0, false, errors.Trace(err)
+	}
+	startTS, err = util.GetStartTS(sctx)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+
+	sc := sctx.GetSessionVars().StmtCtx
+	expire := types.NewTimeDatum(types.NewTime(
+		types.FromGoTime(expireTime.In(sc.TimeZone())), timeCol.GetType(), timeCol.GetDecimal(),
+	))
+	adjusters := []*ttlHistAdjuster{{typeCtx: sc.TypeCtx(), loc: sc.TimeZone(), col: timeCol, histID: timeCol.ID}}
+	for _, idx := range tblInfo.Indices {
+		if idx.State != model.StatePublic || len(idx.Columns) != 1 || idx.Columns[0].Offset != timeCol.Offset {
+			continue
+		}
+		adjusters = append(adjusters, &ttlHistAdjuster{
+			typeCtx: sc.TypeCtx(), loc: sc.TimeZone(), col: timeCol, isIndex: 1, histID: idx.ID,
+		})
+	}
+	for _, a := range adjusters {
+		if err = a.adjust(ctx, sctx, physicalID, expire, startTS); err != nil {
+			return 0, false, errors.Trace(err)
+		}
+	}
+	if _, err = util.ExecWithCtx(
+		ctx, sctx,
+		"update mysql.stats_meta set version = %? where table_id = %?",
+		startTS, physicalID,
+	); err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	return startTS, true, nil
+}
@@ -168,9 +168,10 @@ func UpdateStatsMeta(
 }
 
 // InsertExtendedStats inserts a record into mysql.stats_extended and update version in mysql.stats_meta.
+// The exprStr is the expression for the expression type, and it's empty for the other types.
 func InsertExtendedStats(sctx sessionctx.Context,
 	statsCache types.StatsCache,
-	statsName string, colIDs []int64, tp int, tableID int64, ifNotExists bool) (statsVer uint64, err error) {
+	statsName string, colIDs []int64, tp int, exprStr string, tableID int64, ifNotExists bool) (statsVer uint64, err error) {
 	slices.Sort(colIDs)
 	bytes, err := json.Marshal(colIDs)
 	if err != nil {
@@ -179,7 +180,7 @@ func InsertExtendedStats(sctx sessionctx.Context,
 	strColIDs := string(bytes)
 
 	// No need to use `exec.ExecuteInternal` since we have acquired the lock.
-	rows, _, err := statsutil.ExecRows(sctx, "SELECT name, type, column_ids FROM mysql.stats_extended WHERE table_id = %? and status in (%?, %?)", tableID, statistics.ExtendedStatsInited, statistics.ExtendedStatsAnalyzed)
+	rows, _, err := statsutil.ExecRows(sctx, "SELECT name, type, column_ids, stats FROM mysql.stats_extended WHERE table_id = %? and status in (%?, %?)", tableID, statistics.ExtendedStatsInited, statistics.ExtendedStatsAnalyzed)
 	if err != nil {
 		return 0, errors.Trace(err)
 	}
@@ -193,9 +194,16 @@ func InsertExtendedStats(sctx sessionctx.Context,
 			}
 			return 0, errors.Errorf("extended statistics '%s' for the specified table already exists", statsName)
 		}
-		if tp == int(currTp) && currStrColIDs == strColIDs {
+		if tp != int(currTp) || currStrColIDs != strColIDs {
+			continue
+		}
+		if tp != int(ast.StatsTypeExpression) {
 			return 0, errors.Errorf("extended statistics '%s' with same type on same columns already exists", statsName)
 		}
+		// The expression stats on the same columns are different if their expressions are different.
+		if currExprStats, err := statistics.DecodeExpressionStats(row.GetString(3)); err == nil && currExprStats.Expr == exprStr {
+			return 0, errors.Errorf("extended statistics '%s' on the same expression already exists", currStatsName)
+		}
 	}
 	version, err := statsutil.GetStartTS(sctx)
 	if err != nil {
@@ -216,8 +224,15 @@ func InsertExtendedStats(sctx sessionctx.Context,
 	// the record from the table, tidb-b should delete the cached item synchronously. While for tidb-c, it has to wait for
 	// next `Update()` to remove the cached item then.
 	removeExtendedStatsItem(statsCache, tableID, statsName)
-	const sql = "INSERT INTO mysql.stats_extended(name, type, table_id, column_ids, version, status) VALUES (%?, %?, %?, %?, %?, %?)"
-	if _, err = statsutil.Exec(sctx, sql, statsName, tp, tableID, strColIDs, version, statistics.ExtendedStatsInited); err != nil {
+	var stats any
+	if tp == int(ast.StatsTypeExpression) {
+		// Persist the expression before the stats are built, so that it can be found by analyze.
+		if stats, err = statistics.EncodeExpressionStats(&statistics.ExpressionStats{Expr: exprStr}); err != nil {
+			return 0, err
+		}
+	}
+	const sql = "INSERT INTO mysql.stats_extended(name, type, table_id, column_ids, stats, version, status) VALUES (%?, %?, %?, %?, %?, %?, %?)"
+	if _, err = statsutil.Exec(sctx, sql, statsName, tp, tableID, strColIDs, stats, version, statistics.ExtendedStatsInited); err != nil {
 		return 0, err
 	}
 	return
@@ -244,7 +259,7 @@ func SaveExtendedStatsToStorage(sctx sessionctx.Context,
 		switch item.Tp {
 		case ast.StatsTypeCardinality, ast.StatsTypeCorrelation:
 			statsStr = fmt.Sprintf("%f", item.ScalarVals)
-		case ast.StatsTypeDependency:
+		case ast.StatsTypeDependency, ast.StatsTypeTrigram, ast.StatsTypeExpression, ast.StatsTypeJoinSketch:
 			statsStr = item.StringVals
 		}
 		// If isLoad is true, it's INSERT; otherwise, it's UPDATE.
@@ -319,3 +334,19 @@ func UpdateStatsMetaVersionForGC(
 	}
 	return startTS, nil
 }
+
+// RenameAnalyzeJobsTable changes the schema and table name of the analyze jobs of the renamed table,
+// so that the analyze history of the table is kept after it is renamed, even across databases.
+func RenameAnalyzeJobsTable(
+	ctx context.Context,
+	sctx sessionctx.Context,
+	oldSchemaName, oldTableName string,
+	newSchemaName, newTableName string,
+) error {
+	_, err := statsutil.ExecWithCtx(
+		ctx, sctx,
+		"update mysql.analyze_jobs set table_schema = %?, table_name = %? where table_schema = %? and table_name = %?",
+		newSchemaName, newTableName, oldSchemaName, oldTableName,
+	)
+	return errors.Trace(err)
+}
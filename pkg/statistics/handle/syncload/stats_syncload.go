@@ -18,6 +18,7 @@ import (
 	stderrors "errors"
 	"math/rand"
 	"runtime"
+	"sync/atomic"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -35,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/handle/storage"
 	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+	statsutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/intest"
@@ -67,6 +69,14 @@ type statsSyncLoad struct {
 
 var globalStatsSyncLoadSingleFlight singleflight.Group
 
+// syncLoads and syncLoadTimeouts count the sync loads and the timed out ones, from which the timeout ratio is derived.
+var syncLoads, syncLoadTimeouts atomic.Int64
+
+// SyncLoadCounts returns the number of the sync loads and the timed out ones since the instance started.
+func SyncLoadCounts() (total, timeouts int64) {
+	return syncLoads.Load(), syncLoadTimeouts.Load()
+}
+
 // NewStatsSyncLoad creates a new StatsSyncLoad.
 func NewStatsSyncLoad(is infoschema.InfoSchema, statsHandle statstypes.StatsHandle) statstypes.StatsSyncLoad {
 	s := &statsSyncLoad{statsHandle: statsHandle, is: is}
@@ -154,6 +164,7 @@ func (*statsSyncLoad) SyncWaitStatsLoad(sc *stmtctx.StatementContext) error {
 		select {
 		case result, ok := <-resultCh:
 			metrics.SyncLoadCounter.Inc()
+			syncLoads.Add(1)
 			if !ok {
 				return errors.New("sync load stats channel closed unexpectedly")
 			}
@@ -172,6 +183,8 @@ func (*statsSyncLoad) SyncWaitStatsLoad(sc *stmtctx.StatementContext) error {
 		case <-timer.C:
 			metrics.SyncLoadCounter.Inc()
 			metrics.SyncLoadTimeoutCounter.Inc()
+			syncLoads.Add(1)
+			syncLoadTimeouts.Add(1)
 			return errors.New("sync load stats timeout")
 		}
 	}
@@ -308,6 +321,7 @@ func (s *statsSyncLoad) handleOneItemTask(task *statstypes.NeededItemTask) (err
 			s.statsHandle.SPool().Destroy(se)
 		}
 	}()
+	defer statsutil.LabelSCtx(sctx, statsutil.StatsOperationLoad, task.Item.TableID)()
 	var skipTypes map[string]struct{}
 	val, err := sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBAnalyzeSkipColumnTypes)
 	if err != nil {
@@ -606,6 +620,13 @@ func (s *statsSyncLoad) updateCachedItem(tblInfo *model.TableInfo, item model.Ta
 		}
 		// we have to refresh the map for the possible change to ensure that the map information is not missing.
 		tbl.ColAndIdxExistenceMap.InsertCol(item.ID, colHist.StatsAvailable())
+		// The stats of the global indexes with prefix column are derived from the base column lazily.
+		if fullLoaded {
+			if err := statistics.DerivePrefixGlobalIndexStatsOnColumn(tbl, tblInfo, colHist); err != nil {
+				logutil.BgLogger().Warn("failed to derive the stats of the prefix global indexes",
+					zap.Int64("tableID", item.TableID), zap.Int64("columnID", item.ID), zap.Error(err))
+			}
+		}
 	} else if item.IsIndex && idxHist != nil {
 		index := tbl.GetIdx(item.ID)
 		// - If the stats is fully loaded,
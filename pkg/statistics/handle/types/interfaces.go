@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/handle/usage/indexusage"
 	handleutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
@@ -49,12 +50,17 @@ type StatsGC interface {
 	// DeleteTableStatsFromKV deletes table statistics from kv.
 	// A statsID refers to statistic of a table or a partition.
 	DeleteTableStatsFromKV(statsIDs []int64, soft bool) (err error)
+
+	// DeleteColumnAndIndexStatsFromKV resets the stats of the columns and indexes of the tables or partitions in one
+	// transaction, the other stats of them are kept.
+	DeleteColumnAndIndexStatsFromKV(statsIDs, colIDs, idxIDs []int64) (err error)
 }
 
 // ColStatsTimeInfo records usage information of this column stats.
 type ColStatsTimeInfo struct {
 	LastUsedAt     *types.Time // last time the column is used
 	LastAnalyzedAt *types.Time // last time the column is analyzed
+	UsageScore     float64     // usage score of the column at LastUsedAt
 }
 
 // StatsUsage is used to track the usage of column / index statistics.
@@ -85,8 +91,47 @@ type StatsUsage interface {
 	// DumpStatsDeltaToKV sweeps the whole list and updates the global map, then we dumps every table that held in map to KV.
 	DumpStatsDeltaToKV(dumpAll bool) error
 
+	// EnableStatsDeltaCheckpoint restores the pending stats deltas checkpointed to the local file path by the last run
+	// of the instance, and checkpoints the pending deltas to path after each dump since then.
+	EnableStatsDeltaCheckpoint(path string) error
+
+	// DumpDueStatsDeltaToKV sweeps the whole list and dumps the stats deltas whose flush intervals, which adapt to the
+	// write rates of the tables, have elapsed to KV.
+	DumpDueStatsDeltaToKV() error
+
 	// DumpColStatsUsageToKV sweeps the whole list, updates the column stats usage map and dumps it to KV.
 	DumpColStatsUsageToKV() error
+
+	// DumpStatsDeltaToKVByIDs sweeps the whole list and dumps the stats delta of the given tables or partitions to KV
+	// immediately, regardless of the modification ratio. The deltas of the other tables are kept in memory.
+	DumpStatsDeltaToKVByIDs(tableIDs ...int64) error
+
+	// PendingStatsDeltas returns the stats deltas not yet persisted to KV, including the ones held by the sessions.
+	PendingStatsDeltas() []variable.TableDelta
+
+	// ObserveRegionRowDeltas adds the rows changed out of the sessions, which are observed by the approximate keys of
+	// the regions of the tables, to the stats deltas.
+	ObserveRegionRowDeltas() error
+
+	// DumpColStatsUsageToKVByIDs sweeps the whole list and dumps the column stats usage of the given tables or
+	// partitions to KV. The usage of the other tables is kept in memory.
+	DumpColStatsUsageToKVByIDs(tableIDs ...int64) error
+
+	// RecordEstimationError records the sampled estimated and actual row counts of an operator accessing the table.
+	RecordEstimationError(key EstimationErrorKey, estRows, actRows float64)
+
+	// DumpEstimationErrorsToKV dumps the recorded estimation errors to mysql.stats_estimation_errors.
+	DumpEstimationErrorsToKV() error
+}
+
+// EstimationErrorKey is the key to aggregate the estimation errors of the operators.
+type EstimationErrorKey struct {
+	// SQLDigest is the digest of the statement.
+	SQLDigest string
+	// Operator is the type of the operator, with the name of the index for the index scans.
+	Operator string
+	// TableID is the logical ID of the table accessed by the operator.
+	TableID int64
 }
 
 // IndexUsage is an interface to define the function of collecting index usage stats.
@@ -105,6 +150,9 @@ type IndexUsage interface {
 
 	// GetIndexUsage returns the index usage information
 	GetIndexUsage(tableID int64, indexID int64) indexusage.Sample
+
+	// GetIndexUsageStartTime returns the time since which the index usage is collected by the current instance.
+	GetIndexUsageStartTime() time.Time
 }
 
 // StatsHistory is used to manage historical stats.
@@ -226,6 +274,10 @@ type StatsCache interface {
 	// To work with auto-analyze's needs, we'll update all table's stats meta into memory.
 	Update(ctx context.Context, is infoschema.InfoSchema, tableAndPartitionIDs ...int64) error
 
+	// LastUpdatedTables returns the number of the tables and partitions whose stats are refreshed by the last Update
+	// of all the tables.
+	LastUpdatedTables() int64
+
 	// MemConsumed returns its memory usage.
 	MemConsumed() (size int64)
 
@@ -263,6 +315,30 @@ type StatsCache interface {
 
 	// TriggerEvict triggers the cache to evict some items
 	TriggerEvict()
+
+	// GetTableCacheUsage returns the load time and the eviction count of the stats of the table or the partition in
+	// the cache.
+	GetTableCacheUsage(tableID int64) (TableCacheUsage, bool)
+
+	// EvictTableStats evicts the stats of the table or the partition, or only the given columns of it, from the
+	// cache without touching the storage. It returns false if nothing is evicted.
+	EvictTableStats(tableID int64, colIDs []int64) bool
+
+	// Shrink evicts the stats of the largest tables from the cache to release about half of its memory, and returns
+	// the bytes released. It's called by the server memory controller when tidb_server_memory_limit is exceeded.
+	Shrink() int64
+}
+
+// TableCacheUsage is the load time and the eviction count of the stats of a table or a partition in the stats cache.
+type TableCacheUsage struct {
+	// LoadTime is the last time the stats of the table are put into the cache.
+	LoadTime time.Time
+	// ItemLoadTimes is the last time the stats of each column and index are fully loaded into the cache.
+	ItemLoadTimes map[model.TableItemID]time.Time
+	// ItemEvictCounts is the number of the times the stats of each column and index are evicted from the cache.
+	ItemEvictCounts map[model.TableItemID]int64
+	// EvictCount is the number of the times the stats of the table are evicted from the cache.
+	EvictCount int64
 }
 
 // StatsLockTable is the table info of which will be locked.
@@ -272,46 +348,102 @@ type StatsLockTable struct {
 	FullName string
 }
 
+// StatsLockAudit is the audit information of a stats lock or unlock operation.
+// It's recorded in mysql.stats_lock_history.
+type StatsLockAudit struct {
+	// User is the user who executes the operation.
+	User string
+	// Reason is the comment of the operation given by the user.
+	Reason string
+}
+
 // StatsLock is used to manage locked stats.
 type StatsLock interface {
 	// LockTables add locked tables id to store.
 	// - tables: tables that will be locked.
+	// - expireVersion: the TSO after which the tables are unlocked automatically, 0 means never.
+	// - audit: the audit information recorded in the lock history.
 	// Return the message of skipped tables and error.
-	LockTables(tables map[int64]*StatsLockTable) (skipped string, err error)
+	LockTables(tables map[int64]*StatsLockTable, expireVersion uint64, audit *StatsLockAudit) (skipped string, err error)
 
 	// LockPartitions add locked partitions id to store.
 	// If the whole table is locked, then skip all partitions of the table.
 	// - tid: table id of which will be locked.
 	// - tableName: table name of which will be locked.
 	// - pidNames: partition ids of which will be locked.
+	// - expireVersion: the TSO after which the partitions are unlocked automatically, 0 means never.
+	// - audit: the audit information recorded in the lock history.
 	// Return the message of skipped tables and error.
 	// Note: If the whole table is locked, then skip all partitions of the table.
 	LockPartitions(
 		tid int64,
 		tableName string,
 		pidNames map[int64]string,
+		expireVersion uint64,
+		audit *StatsLockAudit,
 	) (skipped string, err error)
 
 	// RemoveLockedTables remove tables from table locked records.
 	// - tables: tables of which will be unlocked.
+	// - audit: the audit information recorded in the lock history.
 	// Return the message of skipped tables and error.
-	RemoveLockedTables(tables map[int64]*StatsLockTable) (skipped string, err error)
+	RemoveLockedTables(tables map[int64]*StatsLockTable, audit *StatsLockAudit) (skipped string, err error)
 
 	// RemoveLockedPartitions remove partitions from table locked records.
 	// - tid: table id of which will be unlocked.
 	// - tableName: table name of which will be unlocked.
 	// - pidNames: partition ids of which will be unlocked.
+	// - audit: the audit information recorded in the lock history.
 	// Note: If the whole table is locked, then skip all partitions of the table.
 	RemoveLockedPartitions(
 		tid int64,
 		tableName string,
 		pidNames map[int64]string,
+		audit *StatsLockAudit,
+	) (skipped string, err error)
+
+	// LockColumns add locked columns id to store.
+	// The stats of the locked columns are kept when the table is analyzed.
+	// - tid: table id of which the columns will be locked.
+	// - tableName: table name of which the columns will be locked.
+	// - colIDNames: column ids of which will be locked.
+	// - audit: the audit information recorded in the lock history.
+	// Return the message of skipped columns and error.
+	// Note: If the whole table is locked, then skip all columns of the table.
+	LockColumns(
+		tid int64,
+		tableName string,
+		colIDNames map[int64]string,
+		audit *StatsLockAudit,
+	) (skipped string, err error)
+
+	// RemoveLockedColumns remove columns from column locked records.
+	// - tid: table id of which the columns will be unlocked.
+	// - tableName: table name of which the columns will be unlocked.
+	// - colIDNames: column ids of which will be unlocked.
+	// - audit: the audit information recorded in the lock history.
+	// Return the message of skipped columns and error.
+	RemoveLockedColumns(
+		tid int64,
+		tableName string,
+		colIDNames map[int64]string,
+		audit *StatsLockAudit,
 	) (skipped string, err error)
 
+	// GetLockedColumns returns the locked columns of the given table.
+	GetLockedColumns(tableID int64) (map[int64]struct{}, error)
+
+	// RemoveExpiredLockedTables removes the tables and partitions whose locks have expired from table locked records.
+	RemoveExpiredLockedTables(is infoschema.InfoSchema) error
+
 	// GetLockedTables returns the locked status of the given tables.
 	// Note: This function query locked tables from store, so please try to batch the query.
 	GetLockedTables(tableIDs ...int64) (map[int64]struct{}, error)
 
+	// GetTablesLockVersion returns the version of the latest lock operation of the given tables and partitions
+	// recorded in the lock history. The tables without any lock record are not in the result.
+	GetTablesLockVersion(tableIDs ...int64) (map[int64]uint64, error)
+
 	// GetTableLockedAndClearForTest for unit test only.
 	GetTableLockedAndClearForTest() (map[int64]struct{}, error)
 }
@@ -325,6 +457,27 @@ type PartitionStatisticLoadTask struct {
 // PersistFunc is used to persist JSONTable in the partition level.
 type PersistFunc func(ctx context.Context, jsonTable *statsutil.JSONTable, physicalID int64) error
 
+// StatsStorage is the backend persisting the analyzed stats of the tables, i.e. the stats meta, histograms, TopNs,
+// sketches and buckets written by analyze, together with their historical versions. The default one keeps them in the
+// mysql.stats_* system tables, and the alternative ones can keep them elsewhere, e.g. in the local files or a remote
+// service. The other stats related states, e.g. the deltas of the DML, the locked tables, the predicate columns, the
+// analyze options and the extended stats, are always kept in the system tables and not covered by it.
+type StatsStorage interface {
+	// Save saves the analyze results of a table or a partition, and returns the version of the saved stats. The update
+	// event of the stats from the source is recorded atomically with them, see tidb_enable_stats_change_events.
+	Save(results *statistics.AnalyzeResults, analyzeSnapshot bool, source string) (statsVer uint64, err error)
+
+	// Load loads the stats of a table or a partition at the snapshot, the latest ones if the snapshot is 0.
+	// The table is the cached stats to update, which can be nil.
+	Load(tableInfo *model.TableInfo, physicalID int64, loadAll bool, snapshot uint64, table *statistics.Table) (*statistics.Table, error)
+
+	// History loads the historical stats of a table or a partition at the snapshot.
+	History(physicalID int64, snapshot uint64) (jsonTbl *statsutil.JSONTable, exist bool, err error)
+
+	// GC deletes the stats of the tables or partitions. The soft deletion only marks them as deleted.
+	GC(statsIDs []int64, soft bool) error
+}
+
 // StatsReadWriter is used to read and write stats to the storage.
 // TODO: merge and remove some methods.
 type StatsReadWriter interface {
@@ -353,6 +506,9 @@ type StatsReadWriter interface {
 	// SaveMetaToStorage saves the stats meta of a table to storage.
 	SaveMetaToStorage(tableID, count, modifyCount int64, source string) (err error)
 
+	// SaveQuickStatsToStorage saves the quick stats of a physical table built from its regions to storage.
+	SaveQuickStatsToStorage(physicalID int64, qs *statistics.QuickStats, source string) (err error)
+
 	// UpdateStatsVersion will set statistics version to the newest TS,
 	// then tidb-server will reload automatic.
 	UpdateStatsVersion() error
@@ -385,6 +541,10 @@ type StatsReadWriter interface {
 	// ChangeGlobalStatsID changes the global stats ID.
 	ChangeGlobalStatsID(from, to int64) (err error)
 
+	// AdjustStatsForTTL removes the values before the expire time from the stats of the TTL time column
+	// after the TTL job has deleted the expired rows of the table or partition.
+	AdjustStatsForTTL(tblInfo *model.TableInfo, physicalID int64, expireTime time.Time) (err error)
+
 	// TableStatsToJSON dumps table stats to JSON.
 	TableStatsToJSON(dbName string, tableInfo *model.TableInfo, physicalID int64, snapshot uint64) (*statsutil.JSONTable, error)
 
@@ -424,10 +584,17 @@ type StatsReadWriter interface {
 	// LoadStatsFromJSONNoUpdate will load statistic from JSONTable, and save it to the storage.
 	LoadStatsFromJSONNoUpdate(ctx context.Context, is infoschema.InfoSchema, jsonTbl *statsutil.JSONTable, concurrencyForPartition int) error
 
+	// DumpColumnStatsUsageToJSON dumps the predicate columns of the table and its partitions to json.
+	DumpColumnStatsUsageToJSON(dbName string, tableInfo *model.TableInfo) (*statsutil.JSONTable, error)
+
+	// LoadColumnStatsUsageFromJSON loads the predicate columns from JSONTable, and save them to the storage.
+	LoadColumnStatsUsageFromJSON(is infoschema.InfoSchema, jsonTbl *statsutil.JSONTable) error
+
 	// Methods for extended stast.
 
 	// InsertExtendedStats inserts a record into mysql.stats_extended and update version in mysql.stats_meta.
-	InsertExtendedStats(statsName string, colIDs []int64, tp int, tableID int64, ifNotExists bool) (err error)
+	// The exprStr is the expression for the expression type, and it's empty for the other types.
+	InsertExtendedStats(statsName string, colIDs []int64, tp int, exprStr string, tableID int64, ifNotExists bool) (err error)
 
 	// MarkExtendedStatsDeleted update the status of mysql.stats_extended to be `deleted` and the version of mysql.stats_meta.
 	MarkExtendedStatsDeleted(statsName string, tableID int64, ifExists bool) (err error)
@@ -507,6 +674,12 @@ type StatsGlobal interface {
 		info *GlobalStatsInfo,
 		physicalID int64,
 	) (err error)
+
+	// BuildMissingGlobalStats builds the global stats for the partitioned tables which only have partition-level stats.
+	BuildMissingGlobalStats(is infoschema.InfoSchema) error
+
+	// DDLEventHandlers returns the handlers of the ddl events which invalidate the cached partial merge results.
+	DDLEventHandlers() *DDLEventHandlers
 }
 
 // DDL is used to handle ddl events.
@@ -515,6 +688,43 @@ type DDL interface {
 	HandleDDLEvent(ctx context.Context, sctx sessionctx.Context, changeEvent *notifier.SchemaChangeEvent) error
 	// DDLEventCh returns ddl events channel in handle.
 	DDLEventCh() chan *notifier.SchemaChangeEvent
+	// RegisterDDLEventHandlers registers the handlers of the ddl events. The handlers are called in the
+	// registration order, and the ones maintaining the stats themselves are always the first.
+	RegisterDDLEventHandlers(handlers *DDLEventHandlers)
+}
+
+// DDLEventHandlers is a set of the typed handlers of the ddl events, which is used by the subsystems keeping
+// data related to the stats, e.g. the historical stats, the predicate columns and the analyze options. The nil
+// handlers are skipped. The handlers are called in the same session and transaction as the stats are updated.
+type DDLEventHandlers struct {
+	// Name is used to identify the handlers in the logs.
+	Name string
+	// OnCreateTable handles the created table, referTableID is the table whose stats are copied by
+	// CREATE TABLE ... LIKE ... WITH STATISTICS, it's 0 otherwise.
+	OnCreateTable func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, referTableID int64) error
+	// OnTruncateTable handles the truncated table, refillFactor is the ratio of the old stats carried forward
+	// to the new table, it's 0 if the stats are not carried forward.
+	OnTruncateTable func(ctx context.Context, sctx sessionctx.Context, newTblInfo, droppedTblInfo *model.TableInfo, refillFactor float64) error
+	// OnDropTable handles the dropped table.
+	OnDropTable func(ctx context.Context, sctx sessionctx.Context, droppedTblInfo *model.TableInfo) error
+	// OnRecoverTable handles the table recovered by RECOVER TABLE or FLASHBACK TABLE at the snapshot.
+	OnRecoverTable func(ctx context.Context, sctx sessionctx.Context, recoveredTblInfo *model.TableInfo, snapshotTS uint64) error
+	// OnRenameTable handles the renamed table.
+	OnRenameTable func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, oldSchemaName, newSchemaName, oldTableName string) error
+	// OnAddColumn handles the columns added to the table.
+	OnAddColumn func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, addedCols []*model.ColumnInfo) error
+	// OnModifyColumn handles the modified columns, the old columns are in the same order as the modified ones.
+	OnModifyColumn func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, modifiedCols, oldCols []*model.ColumnInfo) error
+	// OnAddIndex handles the indexes added to the table.
+	OnAddIndex func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, addedIdxs []*model.IndexInfo) error
+	// OnDropIndex handles the indexes dropped from the table.
+	OnDropIndex func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, droppedIdxs []*model.IndexInfo) error
+	// OnAddPartition handles the partitions added to the partitioned table.
+	OnAddPartition func(ctx context.Context, sctx sessionctx.Context, globalTblInfo *model.TableInfo, addedPartInfo *model.PartitionInfo) error
+	// OnDropPartition handles the partitions dropped from the partitioned table.
+	OnDropPartition func(ctx context.Context, sctx sessionctx.Context, globalTblInfo *model.TableInfo, droppedPartInfo *model.PartitionInfo) error
+	// OnExchangePartition handles the partition exchanged with the non-partitioned table.
+	OnExchangePartition func(ctx context.Context, sctx sessionctx.Context, globalTblInfo *model.TableInfo, partInfo *model.PartitionInfo, nonPartTblInfo *model.TableInfo) error
 }
 
 // StatsHandle is used to manage TiDB Statistics.
@@ -543,9 +753,31 @@ type StatsHandle interface {
 	// GetPartitionStatsByID retrieves the partition stats from cache by partition ID.
 	GetPartitionStatsByID(is infoschema.InfoSchema, pid int64) *statistics.Table
 
+	// GetPartitionCardinality returns the row count summary of all the partitions of the given table, partColID is the
+	// ID of the column the partitions are decided by, or 0 if they're decided by multiple columns.
+	GetPartitionCardinality(tblInfo *model.TableInfo, partColID int64) *statistics.PartitionCardinality
+
+	// RecordCardinalityFeedback records the estimated and the actual row counts of a table reader of the statement.
+	RecordCardinalityFeedback(sqlDigest string, tableID int64, estRows, actRows float64)
+
+	// GetCardinalityFeedbackFactor returns the factor to correct the estimated row count of the table in the statement.
+	GetCardinalityFeedbackFactor(sqlDigest string, tableID int64) (factor float64, ok bool)
+
+	// InListSelCache returns the cache of the selectivities of the constants in the IN lists probed against the stats.
+	InListSelCache() *statistics.InListSelCache
+
+	// GetDynamicSample returns the rows sampled from the table without usable stats, which are cached in the stats cache.
+	GetDynamicSample(dbName string, tblInfo *model.TableInfo, maxRows int) (*statistics.DynamicSample, error)
+
 	// GetPartitionStatsForAutoAnalyze retrieves the partition stats from cache, but it will not return pseudo.
 	GetPartitionStatsForAutoAnalyze(tblInfo *model.TableInfo, pid int64) *statistics.Table
 
+	// StatsStorage returns the backend persisting the stats.
+	StatsStorage() StatsStorage
+
+	// SetStatsStorage replaces the backend persisting the stats. It's safe to be called concurrently.
+	SetStatsStorage(backend StatsStorage)
+
 	// StatsGC is used to do the GC job.
 	StatsGC
 
@@ -573,3 +805,30 @@ type StatsHandle interface {
 	// DDL is used to handle ddl events.
 	DDL
 }
+
+// CostCalibrationSample is a measured execution used to calibrate the cost model, e.g. the coprocessor scan of an
+// analyze statement or the average execution of a statement in the statement summary.
+type CostCalibrationSample struct {
+	// ScanRows is the number of rows scanned in the storage.
+	ScanRows float64
+	// LookupTasks is the number of the coprocessor requests sent to the storage.
+	LookupTasks float64
+	// NetRows is the number of rows transferred to TiDB.
+	NetRows float64
+	// Latency is the measured duration in nanoseconds.
+	Latency float64
+	// Weight is the weight of the sample in the fitting, e.g. the execution count of the statement.
+	Weight float64
+}
+
+// CostCalibrator fits the calibration profile of the cost model from the measured executions and applies it.
+// It's implemented by the planner, so the stats handle only collects the samples and persists the encoded profile
+// without depending on the cost model.
+type CostCalibrator interface {
+	// Fit fits the calibration profile from the samples and encodes it. It returns nil if the samples are not enough.
+	Fit(samples []CostCalibrationSample) ([]byte, error)
+	// Apply decodes the calibration profile and applies it to the cost model of this instance.
+	Apply(profile []byte) error
+	// FittedAt returns the time the applied profile was fitted, zero if no profile is applied.
+	FittedAt() time.Time
+}
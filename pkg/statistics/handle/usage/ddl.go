@@ -0,0 +1,68 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+	"github.com/pingcap/tidb/pkg/statistics/handle/usage/predicatecolumn"
+	utilstats "github.com/pingcap/tidb/pkg/statistics/handle/util"
+)
+
+// PredicateColumnsDDLEventHandlers returns the handlers of the ddl events which carry the predicate columns forward
+// to the new tables and columns. The predicate columns of a table are kept by its physical IDs and column IDs, so they would be lost once the
+// table is truncated or a column is rebuilt by MODIFY COLUMN, and the next analyze with the PREDICATE column choice
+// would skip the columns used by the queries.
+func PredicateColumnsDDLEventHandlers() *statstypes.DDLEventHandlers {
+	return &statstypes.DDLEventHandlers{
+		Name: "predicate columns",
+		OnCreateTable: func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, referTableID int64) error {
+			if referTableID == 0 {
+				return nil
+			}
+			is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+			referTblInfo, ok := is.TableInfoByID(referTableID)
+			if !ok {
+				return nil
+			}
+			return predicatecolumn.CopyColumnStatsUsage(ctx, sctx, utilstats.MapPhysicalIDs(tblInfo, referTblInfo))
+		},
+		OnTruncateTable: func(ctx context.Context, sctx sessionctx.Context, newTblInfo, droppedTblInfo *model.TableInfo, _ float64) error {
+			return predicatecolumn.CopyColumnStatsUsage(ctx, sctx, utilstats.MapPhysicalIDs(newTblInfo, droppedTblInfo))
+		},
+		OnModifyColumn: func(ctx context.Context, sctx sessionctx.Context, tblInfo *model.TableInfo, modifiedCols, oldCols []*model.ColumnInfo) error {
+			if len(modifiedCols) != len(oldCols) {
+				return nil
+			}
+			ids := utilstats.PhysicalIDs(tblInfo)
+			for i, colInfo := range modifiedCols {
+				if colInfo.ID == oldCols[i].ID {
+					continue
+				}
+				for _, id := range ids {
+					if err := predicatecolumn.ChangeColumnStatsUsageColumnID(ctx, sctx, id, oldCols[i].ID, colInfo.ID); err != nil {
+						return errors.Trace(err)
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
+	"go.uber.org/zap"
+)
+
+// EnableStatsDeltaCheckpoint restores the pending stats deltas checkpointed to the local file path by the last run of
+// the instance, and checkpoints the pending deltas to path after each dump since then. So the deltas aren't lost if the
+// instance crashes, which makes the tables look fresher than they are and delays their auto analyze. The deltas dumped
+// right before the crash may be restored again if the checkpoint isn't taken yet, but the window is tiny since the
+// checkpoint is taken right after each dump.
+func (s *statsUsageImpl) EnableStatsDeltaCheckpoint(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Trace(err)
+	}
+	if len(data) > 0 {
+		var deltas []variable.TableDelta
+		if err := json.Unmarshal(data, &deltas); err != nil {
+			// A broken checkpoint, e.g. written partially, is dropped rather than blocking the startup.
+			statslogutil.StatsLogger().Warn("drop the broken checkpoint of the stats deltas",
+				zap.String("path", path), zap.Error(err))
+		} else {
+			deltaMap := make(map[int64]variable.TableDelta, len(deltas))
+			for _, delta := range deltas {
+				deltaMap[delta.TableID] = delta
+			}
+			s.SessionTableDelta().Merge(deltaMap)
+			statslogutil.StatsLogger().Info("restore the stats deltas from the checkpoint",
+				zap.String("path", path), zap.Int("tableCount", len(deltas)))
+		}
+	}
+	s.checkpointPath.Store(path)
+	return nil
+}
+
+// checkpointStatsDeltas writes the pending stats deltas to the checkpoint file if it's enabled. The file is replaced
+// by renaming, so it's never written partially, and it's removed if there is no pending delta.
+func (s *statsUsageImpl) checkpointStatsDeltas() {
+	path := s.checkpointPath.Load()
+	if path == "" {
+		return
+	}
+	deltas := s.SessionTableDelta().Pending()
+	var err error
+	if len(deltas) == 0 {
+		if err = os.Remove(path); os.IsNotExist(err) {
+			err = nil
+		}
+	} else {
+		var data []byte
+		data, err = json.Marshal(deltas)
+		if err == nil {
+			tmpPath := path + ".tmp"
+			if err = os.WriteFile(tmpPath, data, 0600); err == nil {
+				err = os.Rename(tmpPath, path)
+			}
+		}
+	}
+	if err != nil {
+		statslogutil.SingletonStatsSamplerLogger().Warn("failed to checkpoint the stats deltas",
+			zap.String("path", path), zap.Error(err))
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsDeltaCheckpoint(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int)")
+	tk.MustExec("create table t2 (a int)")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	path := filepath.Join(t.TempDir(), "stats_delta.checkpoint")
+	require.NoError(t, h.EnableStatsDeltaCheckpoint(path))
+
+	tableID := func(name string) int64 {
+		tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr(name))
+		require.NoError(t, err)
+		return tbl.Meta().ID
+	}
+	statsMeta := func(name string) *testkit.Result {
+		return tk.MustQuery(fmt.Sprintf("select modify_count, count from mysql.stats_meta where table_id = %d", tableID(name)))
+	}
+	tk.MustExec("insert into t1 values (1), (2), (3)")
+	tk.MustExec("insert into t2 values (1), (2)")
+	// The deltas not dumped are checkpointed after the dump.
+	require.NoError(t, h.DumpStatsDeltaToKVByIDs(tableID("t2")))
+	_, err := os.Stat(path)
+	require.NoError(t, err)
+
+	// The deltas lost by the crash are restored from the checkpoint, but the dumped ones aren't.
+	h.ResetSessionStatsList()
+	require.NoError(t, h.EnableStatsDeltaCheckpoint(path))
+	pending := make(map[int64]int64)
+	for _, delta := range h.PendingStatsDeltas() {
+		pending[delta.TableID] = delta.Count
+	}
+	require.Equal(t, int64(3), pending[tableID("t1")])
+	require.NotContains(t, pending, tableID("t2"))
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	statsMeta("t1").Check(testkit.Rows("3 3"))
+	statsMeta("t2").Check(testkit.Rows("2 2"))
+
+	// A broken checkpoint is dropped.
+	require.NoError(t, os.WriteFile(path, []byte("[{"), 0600))
+	h.ResetSessionStatsList()
+	require.NoError(t, h.EnableStatsDeltaCheckpoint(path))
+	require.Empty(t, h.PendingStatsDeltas())
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/metrics"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+	utilstats "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/sqlescape"
+)
+
+// maxEstimationErrorEntries is the maximum number of the estimation error entries kept in memory between two dumps.
+// The records of the new entries are dropped once it's reached.
+const maxEstimationErrorEntries = 10000
+
+// EstimationErrors aggregates the sampled estimated and actual row counts of the operators accessing tables.
+// All methods of it are thread-safe.
+type EstimationErrors struct {
+	entries map[statstypes.EstimationErrorKey]*estimationErrorEntry
+	lock    sync.Mutex
+}
+
+type estimationErrorEntry struct {
+	sampleCount int64
+	sumEstRows  float64
+	sumActRows  float64
+	maxQError   float64
+	lastSeenAt  time.Time
+}
+
+// NewEstimationErrors creates a new EstimationErrors.
+func NewEstimationErrors() *EstimationErrors {
+	return &EstimationErrors{
+		entries: make(map[statstypes.EstimationErrorKey]*estimationErrorEntry),
+	}
+}
+
+// Record records an execution of the operator. It returns false if the record is dropped.
+func (e *EstimationErrors) Record(key statstypes.EstimationErrorKey, estRows, actRows float64, seenAt time.Time) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	entry, ok := e.entries[key]
+	if !ok {
+		if len(e.entries) >= maxEstimationErrorEntries {
+			return false
+		}
+		entry = &estimationErrorEntry{}
+		e.entries[key] = entry
+	}
+	entry.sampleCount++
+	entry.sumEstRows += estRows
+	entry.sumActRows += actRows
+	entry.maxQError = max(entry.maxQError, qError(estRows, actRows))
+	if seenAt.After(entry.lastSeenAt) {
+		entry.lastSeenAt = seenAt
+	}
+	return true
+}
+
+// getAndReset gets the entries and resets the EstimationErrors.
+func (e *EstimationErrors) getAndReset() map[statstypes.EstimationErrorKey]*estimationErrorEntry {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	ret := e.entries
+	e.entries = make(map[statstypes.EstimationErrorKey]*estimationErrorEntry)
+	return ret
+}
+
+// merge merges the entries failed to dump back.
+func (e *EstimationErrors) merge(other map[statstypes.EstimationErrorKey]*estimationErrorEntry) {
+	if len(other) == 0 {
+		return
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for key, o := range other {
+		entry, ok := e.entries[key]
+		if !ok {
+			e.entries[key] = o
+			continue
+		}
+		entry.sampleCount += o.sampleCount
+		entry.sumEstRows += o.sumEstRows
+		entry.sumActRows += o.sumActRows
+		entry.maxQError = max(entry.maxQError, o.maxQError)
+		if o.lastSeenAt.After(entry.lastSeenAt) {
+			entry.lastSeenAt = o.lastSeenAt
+		}
+	}
+}
+
+// qError returns the q-error of the estimation, i.e. the factor by which the estimated row count deviates from the
+// actual one. Both are smoothed by 1 to avoid dividing by 0.
+func qError(estRows, actRows float64) float64 {
+	est, act := max(estRows, 0)+1, max(actRows, 0)+1
+	return max(est/act, act/est)
+}
+
+// RecordEstimationError records the sampled estimated and actual row counts of an operator accessing the table.
+func (u *statsUsageImpl) RecordEstimationError(key statstypes.EstimationErrorKey, estRows, actRows float64) {
+	if !u.estimationErrors.Record(key, estRows, actRows, time.Now()) {
+		metrics.EstimationErrorCounter.WithLabelValues("drop").Inc()
+		return
+	}
+	metrics.EstimationErrorCounter.WithLabelValues("record").Inc()
+}
+
+// DumpEstimationErrorsToKV dumps the recorded estimation errors to mysql.stats_estimation_errors.
+func (u *statsUsageImpl) DumpEstimationErrorsToKV() error {
+	defer util.Recover(metrics.LabelStats, "DumpEstimationErrorsToKV", nil, false)
+	entries := u.estimationErrors.getAndReset()
+	defer func() {
+		u.estimationErrors.merge(entries)
+	}()
+	keys := make([]statstypes.EstimationErrorKey, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b statstypes.EstimationErrorKey) int {
+		return cmp.Or(cmp.Compare(a.SQLDigest, b.SQLDigest), cmp.Compare(a.TableID, b.TableID), cmp.Compare(a.Operator, b.Operator))
+	})
+	// Use batch insert to reduce cost.
+	for i := 0; i < len(keys); i += batchInsertSize {
+		end := min(i+batchInsertSize, len(keys))
+		sql := new(strings.Builder)
+		sqlescape.MustFormatSQL(sql, "INSERT INTO mysql.stats_estimation_errors "+
+			"(sql_digest, table_id, operator, sample_count, sum_est_rows, sum_act_rows, max_q_error, last_seen_at) VALUES ")
+		for j := i; j < end; j++ {
+			key, entry := keys[j], entries[keys[j]]
+			if j > i {
+				sqlescape.MustFormatSQL(sql, ",")
+			}
+			// Pass in the UTC time and convert it to the session's time zone, see DumpColStatsUsageToKV.
+			sqlescape.MustFormatSQL(sql, "(%?, %?, %?, %?, %?, %?, %?, CONVERT_TZ(%?, '+00:00', @@TIME_ZONE))",
+				key.SQLDigest, key.TableID, key.Operator, entry.sampleCount, entry.sumEstRows, entry.sumActRows,
+				entry.maxQError, entry.lastSeenAt.UTC().Format(types.TimeFormat))
+		}
+		sqlescape.MustFormatSQL(sql, " ON DUPLICATE KEY UPDATE "+
+			"sample_count = sample_count + VALUES(sample_count), "+
+			"sum_est_rows = sum_est_rows + VALUES(sum_est_rows), "+
+			"sum_act_rows = sum_act_rows + VALUES(sum_act_rows), "+
+			"max_q_error = GREATEST(max_q_error, VALUES(max_q_error)), "+
+			"last_seen_at = GREATEST(last_seen_at, VALUES(last_seen_at))")
+		if err := utilstats.CallWithSCtxAndLabels(utilstats.StatsCtx, u.statsHandle.SPool(), utilstats.StatsOperationDumpDelta, 0, func(sctx sessionctx.Context) error {
+			_, _, err := utilstats.ExecRows(sctx, sql.String())
+			return err
+		}); err != nil {
+			return errors.Trace(err)
+		}
+		for j := i; j < end; j++ {
+			delete(entries, keys[j])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpEstimationErrors(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index ia(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5)")
+	tk.MustExec("analyze table t all columns")
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	h := dom.StatsHandle()
+	queryErrors := fmt.Sprintf("select operator, sample_count, sum_act_rows from mysql.stats_estimation_errors where table_id = %d order by operator", tbl.Meta().ID)
+
+	// Nothing is recorded if the sample rate is 0.
+	tk.MustQuery("select * from t where b > 1")
+	require.NoError(t, h.DumpEstimationErrorsToKV())
+	tk.MustQuery(queryErrors).Check(testkit.Rows())
+
+	tk.MustExec("set global tidb_estimation_error_sample_rate = 1")
+	defer tk.MustExec("set global tidb_estimation_error_sample_rate = default")
+	tk.MustQuery("select * from t where b > 1")
+	tk.MustQuery("select * from t where b > 3")
+	tk.MustQuery("select * from t use index(ia) where a < 3")
+	require.NoError(t, h.DumpEstimationErrorsToKV())
+	// The executions of the same statement are aggregated.
+	tk.MustQuery(queryErrors).Check(testkit.Rows(
+		"IndexLookUp 1 2",
+		"IndexRangeScan(ia) 1 2",
+		"Selection 2 6",
+		"TableFullScan 2 10",
+		"TableReader 2 6",
+		"TableRowIDScan 1 2",
+	))
+	// The dumped errors are accumulated.
+	tk.MustQuery("select * from t where b > 4")
+	require.NoError(t, h.DumpEstimationErrorsToKV())
+	tk.MustQuery(queryErrors + " limit 1 offset 3").Check(testkit.Rows("TableFullScan 3 15"))
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_estimation_errors where table_id = %d and max_q_error >= 1", tbl.Meta().ID)).Check(testkit.Rows("6"))
+
+	// The errors not seen within the retention are removed by the stats GC.
+	tk.MustExec("update mysql.stats_estimation_errors set last_seen_at = NOW() - INTERVAL 8 DAY where operator != 'TableFullScan'")
+	require.NoError(t, h.GCStats(dom.InfoSchema(), time.Duration(0)))
+	tk.MustQuery(queryErrors).Check(testkit.Rows("TableFullScan 3 15"))
+}
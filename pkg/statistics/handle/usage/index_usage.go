@@ -16,6 +16,7 @@ package usage
 
 import (
 	"context"
+	"time"
 
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/meta/model"
@@ -60,3 +61,8 @@ func (u *statsUsageImpl) Close() {
 func (u *statsUsageImpl) GetIndexUsage(tableID int64, indexID int64) indexusage.Sample {
 	return u.idxUsageCollector.GetIndexUsage(tableID, indexID)
 }
+
+// GetIndexUsageStartTime returns the time since which the index usage is collected by the current instance.
+func (u *statsUsageImpl) GetIndexUsageStartTime() time.Time {
+	return u.idxUsageCollector.StartTime()
+}
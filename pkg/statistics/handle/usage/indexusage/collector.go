@@ -127,6 +127,9 @@ type Collector struct {
 	indexUsage indexUsage
 	sync.RWMutex
 
+	// startTime is when the collector is created, the index usage before it isn't known.
+	startTime time.Time
+
 	closed bool
 }
 
@@ -134,6 +137,7 @@ type Collector struct {
 func NewCollector() *Collector {
 	iuc := &Collector{
 		indexUsage: indexUsagePool.Get().(indexUsage),
+		startTime:  time.Now(),
 	}
 	iuc.collector = collector.NewGlobalCollector[indexUsage](iuc.merge)
 
@@ -155,6 +159,11 @@ func (c *Collector) GetIndexUsage(tableID int64, indexID int64) Sample {
 	return info
 }
 
+// StartTime returns the time since which the index usage is collected.
+func (c *Collector) StartTime() time.Time {
+	return c.startTime
+}
+
 func (c *Collector) merge(delta indexUsage) {
 	c.Lock()
 	defer c.Unlock()
@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tidb/pkg/statistics/handle/usage/indexusage"
 	"github.com/pingcap/tidb/pkg/statistics/handle/usage/predicatecolumn"
 	utilstats "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"go.uber.org/atomic"
 )
 
 // statsUsageImpl implements statstypes.StatsUsage.
@@ -32,6 +33,15 @@ type statsUsageImpl struct {
 	// idxUsageCollector contains all the index usage collectors required by session.
 	idxUsageCollector *indexusage.Collector
 
+	// estimationErrors contains the sampled estimation errors to be dumped.
+	estimationErrors *EstimationErrors
+
+	// regionDeltas contains the baselines of the tables observed by ObserveRegionRowDeltas.
+	regionDeltas *regionRowDeltas
+
+	// checkpointPath is the local file the pending stats deltas are checkpointed to, see EnableStatsDeltaCheckpoint.
+	checkpointPath atomic.String
+
 	// SessionStatsList contains all the stats collector required by session.
 	*SessionStatsList
 }
@@ -41,6 +51,8 @@ func NewStatsUsageImpl(statsHandle statstypes.StatsHandle) statstypes.StatsUsage
 	return &statsUsageImpl{
 		statsHandle:       statsHandle,
 		idxUsageCollector: indexusage.NewCollector(),
+		estimationErrors:  NewEstimationErrors(),
+		regionDeltas:      newRegionRowDeltas(),
 		SessionStatsList:  NewSessionStatsList()}
 }
 
@@ -100,6 +100,74 @@ func TestAnalyzeTableWithPredicateColumns(t *testing.T) {
 	)
 }
 
+func TestPredicateColumnsUsageDecay(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int)")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3)")
+	tk.MustExec("set global tidb_predicate_columns_usage_half_life='24h'")
+	defer tk.MustExec("set global tidb_predicate_columns_usage_half_life=default")
+
+	// Use column a twice, the usage score is accumulated.
+	h := dom.StatsHandle()
+	tk.MustQuery("select * from t where a > 1").Check(testkit.Rows("2 2 2", "3 3 3"))
+	require.NoError(t, h.DumpColStatsUsageToKV())
+	tk.MustQuery("select * from t where a > 1").Check(testkit.Rows("2 2 2", "3 3 3"))
+	require.NoError(t, h.DumpColStatsUsageToKV())
+	tk.MustQuery("select * from t where b > 1").Check(testkit.Rows("2 2 2", "3 3 3"))
+	require.NoError(t, h.DumpColStatsUsageToKV())
+	tk.MustQuery("select column_id, usage_score >= 1.9 from mysql.column_stats_usage where table_id = (select tidb_table_id from information_schema.tables where table_schema = 'test' and table_name = 't') order by column_id").Check(
+		testkit.Rows("1 1", "2 0"),
+	)
+
+	// Column b has not been used for 5 days, its score decays below the threshold.
+	// Column a has a higher score and it is still a predicate column after 3 days.
+	tk.MustExec("update mysql.column_stats_usage set last_used_at = date_sub(now(), interval 5 day) where column_id = 2")
+	tk.MustExec("update mysql.column_stats_usage set last_used_at = date_sub(now(), interval 3 day) where column_id = 1")
+	tk.MustExec("set global tidb_analyze_column_options='PREDICATE'")
+	defer tk.MustExec("set global tidb_analyze_column_options=default")
+	tk.MustExec("analyze table t")
+	tk.MustQuery("select table_name, job_info from mysql.analyze_jobs order by id desc limit 1").Check(
+		testkit.Rows("t analyze table column a with 256 buckets, 100 topn, 1 samplerate"),
+	)
+
+	// Disable the decay, both columns are predicate columns again.
+	tk.MustExec("set global tidb_predicate_columns_usage_half_life=0")
+	tk.MustExec("analyze table t")
+	tk.MustQuery("select table_name, job_info from mysql.analyze_jobs order by id desc limit 1").Check(
+		testkit.Rows("t analyze table columns a, b with 256 buckets, 100 topn, 1 samplerate"),
+	)
+}
+
+func TestPredicateSelectivityUsage(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int)")
+	tk.MustExec("insert into t with recursive s(n) as (select 1 union all select n + 1 from s where n < 1000) select n, n, n from s")
+	tk.MustExec("analyze table t all columns")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpColStatsUsageToKV())
+
+	tk.MustQuery("select count(*) from t where a = 1").Check(testkit.Rows("1"))
+	tk.MustQuery("select count(*) from t where a in (1, 2) and b > 995").Check(testkit.Rows("0"))
+	tk.MustQuery("select count(*) from t where c > 100").Check(testkit.Rows("900"))
+	tk.MustQuery("select count(*) from t where c > 100 or c = 1").Check(testkit.Rows("901"))
+	require.NoError(t, h.DumpColStatsUsageToKV())
+	// Each statement is counted once for each class of the predicates on the column.
+	query := "select column_id, point_predicates, narrow_range_predicates, wide_range_predicates from mysql.column_stats_usage " +
+		"where table_id = (select tidb_table_id from information_schema.tables where table_schema = 'test' and table_name = 't') order by column_id"
+	tk.MustQuery(query).Check(testkit.Rows("1 2 0 0", "2 0 1 0", "3 0 0 2"))
+
+	// The counts are accumulated.
+	tk.MustQuery("select count(*) from t where b between 10 and 12").Check(testkit.Rows("3"))
+	require.NoError(t, h.DumpColStatsUsageToKV())
+	tk.MustQuery(query).Check(testkit.Rows("1 2 0 0", "2 0 2 0", "3 0 0 2"))
+}
+
 func TestAnalyzeTableWithTiDBPersistAnalyzeOptionsEnabled(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
 	tk := testkit.NewTestKit(t, store)
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -25,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/statistics"
 	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
 	utilstats "github.com/pingcap/tidb/pkg/statistics/handle/util"
@@ -62,6 +64,7 @@ func loadColumnStatsUsage(sctx sessionctx.Context, loc *time.Location, query str
 			t := types.NewTime(types.FromGoTime(gt.In(loc)), mysql.TypeTimestamp, types.DefaultFsp)
 			statsUsage.LastAnalyzedAt = &t
 		}
+		statsUsage.UsageScore = row.GetFloat64(4)
 		colStatsMap[tblColID] = statsUsage
 	}
 	return colStatsMap, nil
@@ -69,13 +72,13 @@ func loadColumnStatsUsage(sctx sessionctx.Context, loc *time.Location, query str
 
 // LoadColumnStatsUsage loads column stats usage information from disk.
 func LoadColumnStatsUsage(sctx sessionctx.Context, loc *time.Location) (map[model.TableItemID]statstypes.ColStatsTimeInfo, error) {
-	query := "SELECT table_id, column_id, CONVERT_TZ(last_used_at, @@TIME_ZONE, '+00:00'), CONVERT_TZ(last_analyzed_at, @@TIME_ZONE, '+00:00') FROM mysql.column_stats_usage"
+	query := "SELECT table_id, column_id, CONVERT_TZ(last_used_at, @@TIME_ZONE, '+00:00'), CONVERT_TZ(last_analyzed_at, @@TIME_ZONE, '+00:00'), usage_score FROM mysql.column_stats_usage"
 	return loadColumnStatsUsage(sctx, loc, query)
 }
 
 // LoadColumnStatsUsageForTable loads column stats usage information for a specific table from disk.
 func LoadColumnStatsUsageForTable(sctx sessionctx.Context, loc *time.Location, tableID int64) (map[model.TableItemID]statstypes.ColStatsTimeInfo, error) {
-	query := "SELECT table_id, column_id, CONVERT_TZ(last_used_at, @@TIME_ZONE, '+00:00'), CONVERT_TZ(last_analyzed_at, @@TIME_ZONE, '+00:00') FROM mysql.column_stats_usage WHERE table_id = %?"
+	query := "SELECT table_id, column_id, CONVERT_TZ(last_used_at, @@TIME_ZONE, '+00:00'), CONVERT_TZ(last_analyzed_at, @@TIME_ZONE, '+00:00'), usage_score FROM mysql.column_stats_usage WHERE table_id = %?"
 	return loadColumnStatsUsage(sctx, loc, query, tableID)
 }
 
@@ -88,12 +91,14 @@ func GetPredicateColumns(sctx sessionctx.Context, tableID int64) ([]int64, error
 	}
 	rows, _, err := utilstats.ExecRows(
 		sctx,
-		"SELECT column_id, CONVERT_TZ(last_used_at, @@TIME_ZONE, '+00:00') FROM mysql.column_stats_usage WHERE table_id = %? AND last_used_at IS NOT NULL",
+		"SELECT column_id, CONVERT_TZ(last_used_at, @@TIME_ZONE, '+00:00'), usage_score FROM mysql.column_stats_usage WHERE table_id = %? AND last_used_at IS NOT NULL",
 		tableID,
 	)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	halfLife := vardef.PredicateColumnsUsageHalfLife.Load()
+	now := time.Now()
 	columnIDs := make([]int64, 0, len(rows))
 	for _, row := range rows {
 		// Usually, it should not be NULL.
@@ -102,11 +107,34 @@ func GetPredicateColumns(sctx sessionctx.Context, tableID int64) ([]int64, error
 			continue
 		}
 		colID := row.GetInt64(0)
+		lastUsedAt, err := row.GetTime(1).GoTime(time.UTC)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		// The columns which are rarely used and not used for a long time are no longer predicate columns.
+		if DecayedUsageScore(row.GetFloat64(2), lastUsedAt, now, halfLife) < MinPredicateColumnUsageScore {
+			continue
+		}
 		columnIDs = append(columnIDs, colID)
 	}
 	return columnIDs, nil
 }
 
+// MinPredicateColumnUsageScore is the minimum decayed usage score of a predicate column.
+// A column used only once is no longer a predicate column after about 3.3 half-lives.
+const MinPredicateColumnUsageScore = 0.1
+
+// DecayedUsageScore returns the usage score of a column decayed from its last used time to now.
+// The score halves every halfLife, and it never decays if halfLife is 0.
+// The columns used before the usage score is introduced have score 0, they are treated as used once.
+func DecayedUsageScore(score float64, lastUsedAt, now time.Time, halfLife time.Duration) float64 {
+	score = max(score, 1)
+	if halfLife <= 0 || !now.After(lastUsedAt) {
+		return score
+	}
+	return score * math.Pow(0.5, float64(now.Sub(lastUsedAt))/float64(halfLife))
+}
+
 // cleanupDroppedColumnStatsUsage deletes the column stats usage information whose column is dropped.
 func cleanupDroppedColumnStatsUsage(sctx sessionctx.Context, tableID int64) error {
 	is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
@@ -150,8 +178,8 @@ func SaveColumnStatsUsageForTable(
 		}
 		_, _, err := utilstats.ExecRows(
 			sctx,
-			"REPLACE INTO mysql.column_stats_usage (table_id, column_id, last_used_at, last_analyzed_at) VALUES (%?, %?, CONVERT_TZ(%?, '+00:00', @@TIME_ZONE), CONVERT_TZ(%?, '+00:00', @@TIME_ZONE))",
-			colID.TableID, colID.ID, lastUsedAt, lastAnalyzedAt,
+			"REPLACE INTO mysql.column_stats_usage (table_id, column_id, last_used_at, last_analyzed_at, usage_score) VALUES (%?, %?, CONVERT_TZ(%?, '+00:00', @@TIME_ZONE), CONVERT_TZ(%?, '+00:00', @@TIME_ZONE), %?)",
+			colID.TableID, colID.ID, lastUsedAt, lastAnalyzedAt, statsUsage.UsageScore,
 		)
 		if err != nil {
 			return errors.Trace(err)
@@ -160,6 +188,37 @@ func SaveColumnStatsUsageForTable(
 	return nil
 }
 
+// CopyColumnStatsUsage copies the column stats usage information of the old tables to the new ones, ids maps the
+// IDs of the new tables to the ones of the old tables. The columns aren't analyzed in the new tables, so the last
+// analyzed time of the copied rows is kept as is if they exist, and NULL otherwise.
+func CopyColumnStatsUsage(ctx context.Context, sctx sessionctx.Context, ids map[int64]int64) error {
+	for newID, oldID := range ids {
+		_, _, err := utilstats.ExecRowsWithCtx(
+			ctx, sctx,
+			"INSERT INTO mysql.column_stats_usage (table_id, column_id, last_used_at, usage_score, point_predicates, narrow_range_predicates, wide_range_predicates) "+
+				"SELECT %?, column_id, last_used_at, usage_score, point_predicates, narrow_range_predicates, wide_range_predicates FROM mysql.column_stats_usage WHERE table_id = %? "+
+				"ON DUPLICATE KEY UPDATE last_used_at = VALUES(last_used_at), usage_score = VALUES(usage_score), point_predicates = VALUES(point_predicates), "+
+				"narrow_range_predicates = VALUES(narrow_range_predicates), wide_range_predicates = VALUES(wide_range_predicates)",
+			newID, oldID,
+		)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// ChangeColumnStatsUsageColumnID moves the column stats usage information of the column to its new ID, which is
+// allocated when the column is rebuilt by MODIFY COLUMN. The column isn't analyzed under the new ID yet.
+func ChangeColumnStatsUsageColumnID(ctx context.Context, sctx sessionctx.Context, tableID, oldColID, newColID int64) error {
+	_, _, err := utilstats.ExecRowsWithCtx(
+		ctx, sctx,
+		"UPDATE mysql.column_stats_usage SET column_id = %?, last_analyzed_at = NULL WHERE table_id = %? AND column_id = %?",
+		newColID, tableID, oldColID,
+	)
+	return errors.Trace(err)
+}
+
 // CollectColumnsInExtendedStats returns IDs of the columns involved in extended stats.
 func CollectColumnsInExtendedStats(sctx sessionctx.Context, tableID int64) ([]int64, error) {
 	const sql = "SELECT name, type, column_ids FROM mysql.stats_extended WHERE table_id = %? and status in (%?, %?)"
@@ -0,0 +1,268 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
+	"github.com/pingcap/tidb/pkg/statistics/handle/storage"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+	utilstats "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"go.uber.org/zap"
+)
+
+const (
+	// regionDeltaMinRows and regionDeltaMinRatio are the lower bounds of the rows changed out of the sessions to be
+	// added to the stats delta, since the approximate keys of the regions are rough.
+	regionDeltaMinRows  = 10000
+	regionDeltaMinRatio = 0.1
+	// maxRegionDeltaTablesPerRound is the max number of the tables observed in each round, the regions of each table
+	// are requested from the PD.
+	maxRegionDeltaTablesPerRound = 256
+
+	selectStatsMetaCountsSQL = "select table_id, count, snapshot from mysql.stats_meta where table_id in (%?)"
+)
+
+// regionDeltaConfirmDelay is how long a change not explained by stats_meta has to last before it's taken as made out of
+// the sessions. The changes made by the sessions of the other instances are dumped in dumpStatsMaxDuration, and the
+// approximate keys are reported by the region heartbeats every minute. The delay is never shorter than the GC lifetime,
+// so the keys of the old versions and the tombstones, which are removed after the GC, don't last for the delay.
+var regionDeltaConfirmDelay = dumpStatsMaxDuration + time.Minute
+
+// regionDeltaBaseline is the baseline of a physical table to tell the rows changed out of the sessions.
+type regionDeltaBaseline struct {
+	// gap is the approximate keys of the record regions minus the row count in stats_meta and the pending delta. It
+	// keeps stable when the rows are changed by the sessions, since both of them change with the rows.
+	gap int64
+	// snapshot is the snapshot of the last analyze in stats_meta. The count is corrected by the analyze, so the
+	// baseline is taken again then.
+	snapshot uint64
+	// unexplained is the change seen since unexplainedSince, which waits for regionDeltaConfirmDelay.
+	unexplained      int64
+	unexplainedSince time.Time
+}
+
+// regionRowDeltas keeps the baselines of the physical tables observed by ObserveRegionRowDeltas.
+type regionRowDeltas struct {
+	mu        sync.Mutex
+	baselines map[int64]*regionDeltaBaseline
+	// cursor is the largest table ID observed in the last round, the tables are observed in the order of their IDs.
+	cursor int64
+}
+
+func newRegionRowDeltas() *regionRowDeltas {
+	return &regionRowDeltas{baselines: make(map[int64]*regionDeltaBaseline)}
+}
+
+// ObserveRegionRowDeltas compares the row counts of the physical tables estimated by the approximate keys of their
+// record regions reported by TiKV with the ones in stats_meta, and adds the rows changed out of the sessions, e.g. by
+// the physical import of lightning, the apply of TiCDC or the restore of BR, to the stats deltas, which are dumped to
+// stats_meta by DumpStatsDeltaToKV then. The keys include the old versions and the tombstones of the rows, so only the
+// change of the difference between the estimation and stats_meta since the first observation of the table is taken,
+// and it's confirmed by the MVCC properties of the regions, which count the live rows only, before it's added. The
+// tables whose data is in a single region aren't compared, since the region may be shared with the other tables.
+// All of them are approximate, so only the large changes are observed, and the row counts are still corrected by
+// the next analyze.
+func (s *statsUsageImpl) ObserveRegionRowDeltas() error {
+	s.SweepSessionStatsList()
+	pending := make(map[int64]int64)
+	for _, item := range s.SessionTableDelta().Pending() {
+		pending[item.TableID] = item.Delta
+	}
+	var changes map[int64]int64
+	err := utilstats.CallWithSCtxAndLabels(utilstats.StatsCtx, s.statsHandle.SPool(), utilstats.StatsOperationDumpDelta, 0, func(sctx sessionctx.Context) error {
+		is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+		tableIDs := s.regionDeltas.nextTables(physicalTableIDs(is, s.statsHandle))
+		if len(tableIDs) == 0 {
+			return nil
+		}
+		// Convert table IDs to strings since the SQL executor only accepts string arrays for IN clauses
+		stringIDs := make([]string, 0, len(tableIDs))
+		for _, id := range tableIDs {
+			stringIDs = append(stringIDs, strconv.FormatInt(id, 10))
+		}
+		rows, _, err := utilstats.ExecRows(sctx, selectStatsMetaCountsSQL, stringIDs)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		regions, err := storage.RegionKeysOfTables(sctx, tableIDs)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		confirmDelay := max(regionDeltaConfirmDelay, gcLifetime(sctx))
+		failpoint.Inject("skipRegionDeltaConfirmation", func() {
+			confirmDelay = 0
+		})
+		changes = make(map[int64]int64)
+		for _, row := range rows {
+			id, count, snapshot := row.GetInt64(0), int64(row.GetUint64(1)), row.GetUint64(2)
+			expected := count + pending[id]
+			unexplained := s.regionDeltas.observe(id, expected, snapshot, regions[id], now, confirmDelay)
+			if unexplained == 0 {
+				continue
+			}
+			mvccRows, ok, err := storage.MVCCRowsOfTable(utilstats.StatsCtx, sctx, id)
+			if err != nil {
+				return err
+			}
+			var delta int64
+			if ok {
+				delta = confirmByMVCCRows(unexplained, expected, mvccRows)
+			}
+			s.regionDeltas.settle(id, unexplained, delta)
+			if delta != 0 {
+				changes[id] = delta
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for id, delta := range changes {
+		statslogutil.StatsLogger().Info("rows changed out of the sessions are observed by the regions",
+			zap.Int64("tableID", id), zap.Int64("delta", delta))
+		s.SessionTableDelta().Update(id, delta, max(delta, -delta))
+	}
+	return nil
+}
+
+// gcLifetime returns the GC lifetime, in which the old versions and the tombstones of the rows are kept.
+func gcLifetime(sctx sessionctx.Context) time.Duration {
+	val, err := sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBGCLifetime)
+	if err != nil {
+		return 0
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// confirmByMVCCRows returns the part of the unexplained change of the approximate keys which is confirmed by the MVCC
+// rows of the regions. expected is the row count in stats_meta plus the pending delta. The keys of the old versions
+// and the tombstones, e.g. left by the DELETE or UPDATE statements, don't change the MVCC rows, so they're dropped.
+func confirmByMVCCRows(unexplained, expected, mvccRows int64) int64 {
+	mvccUnexplained := mvccRows - expected
+	var delta int64
+	if unexplained > 0 {
+		delta = max(min(unexplained, mvccUnexplained), 0)
+	} else {
+		delta = min(max(unexplained, mvccUnexplained), 0)
+	}
+	if max(delta, -delta) < regionDeltaThreshold(expected) {
+		return 0
+	}
+	return delta
+}
+
+// regionDeltaThreshold returns the least rows changed out of the sessions to be observed.
+func regionDeltaThreshold(expected int64) int64 {
+	return max(regionDeltaMinRows, int64(regionDeltaMinRatio*float64(max(expected, 0))))
+}
+
+// physicalTableIDs returns the IDs of the non-partitioned tables and the partitions in the stats cache.
+func physicalTableIDs(is infoschema.InfoSchema, h statstypes.StatsHandle) []int64 {
+	var ids []int64
+	for _, statsTbl := range h.Values() {
+		tbl, ok := h.TableInfoByID(is, statsTbl.PhysicalID)
+		if !ok || (tbl.Meta().ID == statsTbl.PhysicalID && tbl.Meta().GetPartitionInfo() != nil) {
+			continue
+		}
+		ids = append(ids, statsTbl.PhysicalID)
+	}
+	return ids
+}
+
+// nextTables returns the tables to be observed in this round, and removes the baselines of the tables no longer in ids.
+func (r *regionRowDeltas) nextTables(ids []int64) []int64 {
+	slices.Sort(ids)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id := range r.baselines {
+		if _, found := slices.BinarySearch(ids, id); !found {
+			delete(r.baselines, id)
+		}
+	}
+	if len(ids) <= maxRegionDeltaTablesPerRound {
+		return ids
+	}
+	start, _ := slices.BinarySearch(ids, r.cursor+1)
+	next := make([]int64, 0, maxRegionDeltaTablesPerRound)
+	next = append(next, ids[start:min(len(ids), start+maxRegionDeltaTablesPerRound)]...)
+	next = append(next, ids[:maxRegionDeltaTablesPerRound-len(next)]...)
+	r.cursor = next[len(next)-1]
+	return next
+}
+
+// observe returns the rows of the table changed out of the sessions, which are confirmed if they have lasted for
+// confirmDelay. expected is the row count in stats_meta plus the pending delta. The returned change must be passed to
+// settle with the part of it added to the stats delta.
+func (r *regionRowDeltas) observe(id, expected int64, snapshot uint64, regions storage.RegionKeys,
+	now time.Time, confirmDelay time.Duration) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	baseline, ok := r.baselines[id]
+	if regions.Regions <= 1 {
+		// The keys of a single region can't be told from the ones of the other tables. The rows of a tiny table are
+		// assumed to be the expected ones, so the rows imported into an empty table can still be observed after the
+		// region is split.
+		delete(r.baselines, id)
+		if expected < regionDeltaMinRows {
+			r.baselines[id] = &regionDeltaBaseline{snapshot: snapshot}
+		}
+		return 0
+	}
+	gap := regions.Keys - expected
+	if !ok || baseline.snapshot != snapshot {
+		r.baselines[id] = &regionDeltaBaseline{gap: gap, snapshot: snapshot}
+		return 0
+	}
+	unexplained := gap - baseline.gap
+	if max(unexplained, -unexplained) < regionDeltaThreshold(expected) {
+		baseline.unexplained, baseline.unexplainedSince = 0, time.Time{}
+		return 0
+	}
+	if baseline.unexplained == 0 || (baseline.unexplained > 0) != (unexplained > 0) {
+		baseline.unexplained, baseline.unexplainedSince = unexplained, now
+	}
+	if now.Sub(baseline.unexplainedSince) < confirmDelay {
+		return 0
+	}
+	baseline.unexplained, baseline.unexplainedSince = 0, time.Time{}
+	return unexplained
+}
+
+// settle takes the part of the unexplained change not added to the stats delta, i.e. the keys of the old versions and
+// the tombstones, into the baseline. The added delta gets into the pending deltas, so the gap of it gets back to the
+// baseline by itself.
+func (r *regionRowDeltas) settle(id, unexplained, delta int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if baseline, ok := r.baselines[id]; ok {
+		baseline.gap += unexplained - delta
+	}
+}
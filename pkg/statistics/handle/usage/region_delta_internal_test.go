@@ -0,0 +1,78 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/statistics/handle/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionRowDeltasConfirmation(t *testing.T) {
+	r := newRegionRowDeltas()
+	now := time.Now()
+	keys := func(n int64) storage.RegionKeys {
+		return storage.RegionKeys{Regions: 10, Keys: n}
+	}
+	require.Zero(t, r.observe(1, 100000, 1, keys(120000), now, time.Minute))
+	// The unexplained change has to last for the delay.
+	require.Zero(t, r.observe(1, 100000, 1, keys(170000), now, time.Minute))
+	require.Zero(t, r.observe(1, 100000, 1, keys(170000), now.Add(30*time.Second), time.Minute))
+	require.Equal(t, int64(50000), r.observe(1, 100000, 1, keys(170000), now.Add(time.Minute), time.Minute))
+	// The change is explained once it's in the pending deltas.
+	require.Zero(t, r.observe(1, 150000, 1, keys(170000), now.Add(2*time.Minute), time.Minute))
+	// A change which disappears before the delay, e.g. the delta dumped late by the other instances, is discarded.
+	require.Zero(t, r.observe(1, 150000, 1, keys(250000), now.Add(3*time.Minute), time.Minute))
+	require.Zero(t, r.observe(1, 230000, 1, keys(250000), now.Add(4*time.Minute), time.Minute))
+	require.Zero(t, r.observe(1, 230000, 1, keys(250000), now.Add(6*time.Minute), time.Minute))
+
+	// The tiny table in a single region is assumed to be accurate, so the rows imported into it are observed after
+	// the region is split.
+	require.Zero(t, r.observe(2, 0, 0, storage.RegionKeys{Regions: 1, Keys: 300}, now, 0))
+	require.Equal(t, int64(200000), r.observe(2, 0, 0, keys(200000), now, 0))
+	// The large table in a single region isn't observed.
+	require.Zero(t, r.observe(3, 20000, 0, storage.RegionKeys{Regions: 1, Keys: 300}, now, 0))
+	require.Zero(t, r.observe(3, 20000, 0, keys(200000), now, 0))
+	require.Zero(t, r.observe(3, 20000, 0, keys(200000), now, 0))
+}
+
+func TestRegionRowDeltasConfirmedByMVCCRows(t *testing.T) {
+	// The imported rows are confirmed.
+	require.Equal(t, int64(50000), confirmByMVCCRows(50000, 100000, 150000))
+	require.Equal(t, int64(-50000), confirmByMVCCRows(-50000, 100000, 40000))
+	// Only the part seen by both of them is confirmed.
+	require.Equal(t, int64(20000), confirmByMVCCRows(50000, 100000, 120000))
+	// The keys of the tombstones and the removal of them by the GC don't change the MVCC rows.
+	require.Zero(t, confirmByMVCCRows(50000, 100000, 100000))
+	require.Zero(t, confirmByMVCCRows(-50000, 100000, 100000))
+	require.Zero(t, confirmByMVCCRows(50000, 100000, 90000))
+	require.Zero(t, confirmByMVCCRows(50000, 100000, 105000))
+
+	// The change not confirmed is taken into the baseline, so it isn't observed again.
+	r := newRegionRowDeltas()
+	now := time.Now()
+	keys := func(n int64) storage.RegionKeys {
+		return storage.RegionKeys{Regions: 10, Keys: n}
+	}
+	require.Zero(t, r.observe(1, 100000, 1, keys(120000), now, 0))
+	require.Equal(t, int64(50000), r.observe(1, 100000, 1, keys(170000), now, 0))
+	r.settle(1, 50000, 0)
+	require.Zero(t, r.observe(1, 100000, 1, keys(170000), now, 0))
+	require.Equal(t, int64(-50000), r.observe(1, 100000, 1, keys(120000), now, 0))
+	r.settle(1, -50000, -20000)
+	require.Zero(t, r.observe(1, 80000, 1, keys(120000), now, 0))
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveRegionRowDeltas(t *testing.T) {
+	regionFP := "github.com/pingcap/tidb/pkg/statistics/handle/storage/mockRegionRowCount"
+	mvccFP := "github.com/pingcap/tidb/pkg/statistics/handle/storage/mockMVCCRowCount"
+	confirmFP := "github.com/pingcap/tidb/pkg/statistics/handle/usage/skipRegionDeltaConfirmation"
+	require.NoError(t, failpoint.Enable(confirmFP, "return"))
+	defer func() {
+		require.NoError(t, failpoint.Disable(confirmFP))
+		require.NoError(t, failpoint.Disable(regionFP))
+		require.NoError(t, failpoint.Disable(mvccFP))
+	}()
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t values (1), (2), (3), (4), (5), (6), (7), (8), (9), (10)")
+	h := dom.StatsHandle()
+	require.NoError(t, h.DumpStatsDeltaToKV(true))
+	tk.MustExec("analyze table t")
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	observe := func(regionKeys, mvccRows int) {
+		require.NoError(t, failpoint.Enable(regionFP, fmt.Sprintf("return(%d)", regionKeys)))
+		require.NoError(t, failpoint.Enable(mvccFP, fmt.Sprintf("return(%d)", mvccRows)))
+		require.NoError(t, h.ObserveRegionRowDeltas())
+		require.NoError(t, h.DumpStatsDeltaToKV(true))
+	}
+	statsMeta := fmt.Sprintf("select count, modify_count from mysql.stats_meta where table_id = %d", tbl.Meta().ID)
+
+	// The first observation takes the baseline, the keys of the old versions aren't taken as the rows.
+	observe(1000, 10)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("10 0"))
+
+	// The rows imported out of the sessions are added to stats_meta.
+	observe(101000, 100010)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("100010 100000"))
+	observe(101000, 100010)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("100010 100000"))
+
+	// The changes made by the sessions are explained by the stats deltas, even if they aren't dumped yet.
+	tk.MustExec("delete from t where a > 5")
+	observe(101000, 100005)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("100005 100005"))
+
+	// The tiny changes are taken as the errors of the approximate keys.
+	observe(101000-5000, 100005)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("100005 100005"))
+
+	// The keys of the tombstones and the old versions don't change the MVCC rows, neither does the GC of them.
+	observe(151000, 100005)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("100005 100005"))
+	observe(151000, 100005)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("100005 100005"))
+	observe(101000, 100005)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("100005 100005"))
+
+	// The rows deleted out of the sessions are removed from stats_meta.
+	observe(101000-50000, 50005)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("50005 150005"))
+
+	// The baseline is taken again after the table is analyzed.
+	tk.MustExec("analyze table t")
+	observe(500, 5)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("5 0"))
+	observe(80500, 80005)
+	tk.MustQuery(statsMeta).Check(testkit.Rows("80005 80000"))
+}
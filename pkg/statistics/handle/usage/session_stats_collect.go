@@ -17,10 +17,12 @@ package usage
 import (
 	"cmp"
 	"iter"
+	"maps"
 	"slices"
 	"strings"
 	"sync"
 	"time"
+	"unsafe"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
@@ -28,6 +30,8 @@ import (
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/metrics"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
 	"github.com/pingcap/tidb/pkg/statistics/handle/storage"
@@ -44,50 +48,134 @@ var (
 	DumpStatsDeltaRatio = 1 / 10000.0
 	// dumpStatsMaxDuration is the max duration since last update.
 	dumpStatsMaxDuration = 5 * time.Minute
+	// minDeltaFlushInterval is the min flush interval of the stats delta of a table, see deltaFlushInterval.
+	minDeltaFlushInterval = 5 * time.Second
 
 	// batchInsertSize is the batch size used by internal SQL to insert values to some system table.
 	batchInsertSize = 10
 )
 
+const (
+	// deltaFlushRows and deltaFlushRatio are the modified rows expected in the flush interval of a table, the smaller
+	// one is taken.
+	deltaFlushRows  = 10000
+	deltaFlushRatio = 0.01
+)
+
+// deltaDumpMode is the mode of dumping the stats deltas.
+type deltaDumpMode int
+
+const (
+	// dumpDeltaByRatio dumps the deltas whose `Modify Count / Table Count` exceed DumpStatsDeltaRatio, or which haven't
+	// been dumped in dumpStatsMaxDuration.
+	dumpDeltaByRatio deltaDumpMode = iota
+	// dumpDeltaDue dumps the deltas whose flush intervals have elapsed, see deltaFlushInterval.
+	dumpDeltaDue
+	// dumpDeltaAll dumps all the deltas.
+	dumpDeltaAll
+)
+
 // needDumpStatsDelta checks whether to dump stats delta.
-// 1. If the table doesn't exist or is a mem table or system table, then return false.
-// 2. If the mode is DumpAll, then return true.
-// 3. If the stats delta haven't been dumped in the past hour, then return true.
-// 4. If the table stats is pseudo or empty or `Modify Count / Table Count` exceeds the threshold.
-func (s *statsUsageImpl) needDumpStatsDelta(is infoschema.InfoSchema, dumpAll bool, id int64, item variable.TableDelta, currentTime time.Time) bool {
+// 1. If the table doesn't exist, then return false. If it is a mem table or system table, the delta is never dumped
+// and discard is true.
+// 2. If the mode is dumpDeltaAll, then return true.
+// 3. If the stats delta haven't been dumped in the past 5 minutes, then return true.
+// 4. If the mode is dumpDeltaDue, return whether the flush interval of the table has elapsed.
+// 5. If the table stats is pseudo or empty or `Modify Count / Table Count` exceeds the threshold.
+func (s *statsUsageImpl) needDumpStatsDelta(is infoschema.InfoSchema, mode deltaDumpMode, id int64, item variable.TableDelta, currentTime time.Time) (need, discard bool) {
 	tableItem, ok := s.statsHandle.TableItemByID(is, id)
 	if !ok {
-		return false
+		return false, false
 	}
 	if util.IsMemOrSysDB(tableItem.DBName.L) {
-		return false
+		return false, true
 	}
-	if dumpAll {
-		return true
+	if mode == dumpDeltaAll {
+		return true, false
 	}
 	if item.InitTime.IsZero() {
 		item.InitTime = currentTime
 	}
 	if currentTime.Sub(item.InitTime) > dumpStatsMaxDuration {
 		// Dump the stats to kv at least once 5 minutes.
-		return true
+		return true, false
 	}
 	statsTbl := s.statsHandle.GetPartitionStatsByID(is, id)
+	if mode == dumpDeltaDue {
+		var realtimeCount int64
+		if statsTbl != nil && !statsTbl.Pseudo {
+			realtimeCount = statsTbl.RealtimeCount
+		}
+		return currentTime.Sub(item.InitTime) >= deltaFlushInterval(item, realtimeCount, currentTime), false
+	}
 	if statsTbl == nil || statsTbl.Pseudo || statsTbl.RealtimeCount == 0 || float64(item.Count)/float64(statsTbl.RealtimeCount) > DumpStatsDeltaRatio {
 		// Dump the stats when there are many modifications.
-		return true
+		return true, false
 	}
-	return false
+	return false, false
+}
+
+// deltaFlushInterval returns the flush interval of the stats delta of a table, which is the time for the table to
+// modify deltaFlushRows rows, or deltaFlushRatio of its rows if it's smaller, at the write rate of the pending delta.
+// So the deltas of the hot tables are flushed every few seconds, which makes the staleness of their stats seen by the
+// auto analyze timely, while the ones of the cold tables are flushed rarely, bounded by dumpStatsMaxDuration.
+func deltaFlushInterval(item variable.TableDelta, realtimeCount int64, currentTime time.Time) time.Duration {
+	// The rate of a delta just created is taken over minDeltaFlushInterval, so a few rows don't make the table hot.
+	age := max(currentTime.Sub(item.InitTime), minDeltaFlushInterval)
+	rate := float64(item.Count) / age.Seconds()
+	if rate <= 0 {
+		return dumpStatsMaxDuration
+	}
+	rows := max(min(deltaFlushRows, deltaFlushRatio*float64(realtimeCount)), 1)
+	interval := time.Duration(rows / rate * float64(time.Second))
+	return min(max(interval, minDeltaFlushInterval), dumpStatsMaxDuration)
 }
 
 const (
-	dumpDeltaBatchSize = 100_000
+	// dumpDeltaBatchSize is the max number of the tables whose deltas are dumped in a transaction.
+	dumpDeltaBatchSize = 10_000
+	// dumpDeltaConcurrency is the max number of the workers dumping the stats deltas, each of which has a transaction
+	// in flight at most.
+	dumpDeltaConcurrency = 8
+	// dumpDeltaQueueSize is the number of the batches waiting for each worker.
+	dumpDeltaQueueSize = 2
 	tooSlowThreshold   = 20 * time.Second
 )
 
 // DumpStatsDeltaToKV sweeps the whole list and updates the global map, then we dumps every table that held in map to KV.
 // If the mode is `DumpDelta`, it will only dump that delta info that `Modify Count / Table Count` greater than a ratio.
 func (s *statsUsageImpl) DumpStatsDeltaToKV(dumpAll bool) error {
+	mode := dumpDeltaByRatio
+	if dumpAll {
+		mode = dumpDeltaAll
+	}
+	return s.dumpStatsDeltaToKVWithFilter(mode, nil)
+}
+
+// DumpDueStatsDeltaToKV sweeps the whole list and dumps the stats deltas whose flush intervals have elapsed to KV. The
+// flush interval of each table adapts to its write rate, see deltaFlushInterval, so it's supposed to be called every
+// few seconds.
+func (s *statsUsageImpl) DumpDueStatsDeltaToKV() error {
+	return s.dumpStatsDeltaToKVWithFilter(dumpDeltaDue, nil)
+}
+
+// DumpStatsDeltaToKVByIDs sweeps the whole list and dumps the stats delta of the given tables or partitions to KV
+// immediately, regardless of the modification ratio. The deltas of the other tables are kept in memory.
+func (s *statsUsageImpl) DumpStatsDeltaToKVByIDs(tableIDs ...int64) error {
+	return s.dumpStatsDeltaToKVWithFilter(dumpDeltaAll, idSet(tableIDs))
+}
+
+// idSet converts the IDs to a set, which is never nil.
+func idSet(ids []int64) map[int64]struct{} {
+	set := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+// dumpStatsDeltaToKVWithFilter dumps the stats delta to KV. Only the tables in onlyIDs are dumped if it isn't nil.
+func (s *statsUsageImpl) dumpStatsDeltaToKVWithFilter(mode deltaDumpMode, onlyIDs map[int64]struct{}) error {
 	defer util.Recover(metrics.LabelStats, "DumpStatsDeltaToKV", nil, false)
 	start := time.Now()
 	defer func() {
@@ -99,6 +187,15 @@ func (s *statsUsageImpl) DumpStatsDeltaToKV(dumpAll bool) error {
 	deltaMap := s.SessionTableDelta().GetDeltaAndReset()
 	defer func() {
 		s.SessionTableDelta().Merge(deltaMap)
+		s.checkpointStatsDeltas()
+		pending, oldest := s.SessionTableDelta().PendingSummary()
+		utilstats.StatsDeltaMemTracker.ReplaceBytesUsed(int64(pending) * tableDeltaEntrySize)
+		metrics.StatsDeltaPendingTablesGauge.Set(float64(pending))
+		if pending == 0 {
+			metrics.StatsDeltaOldestPendingAgeGauge.Set(0)
+		} else {
+			metrics.StatsDeltaOldestPendingAgeGauge.Set(time.Since(oldest).Seconds())
+		}
 	}()
 	if time.Since(start) > tooSlowThreshold {
 		statslogutil.SingletonStatsSamplerLogger().Warn("Sweeping session list is too slow",
@@ -109,99 +206,164 @@ func (s *statsUsageImpl) DumpStatsDeltaToKV(dumpAll bool) error {
 	// Sort table IDs to ensure a consistent dump order to reduce the chance of deadlock.
 	tableIDs := make([]int64, 0, len(deltaMap))
 	for id := range deltaMap {
+		if _, ok := onlyIDs[id]; onlyIDs != nil && !ok {
+			continue
+		}
 		tableIDs = append(tableIDs, id)
 	}
 	slices.Sort(tableIDs)
 
-	// Dump stats delta in batches.
-	for i := 0; i < len(tableIDs); i += dumpDeltaBatchSize {
-		end := i + dumpDeltaBatchSize
-		if end > len(tableIDs) {
-			end = len(tableIDs)
-		}
-
-		batchTableIDs := tableIDs[i:end]
-		var (
-			statsVersion uint64
-			batchUpdates []*storage.DeltaUpdate
-		)
-		batchStart := time.Now()
-		err := utilstats.CallWithSCtx(s.statsHandle.SPool(), func(sctx sessionctx.Context) error {
-			is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
-			batchUpdates = make([]*storage.DeltaUpdate, 0, len(batchTableIDs))
-			// Collect all updates in the batch.
-			for _, id := range batchTableIDs {
-				item := deltaMap[id]
-				if !s.needDumpStatsDelta(is, dumpAll, id, item, batchStart) {
-					continue
-				}
-				batchUpdates = append(batchUpdates, storage.NewDeltaUpdate(id, item, false))
+	// The deltas are hashed into the shards by their logical table IDs, so the deltas of the partitions and the global
+	// stats of a table are always dumped by the same worker, and the workers never lock the same rows of stats_meta.
+	// The deltas fitting in a batch are still dumped in a single transaction.
+	batchSize := dumpDeltaBatchSize
+	failpoint.Inject("mockDumpDeltaBatchSize", func(val failpoint.Value) {
+		batchSize = val.(int)
+	})
+	shardCount := min(dumpDeltaConcurrency, (len(tableIDs)+batchSize-1)/batchSize)
+	if shardCount == 0 {
+		return nil
+	}
+	workers := make([]*deltaDumpWorker, shardCount)
+	var wg util.WaitGroupWrapper
+	for i := range workers {
+		w := &deltaDumpWorker{batches: make(chan []*storage.DeltaUpdate, dumpDeltaQueueSize)}
+		workers[i] = w
+		wg.Run(func() { s.runDeltaDumpWorker(w) })
+	}
+	err := utilstats.CallWithSCtxAndLabels(utilstats.StatsCtx, s.statsHandle.SPool(), utilstats.StatsOperationDumpDelta, 0, func(sctx sessionctx.Context) error {
+		is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+		collectStart := time.Now()
+		batches := make([][]*storage.DeltaUpdate, shardCount)
+		for _, id := range tableIDs {
+			item := deltaMap[id]
+			need, discard := s.needDumpStatsDelta(is, mode, id, item, collectStart)
+			if discard {
+				// Don't keep the delta in memory forever, which makes the pending deltas look stuck.
+				delete(deltaMap, id)
 			}
-			if time.Since(batchStart) > tooSlowThreshold {
-				statslogutil.SingletonStatsSamplerLogger().Warn("Collecting batch updates is too slow",
-					zap.Int("tableCount", len(batchUpdates)),
-					zap.Duration("duration", time.Since(batchStart)))
+			if !need {
+				continue
 			}
-
-			if len(batchUpdates) == 0 {
-				return nil
+			shardKey := id
+			if tblID, ok := is.TableIDByPartitionID(id); ok {
+				shardKey = tblID
 			}
-
-			// Process all updates in the batch with a single transaction.
-			// Note: batchUpdates may be modified in dumpStatsDeltaToKV. (e.g. sorting, updating IsLocked)
-			startTs, updated, err := s.dumpStatsDeltaToKV(is, sctx, batchUpdates)
-			if err != nil {
-				return errors.Trace(err)
+			shard := shardKey % int64(shardCount)
+			batches[shard] = append(batches[shard], storage.NewDeltaUpdate(id, item, false))
+			if len(batches[shard]) >= batchSize {
+				// It blocks if the worker falls behind, which bounds the batches waiting in memory.
+				workers[shard].batches <- batches[shard]
+				batches[shard] = nil
 			}
-			statsVersion = startTs
-			// Note: Ensure we use the updated slice after dumpStatsDeltaToKV,
-			// because dumpStatsDeltaToKV may modify the underlying array of batchUpdates.
-			// For example, dumpStatsDeltaToKV may sort the array.
-			batchUpdates = updated
-			intest.AssertFunc(
-				func() bool {
-					return slices.IsSortedFunc(batchUpdates, func(i, j *storage.DeltaUpdate) int {
-						return cmp.Compare(i.TableID, j.TableID)
-					})
-				},
-				"batchUpdates should be sorted by table ID",
-			)
-
-			// Update deltaMap after the batch is successfully dumped.
-			for _, update := range batchUpdates {
-				delete(deltaMap, update.TableID)
+		}
+		for shard, batch := range batches {
+			if len(batch) > 0 {
+				workers[shard].batches <- batch
 			}
+		}
+		if time.Since(collectStart) > tooSlowThreshold {
+			statslogutil.SingletonStatsSamplerLogger().Warn("Collecting batch updates is too slow",
+				zap.Int("tableCount", len(tableIDs)),
+				zap.Duration("duration", time.Since(collectStart)))
+		}
+		return nil
+	})
+	for _, w := range workers {
+		close(w.batches)
+	}
+	wg.Wait()
+	for _, w := range workers {
+		// Update deltaMap after the batches are successfully dumped.
+		for _, id := range w.dumped {
+			delete(deltaMap, id)
+		}
+		if err == nil {
+			err = w.err
+		}
+	}
+	return errors.Trace(err)
+}
 
-			if time.Since(batchStart) > tooSlowThreshold {
-				statslogutil.SingletonStatsSamplerLogger().Warn("Dumping batch updates is too slow",
-					zap.Int("tableCount", len(batchUpdates)),
-					zap.Duration("duration", time.Since(batchStart)))
-			}
+// deltaDumpWorker dumps the batches of the stats deltas of a shard one by one, each in a transaction.
+type deltaDumpWorker struct {
+	batches chan []*storage.DeltaUpdate
+	// dumped is the tables whose deltas have been dumped, which are removed from the pending deltas after the worker
+	// exits.
+	dumped []int64
+	err    error
+}
+
+func (s *statsUsageImpl) runDeltaDumpWorker(w *deltaDumpWorker) {
+	for batch := range w.batches {
+		// The batches after a failure are still received, so the collection isn't blocked.
+		if w.err == nil {
+			w.err = s.dumpStatsDeltaBatch(w, batch)
+		}
+	}
+}
 
-			return nil
-		}, utilstats.FlagWrapTxn)
+// dumpStatsDeltaBatch dumps a batch of the stats deltas in a transaction, and records the historical stats meta of the
+// tables then.
+func (s *statsUsageImpl) dumpStatsDeltaBatch(w *deltaDumpWorker, batchUpdates []*storage.DeltaUpdate) error {
+	defer util.Recover(metrics.LabelStats, "dumpStatsDeltaBatch", nil, false)
+	var statsVersion uint64
+	batchStart := time.Now()
+	err := utilstats.CallWithSCtxAndLabels(utilstats.StatsCtx, s.statsHandle.SPool(), utilstats.StatsOperationDumpDelta, 0, func(sctx sessionctx.Context) error {
+		is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+		// Process all updates in the batch with a single transaction.
+		// Note: batchUpdates may be modified in dumpStatsDeltaToKV. (e.g. sorting, updating IsLocked)
+		startTs, updated, err := s.dumpStatsDeltaToKV(is, sctx, batchUpdates)
 		if err != nil {
 			return errors.Trace(err)
 		}
-		startRecordHistoricalStatsMeta := time.Now()
-		unlockedTableIDs := make([]int64, 0, len(batchUpdates))
-		for _, update := range batchUpdates {
-			if !update.IsLocked {
-				failpoint.Inject("panic-when-record-historical-stats-meta", func() {
-					panic("panic when record historical stats meta")
+		statsVersion = startTs
+		// Note: Ensure we use the updated slice after dumpStatsDeltaToKV,
+		// because dumpStatsDeltaToKV may modify the underlying array of batchUpdates.
+		// For example, dumpStatsDeltaToKV may sort the array.
+		batchUpdates = updated
+		intest.AssertFunc(
+			func() bool {
+				return slices.IsSortedFunc(batchUpdates, func(i, j *storage.DeltaUpdate) int {
+					return cmp.Compare(i.TableID, j.TableID)
 				})
-				unlockedTableIDs = append(unlockedTableIDs, update.TableID)
-			}
-		}
-		s.statsHandle.RecordHistoricalStatsMeta(statsVersion, "flush stats", false, unlockedTableIDs...)
-		// Log a warning if recording historical stats meta takes too long, as it can be slow for large table counts
-		if time.Since(startRecordHistoricalStatsMeta) > time.Minute*15 {
-			statslogutil.SingletonStatsSamplerLogger().Warn("Recording historical stats meta is too slow",
-				zap.Int("tableCount", len(batchUpdates)),
-				zap.Duration("duration", time.Since(startRecordHistoricalStatsMeta)))
+			},
+			"batchUpdates should be sorted by table ID",
+		)
+		return nil
+	}, utilstats.FlagWrapTxn)
+	if err != nil {
+		metrics.StatsDeltaFlushErrorCounter.Inc()
+		return errors.Trace(err)
+	}
+	for _, update := range batchUpdates {
+		w.dumped = append(w.dumped, update.TableID)
+	}
+	if time.Since(batchStart) > tooSlowThreshold {
+		statslogutil.SingletonStatsSamplerLogger().Warn("Dumping batch updates is too slow",
+			zap.Int("tableCount", len(batchUpdates)),
+			zap.Duration("duration", time.Since(batchStart)))
+	}
+	if len(batchUpdates) > 0 {
+		metrics.StatsDeltaFlushBatchSizeHistogram.Observe(float64(len(batchUpdates)))
+	}
+	startRecordHistoricalStatsMeta := time.Now()
+	unlockedTableIDs := make([]int64, 0, len(batchUpdates))
+	for _, update := range batchUpdates {
+		if !update.IsLocked {
+			failpoint.Inject("panic-when-record-historical-stats-meta", func() {
+				panic("panic when record historical stats meta")
+			})
+			unlockedTableIDs = append(unlockedTableIDs, update.TableID)
 		}
 	}
-
+	s.statsHandle.RecordHistoricalStatsMeta(statsVersion, "flush stats", false, unlockedTableIDs...)
+	// Log a warning if recording historical stats meta takes too long, as it can be slow for large table counts
+	if time.Since(startRecordHistoricalStatsMeta) > time.Minute*15 {
+		statslogutil.SingletonStatsSamplerLogger().Warn("Recording historical stats meta is too slow",
+			zap.Int("tableCount", len(batchUpdates)),
+			zap.Duration("duration", time.Since(startRecordHistoricalStatsMeta)))
+	}
 	return nil
 }
 
@@ -313,6 +475,18 @@ func (s *statsUsageImpl) dumpStatsDeltaToKV(
 
 // DumpColStatsUsageToKV sweeps the whole list, updates the column stats usage map and dumps it to KV.
 func (s *statsUsageImpl) DumpColStatsUsageToKV() error {
+	return s.dumpColStatsUsageToKVWithFilter(nil)
+}
+
+// DumpColStatsUsageToKVByIDs sweeps the whole list and dumps the column stats usage of the given tables or
+// partitions to KV. The usage of the other tables is kept in memory.
+func (s *statsUsageImpl) DumpColStatsUsageToKVByIDs(tableIDs ...int64) error {
+	return s.dumpColStatsUsageToKVWithFilter(idSet(tableIDs))
+}
+
+// dumpColStatsUsageToKVWithFilter dumps the column stats usage to KV. Only the tables in onlyIDs are dumped if it
+// isn't nil.
+func (s *statsUsageImpl) dumpColStatsUsageToKVWithFilter(onlyIDs map[int64]struct{}) error {
 	defer util.Recover(metrics.LabelStats, "DumpColStatsUsageToKV", nil, false)
 	s.SweepSessionStatsList()
 	colMap := s.SessionStatsUsage().GetUsageAndReset()
@@ -325,6 +499,9 @@ func (s *statsUsageImpl) DumpColStatsUsageToKV() error {
 	}
 	pairs := make([]pair, 0, len(colMap))
 	for id, t := range colMap {
+		if _, ok := onlyIDs[id.TableID]; onlyIDs != nil && !ok {
+			continue
+		}
 		pairs = append(pairs, pair{tblColID: id, lastUsedAt: t.UTC().Format(types.TimeFormat)})
 	}
 	slices.SortFunc(pairs, func(i, j pair) int {
@@ -333,6 +510,8 @@ func (s *statsUsageImpl) DumpColStatsUsageToKV() error {
 		}
 		return cmp.Compare(i.tblColID.TableID, j.tblColID.TableID)
 	})
+	// Each dump adds 1 to the usage score of the used columns, and the old score decays over time.
+	halfLife := vardef.PredicateColumnsUsageHalfLife.Load()
 	// Use batch insert to reduce cost.
 	for i := 0; i < len(pairs); i += batchInsertSize {
 		end := i + batchInsertSize
@@ -340,17 +519,24 @@ func (s *statsUsageImpl) DumpColStatsUsageToKV() error {
 			end = len(pairs)
 		}
 		sql := new(strings.Builder)
-		sqlescape.MustFormatSQL(sql, "INSERT INTO mysql.column_stats_usage (table_id, column_id, last_used_at) VALUES ")
+		sqlescape.MustFormatSQL(sql, "INSERT INTO mysql.column_stats_usage (table_id, column_id, last_used_at, usage_score) VALUES ")
 		for j := i; j < end; j++ {
 			// Since we will use some session from session pool to execute the insert statement, we pass in UTC time here and covert it
 			// to the session's time zone when executing the insert statement. In this way we can make the stored time right.
-			sqlescape.MustFormatSQL(sql, "(%?, %?, CONVERT_TZ(%?, '+00:00', @@TIME_ZONE))", pairs[j].tblColID.TableID, pairs[j].tblColID.ID, pairs[j].lastUsedAt)
+			sqlescape.MustFormatSQL(sql, "(%?, %?, CONVERT_TZ(%?, '+00:00', @@TIME_ZONE), 1)", pairs[j].tblColID.TableID, pairs[j].tblColID.ID, pairs[j].lastUsedAt)
 			if j < end-1 {
 				sqlescape.MustFormatSQL(sql, ",")
 			}
 		}
-		sqlescape.MustFormatSQL(sql, " ON DUPLICATE KEY UPDATE last_used_at = CASE WHEN last_used_at IS NULL THEN VALUES(last_used_at) ELSE GREATEST(last_used_at, VALUES(last_used_at)) END")
-		if err := utilstats.CallWithSCtx(s.statsHandle.SPool(), func(sctx sessionctx.Context) error {
+		// The usage score must be updated before last_used_at, because it decays from the old last_used_at.
+		sqlescape.MustFormatSQL(sql, " ON DUPLICATE KEY UPDATE ")
+		if halfLife > 0 {
+			sqlescape.MustFormatSQL(sql, "usage_score = IF(last_used_at IS NULL, 0, GREATEST(usage_score, 1) * POW(0.5, GREATEST(TIMESTAMPDIFF(SECOND, last_used_at, VALUES(last_used_at)), 0) / %?)) + 1, ", halfLife.Seconds())
+		} else {
+			sqlescape.MustFormatSQL(sql, "usage_score = IF(last_used_at IS NULL, 0, GREATEST(usage_score, 1)) + 1, ")
+		}
+		sqlescape.MustFormatSQL(sql, "last_used_at = CASE WHEN last_used_at IS NULL THEN VALUES(last_used_at) ELSE GREATEST(last_used_at, VALUES(last_used_at)) END")
+		if err := utilstats.CallWithSCtxAndLabels(utilstats.StatsCtx, s.statsHandle.SPool(), utilstats.StatsOperationDumpDelta, 0, func(sctx sessionctx.Context) error {
 			_, _, err := utilstats.ExecRows(sctx, sql.String())
 			return err
 		}); err != nil {
@@ -361,7 +547,78 @@ func (s *statsUsageImpl) DumpColStatsUsageToKV() error {
 			delete(colMap, pairs[j].tblColID)
 		}
 	}
-	return nil
+	return s.dumpPredicateSelectivityToKV(onlyIDs)
+}
+
+// dumpPredicateSelectivityToKV adds the counts of the classes of the selectivity of the predicates to
+// mysql.column_stats_usage. The counts of the partitions are added to their tables, as the predicate columns are
+// tracked. Only the tables in onlyIDs are dumped if it isn't nil.
+func (s *statsUsageImpl) dumpPredicateSelectivityToKV(onlyIDs map[int64]struct{}) error {
+	countsMap := s.SessionStatsUsage().GetPredicateSelectivityAndReset()
+	defer func() {
+		s.SessionStatsUsage().MergePredicateSelectivity(countsMap)
+	}()
+	if len(countsMap) == 0 {
+		return nil
+	}
+	return utilstats.CallWithSCtxAndLabels(utilstats.StatsCtx, s.statsHandle.SPool(), utilstats.StatsOperationDumpDelta, 0, func(sctx sessionctx.Context) error {
+		is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+		merged := make(map[model.TableItemID]PredicateSelectivityCounts, len(countsMap))
+		physicalIDs := make(map[model.TableItemID][]model.TableItemID, len(countsMap))
+		for id, counts := range countsMap {
+			if _, ok := onlyIDs[id.TableID]; onlyIDs != nil && !ok {
+				continue
+			}
+			tableItem, ok := s.statsHandle.TableItemByID(is, id.TableID)
+			if !ok || util.IsMemOrSysDB(tableItem.DBName.L) {
+				delete(countsMap, id)
+				continue
+			}
+			tblColID := id
+			if tblID, ok := is.TableIDByPartitionID(id.TableID); ok {
+				tblColID.TableID = tblID
+			}
+			sum := merged[tblColID]
+			sum.Point += counts.Point
+			sum.NarrowRange += counts.NarrowRange
+			sum.WideRange += counts.WideRange
+			merged[tblColID] = sum
+			physicalIDs[tblColID] = append(physicalIDs[tblColID], id)
+		}
+		tblColIDs := slices.SortedFunc(maps.Keys(merged), func(i, j model.TableItemID) int {
+			return cmp.Or(cmp.Compare(i.TableID, j.TableID), cmp.Compare(i.ID, j.ID))
+		})
+		for i := 0; i < len(tblColIDs); i += batchInsertSize {
+			end := min(i+batchInsertSize, len(tblColIDs))
+			sql := new(strings.Builder)
+			sqlescape.MustFormatSQL(sql, "INSERT INTO mysql.column_stats_usage (table_id, column_id, point_predicates, narrow_range_predicates, wide_range_predicates) VALUES ")
+			for j := i; j < end; j++ {
+				counts := merged[tblColIDs[j]]
+				sqlescape.MustFormatSQL(sql, "(%?, %?, %?, %?, %?)", tblColIDs[j].TableID, tblColIDs[j].ID, counts.Point, counts.NarrowRange, counts.WideRange)
+				if j < end-1 {
+					sqlescape.MustFormatSQL(sql, ",")
+				}
+			}
+			sqlescape.MustFormatSQL(sql, " ON DUPLICATE KEY UPDATE point_predicates = point_predicates + VALUES(point_predicates), "+
+				"narrow_range_predicates = narrow_range_predicates + VALUES(narrow_range_predicates), "+
+				"wide_range_predicates = wide_range_predicates + VALUES(wide_range_predicates)")
+			if _, _, err := utilstats.ExecRows(sctx, sql.String()); err != nil {
+				return errors.Trace(err)
+			}
+			for j := i; j < end; j++ {
+				for _, id := range physicalIDs[tblColIDs[j]] {
+					delete(countsMap, id)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// PendingStatsDeltas returns the stats deltas not yet persisted to KV, including the ones held by the sessions.
+func (s *statsUsageImpl) PendingStatsDeltas() []variable.TableDelta {
+	s.SweepSessionStatsList()
+	return s.SessionTableDelta().Pending()
 }
 
 // NewSessionStatsItem allocates a stats collector for a session.
@@ -372,6 +629,7 @@ func (s *statsUsageImpl) NewSessionStatsItem() any {
 func merge(s *SessionStatsItem, deltaMap *TableDelta, colMap *StatsUsage) {
 	deltaMap.Merge(s.mapper.GetDeltaAndReset())
 	colMap.Merge(s.statsUsage.GetUsageAndReset())
+	colMap.MergePredicateSelectivity(s.statsUsage.GetPredicateSelectivityAndReset())
 }
 
 // SessionStatsItem is a list item that holds the delta mapper. If you want to write or read mapper, you must lock it.
@@ -416,6 +674,14 @@ func (s *SessionStatsItem) UpdateColStatsUsage(colItems iter.Seq[model.TableItem
 	s.statsUsage.MergeRawData(colItems, updateTime)
 }
 
+// UpdatePredicateSelectivity counts the classes of the selectivity of the predicates on the columns estimated for a
+// statement, see stmtctx.PointPredicate.
+func (s *SessionStatsItem) UpdatePredicateSelectivity(classes map[model.TableItemID]uint8) {
+	s.Lock()
+	defer s.Unlock()
+	s.statsUsage.AddPredicateSelectivity(classes)
+}
+
 // SessionStatsList is a list of SessionStatsItem, which is used to collect stats usage and table delta information from sessions.
 // TODO: merge SessionIndexUsage into this list.
 /*
@@ -493,6 +759,7 @@ func (sl *SessionStatsList) SweepSessionStatsList() {
 	prev.Unlock()
 	sl.tableDelta.Merge(deltaMap.GetDeltaAndReset())
 	sl.statsUsage.Merge(colMap.GetUsageAndReset())
+	sl.statsUsage.MergePredicateSelectivity(colMap.GetPredicateSelectivityAndReset())
 }
 
 // SessionTableDelta returns the current *TableDelta.
@@ -558,28 +825,87 @@ func (m *TableDelta) Merge(deltaMap map[int64]variable.TableDelta) {
 	defer m.lock.Unlock()
 	for id, item := range deltaMap {
 		UpdateTableDeltaMap(m.delta, id, item.Delta, item.Count)
+		// Keep the time of the earliest change, so that the age of the delta isn't reset by the merge.
+		if merged := m.delta[id]; !item.InitTime.IsZero() && item.InitTime.Before(merged.InitTime) {
+			merged.InitTime = item.InitTime
+			m.delta[id] = merged
+		}
+	}
+}
+
+// Pending returns the copies of the deltas held by the TableDelta, with the TableID filled.
+func (m *TableDelta) Pending() []variable.TableDelta {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ret := make([]variable.TableDelta, 0, len(m.delta))
+	for id, item := range m.delta {
+		item.TableID = id
+		ret = append(ret, item)
 	}
+	return ret
 }
 
-// UpdateTableDeltaMap updates the delta of the table.
+// PendingSummary returns the number of the tables held by the TableDelta and the time of the oldest delta.
+func (m *TableDelta) PendingSummary() (count int, oldest time.Time) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, item := range m.delta {
+		if oldest.IsZero() || item.InitTime.Before(oldest) {
+			oldest = item.InitTime
+		}
+	}
+	return len(m.delta), oldest
+}
+
+// tableDeltaEntrySize is the approximate memory usage of an entry of the delta map, including the key.
+const tableDeltaEntrySize = int64(unsafe.Sizeof(variable.TableDelta{})) + 8
+
+// UpdateTableDeltaMap updates the delta of the table. The InitTime is set when the table is first changed.
 func UpdateTableDeltaMap(m map[int64]variable.TableDelta, id int64, delta int64, count int64) {
 	item := m[id]
 	item.Delta += delta
 	item.Count += count
+	if item.InitTime.IsZero() {
+		item.InitTime = time.Now()
+	}
 	m[id] = item
 }
 
-// StatsUsage maps (tableID, columnID) to the last time when the column stats are used(needed).
+// StatsUsage maps (tableID, columnID) to the last time when the column stats are used(needed), and the number of the
+// statements whose predicates on the column are of each class of the selectivity.
 // All methods of it are thread-safe.
 type StatsUsage struct {
-	usage map[model.TableItemID]time.Time
-	lock  sync.RWMutex
+	usage       map[model.TableItemID]time.Time
+	selectivity map[model.TableItemID]PredicateSelectivityCounts
+	lock        sync.RWMutex
+}
+
+// PredicateSelectivityCounts is the number of the statements whose predicates on a column are of each class of the
+// selectivity, see stmtctx.PointPredicate.
+type PredicateSelectivityCounts struct {
+	Point       int64
+	NarrowRange int64
+	WideRange   int64
+}
+
+// add adds the classes of a statement to the counts.
+func (c *PredicateSelectivityCounts) add(classes uint8) {
+	if classes&stmtctx.PointPredicate != 0 {
+		c.Point++
+	}
+	if classes&stmtctx.NarrowRangePredicate != 0 {
+		c.NarrowRange++
+	}
+	if classes&stmtctx.WideRangePredicate != 0 {
+		c.WideRange++
+	}
 }
 
 // NewStatsUsage creates a new StatsUsage.
 func NewStatsUsage() *StatsUsage {
 	return &StatsUsage{
-		usage: make(map[model.TableItemID]time.Time),
+		usage:       make(map[model.TableItemID]time.Time),
+		selectivity: make(map[model.TableItemID]PredicateSelectivityCounts),
 	}
 }
 
@@ -588,6 +914,43 @@ func (m *StatsUsage) Reset() {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	m.usage = make(map[model.TableItemID]time.Time)
+	m.selectivity = make(map[model.TableItemID]PredicateSelectivityCounts)
+}
+
+// AddPredicateSelectivity counts the classes of the selectivity of the predicates on the columns of a statement.
+func (m *StatsUsage) AddPredicateSelectivity(classes map[model.TableItemID]uint8) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for id, c := range classes {
+		counts := m.selectivity[id]
+		counts.add(c)
+		m.selectivity[id] = counts
+	}
+}
+
+// GetPredicateSelectivityAndReset gets the counts of the classes of the selectivity and resets them.
+func (m *StatsUsage) GetPredicateSelectivityAndReset() map[model.TableItemID]PredicateSelectivityCounts {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ret := m.selectivity
+	m.selectivity = make(map[model.TableItemID]PredicateSelectivityCounts)
+	return ret
+}
+
+// MergePredicateSelectivity merges the counts of the classes of the selectivity into the StatsUsage.
+func (m *StatsUsage) MergePredicateSelectivity(other map[model.TableItemID]PredicateSelectivityCounts) {
+	if len(other) == 0 {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for id, c := range other {
+		counts := m.selectivity[id]
+		counts.Point += c.Point
+		counts.NarrowRange += c.NarrowRange
+		counts.WideRange += c.WideRange
+		m.selectivity[id] = counts
+	}
 }
 
 // GetUsageAndReset gets the usage and resets the StatsUsage.
@@ -16,7 +16,9 @@ package usage
 
 import (
 	"testing"
+	"time"
 
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/stretchr/testify/require"
 )
 
@@ -41,3 +43,53 @@ func TestInsertAndDelete(t *testing.T) {
 	sl.SweepSessionStatsList()
 	require.Nil(t, sl.listHead.next)
 }
+
+func TestTableDeltaInitTime(t *testing.T) {
+	sl := NewSessionStatsList()
+	item := sl.NewSessionStatsItem()
+	item.Update(1, 1, 1)
+	sl.SweepSessionStatsList()
+	pending := sl.SessionTableDelta().Pending()
+	require.Len(t, pending, 1)
+	initTime := pending[0].InitTime
+	require.False(t, initTime.IsZero())
+	require.Equal(t, int64(1), pending[0].TableID)
+
+	// The later changes and the merge don't reset the time of the first change.
+	item.Update(1, 2, 2)
+	item.Update(2, 1, 1)
+	sl.SweepSessionStatsList()
+	deltaMap := sl.SessionTableDelta().GetDeltaAndReset()
+	require.Equal(t, initTime, deltaMap[1].InitTime)
+	require.Equal(t, int64(3), deltaMap[1].Count)
+	sl.SessionTableDelta().Update(1, 1, 1)
+	sl.SessionTableDelta().Merge(deltaMap)
+	count, oldest := sl.SessionTableDelta().PendingSummary()
+	require.Equal(t, 2, count)
+	require.Equal(t, initTime, oldest)
+
+	older := initTime.Add(-time.Minute)
+	sl.SessionTableDelta().Merge(map[int64]variable.TableDelta{2: {Delta: 1, Count: 1, InitTime: older}})
+	_, oldest = sl.SessionTableDelta().PendingSummary()
+	require.Equal(t, older, oldest)
+}
+
+func TestDeltaFlushInterval(t *testing.T) {
+	now := time.Now()
+	delta := func(count int64, age time.Duration) variable.TableDelta {
+		return variable.TableDelta{Delta: count, Count: count, InitTime: now.Add(-age)}
+	}
+	// A hot table is flushed every few seconds.
+	require.Equal(t, minDeltaFlushInterval, deltaFlushInterval(delta(100000, 10*time.Second), 1000000, now))
+	// 10000 rows are expected in the interval at 100 rows per second.
+	require.Equal(t, 100*time.Second, deltaFlushInterval(delta(1000, 10*time.Second), 1000000, now))
+	// 1% of the rows are expected if it's smaller, so the staleness of a small table is seen earlier.
+	require.Equal(t, 10*time.Second, deltaFlushInterval(delta(1000, 10*time.Second), 100000, now))
+	// A cold table is flushed rarely.
+	require.Equal(t, dumpStatsMaxDuration, deltaFlushInterval(delta(10, time.Minute), 1000000, now))
+	require.Equal(t, dumpStatsMaxDuration, deltaFlushInterval(delta(0, time.Minute), 1000000, now))
+	// A few rows changed just now don't make the table hot.
+	require.Equal(t, 50*time.Second, deltaFlushInterval(delta(1000, time.Millisecond), 10000000, now))
+	// The delta of an empty table is flushed soon.
+	require.Equal(t, minDeltaFlushInterval, deltaFlushInterval(delta(1, time.Second), 0, now))
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"github.com/pingcap/tidb/pkg/util/memory"
+	"github.com/pingcap/tidb/pkg/util/servermemorylimit"
+)
+
+// The names of the stats memory consumers registered with the server memory controller.
+const (
+	// MemoryConsumerStatsCache is the name of the stats cache consumer.
+	MemoryConsumerStatsCache = "stats cache"
+	// MemoryConsumerAnalyze is the name of the analyze jobs consumer.
+	MemoryConsumerAnalyze = "analyze"
+	// MemoryConsumerGlobalStatsMerge is the name of the global stats merge consumer.
+	MemoryConsumerGlobalStatsMerge = "global stats merge"
+	// MemoryConsumerStatsDelta is the name of the stats delta consumer.
+	MemoryConsumerStatsDelta = "stats delta"
+)
+
+var (
+	// StatsMemTracker is the root tracker of the memory used by the stats subsystems.
+	StatsMemTracker = memory.NewTracker(memory.LabelForStatsMemory, -1)
+	// StatsCacheMemTracker tracks the memory used by the stats cache.
+	StatsCacheMemTracker = newStatsMemTracker(memory.LabelForStatsCache)
+	// GlobalStatsMergeMemTracker tracks the memory used by the partition stats loaded to merge the global stats.
+	GlobalStatsMergeMemTracker = newStatsMemTracker(memory.LabelForGlobalStatsMerge)
+	// StatsDeltaMemTracker tracks the memory used by the stats deltas collected from the sessions.
+	StatsDeltaMemTracker = newStatsMemTracker(memory.LabelForStatsDelta)
+)
+
+func newStatsMemTracker(label int) *memory.Tracker {
+	t := memory.NewTracker(label, -1)
+	t.AttachTo(StatsMemTracker)
+	return t
+}
+
+// RegisterStatsMemoryConsumer registers a stats subsystem with the server memory controller, so its memory usage is
+// shown in information_schema.memory_usage_consumers and it's shrunk by shrink when tidb_server_memory_limit is
+// exceeded. shrink can be nil if the memory can't be released.
+func RegisterStatsMemoryConsumer(name string, tracker *memory.Tracker, shrink func() int64) {
+	servermemorylimit.RegisterMemoryConsumer(&servermemorylimit.MemoryConsumer{
+		Name:    name,
+		Tracker: tracker,
+		Shrink:  shrink,
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"runtime/pprof"
+	"strconv"
+
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/util"
+)
+
+// The pprof labels of the stats background work, so that the CPU/heap profiles and the goroutine dumps can attribute
+// the resource usage to the kind of the work, e.g. `go tool pprof -tagfocus stats_operation=analyze`.
+const (
+	// PprofLabelStatsOperation is the label of the kind of the stats work.
+	PprofLabelStatsOperation = "stats_operation"
+	// PprofLabelStatsTableID is the label of the table or partition the stats work is processing.
+	PprofLabelStatsTableID = "stats_table_id"
+)
+
+// The values of PprofLabelStatsOperation.
+const (
+	// StatsOperationAnalyze is the auto analyze.
+	StatsOperationAnalyze = "analyze"
+	// StatsOperationMergeGlobalStats is the merge of the global stats of the partitioned tables.
+	StatsOperationMergeGlobalStats = "merge_global_stats"
+	// StatsOperationDumpDelta is the dump of the stats deltas and the usage data of the stats.
+	StatsOperationDumpDelta = "dump_delta"
+	// StatsOperationCacheRefresh is the refresh of the stats cache with the stats updated by the other instances.
+	StatsOperationCacheRefresh = "cache_refresh"
+	// StatsOperationLoad is the load of the histograms needed by the queries.
+	StatsOperationLoad = "load"
+	// StatsOperationInit is the initialization of the stats cache.
+	StatsOperationInit = "init"
+	// StatsOperationGC is the GC of the stats of the dropped tables and the outdated stats data.
+	StatsOperationGC = "gc"
+)
+
+// statsLabelsCtxKeyType is the type of the key of the labeled context saved in the pooled sessions.
+type statsLabelsCtxKeyType int
+
+func (statsLabelsCtxKeyType) String() string {
+	return "stats_labels_ctx"
+}
+
+const statsLabelsCtxKey statsLabelsCtxKeyType = 0
+
+// WithStatsLabels returns the ctx labeled with the stats operation and the table. The table isn't labeled if tableID
+// is 0.
+func WithStatsLabels(ctx context.Context, operation string, tableID int64) context.Context {
+	if tableID == 0 {
+		return pprof.WithLabels(ctx, pprof.Labels(PprofLabelStatsOperation, operation))
+	}
+	return pprof.WithLabels(ctx, pprof.Labels(PprofLabelStatsOperation, operation,
+		PprofLabelStatsTableID, strconv.FormatInt(tableID, 10)))
+}
+
+// LabelSCtx labels the current goroutine with the stats operation and the table, and so are the SQLs executed by the
+// sctx with Exec and ExecRows, which reset the labels of the goroutine to the ones of their context after execution
+// otherwise. The reads of the read-only operations are routed by tidb_stats_replica_read as well, see
+// useStatsReplicaRead. The returned function puts back the context of the sctx and the labels of the goroutine from
+// it, which are the ones of the caller of CallWithSCtxAndLabels, and restores the replica read of the sctx. It must be
+// called before the sctx is put back to the pool.
+func LabelSCtx(sctx sessionctx.Context, operation string, tableID int64) (done func()) {
+	parent, hasParent := sctx.Value(statsLabelsCtxKey).(context.Context)
+	ctx := WithStatsLabels(statsCtxOf(sctx), operation, tableID)
+	sctx.SetValue(statsLabelsCtxKey, ctx)
+	pprof.SetGoroutineLabels(ctx)
+	restoreReplicaRead := useStatsReplicaRead(sctx, operation)
+	return func() {
+		restoreReplicaRead()
+		if hasParent {
+			sctx.SetValue(statsLabelsCtxKey, parent)
+		} else {
+			sctx.ClearValue(statsLabelsCtxKey)
+		}
+		pprof.SetGoroutineLabels(statsCtxOf(sctx))
+	}
+}
+
+// CallWithSCtxAndLabels is like CallWithSCtxCtx, but the sctx and the goroutine are labeled with the stats operation
+// and the table during f, see LabelSCtx. ctx must carry the labels of the current goroutine, e.g. StatsCtx on the
+// goroutines without any label, which are put back after f.
+func CallWithSCtxAndLabels(ctx context.Context, pool util.DestroyableSessionPool, operation string, tableID int64,
+	f func(sctx sessionctx.Context) error, flags ...int) error {
+	return callWithSCtx(ctx, pool, operation, tableID, f, flags...)
+}
+
+// withStatsCtx makes the SQLs executed by the sctx with Exec and ExecRows run with ctx, which is labeled further by
+// LabelSCtx. The returned function removes ctx from the sctx.
+func withStatsCtx(ctx context.Context, sctx sessionctx.Context) (done func()) {
+	sctx.SetValue(statsLabelsCtxKey, kv.WithInternalSourceType(ctx, kv.InternalTxnStats))
+	return func() {
+		sctx.ClearValue(statsLabelsCtxKey)
+	}
+}
+
+// statsCtxOf returns the context to execute the SQLs of the stats by the session, which is labeled if the session is
+// got by CallWithSCtxAndLabels, and is the context of the caller if the session is got by CallWithSCtxCtx.
+func statsCtxOf(sctx sessionctx.Context) context.Context {
+	if ctx, ok := sctx.Value(statsLabelsCtxKey).(context.Context); ok {
+		return ctx
+	}
+	return StatsCtx
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+)
+
+// isReadOnlyStatsOperation returns whether the stats operation only reads the stats, so its SQLs can read from the
+// replicas other than the leaders.
+func isReadOnlyStatsOperation(operation string) bool {
+	switch operation {
+	case StatsOperationCacheRefresh, StatsOperationLoad, StatsOperationInit:
+		return true
+	}
+	return false
+}
+
+// useStatsReplicaRead routes the reads of the sctx to the replicas configured by tidb_stats_replica_read if the stats
+// operation only reads the stats. The follower reads are still strongly consistent since they are done by the read
+// index. The returned function restores the replica read of the sctx.
+func useStatsReplicaRead(sctx sessionctx.Context, operation string) (restore func()) {
+	if !isReadOnlyStatsOperation(operation) {
+		return func() {}
+	}
+	replicaRead, ok := variable.ParseReplicaRead(vardef.StatsReplicaRead.Load())
+	if !ok || replicaRead == kv.ReplicaReadLeader {
+		return func() {}
+	}
+	sessVars := sctx.GetSessionVars()
+	origin := sessVars.GetReplicaRead()
+	sessVars.SetReplicaRead(replicaRead)
+	return func() {
+		sessVars.SetReplicaRead(origin)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/kv"
+	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
+	storeerr "github.com/pingcap/tidb/pkg/store/driver/error"
+	"github.com/pingcap/tidb/pkg/util/backoff"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultRetryOnConflictBudget is the default max number of the retries of a call with FlagRetryOnConflict.
+	defaultRetryOnConflictBudget = 6
+	// defaultRetryOnConflictBaseBackoff and defaultRetryOnConflictMaxBackoff bound the exponential backoff between the
+	// retries by default, so the retries of a call last for about 2.5s at most, which covers a leader transfer of TiKV.
+	defaultRetryOnConflictBaseBackoff = 50 * time.Millisecond
+	defaultRetryOnConflictMaxBackoff  = time.Second
+)
+
+// RetryOnConflictPolicy is the retry budget and the backoff of a call with FlagRetryOnConflict. The zero fields take
+// the defaults, i.e. 6 retries with the backoff from 50ms to 1s.
+type RetryOnConflictPolicy struct {
+	// Budget is the max number of the retries.
+	Budget int
+	// BaseBackoff and MaxBackoff bound the exponential backoff between the retries.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// retryOnConflictPolicyKeyType is the type of the key of the RetryOnConflictPolicy in the context.
+type retryOnConflictPolicyKeyType struct{}
+
+// WithRetryOnConflictPolicy returns the ctx with which CallWithSCtxCtx retries the call of FlagRetryOnConflict by the
+// policy, e.g. a longer budget for the writes which are expensive to redo from the beginning.
+func WithRetryOnConflictPolicy(ctx context.Context, policy RetryOnConflictPolicy) context.Context {
+	return context.WithValue(ctx, retryOnConflictPolicyKeyType{}, policy)
+}
+
+func retryOnConflictPolicyOf(ctx context.Context) RetryOnConflictPolicy {
+	policy, _ := ctx.Value(retryOnConflictPolicyKeyType{}).(RetryOnConflictPolicy)
+	if policy.Budget <= 0 {
+		policy.Budget = defaultRetryOnConflictBudget
+	}
+	if policy.BaseBackoff <= 0 {
+		policy.BaseBackoff = defaultRetryOnConflictBaseBackoff
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = max(defaultRetryOnConflictMaxBackoff, policy.BaseBackoff)
+	}
+	return policy
+}
+
+// isRetryableOnConflict returns whether the error is a transient one which may disappear by retrying the transaction,
+// i.e. the write conflicts and the unavailable regions.
+func isRetryableOnConflict(err error) bool {
+	return kv.IsTxnRetryableError(err) || storeerr.ErrRegionUnavailable.Equal(err)
+}
+
+// retryOnConflict calls f and retries it with exponential backoff and jitter when it fails with a retryable error,
+// until the retry budget of the policy is used up or ctx is done. f must be idempotent, e.g. the statements are
+// wrapped in a transaction which is rolled back on error.
+func retryOnConflict(ctx context.Context, policy RetryOnConflictPolicy, f func() error) (err error) {
+	bo := backoff.NewExponential(policy.BaseBackoff, 2, policy.MaxBackoff)
+	for retryCnt := 0; ; retryCnt++ {
+		err = f()
+		if err == nil || retryCnt >= policy.Budget || !isRetryableOnConflict(err) {
+			return err
+		}
+		// The jitter spreads the retries of the concurrent callers conflicting with each other.
+		d := bo.Backoff(retryCnt)
+		d = d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+		statslogutil.StatsLogger().Info("retry the stats transaction on the transient error",
+			zap.Int("retryCnt", retryCnt+1),
+			zap.Duration("backoff", d),
+			zap.Error(err))
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/util/set"
+)
+
+// ShowStatsFilter is the filter pushed down to the reading of the stats by `SHOW STATS_META`, `SHOW STATS_HISTOGRAMS`,
+// `SHOW STATS_BUCKETS` and `SHOW STATS_TOPN`, so that the stats of the unmatched tables are never loaded.
+// The names in the sets are in lower case, and a nil set matches all the names.
+type ShowStatsFilter struct {
+	DBNames        set.StringSet
+	TableNames     set.StringSet
+	PartitionNames set.StringSet
+	ColumnNames    set.StringSet
+	// SkipRequest indicates that the predicates are always false, so no stats need to be read.
+	SkipRequest bool
+	// Limit is the max number of the rows needed, 0 means no limit.
+	Limit uint64
+}
+
+func matchName(names set.StringSet, name string) bool {
+	return names == nil || names.Exist(strings.ToLower(name))
+}
+
+// MatchDB returns whether the stats of the database should be read.
+func (f *ShowStatsFilter) MatchDB(name string) bool {
+	return f == nil || (!f.SkipRequest && matchName(f.DBNames, name))
+}
+
+// MatchTable returns whether the stats of the table should be read.
+func (f *ShowStatsFilter) MatchTable(name string) bool {
+	return f == nil || matchName(f.TableNames, name)
+}
+
+// MatchPartition returns whether the stats of the partition should be read. The partition name is empty for the
+// non-partitioned tables and "global" for the global stats.
+func (f *ShowStatsFilter) MatchPartition(name string) bool {
+	return f == nil || matchName(f.PartitionNames, name)
+}
+
+// MatchColumn returns whether the stats of the column or the index should be output.
+func (f *ShowStatsFilter) MatchColumn(name string) bool {
+	return f == nil || matchName(f.ColumnNames, name)
+}
+
+// LimitReached returns whether the number of the output rows reaches the limit.
+func (f *ShowStatsFilter) LimitReached(rows int) bool {
+	return f != nil && f.Limit > 0 && uint64(rows) >= f.Limit
+}
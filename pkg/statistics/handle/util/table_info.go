@@ -18,6 +18,8 @@ import (
 	"context"
 
 	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/autoid"
+	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/table"
 )
 
@@ -59,3 +61,53 @@ func (*tableInfoGetterImpl) TableItemByID(is infoschema.InfoSchema, id int64) (i
 	}
 	return is.TableItemByPartitionID(id)
 }
+
+// AutoIDBase returns the base of the local allocator of the auto IDs which are allocated one for each inserted
+// row, i.e. the auto_random IDs, the auto_increment IDs or the _tidb_rowid. It returns 0 if the table doesn't
+// allocate such IDs or no ID has been allocated in this instance.
+func AutoIDBase(tbl table.Table) int64 {
+	tblInfo := tbl.Meta()
+	var tp autoid.AllocatorType
+	switch {
+	case tblInfo.ContainsAutoRandomBits():
+		tp = autoid.AutoRandomType
+	case tblInfo.GetAutoIncrementColInfo() != nil:
+		tp = autoid.AutoIncrementType
+	case !tblInfo.PKIsHandle && !tblInfo.IsCommonHandle:
+		tp = autoid.RowIDAllocType
+	default:
+		return 0
+	}
+	alloc := tbl.Allocators(nil).Get(tp)
+	if alloc == nil {
+		return 0
+	}
+	return alloc.Base()
+}
+
+// MapPhysicalIDs maps the physical IDs of the new table to the ones of the old table, which is the truncated
+// table or the refer table of CREATE TABLE ... LIKE. Both of them keep the order of the partitions.
+func MapPhysicalIDs(newTableInfo, oldTableInfo *model.TableInfo) map[int64]int64 {
+	ids := map[int64]int64{newTableInfo.ID: oldTableInfo.ID}
+	newPi, oldPi := newTableInfo.GetPartitionInfo(), oldTableInfo.GetPartitionInfo()
+	if newPi == nil || oldPi == nil {
+		return ids
+	}
+	for i, def := range newPi.Definitions {
+		if i < len(oldPi.Definitions) {
+			ids[def.ID] = oldPi.Definitions[i].ID
+		}
+	}
+	return ids
+}
+
+// PhysicalIDs returns the IDs of the table and all its partitions.
+func PhysicalIDs(tblInfo *model.TableInfo) []int64 {
+	ids := []int64{tblInfo.ID}
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		for _, def := range pi.Definitions {
+			ids = append(ids, def.ID)
+		}
+	}
+	return ids
+}
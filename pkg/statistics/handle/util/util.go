@@ -16,6 +16,7 @@ package util
 
 import (
 	"context"
+	"math"
 	"strconv"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/sessiontxn"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/chunk"
@@ -49,6 +51,12 @@ const (
 	StatsMetaHistorySourceSchemaChange = "schema change"
 	// StatsMetaHistorySourceExtendedStats indicates stats history meta source from extended stats
 	StatsMetaHistorySourceExtendedStats = "extended stats"
+	// StatsMetaHistorySourceTTL indicates stats history meta source from the adjustment after TTL deletion
+	StatsMetaHistorySourceTTL = "ttl"
+	// StatsMetaHistorySourceImport indicates stats history meta source from the stats built during IMPORT INTO
+	StatsMetaHistorySourceImport = "import"
+	// StatsMetaHistorySourceQuickAnalyze indicates stats history meta source from ANALYZE TABLE ... QUICK
+	StatsMetaHistorySourceQuickAnalyze = "quick analyze"
 )
 
 var (
@@ -64,7 +72,8 @@ func finishTransaction(sctx sessionctx.Context, err error) error {
 	if err == nil {
 		_, _, err = ExecRows(sctx, "COMMIT")
 	} else {
-		_, _, err1 := ExecRows(sctx, "rollback")
+		// The rollback isn't cancelled with the context of CallWithSCtxCtx, or the transaction is left open.
+		_, _, err1 := ExecRowsWithCtx(context.WithoutCancel(statsCtxOf(sctx)), sctx, "rollback")
 		terror.Log(errors.Trace(err1))
 	}
 	return errors.Trace(err)
@@ -73,10 +82,44 @@ func finishTransaction(sctx sessionctx.Context, err error) error {
 var (
 	// FlagWrapTxn indicates whether to wrap a transaction.
 	FlagWrapTxn = 0
+	// FlagRetryOnConflict indicates whether to retry the call with backoff on the write conflicts and the unavailable
+	// regions, e.g. during the leader transfers of TiKV. It's usually used together with FlagWrapTxn. The retry budget
+	// and the backoff can be given by WithRetryOnConflictPolicy.
+	FlagRetryOnConflict = 1
+	// FlagWrapReadOnlyTxn indicates whether to wrap a read-only transaction, which reads the snapshot at the TS given
+	// by WithReadOnlyTxnTS or the latest one without any lock. It's ignored with FlagWrapTxn.
+	FlagWrapReadOnlyTxn = 2
 )
 
+// readOnlyTxnTSKeyType is the type of the key of the TS of the read-only transaction in the context.
+type readOnlyTxnTSKeyType struct{}
+
+// WithReadOnlyTxnTS returns the ctx with which CallWithSCtxCtx starts the read-only transaction of FlagWrapReadOnlyTxn
+// at ts. The latest snapshot is read if ts is 0.
+func WithReadOnlyTxnTS(ctx context.Context, ts uint64) context.Context {
+	return context.WithValue(ctx, readOnlyTxnTSKeyType{}, ts)
+}
+
+func readOnlyTxnTSOf(ctx context.Context) uint64 {
+	ts, _ := ctx.Value(readOnlyTxnTSKeyType{}).(uint64)
+	return ts
+}
+
 // CallWithSCtx allocates a sctx from the pool and call the f().
 func CallWithSCtx(pool util.DestroyableSessionPool, f func(sctx sessionctx.Context) error, flags ...int) (err error) {
+	return callWithSCtx(StatsCtx, pool, "", 0, f, flags...)
+}
+
+// CallWithSCtxCtx is like CallWithSCtx, but the SQLs executed by the sctx with Exec and ExecRows during f run with
+// ctx, so they're cancelled when ctx is done. The transaction wrapped by FlagWrapTxn is rolled back instead of
+// committed if ctx is done before f returns, and so are the retries of FlagRetryOnConflict stopped.
+func CallWithSCtxCtx(ctx context.Context, pool util.DestroyableSessionPool, f func(sctx sessionctx.Context) error, flags ...int) (err error) {
+	return callWithSCtx(ctx, pool, "", 0, f, flags...)
+}
+
+// callWithSCtx allocates a sctx from the pool and call the f(). The SQLs of the sctx run with ctx if it isn't the
+// default StatsCtx, and the sctx is labeled by LabelSCtx if operation isn't empty.
+func callWithSCtx(ctx context.Context, pool util.DestroyableSessionPool, operation string, tableID int64, f func(sctx sessionctx.Context) error, flags ...int) (err error) {
 	defer util.Recover(metrics.LabelStats, "CallWithSCtx", nil, false)
 	se, err := pool.Get()
 	if err != nil {
@@ -91,98 +134,97 @@ func CallWithSCtx(pool util.DestroyableSessionPool, f func(sctx sessionctx.Conte
 		}
 	}()
 	sctx := se.(sessionctx.Context)
+	if ctx != StatsCtx {
+		defer withStatsCtx(ctx, sctx)()
+	}
+	if operation != "" {
+		defer LabelSCtx(sctx, operation, tableID)()
+	}
 	if err := UpdateSCtxVarsForStats(sctx); err != nil { // update stats variables automatically
 		return errors.Trace(err)
 	}
 
-	wrapTxn := false
+	wrapTxn, wrapReadOnlyTxn, retry := false, false, false
 	for _, flag := range flags {
-		if flag == FlagWrapTxn {
+		switch flag {
+		case FlagWrapTxn:
 			wrapTxn = true
+		case FlagWrapReadOnlyTxn:
+			wrapReadOnlyTxn = true
+		case FlagRetryOnConflict:
+			retry = true
 		}
 	}
-	if wrapTxn {
-		err = WrapTxn(sctx, f)
+	call := func() error {
+		switch {
+		case wrapTxn:
+			return WrapTxn(sctx, f)
+		case wrapReadOnlyTxn:
+			return WrapReadOnlyTxn(sctx, readOnlyTxnTSOf(ctx), f)
+		}
+		return f(sctx)
+	}
+	if retry {
+		err = retryOnConflict(statsCtxOf(sctx), retryOnConflictPolicyOf(ctx), call)
 	} else {
-		err = f(sctx)
+		err = call()
 	}
 	return errors.Trace(err)
 }
 
+// statsSysVars is the global sysvars which may affect the behavior of statistics, see UpdateSCtxVarsForStats.
+var statsSysVars = []string{
+	vardef.TiDBEnableAsyncMergeGlobalStats,
+	vardef.TiDBAnalyzePartitionConcurrency,
+	vardef.TiDBAnalyzeVersion,
+	vardef.TiDBEnableHistoricalStats,
+	vardef.TiDBPartitionPruneMode,
+	vardef.TiDBEnableAnalyzeSnapshot,
+	vardef.TiDBAnalyzeSkipColumnTypes,
+	vardef.TiDBSkipMissingPartitionStats,
+	vardef.TiDBMergePartitionStatsConcurrency,
+}
+
 // UpdateSCtxVarsForStats updates all necessary variables that may affect the behavior of statistics.
+// The sysvars are got at once and applied only if all of them are valid, so the sctx never sees a mix of the old and
+// the new values.
 func UpdateSCtxVarsForStats(sctx sessionctx.Context) error {
-	// async merge global stats
-	enableAsyncMergeGlobalStats, err := sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBEnableAsyncMergeGlobalStats)
+	sessVars := sctx.GetSessionVars()
+	vals, err := sessVars.GlobalVarsAccessor.GetGlobalSysVars(statsSysVars...)
 	if err != nil {
 		return err
 	}
-	sctx.GetSessionVars().EnableAsyncMergeGlobalStats = variable.TiDBOptOn(enableAsyncMergeGlobalStats)
-
 	// concurrency of save stats to storage
-	analyzePartitionConcurrency, err := sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBAnalyzePartitionConcurrency)
-	if err != nil {
-		return err
-	}
-	c, err := strconv.ParseInt(analyzePartitionConcurrency, 10, 64)
+	analyzePartitionConcurrency, err := strconv.ParseInt(vals[vardef.TiDBAnalyzePartitionConcurrency], 10, 64)
 	if err != nil {
 		return err
 	}
-	sctx.GetSessionVars().AnalyzePartitionConcurrency = int(c)
-
 	// analyzer version
-	verInString, err := sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBAnalyzeVersion)
+	analyzeVersion, err := strconv.ParseInt(vals[vardef.TiDBAnalyzeVersion], 10, 64)
 	if err != nil {
 		return err
 	}
-	ver, err := strconv.ParseInt(verInString, 10, 64)
+	// concurrency of merging the partition stats
+	mergeConcurrency, err := strconv.ParseInt(vals[vardef.TiDBMergePartitionStatsConcurrency], 10, 64)
 	if err != nil {
 		return err
 	}
-	sctx.GetSessionVars().AnalyzeVersion = int(ver)
 
+	// async merge global stats
+	sessVars.EnableAsyncMergeGlobalStats = variable.TiDBOptOn(vals[vardef.TiDBEnableAsyncMergeGlobalStats])
+	sessVars.AnalyzePartitionConcurrency = int(analyzePartitionConcurrency)
+	sessVars.AnalyzeVersion = int(analyzeVersion)
 	// enable historical stats
-	val, err := sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBEnableHistoricalStats)
-	if err != nil {
-		return err
-	}
-	sctx.GetSessionVars().EnableHistoricalStats = variable.TiDBOptOn(val)
-
+	sessVars.EnableHistoricalStats = variable.TiDBOptOn(vals[vardef.TiDBEnableHistoricalStats])
 	// partition mode
-	pruneMode, err := sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBPartitionPruneMode)
-	if err != nil {
-		return err
-	}
-	sctx.GetSessionVars().PartitionPruneMode.Store(pruneMode)
-
+	sessVars.PartitionPruneMode.Store(vals[vardef.TiDBPartitionPruneMode])
 	// enable analyze snapshot
-	analyzeSnapshot, err := sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBEnableAnalyzeSnapshot)
-	if err != nil {
-		return err
-	}
-	sctx.GetSessionVars().EnableAnalyzeSnapshot = variable.TiDBOptOn(analyzeSnapshot)
-
+	sessVars.EnableAnalyzeSnapshot = variable.TiDBOptOn(vals[vardef.TiDBEnableAnalyzeSnapshot])
 	// enable skip column types
-	val, err = sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBAnalyzeSkipColumnTypes)
-	if err != nil {
-		return err
-	}
-	sctx.GetSessionVars().AnalyzeSkipColumnTypes = variable.ParseAnalyzeSkipColumnTypes(val)
-
+	sessVars.AnalyzeSkipColumnTypes = variable.ParseAnalyzeSkipColumnTypes(vals[vardef.TiDBAnalyzeSkipColumnTypes])
 	// skip missing partition stats
-	val, err = sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBSkipMissingPartitionStats)
-	if err != nil {
-		return err
-	}
-	sctx.GetSessionVars().SkipMissingPartitionStats = variable.TiDBOptOn(val)
-	verInString, err = sctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBMergePartitionStatsConcurrency)
-	if err != nil {
-		return err
-	}
-	ver, err = strconv.ParseInt(verInString, 10, 64)
-	if err != nil {
-		return err
-	}
-	sctx.GetSessionVars().AnalyzePartitionMergeConcurrency = int(ver)
+	sessVars.SkipMissingPartitionStats = variable.TiDBOptOn(vals[vardef.TiDBSkipMissingPartitionStats])
+	sessVars.AnalyzePartitionMergeConcurrency = int(mergeConcurrency)
 	return nil
 }
 
@@ -205,9 +247,41 @@ func WrapTxn(sctx sessionctx.Context, f func(sctx sessionctx.Context) error) (er
 		err = finishTransaction(sctx, err)
 	}()
 	err = f(sctx)
+	if err == nil {
+		// The transaction is aborted if the context of CallWithSCtxCtx is done.
+		err = statsCtxOf(sctx).Err()
+	}
 	return
 }
 
+// WrapReadOnlyTxn is like WrapTxn, but the transaction only reads the snapshot at ts, or the latest one if ts is 0 or
+// math.MaxUint64, without the pessimistic locks. It's always rolled back since nothing is written. The stale
+// transaction at ts is already active, so f should read its start ts from the TxnCtx rather than by GetStartTS, which
+// would commit it and start another one.
+func WrapReadOnlyTxn(sctx sessionctx.Context, ts uint64, f func(sctx sessionctx.Context) error) (err error) {
+	if ts == 0 || ts == math.MaxUint64 {
+		_, _, err = ExecRows(sctx, "BEGIN OPTIMISTIC")
+	} else {
+		// The transaction is started at the exact ts rather than by `START TRANSACTION READ ONLY AS OF TIMESTAMP`,
+		// which loses the logical part of the ts when it's converted to a time.
+		err = sessiontxn.GetTxnManager(sctx).EnterNewTxn(statsCtxOf(sctx), &sessiontxn.EnterNewTxnRequest{
+			Type:        sessiontxn.EnterNewTxnWithBeginStmt,
+			StaleReadTS: ts,
+		})
+		if err == nil {
+			sctx.GetSessionVars().SetInTxn(true)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _, err1 := ExecRowsWithCtx(context.WithoutCancel(statsCtxOf(sctx)), sctx, "rollback")
+		terror.Log(errors.Trace(err1))
+	}()
+	return f(sctx)
+}
+
 // GetStartTS gets the start ts from current transaction.
 func GetStartTS(sctx sessionctx.Context) (uint64, error) {
 	txn, err := sctx.Txn(true)
@@ -219,7 +293,7 @@ func GetStartTS(sctx sessionctx.Context) (uint64, error) {
 
 // Exec is a helper function to execute sql and return RecordSet.
 func Exec(sctx sessionctx.Context, sql string, args ...any) (sqlexec.RecordSet, error) {
-	return ExecWithCtx(StatsCtx, sctx, sql, args...)
+	return ExecWithCtx(statsCtxOf(sctx), sctx, sql, args...)
 }
 
 // ExecWithCtx is a helper function to execute sql and return RecordSet.
@@ -239,7 +313,7 @@ func ExecRows(sctx sessionctx.Context, sql string, args ...any) (rows []chunk.Ro
 	failpoint.Inject("ExecRowsTimeout", func() {
 		failpoint.Return(nil, nil, errors.New("inject timeout error"))
 	})
-	return ExecRowsWithCtx(StatsCtx, sctx, sql, args...)
+	return ExecRowsWithCtx(statsCtxOf(sctx), sctx, sql, args...)
 }
 
 // ExecRowsWithCtx is a helper function to execute sql and return rows and fields.
@@ -264,7 +338,7 @@ func ExecRowsWithCtx(
 // ExecWithOpts is a helper function to execute sql and return rows and fields.
 func ExecWithOpts(sctx sessionctx.Context, opts []sqlexec.OptionFuncAlias, sql string, args ...any) (rows []chunk.Row, fields []*resolve.ResultField, err error) {
 	sqlExec := sctx.GetRestrictedSQLExecutor()
-	return sqlExec.ExecRestrictedSQL(StatsCtx, opts, sql, args...)
+	return sqlExec.ExecRestrictedSQL(statsCtxOf(sctx), opts, sql, args...)
 }
 
 // DurationToTS converts duration to timestamp.
@@ -15,12 +15,20 @@
 package util_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"runtime/pprof"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/domain/infosync"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	storeerr "github.com/pingcap/tidb/pkg/store/driver/error"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/stretchr/testify/require"
 )
@@ -72,3 +80,266 @@ func TestCallSCtxFailed(t *testing.T) {
 	notReleased := infosync.ContainsInternalSessionForTest(sctxWithFailure)
 	require.False(t, notReleased)
 }
+
+func TestStatsLabels(t *testing.T) {
+	ctx := util.WithStatsLabels(context.Background(), util.StatsOperationDumpDelta, 0)
+	operation, ok := pprof.Label(ctx, util.PprofLabelStatsOperation)
+	require.True(t, ok)
+	require.Equal(t, util.StatsOperationDumpDelta, operation)
+	_, ok = pprof.Label(ctx, util.PprofLabelStatsTableID)
+	require.False(t, ok)
+	ctx = util.WithStatsLabels(ctx, util.StatsOperationAnalyze, 100)
+	operation, ok = pprof.Label(ctx, util.PprofLabelStatsOperation)
+	require.True(t, ok)
+	require.Equal(t, util.StatsOperationAnalyze, operation)
+	tableID, ok := pprof.Label(ctx, util.PprofLabelStatsTableID)
+	require.True(t, ok)
+	require.Equal(t, "100", tableID)
+
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	sctx := tk.Session()
+	done := util.LabelSCtx(sctx, util.StatsOperationLoad, 1)
+	rows, _, err := util.ExecRows(sctx, "select 1")
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	done()
+	rows, _, err = util.ExecRows(sctx, "select 1")
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+}
+
+// currentGoroutineLabels returns the pprof labels of the current goroutine from the goroutine profile.
+func currentGoroutineLabels(t *testing.T) map[string]string {
+	var buf bytes.Buffer
+	require.NoError(t, pprof.Lookup("goroutine").WriteTo(&buf, 1))
+	for _, record := range strings.Split(buf.String(), "\n\n") {
+		if !strings.Contains(record, "util_test.currentGoroutineLabels") {
+			continue
+		}
+		labels := make(map[string]string)
+		for _, line := range strings.Split(record, "\n") {
+			if l, ok := strings.CutPrefix(line, "# labels: "); ok {
+				require.NoError(t, json.Unmarshal([]byte(l), &labels))
+			}
+		}
+		return labels
+	}
+	require.FailNow(t, "the current goroutine isn't found in the goroutine profile")
+	return nil
+}
+
+func TestCallWithSCtxAndLabelsKeepsCallerLabels(t *testing.T) {
+	_, dom := testkit.CreateMockStoreAndDomain(t)
+	pool := dom.StatsHandle().SPool()
+	callerLabels := map[string]string{"sql_digest": "digest"}
+	ctx := pprof.WithLabels(context.Background(), pprof.Labels("sql_digest", "digest"))
+	pprof.SetGoroutineLabels(ctx)
+	defer pprof.SetGoroutineLabels(context.Background())
+
+	err := util.CallWithSCtxAndLabels(ctx, pool, util.StatsOperationMergeGlobalStats, 100, func(sctx sessionctx.Context) error {
+		require.Equal(t, map[string]string{
+			"sql_digest":                  "digest",
+			util.PprofLabelStatsOperation: util.StatsOperationMergeGlobalStats,
+			util.PprofLabelStatsTableID:   "100",
+		}, currentGoroutineLabels(t))
+		// The labels are kept by the nested calls and the SQLs, which reset the labels of the goroutine.
+		done := util.LabelSCtx(sctx, util.StatsOperationLoad, 0)
+		_, _, err := util.ExecRows(sctx, "select 1")
+		require.NoError(t, err)
+		require.Equal(t, util.StatsOperationLoad, currentGoroutineLabels(t)[util.PprofLabelStatsOperation])
+		done()
+		_, _, err = util.ExecRows(sctx, "select 1")
+		require.Equal(t, util.StatsOperationMergeGlobalStats, currentGoroutineLabels(t)[util.PprofLabelStatsOperation])
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, callerLabels, currentGoroutineLabels(t))
+
+	// The goroutine without any label is left without any.
+	pprof.SetGoroutineLabels(context.Background())
+	err = util.CallWithSCtxAndLabels(util.StatsCtx, pool, util.StatsOperationGC, 0, func(sctx sessionctx.Context) error {
+		_, _, err := util.ExecRows(sctx, "select 1")
+		return err
+	})
+	require.NoError(t, err)
+	require.Empty(t, currentGoroutineLabels(t))
+}
+
+func TestStatsReplicaRead(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	sctx := tk.Session()
+	sessVars := sctx.GetSessionVars()
+
+	// The leader is read by default.
+	done := util.LabelSCtx(sctx, util.StatsOperationLoad, 1)
+	require.Equal(t, kv.ReplicaReadLeader, sessVars.GetReplicaRead())
+	done()
+
+	tk.MustExec("set global tidb_stats_replica_read = 'follower'")
+	defer tk.MustExec("set global tidb_stats_replica_read = default")
+	for _, operation := range []string{util.StatsOperationLoad, util.StatsOperationCacheRefresh, util.StatsOperationInit} {
+		done = util.LabelSCtx(sctx, operation, 0)
+		require.Equal(t, kv.ReplicaReadFollower, sessVars.GetReplicaRead())
+		rows, _, err := util.ExecRows(sctx, "select 1")
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		done()
+		require.Equal(t, kv.ReplicaReadLeader, sessVars.GetReplicaRead())
+	}
+	// The operations writing the stats always read the leader.
+	done = util.LabelSCtx(sctx, util.StatsOperationAnalyze, 1)
+	require.Equal(t, kv.ReplicaReadLeader, sessVars.GetReplicaRead())
+	done()
+}
+
+func TestCallWithSCtxRetryOnConflict(t *testing.T) {
+	_, dom := testkit.CreateMockStoreAndDomain(t)
+	pool := dom.StatsHandle().SPool()
+
+	// The transient errors are retried until f succeeds.
+	calls := 0
+	err := util.CallWithSCtx(pool, func(sctx sessionctx.Context) error {
+		calls++
+		switch calls {
+		case 1:
+			return kv.ErrWriteConflict.FastGenByArgs(1, 2, 3, 4, "reason")
+		case 2:
+			return storeerr.ErrRegionUnavailable
+		}
+		return nil
+	}, util.FlagWrapTxn, util.FlagRetryOnConflict)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	// The other errors aren't retried.
+	calls = 0
+	err = util.CallWithSCtx(pool, func(sctx sessionctx.Context) error {
+		calls++
+		return errors.New("simulated error")
+	}, util.FlagWrapTxn, util.FlagRetryOnConflict)
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+
+	// The transient errors aren't retried without the flag.
+	calls = 0
+	err = util.CallWithSCtx(pool, func(sctx sessionctx.Context) error {
+		calls++
+		return storeerr.ErrRegionUnavailable
+	}, util.FlagWrapTxn)
+	require.True(t, storeerr.ErrRegionUnavailable.Equal(err))
+	require.Equal(t, 1, calls)
+
+	// The retries stop when the budget is used up.
+	calls = 0
+	err = util.CallWithSCtx(pool, func(sctx sessionctx.Context) error {
+		calls++
+		return storeerr.ErrRegionUnavailable
+	}, util.FlagRetryOnConflict)
+	require.True(t, storeerr.ErrRegionUnavailable.Equal(err))
+	require.Equal(t, 7, calls)
+
+	// The caller can give its own budget.
+	calls = 0
+	ctx := util.WithRetryOnConflictPolicy(context.Background(), util.RetryOnConflictPolicy{
+		Budget:      2,
+		BaseBackoff: time.Millisecond,
+		MaxBackoff:  10 * time.Millisecond,
+	})
+	err = util.CallWithSCtxCtx(ctx, pool, func(sctx sessionctx.Context) error {
+		calls++
+		return storeerr.ErrRegionUnavailable
+	}, util.FlagWrapTxn, util.FlagRetryOnConflict)
+	require.True(t, storeerr.ErrRegionUnavailable.Equal(err))
+	require.Equal(t, 3, calls)
+}
+
+func TestCallWithSCtxCtx(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	pool := dom.StatsHandle().SPool()
+
+	// The transaction is committed if the ctx isn't done.
+	err := util.CallWithSCtxCtx(context.Background(), pool, func(sctx sessionctx.Context) error {
+		_, _, err := util.ExecRows(sctx, "insert into test.t values (1)")
+		return err
+	}, util.FlagWrapTxn)
+	require.NoError(t, err)
+	tk.MustQuery("select a from t").Check(testkit.Rows("1"))
+
+	// The transaction is rolled back if the ctx is done before f returns.
+	ctx, cancel := context.WithCancel(context.Background())
+	err = util.CallWithSCtxCtx(ctx, pool, func(sctx sessionctx.Context) error {
+		_, _, err := util.ExecRows(sctx, "insert into test.t values (2)")
+		cancel()
+		return err
+	}, util.FlagWrapTxn)
+	require.Equal(t, context.Canceled, errors.Cause(err))
+	tk.MustQuery("select a from t").Check(testkit.Rows("1"))
+
+	// The retries are stopped when the ctx is done.
+	calls := 0
+	err = util.CallWithSCtxCtx(ctx, pool, func(sctx sessionctx.Context) error {
+		calls++
+		return storeerr.ErrRegionUnavailable
+	}, util.FlagRetryOnConflict)
+	require.True(t, storeerr.ErrRegionUnavailable.Equal(err))
+	require.Equal(t, 1, calls)
+}
+
+func TestUpdateSCtxVarsForStats(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("set global tidb_analyze_version = 1")
+	defer tk.MustExec("set global tidb_analyze_version = default")
+	tk.MustExec("set global tidb_partition_prune_mode = 'static'")
+	defer tk.MustExec("set global tidb_partition_prune_mode = default")
+	tk.MustExec("set global tidb_merge_partition_stats_concurrency = 4")
+	defer tk.MustExec("set global tidb_merge_partition_stats_concurrency = default")
+
+	sctx := tk.Session()
+	require.NoError(t, util.UpdateSCtxVarsForStats(sctx))
+	sessVars := sctx.GetSessionVars()
+	require.Equal(t, 1, sessVars.AnalyzeVersion)
+	require.Equal(t, "static", sessVars.PartitionPruneMode.Load())
+	require.Equal(t, 4, sessVars.AnalyzePartitionMergeConcurrency)
+	require.False(t, sessVars.EnableAnalyzeSnapshot)
+}
+
+func TestCallWithSCtxReadOnlyTxn(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t values (1)")
+	ver, err := store.CurrentVersion(kv.GlobalTxnScope)
+	require.NoError(t, err)
+	tk.MustExec("insert into t values (2)")
+	pool := dom.StatsHandle().SPool()
+
+	// The snapshot at the TS is read, and nothing can be written.
+	err = util.CallWithSCtxCtx(util.WithReadOnlyTxnTS(context.Background(), ver.Ver), pool, func(sctx sessionctx.Context) error {
+		// The logical part of the TS isn't lost.
+		require.Equal(t, ver.Ver, sctx.GetSessionVars().TxnCtx.StartTS)
+		rows, _, err := util.ExecRows(sctx, "select count(*) from test.t")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), rows[0].GetInt64(0))
+		_, _, err = util.ExecRows(sctx, "insert into test.t values (3)")
+		require.Error(t, err)
+		return nil
+	}, util.FlagWrapReadOnlyTxn)
+	require.NoError(t, err)
+
+	// The latest snapshot is read without the TS.
+	err = util.CallWithSCtx(pool, func(sctx sessionctx.Context) error {
+		rows, _, err := util.ExecRows(sctx, "select count(*) from test.t")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), rows[0].GetInt64(0))
+		return nil
+	}, util.FlagWrapReadOnlyTxn)
+	require.NoError(t, err)
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("2"))
+}
@@ -17,12 +17,14 @@ package statistics
 import (
 	"bytes"
 	"cmp"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"slices"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 	"unsafe"
 
 	"github.com/pingcap/errors"
@@ -286,6 +288,96 @@ func ValueToString(vars *variable.SessionVars, value *types.Datum, idxCols int,
 	return str, err
 }
 
+// ValueToReadableString converts a possible encoded value to a readable string like ValueToString does, and the
+// values are further decoded by their types: the collate keys are decoded to the strings equal to the original ones
+// under the collations, the values of BIT, ENUM and SET are converted to the bit literals and the names, and the bytes
+// which can't be shown are converted to hex. The length of fts is the same as idxCols, or 1 if idxCols is 0.
+func ValueToReadableString(vars *variable.SessionVars, value *types.Datum, idxCols int, fts []*types.FieldType) (string, error) {
+	if idxCols == 0 {
+		var ft *types.FieldType
+		if len(fts) > 0 {
+			ft = fts[0]
+		}
+		d := readableDatum(*value, ft)
+		return d.ToString()
+	}
+	var loc *time.Location
+	if vars != nil {
+		loc = vars.Location()
+	}
+	idxColumnTypes := make([]byte, 0, len(fts))
+	for _, ft := range fts {
+		idxColumnTypes = append(idxColumnTypes, ft.GetType())
+	}
+	// Ignore the error and treat remaining part that cannot decode successfully as bytes.
+	decodedVals, remained, err := codec.DecodeRange(value.GetBytes(), idxCols, idxColumnTypes, loc)
+	// Ignore err explicit to pass errcheck.
+	_ = err
+	for i := range decodedVals {
+		if i < len(fts) {
+			decodedVals[i] = readableDatum(decodedVals[i], fts[i])
+		}
+	}
+	if len(remained) > 0 {
+		decodedVals = append(decodedVals, readableDatum(types.NewBytesDatum(remained), nil))
+	}
+	return types.DatumsToString(decodedVals, true)
+}
+
+// readableDatum converts the datum of the field type to a readable one. The converted datums are bytes to avoid the
+// quotes added by types.DatumsToString.
+func readableDatum(d types.Datum, ft *types.FieldType) types.Datum {
+	switch d.Kind() {
+	case types.KindNull, types.KindMinNotNull, types.KindMaxValue:
+		return d
+	}
+	if ft != nil {
+		switch ft.GetType() {
+		case mysql.TypeBit:
+			switch d.Kind() {
+			case types.KindUint64, types.KindInt64:
+				return types.NewBytesDatum([]byte(types.NewBinaryLiteralFromUint(d.GetUint64(), -1).ToBitLiteralString(true)))
+			case types.KindMysqlBit, types.KindBinaryLiteral, types.KindBytes, types.KindString:
+				return types.NewBytesDatum([]byte(types.BinaryLiteral(d.GetBytes()).ToBitLiteralString(true)))
+			}
+		case mysql.TypeEnum:
+			if d.Kind() == types.KindUint64 || d.Kind() == types.KindInt64 {
+				if e, err := types.ParseEnumValue(ft.GetElems(), d.GetUint64()); err == nil {
+					return types.NewBytesDatum([]byte(e.Name))
+				}
+			}
+		case mysql.TypeSet:
+			if d.Kind() == types.KindUint64 || d.Kind() == types.KindInt64 {
+				if s, err := types.ParseSetValue(ft.GetElems(), d.GetUint64()); err == nil {
+					return types.NewBytesDatum([]byte(s.Name))
+				}
+			}
+		case mysql.TypeJSON:
+			if d.Kind() == types.KindBytes || d.Kind() == types.KindString {
+				if j, err := types.ParseBinaryJSONFromString(string(d.GetBytes())); err == nil {
+					return types.NewBytesDatum([]byte(j.String()))
+				}
+			}
+		case mysql.TypeTiDBVectorFloat32:
+			if d.Kind() == types.KindBytes || d.Kind() == types.KindString {
+				if v, remained, err := types.ZeroCopyDeserializeVectorFloat32(d.GetBytes()); err == nil && len(remained) == 0 {
+					return types.NewBytesDatum([]byte(v.String()))
+				}
+			}
+		default:
+			if ft.EvalType() == types.ETString && (d.Kind() == types.KindBytes || d.Kind() == types.KindString) {
+				if str, ok := collate.DecodeKey(ft.GetCollate(), d.GetBytes()); ok {
+					return types.NewBytesDatum([]byte(str))
+				}
+			}
+		}
+	}
+	if (d.Kind() == types.KindBytes || d.Kind() == types.KindString) && !utf8.Valid(d.GetBytes()) {
+		return types.NewBytesDatum([]byte("0x" + hex.EncodeToString(d.GetBytes())))
+	}
+	return d
+}
+
 // BucketToString change the given bucket to string format.
 func (hg *Histogram) BucketToString(bktID, idxCols int) string {
 	upperVal, err := ValueToString(nil, hg.GetUpper(bktID), idxCols, nil)
@@ -1097,6 +1189,58 @@ func (hg *Histogram) OutOfRangeRowCount(
 	return min(rowCount, float64(modifyCount))
 }
 
+// MinExtrapolationCorrelation is the minimum absolute correlation between the physical row ordering and the values
+// of a column to regard it as an increasing (or decreasing) column whose out-of-range part can be extrapolated.
+const MinExtrapolationCorrelation = 0.9
+
+// ExtrapolatedOutOfRangeRowCount estimates the row count of part of [lDatum, rDatum] which is out of range of the
+// histogram of an increasing (or decreasing) column, like an auto-increment id or a creation time.
+// The rows inserted since the histogram is built are assumed to extend the range of the histogram in the direction
+// of the correlation, at the same density as the rows in the histogram, so the data likely extends to
+// histR + insertedRows * histWidth / notNullCount, and the inserted rows distribute uniformly in the extension.
+// It returns false if the column is not regarded as increasing or decreasing, or the histogram is unusable, then the
+// caller should fall back to OutOfRangeRowCount.
+func (hg *Histogram) ExtrapolatedOutOfRangeRowCount(lDatum, rDatum *types.Datum, correlation, insertedRows float64) (float64, bool) {
+	if hg.Len() == 0 || math.Abs(correlation) < MinExtrapolationCorrelation || hg.NotNullCount() <= 0 {
+		return 0, false
+	}
+	if insertedRows <= 0 {
+		return 0, true
+	}
+	commonPrefix := 0
+	if hg.GetLower(0).Kind() == types.KindBytes || hg.GetLower(0).Kind() == types.KindString {
+		commonPrefix = commonPrefixLength(hg.GetLower(0).GetBytes(),
+			hg.GetUpper(hg.Len()-1).GetBytes(),
+			lDatum.GetBytes(),
+			rDatum.GetBytes())
+	}
+	l := convertDatumToScalar(lDatum, commonPrefix)
+	r := convertDatumToScalar(rDatum, commonPrefix)
+	if mysql.HasUnsignedFlag(hg.Tp.GetFlag()) {
+		l, r = max(l, 0), max(r, 0)
+	}
+	histL := convertDatumToScalar(hg.GetLower(0), commonPrefix)
+	histR := convertDatumToScalar(hg.GetUpper(hg.Len()-1), commonPrefix)
+	histWidth := histR - histL
+	if histWidth <= 0 || math.IsInf(histWidth, 0) || math.IsNaN(histWidth) {
+		return 0, false
+	}
+	if l >= r {
+		return 0, true
+	}
+	extension := insertedRows * histWidth / hg.NotNullCount()
+	// The extension of the range where the inserted rows likely are.
+	extL, extR := histR, histR+extension
+	if correlation < 0 {
+		extL, extR = histL-extension, histL
+	}
+	overlap := min(r, extR) - max(l, extL)
+	if overlap <= 0 || extension <= 0 {
+		return 0, true
+	}
+	return insertedRows * overlap / extension, true
+}
+
 // Copy deep copies the histogram.
 func (hg *Histogram) Copy() *Histogram {
 	if hg == nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -50,6 +51,46 @@ func TestValueToString4InvalidKey(t *testing.T) {
 	require.Equal(t, "(1, 0.5, \x14)", res)
 }
 
+func TestValueToReadableString(t *testing.T) {
+	collate.SetNewCollationEnabledForTest(true)
+	defer collate.SetNewCollationEnabledForTest(false)
+	bitTp := types.NewFieldType(mysql.TypeBit)
+	bitTp.SetFlen(8)
+	enumTp := types.NewFieldType(mysql.TypeEnum)
+	enumTp.SetElems([]string{"x", "y"})
+	strTp := types.NewFieldType(mysql.TypeVarchar)
+	strTp.SetCharset("utf8mb4")
+	strTp.SetCollate("utf8mb4_general_ci")
+	binTp := types.NewFieldType(mysql.TypeVarString)
+	binTp.SetCharset("binary")
+	binTp.SetCollate("binary")
+	strKey := collate.GetCollator("utf8mb4_general_ci").Key("abc")
+
+	// The bounds of the columns.
+	for _, tc := range []struct {
+		d        types.Datum
+		ft       *types.FieldType
+		expected string
+	}{
+		{types.NewMysqlBitDatum(types.NewBinaryLiteralFromUint(5, 1)), bitTp, "b'101'"},
+		{types.NewMysqlEnumDatum(types.Enum{Name: "y", Value: 2}), enumTp, "y"},
+		{types.NewCollationStringDatum(string(strKey), "utf8mb4_general_ci"), strTp, "ABC"},
+		{types.NewBytesDatum([]byte{0xff, 0x01}), binTp, "0xff01"},
+	} {
+		res, err := ValueToReadableString(nil, &tc.d, 0, []*types.FieldType{tc.ft})
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, res)
+	}
+
+	// The values of the indexes.
+	bytes, err := codec.EncodeKey(time.UTC, nil, types.NewUintDatum(5), types.NewUintDatum(2), types.NewBytesDatum(strKey), types.NewBytesDatum([]byte{0xff, 0x01}))
+	require.NoError(t, err)
+	datum := types.NewDatum(bytes)
+	res, err := ValueToReadableString(nil, &datum, 4, []*types.FieldType{bitTp, enumTp, strTp, binTp})
+	require.NoError(t, err)
+	require.Equal(t, "(b'101', y, ABC, 0xff01)", res)
+}
+
 type bucket4Test struct {
 	lower  int64
 	upper  int64
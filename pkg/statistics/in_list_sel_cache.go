@@ -0,0 +1,81 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/pingcap/tidb/pkg/util/kvcache"
+)
+
+// InListSelCacheCapacity is the max number of the per-constant selectivities kept in InListSelCache.
+const InListSelCacheCapacity = 10000
+
+// InListSelCache caches the selectivities of the constants in the IN lists probed against the stats, so that the
+// parameterized variants of a statement using the plan cache don't need to probe the same constants again.
+// It's owned by the stats handle and flushed with the stats cache. The key contains the version of the column stats
+// and the row count of the table, so the stale entries are never hit.
+type InListSelCache struct {
+	mu    sync.Mutex
+	cache *kvcache.SimpleLRUCache
+}
+
+// NewInListSelCache creates an InListSelCache.
+func NewInListSelCache() *InListSelCache {
+	return &InListSelCache{cache: kvcache.NewSimpleLRUCache(InListSelCacheCapacity, 0, 0)}
+}
+
+type inListSelCacheKey []byte
+
+// Hash implements the kvcache.Key interface.
+func (k inListSelCacheKey) Hash() []byte {
+	return k
+}
+
+func newInListSelCacheKey(coll *HistColl, c *Column, encodedVal []byte) inListSelCacheKey {
+	key := make([]byte, 0, 40+len(encodedVal))
+	key = binary.BigEndian.AppendUint64(key, uint64(coll.PhysicalID))
+	key = binary.BigEndian.AppendUint64(key, uint64(c.Histogram.ID))
+	key = binary.BigEndian.AppendUint64(key, c.Histogram.LastUpdateVersion)
+	key = binary.BigEndian.AppendUint64(key, uint64(coll.RealtimeCount))
+	key = binary.BigEndian.AppendUint64(key, uint64(coll.ModifyCount))
+	return append(key, encodedVal...)
+}
+
+// Get returns the cached selectivity of the encoded constant on the column.
+func (c *InListSelCache) Get(coll *HistColl, col *Column, encodedVal []byte) (float64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sel, ok := c.cache.Get(newInListSelCacheKey(coll, col, encodedVal))
+	if !ok {
+		return 0, false
+	}
+	return sel.(float64), true
+}
+
+// Put caches the selectivity of the encoded constant on the column.
+func (c *InListSelCache) Put(coll *HistColl, col *Column, encodedVal []byte, sel float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Put(newInListSelCacheKey(coll, col, encodedVal), sel)
+}
+
+// Clear removes all the cached selectivities.
+func (c *InListSelCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.DeleteAll()
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"time"
+
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/util/hack"
+)
+
+// MergeIncrementalStats merges the stats of the rows appended since the last analyze into the stats of the last analyze,
+// which is used by ANALYZE INCREMENTAL TABLE. The old rows and the appended ones are disjoint, so the TopNs and the
+// histograms are merged in the same way as the partition stats are merged into the global stats, and the NDV is
+// derived from the merged FMSketch. The input stats are not modified.
+func MergeIncrementalStats(
+	sc *stmtctx.StatementContext,
+	oldHist, newHist *Histogram,
+	oldTopN, newTopN *TopN,
+	oldFMS, newFMS *FMSketch,
+	numBuckets, numTopN uint64,
+	isIndex bool,
+) (*Histogram, *TopN, *FMSketch, error) {
+	hists := []*Histogram{oldHist.Copy(), newHist.Copy()}
+	topN, poppedTopN, err := mergeIncrementalTopN(sc.TimeZone(), []*TopN{oldTopN, newTopN}, hists, uint32(numTopN), isIndex)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	hist, err := MergePartitionHist2GlobalHist(sc, hists, poppedTopN, int64(numBuckets), isIndex, Version2)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// The NDVs of the merged buckets tend to be underestimated, so they're not used, the same as the global stats.
+	for i := range hist.Buckets {
+		hist.Buckets[i].NDV = 0
+	}
+
+	fms := oldFMS.Copy()
+	if fms == nil {
+		fms = newFMS.Copy()
+	} else {
+		fms.MergeFMSketch(newFMS)
+	}
+	ndv := oldHist.NDV + newHist.NDV
+	if fms != nil {
+		ndv = fms.NDV()
+	}
+	oldRows := oldHist.NotNullCount() + float64(oldTopN.TotalCount())
+	newRows := newHist.NotNullCount() + float64(newTopN.TotalCount())
+	hist.NDV = min(ndv, int64(oldRows+newRows))
+	// The appended rows usually keep the order of the old ones, so the correlation is weighted by the row counts.
+	if oldRows+newRows > 0 {
+		hist.Correlation = (oldHist.Correlation*oldRows + newHist.Correlation*newRows) / (oldRows + newRows)
+	}
+	return hist, topN, fms, nil
+}
+
+// mergeIncrementalTopN merges the TopNs of the old and the appended rows. The count of a value in one TopN is added
+// with its count in the other TopN, or else the count estimated by the other histogram, which is removed from the
+// histogram. It returns the merged TopN and the values popped out of it, which should be put back to the histogram.
+func mergeIncrementalTopN(loc *time.Location, topNs []*TopN, hists []*Histogram, n uint32, isIndex bool) (*TopN, []TopNMeta, error) {
+	counter := make(map[hack.MutableString]uint64)
+	datumMap := NewDatumMapCache()
+	for i, topN := range topNs {
+		if topN.TotalCount() == 0 {
+			continue
+		}
+		for _, val := range topN.TopN {
+			encodedVal := hack.String(val.Encoded)
+			_, exists := counter[encodedVal]
+			counter[encodedVal] += val.Count
+			if exists {
+				continue
+			}
+			for j, hist := range hists {
+				if j == i || topNs[j].FindTopN(val.Encoded) != -1 {
+					continue
+				}
+				datum, ok := datumMap.Get(encodedVal)
+				if !ok {
+					var err error
+					datum, err = datumMap.Put(val, encodedVal, hist.Tp.GetType(), isIndex, loc)
+					if err != nil {
+						return nil, nil, err
+					}
+				}
+				if count, _ := hist.EqualRowCount(nil, datum, isIndex); count != 0 {
+					counter[encodedVal] += uint64(count)
+					hist.BinarySearchRemoveVal(&datum, int64(count))
+				}
+			}
+		}
+	}
+	if len(counter) == 0 {
+		return nil, nil, nil
+	}
+	sorted := make([]TopNMeta, 0, len(counter))
+	for value, cnt := range counter {
+		sorted = append(sorted, TopNMeta{Encoded: hack.Slice(string(value)), Count: cnt})
+	}
+	topN, popped := GetMergedTopNFromSortedSlice(sorted, n)
+	return topN, popped, nil
+}
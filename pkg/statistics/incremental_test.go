@@ -0,0 +1,90 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeIncrementalStats(t *testing.T) {
+	sc := mock.NewContext().GetSessionVars().StmtCtx
+	// buildStats builds the stats of the int values in [lower, upper] with the buckets of the size and the TopN values
+	// which are not in the histogram.
+	buildStats := func(lower, upper, size int64, topN map[int64]uint64) (*Histogram, *TopN, *FMSketch) {
+		hg := NewHistogram(1, upper-lower+1, 0, 0, types.NewFieldType(mysql.TypeLonglong), int((upper-lower+1)/size), 0)
+		hg.Correlation = 1
+		fms := NewFMSketch(1000)
+		for l := lower; l <= upper; l += size {
+			lowerDatum, upperDatum := types.NewIntDatum(l), types.NewIntDatum(l+size-1)
+			hg.AppendBucket(&lowerDatum, &upperDatum, l+size-lower, 1)
+		}
+		for v := lower; v <= upper; v++ {
+			require.NoError(t, fms.InsertValue(sc, types.NewIntDatum(v)))
+		}
+		tn := NewTopN(len(topN))
+		for v, cnt := range topN {
+			encoded, err := codec.EncodeKey(sc.TimeZone(), nil, types.NewIntDatum(v))
+			require.NoError(t, err)
+			tn.AppendTopN(encoded, cnt)
+			require.NoError(t, fms.InsertValue(sc, types.NewIntDatum(v)))
+		}
+		tn.Sort()
+		return hg, tn, fms
+	}
+	topNCount := func(tn *TopN, v int64) uint64 {
+		encoded, err := codec.EncodeKey(sc.TimeZone(), nil, types.NewIntDatum(v))
+		require.NoError(t, err)
+		cnt, _ := tn.QueryTopN(nil, encoded)
+		return cnt
+	}
+
+	oldHist, oldTopN, oldFMS := buildStats(1, 100, 25, map[int64]uint64{0: 10})
+	newHist, newTopN, newFMS := buildStats(101, 150, 25, map[int64]uint64{0: 3, 200: 20})
+	hist, topN, fms, err := MergeIncrementalStats(sc, oldHist, newHist, oldTopN, newTopN, oldFMS, newFMS, 4, 2, false)
+	require.NoError(t, err)
+	// The counts of the same value in the TopNs are added up.
+	require.Equal(t, 2, topN.Num())
+	require.Equal(t, uint64(13), topNCount(topN, 0))
+	require.Equal(t, uint64(20), topNCount(topN, 200))
+	require.Equal(t, float64(150), hist.NotNullCount())
+	require.Equal(t, int64(152), fms.NDV())
+	require.Equal(t, int64(152), hist.NDV)
+	require.Equal(t, float64(1), hist.Correlation)
+	// The old stats are not modified.
+	require.Equal(t, 4, oldHist.Len())
+	require.Equal(t, int64(101), oldFMS.NDV())
+
+	// The value in the TopN of the appended rows is moved out of the old histogram.
+	newHist, newTopN, newFMS = buildStats(101, 150, 25, map[int64]uint64{50: 30})
+	hist, topN, _, err = MergeIncrementalStats(sc, oldHist, newHist, oldTopN, newTopN, oldFMS, newFMS, 4, 2, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(31), topNCount(topN, 50))
+	require.Equal(t, float64(149), hist.NotNullCount())
+
+	// Nothing is appended.
+	emptyHist := NewHistogram(1, 0, 0, 0, types.NewFieldType(mysql.TypeLonglong), 0, 0)
+	hist, topN, fms, err = MergeIncrementalStats(sc, oldHist, emptyHist, oldTopN, nil, oldFMS, nil, 4, 2, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), topNCount(topN, 0))
+	require.Equal(t, float64(100), hist.NotNullCount())
+	require.Equal(t, int64(101), hist.NDV)
+	require.Equal(t, int64(101), fms.NDV())
+}
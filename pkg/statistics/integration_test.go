@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/kv"
 	metamodel "github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/statistics"
@@ -623,3 +624,108 @@ func TestLastAnalyzeVersionNotChangedWithAsyncStatsLoad(t *testing.T) {
 	// The last analyze time.
 	require.Equal(t, "<nil>", result.Rows()[0][6])
 }
+
+func TestOptimizeWithStatsSnapshot(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("set global tidb_enable_historical_stats = true")
+	defer tk.MustExec("set global tidb_enable_historical_stats = default")
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4)")
+	tk.MustExec("analyze table t")
+	h := dom.StatsHandle()
+	tblInfo, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	_, err = h.RecordHistoricalStatsToStorage("test", tblInfo.Meta(), tblInfo.Meta().ID, false)
+	require.NoError(t, err)
+	snapshot, err := store.CurrentVersion(kv.GlobalTxnScope)
+	require.NoError(t, err)
+
+	tk.MustExec("insert into t select a + 4, b + 4 from t")
+	tk.MustExec("insert into t select a + 8, b + 8 from t")
+	tk.MustExec("analyze table t")
+	_, err = h.RecordHistoricalStatsToStorage("test", tblInfo.Meta(), tblInfo.Meta().ID, false)
+	require.NoError(t, err)
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+
+	tk.MustQuery("explain format = 'brief' select * from t").CheckAt([]int{0, 1}, testkit.Rows(
+		"TableReader 16.00",
+		"└─TableFullScan 16.00"))
+	// The hint pins the optimization to the stats at the snapshot.
+	tk.MustQuery(fmt.Sprintf("explain format = 'brief' select /*+ stats_snapshot_ts(%d) */ * from t", snapshot.Ver)).CheckAt([]int{0, 1}, testkit.Rows(
+		"TableReader 4.00",
+		"└─TableFullScan 4.00"))
+	// So does the session variable.
+	tk.MustExec(fmt.Sprintf("set @@tidb_opt_stats_snapshot_ts = %d", snapshot.Ver))
+	tk.MustQuery("explain format = 'brief' select * from t").CheckAt([]int{0, 1}, testkit.Rows(
+		"TableReader 4.00",
+		"└─TableFullScan 4.00"))
+	// The hint overrides the session variable, and the latest stats are used if there are no historical stats.
+	tk.MustQuery("explain format = 'brief' select /*+ stats_snapshot_ts(1) */ * from t").CheckAt([]int{0, 1}, testkit.Rows(
+		"TableReader 16.00",
+		"└─TableFullScan 16.00"))
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1105 no historical stats of table t at 1, use the latest stats"))
+	tk.MustExec("set @@tidb_opt_stats_snapshot_ts = default")
+	tk.MustQuery("explain format = 'brief' select * from t").CheckAt([]int{0, 1}, testkit.Rows(
+		"TableReader 16.00",
+		"└─TableFullScan 16.00"))
+
+	// The plans cached with the latest stats aren't reused for the queries pinned to a stats snapshot.
+	tk.MustExec("prepare stmt from 'select * from t where a > ?'")
+	tk.MustExec("set @a = 0")
+	tk.MustExec("execute stmt using @a")
+	tk.MustExec("execute stmt using @a")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk.MustExec(fmt.Sprintf("set @@tidb_opt_stats_snapshot_ts = %d", snapshot.Ver))
+	tk.MustExec("execute stmt using @a")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	tk.MustExec("set @@tidb_opt_stats_snapshot_ts = default")
+}
+
+func TestOptimizeStaleReadWithHistoricalStats(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("set global tidb_enable_historical_stats = true")
+	defer tk.MustExec("set global tidb_enable_historical_stats = default")
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4)")
+	tk.MustExec("analyze table t")
+	h := dom.StatsHandle()
+	tblInfo, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	_, err = h.RecordHistoricalStatsToStorage("test", tblInfo.Meta(), tblInfo.Meta().ID, false)
+	require.NoError(t, err)
+	// The read timestamp is in milliseconds, so make sure it's after the historical stats.
+	time.Sleep(10 * time.Millisecond)
+	tk.MustExec("set @a = now(6)")
+	time.Sleep(10 * time.Millisecond)
+
+	tk.MustExec("insert into t select a + 4, b + 4 from t")
+	tk.MustExec("insert into t select a + 8, b + 8 from t")
+	tk.MustExec("analyze table t")
+	_, err = h.RecordHistoricalStatsToStorage("test", tblInfo.Meta(), tblInfo.Meta().ID, false)
+	require.NoError(t, err)
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+
+	// The stale read is optimized with the latest stats by default.
+	tk.MustQuery("explain format = 'brief' select * from t as of timestamp(@a)").CheckAt([]int{0, 1}, testkit.Rows(
+		"TableReader 16.00",
+		"└─TableFullScan 16.00"))
+	tk.MustExec("set @@tidb_opt_stale_read_historical_stats = on")
+	defer tk.MustExec("set @@tidb_opt_stale_read_historical_stats = default")
+	tk.MustQuery("explain format = 'brief' select * from t as of timestamp(@a)").CheckAt([]int{0, 1}, testkit.Rows(
+		"TableReader 4.00",
+		"└─TableFullScan 4.00"))
+	// So is the read by tidb_snapshot.
+	tk.MustExec("set @@tidb_snapshot = @a")
+	tk.MustQuery("explain format = 'brief' select * from t").CheckAt([]int{0, 1}, testkit.Rows(
+		"TableReader 4.00",
+		"└─TableFullScan 4.00"))
+	tk.MustExec("set @@tidb_snapshot = ''")
+	// The queries reading the latest data aren't affected.
+	tk.MustQuery("explain format = 'brief' select * from t").CheckAt([]int{0, 1}, testkit.Rows(
+		"TableReader 16.00",
+		"└─TableFullScan 16.00"))
+}
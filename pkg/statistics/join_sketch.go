@@ -0,0 +1,186 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/twmb/murmur3"
+)
+
+// MaxJoinSketchSize is the max number of the distinct values kept in the join sketch of a column.
+const MaxJoinSketchSize = 1024
+
+// JoinSketch is a small hash sketch of a join key column, which is built from the samples during analyze.
+// It keeps the sampled values whose hash values are the smallest ones, together with their estimated row counts.
+// Since the same hash function is used for all the tables, the sketches of the two sides of a join keep the same
+// subset of the values, so the join output size can be estimated from the matched values directly instead of
+// assuming the containment of the values by the NDVs, which often goes wrong for the schemas without foreign keys.
+// The sketch is declared on a single column rather than on a pair of tables. Because the hash function is shared,
+// any two columns with join sketches and comparable types can be paired when they are joined, and a column joined
+// with multiple tables needs only one sketch.
+type JoinSketch struct {
+	// Hashes are the hash values of the kept values in ascending order.
+	Hashes []uint64 `json:"hashes"`
+	// Counts are the estimated row counts of the kept values, in the same order as Hashes.
+	Counts []float64 `json:"counts"`
+	// Threshold is the max hash value kept in the sketch. All the sampled values whose hash values are not
+	// greater than it are kept. It's math.MaxUint64 if all the sampled values are kept.
+	Threshold uint64 `json:"threshold"`
+	// RowCount is the number of the rows, including the null ones, when the sketch is built.
+	RowCount float64 `json:"row_count"`
+}
+
+// hashJoinKey hashes a value of the join key. The unsigned integers which fit in int64 are hashed as the signed
+// ones so that the same values of the signed and unsigned columns can be matched.
+func hashJoinKey(loc *time.Location, d types.Datum, buf []byte) (uint64, []byte, error) {
+	if d.Kind() == types.KindUint64 && d.GetUint64() <= math.MaxInt64 {
+		d = types.NewIntDatum(int64(d.GetUint64()))
+	}
+	buf, err := codec.EncodeValue(loc, buf[:0], d)
+	if err != nil {
+		return 0, buf, errors.Trace(err)
+	}
+	return murmur3.Sum64(buf), buf, nil
+}
+
+// BuildJoinSketch builds the join sketch from the samples of a column. nonNullCount and nullCount are the numbers
+// of the non-null and null rows of the column, which are used to scale the sampled counts to the table.
+func BuildJoinSketch(loc *time.Location, samples []*SampleItem, nonNullCount, nullCount int64) (*JoinSketch, error) {
+	counts := make(map[uint64]float64)
+	var (
+		sampleCount float64
+		buf         []byte
+	)
+	for _, sample := range samples {
+		if sample.Value.IsNull() {
+			continue
+		}
+		var (
+			h   uint64
+			err error
+		)
+		h, buf, err = hashJoinKey(loc, sample.Value, buf)
+		if err != nil {
+			return nil, err
+		}
+		counts[h]++
+		sampleCount++
+	}
+	sketch := &JoinSketch{Threshold: math.MaxUint64, RowCount: float64(nonNullCount + nullCount)}
+	if sampleCount == 0 {
+		return sketch, nil
+	}
+	scale := max(float64(nonNullCount), sampleCount) / sampleCount
+	hashes := make([]uint64, 0, len(counts))
+	for h := range counts {
+		hashes = append(hashes, h)
+	}
+	slices.Sort(hashes)
+	if len(hashes) > MaxJoinSketchSize {
+		hashes = hashes[:MaxJoinSketchSize]
+		sketch.Threshold = hashes[MaxJoinSketchSize-1]
+	}
+	sketch.Hashes = hashes
+	sketch.Counts = make([]float64, 0, len(hashes))
+	for _, h := range hashes {
+		sketch.Counts = append(sketch.Counts, counts[h]*scale)
+	}
+	return sketch, nil
+}
+
+// EstimateJoinSize estimates the row count of the equal join of the two columns when the sketches are built.
+// Only the values whose hash values are not greater than the smaller threshold are kept by both sketches, so the
+// matched size of them is scaled up by the fraction of the hash space they cover.
+// ok is false if any of the sketches is empty.
+func EstimateJoinSize(s1, s2 *JoinSketch) (size float64, ok bool) {
+	if s1 == nil || s2 == nil || len(s1.Hashes) == 0 || len(s2.Hashes) == 0 {
+		return 0, false
+	}
+	threshold := min(s1.Threshold, s2.Threshold)
+	for i, j := 0, 0; i < len(s1.Hashes) && j < len(s2.Hashes); {
+		h1, h2 := s1.Hashes[i], s2.Hashes[j]
+		if h1 > threshold || h2 > threshold {
+			break
+		}
+		switch {
+		case h1 < h2:
+			i++
+		case h1 > h2:
+			j++
+		default:
+			size += s1.Counts[i] * s2.Counts[j]
+			i++
+			j++
+		}
+	}
+	if threshold == math.MaxUint64 {
+		return size, true
+	}
+	return size / (float64(threshold) / math.MaxUint64), true
+}
+
+// EncodeJoinSketch encodes the join sketch to the string persisted in mysql.stats_extended.
+func EncodeJoinSketch(s *JoinSketch) (string, error) {
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(bytes), nil
+}
+
+// DecodeJoinSketch decodes the join sketch from the string persisted in mysql.stats_extended.
+func DecodeJoinSketch(str string) (*JoinSketch, error) {
+	s := &JoinSketch{}
+	if err := json.Unmarshal([]byte(str), s); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(s.Hashes) != len(s.Counts) {
+		return nil, errors.Errorf("invalid join sketch, %d hashes with %d counts", len(s.Hashes), len(s.Counts))
+	}
+	return s, nil
+}
+
+// JoinSketchesByUniqueID collects the join sketches of the given columns, keyed by the column UniqueID.
+func (coll *ExtendedStatsColl) JoinSketchesByUniqueID(cols []*expression.Column) map[int64]*JoinSketch {
+	if coll == nil {
+		return nil
+	}
+	var res map[int64]*JoinSketch
+	for _, item := range coll.Stats {
+		if item.Tp != ast.StatsTypeJoinSketch || item.JoinSketch == nil || len(item.ColIDs) != 1 {
+			continue
+		}
+		for _, col := range cols {
+			if col.ID != item.ColIDs[0] {
+				continue
+			}
+			if res == nil {
+				res = make(map[int64]*JoinSketch)
+			}
+			res[col.UniqueID] = item.JoinSketch
+			break
+		}
+	}
+	return res
+}
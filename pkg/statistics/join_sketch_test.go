@@ -0,0 +1,75 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoinSketch(t *testing.T) {
+	buildSketch := func(start, end, repeat int, unsigned bool, nullCount int64) *JoinSketch {
+		samples := make([]*SampleItem, 0, (end-start)*repeat)
+		for i := start; i < end; i++ {
+			for range repeat {
+				d := types.NewIntDatum(int64(i))
+				if unsigned {
+					d = types.NewUintDatum(uint64(i))
+				}
+				samples = append(samples, &SampleItem{Value: d})
+			}
+		}
+		samples = append(samples, &SampleItem{Value: types.NewDatum(nil)})
+		// The samples are the half of the non-null rows.
+		sketch, err := BuildJoinSketch(time.UTC, samples, int64(len(samples)-1)*2, nullCount)
+		require.NoError(t, err)
+		return sketch
+	}
+	s1 := buildSketch(0, 100, 1, false, 10)
+	require.Len(t, s1.Hashes, 100)
+	require.Equal(t, uint64(math.MaxUint64), s1.Threshold)
+	require.Equal(t, float64(210), s1.RowCount)
+	require.Equal(t, float64(2), s1.Counts[0])
+
+	// The signed and unsigned values are matched.
+	s2 := buildSketch(50, 150, 2, true, 0)
+	size, ok := EstimateJoinSize(s1, s2)
+	require.True(t, ok)
+	require.Equal(t, float64(50*2*4), size)
+
+	_, ok = EstimateJoinSize(s1, &JoinSketch{})
+	require.False(t, ok)
+
+	// Only the smallest hash values are kept for the large NDV, and the join size is scaled by the kept fraction.
+	s3 := buildSketch(0, MaxJoinSketchSize*4, 1, false, 0)
+	s4 := buildSketch(0, MaxJoinSketchSize*4, 1, false, 0)
+	require.Len(t, s3.Hashes, MaxJoinSketchSize)
+	require.Less(t, s3.Threshold, uint64(math.MaxUint64))
+	size, ok = EstimateJoinSize(s3, s4)
+	require.True(t, ok)
+	require.InEpsilon(t, float64(MaxJoinSketchSize*4*4), size, 0.2)
+
+	str, err := EncodeJoinSketch(s3)
+	require.NoError(t, err)
+	decoded, err := DecodeJoinSketch(str)
+	require.NoError(t, err)
+	require.Equal(t, s3, decoded)
+	_, err = DecodeJoinSketch(`{"hashes":[1],"counts":[]}`)
+	require.Error(t, err)
+}
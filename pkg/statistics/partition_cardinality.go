@@ -0,0 +1,144 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+)
+
+// PartitionCardinality summarizes how the rows of a partitioned table are distributed over its partitions.
+// Under the dynamic prune mode the planner only sees the global stats, which can't tell how many rows
+// live in the partitions that are left after partition pruning. For LIST and HASH partitioned tables,
+// where the partition a row belongs to is decided by its value rather than a range, the summary is the
+// only cheap way to know it.
+type PartitionCardinality struct {
+	// RowCounts maps the partition ID to its realtime row count.
+	RowCounts map[int64]int64
+	// ValueNDVs maps the partition ID to the number of the distinct partition column values in it, which is
+	// collected by analyze. The partition whose partition column is not analyzed is absent.
+	ValueNDVs map[int64]int64
+	// TotalRowCount is the sum of all the row counts in RowCounts.
+	TotalRowCount int64
+	// MissingPartitions is the number of partitions whose stats are not available(pseudo or not loaded).
+	MissingPartitions int
+}
+
+// NewPartitionCardinality builds the summary for the given partitions. The partColID is the ID of the column the
+// partitions are decided by, or 0 if they're decided by multiple columns.
+// The getter returns the stats of a partition, and a nil or pseudo table is treated as missing.
+func NewPartitionCardinality(partitionIDs []int64, partColID int64, getter func(pid int64) *Table) *PartitionCardinality {
+	pc := &PartitionCardinality{
+		RowCounts: make(map[int64]int64, len(partitionIDs)),
+		ValueNDVs: make(map[int64]int64, len(partitionIDs)),
+	}
+	for _, pid := range partitionIDs {
+		tbl := getter(pid)
+		if tbl == nil || tbl.Pseudo {
+			pc.MissingPartitions++
+			continue
+		}
+		rowCount := max(tbl.RealtimeCount, 0)
+		pc.RowCounts[pid] = rowCount
+		pc.TotalRowCount += rowCount
+		if partColID <= 0 {
+			continue
+		}
+		if col := tbl.GetCol(partColID); col != nil && col.IsAnalyzed() && col.Histogram.NDV > 0 {
+			pc.ValueNDVs[pid] = col.Histogram.NDV
+		}
+	}
+	return pc
+}
+
+// IsComplete returns whether all the partitions have stats.
+func (pc *PartitionCardinality) IsComplete() bool {
+	return pc != nil && pc.MissingPartitions == 0
+}
+
+// RowCountOfPartitions returns the combined row count of the given partitions.
+// The ok is false when some of the partitions' stats are missing, so the result can't be trusted.
+func (pc *PartitionCardinality) RowCountOfPartitions(partitionIDs []int64) (rowCount int64, ok bool) {
+	if pc == nil {
+		return 0, false
+	}
+	for _, pid := range partitionIDs {
+		cnt, found := pc.RowCounts[pid]
+		if !found {
+			return 0, false
+		}
+		rowCount += cnt
+	}
+	return rowCount, true
+}
+
+// RowCountOfValues returns the combined row count of the given partitions matching at most valueCount distinct
+// partition column values. Each value matches the average rows per value of the partition, so a partition matches
+// at most valueCount/NDV of its rows, and all of them if its NDV is unknown.
+// The ok is false when some of the partitions' stats are missing, so the result can't be trusted.
+func (pc *PartitionCardinality) RowCountOfValues(partitionIDs []int64, valueCount int) (rowCount float64, ok bool) {
+	if pc == nil {
+		return 0, false
+	}
+	for _, pid := range partitionIDs {
+		cnt, found := pc.RowCounts[pid]
+		if !found {
+			return 0, false
+		}
+		if ndv := pc.ValueNDVs[pid]; ndv > int64(valueCount) {
+			rowCount += float64(cnt) * float64(valueCount) / float64(ndv)
+			continue
+		}
+		rowCount += float64(cnt)
+	}
+	return rowCount, true
+}
+
+// EncodedColumnBounds returns the min and max non-null values of the column in the stats, which are collected from
+// the histogram and the TopN built by analyze. They're encoded by codec.EncodeKey so that they can be compared with
+// the encoded ranges directly. Note that the values modified after analyze might be out of the bounds.
+// ok is false if the column is not analyzed, its stats are not fully loaded, or it has no non-null values.
+func (t *Table) EncodedColumnBounds(colID int64) (lower, upper []byte, ok bool) {
+	col := t.GetCol(colID)
+	if col == nil || !col.IsAnalyzed() || !col.IsFullLoad() {
+		return nil, nil, false
+	}
+	update := func(val []byte) {
+		if lower == nil || bytes.Compare(val, lower) < 0 {
+			lower = val
+		}
+		if upper == nil || bytes.Compare(val, upper) > 0 {
+			upper = val
+		}
+	}
+	if col.Histogram.Len() > 0 {
+		for _, d := range []*types.Datum{col.Histogram.GetLower(0), col.Histogram.GetUpper(col.Histogram.Len() - 1)} {
+			val, err := codec.EncodeKey(time.UTC, nil, *d)
+			if err != nil {
+				return nil, nil, false
+			}
+			update(val)
+		}
+	}
+	if col.TopN != nil {
+		for _, meta := range col.TopN.TopN {
+			update(meta.Encoded)
+		}
+	}
+	return lower, upper, lower != nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionCardinality(t *testing.T) {
+	const partColID = 1
+	newTable := func(realtimeCount, ndv int64, pseudo bool) *Table {
+		tbl := &Table{HistColl: *NewHistColl(1, realtimeCount, 0, 1, 0)}
+		tbl.Pseudo = pseudo
+		if ndv > 0 {
+			tbl.SetCol(partColID, &Column{
+				Histogram:         *NewHistogram(partColID, ndv, 0, 0, types.NewFieldType(mysql.TypeLong), 0, 0),
+				StatsLoadedStatus: NewStatsFullLoadStatus(),
+				StatsVer:          Version2,
+			})
+		}
+		return tbl
+	}
+	tables := map[int64]*Table{
+		1: newTable(100, 10, false),
+		2: newTable(0, 0, false),
+		3: newTable(300, 0, false),
+		4: newTable(50, 5, true),
+	}
+	getter := func(pid int64) *Table {
+		return tables[pid]
+	}
+
+	pc := NewPartitionCardinality([]int64{1, 2, 3}, partColID, getter)
+	require.True(t, pc.IsComplete())
+	require.Equal(t, int64(400), pc.TotalRowCount)
+	rowCount, ok := pc.RowCountOfPartitions([]int64{1, 2})
+	require.True(t, ok)
+	require.Equal(t, int64(100), rowCount)
+	_, ok = pc.RowCountOfPartitions([]int64{1, 5})
+	require.False(t, ok)
+
+	// The partition matches valueCount/NDV of its rows, or all of them if its NDV is unknown.
+	valueRowCount, ok := pc.RowCountOfValues([]int64{1, 3}, 2)
+	require.True(t, ok)
+	require.Equal(t, float64(100*2/10+300), valueRowCount)
+	valueRowCount, ok = pc.RowCountOfValues([]int64{1}, 20)
+	require.True(t, ok)
+	require.Equal(t, float64(100), valueRowCount)
+	_, ok = pc.RowCountOfValues([]int64{1, 5}, 1)
+	require.False(t, ok)
+	// The NDVs are unknown if the partitions are decided by multiple columns.
+	valueRowCount, ok = NewPartitionCardinality([]int64{1}, 0, getter).RowCountOfValues([]int64{1}, 1)
+	require.True(t, ok)
+	require.Equal(t, float64(100), valueRowCount)
+
+	// Pseudo and missing partitions make the summary incomplete.
+	pc = NewPartitionCardinality([]int64{1, 4, 5}, partColID, getter)
+	require.False(t, pc.IsComplete())
+	require.Equal(t, 2, pc.MissingPartitions)
+
+	var nilPC *PartitionCardinality
+	require.False(t, nilPC.IsComplete())
+	_, ok = nilPC.RowCountOfPartitions([]int64{1})
+	require.False(t, ok)
+	_, ok = nilPC.RowCountOfValues([]int64{1}, 1)
+	require.False(t, ok)
+}
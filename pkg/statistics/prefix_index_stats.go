@@ -0,0 +1,220 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tidb/pkg/util/ranger"
+)
+
+// The global indexes with prefix columns are skipped by the auto analyze, so they usually have no stats. For
+// such an index on a single column, its stats can be derived from the stats of the column approximately: the
+// bounds of the column histogram and the TopN values are the collate keys of the values, they are truncated to
+// the prefix length and encoded as the index keys.
+
+// PrefixIndexBaseColumn returns the column whose stats can be used to derive the stats of the global index with
+// prefix column. It returns nil if the index is not such an index, or the collate keys of the column can't be
+// truncated as the values, which is only true for the binary collations.
+func PrefixIndexBaseColumn(tblInfo *model.TableInfo, idxInfo *model.IndexInfo) *model.ColumnInfo {
+	if !idxInfo.Global || len(idxInfo.Columns) != 1 || idxInfo.Columns[0].Length == types.UnspecifiedLength {
+		return nil
+	}
+	colInfo := tblInfo.Columns[idxInfo.Columns[0].Offset]
+	if colInfo.IsVirtualGenerated() || colInfo.FieldType.EvalType() != types.ETString ||
+		colInfo.GetType() == mysql.TypeEnum || colInfo.GetType() == mysql.TypeSet {
+		return nil
+	}
+	if collate.NewCollationEnabled() && !collate.IsBinCollation(colInfo.GetCollate()) {
+		return nil
+	}
+	return colInfo
+}
+
+// PrefixGlobalIndexes returns the public global indexes with prefix column of the partitioned table, whose
+// stats can be derived from their base columns.
+func PrefixGlobalIndexes(tblInfo *model.TableInfo) []*model.IndexInfo {
+	if tblInfo.Partition == nil {
+		return nil
+	}
+	var idxInfos []*model.IndexInfo
+	for _, idxInfo := range tblInfo.Indices {
+		if idxInfo.State == model.StatePublic && PrefixIndexBaseColumn(tblInfo, idxInfo) != nil {
+			idxInfos = append(idxInfos, idxInfo)
+		}
+	}
+	return idxInfos
+}
+
+// DerivePrefixGlobalIndexStatsOnColumn derives the stats of the never analyzed global indexes with prefix
+// column based on the column, whose full stats have just been loaded into the global stats table. The derived
+// stats are set to the table, they are not marked as analyzed since they are not in the storage.
+func DerivePrefixGlobalIndexStatsOnColumn(tbl *Table, tblInfo *model.TableInfo, col *Column) error {
+	if tbl.PhysicalID != tblInfo.ID {
+		return nil
+	}
+	for _, idxInfo := range PrefixGlobalIndexes(tblInfo) {
+		if tblInfo.Columns[idxInfo.Columns[0].Offset].ID != col.ID || tbl.ColAndIdxExistenceMap.HasAnalyzed(idxInfo.ID, true) {
+			continue
+		}
+		if idx := tbl.GetIdx(idxInfo.ID); idx != nil && idx.LastUpdateVersion >= col.LastUpdateVersion {
+			continue
+		}
+		idx, err := DerivePrefixIndexStats(col, idxInfo)
+		if err != nil || idx == nil {
+			return err
+		}
+		tbl.SetIdx(idxInfo.ID, idx)
+		tbl.ColAndIdxExistenceMap.InsertIndex(idxInfo.ID, false)
+	}
+	return nil
+}
+
+// prefixKeyEncoder truncates the collate keys of the column to the prefix length and encodes them as the index
+// keys, in the same way as the analyze builds the samples of the index.
+type prefixKeyEncoder struct {
+	prefixLen int
+	colInfo   *model.ColumnInfo
+	buf       types.Datum
+}
+
+func (e *prefixKeyEncoder) encode(key []byte) ([]byte, error) {
+	e.buf.SetString(string(key), e.colInfo.GetCollate())
+	ranger.CutDatumByPrefixLen(&e.buf, e.prefixLen, &e.colInfo.FieldType)
+	encoded, err := codec.EncodeKey(time.UTC, nil, e.buf)
+	return encoded, errors.Trace(err)
+}
+
+// DerivePrefixIndexStats derives the stats of the global index with prefix column from the stats of its base
+// column, see PrefixIndexBaseColumn. The adjacent buckets whose truncated bounds overlap are merged, and the
+// TopN values which become the same are merged too. It returns nil if the column has no stats of version 2.
+func DerivePrefixIndexStats(col *Column, idxInfo *model.IndexInfo) (*Index, error) {
+	if col == nil || col.StatsVer != Version2 || !col.IsFullLoad() {
+		return nil, nil
+	}
+	hg := &col.Histogram
+	e := &prefixKeyEncoder{prefixLen: idxInfo.Columns[0].Length, colInfo: col.Info}
+
+	var topN *TopN
+	if col.TopN != nil && len(col.TopN.TopN) > 0 {
+		counts := make(map[string]uint64, len(col.TopN.TopN))
+		for _, meta := range col.TopN.TopN {
+			_, d, err := codec.DecodeOne(meta.Encoded)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			encoded, err := e.encode(d.GetBytes())
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			counts[string(encoded)] += meta.Count
+		}
+		topN = NewTopN(len(counts))
+		for encoded, count := range counts {
+			topN.AppendTopN([]byte(encoded), count)
+		}
+		topN.Sort()
+	}
+
+	idxHist := NewHistogram(idxInfo.ID, 0, hg.NullCount, hg.LastUpdateVersion, types.NewFieldType(mysql.TypeBlob), hg.Len(), hg.TotColSize)
+	// The NDV of the buckets is not kept by the merged global stats, it's estimated by the row count then.
+	histNDV := max(hg.NDV-int64(col.TopN.Num()), 0)
+	histRows := hg.NotNullCount()
+	var lastUpper []byte
+	for i := range hg.Buckets {
+		bucket := hg.Buckets[i]
+		count := bucket.Count
+		if i > 0 {
+			count -= hg.Buckets[i-1].Count
+		}
+		lower, err := e.encode(hg.GetLower(i).GetBytes())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		upper, err := e.encode(hg.GetUpper(i).GetBytes())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		repeat, ndv := bucket.Repeat, bucket.NDV
+		if ndv == 0 && histRows > 0 {
+			ndv = max(int64(math.Round(float64(histNDV)*float64(count)/histRows)), 1)
+		}
+		// The values in a bucket become the same one if its bounds are truncated to the same value.
+		if bytes.Equal(lower, upper) {
+			repeat, ndv = count, 1
+		}
+		n := idxHist.Len()
+		if n > 0 && bytes.Equal(lower, lastUpper) {
+			// The lower bound is the same as the upper bound of the last bucket, merge the bucket into it.
+			last := &idxHist.Buckets[n-1]
+			if bytes.Equal(upper, lastUpper) {
+				last.Repeat += count
+			} else {
+				idxHist.Bounds.TruncateTo(2*n - 1)
+				idxHist.Bounds.AppendBytes(0, upper)
+				last.Repeat = repeat
+				last.NDV += max(ndv-1, 0)
+			}
+			last.Count += count
+		} else {
+			lastCount := int64(0)
+			if n > 0 {
+				lastCount = idxHist.Buckets[n-1].Count
+			}
+			lowerDatum, upperDatum := types.NewBytesDatum(lower), types.NewBytesDatum(upper)
+			idxHist.AppendBucketWithNDV(&lowerDatum, &upperDatum, lastCount+count, repeat, ndv)
+		}
+		lastUpper = upper
+	}
+	// The truncation never makes more distinct values, and the TopN values may become the same as the values in
+	// the buckets.
+	var idxNDV int64
+	for _, bucket := range idxHist.Buckets {
+		idxNDV += bucket.NDV
+	}
+	if topN != nil {
+		for _, meta := range topN.TopN {
+			if !prefixKeyInBuckets(idxHist, meta.Encoded) {
+				idxNDV++
+			}
+		}
+	}
+	idxHist.NDV = min(hg.NDV, idxNDV)
+	idxHist.PreCalculateScalar()
+	return &Index{
+		Histogram:         *idxHist,
+		TopN:              topN,
+		Info:              idxInfo,
+		StatsVer:          Version2,
+		PhysicalID:        col.PhysicalID,
+		StatsLoadedStatus: NewStatsFullLoadStatus(),
+	}, nil
+}
+
+func prefixKeyInBuckets(hg *Histogram, key []byte) bool {
+	i := sort.Search(hg.Len(), func(i int) bool {
+		return bytes.Compare(hg.GetUpper(i).GetBytes(), key) >= 0
+	})
+	return i < hg.Len() && bytes.Compare(hg.GetLower(i).GetBytes(), key) <= 0
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// MaxPseudoStatsUsageEntries is the max number of the tables tracked by PseudoStatsUsage. The usages of the new
+	// tables are dropped once the limit is reached and no tracked table is idle in the window.
+	MaxPseudoStatsUsageEntries = 4096
+	// PseudoStatsUsageWindow is the window in which the pseudo stats usages are counted.
+	PseudoStatsUsageWindow = time.Hour
+	// pseudoStatsUsageBuckets is the number of the per-minute buckets in the window.
+	pseudoStatsUsageBuckets = int64(PseudoStatsUsageWindow / time.Minute)
+)
+
+// pseudoStatsUsageEntry counts the pseudo stats usages of a table by minute in a ring.
+type pseudoStatsUsageEntry struct {
+	// minutes[i] is the minute, i.e. the unix time in minutes, counted by counts[i].
+	minutes [pseudoStatsUsageBuckets]int64
+	counts  [pseudoStatsUsageBuckets]int64
+}
+
+func (e *pseudoStatsUsageEntry) count(minute int64) int64 {
+	var total int64
+	for i, m := range e.minutes {
+		if minute-m < pseudoStatsUsageBuckets {
+			total += e.counts[i]
+		}
+	}
+	return total
+}
+
+// PseudoStatsUsage counts how many times the optimizer uses the pseudo stats of each table in the last
+// PseudoStatsUsageWindow, which tells the tables whose missing or outdated stats actually hurt the queries.
+type PseudoStatsUsage struct {
+	entries map[int64]*pseudoStatsUsageEntry
+	mu      sync.Mutex
+}
+
+// NewPseudoStatsUsage creates a PseudoStatsUsage.
+func NewPseudoStatsUsage() *PseudoStatsUsage {
+	return &PseudoStatsUsage{entries: make(map[int64]*pseudoStatsUsageEntry)}
+}
+
+// Record records a pseudo stats usage of the table at now.
+func (u *PseudoStatsUsage) Record(tableID int64, now time.Time) {
+	minute := now.Unix() / 60
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	entry, ok := u.entries[tableID]
+	if !ok {
+		if len(u.entries) >= MaxPseudoStatsUsageEntries {
+			u.evictIdleLocked(minute)
+			if len(u.entries) >= MaxPseudoStatsUsageEntries {
+				return
+			}
+		}
+		entry = &pseudoStatsUsageEntry{}
+		u.entries[tableID] = entry
+	}
+	idx := minute % pseudoStatsUsageBuckets
+	if entry.minutes[idx] != minute {
+		entry.minutes[idx] = minute
+		entry.counts[idx] = 0
+	}
+	entry.counts[idx]++
+}
+
+// evictIdleLocked removes the tables without any usage in the window.
+func (u *PseudoStatsUsage) evictIdleLocked(minute int64) {
+	for tableID, entry := range u.entries {
+		if entry.count(minute) == 0 {
+			delete(u.entries, tableID)
+		}
+	}
+}
+
+// Count returns the number of the pseudo stats usages of the table in the window before now.
+func (u *PseudoStatsUsage) Count(tableID int64, now time.Time) int64 {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	entry, ok := u.entries[tableID]
+	if !ok {
+		return 0
+	}
+	return entry.count(now.Unix() / 60)
+}
+
+// Reset removes all the recorded usages.
+func (u *PseudoStatsUsage) Reset() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	clear(u.entries)
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPseudoStatsUsage(t *testing.T) {
+	u := NewPseudoStatsUsage()
+	now := time.Unix(1700000000, 0)
+	u.Record(1, now)
+	u.Record(1, now.Add(time.Second))
+	u.Record(1, now.Add(30*time.Minute))
+	u.Record(2, now)
+	require.Equal(t, int64(3), u.Count(1, now.Add(30*time.Minute)))
+	require.Equal(t, int64(1), u.Count(2, now.Add(30*time.Minute)))
+	require.Equal(t, int64(0), u.Count(3, now))
+
+	// The usages out of the window are not counted.
+	require.Equal(t, int64(1), u.Count(1, now.Add(PseudoStatsUsageWindow+time.Minute)))
+	require.Equal(t, int64(0), u.Count(2, now.Add(PseudoStatsUsageWindow+time.Minute)))
+	// The bucket reused by a later minute is reset.
+	later := now.Add(PseudoStatsUsageWindow)
+	u.Record(1, later)
+	require.Equal(t, int64(2), u.Count(1, later))
+
+	// The idle tables are evicted when the tracked tables reach the limit, and the usages of the new tables are
+	// dropped if no table is idle.
+	for i := range MaxPseudoStatsUsageEntries - 2 {
+		u.Record(int64(i+100), later)
+	}
+	u.Record(3, later)
+	require.Equal(t, int64(1), u.Count(3, later))
+	require.NotContains(t, u.entries, int64(2))
+	require.Len(t, u.entries, MaxPseudoStatsUsageEntries)
+	u.Record(4, later)
+	require.Equal(t, int64(0), u.Count(4, later))
+
+	u.Reset()
+	require.Equal(t, int64(0), u.Count(1, later))
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"bytes"
+	"math"
+	"slices"
+
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/codec"
+)
+
+// pseudoIndexNDV is the NDV of the non-unique indexes in the quick stats, with which an equal condition selects 1/1000
+// of the rows, the same as the pseudo stats.
+const pseudoIndexNDV = 1000
+
+// RegionProperties is the approximate size and keys of a region reported by TiKV, which overlaps with the records or
+// an index of a table.
+type RegionProperties struct {
+	// StartKey and EndKey are the raw boundary keys of the region, nil means unbounded.
+	StartKey, EndKey []byte
+	// ApproximateSize is the approximate size of the region in bytes.
+	ApproximateSize int64
+	// ApproximateKeys is the approximate number of the keys in the region.
+	ApproximateKeys int64
+}
+
+// QuickStats is the approximate stats of a physical table derived from the properties of its regions without scanning
+// the data, which is built by ANALYZE TABLE ... QUICK.
+type QuickStats struct {
+	// RowCount is the approximate row count.
+	RowCount int64
+	// TotColSizes is the approximate total size of each column, from which the average row size is derived.
+	TotColSizes map[int64]int64
+	// IndexHists is the histograms of the indexes built from the boundaries of their regions. The indexes within a
+	// single region have no histogram.
+	IndexHists []*Histogram
+}
+
+// BuildQuickStats builds the quick stats of the physical table from the properties of its record regions and the
+// regions of each index.
+func BuildQuickStats(tblInfo *model.TableInfo, physicalID int64, records []RegionProperties, indexes map[int64][]RegionProperties) *QuickStats {
+	var keys, size int64
+	for _, r := range records {
+		keys += r.ApproximateKeys
+		size += r.ApproximateSize
+	}
+	qs := &QuickStats{RowCount: keys}
+	// The only region of a small table may be shared with the other tables, so the approximate keys of it are only used
+	// as an upper bound of PseudoRowCount, the same as the estimation of the pseudo stats.
+	if len(records) == 1 {
+		qs.RowCount = min(keys, PseudoRowCount)
+		size = int64(float64(size) * float64(qs.RowCount) / float64(max(keys, 1)))
+	}
+	if qs.RowCount <= 0 {
+		return qs
+	}
+
+	// The size of the records is divided among the columns by their estimated widths.
+	cols := make([]*model.ColumnInfo, 0, len(tblInfo.Columns))
+	totalWidth := 0
+	for _, col := range tblInfo.Columns {
+		if col.State != model.StatePublic || col.IsVirtualGenerated() {
+			continue
+		}
+		cols = append(cols, col)
+		totalWidth += chunk.EstimateTypeWidth(&col.FieldType)
+	}
+	if size > 0 && totalWidth > 0 {
+		qs.TotColSizes = make(map[int64]int64, len(cols))
+		for _, col := range cols {
+			qs.TotColSizes[col.ID] = size * int64(chunk.EstimateTypeWidth(&col.FieldType)) / int64(totalWidth)
+		}
+	}
+
+	for _, idx := range tblInfo.Indices {
+		if idx.State != model.StatePublic || idx.MVIndex || idx.VectorInfo != nil {
+			continue
+		}
+		if hg := BuildIndexHistogramByRegions(physicalID, idx, qs.RowCount, indexes[idx.ID]); hg != nil {
+			qs.IndexHists = append(qs.IndexHists, hg)
+		}
+	}
+	return qs
+}
+
+// BuildIndexHistogramByRegions builds the histogram of the index from the boundaries of its regions. Each bucket is
+// between two adjacent boundaries in the index, and its count is the approximate keys of the region starting at the
+// lower bound, scaled to the row count. The keys of the regions before the first boundary and after the last one are
+// counted in the first and the last buckets, so the min and the max of the histogram are only rough ones. It returns
+// nil if there are less than 2 boundaries in the index.
+func BuildIndexHistogramByRegions(physicalID int64, idx *model.IndexInfo, rowCount int64, regions []RegionProperties) *Histogram {
+	if rowCount <= 0 || len(regions) < 2 {
+		return nil
+	}
+	regions = slices.Clone(regions)
+	slices.SortFunc(regions, func(a, b RegionProperties) int {
+		return bytes.Compare(a.StartKey, b.StartKey)
+	})
+	prefix := tablecodec.EncodeTableIndexPrefix(physicalID, idx.ID)
+	var (
+		bounds [][]byte
+		counts []int64
+		// head is the keys of the regions before the first boundary.
+		head  int64
+		total int64
+	)
+	for _, r := range regions {
+		total += r.ApproximateKeys
+		bound := indexRegionBound(r.StartKey, prefix, len(idx.Columns))
+		switch {
+		case bound != nil && (len(bounds) == 0 || bytes.Compare(bound, bounds[len(bounds)-1]) > 0):
+			bounds = append(bounds, bound)
+			counts = append(counts, r.ApproximateKeys)
+		case len(bounds) > 0:
+			// The boundary is truncated to the same index values as the previous one.
+			counts[len(counts)-1] += r.ApproximateKeys
+		default:
+			head += r.ApproximateKeys
+		}
+	}
+	if len(bounds) < 2 || total <= 0 {
+		return nil
+	}
+	// The region starting at the last boundary is counted in the last bucket, whose upper bound is unknown.
+	counts[len(counts)-2] += counts[len(counts)-1]
+	counts[0] += head
+
+	ndv := rowCount
+	if !idx.Unique {
+		ndv = min(rowCount, pseudoIndexNDV)
+	}
+	hg := NewHistogram(idx.ID, ndv, 0, 0, types.NewFieldType(mysql.TypeBlob), len(bounds)-1, 0)
+	scale := float64(rowCount) / float64(total)
+	var cumulative int64
+	for i := 0; i+1 < len(bounds); i++ {
+		cumulative += int64(math.Round(float64(counts[i]) * scale))
+		if i+2 == len(bounds) {
+			cumulative = rowCount
+		}
+		lower, upper := types.NewBytesDatum(bounds[i]), types.NewBytesDatum(bounds[i+1])
+		hg.AppendBucket(&lower, &upper, cumulative, 0)
+	}
+	return hg
+}
+
+// indexRegionBound returns the encoded index values in the boundary key of a region, or nil if the key isn't in the
+// index. The handle following the index values is trimmed.
+func indexRegionBound(key, prefix []byte, numCols int) []byte {
+	if !bytes.HasPrefix(key, prefix) {
+		return nil
+	}
+	vals := key[len(prefix):]
+	rest := vals
+	for range numCols {
+		if len(rest) == 0 {
+			break
+		}
+		_, remain, err := codec.CutOne(rest)
+		if err != nil {
+			break
+		}
+		rest = remain
+	}
+	if len(vals) == len(rest) {
+		return nil
+	}
+	return vals[:len(vals)-len(rest)]
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildIndexHistogramByRegions(t *testing.T) {
+	idx := &model.IndexInfo{ID: 1, Columns: []*model.IndexColumn{{Name: ast.NewCIStr("a")}}, State: model.StatePublic}
+	prefix := tablecodec.EncodeTableIndexPrefix(100, idx.ID)
+	indexKey := func(val, handle int64) []byte {
+		key, err := codec.EncodeKey(time.UTC, nil, types.NewIntDatum(val), types.NewIntDatum(handle))
+		require.NoError(t, err)
+		return append(append([]byte{}, prefix...), key...)
+	}
+	encodedVal := func(val int64) []byte {
+		key, err := codec.EncodeKey(time.UTC, nil, types.NewIntDatum(val))
+		require.NoError(t, err)
+		return key
+	}
+	regions := []RegionProperties{
+		{StartKey: indexKey(30, 9), ApproximateKeys: 40},
+		{StartKey: indexKey(10, 1), ApproximateKeys: 20},
+		// The first region starts before the index.
+		{StartKey: nil, ApproximateKeys: 10},
+		{StartKey: indexKey(20, 5), ApproximateKeys: 30},
+	}
+	hg := BuildIndexHistogramByRegions(100, idx, 2000, regions)
+	require.NotNil(t, hg)
+	require.Equal(t, 2, hg.Len())
+	require.Equal(t, int64(pseudoIndexNDV), hg.NDV)
+	// The keys are scaled by 20 to the row count, and the head and the tail regions are counted in the first and the
+	// last buckets.
+	require.Equal(t, int64(600), hg.Buckets[0].Count)
+	require.Equal(t, int64(2000), hg.Buckets[1].Count)
+	require.Equal(t, encodedVal(10), hg.GetLower(0).GetBytes())
+	require.Equal(t, encodedVal(20), hg.GetUpper(0).GetBytes())
+	require.Equal(t, encodedVal(30), hg.GetUpper(1).GetBytes())
+
+	idx.Unique = true
+	hg = BuildIndexHistogramByRegions(100, idx, 2000, regions)
+	require.Equal(t, int64(2000), hg.NDV)
+	// The index within a single region has no histogram.
+	require.Nil(t, BuildIndexHistogramByRegions(100, idx, 2000, regions[:1]))
+}
+
+func TestBuildQuickStats(t *testing.T) {
+	tblInfo := &model.TableInfo{
+		ID: 100,
+		Columns: []*model.ColumnInfo{
+			{ID: 1, Name: ast.NewCIStr("a"), FieldType: *types.NewFieldType(mysql.TypeLonglong), State: model.StatePublic},
+			{ID: 2, Name: ast.NewCIStr("b"), FieldType: *types.NewFieldType(mysql.TypeLonglong), State: model.StatePublic},
+			{ID: 3, Name: ast.NewCIStr("c"), FieldType: *types.NewFieldType(mysql.TypeLonglong), State: model.StateWriteOnly},
+		},
+		Indices: []*model.IndexInfo{{ID: 1, Columns: []*model.IndexColumn{{Name: ast.NewCIStr("a")}}, State: model.StatePublic}},
+	}
+	records := []RegionProperties{
+		{ApproximateKeys: 3000, ApproximateSize: 300000},
+		{ApproximateKeys: 2000, ApproximateSize: 200000},
+	}
+	qs := BuildQuickStats(tblInfo, 100, records, nil)
+	require.Equal(t, int64(5000), qs.RowCount)
+	// The size is divided evenly between the public columns of the same width.
+	require.Equal(t, map[int64]int64{1: 250000, 2: 250000}, qs.TotColSizes)
+	require.Empty(t, qs.IndexHists)
+
+	// The row count of the table within a single region is at most the pseudo row count.
+	qs = BuildQuickStats(tblInfo, 100, []RegionProperties{{ApproximateKeys: 20000, ApproximateSize: 2000000}}, nil)
+	require.Equal(t, int64(PseudoRowCount), qs.RowCount)
+	require.Equal(t, map[int64]int64{1: 500000, 2: 500000}, qs.TotColSizes)
+
+	qs = BuildQuickStats(tblInfo, 100, nil, nil)
+	require.Zero(t, qs.RowCount)
+	require.Nil(t, qs.TotColSizes)
+}
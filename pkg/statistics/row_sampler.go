@@ -252,6 +252,54 @@ func (s *baseCollector) collectColumns(sc *stmtctx.StatementContext, cols []type
 	return nil
 }
 
+// RebuildColumnBySamples rebuilds the null count, the FM sketch and the total size of the column at the offset
+// from the decoded samples. It's used for the virtual generated columns, which are not stored in TiKV and only
+// get their values evaluated in TiDB from the samples. The null count and the total size are scaled up by the
+// sample rate, and the estimated NDV is returned since the FM sketch only sees the sampled values.
+func (s *baseCollector) RebuildColumnBySamples(sc *stmtctx.StatementContext, offset int) (ndv int64, err error) {
+	sampleNum := int64(len(s.Samples))
+	if sampleNum == 0 {
+		return 0, nil
+	}
+	fmSketch := NewFMSketch(MaxSketchSize)
+	freqs := make(map[string]uint64, sampleNum)
+	var nullCount, totalSize int64
+	var buf []byte
+	for _, sample := range s.Samples {
+		d := sample.Columns[offset]
+		if d.IsNull() {
+			nullCount++
+			continue
+		}
+		buf, err = codec.EncodeValue(sc.TimeZone(), buf[:0], d)
+		if err != nil {
+			return 0, err
+		}
+		// Minus one is to remove the flag byte.
+		totalSize += int64(len(buf)) - 1
+		freqs[string(buf)]++
+		if err = fmSketch.InsertValue(sc, d); err != nil {
+			return 0, err
+		}
+	}
+	scale := float64(s.Count) / float64(sampleNum)
+	s.NullCount[offset] = int64(float64(nullCount) * scale)
+	s.TotalSizes[offset] = int64(float64(totalSize) * scale)
+	s.FMSketches[offset] = fmSketch
+	var onlyOnce uint64
+	for _, freq := range freqs {
+		if freq == 1 {
+			onlyOnce++
+		}
+	}
+	notNullSampleNum := uint64(sampleNum - nullCount)
+	if notNullSampleNum == 0 {
+		return 0, nil
+	}
+	notNullCount := uint64(max(s.Count-s.NullCount[offset], 0))
+	return int64(estimateNDVBySample(notNullSampleNum, uint64(len(freqs)), onlyOnce, notNullCount)), nil
+}
+
 func (s *baseCollector) collectColumnGroups(sc *stmtctx.StatementContext, cols []types.Datum, colGroups [][]int64, sizes []int64) error {
 	colLen := len(cols)
 	datumBuffer := make([]types.Datum, 0, len(cols))
@@ -369,6 +417,13 @@ func (s *ReservoirRowSampleCollector) sampleRow(row []types.Datum, rng *rand.Ran
 	}
 }
 
+// SampleRow samples the row whose columns are encoded in the same way as the samples collected by TiKV. It's used
+// when the rows are sampled in TiDB, e.g. when they are encoded by IMPORT INTO. The caller maintains the count, the
+// null counts, the FM sketches and the total sizes itself.
+func (s *ReservoirRowSampleCollector) SampleRow(row []types.Datum, rng *rand.Rand) {
+	s.sampleRow(row, rng)
+}
+
 // MergeCollector merges the collectors to a final one.
 func (s *ReservoirRowSampleCollector) MergeCollector(subCollector RowSampleCollector) {
 	s.Count += subCollector.Base().Count
@@ -0,0 +1,153 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+)
+
+// The kinds of the StatsViolation.
+const (
+	// ViolationRowCountMismatch means the sum of the bucket counts, the TopN counts and the null count differs from
+	// the row count of the table.
+	ViolationRowCountMismatch = "row_count_mismatch"
+	// ViolationBucketCountDecreasing means the cumulative bucket counts decrease or the repeat of a bucket exceeds the
+	// count of the bucket.
+	ViolationBucketCountDecreasing = "bucket_count_decreasing"
+	// ViolationTopNInBuckets means a TopN value also appears in the buckets.
+	ViolationTopNInBuckets = "topn_in_buckets"
+	// ViolationNDVExceedsRowCount means the NDV is larger than the row count.
+	ViolationNDVExceedsRowCount = "ndv_exceeds_row_count"
+	// ViolationNullCountExceedsRowCount means the null count is larger than the row count.
+	ViolationNullCountExceedsRowCount = "null_count_exceeds_row_count"
+)
+
+// RowCountMismatchTolerance is the ratio of the difference between the sum of the counts in the stats and the row
+// count that is tolerated, since the counts built from the samples are scaled and rounded.
+const RowCountMismatchTolerance = 0.05
+
+// StatsViolation is a logical inconsistency found in the stats of a column or an index.
+type StatsViolation struct {
+	Kind    string
+	Detail  string
+	ID      int64
+	IsIndex bool
+	// Stale indicates that the violation can be explained by the modifications after the last analyze, so
+	// re-analyzing the table fixes it. Otherwise, the stats are broken and need to be repaired, i.e. dropped and
+	// analyzed again.
+	Stale bool
+}
+
+// CheckSanity validates the loaded stats of the columns and the indexes of the table for the logical sanity. The
+// bucket related checks are skipped for the columns and the indexes whose histograms aren't fully loaded.
+func (t *Table) CheckSanity(loc *time.Location) ([]StatsViolation, error) {
+	var violations []StatsViolation
+	for _, col := range t.StableOrderColSlice() {
+		if !col.IsStatsInitialized() {
+			continue
+		}
+		vs, err := t.checkItemSanity(loc, col.ID, false, &col.Histogram, col.TopN, col.StatsVer, col.IsFullLoad())
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, vs...)
+	}
+	for _, idx := range t.StableOrderIdxSlice() {
+		if !idx.IsStatsInitialized() {
+			continue
+		}
+		vs, err := t.checkItemSanity(loc, idx.ID, true, &idx.Histogram, idx.TopN, idx.StatsVer, idx.IsFullLoad())
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, vs...)
+	}
+	return violations, nil
+}
+
+func (t *Table) checkItemSanity(loc *time.Location, id int64, isIndex bool, hist *Histogram, topN *TopN,
+	statsVer int64, fullLoad bool) ([]StatsViolation, error) {
+	var violations []StatsViolation
+	modified := t.ModifyCount > 0
+	addViolation := func(kind string, stale bool, format string, args ...any) {
+		detail := fmt.Sprintf(format, args...)
+		if stale {
+			detail += fmt.Sprintf(", %d rows are modified after the last analyze", t.ModifyCount)
+		}
+		violations = append(violations, StatsViolation{Kind: kind, Detail: detail, ID: id, IsIndex: isIndex, Stale: stale})
+	}
+	if hist.NDV > t.RealtimeCount {
+		addViolation(ViolationNDVExceedsRowCount, modified, "the NDV is %d, but the row count is %d", hist.NDV, t.RealtimeCount)
+	}
+	if hist.NullCount > t.RealtimeCount {
+		addViolation(ViolationNullCountExceedsRowCount, modified, "the null count is %d, but the row count is %d",
+			hist.NullCount, t.RealtimeCount)
+	}
+	if !fullLoad {
+		return violations, nil
+	}
+
+	total := hist.TotalRowCount()
+	if statsVer >= Version2 {
+		total += float64(topN.TotalCount())
+	}
+	if math.Abs(total-float64(t.RealtimeCount)) > math.Max(1, float64(t.RealtimeCount)*RowCountMismatchTolerance) {
+		addViolation(ViolationRowCountMismatch, modified,
+			"the sum of the bucket counts, the TopN counts and the null count is %d, but the row count is %d",
+			int64(total), t.RealtimeCount)
+	}
+
+	var prevCount int64
+	for i, bkt := range hist.Buckets {
+		if bkt.Count < prevCount || bkt.Repeat < 0 || bkt.Repeat > bkt.Count-prevCount {
+			addViolation(ViolationBucketCountDecreasing, false,
+				"bucket %d has the cumulative count %d and the repeat %d, but the cumulative count of the previous bucket is %d",
+				i, bkt.Count, bkt.Repeat, prevCount)
+			break
+		}
+		prevCount = bkt.Count
+	}
+
+	// The TopN values are removed from the buckets since the stats version 2.
+	if statsVer < Version2 || topN.Num() == 0 {
+		return violations, nil
+	}
+	var upper types.Datum
+	for i, bkt := range hist.Buckets {
+		if bkt.Repeat == 0 {
+			continue
+		}
+		hist.UpperToDatum(i, &upper)
+		encoded := upper.GetBytes()
+		if !isIndex {
+			var err error
+			encoded, err = codec.EncodeKey(loc, nil, upper)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if topN.FindTopN(encoded) >= 0 {
+			addViolation(ViolationTopNInBuckets, false,
+				"the upper bound of bucket %d is also a TopN value, which is counted twice", i)
+			break
+		}
+	}
+	return violations, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSanity(t *testing.T) {
+	encode := func(v int64) []byte {
+		encoded, err := codec.EncodeKey(time.UTC, nil, types.NewIntDatum(v))
+		require.NoError(t, err)
+		return encoded
+	}
+	// The column has the values 1, 1, 2, 2, 3 and a NULL, and the value 2 is in the TopN.
+	hg := NewHistogram(1, 3, 1, 1, types.NewFieldType(mysql.TypeLonglong), 2, 0)
+	lower, upper := types.NewIntDatum(1), types.NewIntDatum(1)
+	hg.AppendBucket(&lower, &upper, 2, 2)
+	lower, upper = types.NewIntDatum(3), types.NewIntDatum(3)
+	hg.AppendBucket(&lower, &upper, 3, 1)
+	topN := NewTopN(1)
+	topN.AppendTopN(encode(2), 2)
+	col := &Column{
+		Histogram:         *hg,
+		TopN:              topN,
+		Info:              &model.ColumnInfo{ID: 1},
+		StatsLoadedStatus: NewStatsFullLoadStatus(),
+		StatsVer:          Version2,
+	}
+	tbl := &Table{HistColl: *NewHistColl(1, 6, 0, 1, 0)}
+	tbl.SetCol(1, col)
+	kinds := func() []string {
+		violations, err := tbl.CheckSanity(time.UTC)
+		require.NoError(t, err)
+		kinds := make([]string, 0, len(violations))
+		for _, v := range violations {
+			require.Equal(t, int64(1), v.ID)
+			require.False(t, v.IsIndex)
+			kinds = append(kinds, v.Kind)
+		}
+		return kinds
+	}
+	require.Empty(t, kinds())
+
+	// The mismatch of the row count is caused by the modifications after the analyze.
+	tbl.RealtimeCount, tbl.ModifyCount = 2, 4
+	violations, err := tbl.CheckSanity(time.UTC)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	require.Equal(t, ViolationNDVExceedsRowCount, violations[0].Kind)
+	require.Equal(t, ViolationRowCountMismatch, violations[1].Kind)
+	require.Equal(t, "the sum of the bucket counts, the TopN counts and the null count is 6, but the row count is 2, "+
+		"4 rows are modified after the last analyze", violations[1].Detail)
+	require.True(t, violations[1].Stale)
+	tbl.RealtimeCount, tbl.ModifyCount = 6, 0
+
+	// The value 3 is both in the TopN and the buckets.
+	topN.AppendTopN(encode(3), 0)
+	topN.Sort()
+	violations, err = tbl.CheckSanity(time.UTC)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Equal(t, ViolationTopNInBuckets, violations[0].Kind)
+	require.False(t, violations[0].Stale)
+	topN.RemoveVal(encode(3))
+
+	col.Histogram.Buckets[1].Count = 1
+	require.Equal(t, []string{ViolationRowCountMismatch, ViolationBucketCountDecreasing}, kinds())
+	col.Histogram.Buckets[1].Count = 3
+
+	// Only the NDV and the null count are checked if the histogram isn't loaded.
+	col.NullCount = 7
+	col.StatsLoadedStatus = NewStatsAllEvictedStatus()
+	require.Equal(t, []string{ViolationNullCountExceedsRowCount}, kinds())
+}
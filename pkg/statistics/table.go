@@ -62,6 +62,9 @@ var (
 // Table represents statistics for a table.
 type Table struct {
 	ExtendedStats *ExtendedStatsColl
+	// FKFanouts maps the foreign key ID to the fanout of it, which is collected when the table is analyzed. The
+	// fanouts are immutable, so the map is shared by the copies of the table and replaced as a whole when reloaded.
+	FKFanouts map[int64]*FKFanout
 
 	ColAndIdxExistenceMap *ColAndIdxExistenceMap
 	HistColl
@@ -78,8 +81,14 @@ type Table struct {
 	// and the schema of the table does not change, we don't need to load the stats for this
 	// table again.
 	TblInfoUpdateTS uint64
+	// AutoIDBase is the base of the local allocator of the auto IDs of the table when the stats meta is loaded.
+	// The auto IDs allocated after that are used to extrapolate the row count. 0 means it's unknown.
+	AutoIDBase int64
 
 	IsPkIsHandle bool
+	// Pinned indicates the stats of the table are never evicted from the stats cache. It's set for the cached
+	// tables, whose stats are small and used by the queries all the time.
+	Pinned bool
 }
 
 // ColAndIdxExistenceMap is the meta map for statistics.Table.
@@ -196,6 +205,12 @@ func ColAndIdxExistenceMapIsEqual(m1, m2 *ColAndIdxExistenceMap) bool {
 
 // ExtendedStatsItem is the cached item of a mysql.stats_extended record.
 type ExtendedStatsItem struct {
+	// Trigrams is decoded from StringVals for the trigram type.
+	Trigrams *TrigramStats
+	// ExprStats is decoded from StringVals for the expression type.
+	ExprStats *ExpressionStats
+	// JoinSketch is decoded from StringVals for the join sketch type.
+	JoinSketch *JoinSketch
 	StringVals string
 	ColIDs     []int64
 	ScalarVals float64
@@ -254,6 +269,28 @@ type HistColl struct {
 	// For normal index, the column id is enough, as we already have in Idx2ColUniqueIDs. But currently, mv index needs more
 	// information to match the filter against the mv index columns, and we need this map to provide this information.
 	MVIdx2Columns map[int64][]*expression.Column
+	// TrigramStats maps the column UniqueID to its trigram statistics. It's used to estimate the selectivity of
+	// the string matching functions in planner, and it's only filled when tidb_enable_extended_stats is on.
+	TrigramStats map[int64]*TrigramStats
+	// ExpressionStats are the statistics of the expressions on the columns. Like TrigramStats, it's used to estimate
+	// the selectivity in planner, and it's only filled when tidb_enable_extended_stats is on.
+	ExpressionStats []*ExpressionStatsInQuery
+	// JoinSketches maps the column UniqueID to its join sketch. It's used to estimate the row count of the equal
+	// join in planner, and it's only filled when tidb_enable_extended_stats is on.
+	JoinSketches map[int64]*JoinSketch
+	// FKFanouts maps the UniqueID of the foreign key column to the fanout of the foreign key. It's used to estimate
+	// the row count of the equal join between the child and the parent tables in planner, and it's only filled when
+	// tidb_opt_fk_fanout_estimation is on.
+	FKFanouts map[int64]*FKJoinFanout
+	// DynamicSample is the rows sampled from the table when it has no usable stats. It's used to estimate the
+	// selectivity in planner when the stats are pseudo, see tidb_opt_dynamic_sampling_rows.
+	DynamicSample *DynamicSample
+	// InListSelCache caches the selectivities of the constants in the IN lists probed against the stats. It's only
+	// set for the statements using the plan cache when tidb_opt_in_list_probe_estimation is on.
+	InListSelCache *InListSelCache
+	// StatsVersion is the version of the stats meta the RealtimeCount and ModifyCount are read from. It's used to
+	// extrapolate the modifications since then in the out-of-range estimation.
+	StatsVersion uint64
 }
 
 // NewHistColl creates a new HistColl.
@@ -416,7 +453,9 @@ func (coll *HistColl) CalcPreScalar() {
 }
 
 // DropEvicted will drop the unnecessary data for all columns and indices. It's triggerred by stats cache.
+// The dynamic sample is dropped as well, and it will be sampled again by the next query if it's still needed.
 func (coll *HistColl) DropEvicted() {
+	coll.DynamicSample = nil
 	for _, col := range coll.columns {
 		if !col.IsStatsInitialized() || col.GetEvictedStatus() == AllEvicted {
 			continue
@@ -437,6 +476,8 @@ type TableMemoryUsage struct {
 	IndicesMemUsage map[int64]CacheItemMemoryUsage
 	TableID         int64
 	TotalMemUsage   int64
+	// DynamicSampleMemUsage is the memory usage of the dynamic sample, which is dropped when the table is evicted.
+	DynamicSampleMemUsage int64
 }
 
 // TotalIdxTrackingMemUsage returns total indices' tracking memory usage
@@ -457,7 +498,7 @@ func (t *TableMemoryUsage) TotalColTrackingMemUsage() (sum int64) {
 
 // TotalTrackingMemUsage return total tracking memory usage
 func (t *TableMemoryUsage) TotalTrackingMemUsage() int64 {
-	return t.TotalIdxTrackingMemUsage() + t.TotalColTrackingMemUsage()
+	return t.TotalIdxTrackingMemUsage() + t.TotalColTrackingMemUsage() + t.DynamicSampleMemUsage
 }
 
 // TableCacheItem indicates the unit item stored in statsCache, eg: Column/Index
@@ -584,6 +625,8 @@ func (t *Table) MemoryUsage() *TableMemoryUsage {
 			tMemUsage.TotalMemUsage += idxMemUsage.TotalMemoryUsage()
 		}
 	}
+	tMemUsage.DynamicSampleMemUsage = t.DynamicSample.MemoryUsage()
+	tMemUsage.TotalMemUsage += tMemUsage.DynamicSampleMemUsage
 	return tMemUsage
 }
 
@@ -597,6 +640,7 @@ func (t *Table) Copy() *Table {
 		Pseudo:        t.Pseudo,
 		ModifyCount:   t.ModifyCount,
 		StatsVer:      t.StatsVer,
+		DynamicSample: t.DynamicSample,
 	}
 	for id, col := range t.columns {
 		newHistColl.columns[id] = col.Copy()
@@ -609,6 +653,9 @@ func (t *Table) Copy() *Table {
 		Version:            t.Version,
 		TblInfoUpdateTS:    t.TblInfoUpdateTS,
 		LastAnalyzeVersion: t.LastAnalyzeVersion,
+		Pinned:             t.Pinned,
+		AutoIDBase:         t.AutoIDBase,
+		FKFanouts:          t.FKFanouts,
 	}
 	if t.ExtendedStats != nil {
 		newExtStatsColl := &ExtendedStatsColl{
@@ -638,14 +685,18 @@ func (t *Table) ShallowCopy() *Table {
 		Pseudo:        t.Pseudo,
 		ModifyCount:   t.ModifyCount,
 		StatsVer:      t.StatsVer,
+		DynamicSample: t.DynamicSample,
 	}
 	nt := &Table{
 		HistColl:              newHistColl,
 		Version:               t.Version,
 		TblInfoUpdateTS:       t.TblInfoUpdateTS,
 		ExtendedStats:         t.ExtendedStats,
+		FKFanouts:             t.FKFanouts,
 		ColAndIdxExistenceMap: t.ColAndIdxExistenceMap,
 		LastAnalyzeVersion:    t.LastAnalyzeVersion,
+		Pinned:                t.Pinned,
+		AutoIDBase:            t.AutoIDBase,
 	}
 	return nt
 }
@@ -1022,7 +1073,7 @@ func PseudoTable(tblInfo *model.TableInfo, allowTriggerLoading bool, allowFillHi
 	}
 	for _, col := range tblInfo.Columns {
 		// The column is public to use. Also we should check the column is not hidden since hidden means that it's used by expression index.
-		// We would not collect stats for the hidden column and we won't use the hidden column to estimate.
+		// The hidden column gets its stats only when it's analyzed, and it's estimated by the stats of the expression index before that.
 		// Thus we don't create pseudo stats for it.
 		if col.State == model.StatePublic && !col.Hidden {
 			t.ColAndIdxExistenceMap.InsertCol(col.ID, false)
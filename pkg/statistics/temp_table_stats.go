@@ -0,0 +1,252 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"hash"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
+)
+
+// tempTableFMSketchSize is the max size of the FM sketches of the temporary table columns. It's smaller than
+// the one used by analyze since the stats are kept in the memory of the session.
+const tempTableFMSketchSize = 1000
+
+// tempTableStatsVersion is the version of the stats built for the temporary tables. The stats are never saved,
+// so the version only tells they are not the pseudo stats.
+const tempTableStatsVersion uint64 = 1
+
+// TempTableStats holds the stats of a temporary table which are collected when the rows are written.
+// Temporary tables are never analyzed, so the row count, the min / max value, the null count and the
+// approximate NDV of the columns are collected on the fly to give the planner something better than the
+// pseudo stats. The deleted rows are only subtracted from the row count.
+// The stats collected by the running statement are staged until the statement ends, so the rows written by
+// the rolled back statements are not counted.
+type TempTableStats struct {
+	cols map[int64]*tempColumnStats
+	// stmt holds the stats collected by the running statement.
+	stmt  *TempTableStats
+	Count int64
+}
+
+// tempColumnStats holds the stats of a column of a temporary table.
+type tempColumnStats struct {
+	// min and max are the sort keys for the string values, which is the same as the histogram bounds.
+	min       types.Datum
+	max       types.Datum
+	fmSketch  *FMSketch
+	nullCount int64
+}
+
+// NewTempTableStats creates a new TempTableStats.
+func NewTempTableStats() *TempTableStats {
+	return &TempTableStats{cols: make(map[int64]*tempColumnStats)}
+}
+
+// tempTableStatsColumn returns whether the stats of the column are collected.
+func tempTableStatsColumn(col *model.ColumnInfo) bool {
+	if col.State != model.StatePublic || col.Hidden {
+		return false
+	}
+	switch col.FieldType.EvalType() {
+	case types.ETInt, types.ETReal, types.ETDecimal, types.ETString, types.ETDatetime, types.ETTimestamp, types.ETDuration:
+		return true
+	}
+	return false
+}
+
+// stmtStats returns the stats collected by the running statement.
+func (s *TempTableStats) stmtStats() *TempTableStats {
+	if s.stmt == nil {
+		s.stmt = NewTempTableStats()
+	}
+	return s.stmt
+}
+
+// CollectRow collects the stats of an inserted row, the row is indexed by the column offsets.
+func (s *TempTableStats) CollectRow(loc *time.Location, tblInfo *model.TableInfo, row []types.Datum) error {
+	stmt := s.stmtStats()
+	stmt.Count++
+	return stmt.collectValues(loc, tblInfo, row)
+}
+
+// CollectUpdatedRow collects the new values of an updated row, the row count is unchanged.
+func (s *TempTableStats) CollectUpdatedRow(loc *time.Location, tblInfo *model.TableInfo, row []types.Datum) error {
+	return s.stmtStats().collectValues(loc, tblInfo, row)
+}
+
+// StmtCommit merges the stats collected by the statement when it succeeds.
+func (s *TempTableStats) StmtCommit() {
+	if s.stmt != nil {
+		s.Merge(s.stmt)
+		s.stmt = nil
+	}
+}
+
+// StmtRollback discards the stats collected by the statement when it's rolled back.
+func (s *TempTableStats) StmtRollback() {
+	s.stmt = nil
+}
+
+func (s *TempTableStats) collectValues(loc *time.Location, tblInfo *model.TableInfo, row []types.Datum) error {
+	hashFunc := murmur3Pool.Get().(hash.Hash64)
+	defer murmur3Pool.Put(hashFunc)
+	var buf []byte
+	for _, col := range tblInfo.Columns {
+		if col.Offset >= len(row) || !tempTableStatsColumn(col) {
+			continue
+		}
+		cs, ok := s.cols[col.ID]
+		if !ok {
+			cs = &tempColumnStats{fmSketch: NewFMSketch(tempTableFMSketchSize)}
+			s.cols[col.ID] = cs
+		}
+		d := row[col.Offset]
+		if d.IsNull() {
+			cs.nullCount++
+			continue
+		}
+		var err error
+		buf, err = codec.EncodeValue(loc, buf[:0], d)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		hashFunc.Reset()
+		if _, err = hashFunc.Write(buf); err != nil {
+			return errors.Trace(err)
+		}
+		cs.fmSketch.insertHashValue(hashFunc.Sum64())
+
+		if d.Kind() == types.KindString {
+			var key types.Datum
+			key.SetBytesAsString(collate.GetCollator(col.GetCollate()).Key(d.GetString()), d.Collation(), uint32(d.Length()))
+			d = key
+		}
+		if cs.min.IsNull() || compareTempTableBound(&d, &cs.min) < 0 {
+			d.Copy(&cs.min)
+		}
+		if cs.max.IsNull() || compareTempTableBound(&d, &cs.max) > 0 {
+			d.Copy(&cs.max)
+		}
+	}
+	return nil
+}
+
+func compareTempTableBound(a, b *types.Datum) int {
+	cmp, err := a.Compare(types.DefaultStmtNoWarningContext, b, collate.GetBinaryCollator())
+	if err != nil {
+		return 0
+	}
+	return cmp
+}
+
+// RemoveRow removes a deleted row from the stats.
+func (s *TempTableStats) RemoveRow() {
+	s.stmtStats().Count--
+}
+
+// Merge merges the stats collected by another TempTableStats, e.g. the stats collected in a transaction.
+// The stats staged by the running statement of the other one are not merged.
+func (s *TempTableStats) Merge(other *TempTableStats) {
+	if other == nil {
+		return
+	}
+	s.Count += other.Count
+	for id, ocs := range other.cols {
+		cs, ok := s.cols[id]
+		if !ok {
+			s.cols[id] = ocs.copy()
+			continue
+		}
+		cs.nullCount += ocs.nullCount
+		cs.fmSketch.MergeFMSketch(ocs.fmSketch)
+		if !ocs.min.IsNull() && (cs.min.IsNull() || compareTempTableBound(&ocs.min, &cs.min) < 0) {
+			ocs.min.Copy(&cs.min)
+		}
+		if !ocs.max.IsNull() && (cs.max.IsNull() || compareTempTableBound(&ocs.max, &cs.max) > 0) {
+			ocs.max.Copy(&cs.max)
+		}
+	}
+}
+
+// Copy copies the TempTableStats.
+func (s *TempTableStats) Copy() *TempTableStats {
+	ns := &TempTableStats{cols: make(map[int64]*tempColumnStats, len(s.cols)), Count: s.Count}
+	for id, cs := range s.cols {
+		ns.cols[id] = cs.copy()
+	}
+	return ns
+}
+
+func (cs *tempColumnStats) copy() *tempColumnStats {
+	ncs := &tempColumnStats{fmSketch: cs.fmSketch.Copy(), nullCount: cs.nullCount}
+	cs.min.Copy(&ncs.min)
+	cs.max.Copy(&ncs.max)
+	return ncs
+}
+
+// BuildTable builds the stats table for the planner. Each column gets a histogram with a single bucket which
+// covers [min, max]. Since the deleted rows are not removed from the column stats, the counts are capped by
+// the row count of the table.
+func (s *TempTableStats) BuildTable(tblInfo *model.TableInfo) *Table {
+	count := max(s.Count, 0)
+	t := &Table{
+		HistColl:              *NewHistColl(tblInfo.ID, count, 0, len(tblInfo.Columns), 0),
+		ColAndIdxExistenceMap: NewColAndIndexExistenceMap(len(tblInfo.Columns), len(tblInfo.Indices)),
+		Version:               tempTableStatsVersion,
+		IsPkIsHandle:          tblInfo.PKIsHandle,
+	}
+	t.CanNotTriggerLoad = true
+	t.StatsVer = Version2
+	for _, col := range tblInfo.Columns {
+		if !tempTableStatsColumn(col) {
+			continue
+		}
+		cs, ok := s.cols[col.ID]
+		if !ok {
+			t.ColAndIdxExistenceMap.InsertCol(col.ID, false)
+			continue
+		}
+		nullCount := min(cs.nullCount, count)
+		notNullCount := count - nullCount
+		ndv := min(cs.fmSketch.NDV(), notNullCount)
+		hist := NewHistogram(col.ID, ndv, nullCount, 0, &col.FieldType, 1, 0)
+		if notNullCount > 0 && !cs.min.IsNull() {
+			hist.AppendBucket(&cs.min, &cs.max, notNullCount, max(notNullCount/max(ndv, 1), 1))
+			hist.PreCalculateScalar()
+		}
+		t.SetCol(col.ID, &Column{
+			PhysicalID:        tblInfo.ID,
+			Info:              col,
+			Histogram:         *hist,
+			StatsLoadedStatus: NewStatsFullLoadStatus(),
+			StatsVer:          Version2,
+			IsHandle:          tblInfo.PKIsHandle && mysql.HasPriKeyFlag(col.GetFlag()),
+		})
+		t.ColAndIdxExistenceMap.InsertCol(col.ID, true)
+	}
+	for _, idx := range tblInfo.Indices {
+		if idx.State == model.StatePublic {
+			t.ColAndIdxExistenceMap.InsertIndex(idx.ID, false)
+		}
+	}
+	return t
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"cmp"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+)
+
+const (
+	// trigramLen is the length in bytes of a trigram.
+	trigramLen = 3
+	// MaxTrigramNum is the max number of trigrams kept in the trigram statistics of a column.
+	MaxTrigramNum = 1024
+)
+
+// TrigramStats is the trigram statistics of a string column, which is built from the samples during analyze.
+// It's used to estimate the selectivity of the LIKE and REGEXP predicates with a leading wildcard, which can't
+// be estimated by the histogram or the TopN.
+// Note that the trigrams are extracted from the collation keys of the values, so the patterns need to be
+// converted to the collation keys of the same collation before looking up the trigrams.
+type TrigramStats struct {
+	// Counts maps the trigram to the number of the sampled values containing it.
+	Counts map[string]int64
+	// SampleCount is the number of the sampled non-null values.
+	SampleCount int64
+	// MinCount is the upper bound of the counts of the trigrams which are not kept in Counts.
+	// It's 0 if all the trigrams of the samples are kept.
+	MinCount int64
+}
+
+// trigramStatsJSON is the persisted format of TrigramStats. The trigrams are encoded in hex because the
+// collation keys may not be valid UTF-8 strings.
+type trigramStatsJSON struct {
+	Trigrams    []string `json:"trigrams"`
+	Counts      []int64  `json:"counts"`
+	SampleCount int64    `json:"sample_count"`
+	MinCount    int64    `json:"min_count"`
+}
+
+// BuildTrigramStats builds the trigram statistics from the samples of a string column.
+func BuildTrigramStats(samples []*SampleItem) *TrigramStats {
+	counts := make(map[string]int64)
+	seen := make(map[string]struct{})
+	var sampleCount int64
+	for _, sample := range samples {
+		if sample.Value.IsNull() {
+			continue
+		}
+		sampleCount++
+		val := sample.Value.GetBytes()
+		clear(seen)
+		for i := 0; i+trigramLen <= len(val); i++ {
+			trigram := string(val[i : i+trigramLen])
+			if _, ok := seen[trigram]; ok {
+				continue
+			}
+			seen[trigram] = struct{}{}
+			counts[trigram]++
+		}
+	}
+	stats := &TrigramStats{Counts: counts, SampleCount: sampleCount}
+	if len(counts) <= MaxTrigramNum {
+		return stats
+	}
+	// Only keep the most frequent trigrams, the dropped ones are bounded by the count of the first dropped one.
+	trigrams := make([]string, 0, len(counts))
+	for trigram := range counts {
+		trigrams = append(trigrams, trigram)
+	}
+	slices.SortFunc(trigrams, func(a, b string) int {
+		if c := cmp.Compare(counts[b], counts[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+	stats.MinCount = counts[trigrams[MaxTrigramNum]]
+	for _, trigram := range trigrams[MaxTrigramNum:] {
+		delete(counts, trigram)
+	}
+	return stats
+}
+
+// Selectivity estimates the fraction of the non-null values containing all the given substrings, which are
+// the collation keys of the literal parts of a pattern. The fraction is bounded by the least frequent trigram
+// of the substrings. ok is false if none of the substrings is long enough to produce a trigram.
+func (t *TrigramStats) Selectivity(substrs [][]byte) (selectivity float64, ok bool) {
+	if t == nil || t.SampleCount <= 0 {
+		return 0, false
+	}
+	minCount := float64(t.SampleCount)
+	for _, substr := range substrs {
+		for i := 0; i+trigramLen <= len(substr); i++ {
+			ok = true
+			count, found := t.Counts[string(substr[i:i+trigramLen])]
+			if !found {
+				count = t.MinCount
+			}
+			minCount = min(minCount, float64(count))
+		}
+	}
+	if !ok {
+		return 0, false
+	}
+	// The trigram may just be missed by the samples, so we don't return a zero selectivity.
+	minCount = max(minCount, 0.5)
+	return minCount / float64(t.SampleCount), true
+}
+
+// EncodeTrigramStats encodes the trigram statistics to the string persisted in mysql.stats_extended.
+func EncodeTrigramStats(t *TrigramStats) (string, error) {
+	js := trigramStatsJSON{
+		Trigrams:    make([]string, 0, len(t.Counts)),
+		Counts:      make([]int64, 0, len(t.Counts)),
+		SampleCount: t.SampleCount,
+		MinCount:    t.MinCount,
+	}
+	trigrams := make([]string, 0, len(t.Counts))
+	for trigram := range t.Counts {
+		trigrams = append(trigrams, trigram)
+	}
+	slices.Sort(trigrams)
+	for _, trigram := range trigrams {
+		js.Trigrams = append(js.Trigrams, hex.EncodeToString([]byte(trigram)))
+		js.Counts = append(js.Counts, t.Counts[trigram])
+	}
+	bytes, err := json.Marshal(js)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(bytes), nil
+}
+
+// DecodeTrigramStats decodes the trigram statistics from the string persisted in mysql.stats_extended.
+func DecodeTrigramStats(str string) (*TrigramStats, error) {
+	var js trigramStatsJSON
+	if err := json.Unmarshal([]byte(str), &js); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(js.Trigrams) != len(js.Counts) {
+		return nil, errors.Errorf("invalid trigram stats, %d trigrams with %d counts", len(js.Trigrams), len(js.Counts))
+	}
+	t := &TrigramStats{
+		Counts:      make(map[string]int64, len(js.Trigrams)),
+		SampleCount: js.SampleCount,
+		MinCount:    js.MinCount,
+	}
+	for i, trigram := range js.Trigrams {
+		bytes, err := hex.DecodeString(trigram)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		t.Counts[string(bytes)] = js.Counts[i]
+	}
+	return t, nil
+}
+
+// TrigramStatsByUniqueID collects the trigram statistics of the given columns, keyed by the column UniqueID.
+func (coll *ExtendedStatsColl) TrigramStatsByUniqueID(cols []*expression.Column) map[int64]*TrigramStats {
+	if coll == nil {
+		return nil
+	}
+	var res map[int64]*TrigramStats
+	for _, item := range coll.Stats {
+		if item.Tp != ast.StatsTypeTrigram || item.Trigrams == nil || len(item.ColIDs) != 1 {
+			continue
+		}
+		for _, col := range cols {
+			if col.ID != item.ColIDs[0] {
+				continue
+			}
+			if res == nil {
+				res = make(map[int64]*TrigramStats)
+			}
+			res[col.UniqueID] = item.Trigrams
+			break
+		}
+	}
+	return res
+}
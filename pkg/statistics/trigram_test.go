@@ -0,0 +1,73 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrigramStats(t *testing.T) {
+	samples := make([]*SampleItem, 0, 101)
+	for i := range 100 {
+		val := fmt.Sprintf("value%d", i)
+		if i%10 == 0 {
+			val = fmt.Sprintf("needle%dneedle", i)
+		}
+		samples = append(samples, &SampleItem{Value: types.NewBytesDatum([]byte(val))})
+	}
+	samples = append(samples, &SampleItem{Value: types.NewDatum(nil)})
+	stats := BuildTrigramStats(samples)
+	require.Equal(t, int64(100), stats.SampleCount)
+	require.Equal(t, int64(0), stats.MinCount)
+	// A trigram is counted once for each value even if it appears multiple times.
+	require.Equal(t, int64(10), stats.Counts["nee"])
+	require.Equal(t, int64(90), stats.Counts["val"])
+
+	sel, ok := stats.Selectivity([][]byte{[]byte("needle")})
+	require.True(t, ok)
+	require.Equal(t, 0.1, sel)
+	sel, ok = stats.Selectivity([][]byte{[]byte("value"), []byte("needle")})
+	require.True(t, ok)
+	require.Equal(t, 0.1, sel)
+	// The missing trigram doesn't lead to a zero selectivity.
+	sel, ok = stats.Selectivity([][]byte{[]byte("xyz")})
+	require.True(t, ok)
+	require.Equal(t, 0.005, sel)
+	// The substrings are too short to produce a trigram.
+	_, ok = stats.Selectivity([][]byte{[]byte("ne"), []byte("v")})
+	require.False(t, ok)
+
+	str, err := EncodeTrigramStats(stats)
+	require.NoError(t, err)
+	decoded, err := DecodeTrigramStats(str)
+	require.NoError(t, err)
+	require.Equal(t, stats, decoded)
+	_, err = DecodeTrigramStats(`{"trigrams":["616263"],"counts":[]}`)
+	require.Error(t, err)
+
+	// Only the most frequent trigrams are kept.
+	samples = samples[:0]
+	for i := range MaxTrigramNum + 100 {
+		val := []byte{byte('a' + i%26), byte('a' + i/26%26), byte('a' + i/676)}
+		samples = append(samples, &SampleItem{Value: types.NewBytesDatum(val)})
+	}
+	stats = BuildTrigramStats(samples)
+	require.Len(t, stats.Counts, MaxTrigramNum)
+	require.Equal(t, int64(1), stats.MinCount)
+}
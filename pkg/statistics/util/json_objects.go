@@ -27,18 +27,22 @@ const (
 )
 
 // JSONTable is used for dumping statistics.
+// If PredicateColumnsOnly is true, only the predicate columns of the table and its partitions are dumped.
+// If SinceVersion is not 0, only the columns, the indexes and the partitions updated after it are dumped.
 type JSONTable struct {
-	Columns           map[string]*JSONColumn `json:"columns"`
-	Indices           map[string]*JSONColumn `json:"indices"`
-	Partitions        map[string]*JSONTable  `json:"partitions"`
-	DatabaseName      string                 `json:"database_name"`
-	TableName         string                 `json:"table_name"`
-	ExtStats          []*JSONExtendedStats   `json:"ext_stats"`
-	PredicateColumns  []*JSONPredicateColumn `json:"predicate_columns"`
-	Count             int64                  `json:"count"`
-	ModifyCount       int64                  `json:"modify_count"`
-	Version           uint64                 `json:"version"`
-	IsHistoricalStats bool                   `json:"is_historical_stats"`
+	Columns              map[string]*JSONColumn `json:"columns"`
+	Indices              map[string]*JSONColumn `json:"indices"`
+	Partitions           map[string]*JSONTable  `json:"partitions"`
+	DatabaseName         string                 `json:"database_name"`
+	TableName            string                 `json:"table_name"`
+	ExtStats             []*JSONExtendedStats   `json:"ext_stats"`
+	PredicateColumns     []*JSONPredicateColumn `json:"predicate_columns"`
+	Count                int64                  `json:"count"`
+	ModifyCount          int64                  `json:"modify_count"`
+	Version              uint64                 `json:"version"`
+	SinceVersion         uint64                 `json:"since_version,omitempty"`
+	IsHistoricalStats    bool                   `json:"is_historical_stats"`
+	PredicateColumnsOnly bool                   `json:"predicate_columns_only,omitempty"`
 }
 
 // Sort is used to sort the object in the JSONTable. it is used for testing to avoid flaky test.
@@ -48,6 +52,29 @@ func (j *JSONTable) Sort() {
 	})
 }
 
+// RemoveUnchangedSince removes the columns and the indexes not updated after the version, and the partitions whose
+// stats meta is not updated after the version, so that only the stats changed since the last dump are dumped.
+func (j *JSONTable) RemoveUnchangedSince(version uint64) {
+	j.SinceVersion = version
+	for name, col := range j.Columns {
+		if col.LastUpdateVersion <= version {
+			delete(j.Columns, name)
+		}
+	}
+	for name, idx := range j.Indices {
+		if idx.LastUpdateVersion <= version {
+			delete(j.Indices, name)
+		}
+	}
+	for name, partition := range j.Partitions {
+		if partition == nil || partition.Version <= version {
+			delete(j.Partitions, name)
+			continue
+		}
+		partition.RemoveUnchangedSince(version)
+	}
+}
+
 // JSONExtendedStats is used for dumping extended statistics.
 type JSONExtendedStats struct {
 	StatsName  string  `json:"stats_name"`
@@ -85,8 +112,11 @@ func (col *JSONColumn) TotalMemoryUsage() (size int64) {
 }
 
 // JSONPredicateColumn contains the information of the columns used in the predicate.
+// Name is used to match the column when the column IDs are different between clusters.
 type JSONPredicateColumn struct {
 	LastUsedAt     *string `json:"last_used_at"`
 	LastAnalyzedAt *string `json:"last_analyzed_at"`
+	Name           string  `json:"name,omitempty"`
 	ID             int64   `json:"id"`
+	UsageScore     float64 `json:"usage_score,omitempty"`
 }
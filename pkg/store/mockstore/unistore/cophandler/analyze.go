@@ -322,7 +322,8 @@ func buildBaseAnalyzeColumnsExec(dbReader *dbreader.DBReader, rans []kv.KeyRange
 	for i, col := range columns {
 		ft := fieldTypeFromPBColumn(col)
 		fts[i] = ft
-		if ft.EvalType() == types.ETString {
+		// The values of ENUM and SET are decoded by their numbers in TiDB, so they are not replaced by the collate keys.
+		if ft.EvalType() == types.ETString && ft.GetType() != mysql.TypeEnum && ft.GetType() != mysql.TypeSet {
 			collators[i] = collate.GetCollator(ft.GetCollate())
 		}
 	}
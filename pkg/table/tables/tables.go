@@ -432,6 +432,7 @@ func (t *TableCommon) updateRecord(sctx table.MutateContext, txn kv.Transaction,
 				return err
 			}
 			defer handleTempTableSize(tmpTable, txn.Size(), txn)
+			logTempTableStatsError(m, tempTableStats(tmpTable).CollectUpdatedRow(sctx.GetExprCtx().GetEvalCtx().Location(), m, newData))
 		}
 	}
 
@@ -668,6 +669,20 @@ func handleTempTableSize(t tblctx.TemporaryTableHandler, txnSizeBefore int, txn
 	t.UpdateTxnDeltaSize(txn.Size() - txnSizeBefore)
 }
 
+// tempTableStats returns the stats of the temporary table collected in txn.
+func tempTableStats(t tblctx.TemporaryTableHandler) *statistics.TempTableStats {
+	return t.GetStats().(*statistics.TempTableStats)
+}
+
+// logTempTableStatsError logs the error of collecting the stats of the temporary table. The stats are only
+// used by the planner, so the error doesn't fail the statement.
+func logTempTableStatsError(tblInfo *model.TableInfo, err error) {
+	if err != nil {
+		logutil.BgLogger().Warn("failed to collect the stats of the temporary table",
+			zap.String("table", tblInfo.Name.O), zap.Error(err))
+	}
+}
+
 func checkTempTableSize(tmpTable tblctx.TemporaryTableHandler, sizeLimit int64) error {
 	if tmpTable.GetCommittedSize()+tmpTable.GetDirtySize() > sizeLimit {
 		return table.ErrTempTableFull.GenWithStackByArgs(tmpTable.Meta().Name.O)
@@ -689,6 +704,11 @@ func (t *TableCommon) addRecord(sctx table.MutateContext, txn kv.Transaction, r
 				return nil, err
 			}
 			defer handleTempTableSize(tmpTable, txn.Size(), txn)
+			defer func() {
+				if err == nil {
+					logTempTableStatsError(m, tempTableStats(tmpTable).CollectRow(sctx.GetExprCtx().GetEvalCtx().Location(), m, r))
+				}
+			}()
 		}
 	}
 
@@ -1121,6 +1141,7 @@ func (t *TableCommon) removeRecord(ctx table.MutateContext, txn kv.Transaction,
 				return err
 			}
 			defer handleTempTableSize(tmpTable, txn.Size(), txn)
+			tempTableStats(tmpTable).RemoveRow()
 		}
 	}
 
@@ -1825,8 +1846,8 @@ func SetPBColumnsDefaultValue(ctx expression.BuildContext, pbColumns []*tipb.Col
 type TemporaryTable struct {
 	// Whether it's modified in this transaction.
 	modified bool
-	// The stats of this table. So far it's always pseudo stats.
-	stats *statistics.Table
+	// The stats of the rows written to this table in the transaction.
+	stats *statistics.TempTableStats
 	// The autoID allocator of this table.
 	autoIDAllocator autoid.Allocator
 	// Table size.
@@ -1839,7 +1860,7 @@ type TemporaryTable struct {
 func TempTableFromMeta(tblInfo *model.TableInfo) tableutil.TempTable {
 	return &TemporaryTable{
 		modified:        false,
-		stats:           statistics.PseudoTable(tblInfo, false, false),
+		stats:           statistics.NewTempTableStats(),
 		autoIDAllocator: autoid.NewAllocatorFromTempTblInfo(tblInfo),
 		meta:            tblInfo,
 	}
@@ -86,6 +86,11 @@ func (h *TemporaryTableHandler) UpdateTxnDeltaSize(delta int) {
 	h.tblInTxn.SetSize(h.tblInTxn.GetSize() + int64(delta))
 }
 
+// GetStats returns the stats of the temporary table collected in txn.
+func (h *TemporaryTableHandler) GetStats() any {
+	return h.tblInTxn.GetStats()
+}
+
 // TemporaryTableSupport is used for temporary table operations
 type TemporaryTableSupport interface {
 	// GetTemporaryTableSizeLimit returns the size limit of a temporary table.
@@ -95,6 +95,7 @@ func (d *temporaryTableDDL) clearTemporaryTableRecords(tblID int64) error {
 	if sessionData == nil {
 		return nil
 	}
+	sessionData.SetTableStats(tblID, nil)
 
 	tblPrefix := tablecodec.EncodeTablePrefix(tblID)
 	endKey := tablecodec.EncodeTablePrefix(tblID + 1)
@@ -0,0 +1,234 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package statsstorage provides an in-memory fake of the stats storage backend, so the load/save logic of the stats
+// handle can be tested without keeping the analyzed stats in the mysql.stats_* system tables.
+package statsstorage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/statistics"
+	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
+	statsutil "github.com/pingcap/tidb/pkg/statistics/util"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// item is the analyzed stats of a column or an index.
+type item struct {
+	hist     *statistics.Histogram
+	cms      *statistics.CMSketch
+	topN     *statistics.TopN
+	fms      *statistics.FMSketch
+	statsVer int64
+}
+
+// tableStats is a version of the stats of a table or a partition.
+type tableStats struct {
+	version  uint64
+	count    int64
+	snapshot uint64
+	columns  map[int64]*item
+	indexes  map[int64]*item
+}
+
+// Storage is an in-memory fake of the StatsStorage. It keeps every saved version of the stats of each table or
+// partition, i.e. the row count, the histograms, the TopNs and the sketches, so they can be loaded at any snapshot.
+// The extended stats and the foreign key fanouts are not kept, and the historical stats in JSON are never found.
+type Storage struct {
+	mu          sync.Mutex
+	lastVersion uint64
+	tables      map[int64][]*tableStats
+}
+
+var _ statstypes.StatsStorage = (*Storage)(nil)
+
+// New creates an empty Storage.
+func New() *Storage {
+	return &Storage{tables: make(map[int64][]*tableStats)}
+}
+
+// nextVersion returns a version larger than all the previous ones, which is a TSO of the current time if possible,
+// so the stats can be loaded at the snapshots of a real store too.
+func (s *Storage) nextVersion() uint64 {
+	s.lastVersion = max(s.lastVersion+1, oracle.GoTimeToTS(time.Now()))
+	return s.lastVersion
+}
+
+// latest returns the latest version of the stats of the table at the snapshot, the latest one if the snapshot is 0.
+func (s *Storage) latest(physicalID int64, snapshot uint64) *tableStats {
+	versions := s.tables[physicalID]
+	for i := len(versions) - 1; i >= 0; i-- {
+		if snapshot == 0 || versions[i].version <= snapshot {
+			return versions[i]
+		}
+	}
+	return nil
+}
+
+// Save implements the StatsStorage interface.
+func (s *Storage) Save(results *statistics.AnalyzeResults, _ bool, _ string) (statsVer uint64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tableID := results.TableID.GetStatisticsID()
+	stats := &tableStats{
+		count:    results.Count,
+		snapshot: results.Snapshot,
+		columns:  make(map[int64]*item),
+		indexes:  make(map[int64]*item),
+	}
+	if prev := s.latest(tableID, 0); prev != nil {
+		// A newer version analyze result has been saved, so skip this one, as the system tables do.
+		if prev.snapshot >= results.Snapshot && results.StatsVer == statistics.Version2 && !results.ForMVIndexOrGlobalIndex {
+			return 0, nil
+		}
+		for id, it := range prev.columns {
+			stats.columns[id] = it
+		}
+		for id, it := range prev.indexes {
+			stats.indexes[id] = it
+		}
+		if results.ForMVIndexOrGlobalIndex {
+			stats.count, stats.snapshot = prev.count, prev.snapshot
+		}
+	}
+	stats.version = s.nextVersion()
+	for _, result := range results.Ars {
+		for i, hg := range result.Hist {
+			// It's normal virtual column, skip it.
+			if hg == nil {
+				continue
+			}
+			// The results are destroyed after being saved, so keep the copies of them.
+			it := &item{
+				hist:     hg.Copy(),
+				topN:     result.TopNs[i].Copy(),
+				fms:      result.Fms[i].Copy(),
+				statsVer: int64(results.StatsVer),
+			}
+			it.hist.LastUpdateVersion = stats.version
+			if results.StatsVer != statistics.Version2 {
+				it.cms = result.Cms[i].Copy()
+			}
+			if result.IsIndex > 0 {
+				stats.indexes[hg.ID] = it
+			} else {
+				stats.columns[hg.ID] = it
+			}
+		}
+	}
+	s.tables[tableID] = append(s.tables[tableID], stats)
+	return stats.version, nil
+}
+
+// Load implements the StatsStorage interface. The stats are always built from the saved ones rather than updating the
+// cached table.
+func (s *Storage) Load(
+	tableInfo *model.TableInfo,
+	physicalID int64,
+	loadAll bool,
+	snapshot uint64,
+	_ *statistics.Table,
+) (*statistics.Table, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.latest(physicalID, snapshot)
+	if stats == nil {
+		return nil, nil
+	}
+	table := &statistics.Table{
+		HistColl:              *statistics.NewHistColl(physicalID, stats.count, 0, len(stats.columns), len(stats.indexes)),
+		ColAndIdxExistenceMap: statistics.NewColAndIndexExistenceMap(len(tableInfo.Columns), len(tableInfo.Indices)),
+	}
+	table.Pinned = tableInfo.TableCacheStatusType == model.TableCacheStatusEnable
+	for _, colInfo := range tableInfo.Columns {
+		it, ok := stats.columns[colInfo.ID]
+		if !ok {
+			continue
+		}
+		col := &statistics.Column{
+			PhysicalID: physicalID,
+			Histogram:  *it.hist.Copy(),
+			Info:       colInfo,
+			CMSketch:   it.cms.Copy(),
+			TopN:       it.topN.Copy(),
+			IsHandle:   tableInfo.PKIsHandle && mysql.HasPriKeyFlag(colInfo.GetFlag()),
+			StatsVer:   it.statsVer,
+		}
+		if loadAll {
+			col.FMSketch = it.fms.Copy()
+		}
+		if col.StatsAvailable() {
+			col.StatsLoadedStatus = statistics.NewStatsFullLoadStatus()
+		}
+		table.ColAndIdxExistenceMap.InsertCol(colInfo.ID, true)
+		table.SetCol(colInfo.ID, col)
+		table.StatsVer = int(it.statsVer)
+		table.LastAnalyzeVersion = max(table.LastAnalyzeVersion, it.hist.LastUpdateVersion)
+	}
+	for _, idxInfo := range tableInfo.Indices {
+		it, ok := stats.indexes[idxInfo.ID]
+		if !ok {
+			continue
+		}
+		idx := &statistics.Index{
+			Histogram:  *it.hist.Copy(),
+			CMSketch:   it.cms.Copy(),
+			TopN:       it.topN.Copy(),
+			Info:       idxInfo,
+			StatsVer:   it.statsVer,
+			PhysicalID: physicalID,
+		}
+		if loadAll {
+			idx.FMSketch = it.fms.Copy()
+		}
+		idx.StatsLoadedStatus = statistics.NewStatsFullLoadStatus()
+		table.ColAndIdxExistenceMap.InsertIndex(idxInfo.ID, true)
+		table.SetIdx(idxInfo.ID, idx)
+		table.StatsVer = int(it.statsVer)
+		table.LastAnalyzeVersion = max(table.LastAnalyzeVersion, it.hist.LastUpdateVersion)
+	}
+	table.ColAndIdxExistenceMap.SetChecked()
+	return table, nil
+}
+
+// History implements the StatsStorage interface. The historical stats in JSON are not kept, so the callers fall back
+// to the latest stats.
+func (*Storage) History(int64, uint64) (*statsutil.JSONTable, bool, error) {
+	return nil, false, nil
+}
+
+// GC implements the StatsStorage interface. The deleted stats only keep the row count, as the stats meta kept in the
+// system tables.
+func (s *Storage) GC(statsIDs []int64, _ bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, statsID := range statsIDs {
+		prev := s.latest(statsID, 0)
+		if prev == nil {
+			continue
+		}
+		s.tables[statsID] = append(s.tables[statsID], &tableStats{
+			version:  s.nextVersion(),
+			count:    prev.count,
+			snapshot: prev.snapshot,
+			columns:  make(map[int64]*item),
+			indexes:  make(map[int64]*item),
+		})
+	}
+	return nil
+}
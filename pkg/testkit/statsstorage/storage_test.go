@@ -0,0 +1,176 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statsstorage_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/testkit/statsstorage"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+const tableID = 100
+
+func newTableInfo() *model.TableInfo {
+	return &model.TableInfo{
+		ID:   tableID,
+		Name: ast.NewCIStr("t"),
+		Columns: []*model.ColumnInfo{
+			{ID: 1, Name: ast.NewCIStr("a"), Offset: 0, FieldType: *types.NewFieldType(mysql.TypeLonglong)},
+			{ID: 2, Name: ast.NewCIStr("b"), Offset: 1, FieldType: *types.NewFieldType(mysql.TypeLonglong)},
+		},
+		Indices: []*model.IndexInfo{
+			{ID: 1, Name: ast.NewCIStr("idx"), Columns: []*model.IndexColumn{{Name: ast.NewCIStr("a"), Offset: 0}}},
+		},
+	}
+}
+
+// newResults creates the analyze results of column a and index idx on the rows 1..count.
+func newResults(count int64, snapshot uint64) *statistics.AnalyzeResults {
+	newHist := func(id int64) *statistics.Histogram {
+		hg := statistics.NewHistogram(id, count, 0, 0, types.NewFieldType(mysql.TypeLonglong), 1, 0)
+		lower, upper := types.NewIntDatum(1), types.NewIntDatum(count)
+		hg.AppendBucket(&lower, &upper, count, 1)
+		return hg
+	}
+	fms := statistics.NewFMSketch(8)
+	return &statistics.AnalyzeResults{
+		TableID:  statistics.AnalyzeTableID{TableID: tableID, PartitionID: statistics.NonPartitionTableID},
+		Count:    count,
+		StatsVer: statistics.Version2,
+		Snapshot: snapshot,
+		Ars: []*statistics.AnalyzeResult{
+			{
+				Hist:  []*statistics.Histogram{newHist(1)},
+				Cms:   []*statistics.CMSketch{nil},
+				TopNs: []*statistics.TopN{statistics.NewTopN(1)},
+				Fms:   []*statistics.FMSketch{fms},
+			},
+			{
+				Hist:    []*statistics.Histogram{newHist(1)},
+				Cms:     []*statistics.CMSketch{nil},
+				TopNs:   []*statistics.TopN{nil},
+				Fms:     []*statistics.FMSketch{nil},
+				IsIndex: 1,
+			},
+		},
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	s := statsstorage.New()
+	tableInfo := newTableInfo()
+	tbl, err := s.Load(tableInfo, tableID, true, 0, nil)
+	require.NoError(t, err)
+	require.Nil(t, tbl)
+
+	ver1, err := s.Save(newResults(3, 1), false, "test")
+	require.NoError(t, err)
+	require.NotZero(t, ver1)
+	tbl, err = s.Load(tableInfo, tableID, false, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), tbl.RealtimeCount)
+	require.Equal(t, statistics.Version2, tbl.StatsVer)
+	require.Equal(t, ver1, tbl.LastAnalyzeVersion)
+	col := tbl.GetCol(1)
+	require.True(t, col.IsFullLoad())
+	require.Equal(t, int64(3), col.NDV)
+	require.Equal(t, ver1, col.LastUpdateVersion)
+	require.Nil(t, col.FMSketch)
+	require.Nil(t, tbl.GetCol(2))
+	require.True(t, tbl.ColAndIdxExistenceMap.Has(1, false))
+	require.False(t, tbl.ColAndIdxExistenceMap.Has(2, false))
+	require.Equal(t, int64(3), tbl.GetIdx(1).NDV)
+
+	// The results with an older snapshot are skipped.
+	ver, err := s.Save(newResults(4, 1), false, "test")
+	require.NoError(t, err)
+	require.Zero(t, ver)
+
+	ver2, err := s.Save(newResults(5, 2), false, "test")
+	require.NoError(t, err)
+	require.Greater(t, ver2, ver1)
+	tbl, err = s.Load(tableInfo, tableID, true, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), tbl.RealtimeCount)
+	require.NotNil(t, tbl.GetCol(1).FMSketch)
+	// The stats are loaded at the snapshot.
+	tbl, err = s.Load(tableInfo, tableID, true, ver1, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), tbl.RealtimeCount)
+	require.Equal(t, ver1, tbl.GetCol(1).LastUpdateVersion)
+	tbl, err = s.Load(tableInfo, tableID, true, ver1-1, nil)
+	require.NoError(t, err)
+	require.Nil(t, tbl)
+
+	_, exist, err := s.History(tableID, ver2)
+	require.NoError(t, err)
+	require.False(t, exist)
+}
+
+func TestGC(t *testing.T) {
+	s := statsstorage.New()
+	tableInfo := newTableInfo()
+	ver, err := s.Save(newResults(3, 1), false, "test")
+	require.NoError(t, err)
+	require.NoError(t, s.GC([]int64{tableID, tableID + 1}, true))
+
+	tbl, err := s.Load(tableInfo, tableID, true, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), tbl.RealtimeCount)
+	require.Nil(t, tbl.GetCol(1))
+	require.Nil(t, tbl.GetIdx(1))
+	require.Equal(t, statistics.Version0, tbl.StatsVer)
+	tbl, err = s.Load(tableInfo, tableID+1, true, 0, nil)
+	require.NoError(t, err)
+	require.Nil(t, tbl)
+	// The stats before the deletion are still loaded at the snapshot.
+	tbl, err = s.Load(tableInfo, tableID, true, ver, nil)
+	require.NoError(t, err)
+	require.NotNil(t, tbl.GetCol(1))
+}
+
+func TestStatsHandleWithStorage(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index idx(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	h := dom.StatsHandle()
+	backend := h.StatsStorage()
+	h.SetStatsStorage(statsstorage.New())
+	defer h.SetStatsStorage(backend)
+
+	tk.MustExec("analyze table t all columns")
+	tableInfo := dom.MustGetTableInfo(t, "test", "t")
+	// The analyzed stats are kept in memory rather than the system tables.
+	tk.MustQuery("select count(*) from mysql.stats_histograms where table_id = ?", tableInfo.ID).Check(testkit.Rows("0"))
+	statsTbl, err := h.TableStatsFromStorage(tableInfo, tableInfo.ID, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), statsTbl.RealtimeCount)
+	require.Equal(t, int64(3), statsTbl.GetCol(tableInfo.Columns[1].ID).NDV)
+	require.Equal(t, int64(3), statsTbl.GetIdx(tableInfo.Indices[0].ID).NDV)
+
+	require.NoError(t, h.DeleteTableStatsFromKV([]int64{tableInfo.ID}, true))
+	statsTbl, err = h.TableStatsFromStorage(tableInfo, tableInfo.ID, true, 0)
+	require.NoError(t, err)
+	require.Nil(t, statsTbl.GetCol(tableInfo.Columns[1].ID))
+}
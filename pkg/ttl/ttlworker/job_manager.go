@@ -582,6 +582,9 @@ j:
 				continue
 			}
 			m.removeJob(job)
+			if tbl, ok := m.infoSchemaCache.Tables[job.tableID]; ok && summary.SuccessRows > 0 {
+				AdjustStatsAfterTTL(tbl, job.ttlExpireTime)
+			}
 		}
 		cancel()
 	}
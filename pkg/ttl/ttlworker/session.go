@@ -56,6 +56,10 @@ var DetachStatsCollector = func(s sqlexec.SQLExecutor) sqlexec.SQLExecutor {
 	return s
 }
 
+// AdjustStatsAfterTTL adjusts the stats of the table after a TTL job has deleted the rows expired before the expire time.
+// this function is registered in BootstrapSession in /session/session.go
+var AdjustStatsAfterTTL = func(tbl *cache.PhysicalTable, expireTime time.Time) {}
+
 var allIsolationReadEngines = map[kv.StoreType]struct{}{
 	kv.TiKV:    {},
 	kv.TiFlash: {},
@@ -143,6 +143,30 @@ func TestUTF8CollatorKey(t *testing.T) {
 	testKeyTable(t, collations, tests)
 }
 
+func TestDecodeKey(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+	collations := []string{"binary", "utf8mb4_bin", "utf8mb4_general_ci", "utf8mb4_unicode_ci", "utf8mb4_0900_ai_ci", "utf8mb4_0900_bin", "gbk_bin", "gbk_chinese_ci"}
+	strs := []string{"a", "A", "abc Def", "a ", "中文", "갟감1", "Foo © bar baz"}
+	for _, collation := range collations {
+		c := GetCollator(collation)
+		for _, str := range strs {
+			decoded, ok := DecodeKey(collation, c.Key(str))
+			require.True(t, ok, "%s %s", collation, str)
+			require.Equal(t, 0, c.Compare(decoded, str), "%s %s %s", collation, str, decoded)
+		}
+	}
+	decoded, ok := DecodeKey("utf8mb4_general_ci", GetCollator("utf8mb4_general_ci").Key("abc"))
+	require.True(t, ok)
+	require.Equal(t, "ABC", decoded)
+	decoded, ok = DecodeKey("utf8mb4_bin", GetCollator("utf8mb4_bin").Key("abc"))
+	require.True(t, ok)
+	require.Equal(t, "abc", decoded)
+	// The weights of the runes out of the BMP can't be decoded.
+	_, ok = DecodeKey("utf8mb4_0900_ai_ci", GetCollator("utf8mb4_0900_ai_ci").Key("\U0001F600"))
+	require.False(t, ok)
+}
+
 func TestSetNewCollateEnabled(t *testing.T) {
 	defer SetNewCollationEnabledForTest(false)
 
@@ -0,0 +1,86 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// keyDecoders caches the keyDecoder of each collation, which is built when the keys of the collation are decoded at
+// the first time.
+var keyDecoders sync.Map // map[string]*keyDecoder
+
+// keyDecoder maps the collate keys of the runes in the BMP back to the runes.
+type keyDecoder struct {
+	runes     map[string]rune
+	maxKeyLen int
+}
+
+func newKeyDecoder(c Collator) *keyDecoder {
+	d := &keyDecoder{runes: make(map[string]rune)}
+	for r := rune(0); r <= 0xFFFF; r++ {
+		if r >= 0xD800 && r <= 0xDFFF {
+			// Surrogates are not valid runes.
+			continue
+		}
+		key := c.KeyWithoutTrimRightSpace(string(r))
+		// The ignorable runes have no weight. The runes are iterated in order, so the smallest one of the runes
+		// with the same weights is kept.
+		if _, ok := d.runes[string(key)]; len(key) == 0 || ok {
+			continue
+		}
+		d.runes[string(key)] = r
+		d.maxKeyLen = max(d.maxKeyLen, len(key))
+	}
+	return d
+}
+
+func (d *keyDecoder) decode(key []byte) (string, bool) {
+	var sb strings.Builder
+	for len(key) > 0 {
+		matched := false
+		for l := min(d.maxKeyLen, len(key)); l > 0; l-- {
+			if r, ok := d.runes[string(key[:l])]; ok {
+				sb.WriteRune(r)
+				key = key[l:]
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return "", false
+		}
+	}
+	return sb.String(), true
+}
+
+// DecodeKey returns a string whose collate key is the key, so it equals to the string the key is generated from under
+// the collation, e.g. "ABC" for the key of "abc" in utf8mb4_general_ci. The smallest one of the runes with the same
+// weights is chosen. It returns false if the key can't be decoded, e.g. it contains the weights of the runes out of
+// the BMP. It's used to show the collate keys kept in the statistics.
+func DecodeKey(collate string, key []byte) (string, bool) {
+	c := GetCollator(collate)
+	switch c.(type) {
+	case *binCollator, *binPaddingCollator:
+		return string(key), utf8.Valid(key)
+	}
+	v, ok := keyDecoders.Load(collate)
+	if !ok {
+		v, _ = keyDecoders.LoadOrStore(collate, newKeyDecoder(c.Clone()))
+	}
+	return v.(*keyDecoder).decode(key)
+}
@@ -35,6 +35,8 @@ var (
 const (
 	lockFile  = "_dir.lock"
 	recordDir = "record"
+	// statsDeltaCheckpointFile is the checkpoint of the pending stats deltas, which is kept across the restarts.
+	statsDeltaCheckpointFile = "stats_delta.checkpoint"
 )
 
 // CheckAndInitTempDir check whether the temp directory is existed.
@@ -92,7 +94,7 @@ func InitializeTempDir() error {
 			for _, subDir := range subDirs {
 				// Do not remove the lock file.
 				switch subDir.Name() {
-				case lockFile, recordDir:
+				case lockFile, recordDir, statsDeltaCheckpointFile:
 					continue
 				}
 				err := os.RemoveAll(filepath.Join(tempDir, subDir.Name()))
@@ -106,6 +108,15 @@ func InitializeTempDir() error {
 	return nil
 }
 
+// StatsDeltaCheckpointPath returns the path of the checkpoint of the pending stats deltas in the temp storage dir. It's
+// empty if the dir isn't locked by the instance, since the checkpoint must not be shared by the instances.
+func StatsDeltaCheckpointPath() string {
+	if tempDirLock == nil {
+		return ""
+	}
+	return filepath.Join(config.GetGlobalConfig().TempStoragePath, statsDeltaCheckpointFile)
+}
+
 // CleanUp releases the directory lock when exiting TiDB.
 func CleanUp() {
 	if tempDirLock != nil {
@@ -114,6 +114,10 @@ const (
 	HintSemiJoinRewrite = "semi_join_rewrite"
 	// HintNoDecorrelate indicates a LogicalApply not to be decorrelated.
 	HintNoDecorrelate = "no_decorrelate"
+	// HintSetTableRows is a hint to override the row count of the table in the statistics.
+	HintSetTableRows = "set_table_rows"
+	// HintSetColumnNDV is a hint to override the NDV of the column in the statistics.
+	HintSetColumnNDV = "set_column_ndv"
 
 	// HintMemoryQuota sets the memory limit for a query
 	HintMemoryQuota = "memory_quota"
@@ -123,6 +127,8 @@ const (
 	HintNoIndexMerge = "no_index_merge"
 	// HintMaxExecutionTime specifies the max allowed execution time in milliseconds
 	HintMaxExecutionTime = "max_execution_time"
+	// HintStatsSnapshotTS specifies the timestamp of the historical stats used to optimize the query
+	HintStatsSnapshotTS = "stats_snapshot_ts"
 
 	// HintFlagSemiJoinRewrite corresponds to HintSemiJoinRewrite.
 	HintFlagSemiJoinRewrite uint64 = 1 << iota
@@ -215,6 +221,8 @@ type StmtHints struct {
 	// -1 for disable.
 	ForceNthPlan  int64
 	ResourceGroup string
+	// StatsSnapshotTS is the timestamp of the historical stats used to optimize the query.
+	StatsSnapshotTS uint64
 
 	// Hint flags
 	HasAllowInSubqToJoinAndAggHint bool
@@ -223,6 +231,7 @@ type StmtHints struct {
 	HasMaxExecutionTime            bool
 	HasEnableCascadesPlannerHint   bool
 	HasResourceGroup               bool
+	HasStatsSnapshotTS             bool
 	SetVars                        map[string]string
 
 	// Hypo Indexes from Hints
@@ -263,12 +272,14 @@ func (sh *StmtHints) Clone() *StmtHints {
 		EnableCascadesPlanner:          sh.EnableCascadesPlanner,
 		ForceNthPlan:                   sh.ForceNthPlan,
 		ResourceGroup:                  sh.ResourceGroup,
+		StatsSnapshotTS:                sh.StatsSnapshotTS,
 		HasAllowInSubqToJoinAndAggHint: sh.HasAllowInSubqToJoinAndAggHint,
 		HasMemQuotaHint:                sh.HasMemQuotaHint,
 		HasReplicaReadHint:             sh.HasReplicaReadHint,
 		HasMaxExecutionTime:            sh.HasMaxExecutionTime,
 		HasEnableCascadesPlannerHint:   sh.HasEnableCascadesPlannerHint,
 		HasResourceGroup:               sh.HasResourceGroup,
+		HasStatsSnapshotTS:             sh.HasStatsSnapshotTS,
 		SetVars:                        vars,
 		OriginalTableHints:             tableHints,
 	}
@@ -298,7 +309,7 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 	}
 	hintOffs := make(map[string]int, len(hints))
 	var forceNthPlan *ast.TableOptimizerHint
-	var memoryQuotaHintCnt, useToJAHintCnt, useCascadesHintCnt, noIndexMergeHintCnt, readReplicaHintCnt, maxExecutionTimeCnt, forceNthPlanCnt, straightJoinHintCnt, resourceGroupHintCnt int
+	var memoryQuotaHintCnt, useToJAHintCnt, useCascadesHintCnt, noIndexMergeHintCnt, readReplicaHintCnt, maxExecutionTimeCnt, forceNthPlanCnt, straightJoinHintCnt, resourceGroupHintCnt, statsSnapshotTSHintCnt int
 	setVars := make(map[string]string)
 	setVarsOffs := make([]int, 0, len(hints))
 	for i, hint := range hints {
@@ -324,6 +335,9 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 		case "max_execution_time":
 			hintOffs[hint.HintName.L] = i
 			maxExecutionTimeCnt++
+		case "stats_snapshot_ts":
+			hintOffs[hint.HintName.L] = i
+			statsSnapshotTSHintCnt++
 		case "nth_plan":
 			forceNthPlanCnt++
 			forceNthPlan = hint
@@ -471,6 +485,16 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 		stmtHints.HasMaxExecutionTime = true
 		stmtHints.MaxExecutionTime = maxExecutionTime.HintData.(uint64)
 	}
+	// Handle STATS_SNAPSHOT_TS
+	if statsSnapshotTSHintCnt != 0 {
+		statsSnapshotTS := hints[hintOffs["stats_snapshot_ts"]]
+		if statsSnapshotTSHintCnt > 1 {
+			warn := errors.NewNoStackErrorf("STATS_SNAPSHOT_TS() is defined more than once, only the last definition takes effect: STATS_SNAPSHOT_TS(%v)", statsSnapshotTS.HintData.(uint64))
+			warns = append(warns, warn)
+		}
+		stmtHints.HasStatsSnapshotTS = true
+		stmtHints.StatsSnapshotTS = statsSnapshotTS.HintData.(uint64)
+	}
 	// Handle RESOURCE_GROUP
 	if resourceGroupHintCnt != 0 {
 		resourceGroup := hints[hintOffs["resource_group"]]
@@ -508,7 +532,7 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 // isStmtHint checks whether this hint is a statement-level hint.
 func isStmtHint(h *ast.TableOptimizerHint) bool {
 	switch h.HintName.L {
-	case "max_execution_time", "memory_quota", "resource_group":
+	case "max_execution_time", "memory_quota", "resource_group", "stats_snapshot_ts":
 		return true
 	default:
 		return false
@@ -540,6 +564,7 @@ type PlanHints struct {
 	LeadingJoinOrder   []HintedTable  // leading
 	HJBuild            []HintedTable  // hash_join_build
 	HJProbe            []HintedTable  // hash_join_probe
+	StatsOverrides     []HintedStats  // set_table_rows, set_column_ndv
 
 	// Hints belows are not associated with any particular table.
 	PreferAggType    uint // hash_agg, merge_agg, agg_to_cop and so on
@@ -558,6 +583,23 @@ type HintedTable struct {
 	Matched      bool        // whether this hint is applied successfully
 }
 
+// HintedStats indicates the statistics of which table or column are overridden by the hint.
+type HintedStats struct {
+	HintedTable
+	HintName string    // set_table_rows or set_column_ndv
+	Column   ast.CIStr // the column name, empty for set_table_rows
+	Value    float64   // the value of the statistics
+}
+
+// String formats the hint as hintName(DBName.tableName[.columnName], value).
+func (hint *HintedStats) String() string {
+	target := fmt.Sprintf("%s.%s", hint.DBName, hint.TblName)
+	if hint.Column.L != "" {
+		target += "." + hint.Column.L
+	}
+	return fmt.Sprintf("%s(%s, %v)", hint.HintName, target, hint.Value)
+}
+
 // HintedIndex indicates which index this hint should take effect on.
 type HintedIndex struct {
 	DBName     ast.CIStr      // the database name
@@ -697,6 +739,22 @@ func (*PlanHints) matchTiKVOrTiFlash(tableName *HintedTable, hintTables []Hinted
 	return nil
 }
 
+// MatchStatsOverrides returns the hints overriding the statistics of the given table in the select block.
+func (pHints *PlanHints) MatchStatsOverrides(dbName, tblName ast.CIStr, selectOffset int) []HintedStats {
+	if pHints == nil {
+		return nil
+	}
+	var matched []HintedStats
+	for i, hint := range pHints.StatsOverrides {
+		if (hint.DBName.L == dbName.L || hint.DBName.L == "*") && hint.TblName.L == tblName.L &&
+			hint.SelectOffset == selectOffset {
+			pHints.StatsOverrides[i].Matched = true
+			matched = append(matched, hint)
+		}
+	}
+	return matched
+}
+
 // MatchTableName checks whether the hint hit the need.
 // Only need either side matches one on the list.
 // Even though you can put 2 tables on the list,
@@ -744,6 +802,7 @@ func ParsePlanHints(hints []*ast.TableOptimizerHint,
 		cteMerge                                                                        bool
 		leadingJoinOrder                                                                []HintedTable
 		hjBuildTables, hjProbeTables                                                    []HintedTable
+		statsOverrides                                                                  []HintedStats
 		leadingHintCnt                                                                  int
 	)
 	for _, hint := range hints {
@@ -856,6 +915,16 @@ func ParsePlanHints(hints []*ast.TableOptimizerHint,
 					HintScope:  ast.HintForScan,
 				},
 			})
+		case HintSetTableRows, HintSetColumnNDV:
+			var column ast.CIStr
+			value, ok := hint.HintData.(float64)
+			if !ok {
+				colStats := hint.HintData.(ast.HintColumnStats)
+				column, value = colStats.Column, colStats.Value
+			}
+			for _, tbl := range tableNames2HintTableInfo(currentDB, hint.HintName.L, hint.Tables, hintProcessor, currentLevel, warnHandler) {
+				statsOverrides = append(statsOverrides, HintedStats{HintedTable: tbl, HintName: hint.HintName.L, Column: column, Value: value})
+			}
 		case HintTimeRange:
 			timeRangeHint = hint.HintData.(ast.HintTimeRange)
 		case HintLimitToCop:
@@ -917,6 +986,7 @@ func ParsePlanHints(hints []*ast.TableOptimizerHint,
 		LeadingJoinOrder:   leadingJoinOrder,
 		HJBuild:            hjBuildTables,
 		HJProbe:            hjProbeTables,
+		StatsOverrides:     statsOverrides,
 	}, subQueryHintFlags, nil
 }
 
@@ -1077,6 +1147,17 @@ func CollectUnmatchedHintWarnings(hintInfo *PlanHints) (warnings []string) {
 	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintHashJoinProbe, "", hintInfo.HJProbe)...)
 	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintLeading, "", hintInfo.LeadingJoinOrder)...)
 	warnings = append(warnings, collectUnmatchedStorageHintWarning(hintInfo.TiFlashTables, hintInfo.TiKVTables)...)
+	warnings = append(warnings, collectUnmatchedStatsHintWarning(hintInfo.StatsOverrides)...)
+	return warnings
+}
+
+func collectUnmatchedStatsHintWarning(statsHints []HintedStats) (warnings []string) {
+	for _, hint := range statsHints {
+		if !hint.Matched {
+			warnings = append(warnings, fmt.Sprintf("%s is inapplicable, check whether the table(%s.%s) exists",
+				hint.String(), hint.DBName, hint.TblName))
+		}
+	}
 	return warnings
 }
 
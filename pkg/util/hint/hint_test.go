@@ -0,0 +1,64 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hint
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	_ "github.com/pingcap/tidb/pkg/types/parser_driver"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWarnHandler struct {
+	warnings []string
+}
+
+func (h *mockWarnHandler) SetHintWarning(warn string) {
+	h.warnings = append(h.warnings, warn)
+}
+
+func (h *mockWarnHandler) SetHintWarningFromError(err error) {
+	h.warnings = append(h.warnings, err.Error())
+}
+
+func TestParseStatsOverrideHints(t *testing.T) {
+	stmt, _, err := parser.New().Parse("select /*+ set_table_rows(t1, 1e9), set_table_rows(db2.t2, 100), set_column_ndv(t1.c1, 50000), set_column_ndv(t3.c3, 1) */ * from t1, db2.t2", "", "")
+	require.NoError(t, err)
+	warnHandler := &mockWarnHandler{}
+	planHints, _, err := ParsePlanHints(stmt[0].(*ast.SelectStmt).TableHints, 1, "test", nil, false, false, false, true, warnHandler)
+	require.NoError(t, err)
+	require.Empty(t, warnHandler.warnings)
+	require.Len(t, planHints.StatsOverrides, 4)
+	require.Equal(t, "set_table_rows(test.t1, 1e+09)", planHints.StatsOverrides[0].String())
+	require.Equal(t, "set_table_rows(db2.t2, 100)", planHints.StatsOverrides[1].String())
+	require.Equal(t, "set_column_ndv(test.t1.c1, 50000)", planHints.StatsOverrides[2].String())
+	require.Equal(t, "set_column_ndv(test.t3.c3, 1)", planHints.StatsOverrides[3].String())
+
+	matched := planHints.MatchStatsOverrides(ast.NewCIStr("test"), ast.NewCIStr("t1"), 1)
+	require.Len(t, matched, 2)
+	require.Equal(t, HintSetTableRows, matched[0].HintName)
+	require.Equal(t, float64(1e9), matched[0].Value)
+	require.Equal(t, HintSetColumnNDV, matched[1].HintName)
+	require.Equal(t, "c1", matched[1].Column.L)
+	require.Equal(t, float64(50000), matched[1].Value)
+	// The hints only match the tables in the same query block.
+	require.Empty(t, planHints.MatchStatsOverrides(ast.NewCIStr("db2"), ast.NewCIStr("t2"), 2))
+	require.Len(t, planHints.MatchStatsOverrides(ast.NewCIStr("db2"), ast.NewCIStr("t2"), 1), 1)
+
+	require.Equal(t, []string{"set_column_ndv(test.t3.c3, 1) is inapplicable, check whether the table(test.t3) exists"},
+		CollectUnmatchedHintWarnings(planHints))
+}
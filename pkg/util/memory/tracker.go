@@ -910,6 +910,12 @@ const (
 	LabelForSortPartition int = -31
 	// LabelForHashTableInHashJoinV2 represents the label of the hash join v2's hash table
 	LabelForHashTableInHashJoinV2 int = -32
+	// LabelForStatsMemory represents the label of the memory of all the stats subsystems
+	LabelForStatsMemory int = -33
+	// LabelForGlobalStatsMerge represents the label of the buffers of merging the global stats
+	LabelForGlobalStatsMerge int = -34
+	// LabelForStatsDelta represents the label of the delta maps of the stats
+	LabelForStatsDelta int = -35
 )
 
 // MetricsTypes is used to get label for metrics
@@ -492,6 +492,9 @@ func (c *Context) GoCtx() context.Context {
 // UpdateColStatsUsage updates the column stats usage.
 func (*Context) UpdateColStatsUsage(_ iter.Seq[model.TableItemID]) {}
 
+// UpdatePredicateSelectivity updates the classes of the selectivity of the predicates on the columns.
+func (*Context) UpdatePredicateSelectivity(_ map[model.TableItemID]uint8) {}
+
 // StoreIndexUsage strores the index usage information.
 func (*Context) StoreIndexUsage(_ int64, _ int64, _ int64) {}
 
@@ -0,0 +1,142 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermemorylimit
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/memory"
+	"go.uber.org/zap"
+)
+
+// minShrinkInterval is the min interval between two shrinks of the same consumer, so a consumer which can't release
+// enough memory doesn't stop the controller from killing the top1 session.
+const minShrinkInterval = 10 * time.Second
+
+// MemoryConsumer is a consumer of the memory outside the sessions, e.g. the caches and the background jobs, which is
+// registered with the server memory controller. Its memory usage is shown in information_schema.memory_usage_consumers,
+// and when the heap in use exceeds tidb_server_memory_limit, the controller shrinks the consumer if it uses more memory
+// than the top1 session instead of killing the session.
+type MemoryConsumer struct {
+	// Name is the unique name of the consumer.
+	Name string
+	// Tracker tracks the memory usage of the consumer.
+	Tracker *memory.Tracker
+	// Shrink releases the memory of the consumer, e.g. evicts the cache or cancels the job, and returns the bytes
+	// released. The consumer can't be shrunk if it's nil.
+	Shrink func() int64
+
+	lastShrink  time.Time
+	shrinkTotal int64
+}
+
+// MemoryConsumerUsage is the memory usage of a registered consumer.
+type MemoryConsumerUsage struct {
+	Name        string
+	Bytes       int64
+	MaxBytes    int64
+	Shrinkable  bool
+	ShrinkLast  time.Time
+	ShrinkTotal int64
+}
+
+var memoryConsumers struct {
+	sync.Mutex
+	list []*MemoryConsumer
+}
+
+// RegisterMemoryConsumer registers a memory consumer with the server memory controller. The consumer registered
+// before with the same name is replaced.
+func RegisterMemoryConsumer(consumer *MemoryConsumer) {
+	memoryConsumers.Lock()
+	defer memoryConsumers.Unlock()
+	memoryConsumers.list = slices.DeleteFunc(memoryConsumers.list, func(c *MemoryConsumer) bool {
+		return c.Name == consumer.Name
+	})
+	memoryConsumers.list = append(memoryConsumers.list, consumer)
+}
+
+// UnregisterMemoryConsumer unregisters the memory consumer of the name.
+func UnregisterMemoryConsumer(name string) {
+	memoryConsumers.Lock()
+	defer memoryConsumers.Unlock()
+	memoryConsumers.list = slices.DeleteFunc(memoryConsumers.list, func(c *MemoryConsumer) bool {
+		return c.Name == name
+	})
+}
+
+// MemoryConsumersUsage returns the memory usage of the registered consumers in the order of registration.
+func MemoryConsumersUsage() []MemoryConsumerUsage {
+	memoryConsumers.Lock()
+	defer memoryConsumers.Unlock()
+	usages := make([]MemoryConsumerUsage, 0, len(memoryConsumers.list))
+	for _, c := range memoryConsumers.list {
+		usages = append(usages, MemoryConsumerUsage{
+			Name:        c.Name,
+			Bytes:       c.Tracker.BytesConsumed(),
+			MaxBytes:    c.Tracker.MaxConsumed(),
+			Shrinkable:  c.Shrink != nil,
+			ShrinkLast:  c.lastShrink,
+			ShrinkTotal: c.shrinkTotal,
+		})
+	}
+	return usages
+}
+
+// shrinkConsumerIfNeeded shrinks the largest shrinkable consumer if it uses more memory than both the top1 session and
+// tidb_server_memory_limit_sess_min_size. It returns whether a consumer is shrunk, in which case the controller
+// doesn't kill the top1 session until the next check.
+func shrinkConsumerIfNeeded(top1SessUsage int64, limitSessMinSize, memoryLimit, heapInuse uint64) bool {
+	memoryConsumers.Lock()
+	var target *MemoryConsumer
+	now := time.Now()
+	for _, c := range memoryConsumers.list {
+		if c.Shrink == nil || now.Sub(c.lastShrink) < minShrinkInterval {
+			continue
+		}
+		usage := c.Tracker.BytesConsumed()
+		if usage <= top1SessUsage || uint64(usage) < limitSessMinSize {
+			continue
+		}
+		if target == nil || usage > target.Tracker.BytesConsumed() {
+			target = c
+		}
+	}
+	if target == nil {
+		memoryConsumers.Unlock()
+		return false
+	}
+	target.lastShrink = now
+	target.shrinkTotal++
+	name, usage, shrink := target.Name, target.Tracker.BytesConsumed(), target.Shrink
+	memoryConsumers.Unlock()
+
+	released := shrink()
+	logutil.BgLogger().Warn("global memory controller shrinks the top1 memory consumer",
+		zap.String("consumer", name),
+		zap.Int64("memory usage", usage),
+		zap.Int64("released", released),
+		zap.Uint64("tidb_server_memory_limit", memoryLimit),
+		zap.Uint64("heap inuse", heapInuse),
+	)
+	GlobalMemoryOpsHistoryManager.recordShrink(name, usage, now, memoryLimit, heapInuse)
+	//nolint: all_revive,revive
+	runtime.GC()
+	return true
+}
@@ -148,6 +148,14 @@ func killSessIfNeeded(s *sessionToBeKilled, bt uint64, sm util.SessionManager) {
 	limitSessMinSize := memory.ServerMemoryLimitSessMinSize.Load()
 	if instanceStats.HeapInuse > bt {
 		t := memory.MemUsageTop1Tracker.Load()
+		var top1SessUsage int64
+		if t != nil {
+			top1SessUsage = t.BytesConsumed()
+		}
+		// The consumers outside the sessions, e.g. the stats cache, are shrunk first if they use more memory.
+		if shrinkConsumerIfNeeded(top1SessUsage, limitSessMinSize, bt, instanceStats.HeapInuse) {
+			return
+		}
 		if t != nil {
 			sessionID := t.SessionID.Load()
 			memUsage := t.BytesConsumed()
@@ -200,6 +208,7 @@ type memoryOpsHistoryManager struct {
 }
 
 type memoryOpsHistory struct {
+	op               string
 	killTime         time.Time
 	memoryLimit      uint64
 	memoryCurrent    uint64
@@ -214,9 +223,24 @@ func (m *memoryOpsHistoryManager) init() {
 func (m *memoryOpsHistoryManager) recordOne(info *util.ProcessInfo, killTime time.Time, memoryLimit uint64, memoryCurrent uint64) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	op := memoryOpsHistory{killTime: killTime, memoryLimit: memoryLimit, memoryCurrent: memoryCurrent, processInfoDatum: types.MakeDatums(info.ToRow(time.UTC)...)}
+	op := memoryOpsHistory{op: "SessionKill", killTime: killTime, memoryLimit: memoryLimit, memoryCurrent: memoryCurrent, processInfoDatum: types.MakeDatums(info.ToRow(time.UTC)...)}
 	sqlInfo := op.processInfoDatum[7]
 	sqlInfo.SetString(fmt.Sprintf("%.256v", sqlInfo.GetString()), mysql.DefaultCollationName) // Truncated
+	m.record(op)
+}
+
+// recordShrink records the shrink of a memory consumer, whose name is shown as the SQL_TEXT and the memory usage
+// before the shrink is shown as the MEM.
+func (m *memoryOpsHistoryManager) recordShrink(name string, usage int64, shrinkTime time.Time, memoryLimit uint64, memoryCurrent uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	datums := make([]types.Datum, 12)
+	datums[7].SetString(name, mysql.DefaultCollationName)
+	datums[9].SetUint64(uint64(usage))
+	m.record(memoryOpsHistory{op: "Shrink", killTime: shrinkTime, memoryLimit: memoryLimit, memoryCurrent: memoryCurrent, processInfoDatum: datums})
+}
+
+func (m *memoryOpsHistoryManager) record(op memoryOpsHistory) {
 	// Only record the last 50 history ops
 	m.infos[m.offsets] = op
 	m.offsets++
@@ -231,10 +255,9 @@ func (m *memoryOpsHistoryManager) GetRows() [][]types.Datum {
 	rows := make([][]types.Datum, 0, len(m.infos))
 	getRowFromInfo := func(info memoryOpsHistory) {
 		killTime := types.NewTime(types.FromGoTime(info.killTime), mysql.TypeDatetime, 0)
-		op := "SessionKill"
 		rows = append(rows, []types.Datum{
 			types.NewDatum(killTime),           // TIME
-			types.NewDatum(op),                 // OPS
+			types.NewDatum(info.op),            // OPS
 			types.NewDatum(info.memoryLimit),   // MEMORY_LIMIT
 			types.NewDatum(info.memoryCurrent), // MEMORY_CURRENT
 			info.processInfoDatum[0],           // PROCESSID
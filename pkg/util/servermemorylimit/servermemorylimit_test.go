@@ -21,6 +21,7 @@ import (
 
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/stretchr/testify/require"
 )
 
@@ -69,3 +70,53 @@ func TestMemoryUsageOpsHistory(t *testing.T) {
 	}
 	require.Equal(t, GlobalMemoryOpsHistoryManager.offsets, 3)
 }
+
+func TestShrinkMemoryConsumer(t *testing.T) {
+	defer GlobalMemoryOpsHistoryManager.init()
+	tracker := memory.NewTracker(memory.LabelForStatsCache, -1)
+	shrunk := 0
+	RegisterMemoryConsumer(&MemoryConsumer{
+		Name:    "test",
+		Tracker: tracker,
+		Shrink: func() int64 {
+			shrunk++
+			tracker.Consume(-50)
+			return 50
+		},
+	})
+	RegisterMemoryConsumer(&MemoryConsumer{Name: "test unshrinkable", Tracker: memory.NewTracker(memory.LabelForStatsDelta, -1)})
+	defer UnregisterMemoryConsumer("test")
+	defer UnregisterMemoryConsumer("test unshrinkable")
+
+	// The consumer uses less memory than the top1 session.
+	tracker.Consume(100)
+	require.False(t, shrinkConsumerIfNeeded(200, 0, 1000, 900))
+	// The consumer uses less memory than tidb_server_memory_limit_sess_min_size.
+	require.False(t, shrinkConsumerIfNeeded(50, 128, 1000, 900))
+	require.Equal(t, 0, shrunk)
+
+	require.True(t, shrinkConsumerIfNeeded(50, 0, 1000, 900))
+	require.Equal(t, 1, shrunk)
+	// The consumer isn't shrunk again within minShrinkInterval.
+	tracker.Consume(100)
+	require.False(t, shrinkConsumerIfNeeded(50, 0, 1000, 900))
+	require.Equal(t, 1, shrunk)
+
+	usages := MemoryConsumersUsage()
+	require.Len(t, usages, 2)
+	require.Equal(t, "test", usages[0].Name)
+	require.Equal(t, int64(150), usages[0].Bytes)
+	require.True(t, usages[0].Shrinkable)
+	require.Equal(t, int64(1), usages[0].ShrinkTotal)
+	require.False(t, usages[0].ShrinkLast.IsZero())
+	require.False(t, usages[1].Shrinkable)
+
+	rows := GlobalMemoryOpsHistoryManager.GetRows()
+	require.NotEmpty(t, rows)
+	row := rows[len(rows)-1]
+	require.Equal(t, "Shrink", row[1].GetString())
+	require.Equal(t, int64(1000), row[2].GetInt64())
+	require.Equal(t, int64(900), row[3].GetInt64())
+	require.Equal(t, int64(100), row[5].GetInt64())
+	require.Equal(t, "test", row[11].GetString())
+}
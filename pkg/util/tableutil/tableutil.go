@@ -31,7 +31,7 @@ type TempTable interface {
 	// GetModified queries whether the table is modified.
 	GetModified() bool
 
-	// The stats of this table (*statistics.Table).
+	// The stats of this table collected in the transaction (*statistics.TempTableStats).
 	// Define the return type as interface{} here to avoid cycle imports.
 	GetStats() any
 